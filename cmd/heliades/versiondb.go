@@ -15,7 +15,7 @@ import (
 	versiondbclient "helios-core/helios-chain/versiondb/client"
 
 	"helios-core/helios-chain/app"
-	"helios-core/helios-chain/cmd/heliades/opendb"
+	"helios-core/cmd/heliades/opendb"
 )
 
 // ChangeSetCmd returns a Cobra command for interacting with change sets.
@@ -35,5 +35,4 @@ func ChangeSetCmd() *cobra.Command {
 			return opendb.NewRocksdbOptions(nil, sstFileWriter)
 		},
 	})
-	return nil
 }
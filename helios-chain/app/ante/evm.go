@@ -12,6 +12,7 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.BankKeeper,
 			options.FeeMarketKeeper,
 			options.EvmKeeper,
+			options.Erc20Keeper,
 			options.DistributionKeeper,
 			options.StakingKeeper,
 			options.MaxTxGasWanted,
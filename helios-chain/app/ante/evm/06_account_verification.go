@@ -15,7 +15,8 @@ import (
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
 // - from address is NOT an EOA
-// - account balance is lower than the transaction cost
+// - account balance is lower than the transaction cost, or the transferred value when
+// the fees are paid with a fee token
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper evmtypes.AccountKeeper,
@@ -37,6 +38,18 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
+	// the fees paid with a fee token are checked when they are deducted, only the
+	// transferred value remains to be covered by the balance in the EVM denom
+	if _, ok := evmtypes.FeePaymentFromContext(ctx); ok {
+		if account.Balance.Cmp(txData.GetValue()) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFunds,
+				"failed to check sender balance: sender balance < tx value (%s < %s)", account.Balance, txData.GetValue(),
+			)
+		}
+		return nil
+	}
+
 	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
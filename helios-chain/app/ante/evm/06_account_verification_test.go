@@ -135,3 +135,75 @@ func getDefaultStateDBAccount(unitNetwork *network.UnitTestNetwork, addr common.
 	statedb := unitNetwork.GetStateDB()
 	return statedb.Keeper().GetAccount(unitNetwork.GetContext(), addr)
 }
+
+func (suite *EvmAnteTestSuite) TestVerifyAccountBalanceWithFeeToken() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	senderKey := keyring.GetKey(0)
+
+	payment := evmtypes.FeePayment{Denom: "ausdt", ConversionRate: math.LegacyNewDec(1_000_000)}
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		value         func(balance *big.Int) *big.Int
+	}{
+		{
+			name: "success: the balance only covers the transferred value",
+			value: func(balance *big.Int) *big.Int {
+				return balance
+			},
+		},
+		{
+			name:          "fail: the balance is lower than the transferred value",
+			expectedError: errortypes.ErrInsufficientFunds,
+			value: func(balance *big.Int) *big.Int {
+				return new(big.Int).Add(balance, big.NewInt(1))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			statedbAccount := getDefaultStateDBAccount(unitNetwork, senderKey.Addr)
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+			suite.Require().NoError(err)
+			txArgs.Amount = tc.value(statedbAccount.Balance)
+			txData, err := txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			// the fees are paid with the fee token, the EVM denom balance can't cover them
+			err = evm.VerifyAccountBalance(
+				unitNetwork.GetContext(),
+				unitNetwork.App.AccountKeeper,
+				statedbAccount,
+				senderKey.Addr,
+				txData,
+			)
+			suite.Require().Error(err)
+
+			//  Function to be tested
+			err = evm.VerifyAccountBalance(
+				evmtypes.ContextWithFeePayment(unitNetwork.GetContext(), payment),
+				unitNetwork.App.AccountKeeper,
+				statedbAccount,
+				senderKey.Addr,
+				txData,
+			)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			err = unitNetwork.NextBlock()
+			suite.Require().NoError(err)
+		})
+	}
+}
@@ -36,6 +36,7 @@ type ConsumeGasKeepers struct {
 	Bank         anteutils.BankKeeper
 	Distribution anteutils.DistributionKeeper
 	Evm          EVMKeeper
+	Erc20        ERC20Keeper
 	Staking      anteutils.StakingKeeper
 }
 
@@ -46,7 +47,14 @@ func ConsumeFeesAndEmitEvent(
 	fees sdktypes.Coins,
 	from sdktypes.AccAddress,
 ) error {
-	if err := deductFees(
+	if payment, ok := evmtypes.FeePaymentFromContext(ctx); ok {
+		// the fees are converted into the fee token chosen by the sender
+		tokenFees, err := keepers.Erc20.DeductFees(ctx, payment, from, fees.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
+		}
+		fees = tokenFees
+	} else if err := deductFees(
 		ctx,
 		keepers,
 		fees,
@@ -7,6 +7,7 @@ import (
 	"helios-core/helios-chain/testutil/integration/evmos/grpc"
 	testkeyring "helios-core/helios-chain/testutil/integration/evmos/keyring"
 	"helios-core/helios-chain/testutil/integration/evmos/network"
+	evmtypes "helios-core/helios-chain/x/evm/types"
 )
 
 func (suite *EvmAnteTestSuite) TestUpdateCumulativeGasWanted() {
@@ -188,3 +189,81 @@ func (suite *EvmAnteTestSuite) TestConsumeGasAndEmitEvent() {
 		})
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestConsumeGasAndEmitEventWithFeeToken() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithOtherDenoms([]string{"ausdt"}),
+	)
+
+	// one EVM coin is worth 10^6 units of the fee token
+	payment := evmtypes.FeePayment{Denom: "ausdt", ConversionRate: math.LegacyNewDec(1_000_000)}
+	fees := sdktypes.Coins{sdktypes.NewCoin(unitNetwork.GetDenom(), math.NewInt(1e15))}
+	tokenFees := sdktypes.Coins{sdktypes.NewCoin(payment.Denom, math.NewInt(1_000))}
+
+	testCases := []struct {
+		name          string
+		expectedError string
+		getSender     func() sdktypes.AccAddress
+	}{
+		{
+			name: "success: the fees are deducted in the fee token",
+			getSender: func() sdktypes.AccAddress {
+				// Return sender prefunded with the fee token
+				return keyring.GetKey(0).AccAddr
+			},
+		},
+		{
+			name:          "fail: insufficient fee token balance, event is NOT emitted",
+			expectedError: "failed to deduct transaction costs from user balance",
+			getSender: func() sdktypes.AccAddress {
+				// Return unfunded account
+				index := keyring.AddKey()
+				return keyring.GetKey(index).AccAddr
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keepers := &evmante.ConsumeGasKeepers{
+				Bank:         unitNetwork.App.BankKeeper,
+				Distribution: unitNetwork.App.DistrKeeper,
+				Evm:          unitNetwork.App.EvmKeeper,
+				Erc20:        unitNetwork.App.Erc20Keeper,
+				Staking:      unitNetwork.App.StakingKeeper,
+			}
+			sender := tc.getSender()
+			ctx := evmtypes.ContextWithFeePayment(unitNetwork.GetContext(), payment)
+			prevBalance := unitNetwork.App.BankKeeper.GetAllBalances(ctx, sender)
+
+			// Function under test
+			err := evmante.ConsumeFeesAndEmitEvent(ctx, keepers, fees, sender)
+
+			if tc.expectedError != "" {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError)
+
+				events := ctx.EventManager().Events()
+				suite.Require().Zero(len(events))
+			} else {
+				suite.Require().NoError(err)
+
+				// only the fee token is deducted, the EVM denom balance is untouched
+				afterBalance := unitNetwork.App.BankKeeper.GetAllBalances(ctx, sender)
+				suite.Require().True(prevBalance.Sub(tokenFees...).Equal(afterBalance))
+
+				expectedEvent := sdktypes.NewEvent(
+					sdktypes.EventTypeTx,
+					sdktypes.NewAttribute(sdktypes.AttributeKeyFee, tokenFees.String()),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expectedEvent)
+			}
+
+			// Reset the context
+			err = unitNetwork.NextBlock()
+			suite.Require().NoError(err)
+		})
+	}
+}
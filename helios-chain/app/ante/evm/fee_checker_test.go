@@ -57,9 +57,9 @@ func TestSDKTxFeeChecker(t *testing.T) {
 	baseDenom := evmtypes.GetEVMCoinDenom()
 	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoin(baseDenom, math.NewInt(10)))
 
-	genesisCtx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
-	checkTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, log.NewNopLogger()).WithMinGasPrices(minGasPrices)
-	deliverTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+	genesisCtx := sdk.NewContext(nil, nil, tmproto.Header{}, false, log.NewNopLogger())
+	checkTxCtx := sdk.NewContext(nil, nil, tmproto.Header{Height: 1}, true, log.NewNopLogger()).WithMinGasPrices(minGasPrices)
+	deliverTxCtx := sdk.NewContext(nil, nil, tmproto.Header{Height: 1}, false, log.NewNopLogger())
 
 	testCases := []struct {
 		name          string
@@ -33,6 +33,13 @@ type EVMKeeper interface { //nolint: revive
 	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
 }
 
+// ERC20Keeper defines the expected keeper interface used on the AnteHandler to pay
+// the fees of the Ethereum transactions with fee tokens
+type ERC20Keeper interface {
+	GetFeePayment(ctx sdk.Context, payer sdk.AccAddress) (evmtypes.FeePayment, bool)
+	DeductFees(ctx sdk.Context, payment evmtypes.FeePayment, payer sdk.AccAddress, fees *big.Int) (sdk.Coins, error)
+}
+
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
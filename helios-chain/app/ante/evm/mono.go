@@ -29,6 +29,7 @@ type MonoDecorator struct {
 	bankKeeper         evmtypes.BankKeeper
 	feeMarketKeeper    FeeMarketKeeper
 	evmKeeper          EVMKeeper
+	erc20Keeper        ERC20Keeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
 	maxGasWanted       uint64
@@ -54,6 +55,7 @@ func NewMonoDecorator(
 	bankKeeper evmtypes.BankKeeper,
 	feeMarketKeeper FeeMarketKeeper,
 	evmKeeper EVMKeeper,
+	erc20Keeper ERC20Keeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
 	maxGasWanted uint64,
@@ -63,6 +65,7 @@ func NewMonoDecorator(
 		bankKeeper:         bankKeeper,
 		feeMarketKeeper:    feeMarketKeeper,
 		evmKeeper:          evmKeeper,
+		erc20Keeper:        erc20Keeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
 		maxGasWanted:       maxGasWanted,
@@ -213,6 +216,12 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		from := ethMsg.GetFrom()
 		fromAddr := common.BytesToAddress(from)
 
+		// The sender may have chosen to pay its fees with a fee token. The payment is
+		// recorded on the context so that the refund of the unused gas and the fee
+		// distribution are made with the same token.
+		payment, _ := md.erc20Keeper.GetFeePayment(ctx, from)
+		ctx = evmtypes.ContextWithFeePayment(ctx, payment)
+
 		// 6. account balance verification
 		// We get the account with the balance from the EVM keeper because it is
 		// using a wrapper of the bank keeper as a dependency to scale all
@@ -272,6 +281,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				Bank:         md.bankKeeper,
 				Distribution: md.distributionKeeper,
 				Evm:          md.evmKeeper,
+				Erc20:        md.erc20Keeper,
 				Staking:      md.stakingKeeper,
 			},
 			msgFees,
@@ -105,6 +105,21 @@ func TestValidateHandlerOptions(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"fail - empty erc20 keeper",
+			ante.HandlerOptions{
+				Cdc:                nw.App.AppCodec(),
+				AccountKeeper:      nw.App.AccountKeeper,
+				BankKeeper:         nw.App.BankKeeper,
+				DistributionKeeper: nw.App.DistrKeeper,
+				IBCKeeper:          nw.App.IBCKeeper,
+				StakingKeeper:      nw.App.StakingKeeper,
+				FeeMarketKeeper:    nw.App.FeeMarketKeeper,
+				EvmKeeper:          nw.App.EvmKeeper,
+				Erc20Keeper:        nil,
+			},
+			false,
+		},
 		{
 			"fail - empty signature gas consumer",
 			ante.HandlerOptions{
@@ -116,6 +131,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				StakingKeeper:      nw.App.StakingKeeper,
 				FeeMarketKeeper:    nw.App.FeeMarketKeeper,
 				EvmKeeper:          nw.App.EvmKeeper,
+				Erc20Keeper:        nw.App.Erc20Keeper,
 				SigGasConsumer:     nil,
 			},
 			false,
@@ -131,6 +147,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				StakingKeeper:      nw.App.StakingKeeper,
 				FeeMarketKeeper:    nw.App.FeeMarketKeeper,
 				EvmKeeper:          nw.App.EvmKeeper,
+				Erc20Keeper:        nw.App.Erc20Keeper,
 				SigGasConsumer:     ante.SigVerificationGasConsumer,
 				SignModeHandler:    nil,
 			},
@@ -147,6 +164,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				StakingKeeper:      nw.App.StakingKeeper,
 				FeeMarketKeeper:    nw.App.FeeMarketKeeper,
 				EvmKeeper:          nw.App.EvmKeeper,
+				Erc20Keeper:        nw.App.Erc20Keeper,
 				SigGasConsumer:     ante.SigVerificationGasConsumer,
 				SignModeHandler:    nw.App.GetTxConfig().SignModeHandler(),
 				TxFeeChecker:       nil,
@@ -162,6 +180,7 @@ func TestValidateHandlerOptions(t *testing.T) {
 				DistributionKeeper:     nw.App.DistrKeeper,
 				ExtensionOptionChecker: types.HasDynamicFeeExtensionOption,
 				EvmKeeper:              nw.App.EvmKeeper,
+				Erc20Keeper:            nw.App.Erc20Keeper,
 				StakingKeeper:          nw.App.StakingKeeper,
 				FeegrantKeeper:         nw.App.FeeGrantKeeper,
 				IBCKeeper:              nw.App.IBCKeeper,
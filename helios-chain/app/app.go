@@ -1,6 +1,8 @@
 package app
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"strings"
 
@@ -169,6 +171,8 @@ import (
 	inflationtypes "helios-core/helios-chain/x/inflation/v1/types"
 
 	srvflags "helios-core/helios-chain/server/flags"
+	memiavlstore "helios-core/helios-chain/store"
+	"helios-core/helios-chain/versiondb"
 
 	chronos "helios-core/helios-chain/x/chronos"
 	epochs "helios-core/helios-chain/x/epochs"
@@ -208,8 +212,8 @@ import (
 	_ "helios-core/helios-chain/x/evm/core/tracers/js"
 	_ "helios-core/helios-chain/x/evm/core/tracers/native"
 
-	heliosserver "helios-core/helios-chain/server"     // Added import for server
 	svrconfig "helios-core/helios-chain/server/config" // Added import for server config
+	"helios-core/helios-chain/server/notifier"
 
 	heliosversion "helios-core/version"
 )
@@ -293,6 +297,7 @@ var (
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 		ratelimittypes.ModuleName:      nil,
+		revenuetypes.ModuleName:        nil,
 		// feemarkettypes.ModuleName:      nil,
 	}
 
@@ -319,6 +324,12 @@ type HeliosApp struct {
 	tKeys   map[string]*storetypes.TransientStoreKey
 	memKeys map[string]*storetypes.MemoryStoreKey
 
+	// qms is the versiondb query multistore, only set when versiondb is enabled
+	qms *versiondb.MultiStore
+
+	// streamingManager is the streaming manager set on the baseapp
+	streamingManager storetypes.StreamingManager
+
 	// cosmos keepers
 	AuthzKeeper           authzkeeper.Keeper
 	AccountKeeper         authkeeper.AccountKeeper
@@ -390,6 +401,9 @@ func NewHeliosApp(
 ) *HeliosApp {
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
+	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+	baseAppOptions = memiavlstore.SetupMemIAVL(logger, homePath, db, appOpts, baseAppOptions)
+
 	app := initHeliosApp(appName, logger, db, archiveDBs, traceStore, baseAppOptions...)
 
 	app.initKeepers(authority, appOpts)
@@ -423,6 +437,15 @@ func NewHeliosApp(
 		panic("failed to load state streaming: " + err.Error())
 	}
 
+	// wire up the versiondb's `StreamingService` and `MultiStore`.
+	if cast.ToBool(appOpts.Get(srvflags.VersionDBEnable)) {
+		qms, err := app.setupVersionDB(homePath)
+		if err != nil {
+			panic("failed to setup versiondb: " + err.Error())
+		}
+		app.qms = qms
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetPreBlocker(app.PreBlocker)
@@ -440,6 +463,7 @@ func NewHeliosApp(
 			BankKeeper:             app.BankKeeper,
 			ExtensionOptionChecker: evmostypes.HasDynamicFeeExtensionOption,
 			EvmKeeper:              app.EvmKeeper,
+			Erc20Keeper:            app.Erc20Keeper,
 			StakingKeeper:          app.StakingKeeper,
 			FeegrantKeeper:         app.FeeGrantKeeper,
 			DistributionKeeper:     app.DistrKeeper,
@@ -463,6 +487,15 @@ func NewHeliosApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		if app.qms != nil {
+			v1 := app.qms.LatestVersion()
+			v2 := app.LastBlockHeight()
+			if v1 > 0 && v1 != v2 {
+				// refuse to start rather than creating a gap in versiondb
+				tmos.Exit(fmt.Sprintf("versiondb latest version %d doesn't match iavl latest version %d", v1, v2))
+			}
+		}
 	}
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -491,8 +524,8 @@ func NewHeliosApp(
 			if appOpts.Get(srvflags.NotifierURL) != "" {
 				notifierURL = cast.ToString(appOpts.Get(srvflags.NotifierURL))
 			}
-			notifier := heliosserver.NewStartupNotifier(notifierURL, minimalClientCtx, logger, &srvCfg)
-			notifier.NotifyStartupAsync(moniker)
+			startupNotifier := notifier.NewStartupNotifier(notifierURL, minimalClientCtx, logger, &srvCfg)
+			startupNotifier.NotifyStartupAsync(moniker)
 		}
 	}
 
@@ -513,46 +546,10 @@ func initHeliosApp(
 		appCodec          = encodingConfig.Codec
 		legacyAmino       = encodingConfig.Amino
 		interfaceRegistry = encodingConfig.InterfaceRegistry
-
-		keys = storetypes.NewKVStoreKeys(
-			// SDK keys
-			authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
-			minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
-			govtypes.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey,
-			upgradetypes.StoreKey, evidencetypes.StoreKey, ibctransfertypes.StoreKey,
-			capabilitytypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey,
-			icahosttypes.StoreKey, ibcfeetypes.StoreKey, crisistypes.StoreKey,
-			consensustypes.StoreKey, packetforwardtypes.StoreKey,
-			// Helios keys
-			hyperiontypes.StoreKey,
-			logostypes.StoreKey,
-			tokenfactorytypes.StoreKey,
-			epochstypes.StoreKey,
-			inflationtypes.StoreKey,
-			// Add missing EVM-related keys
-			evmtypes.StoreKey,
-			feemarkettypes.StoreKey,
-			erc20types.StoreKey,
-			ratelimittypes.StoreKey,
-			chronostypes.StoreKey,
-			revenuetypes.StoreKey,
-		)
-
-		tKeys = storetypes.NewTransientStoreKeys(
-			paramstypes.TStoreKey,
-			banktypes.TStoreKey,
-			// Add missing EVM-related transient keys
-			evmtypes.TransientKey,
-			feemarkettypes.TransientKey,
-		)
-
-		memKeys = storetypes.NewMemoryStoreKeys(
-			capabilitytypes.MemStoreKey,
-			// store historical status
-			hyperiontypes.MemStoreKey,
-		)
 	)
 
+	keys, tKeys, memKeys := StoreKeys()
+
 	bApp := baseapp.NewBaseApp(
 		name,
 		logger,
@@ -588,8 +585,69 @@ func initHeliosApp(
 	return app
 }
 
+// StoreKeys returns the application store keys, the KV store keys are also used
+// by the versiondb tooling to know which stores to stream.
+func StoreKeys() (
+	map[string]*storetypes.KVStoreKey,
+	map[string]*storetypes.TransientStoreKey,
+	map[string]*storetypes.MemoryStoreKey,
+) {
+	keys := storetypes.NewKVStoreKeys(
+		// SDK keys
+		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
+		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
+		govtypes.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey,
+		upgradetypes.StoreKey, evidencetypes.StoreKey, ibctransfertypes.StoreKey,
+		capabilitytypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey,
+		icahosttypes.StoreKey, ibcfeetypes.StoreKey, crisistypes.StoreKey,
+		consensustypes.StoreKey, packetforwardtypes.StoreKey,
+		// Helios keys
+		hyperiontypes.StoreKey,
+		logostypes.StoreKey,
+		tokenfactorytypes.StoreKey,
+		epochstypes.StoreKey,
+		inflationtypes.StoreKey,
+		// Add missing EVM-related keys
+		evmtypes.StoreKey,
+		feemarkettypes.StoreKey,
+		erc20types.StoreKey,
+		ratelimittypes.StoreKey,
+		chronostypes.StoreKey,
+		revenuetypes.StoreKey,
+	)
+
+	tKeys := storetypes.NewTransientStoreKeys(
+		paramstypes.TStoreKey,
+		banktypes.TStoreKey,
+		// Add missing EVM-related transient keys
+		evmtypes.TransientKey,
+		feemarkettypes.TransientKey,
+	)
+
+	memKeys := storetypes.NewMemoryStoreKeys(
+		capabilitytypes.MemStoreKey,
+		// store historical status
+		hyperiontypes.MemStoreKey,
+	)
+
+	return keys, tKeys, memKeys
+}
+
 func (app *HeliosApp) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
+// Close closes the BaseApp and the stores holding resources of their own, e.g. the
+// memiavl db and the versiondb.
+func (app *HeliosApp) Close() error {
+	err := app.BaseApp.Close()
+	if cms, ok := app.CommitMultiStore().(io.Closer); ok {
+		err = errors.Join(err, cms.Close())
+	}
+	if app.qms != nil {
+		err = errors.Join(err, app.qms.Close())
+	}
+	return err
+}
+
 func (app *HeliosApp) GetIBCKeeper() *ibckeeper.Keeper { return app.IBCKeeper }
 
 func (app *HeliosApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
@@ -795,6 +853,8 @@ func (app *HeliosApp) RegisterTxService(clientCtx client.Context) {
 
 func (app *HeliosApp) RegisterTendermintService(clientCtx client.Context) {
 	cmtservice.RegisterTendermintService(clientCtx, app.BaseApp.GRPCQueryRouter(), app.interfaceRegistry, app.Query)
+	// the chain stream replays the blocks before the live ones from the block results
+	app.ChainStreamServer.WithBlockResultsClient(clientCtx.Client)
 }
 
 func (app *HeliosApp) RegisterCometBftConfig(config *cmtcfg.Config) {
@@ -977,6 +1037,7 @@ func (app *HeliosApp) initKeepers(authority string, appOpts servertypes.AppOptio
 		app.AccountKeeper,
 		app.BankKeeper.(bankkeeper.BaseKeeper).WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
 		app.DistrKeeper,
+		&app.Erc20Keeper,
 		authority,
 	)
 
@@ -1116,6 +1177,9 @@ func (app *HeliosApp) initKeepers(authority string, appOpts servertypes.AppOptio
 	)
 	app.Erc20Keeper = erc20Keeper
 
+	// paused ERC20Creator tokens cannot be transferred, neither as bank coins nor as ERC20s
+	app.BankKeeper.AppendSendRestriction(app.Erc20Keeper.CreatorTokenSendRestriction)
+
 	// Create Transfer Keeper
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		app.codec, app.keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
@@ -1128,6 +1192,7 @@ func (app *HeliosApp) initKeepers(authority string, appOpts servertypes.AppOptio
 
 	// Now set the transfer keeper in ERC20 keeper
 	app.Erc20Keeper.SetTransferKeeper(&app.TransferKeeper)
+	app.Erc20Keeper.SetTokenFactoryKeeper(&app.TokenFactoryKeeper)
 
 	app.ChronosKeeper = *chronoskeeper.NewKeeper(
 		app.codec,
@@ -1182,8 +1247,9 @@ func (app *HeliosApp) initKeepers(authority string, appOpts servertypes.AppOptio
 
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
-		// insert epoch hooks receivers here
-		//app.InflationKeeper.Hooks(),
+			// insert epoch hooks receivers here
+			//app.InflationKeeper.Hooks(),
+			app.Erc20Keeper.Hooks(),
 		),
 	)
 
@@ -1224,11 +1290,17 @@ func (app *HeliosApp) initKeepers(authority string, appOpts servertypes.AppOptio
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
 			app.RevenueKeeper.Hooks(),
+			app.ChronosKeeper.Hooks(),
 		),
 	)
 
 	app.EvmKeeper.SetErc20Keeper(app.Erc20Keeper)
 
+	// the before send hooks of the tokenfactory denoms are EVM contracts consulted on every
+	// bank send of the denom, which includes the ERC20 transfers and the hyperion deposits
+	app.TokenFactoryKeeper.SetEVMKeeper(app.EvmKeeper)
+	app.BankKeeper.AppendSendRestriction(app.TokenFactoryKeeper.BeforeSendRestriction)
+
 	// Finally, set up the static precompiles
 	app.EvmKeeper = app.EvmKeeper.WithStaticPrecompiles(
 		evmkeeper.NewAvailableStaticPrecompiles(
@@ -1253,6 +1325,7 @@ func (app *HeliosApp) setPostHandler() {
 	options := post.HandlerOptions{
 		FeeCollectorName: authtypes.FeeCollectorName,
 		BankKeeper:       app.BankKeeper,
+		Erc20Keeper:      app.Erc20Keeper,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -15,22 +15,26 @@ import (
 
 var _ sdk.PostDecorator = &BurnDecorator{}
 
-// BurnDecorator is the decorator that burns all the transaction fees from Cosmos transactions.
+// BurnDecorator is the decorator that burns the transaction fees from Cosmos transactions. The
+// fees paid with a registered fee token, e.g. a bridged token, are left to the fee distribution
+// since burning them would break the supply backing them on their origin chain.
 type BurnDecorator struct {
 	feeCollectorName string
 	bankKeeper       bankkeeper.Keeper
+	erc20Keeper      ERC20Keeper
 }
 
 // NewBurnDecorator creates a new instance of the BurnDecorator.
-func NewBurnDecorator(feeCollector string, bankKeeper bankkeeper.Keeper) sdk.PostDecorator {
+func NewBurnDecorator(feeCollector string, bankKeeper bankkeeper.Keeper, erc20Keeper ERC20Keeper) sdk.PostDecorator {
 	return &BurnDecorator{
 		feeCollectorName: feeCollector,
 		bankKeeper:       bankKeeper,
+		erc20Keeper:      erc20Keeper,
 	}
 }
 
-// PostHandle burns all the transaction fees from Cosmos transactions. If an Ethereum transaction is present, this logic
-// is skipped.
+// PostHandle burns the transaction fees from Cosmos transactions, except the ones paid with a registered
+// fee token. If an Ethereum transaction is present, this logic is skipped.
 func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -55,6 +59,10 @@ func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success
 	// burn min(balance, fee)
 	var burnedCoins sdk.Coins
 	for _, fee := range fees {
+		if _, isFeeToken := bd.erc20Keeper.GetFeeToken(ctx, fee.Denom); isFeeToken {
+			continue
+		}
+
 		balance := bd.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(bd.feeCollectorName), fee.Denom)
 		if !balance.IsPositive() {
 			continue
@@ -65,6 +73,10 @@ func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success
 		burnedCoins = append(burnedCoins, sdk.Coin{Denom: fee.Denom, Amount: amount})
 	}
 
+	if burnedCoins.Empty() {
+		return next(ctx, tx, simulate, success)
+	}
+
 	// NOTE: since all Cosmos tx fees are pooled by the fee collector module account,
 	// we burn them directly from it
 	if err := bd.bankKeeper.BurnCoins(ctx, bd.feeCollectorName, burnedCoins); err != nil {
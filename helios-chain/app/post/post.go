@@ -5,12 +5,20 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	erc20types "helios-core/helios-chain/x/erc20/types"
 )
 
+// ERC20Keeper defines the expected keeper interface used on the PostHandler to tell
+// the fees paid with the registered fee tokens
+type ERC20Keeper interface {
+	GetFeeToken(ctx sdk.Context, denom string) (erc20types.FeeToken, bool)
+}
+
 // HandlerOptions are the options required for constructing a PostHandler.
 type HandlerOptions struct {
 	FeeCollectorName string
 	BankKeeper       bankkeeper.Keeper
+	Erc20Keeper      ERC20Keeper
 }
 
 func (h HandlerOptions) Validate() error {
@@ -22,13 +30,17 @@ func (h HandlerOptions) Validate() error {
 		return errors.New("bank keeper cannot be nil")
 	}
 
+	if h.Erc20Keeper == nil {
+		return errors.New("erc20 keeper cannot be nil")
+	}
+
 	return nil
 }
 
 // NewPostHandler returns a new PostHandler decorators chain.
 func NewPostHandler(ho HandlerOptions) sdk.PostHandler {
 	postDecorators := []sdk.PostDecorator{
-		NewBurnDecorator(ho.FeeCollectorName, ho.BankKeeper),
+		NewBurnDecorator(ho.FeeCollectorName, ho.BankKeeper, ho.Erc20Keeper),
 	}
 
 	return sdk.ChainPostDecorators(postDecorators...)
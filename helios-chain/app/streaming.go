@@ -0,0 +1,82 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/store/streaming"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// RegisterStreamingServices registers the ABCI listener plugins of the streaming config. Unlike
+// the baseapp, the listeners are appended to the streaming manager instead of replacing it, so
+// the app can add its own listeners, e.g. versiondb.
+func (app *HeliosApp) RegisterStreamingServices(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	streamingCfg := cast.ToStringMap(appOpts.Get(baseapp.StreamingTomlKey))
+	for service := range streamingCfg {
+		pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, service, baseapp.StreamingABCIPluginTomlKey)
+		pluginName := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))
+		if len(pluginName) == 0 {
+			continue
+		}
+
+		logLevel := cast.ToString(appOpts.Get(flags.FlagLogLevel))
+		plugin, err := streaming.NewStreamingPlugin(pluginName, logLevel)
+		if err != nil {
+			return fmt.Errorf("failed to load streaming plugin: %w", err)
+		}
+		listener, ok := plugin.(storetypes.ABCIListener)
+		if !ok {
+			return fmt.Errorf("failed to register streaming plugin: unexpected plugin type %T", plugin)
+		}
+
+		keysKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIKeysTomlKey)
+		app.CommitMultiStore().AddListeners(exposedStoreKeys(cast.ToStringSlice(appOpts.Get(keysKey)), keys))
+
+		stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIStopNodeOnErrTomlKey)
+		manager := app.StreamingManager()
+		manager.ABCIListeners = append(manager.ABCIListeners, listener)
+		manager.StopNodeOnErr = manager.StopNodeOnErr || cast.ToBool(appOpts.Get(stopNodeOnErrKey))
+		app.SetStreamingManager(manager)
+	}
+
+	return nil
+}
+
+// SetStreamingManager sets the streaming manager of the baseapp and keeps a copy of it, the
+// baseapp doesn't expose it.
+func (app *HeliosApp) SetStreamingManager(manager storetypes.StreamingManager) {
+	app.streamingManager = manager
+	app.BaseApp.SetStreamingManager(manager)
+}
+
+// StreamingManager returns the streaming manager of the app
+func (app *HeliosApp) StreamingManager() storetypes.StreamingManager {
+	return app.streamingManager
+}
+
+// exposedStoreKeys returns the store keys of the names sorted by name, all of them for "*"
+func exposedStoreKeys(names []string, keys map[string]*storetypes.KVStoreKey) []storetypes.StoreKey {
+	exposed := make([]storetypes.StoreKey, 0, len(keys))
+	for _, name := range names {
+		if name == "*" {
+			exposed = exposed[:0]
+			for _, key := range keys {
+				exposed = append(exposed, key)
+			}
+			break
+		}
+		if key, ok := keys[name]; ok {
+			exposed = append(exposed, key)
+		}
+	}
+	sort.SliceStable(exposed, func(i, j int) bool {
+		return exposed[i].Name() < exposed[j].Name()
+	})
+	return exposed
+}
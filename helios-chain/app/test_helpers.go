@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,12 +42,19 @@ var DefaultConsensusParams = &tmproto.ConsensusParams{
 	},
 }
 
-const defaultHomeDirForTest = "testrun"
+const (
+	defaultHomeDirForTest     = "testrun"
+	defaultTrustedHostForTest = "https://github.com/helios-network/helios-core/releases/download/"
+)
 
 // Setup initializes a new HeliosApp. A Nop logger is set in HeliosApp.
 func Setup(isCheckTx bool, appOpts ...simtestutil.AppOptionsMap) *HeliosApp {
 	sdk.DefaultBondDenom = "helios"
-	testAppOpts := simtestutil.AppOptionsMap{"trace": true}
+	testAppOpts := simtestutil.AppOptionsMap{
+		"trace": true,
+		// the upgrade pre blocker stops the node without trusted hosts
+		sdkserver.FlagUpgradeTrustHosts: defaultTrustedHostForTest,
+	}
 
 	for _, opts := range appOpts {
 		for k, v := range opts {
@@ -165,7 +173,11 @@ func Cleanup(app *HeliosApp) {
 // and be able to set the chainID for the tests properly
 func SetupTestingApp(chainID string) func() (ibctesting.TestingApp, map[string]json.RawMessage) {
 	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
-		testAppOpts := simtestutil.AppOptionsMap{"trace": true}
+		testAppOpts := simtestutil.AppOptionsMap{
+			"trace": true,
+			// the upgrade pre blocker stops the node without trusted hosts
+			sdkserver.FlagUpgradeTrustHosts: defaultTrustedHostForTest,
+		}
 		db := dbm.NewMemDB()
 		bridgeDB := dbm.NewMemDB()
 		chronosDB := dbm.NewMemDB()
@@ -11,6 +11,9 @@ import (
 // nolint:all
 const (
 	upgradeName = "v1.13.2"
+	// upgradeNameV1_14_0 runs the chronos params migration (consensus version 1 to 2)
+	// that fills the per-block cron gas budget and the per-owner execution limit
+	upgradeNameV1_14_0 = "v1.14.0"
 )
 
 func (app *HeliosApp) registerUpgradeHandlers() {
@@ -30,12 +33,18 @@ func (app *HeliosApp) registerUpgradeHandlers() {
 		},
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeNameV1_14_0,
+		func(ctx context.Context, upgradeInfo upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
 	}
 	// nolint:all
-	if upgradeInfo.Name == upgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+	if (upgradeInfo.Name == upgradeName || upgradeInfo.Name == upgradeNameV1_14_0) && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		// add any store upgrades here
 		storeUpgrades := storetypes.StoreUpgrades{
 			Added:   nil,
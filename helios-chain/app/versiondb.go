@@ -0,0 +1,55 @@
+//go:build rocksdb
+// +build rocksdb
+
+package app
+
+import (
+	"os"
+	"path/filepath"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"helios-core/helios-chain/versiondb"
+	"helios-core/helios-chain/versiondb/tsrocksdb"
+)
+
+// setupVersionDB opens the versiondb under the node data directory, streams the
+// state changes of every KV store into it, and switches the query multistore to it.
+func (app *HeliosApp) setupVersionDB(homePath string) (*versiondb.MultiStore, error) {
+	dataDir := filepath.Join(homePath, "data", "versiondb")
+	if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	versionDB, err := tsrocksdb.NewStore(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// always listen for all keys to simplify configuration
+	exposedKeys := make([]storetypes.StoreKey, 0, len(app.keys))
+	for _, key := range app.keys {
+		exposedKeys = append(exposedKeys, key)
+	}
+	app.CommitMultiStore().AddListeners(exposedKeys)
+
+	// versiondb listens next to the streaming plugins, the node is stopped on errors to
+	// avoid creating gaps in versiondb.
+	manager := app.StreamingManager()
+	manager.ABCIListeners = append(manager.ABCIListeners, versiondb.NewStreamingService(versionDB))
+	manager.StopNodeOnErr = true
+	app.SetStreamingManager(manager)
+
+	// the transient and memory stores are not persisted, they are served by the parent store
+	delegatedStoreKeys := make(map[storetypes.StoreKey]struct{}, len(app.tKeys)+len(app.memKeys))
+	for _, k := range app.tKeys {
+		delegatedStoreKeys[k] = struct{}{}
+	}
+	for _, k := range app.memKeys {
+		delegatedStoreKeys[k] = struct{}{}
+	}
+
+	verDB := versiondb.NewMultiStore(app.CommitMultiStore(), versionDB, app.keys, delegatedStoreKeys)
+	app.SetQueryMultiStore(verDB)
+	return verDB, nil
+}
@@ -0,0 +1,15 @@
+//go:build !rocksdb
+// +build !rocksdb
+
+package app
+
+import (
+	"errors"
+
+	"helios-core/helios-chain/versiondb"
+)
+
+// setupVersionDB fails for builds without rocksdb, versiondb is backed by rocksdb.
+func (app *HeliosApp) setupVersionDB(_ string) (*versiondb.MultiStore, error) {
+	return nil, errors.New("versiondb is not supported in this binary, rebuild with the rocksdb build tag")
+}
@@ -45,7 +45,9 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
-func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+// - Stores the logs of the block along with their address and topic indexes
+// - Records the number of cron transactions of the block from the block and tx events
+func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult, finalizeEvents []abci.Event) error {
 	height := block.Header.Height
 
 	batch := kv.db.NewBatch()
@@ -113,6 +115,19 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			}
 		}
 	}
+
+	// the block is left out of the log index if its logs can't be parsed, so that the
+	// log queries covering it fall back to the block results
+	logs, err := blockLogs(txResults)
+	if err != nil {
+		kv.logger.Error("Fail to parse logs", "err", err, "block", height)
+	} else if err := saveBlockLogs(batch, height, logs); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if err := saveBlockCrons(batch, height, blockCronTransactions(txResults, finalizeEvents)); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -157,7 +157,7 @@ func TestKVIndexer(t *testing.T) {
 			db := dbm.NewMemDB()
 			idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
 
-			err = idxer.IndexBlock(tc.block, tc.blockResult)
+			err = idxer.IndexBlock(tc.block, tc.blockResult, nil)
 			require.NoError(t, err)
 			if !tc.expSuccess {
 				first, err := idxer.FirstIndexedBlock()
@@ -0,0 +1,350 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpctypes "helios-core/helios-chain/rpc/types"
+	evmostypes "helios-core/helios-chain/types"
+	chronostypes "helios-core/helios-chain/x/chronos/types"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+const (
+	KeyPrefixBlockLogs  = 3
+	KeyPrefixLog        = 4
+	KeyPrefixLogAddress = 5
+	KeyPrefixLogTopic   = 6
+	KeyPrefixBlockCrons = 7
+
+	// LogPositionLength is the length of the (block number, log sequence) suffix of the log keys
+	LogPositionLength = 8 + 8
+)
+
+// blockLogs parses the logs emitted by the successful txs of a block, in the order of the
+// block results.
+func blockLogs(txResults []*abci.ExecTxResult) ([]*ethtypes.Log, error) {
+	var logs []*ethtypes.Log
+	for _, result := range txResults {
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+
+		txLogs, err := rpctypes.AllTxLogsFromEvents(result.Events)
+		if err != nil {
+			return nil, err
+		}
+		for _, msgLogs := range txLogs {
+			logs = append(logs, msgLogs...)
+		}
+	}
+	return logs, nil
+}
+
+// blockCronTransactions returns the number of cron transactions stored in a block, from
+// its finalize block events and the events of its successful txs (a cron cancelled by a
+// tx stores its result there). The blocks indexed before the cron transaction event
+// existed are counted from their cron executions.
+func blockCronTransactions(txResults []*abci.ExecTxResult, finalizeEvents []abci.Event) uint64 {
+	var count, executions uint64
+	countEvents := func(events []abci.Event) {
+		for _, event := range events {
+			switch event.Type {
+			case chronostypes.EventTypeCronTransaction:
+				count++
+			case chronostypes.EventTypeExecuteCron:
+				executions++
+			}
+		}
+	}
+
+	countEvents(finalizeEvents)
+	for _, result := range txResults {
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+		countEvents(result.Events)
+	}
+
+	if count == 0 {
+		return executions
+	}
+	return count
+}
+
+// saveBlockCrons records the number of cron transactions of a block, the blocks without
+// cron transactions are left out.
+func saveBlockCrons(batch dbm.Batch, height int64, count uint64) error {
+	if count == 0 {
+		return nil
+	}
+	if err := batch.Set(BlockCronsKey(height), sdk.Uint64ToBigEndian(count)); err != nil {
+		return errorsmod.Wrap(err, "set block-crons key")
+	}
+	return nil
+}
+
+// saveBlockLogs index the logs of a block into the kv db batch along with their address
+// and topics, and marks the block as indexed even if it has no logs.
+func saveBlockLogs(batch dbm.Batch, height int64, logs []*ethtypes.Log) error {
+	if err := batch.Set(BlockLogsKey(height), sdk.Uint64ToBigEndian(uint64(len(logs)))); err != nil {
+		return errorsmod.Wrap(err, "set block-logs key")
+	}
+
+	for i, log := range logs {
+		position := logPosition(height, uint64(i))
+
+		bz, err := evmtypes.NewLogFromEth(log).Marshal()
+		if err != nil {
+			return errorsmod.Wrap(err, "marshal log")
+		}
+		if err := batch.Set(LogKey(position), bz); err != nil {
+			return errorsmod.Wrap(err, "set log key")
+		}
+		if err := batch.Set(LogAddressKey(log.Address, position), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+		for j, topic := range log.Topics {
+			if err := batch.Set(LogTopicKey(j, topic, position), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	return nil
+}
+
+// GetLogs returns the logs of the blocks within [from, to] matching the addresses and the
+// topics. The positions of the matching logs are looked up in the address index when
+// addresses are given, or in the index of the first constrained topic otherwise, before
+// the logs are loaded and matched against the whole criteria.
+func (kv *KVIndexer) GetLogs(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]*ethtypes.Log, error) {
+	if from < 1 || to < from {
+		return []*ethtypes.Log{}, nil
+	}
+
+	indexed, err := kv.countIndexedBlocks(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if indexed != to-from+1 {
+		return nil, evmostypes.ErrLogsNotIndexed
+	}
+
+	var prefixes [][]byte
+	switch {
+	case len(addresses) > 0:
+		for _, address := range addresses {
+			prefixes = append(prefixes, LogAddressKey(address, nil))
+		}
+	default:
+		for i, sub := range topics {
+			if len(sub) == 0 {
+				continue
+			}
+			for _, topic := range sub {
+				prefixes = append(prefixes, LogTopicKey(i, topic, nil))
+			}
+			break
+		}
+	}
+
+	var positions [][]byte
+	if len(prefixes) == 0 {
+		positions, err = kv.scanPositions([]byte{KeyPrefixLog}, from, to)
+	} else {
+		positions, err = kv.lookupPositions(prefixes, from, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []*ethtypes.Log{}
+	for _, position := range positions {
+		bz, err := kv.db.Get(LogKey(position))
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+		var log evmtypes.Log
+		if err := log.Unmarshal(bz); err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogs")
+		}
+
+		ethLog := log.ToEthereum()
+		if !matchLog(ethLog, addresses, topics) {
+			continue
+		}
+		if limit > 0 && len(logs) == limit {
+			return nil, fmt.Errorf("query returned more than %d results", limit)
+		}
+		logs = append(logs, ethLog)
+	}
+	return logs, nil
+}
+
+// GetCronHeights returns the heights of the blocks within [from, to] with cron transactions,
+// it returns ErrLogsNotIndexed if the range is not fully indexed.
+func (kv *KVIndexer) GetCronHeights(from, to int64) ([]int64, error) {
+	if from < 1 || to < from {
+		return []int64{}, nil
+	}
+
+	indexed, err := kv.countIndexedBlocks(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if indexed != to-from+1 {
+		return nil, evmostypes.ErrLogsNotIndexed
+	}
+
+	it, err := kv.db.Iterator(BlockCronsKey(from), BlockCronsKey(to+1))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "GetCronHeights")
+	}
+	defer it.Close()
+
+	heights := []int64{}
+	for ; it.Valid(); it.Next() {
+		heights = append(heights, int64(sdk.BigEndianToUint64(it.Key()[1:]))) //nolint:gosec // G115
+	}
+	return heights, nil
+}
+
+// countIndexedBlocks returns the number of blocks within [from, to] whose logs are indexed
+func (kv *KVIndexer) countIndexedBlocks(from, to int64) (int64, error) {
+	it, err := kv.db.Iterator(BlockLogsKey(from), BlockLogsKey(to+1))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "countIndexedBlocks")
+	}
+	defer it.Close()
+
+	var count int64
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// scanPositions returns the positions of the logs within [from, to] found under the prefix,
+// the keys being suffixed by the log positions.
+func (kv *KVIndexer) scanPositions(prefix []byte, from, to int64) ([][]byte, error) {
+	start := append(bytes.Clone(prefix), sdk.Uint64ToBigEndian(uint64(from))...) //nolint:gosec // G115
+	end := append(bytes.Clone(prefix), sdk.Uint64ToBigEndian(uint64(to+1))...)   //nolint:gosec // G115
+
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "scanPositions")
+	}
+	defer it.Close()
+
+	var positions [][]byte
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		positions = append(positions, bytes.Clone(key[len(key)-LogPositionLength:]))
+	}
+	return positions, nil
+}
+
+// lookupPositions returns the sorted and deduplicated positions of the logs within
+// [from, to] found under any of the prefixes.
+func (kv *KVIndexer) lookupPositions(prefixes [][]byte, from, to int64) ([][]byte, error) {
+	seen := make(map[string]bool)
+	var positions [][]byte
+	for _, prefix := range prefixes {
+		found, err := kv.scanPositions(prefix, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, position := range found {
+			if seen[string(position)] {
+				continue
+			}
+			seen[string(position)] = true
+			positions = append(positions, position)
+		}
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		return bytes.Compare(positions[i], positions[j]) < 0
+	})
+	return positions, nil
+}
+
+// matchLog checks the log against the addresses and the positional topics, following the
+// semantics of the eth_getLogs filter criteria.
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if address == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		match := len(sub) == 0 // empty rule set == wildcard
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+// HasBlockLogs returns true if the logs of the block are indexed
+func (kv *KVIndexer) HasBlockLogs(height int64) (bool, error) {
+	return kv.db.Has(BlockLogsKey(height))
+}
+
+// BlockLogsKey returns the key for db entry: `block number -> logs count`
+func BlockLogsKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockLogs}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115
+}
+
+// BlockCronsKey returns the key for db entry: `block number -> cron transactions count`
+func BlockCronsKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockCrons}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115
+}
+
+// LogKey returns the key for db entry: `(block number, log sequence) -> log`
+func LogKey(position []byte) []byte {
+	return append([]byte{KeyPrefixLog}, position...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log sequence) -> nil`
+func LogAddressKey(address common.Address, position []byte) []byte {
+	return append(append([]byte{KeyPrefixLogAddress}, address.Bytes()...), position...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic index, topic, block number, log sequence) -> nil`
+func LogTopicKey(index int, topic common.Hash, position []byte) []byte {
+	return append(append([]byte{KeyPrefixLogTopic, byte(index)}, topic.Bytes()...), position...)
+}
+
+func logPosition(blockNumber int64, sequence uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(blockNumber)), sdk.Uint64ToBigEndian(sequence)...) //nolint:gosec // G115
+}
@@ -0,0 +1,157 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"helios-core/helios-chain/indexer"
+	evmostypes "helios-core/helios-chain/types"
+	chronostypes "helios-core/helios-chain/x/chronos/types"
+	"helios-core/helios-chain/x/evm/types"
+)
+
+func txLogEvent(t *testing.T, logs ...*ethtypes.Log) abci.Event {
+	event := abci.Event{Type: types.EventTypeTxLog}
+	for _, ethLog := range logs {
+		bz, err := json.Marshal(types.NewLogFromEth(ethLog))
+		require.NoError(t, err)
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)})
+	}
+	return event
+}
+
+func TestKVIndexerLogs(t *testing.T) {
+	token := common.HexToAddress("0x1000")
+	other := common.HexToAddress("0x2000")
+	transfer := common.HexToHash("0x01")
+	approval := common.HexToHash("0x02")
+	alice := common.HexToHash("0xa1")
+	bob := common.HexToHash("0xb0")
+
+	logs := []*ethtypes.Log{
+		{Address: token, Topics: []common.Hash{transfer, alice, bob}, BlockNumber: 1},
+		{Address: other, Topics: []common.Hash{transfer, bob, alice}, BlockNumber: 1},
+		{Address: token, Topics: []common.Hash{approval, alice}, BlockNumber: 3},
+		{Address: other, Topics: []common.Hash{approval}, BlockNumber: 3},
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	blockResults := map[int64][]*abci.ExecTxResult{
+		1: {
+			{Code: 0, Events: []abci.Event{txLogEvent(t, logs[0]), txLogEvent(t, logs[1])}},
+			// the logs of the failed txs are not indexed
+			{Code: 15, Events: []abci.Event{txLogEvent(t, logs[1])}},
+		},
+		2: {},
+		3: {{Code: 0, Events: []abci.Event{txLogEvent(t, logs[2], logs[3])}}},
+	}
+	for height := int64(1); height <= 3; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, blockResults[height], nil))
+	}
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expLogs   []*ethtypes.Log
+	}{
+		{"all logs", 1, 3, nil, nil, logs},
+		{"block range", 2, 3, nil, nil, logs[2:]},
+		{"empty block", 2, 2, nil, nil, []*ethtypes.Log{}},
+		{"by address", 1, 3, []common.Address{token}, nil, []*ethtypes.Log{logs[0], logs[2]}},
+		{"by addresses", 1, 1, []common.Address{token, other}, nil, logs[:2]},
+		{"by first topic", 1, 3, nil, [][]common.Hash{{approval}}, logs[2:]},
+		{"by second topic", 1, 3, nil, [][]common.Hash{nil, {alice}}, []*ethtypes.Log{logs[0], logs[2]}},
+		{"by topic alternatives", 1, 3, nil, [][]common.Hash{nil, {alice, bob}}, logs[:3]},
+		{"by address and topics", 1, 3, []common.Address{other}, [][]common.Hash{{transfer}, {bob}}, []*ethtypes.Log{logs[1]}},
+		{"more topics than the log", 3, 3, nil, [][]common.Hash{{approval}, nil}, []*ethtypes.Log{logs[2]}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, 0)
+			require.NoError(t, err)
+			require.Len(t, res, len(tc.expLogs))
+			for i, expLog := range tc.expLogs {
+				require.Equal(t, expLog.Address, res[i].Address)
+				require.Equal(t, expLog.Topics, res[i].Topics)
+				require.Equal(t, expLog.BlockNumber, res[i].BlockNumber)
+			}
+		})
+	}
+
+	_, err := idxer.GetLogs(1, 3, nil, nil, 3)
+	require.ErrorContains(t, err, "query returned more than 3 results")
+
+	_, err = idxer.GetLogs(1, 4, nil, nil, 0)
+	require.ErrorIs(t, err, evmostypes.ErrLogsNotIndexed)
+
+	indexed, err := idxer.HasBlockLogs(2)
+	require.NoError(t, err)
+	require.True(t, indexed)
+}
+
+func TestKVIndexerCronHeights(t *testing.T) {
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	cronEvent := abci.Event{Type: chronostypes.EventTypeExecuteCron}
+	finalizeEvents := map[int64][]abci.Event{
+		1: {cronEvent},
+		2: {{Type: "transfer"}},
+		4: {cronEvent, {Type: "transfer"}, cronEvent},
+	}
+	for height := int64(1); height <= 4; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{}, finalizeEvents[height]))
+	}
+
+	heights, err := idxer.GetCronHeights(1, 4)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 4}, heights)
+
+	heights, err = idxer.GetCronHeights(2, 3)
+	require.NoError(t, err)
+	require.Empty(t, heights)
+
+	_, err = idxer.GetCronHeights(3, 5)
+	require.ErrorIs(t, err, evmostypes.ErrLogsNotIndexed)
+}
+
+func TestKVIndexerCronHeightsCancellations(t *testing.T) {
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	cronTxEvent := abci.Event{Type: chronostypes.EventTypeCronTransaction}
+	blocks := map[int64]struct {
+		txResults      []*abci.ExecTxResult
+		finalizeEvents []abci.Event
+	}{
+		// a cron cancelled by the end blocker, without any execution
+		1: {finalizeEvents: []abci.Event{cronTxEvent}},
+		// a cron cancelled by a tx
+		2: {txResults: []*abci.ExecTxResult{{Code: abci.CodeTypeOK, Events: []abci.Event{cronTxEvent}}}},
+		// a failed tx stores nothing
+		3: {txResults: []*abci.ExecTxResult{{Code: 1, Events: []abci.Event{cronTxEvent}}}},
+	}
+	for height := int64(1); height <= 3; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, blocks[height].txResults, blocks[height].finalizeEvents))
+	}
+
+	heights, err := idxer.GetCronHeights(1, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, heights)
+}
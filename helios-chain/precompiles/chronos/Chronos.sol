@@ -7,6 +7,52 @@ address constant Chronos_PRECOMPILE_ADDRESS = 0x00000000000000000000000000000000
 /// @dev The ChronosI contract's instance.
 ChronosI constant CHRONOS_CONTRACT = ChronosI(Chronos_PRECOMPILE_ADDRESS);
 
+/// @dev Cron as returned by the chronos queries.
+struct CronInfo {
+    uint64 id;
+    address cronAddress;
+    address ownerAddress;
+    address contractAddress;
+    string methodName;
+    string[] params;
+    uint64 frequency;
+    uint64 nextExecutionBlock;
+    uint64 expirationBlock;
+    uint64 gasLimit;
+    uint256 maxGasPrice;
+    uint64 totalExecutedTransactions;
+    uint256 totalFeesPaid;
+    string cronType;
+    bool archived;
+    bool paused;
+    uint8 scheduleType;
+    string cronExpression;
+    uint64 intervalSeconds;
+    uint64 nextExecutionTime;
+}
+
+/// @dev Result of a cron transaction.
+struct CronTransactionResult {
+    uint64 nonce;
+    uint64 cronId;
+    address cronAddress;
+    bytes32 txHash;
+    uint64 blockNumber;
+    bytes32 blockHash;
+    bool success;
+    uint64 gasUsed;
+    bytes returnData;
+}
+
+/// @dev Statistics of the chronos module.
+struct CronStatistics {
+    uint64 cronCount;
+    uint64 queueCount;
+    uint64 archivedCrons;
+    uint64 refundedLastBlockCount;
+    uint64 executedLastBlockCount;
+}
+
 /// @author Helios Team
 /// @title Chronos Precompiled Contract
 /// @dev The interface through which solidity contracts can create cron tasks
@@ -17,6 +63,11 @@ interface ChronosI {
         uint64 scheduleId
     );
 
+    event CronDeposited(address indexed fromAddress, address indexed toAddress, uint64 cronId, uint256 amount);
+    event CronWithdrawn(address indexed fromAddress, address indexed toAddress, uint64 cronId, uint256 amount);
+    event CronPaused(address indexed fromAddress, address indexed toAddress, uint64 cronId);
+    event CronResumed(address indexed fromAddress, address indexed toAddress, uint64 cronId);
+
     function createCron(
         address contractAddress,
         string memory abi,
@@ -37,8 +88,84 @@ interface ChronosI {
         uint64 newMaxGasPrice
     ) external returns (bool success);
 
+    /// @dev Creates a cron executed on the block time, either on a standard 5 fields
+    /// cron expression (UTC) or every intervalSeconds. Exactly one of them must be set.
+    /// catchUpMode 0 skips the missed executions, 1 runs them once.
+    function createScheduledCron(
+        address contractAddress,
+        string memory abi,
+        string memory methodName,
+        string[] memory params,
+        string memory cronExpression,
+        uint64 intervalSeconds,
+        uint8 catchUpMode,
+        uint64 expirationBlock,
+        uint64 gasLimit,
+        uint256 maxGasPrice,
+        uint256 amountToDeposit
+    ) external returns (bool success);
+
+    function updateCronSchedule(
+        uint64 cronId,
+        string memory cronExpression,
+        uint64 intervalSeconds,
+        uint8 catchUpMode
+    ) external returns (bool success);
+
+    /// @dev Tops up the cron wallet from the caller (owner) balance.
+    function depositToCron(
+        uint64 cronId,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Sends back part of the cron wallet balance to the caller (owner).
+    function withdrawFromCron(
+        uint64 cronId,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Freezes the cron: it is neither executed nor charged until resumed.
+    function pauseCron(
+        uint64 cronId
+    ) external returns (bool success);
+
+    function resumeCron(
+        uint64 cronId
+    ) external returns (bool success);
+
+    /// @dev Creates a cron executed in the EndBlock of any block in which the emitter
+    /// emits a log matching the topics (a zero topic matches any value). The callback is
+    /// called as methodName(address emitter, bytes32[] topics, bytes data) with the first
+    /// matching log of the block.
+    function createEventTriggeredCron(
+        address contractAddress,
+        string memory methodName,
+        address emitter,
+        bytes32[] memory topics,
+        uint64 expirationBlock,
+        uint64 gasLimit,
+        uint256 maxGasPrice,
+        uint256 amountToDeposit
+    ) external returns (bool success);
+
     function cancelCron(
         uint64 cronId
     ) external returns (bool success);
 
+    /// @dev Returns the cron, active or archived.
+    function getCron(uint64 cronId) external view returns (CronInfo memory cron);
+
+    /// @dev Returns a page (starting at 1, at most 100 crons) of the crons of an owner.
+    function getCronsByOwner(
+        address owner,
+        uint64 page,
+        uint64 size
+    ) external view returns (CronInfo[] memory crons);
+
+    /// @dev Returns the balance of the cron wallet.
+    function getCronBalance(uint64 cronId) external view returns (uint256 balance);
+
+    function getCronTransactionResult(uint64 nonce) external view returns (CronTransactionResult memory result);
+
+    function getCronStatistics() external view returns (CronStatistics memory statistics);
 }
@@ -105,6 +105,31 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.CancelCron(ctx, evm.Origin, contract, stateDB, method, args)
 	case CreateCallbackConditionedCronMethod:
 		bz, err = p.CreateCallbackConditionedCron(ctx, evm.Origin, contract, stateDB, method, args)
+	case CreateScheduledCronMethod:
+		bz, err = p.CreateScheduledCron(ctx, evm.Origin, contract, stateDB, method, args)
+	case UpdateCronScheduleMethod:
+		bz, err = p.UpdateCronSchedule(ctx, evm.Origin, contract, stateDB, method, args)
+	case DepositToCronMethod:
+		bz, err = p.DepositToCron(ctx, evm.Origin, contract, stateDB, method, args)
+	case WithdrawFromCronMethod:
+		bz, err = p.WithdrawFromCron(ctx, evm.Origin, contract, stateDB, method, args)
+	case PauseCronMethod:
+		bz, err = p.PauseCron(ctx, evm.Origin, contract, stateDB, method, args)
+	case ResumeCronMethod:
+		bz, err = p.ResumeCron(ctx, evm.Origin, contract, stateDB, method, args)
+	case CreateEventTriggeredCronMethod:
+		bz, err = p.CreateEventTriggeredCron(ctx, evm.Origin, contract, stateDB, method, args)
+	// chronos queries
+	case GetCronMethod:
+		bz, err = p.GetCron(ctx, contract, method, args)
+	case GetCronsByOwnerMethod:
+		bz, err = p.GetCronsByOwner(ctx, contract, method, args)
+	case GetCronBalanceMethod:
+		bz, err = p.GetCronBalance(ctx, contract, method, args)
+	case GetCronTransactionResultMethod:
+		bz, err = p.GetCronTransactionResult(ctx, contract, method, args)
+	case GetCronStatisticsMethod:
+		bz, err = p.GetCronStatistics(ctx, contract, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -136,6 +161,20 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		return true
 	case CreateCallbackConditionedCronMethod:
 		return true
+	case CreateScheduledCronMethod:
+		return true
+	case UpdateCronScheduleMethod:
+		return true
+	case DepositToCronMethod:
+		return true
+	case WithdrawFromCronMethod:
+		return true
+	case PauseCronMethod:
+		return true
+	case ResumeCronMethod:
+		return true
+	case CreateEventTriggeredCronMethod:
+		return true
 	default:
 		return false
 	}
@@ -1,6 +1,8 @@
 package chronos
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"helios-core/helios-chain/x/evm/core/vm"
@@ -14,8 +16,12 @@ import (
 
 const (
 	EventTypeCronCreated   = "CronCreated"
-	EventTypeCronUpdated   = "CronUpdated"
+	EventTypeCronUpdated   = "CronModified"
 	EventTypeCronCancelled = "CronCancelled"
+	EventTypeCronDeposited = "CronDeposited"
+	EventTypeCronWithdrawn = "CronWithdrawn"
+	EventTypeCronPaused    = "CronPaused"
+	EventTypeCronResumed   = "CronResumed"
 )
 
 func (p Precompile) EmitCronCreatedEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, cronId uint64) error {
@@ -122,3 +128,53 @@ func (p Precompile) EmitCronCanceledEvent(ctx sdk.Context, stateDB vm.StateDB, f
 
 	return nil
 }
+
+func (p Precompile) EmitCronDepositedEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, cronId uint64, amount *big.Int) error {
+	return p.emitCronEvent(ctx, stateDB, EventTypeCronDeposited, from, to, cronId, amount)
+}
+
+func (p Precompile) EmitCronWithdrawnEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, cronId uint64, amount *big.Int) error {
+	return p.emitCronEvent(ctx, stateDB, EventTypeCronWithdrawn, from, to, cronId, amount)
+}
+
+func (p Precompile) EmitCronPausedEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, cronId uint64) error {
+	return p.emitCronEvent(ctx, stateDB, EventTypeCronPaused, from, to, cronId)
+}
+
+func (p Precompile) EmitCronResumedEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, cronId uint64) error {
+	return p.emitCronEvent(ctx, stateDB, EventTypeCronResumed, from, to, cronId)
+}
+
+// emitCronEvent emits an event indexed by (from, to) with the given non indexed values.
+func (p Precompile) emitCronEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, from, to common.Address, values ...interface{}) error {
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(from) // index 1
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(to) // index 2
+	if err != nil {
+		return err
+	}
+
+	packed, err := event.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -22,6 +22,13 @@ const (
 	UpdateCronMethod                    = "updateCron"
 	CancelCronMethod                    = "cancelCron"
 	CreateCallbackConditionedCronMethod = "createCallbackConditionedCron"
+	CreateScheduledCronMethod           = "createScheduledCron"
+	UpdateCronScheduleMethod            = "updateCronSchedule"
+	DepositToCronMethod                 = "depositToCron"
+	WithdrawFromCronMethod              = "withdrawFromCron"
+	PauseCronMethod                     = "pauseCron"
+	ResumeCronMethod                    = "resumeCron"
+	CreateEventTriggeredCronMethod      = "createEventTriggeredCron"
 )
 
 func (p Precompile) CreateCron(
@@ -354,3 +361,464 @@ func (p Precompile) CreateCallbackConditionedCron(
 
 	return method.Outputs.Pack(true)
 }
+
+func (p Precompile) CreateScheduledCron(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	if len(args) != 11 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 11, len(args))
+	}
+
+	contractAddress, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex address")
+	}
+
+	abiStr, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid string for abi")
+	}
+	abiContract, err := abi.JSON(strings.NewReader(abiStr))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ABI JSON")
+	}
+
+	methodName, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid string for methodName")
+	}
+	methodABI, exists := abiContract.Methods[methodName]
+	if !exists {
+		return nil, fmt.Errorf("method %s does not exist in ABI", methodName)
+	}
+
+	params, ok := args[3].([]string)
+	if !ok {
+		return nil, fmt.Errorf("invalid []string for params")
+	}
+	if len(params) != len(methodABI.Inputs) {
+		return nil, fmt.Errorf("invalid number of params: expected %d, got %d", len(methodABI.Inputs), len(params))
+	}
+
+	schedule, err := parseSchedule(args[4], args[5], args[6])
+	if err != nil {
+		return nil, err
+	}
+
+	expirationBlock, ok := args[7].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for ExpirationBlock")
+	}
+
+	gasLimit, ok := args[8].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for GasLimit")
+	}
+	if gasLimit == 0 {
+		return nil, fmt.Errorf("invalid zero GasLimit")
+	}
+
+	maxGasPrice, ok := args[9].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint256 for MaxGasPrice")
+	}
+	if maxGasPrice.Cmp(big.NewInt(0)) <= 0 {
+		return nil, fmt.Errorf("invalid zero MaxGasPrice")
+	}
+
+	amountToDeposit, ok := args[10].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint256 for AmountToDeposit")
+	}
+	if amountToDeposit.Cmp(big.NewInt(0)) <= 0 {
+		return nil, fmt.Errorf("invalid zero AmountToDeposit")
+	}
+
+	maxGasPriceV := cosmosmath.NewIntFromBigInt(maxGasPrice)
+	amountToDepositV := cosmosmath.NewIntFromBigInt(amountToDeposit)
+
+	msg := &chronostypes.MsgCreateCron{
+		OwnerAddress:    cmn.AccAddressFromHexAddress(origin).String(),
+		ContractAddress: contractAddress.String(),
+		AbiJson:         abiStr,
+		MethodName:      methodName,
+		Params:          params,
+		ExpirationBlock: expirationBlock,
+		GasLimit:        gasLimit,
+		MaxGasPrice:     &maxGasPriceV,
+		Sender:          cmn.AccAddressFromHexAddress(origin).String(),
+		AmountToDeposit: &amountToDepositV,
+		Schedule:        schedule,
+	}
+
+	msgSrv := chronoskeeper.NewMsgServerImpl(p.chronosKeeper)
+	resp, err := msgSrv.CreateCron(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCronCreatedEvent(ctx, stateDB, origin, p.Address(), resp.CronId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) UpdateCronSchedule(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	cronId, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for cronId")
+	}
+	cron, found := p.chronosKeeper.GetCron(ctx, cronId)
+	if !found {
+		return nil, fmt.Errorf("invalid cron doesn't exists")
+	}
+
+	schedule, err := parseSchedule(args[1], args[2], args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	// only the schedule changes, the other settings of the cron are kept
+	msg := &chronostypes.MsgUpdateCron{
+		OwnerAddress:       cmn.AccAddressFromHexAddress(origin).String(),
+		CronId:             cronId,
+		NewFrequency:       cron.Frequency,
+		NewParams:          cron.Params,
+		NewExpirationBlock: cron.ExpirationBlock,
+		NewGasLimit:        cron.GasLimit,
+		NewMaxGasPrice:     cron.MaxGasPrice,
+		Sender:             cmn.AccAddressFromHexAddress(origin).String(),
+		NewSchedule:        schedule,
+	}
+
+	msgSrv := chronoskeeper.NewMsgServerImpl(p.chronosKeeper)
+	resp, err := msgSrv.UpdateCron(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCronUpdatedEvent(ctx, stateDB, origin, p.Address(), cronId, resp.Success); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// parseSchedule builds a wall-clock schedule from the (cronExpression, intervalSeconds, catchUpMode)
+// arguments, exactly one of cronExpression and intervalSeconds must be set.
+func parseSchedule(cronExpressionArg, intervalSecondsArg, catchUpModeArg interface{}) (*chronostypes.Schedule, error) {
+	cronExpression, ok := cronExpressionArg.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid string for cronExpression")
+	}
+	intervalSeconds, ok := intervalSecondsArg.(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for intervalSeconds")
+	}
+	catchUpMode, ok := catchUpModeArg.(uint8)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint8 for catchUpMode")
+	}
+
+	schedule := &chronostypes.Schedule{
+		CatchUpMode: chronostypes.CatchUpMode(catchUpMode),
+	}
+	switch {
+	case cronExpression != "" && intervalSeconds != 0:
+		return nil, fmt.Errorf("only one of cronExpression and intervalSeconds can be set")
+	case cronExpression != "":
+		schedule.Type = chronostypes.ScheduleType_SCHEDULE_TYPE_CRON_EXPRESSION
+		schedule.CronExpression = cronExpression
+	case intervalSeconds != 0:
+		schedule.Type = chronostypes.ScheduleType_SCHEDULE_TYPE_INTERVAL
+		schedule.IntervalSeconds = intervalSeconds
+	default:
+		return nil, fmt.Errorf("one of cronExpression and intervalSeconds must be set")
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (p Precompile) DepositToCron(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	cronId, amount, err := parseCronIdAndAmount(args)
+	if err != nil {
+		return nil, err
+	}
+	amountV := cosmosmath.NewIntFromBigInt(amount)
+
+	msg := &chronostypes.MsgDepositToCron{
+		OwnerAddress: cmn.AccAddressFromHexAddress(origin).String(),
+		CronId:       cronId,
+		Amount:       &amountV,
+		Sender:       cmn.AccAddressFromHexAddress(origin).String(),
+	}
+
+	msgSrv := chronoskeeper.NewMsgServerImpl(p.chronosKeeper)
+	if _, err := msgSrv.DepositToCron(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCronDepositedEvent(ctx, stateDB, origin, p.Address(), cronId, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) WithdrawFromCron(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	cronId, amount, err := parseCronIdAndAmount(args)
+	if err != nil {
+		return nil, err
+	}
+	amountV := cosmosmath.NewIntFromBigInt(amount)
+
+	msg := &chronostypes.MsgWithdrawFromCron{
+		OwnerAddress: cmn.AccAddressFromHexAddress(origin).String(),
+		CronId:       cronId,
+		Amount:       &amountV,
+		Sender:       cmn.AccAddressFromHexAddress(origin).String(),
+	}
+
+	msgSrv := chronoskeeper.NewMsgServerImpl(p.chronosKeeper)
+	if _, err := msgSrv.WithdrawFromCron(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCronWithdrawnEvent(ctx, stateDB, origin, p.Address(), cronId, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) PauseCron(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	cronId, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for cronId")
+	}
+
+	msg := &chronostypes.MsgPauseCron{
+		OwnerAddress: cmn.AccAddressFromHexAddress(origin).String(),
+		CronId:       cronId,
+		Sender:       cmn.AccAddressFromHexAddress(origin).String(),
+	}
+
+	msgSrv := chronoskeeper.NewMsgServerImpl(p.chronosKeeper)
+	if _, err := msgSrv.PauseCron(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCronPausedEvent(ctx, stateDB, origin, p.Address(), cronId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func (p Precompile) ResumeCron(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	cronId, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for cronId")
+	}
+
+	msg := &chronostypes.MsgResumeCron{
+		OwnerAddress: cmn.AccAddressFromHexAddress(origin).String(),
+		CronId:       cronId,
+		Sender:       cmn.AccAddressFromHexAddress(origin).String(),
+	}
+
+	msgSrv := chronoskeeper.NewMsgServerImpl(p.chronosKeeper)
+	if _, err := msgSrv.ResumeCron(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCronResumedEvent(ctx, stateDB, origin, p.Address(), cronId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+func parseCronIdAndAmount(args []interface{}) (uint64, *big.Int, error) {
+	if len(args) != 2 {
+		return 0, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	cronId, ok := args[0].(uint64)
+	if !ok {
+		return 0, nil, fmt.Errorf("invalid uint64 for cronId")
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok {
+		return 0, nil, fmt.Errorf("invalid uint256 for amount")
+	}
+	if amount.Sign() <= 0 {
+		return 0, nil, fmt.Errorf("invalid zero amount")
+	}
+
+	return cronId, amount, nil
+}
+
+func (p Precompile) CreateEventTriggeredCron(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+
+	if len(args) != 8 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 8, len(args))
+	}
+
+	contractAddress, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex address")
+	}
+
+	methodName, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid string for methodName")
+	}
+
+	emitter, ok := args[2].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex address for emitter")
+	}
+
+	topics, ok := args[3].([][32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid bytes32[] for topics")
+	}
+
+	expirationBlock, ok := args[4].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for ExpirationBlock")
+	}
+
+	gasLimit, ok := args[5].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for GasLimit")
+	}
+	if gasLimit == 0 {
+		return nil, fmt.Errorf("invalid zero GasLimit")
+	}
+
+	maxGasPrice, ok := args[6].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint256 for MaxGasPrice")
+	}
+	if maxGasPrice.Cmp(big.NewInt(0)) <= 0 {
+		return nil, fmt.Errorf("invalid zero MaxGasPrice")
+	}
+
+	amountToDeposit, ok := args[7].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint256 for AmountToDeposit")
+	}
+	if amountToDeposit.Cmp(big.NewInt(0)) <= 0 {
+		return nil, fmt.Errorf("invalid zero AmountToDeposit")
+	}
+
+	// a zero topic matches any value at its position
+	filterTopics := make([]string, len(topics))
+	for i, topic := range topics {
+		if hash := common.Hash(topic); hash != (common.Hash{}) {
+			filterTopics[i] = hash.Hex()
+		}
+	}
+
+	maxGasPriceV := cosmosmath.NewIntFromBigInt(maxGasPrice)
+	amountToDepositV := cosmosmath.NewIntFromBigInt(amountToDeposit)
+
+	msg := &chronostypes.MsgCreateEventTriggeredCron{
+		OwnerAddress:    cmn.AccAddressFromHexAddress(origin).String(),
+		ContractAddress: contractAddress.String(),
+		MethodName:      methodName,
+		LogFilter: &chronostypes.LogFilter{
+			Address: emitter.String(),
+			Topics:  filterTopics,
+		},
+		ExpirationBlock: expirationBlock,
+		GasLimit:        gasLimit,
+		MaxGasPrice:     &maxGasPriceV,
+		Sender:          cmn.AccAddressFromHexAddress(origin).String(),
+		AmountToDeposit: &amountToDepositV,
+	}
+
+	msgSrv := chronoskeeper.NewMsgServerImpl(p.chronosKeeper)
+	resp, err := msgSrv.CreateEventTriggeredCron(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCronCreatedEvent(ctx, stateDB, origin, p.Address(), resp.CronId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
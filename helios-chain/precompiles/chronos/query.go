@@ -0,0 +1,172 @@
+package chronos
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "helios-core/helios-chain/precompiles/common"
+	chronostypes "helios-core/helios-chain/x/chronos/types"
+	"helios-core/helios-chain/x/evm/core/vm"
+)
+
+const (
+	// GetCronMethod defines the ABI method name for the cron query.
+	GetCronMethod = "getCron"
+	// GetCronsByOwnerMethod defines the ABI method name for the paginated crons of an owner.
+	GetCronsByOwnerMethod = "getCronsByOwner"
+	// GetCronBalanceMethod defines the ABI method name for the cron wallet balance query.
+	GetCronBalanceMethod = "getCronBalance"
+	// GetCronTransactionResultMethod defines the ABI method name for the cron transaction result query.
+	GetCronTransactionResultMethod = "getCronTransactionResult"
+	// GetCronStatisticsMethod defines the ABI method name for the chronos statistics query.
+	GetCronStatisticsMethod = "getCronStatistics"
+)
+
+// GetCron returns a cron, active or archived, by its ID.
+func (p Precompile) GetCron(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	cronId, err := parseCronId(args)
+	if err != nil {
+		return nil, err
+	}
+
+	cron, found := p.chronosKeeper.GetCronOrArchivedCron(ctx, cronId)
+	if !found {
+		return nil, fmt.Errorf("cron %d not found", cronId)
+	}
+
+	return method.Outputs.Pack(NewCronInfo(cron))
+}
+
+// GetCronsByOwner returns a page of the crons, active or archived, of an owner.
+func (p Precompile) GetCronsByOwner(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex address")
+	}
+	page, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for page")
+	}
+	size, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for size")
+	}
+	if page == 0 {
+		page = 1
+	}
+	if size == 0 || size > MaxCronsPageSize {
+		size = MaxCronsPageSize
+	}
+
+	res, err := p.chronosKeeper.QueryGetCronsByOwner(ctx, &chronostypes.QueryGetCronsByOwnerRequest{
+		OwnerAddress: owner.Hex(),
+		Pagination: &query.PageRequest{
+			Offset: (page - 1) * size,
+			Limit:  size,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	crons := make([]CronInfo, 0, len(res.Crons))
+	for _, cron := range res.Crons {
+		crons = append(crons, NewCronInfo(cron))
+	}
+
+	return method.Outputs.Pack(crons)
+}
+
+// GetCronBalance returns the balance of the cron wallet, zero for archived crons.
+func (p Precompile) GetCronBalance(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	cronId, err := parseCronId(args)
+	if err != nil {
+		return nil, err
+	}
+
+	cron, found := p.chronosKeeper.GetCronOrArchivedCron(ctx, cronId)
+	if !found {
+		return nil, fmt.Errorf("cron %d not found", cronId)
+	}
+
+	balance := big.NewInt(0)
+	if !cron.Archived {
+		balance = p.chronosKeeper.CronBalance(ctx, cron).BigInt()
+	}
+
+	return method.Outputs.Pack(balance)
+}
+
+// GetCronTransactionResult returns the result of the cron transaction with the given nonce.
+func (p Precompile) GetCronTransactionResult(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	nonce, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid uint64 for nonce")
+	}
+
+	result, found := p.chronosKeeper.GetCronTransactionResultByNonce(ctx, nonce)
+	if !found {
+		return nil, fmt.Errorf("cron transaction %d not found", nonce)
+	}
+
+	info, err := NewCronTransactionResultInfo(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(info)
+}
+
+// GetCronStatistics returns the chronos module statistics.
+func (p Precompile) GetCronStatistics(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := p.chronosKeeper.QueryGetCronStatistics(ctx, &chronostypes.QueryGetCronStatisticsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(CronStatisticsInfo{
+		CronCount:              res.Statistics.CronCount,
+		QueueCount:             res.Statistics.QueueCount,
+		ArchivedCrons:          res.Statistics.ArchivedCrons,
+		RefundedLastBlockCount: res.Statistics.RefundedLastBlockCount,
+		ExecutedLastBlockCount: res.Statistics.ExecutedLastBlockCount,
+	})
+}
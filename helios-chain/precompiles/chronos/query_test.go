@@ -0,0 +1,234 @@
+package chronos_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/archivekv"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/precompiles/chronos"
+	cmn "helios-core/helios-chain/precompiles/common"
+	chronoskeeper "helios-core/helios-chain/x/chronos/keeper"
+	chronostypes "helios-core/helios-chain/x/chronos/types"
+	"helios-core/helios-chain/x/evm/statedb"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+	hyperionkeeper "helios-core/helios-chain/x/hyperion/keeper"
+)
+
+// mockEVMKeeper serves the cron wallet balances read by the queries.
+type mockEVMKeeper struct {
+	chronostypes.EVMKeeper
+
+	balances map[common.Address]*big.Int
+}
+
+func (m mockEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	balance, ok := m.balances[addr]
+	if !ok {
+		balance = big.NewInt(0)
+	}
+	return &statedb.Account{Balance: new(big.Int).Set(balance)}
+}
+
+type querySuite struct {
+	ctx        sdk.Context
+	keeper     *chronoskeeper.Keeper
+	evmKeeper  mockEVMKeeper
+	precompile *chronos.Precompile
+}
+
+func setupQuerySuite(t *testing.T) *querySuite {
+	storeKey := storetypes.NewKVStoreKey(chronostypes.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(chronostypes.MemStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	archiveStores := map[string]storetypes.ArchiveKVStore{
+		storeKey.Name(): archivekv.NewStore(storeKey.Name(), dbm.NewMemDB()),
+	}
+	// above every testnet upgrade height so the current code paths are used
+	header := cmtproto.Header{Height: 300_000, Time: time.Unix(1_700_000_000, 0).UTC()}
+	ctx := sdk.NewContext(cms, archiveStores, header, false, log.NewNopLogger())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	evmKeeper := mockEVMKeeper{balances: make(map[common.Address]*big.Int)}
+	k := chronoskeeper.NewKeeper(cdc, storeKey, memKey, nil, evmKeeper, nil)
+	require.NoError(t, k.SetParams(ctx, chronostypes.DefaultParams()))
+
+	precompile, err := chronos.NewPrecompile(*k, authzkeeper.Keeper{}, hyperionkeeper.Keeper{})
+	require.NoError(t, err)
+
+	return &querySuite{ctx: ctx, keeper: k, evmKeeper: evmKeeper, precompile: precompile}
+}
+
+func (s *querySuite) addCron(owner sdk.AccAddress, balance int64) chronostypes.Cron {
+	id := s.keeper.StoreGetNextCronID(s.ctx)
+	maxGasPrice := sdkmath.NewInt(2_000_000_000)
+	totalFeesPaid := sdkmath.NewInt(42)
+	cron := chronostypes.Cron{
+		Id:                 id,
+		Address:            sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("cron_%d", id)))).String(),
+		OwnerAddress:       owner.String(),
+		ContractAddress:    common.BigToAddress(big.NewInt(0x1000)).Hex(),
+		MethodName:         "tick",
+		Params:             []string{},
+		Frequency:          10,
+		NextExecutionBlock: uint64(s.ctx.BlockHeight()) + 10,
+		GasLimit:           100_000,
+		MaxGasPrice:        &maxGasPrice,
+		TotalFeesPaid:      &totalFeesPaid,
+		CronType:           chronostypes.LEGACY_CRON,
+		QueueTimestamp:     -1,
+	}
+	s.keeper.AddCron(s.ctx, cron)
+	s.evmKeeper.balances[cmn.AnyToHexAddress(cron.Address)] = big.NewInt(balance)
+	return cron
+}
+
+func TestQueryMethods(t *testing.T) {
+	s := setupQuerySuite(t)
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("owner")))
+	other := sdk.AccAddress(crypto.AddressHash([]byte("other")))
+
+	active := s.addCron(owner, 1_000)
+	archived := s.addCron(owner, 0)
+	s.addCron(other, 5)
+	require.NoError(t, s.keeper.RemoveCron(s.ctx, archived.Id, owner))
+	archived.Archived = true
+
+	run := func(methodName string, args ...interface{}) ([]interface{}, error) {
+		method := s.precompile.ABI.Methods[methodName]
+		var (
+			bz  []byte
+			err error
+		)
+		switch methodName {
+		case chronos.GetCronMethod:
+			bz, err = s.precompile.GetCron(s.ctx, nil, &method, args)
+		case chronos.GetCronsByOwnerMethod:
+			bz, err = s.precompile.GetCronsByOwner(s.ctx, nil, &method, args)
+		case chronos.GetCronBalanceMethod:
+			bz, err = s.precompile.GetCronBalance(s.ctx, nil, &method, args)
+		case chronos.GetCronTransactionResultMethod:
+			bz, err = s.precompile.GetCronTransactionResult(s.ctx, nil, &method, args)
+		case chronos.GetCronStatisticsMethod:
+			bz, err = s.precompile.GetCronStatistics(s.ctx, nil, &method, args)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Unpack(bz)
+	}
+
+	t.Run("getCron", func(t *testing.T) {
+		for _, cron := range []chronostypes.Cron{active, archived} {
+			values, err := run(chronos.GetCronMethod, cron.Id)
+			require.NoError(t, err)
+			var out struct{ Cron chronos.CronInfo }
+			require.NoError(t, s.precompile.ABI.Methods[chronos.GetCronMethod].Outputs.Copy(&out, values))
+			require.Equal(t, chronos.NewCronInfo(cron), out.Cron)
+		}
+
+		_, err := run(chronos.GetCronMethod, uint64(99))
+		require.ErrorContains(t, err, "cron 99 not found")
+	})
+
+	t.Run("getCronsByOwner", func(t *testing.T) {
+		values, err := run(chronos.GetCronsByOwnerMethod, common.BytesToAddress(owner), uint64(1), uint64(10))
+		require.NoError(t, err)
+		var out struct{ Crons []chronos.CronInfo }
+		require.NoError(t, s.precompile.ABI.Methods[chronos.GetCronsByOwnerMethod].Outputs.Copy(&out, values))
+		require.Len(t, out.Crons, 2)
+		require.Equal(t, []uint64{active.Id, archived.Id}, []uint64{out.Crons[0].Id, out.Crons[1].Id})
+		require.True(t, out.Crons[1].Archived)
+
+		// pages of one cron
+		values, err = run(chronos.GetCronsByOwnerMethod, common.BytesToAddress(owner), uint64(2), uint64(1))
+		require.NoError(t, err)
+		require.NoError(t, s.precompile.ABI.Methods[chronos.GetCronsByOwnerMethod].Outputs.Copy(&out, values))
+		require.Len(t, out.Crons, 1)
+		require.Equal(t, archived.Id, out.Crons[0].Id)
+
+		values, err = run(chronos.GetCronsByOwnerMethod, common.BytesToAddress(owner), uint64(3), uint64(1))
+		require.NoError(t, err)
+		require.NoError(t, s.precompile.ABI.Methods[chronos.GetCronsByOwnerMethod].Outputs.Copy(&out, values))
+		require.Empty(t, out.Crons)
+	})
+
+	t.Run("getCronBalance", func(t *testing.T) {
+		values, err := run(chronos.GetCronBalanceMethod, active.Id)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(1_000), values[0])
+
+		// archived crons have no wallet
+		s.evmKeeper.balances[cmn.AnyToHexAddress(archived.Address)] = big.NewInt(7)
+		values, err = run(chronos.GetCronBalanceMethod, archived.Id)
+		require.NoError(t, err)
+		require.Zero(t, values[0].(*big.Int).Sign())
+	})
+
+	t.Run("getCronTransactionResult", func(t *testing.T) {
+		txRes := evmtypes.MsgEthereumTxResponse{GasUsed: 21_000, Ret: []byte{0x01}, VmError: "execution reverted"}
+		bz, err := txRes.Marshal()
+		require.NoError(t, err)
+		result := chronostypes.CronTransactionResult{
+			Result:      bz,
+			Nonce:       1,
+			BlockNumber: uint64(s.ctx.BlockHeight()),
+			BlockHash:   common.BytesToHash([]byte("block")).Hex(),
+			CronId:      active.Id,
+			CronAddress: cmn.AnyToHexAddress(active.Address).Hex(),
+			TxHash:      common.BytesToHash([]byte("tx")).Hex(),
+		}
+		s.keeper.StoreCronTransactionResult(s.ctx, active, result)
+
+		values, err := run(chronos.GetCronTransactionResultMethod, uint64(1))
+		require.NoError(t, err)
+		var out struct{ Result chronos.CronTransactionResultInfo }
+		require.NoError(t, s.precompile.ABI.Methods[chronos.GetCronTransactionResultMethod].Outputs.Copy(&out, values))
+		require.Equal(t, chronos.CronTransactionResultInfo{
+			Nonce:       1,
+			CronId:      active.Id,
+			CronAddress: cmn.AnyToHexAddress(active.Address),
+			TxHash:      common.BytesToHash([]byte("tx")),
+			BlockNumber: uint64(s.ctx.BlockHeight()),
+			BlockHash:   common.BytesToHash([]byte("block")),
+			Success:     false,
+			GasUsed:     21_000,
+			ReturnData:  []byte{0x01},
+		}, out.Result)
+
+		_, err = run(chronos.GetCronTransactionResultMethod, uint64(2))
+		require.ErrorContains(t, err, "cron transaction 2 not found")
+	})
+
+	t.Run("getCronStatistics", func(t *testing.T) {
+		values, err := run(chronos.GetCronStatisticsMethod)
+		require.NoError(t, err)
+		var out struct{ Statistics chronos.CronStatisticsInfo }
+		require.NoError(t, s.precompile.ABI.Methods[chronos.GetCronStatisticsMethod].Outputs.Copy(&out, values))
+		require.Equal(t, uint64(s.keeper.GetTotalCronCount(s.ctx)), out.Statistics.CronCount)
+		require.Equal(t, uint64(1), out.Statistics.ArchivedCrons)
+		require.Equal(t, uint64(0), out.Statistics.QueueCount)
+	})
+}
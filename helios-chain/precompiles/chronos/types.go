@@ -0,0 +1,136 @@
+package chronos
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "helios-core/helios-chain/precompiles/common"
+	chronostypes "helios-core/helios-chain/x/chronos/types"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+// MaxCronsPageSize bounds the number of crons returned by getCronsByOwner.
+const MaxCronsPageSize = 100
+
+// CronInfo is the ABI representation of a cron.
+type CronInfo struct {
+	Id                        uint64
+	CronAddress               common.Address
+	OwnerAddress              common.Address
+	ContractAddress           common.Address
+	MethodName                string
+	Params                    []string
+	Frequency                 uint64
+	NextExecutionBlock        uint64
+	ExpirationBlock           uint64
+	GasLimit                  uint64
+	MaxGasPrice               *big.Int
+	TotalExecutedTransactions uint64
+	TotalFeesPaid             *big.Int
+	CronType                  string
+	Archived                  bool
+	Paused                    bool
+	ScheduleType              uint8
+	CronExpression            string
+	IntervalSeconds           uint64
+	NextExecutionTime         uint64
+}
+
+// NewCronInfo converts a cron to its ABI representation.
+func NewCronInfo(cron chronostypes.Cron) CronInfo {
+	info := CronInfo{
+		Id:                        cron.Id,
+		CronAddress:               cmn.AnyToHexAddress(cron.Address),
+		OwnerAddress:              cmn.AnyToHexAddress(cron.OwnerAddress),
+		ContractAddress:           common.HexToAddress(cron.ContractAddress),
+		MethodName:                cron.MethodName,
+		Params:                    cron.Params,
+		Frequency:                 cron.Frequency,
+		NextExecutionBlock:        cron.NextExecutionBlock,
+		ExpirationBlock:           cron.ExpirationBlock,
+		GasLimit:                  cron.GasLimit,
+		MaxGasPrice:               big.NewInt(0),
+		TotalExecutedTransactions: cron.TotalExecutedTransactions,
+		TotalFeesPaid:             big.NewInt(0),
+		CronType:                  cron.CronType,
+		Archived:                  cron.Archived,
+		Paused:                    cron.Paused,
+	}
+	if info.Params == nil {
+		info.Params = []string{}
+	}
+	if cron.MaxGasPrice != nil {
+		info.MaxGasPrice = cron.MaxGasPrice.BigInt()
+	}
+	if cron.TotalFeesPaid != nil {
+		info.TotalFeesPaid = cron.TotalFeesPaid.BigInt()
+	}
+	if cron.Schedule.IsTimeBased() {
+		info.ScheduleType = uint8(cron.Schedule.Type)
+		info.CronExpression = cron.Schedule.CronExpression
+		info.IntervalSeconds = cron.Schedule.IntervalSeconds
+		info.NextExecutionTime = uint64(cron.NextExecutionTime) //nolint:gosec // G115
+	}
+	return info
+}
+
+// CronTransactionResultInfo is the ABI representation of a cron transaction result.
+type CronTransactionResultInfo struct {
+	Nonce       uint64
+	CronId      uint64
+	CronAddress common.Address
+	TxHash      common.Hash
+	BlockNumber uint64
+	BlockHash   common.Hash
+	Success     bool
+	GasUsed     uint64
+	ReturnData  []byte
+}
+
+// NewCronTransactionResultInfo converts a cron transaction result to its ABI representation.
+func NewCronTransactionResultInfo(result chronostypes.CronTransactionResult) (CronTransactionResultInfo, error) {
+	var res evmtypes.MsgEthereumTxResponse
+	if err := res.Unmarshal(result.Result); err != nil {
+		return CronTransactionResultInfo{}, fmt.Errorf("failed to unmarshal result of nonce %d", result.Nonce)
+	}
+
+	returnData := res.Ret
+	if returnData == nil {
+		returnData = []byte{}
+	}
+
+	return CronTransactionResultInfo{
+		Nonce:       result.Nonce,
+		CronId:      result.CronId,
+		CronAddress: common.HexToAddress(result.CronAddress),
+		TxHash:      common.HexToHash(result.TxHash),
+		BlockNumber: result.BlockNumber,
+		BlockHash:   common.HexToHash(result.BlockHash),
+		Success:     !res.Failed(),
+		GasUsed:     res.GasUsed,
+		ReturnData:  returnData,
+	}, nil
+}
+
+// CronStatisticsInfo is the ABI representation of the chronos statistics.
+type CronStatisticsInfo struct {
+	CronCount              uint64
+	QueueCount             uint64
+	ArchivedCrons          uint64
+	RefundedLastBlockCount uint64
+	ExecutedLastBlockCount uint64
+}
+
+func parseCronId(args []interface{}) (uint64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	cronId, ok := args[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf("invalid uint64 for cronId")
+	}
+	return cronId, nil
+}
@@ -0,0 +1,61 @@
+package chronos_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/precompiles/chronos"
+	chronostypes "helios-core/helios-chain/x/chronos/types"
+)
+
+func TestQueryOutputsPack(t *testing.T) {
+	contractABI, err := chronos.LoadABI()
+	require.NoError(t, err)
+
+	maxGasPrice := sdkmath.NewInt(2_000_000_000)
+	cron := chronostypes.Cron{
+		Id:              7,
+		Address:         "0x0000000000000000000000000000000000000007",
+		OwnerAddress:    "0x0000000000000000000000000000000000000001",
+		ContractAddress: "0x0000000000000000000000000000000000001000",
+		MethodName:      "tick",
+		Frequency:       10,
+		GasLimit:        100_000,
+		MaxGasPrice:     &maxGasPrice,
+		CronType:        chronostypes.LEGACY_CRON,
+		Paused:          true,
+		Schedule: &chronostypes.Schedule{
+			Type:           chronostypes.ScheduleType_SCHEDULE_TYPE_CRON_EXPRESSION,
+			CronExpression: "0 0 * * *",
+		},
+		NextExecutionTime: 1_700_006_400,
+	}
+	info := chronos.NewCronInfo(cron)
+
+	method := contractABI.Methods[chronos.GetCronMethod]
+	bz, err := method.Outputs.Pack(info)
+	require.NoError(t, err)
+
+	var out struct{ Cron chronos.CronInfo }
+	values, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.NoError(t, method.Outputs.Copy(&out, values))
+	require.Zero(t, out.Cron.TotalFeesPaid.Sign())
+	out.Cron.TotalFeesPaid = info.TotalFeesPaid
+	require.Equal(t, info, out.Cron)
+	require.Equal(t, uint8(chronostypes.ScheduleType_SCHEDULE_TYPE_CRON_EXPRESSION), out.Cron.ScheduleType)
+
+	_, err = contractABI.Methods[chronos.GetCronsByOwnerMethod].Outputs.Pack([]chronos.CronInfo{info})
+	require.NoError(t, err)
+
+	_, err = contractABI.Methods[chronos.GetCronTransactionResultMethod].Outputs.Pack(chronos.CronTransactionResultInfo{
+		ReturnData: []byte{},
+		Success:    true,
+	})
+	require.NoError(t, err)
+
+	_, err = contractABI.Methods[chronos.GetCronStatisticsMethod].Outputs.Pack(chronos.CronStatisticsInfo{CronCount: 1})
+	require.NoError(t, err)
+}
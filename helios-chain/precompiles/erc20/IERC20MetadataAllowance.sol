@@ -32,4 +32,18 @@ interface IERC20MetadataAllowance is IERC20Metadata {
         address spender,
         uint256 subtractedValue
     ) external returns (bool approved);
+
+    /** @dev Mints new tokens to the given address. Only available for tokenfactory
+      * denoms and restricted to the admin of the denom.
+      * @param to The address which will receive the minted tokens.
+      * @param amount The amount of tokens to mint.
+      * @return Boolean value to indicate if the mint was successful.
+    */
+    function mint(address to, uint256 amount) external returns (bool);
+
+    /** @dev Burns tokens of the caller. Only available for tokenfactory denoms.
+      * @param amount The amount of tokens to burn.
+      * @return Boolean value to indicate if the burn was successful.
+    */
+    function burn(uint256 amount) external returns (bool);
 }
@@ -13,6 +13,7 @@ import (
 	auth "helios-core/helios-chain/precompiles/authorization"
 	erc20types "helios-core/helios-chain/x/erc20/types"
 	transferkeeper "helios-core/helios-chain/x/ibc/transfer/keeper"
+	tokenfactorytypes "helios-core/helios-chain/x/tokenfactory/types"
 
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +35,8 @@ const (
 	GasTotalSupply       = 2_477
 	GasBalanceOf         = 2_851
 	GasAllowance         = 3_246
+	GasMint              = 50_000
+	GasBurn              = 50_000
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -50,6 +53,9 @@ type Precompile struct {
 	transferKeeper transferkeeper.Keeper
 	// BankKeeper is a public field so that the werc20 precompile can use it.
 	BankKeeper bankkeeper.Keeper
+	// tokenFactory is only set for tokenfactory denoms and serves the mint and
+	// burn methods.
+	tokenFactory tokenfactorytypes.MsgServer
 }
 
 // NewPrecompile creates a new ERC-20 Precompile instance as a
@@ -82,6 +88,13 @@ func NewPrecompile(
 	return p, nil
 }
 
+// WithTokenFactory enables the mint and burn methods of the precompile of a
+// tokenfactory denom, which are executed through the tokenfactory messages.
+func (p *Precompile) WithTokenFactory(msgServer tokenfactorytypes.MsgServer) *Precompile {
+	p.tokenFactory = msgServer
+	return p
+}
+
 // RequiredGas calculates the contract gas used for the
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	// NOTE: This check avoid panicking when trying to decode the method ID
@@ -110,6 +123,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasIncreaseAllowance
 	case auth.DecreaseAllowanceMethod:
 		return GasDecreaseAllowance
+	case MintMethod:
+		return GasMint
+	case BurnMethod:
+		return GasBurn
 	// ERC-20 queries
 	case NameMethod:
 		return GasName
@@ -170,7 +187,9 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		TransferFromMethod,
 		auth.ApproveMethod,
 		auth.IncreaseAllowanceMethod,
-		auth.DecreaseAllowanceMethod:
+		auth.DecreaseAllowanceMethod,
+		MintMethod,
+		BurnMethod:
 		return true
 	default:
 		return false
@@ -197,6 +216,11 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
 	case auth.DecreaseAllowanceMethod:
 		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	// tokenfactory transactions
+	case MintMethod:
+		bz, err = p.Mint(ctx, contract, stateDB, method, args)
+	case BurnMethod:
+		bz, err = p.Burn(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
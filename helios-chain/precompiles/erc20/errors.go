@@ -21,6 +21,7 @@ const (
 	ErrNoAllowanceForToken       = "allowance for token %s does not exist"
 	ErrSubtractMoreThanAllowance = "subtracted value cannot be greater than existing allowance for denom %s: %s > %s"
 	ErrCannotReceiveFunds        = "cannot receive funds, received: %s"
+	ErrNotTokenFactoryDenom      = "method %s is only available for tokenfactory denoms, got: %s"
 )
 
 var (
@@ -1,6 +1,7 @@
 package erc20
 
 import (
+	"fmt"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,6 +15,7 @@ import (
 	cmn "helios-core/helios-chain/precompiles/common"
 	"helios-core/helios-chain/x/evm/core/vm"
 	evmtypes "helios-core/helios-chain/x/evm/types"
+	tokenfactorytypes "helios-core/helios-chain/x/tokenfactory/types"
 )
 
 const (
@@ -23,6 +25,12 @@ const (
 	// TransferFromMethod defines the ABI method name for the ERC-20 transferFrom
 	// transaction.
 	TransferFromMethod = "transferFrom"
+	// MintMethod defines the ABI method name for the tokenfactory admin mint
+	// transaction.
+	MintMethod = "mint"
+	// BurnMethod defines the ABI method name for the tokenfactory burn
+	// transaction.
+	BurnMethod = "burn"
 )
 
 // SendMsgURL defines the authorization type for MsgSend
@@ -139,3 +147,79 @@ func (p *Precompile) transfer(
 
 	return method.Outputs.Pack(true)
 }
+
+// Mint mints new tokens of a tokenfactory denom to the destination address. The
+// caller must be the admin of the denom, the tokens are minted to the admin and
+// then sent to the destination address.
+func (p *Precompile) Mint(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if p.tokenFactory == nil {
+		return nil, fmt.Errorf(ErrNotTokenFactoryDenom, method.Name, p.tokenPair.Denom)
+	}
+
+	to, amount, err := ParseMintArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	minter := contract.CallerAddress
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
+
+	msg := &tokenfactorytypes.MsgMint{
+		Sender: sdk.AccAddress(minter.Bytes()).String(),
+		Amount: coins[0],
+	}
+	if _, err := p.tokenFactory.Mint(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if to != minter {
+		if err := p.BankKeeper.SendCoins(ctx, minter.Bytes(), to.Bytes(), coins); err != nil {
+			return nil, ConvertErrToERC20Error(err)
+		}
+	}
+
+	if err = p.EmitTransferEvent(ctx, stateDB, common.Address{}, to, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Burn burns tokens of a tokenfactory denom from the caller balance.
+func (p *Precompile) Burn(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if p.tokenFactory == nil {
+		return nil, fmt.Errorf(ErrNotTokenFactoryDenom, method.Name, p.tokenPair.Denom)
+	}
+
+	amount, err := ParseBurnArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	burner := contract.CallerAddress
+	msg := &tokenfactorytypes.MsgBurn{
+		Sender: sdk.AccAddress(burner.Bytes()).String(),
+		Amount: sdk.Coin{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)},
+	}
+	if _, err := p.tokenFactory.Burn(ctx, msg); err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	if err = p.EmitTransferEvent(ctx, stateDB, burner, common.Address{}, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -300,3 +300,102 @@ func (s *PrecompileTestSuite) TestParseBalanceOfArgs() {
 		})
 	}
 }
+
+//nolint:dupl // these tests are not duplicates
+func (s *PrecompileTestSuite) TestParseMintArgs() {
+	to := utiltx.GenerateAddress()
+	amount := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name: "pass - correct arguments",
+			args: []interface{}{
+				to,
+				amount,
+			},
+			expPass: true,
+		},
+		{
+			name: "fail - invalid to address",
+			args: []interface{}{
+				"invalid address",
+				amount,
+			},
+			errContains: "invalid to address",
+		},
+		{
+			name: "fail - invalid amount",
+			args: []interface{}{
+				to,
+				"invalid amount",
+			},
+			errContains: "invalid amount",
+		},
+		{
+			name: "fail - invalid number of arguments",
+			args: []interface{}{
+				1, 2, 3,
+			},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			to, amount, err := erc20.ParseMintArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error parsing the mint arguments")
+				s.Require().Equal(to, tc.args[0], "expected different to address")
+				s.Require().Equal(amount, tc.args[1], "expected different amount")
+			} else {
+				s.Require().Error(err, "expected an error parsing the mint arguments")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseBurnArgs() {
+	amount := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{amount},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid amount",
+			args:        []interface{}{"invalid amount"},
+			errContains: "invalid amount",
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{1, 2},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			amount, err := erc20.ParseBurnArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error parsing the burn arguments")
+				s.Require().Equal(amount, tc.args[0], "expected different amount")
+			} else {
+				s.Require().Error(err, "expected an error parsing the burn arguments")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+		})
+	}
+}
@@ -27,4 +27,92 @@ interface ERC20Creator {
         uint8 decimals,
         string memory logoBase64
     ) external returns (address tokenAddress);
+
+    /**
+     * @dev Creates a new ERC20 token from the admin template, the caller becomes the
+     * admin of the token and receives the initial supply.
+     * @param name The name of the ERC20 token.
+     * @param symbol The symbol of the ERC20 token.
+     * @param denom The denomimation of one unit of the ERC20 token.
+     * @param initialSupply The supply minted to the caller, it can be zero for mintable tokens.
+     * @param decimals The number of decimals of the ERC20 token.
+     * @param logoBase64 The logo in base64 png 200x200 optionnal "".
+     * @param supplyCap The maximum total supply of the token, zero for an uncapped supply.
+     * @param mintable Whether the admin can mint new tokens.
+     * @param burnable Whether the holders can burn their tokens.
+     * @param pausable Whether the admin can pause the transfers of the token.
+     * @return tokenAddress The address of the newly created ERC20 token.
+     */
+    function createToken(
+        string memory name,
+        string memory symbol,
+        string memory denom,
+        uint256 initialSupply,
+        uint8 decimals,
+        string memory logoBase64,
+        uint256 supplyCap,
+        bool mintable,
+        bool burnable,
+        bool pausable
+    ) external returns (address tokenAddress);
+
+    /**
+     * @dev Mints new tokens, only the admin of a mintable token can mint, up to its supply cap.
+     * @param token The address of the ERC20 token.
+     * @param to The recipient of the minted tokens.
+     * @param amount The amount of tokens to mint.
+     * @return success Whether the tokens were minted.
+     */
+    function mint(address token, address to, uint256 amount) external returns (bool success);
+
+    /**
+     * @dev Burns tokens of the caller, the token must be burnable.
+     * @param token The address of the ERC20 token.
+     * @param amount The amount of tokens to burn.
+     * @return success Whether the tokens were burnt.
+     */
+    function burn(address token, uint256 amount) external returns (bool success);
+
+    /**
+     * @dev Hands the admin role of the token over to a new admin.
+     * @param token The address of the ERC20 token.
+     * @param newAdmin The address of the new admin.
+     * @return success Whether the admin was changed.
+     */
+    function setAdmin(address token, address newAdmin) external returns (bool success);
+
+    /**
+     * @dev Renounces the admin role of the token for good.
+     * @param token The address of the ERC20 token.
+     * @return success Whether the admin role was renounced.
+     */
+    function renounceAdmin(address token) external returns (bool success);
+
+    /**
+     * @dev Pauses the transfers of a pausable token.
+     * @param token The address of the ERC20 token.
+     * @return success Whether the token was paused.
+     */
+    function pause(address token) external returns (bool success);
+
+    /**
+     * @dev Resumes the transfers of a paused token.
+     * @param token The address of the ERC20 token.
+     * @return success Whether the token was unpaused.
+     */
+    function unpause(address token) external returns (bool success);
+
+    /**
+     * @dev Returns the admin controls of a token created from the admin template.
+     * @param token The address of the ERC20 token.
+     */
+    function tokenInfo(address token) external view returns (
+        string memory denom,
+        address admin,
+        uint256 supplyCap,
+        bool mintable,
+        bool burnable,
+        bool pausable,
+        bool paused
+    );
 }
@@ -13,8 +13,19 @@
 //
 // Current address: 0x0000000000000000000000000000000000000806
 //
-// The precompile exposes a single method:
-//   - createErc20(string name, string symbol, uint256 totalSupply, uint8 decimals) returns (address)
+// The precompile exposes the following methods:
+//   - createErc20(string name, string symbol, string denom, uint256 totalSupply, uint8 decimals, string logoBase64) returns (address)
+//   - createToken(..., uint256 supplyCap, bool mintable, bool burnable, bool pausable) returns (address)
+//   - mint(address token, address to, uint256 amount) returns (bool)
+//   - burn(address token, uint256 amount) returns (bool)
+//   - setAdmin(address token, address newAdmin) returns (bool)
+//   - renounceAdmin(address token) returns (bool)
+//   - pause(address token) returns (bool)
+//   - unpause(address token) returns (bool)
+//   - tokenInfo(address token) returns (string, address, uint256, bool, bool, bool, bool)
+//
+// The admin controls of the tokens created with createToken are enforced by the erc20
+// module, see CreatorToken.
 package erc20creator
 
 import (
@@ -24,24 +35,15 @@ import (
 	"strings"
 
 	erc20keeper "helios-core/helios-chain/x/erc20/keeper"
-	"helios-core/helios-chain/x/erc20/types"
 	evmtypes "helios-core/helios-chain/x/evm/types"
 
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-
-	errorsmod "cosmossdk.io/errors"
-
 	cmn "helios-core/helios-chain/precompiles/common"
 	vm "helios-core/helios-chain/x/evm/core/vm"
 
 	logoskeeper "helios-core/helios-chain/x/logos/keeper"
 
-	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
-	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -55,6 +57,8 @@ const (
 	abiPath = "abi.json"
 
 	GasErc20Creator = 200_000
+	GasAdminAction  = 50_000
+	GasTokenInfo    = 5_000
 
 	// Basic validation constraints
 	MaxNameLength   = 128
@@ -95,162 +99,90 @@ func NewPrecompile(erc20Keeper erc20keeper.Keeper, bankKeeper bankkeeper.Keeper,
 	return p, nil
 }
 
-func (p Precompile) RequiredGas(_ []byte) uint64 {
-	return GasErc20Creator
-}
-
-func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
-
-	// Set up call context and arguments
-	ctx, stateDB, _, method, _, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
-	if err != nil {
-		return nil, fmt.Errorf("failed to run setup for ERC20 precompile: %w", err)
+// RequiredGas returns the static gas cost of the method.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
 	}
 
-	// Extract arguments in expected order: name (name), symbol, totalSupply, decimals
-	name, okName := args[0].(string)
-	symbol, okSymbol := args[1].(string)
-	denom, okDenom := args[2].(string)
-	supply, okSupply := args[3].(*big.Int)
-	decimals, okDecimals := args[4].(uint8)
-	logoBase64, okLogo := args[5].(string)
-
-	if !okName || !okSymbol || !okSupply || !okDecimals || !okDenom || !okLogo {
-		return nil, fmt.Errorf("invalid argument types")
-	}
-
-	logoHash := ""
-
-	if logoBase64 != "" {
-		logoHash, err = p.logosKeeper.StoreLogo(ctx, logoBase64)
-		if err != nil {
-			return nil, fmt.Errorf("failed to store logo: %w", err)
-		}
-	}
-
-	// Validate arguments
-	if err := p.validateArguments(name, symbol, denom, supply, decimals); err != nil {
-		return nil, err
-	}
-
-	// Ensure the evm.Origin is not the zero address (common check for authenticity)
-	if evm.Origin == (common.Address{}) {
-		return nil, fmt.Errorf("origin address is zero address")
+	method, err := p.Precompile.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
 	}
 
-	found := true
-	// Check if metadata already exists for this base denom permit to create ~100 000 same denoms maximum
-	_, found = p.bankKeeper.GetDenomMetaData(ctx, denom)
-	if found {
-		return nil, errorsmod.Wrap(
-			types.ErrInternalTokenPair,
-			"denom metadata already registered, choose a unique base denomination",
-		)
+	switch method.Name {
+	case CreateErc20Method, CreateTokenMethod:
+		return GasErc20Creator
+	case TokenInfoMethod:
+		return GasTokenInfo
+	default:
+		return GasAdminAction
 	}
+}
 
-	coinMetadata := banktypes.Metadata{
-		Description: fmt.Sprintf("Token %s created with ERC20Creator precompile", denom),
-		Base:        denom,
-		Name:        name,
-		Symbol:      symbol,
-		Decimals:    uint32(decimals),
-		Display:     symbol,
-		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    denom,
-				Exponent: uint32(0),
-			},
-			{
-				Denom:    symbol,
-				Exponent: uint32(decimals),
-			},
-		},
-		Logo: logoHash,
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	// Set up call context and arguments
+	ctx, stateDB, snapshot, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run setup for ERC20 precompile: %w", err)
 	}
 
-	// validate metadata
-	if err := coinMetadata.Validate(); err != nil {
-		return nil, fmt.Errorf("failed to deploy ERC20 contract: %w", err)
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// token creation
+	case CreateErc20Method:
+		bz, err = p.CreateErc20(ctx, evm.Origin, stateDB, method, args)
+	case CreateTokenMethod:
+		bz, err = p.CreateToken(ctx, contract.CallerAddress, stateDB, method, args)
+	// admin transactions
+	case MintMethod:
+		bz, err = p.Mint(ctx, contract.CallerAddress, method, args)
+	case BurnMethod:
+		bz, err = p.Burn(ctx, contract.CallerAddress, method, args)
+	case SetAdminMethod:
+		bz, err = p.SetAdmin(ctx, contract.CallerAddress, method, args)
+	case RenounceAdminMethod:
+		bz, err = p.RenounceAdmin(ctx, contract.CallerAddress, method, args)
+	case PauseMethod:
+		bz, err = p.SetPaused(ctx, contract.CallerAddress, method, args, true)
+	case UnpauseMethod:
+		bz, err = p.SetPaused(ctx, contract.CallerAddress, method, args, false)
+	// queries
+	case TokenInfoMethod:
+		bz, err = p.TokenInfo(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
 
-	// Deploy the ERC20 contract
-	contractAddr, err := p.erc20Keeper.DeployERC20Contract(ctx, coinMetadata)
 	if err != nil {
-		return nil, fmt.Errorf("failed to deploy ERC20 contract: %w", err)
-	}
-
-	// Mint tokens in the ERC20 contract
-	if err := p.erc20Keeper.MintERC20Tokens(ctx, contractAddr, evm.Origin, supply); err != nil {
-		return nil, fmt.Errorf("failed to mint ERC20 tokens: %w", err)
+		return nil, err
 	}
 
-	recipient := sdktypes.AccAddress(evm.Origin.Bytes())
-	coins := sdktypes.NewCoins(sdktypes.NewCoin(denom, sdkmath.NewIntFromBigInt(supply)))
+	cost := ctx.GasMeter().GasConsumed() - initialGas
 
-	// Mint native coins to the module account
-	if err := p.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-		return nil, fmt.Errorf("failed to mint coins on-chain: %w", err)
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
 	}
 
-	// Transfer minted coins to the recipient
-	if err := p.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
-		return nil, fmt.Errorf("failed to send minted coins to recipient: %w", err)
-	}
-
-	// Register the token pair for cross-chain usage
-	tokenPair := types.NewTokenPair(contractAddr, denom, types.OWNER_MODULE)
-	p.erc20Keeper.SetToken(ctx, tokenPair)
-
-	// Enable dynamic precompiles for the deployed ERC20 contract
-	if err = p.erc20Keeper.EnableDynamicPrecompiles(ctx, tokenPair.GetERC20Contract()); err != nil {
-		return nil, fmt.Errorf("failed to EnableDynamicPrecompiles: %w", err)
+	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
+		return nil, err
 	}
 
-	// ctx.EventManager().EmitEvent(
-	// 	sdktypes.NewEvent(
-	// 		"erc20_created", // todo: add to sdktypes
-	// 		sdktypes.NewAttribute("denom", denom),
-	// 		sdktypes.NewAttribute("symbol", symbol),
-	// 		sdktypes.NewAttribute("contract_address", contractAddr.String()),
-	// 		sdktypes.NewAttribute("decimals", fmt.Sprintf("%d", decimals)),
-	// 		sdktypes.NewAttribute("supply", supply.String()),
-	// 	),
-	// )
-
-	// TODO REMOVE AFTER
-	// asset := types.Asset{
-	// 	Denom:           denom,
-	// 	ContractAddress: contractAddr.Hex(),
-	// 	ChainId:         utils.MainnetChainID, // Exemple de chainId, à ajuster si nécessaire
-	// 	ChainName:       "Helios",
-	// 	Decimals:        uint64(decimals),
-	// 	BaseWeight:      100, // Valeur par défaut, ajustable selon les besoins
-	// 	Symbol:          symbol,
-	// }
-
-	// TODO : remove this !!
-	// if err := p.erc20Keeper.AddAssetToConsensusWhitelist(ctx, asset); err != nil {
-	// 	return nil, fmt.Errorf("failed to add ERC20 asset to whitelist: %w", err)
-	// }
-
-	// write the log to the stateDB
-	stateDB.AddLog(&ethtypes.Log{
-		Address: p.Address(), // ou une autre adresse
-		Topics: []common.Hash{
-			crypto.Keccak256Hash([]byte("createErc20(address)")),
-		},
-		Data: contractAddr.Bytes(), // ou encode en abi si besoin
-	})
-
-	return method.Outputs.Pack(contractAddr)
+	return bz, nil
 }
 
-func (Precompile) IsTransaction(_ *abi.Method) bool {
-	return true
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	return method.Name != TokenInfoMethod
 }
 
 // validateArguments checks the token parameters for basic safety and correctness
-func (p *Precompile) validateArguments(name, symbol, denom string, supply *big.Int, decimals uint8) error {
+func (p *Precompile) validateArguments(name, symbol, denom string, decimals uint8) error {
 	// Check non-empty fields
 	if strings.TrimSpace(name) == "" {
 		return fmt.Errorf("name cannot be empty")
@@ -284,11 +216,6 @@ func (p *Precompile) validateArguments(name, symbol, denom string, supply *big.I
 		return fmt.Errorf("denom length exceeds %d characters", MaxSymbolLength)
 	}
 
-	// Check supply validity
-	if supply == nil || supply.Sign() <= 0 {
-		return fmt.Errorf("total supply must be greater than zero")
-	}
-
 	// Check decimals range
 	if decimals > MaxDecimals {
 		return fmt.Errorf("decimals cannot exceed %d", MaxDecimals)
@@ -296,3 +223,21 @@ func (p *Precompile) validateArguments(name, symbol, denom string, supply *big.I
 
 	return nil
 }
+
+// validateTemplate checks the supply parameters of the admin template, a mintable token
+// can start with no supply but its initial supply cannot exceed its cap.
+func (p *Precompile) validateTemplate(initialSupply, supplyCap *big.Int, mintable bool) error {
+	if initialSupply == nil || initialSupply.Sign() < 0 {
+		return fmt.Errorf("initial supply cannot be negative")
+	}
+	if initialSupply.Sign() == 0 && !mintable {
+		return fmt.Errorf("initial supply of a non mintable token must be greater than zero")
+	}
+	if supplyCap == nil || supplyCap.Sign() < 0 {
+		return fmt.Errorf("supply cap cannot be negative")
+	}
+	if supplyCap.Sign() > 0 && initialSupply.Cmp(supplyCap) > 0 {
+		return fmt.Errorf("initial supply exceeds the supply cap")
+	}
+	return nil
+}
@@ -0,0 +1,402 @@
+package erc20creator
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "helios-core/helios-chain/precompiles/common"
+	"helios-core/helios-chain/x/erc20/types"
+	vm "helios-core/helios-chain/x/evm/core/vm"
+)
+
+const (
+	CreateErc20Method   = "createErc20"
+	CreateTokenMethod   = "createToken"
+	MintMethod          = "mint"
+	BurnMethod          = "burn"
+	SetAdminMethod      = "setAdmin"
+	RenounceAdminMethod = "renounceAdmin"
+	PauseMethod         = "pause"
+	UnpauseMethod       = "unpause"
+)
+
+// CreateErc20 creates a fixed supply token, the whole supply is minted to the origin.
+func (p Precompile) CreateErc20(
+	ctx sdktypes.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 6 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	// Extract arguments in expected order: name (name), symbol, totalSupply, decimals
+	name, okName := args[0].(string)
+	symbol, okSymbol := args[1].(string)
+	denom, okDenom := args[2].(string)
+	supply, okSupply := args[3].(*big.Int)
+	decimals, okDecimals := args[4].(uint8)
+	logoBase64, okLogo := args[5].(string)
+
+	if !okName || !okSymbol || !okSupply || !okDecimals || !okDenom || !okLogo {
+		return nil, fmt.Errorf("invalid argument types")
+	}
+
+	// Check supply validity
+	if supply == nil || supply.Sign() <= 0 {
+		return nil, fmt.Errorf("total supply must be greater than zero")
+	}
+
+	contractAddr, err := p.createToken(ctx, origin, stateDB, name, symbol, denom, supply, decimals, logoBase64)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(contractAddr)
+}
+
+// CreateToken creates a token from the admin template, the caller becomes the admin of
+// the token and receives the initial supply.
+func (p Precompile) CreateToken(
+	ctx sdktypes.Context,
+	caller common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 10 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 10, len(args))
+	}
+
+	name, okName := args[0].(string)
+	symbol, okSymbol := args[1].(string)
+	denom, okDenom := args[2].(string)
+	initialSupply, okSupply := args[3].(*big.Int)
+	decimals, okDecimals := args[4].(uint8)
+	logoBase64, okLogo := args[5].(string)
+	supplyCap, okCap := args[6].(*big.Int)
+	mintable, okMintable := args[7].(bool)
+	burnable, okBurnable := args[8].(bool)
+	pausable, okPausable := args[9].(bool)
+
+	if !okName || !okSymbol || !okDenom || !okSupply || !okDecimals || !okLogo ||
+		!okCap || !okMintable || !okBurnable || !okPausable {
+		return nil, fmt.Errorf("invalid argument types")
+	}
+
+	if err := p.validateTemplate(initialSupply, supplyCap, mintable); err != nil {
+		return nil, err
+	}
+
+	contractAddr, err := p.createToken(ctx, caller, stateDB, name, symbol, denom, initialSupply, decimals, logoBase64)
+	if err != nil {
+		return nil, err
+	}
+
+	creatorToken := types.CreatorToken{
+		Denom:     denom,
+		Admin:     sdktypes.AccAddress(caller.Bytes()).String(),
+		SupplyCap: sdkmath.NewIntFromBigInt(supplyCap),
+		Mintable:  mintable,
+		Burnable:  burnable,
+		Pausable:  pausable,
+	}
+	if err := creatorToken.Validate(); err != nil {
+		return nil, err
+	}
+	p.erc20Keeper.SetCreatorToken(ctx, creatorToken)
+
+	return method.Outputs.Pack(contractAddr)
+}
+
+// Mint mints new tokens of a mintable token to the recipient, the caller must be the admin.
+func (p Precompile) Mint(
+	ctx sdktypes.Context,
+	caller common.Address,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	token, okToken := args[0].(common.Address)
+	to, okTo := args[1].(common.Address)
+	amount, okAmount := args[2].(*big.Int)
+	if !okToken || !okTo || !okAmount || amount == nil {
+		return nil, fmt.Errorf("invalid argument types")
+	}
+
+	denom, err := p.tokenDenom(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.erc20Keeper.MintCreatorToken(
+		ctx, caller.Bytes(), to.Bytes(), denom, sdkmath.NewIntFromBigInt(amount),
+	); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Burn burns tokens of the caller.
+func (p Precompile) Burn(
+	ctx sdktypes.Context,
+	caller common.Address,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	token, okToken := args[0].(common.Address)
+	amount, okAmount := args[1].(*big.Int)
+	if !okToken || !okAmount || amount == nil {
+		return nil, fmt.Errorf("invalid argument types")
+	}
+
+	denom, err := p.tokenDenom(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.erc20Keeper.BurnCreatorToken(ctx, caller.Bytes(), denom, sdkmath.NewIntFromBigInt(amount)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetAdmin hands the admin role of the token over to a new admin.
+func (p Precompile) SetAdmin(
+	ctx sdktypes.Context,
+	caller common.Address,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	token, okToken := args[0].(common.Address)
+	newAdmin, okAdmin := args[1].(common.Address)
+	if !okToken || !okAdmin {
+		return nil, fmt.Errorf("invalid argument types")
+	}
+	// the role is only given up through renounceAdmin, to not lose it by mistake
+	if newAdmin == (common.Address{}) {
+		return nil, fmt.Errorf("new admin is the zero address, use renounceAdmin instead")
+	}
+
+	denom, err := p.tokenDenom(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.erc20Keeper.SetCreatorTokenAdmin(ctx, caller.Bytes(), denom, newAdmin.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RenounceAdmin gives up the admin role of the token for good.
+func (p Precompile) RenounceAdmin(
+	ctx sdktypes.Context,
+	caller common.Address,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	token, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid argument types")
+	}
+
+	denom, err := p.tokenDenom(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.erc20Keeper.SetCreatorTokenAdmin(ctx, caller.Bytes(), denom, nil); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetPaused pauses or resumes the transfers of a pausable token.
+func (p Precompile) SetPaused(
+	ctx sdktypes.Context,
+	caller common.Address,
+	method *abi.Method,
+	args []interface{},
+	paused bool,
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	token, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid argument types")
+	}
+
+	denom, err := p.tokenDenom(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.erc20Keeper.SetCreatorTokenPaused(ctx, caller.Bytes(), denom, paused); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// createToken deploys the ERC20 contract of a new token, mints its initial supply to the
+// recipient and registers the token pair.
+func (p Precompile) createToken(
+	ctx sdktypes.Context,
+	recipient common.Address,
+	stateDB vm.StateDB,
+	name, symbol, denom string,
+	supply *big.Int,
+	decimals uint8,
+	logoBase64 string,
+) (common.Address, error) {
+	var err error
+	logoHash := ""
+
+	if logoBase64 != "" {
+		logoHash, err = p.logosKeeper.StoreLogo(ctx, logoBase64)
+		if err != nil {
+			return common.Address{}, fmt.Errorf("failed to store logo: %w", err)
+		}
+	}
+
+	// Validate arguments
+	if err := p.validateArguments(name, symbol, denom, decimals); err != nil {
+		return common.Address{}, err
+	}
+
+	// Ensure the creator is not the zero address (common check for authenticity)
+	if recipient == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("creator address is zero address")
+	}
+
+	// Check if metadata already exists for this base denom permit to create ~100 000 same denoms maximum
+	if _, found := p.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return common.Address{}, errorsmod.Wrap(
+			types.ErrInternalTokenPair,
+			"denom metadata already registered, choose a unique base denomination",
+		)
+	}
+
+	coinMetadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Token %s created with ERC20Creator precompile", denom),
+		Base:        denom,
+		Name:        name,
+		Symbol:      symbol,
+		Decimals:    uint32(decimals),
+		Display:     symbol,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: uint32(0),
+			},
+			{
+				Denom:    symbol,
+				Exponent: uint32(decimals),
+			},
+		},
+		Logo: logoHash,
+	}
+
+	// validate metadata
+	if err := coinMetadata.Validate(); err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy ERC20 contract: %w", err)
+	}
+
+	// Deploy the ERC20 contract
+	contractAddr, err := p.erc20Keeper.DeployERC20Contract(ctx, coinMetadata)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy ERC20 contract: %w", err)
+	}
+
+	if supply.Sign() > 0 {
+		// Mint tokens in the ERC20 contract
+		if err := p.erc20Keeper.MintERC20Tokens(ctx, contractAddr, recipient, supply); err != nil {
+			return common.Address{}, fmt.Errorf("failed to mint ERC20 tokens: %w", err)
+		}
+
+		coins := sdktypes.NewCoins(sdktypes.NewCoin(denom, sdkmath.NewIntFromBigInt(supply)))
+
+		// Mint native coins to the module account
+		if err := p.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return common.Address{}, fmt.Errorf("failed to mint coins on-chain: %w", err)
+		}
+
+		// Transfer minted coins to the recipient
+		if err := p.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient.Bytes(), coins); err != nil {
+			return common.Address{}, fmt.Errorf("failed to send minted coins to recipient: %w", err)
+		}
+	}
+
+	// Register the token pair for cross-chain usage
+	tokenPair := types.NewTokenPair(contractAddr, denom, types.OWNER_MODULE)
+	p.erc20Keeper.SetToken(ctx, tokenPair)
+
+	// Enable dynamic precompiles for the deployed ERC20 contract
+	if err = p.erc20Keeper.EnableDynamicPrecompiles(ctx, tokenPair.GetERC20Contract()); err != nil {
+		return common.Address{}, fmt.Errorf("failed to EnableDynamicPrecompiles: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdktypes.NewEvent(
+			types.EventTypeERC20Created,
+			sdktypes.NewAttribute(types.AttributeKeyDenom, denom),
+			sdktypes.NewAttribute(types.AttributeKeySymbol, symbol),
+			sdktypes.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+			sdktypes.NewAttribute(types.AttributeKeyDecimals, fmt.Sprintf("%d", decimals)),
+			sdktypes.NewAttribute(types.AttributeKeySupply, supply.String()),
+		),
+	)
+
+	// write the log to the stateDB
+	stateDB.AddLog(&ethtypes.Log{
+		Address: p.Address(), // ou une autre adresse
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("createErc20(address)")),
+		},
+		Data: contractAddr.Bytes(), // ou encode en abi si besoin
+	})
+
+	return contractAddr, nil
+}
+
+// tokenDenom returns the bank denom of the token created by the precompile
+func (p Precompile) tokenDenom(ctx sdktypes.Context, token common.Address) (string, error) {
+	pair, found := p.erc20Keeper.GetTokenPair(ctx, p.erc20Keeper.GetTokenPairID(ctx, token.String()))
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s'", token)
+	}
+	return pair.Denom, nil
+}
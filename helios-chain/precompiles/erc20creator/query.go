@@ -0,0 +1,64 @@
+package erc20creator
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "helios-core/helios-chain/precompiles/common"
+	"helios-core/helios-chain/x/erc20/types"
+)
+
+const (
+	// TokenInfoMethod defines the ABI method name for the admin controls query.
+	TokenInfoMethod = "tokenInfo"
+)
+
+// TokenInfo returns the admin controls of a token created from the admin template. The
+// admin is the zero address once the role has been renounced.
+func (p Precompile) TokenInfo(
+	ctx sdktypes.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	token, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid argument types")
+	}
+
+	denom, err := p.tokenDenom(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	creatorToken, found := p.erc20Keeper.GetCreatorToken(ctx, denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCreatorTokenNotFound, "denom '%s'", denom)
+	}
+
+	var admin common.Address
+	if creatorToken.Admin != "" {
+		adminAcc, err := sdktypes.AccAddressFromBech32(creatorToken.Admin)
+		if err != nil {
+			return nil, err
+		}
+		admin = common.BytesToAddress(adminAcc)
+	}
+
+	return method.Outputs.Pack(
+		creatorToken.Denom,
+		admin,
+		creatorToken.SupplyCap.BigInt(),
+		creatorToken.Mintable,
+		creatorToken.Burnable,
+		creatorToken.Pausable,
+		creatorToken.Paused,
+	)
+}
@@ -99,7 +99,7 @@ func (p Precompile) AddCounterpartyChainParams(
 			BridgeCounterpartyAddress:     bridgeCounterpartyAddress,
 			BridgeChainId:                 bridgeChainId,
 			BridgeChainLogo:               logoHash,
-			BridgeChainType:               "evm",
+			BridgeChainType:               hyperiontypes.ChainTypeEVM,
 			BridgeChainName:               bridgeChainName,
 			SignedValsetsWindow:           25000,
 			SignedBatchesWindow:           25000,
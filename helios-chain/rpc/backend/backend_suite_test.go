@@ -164,6 +164,7 @@ func (suite *BackendTestSuite) buildFormattedBlock(
 		bloom,
 		common.BytesToAddress(validator.Bytes()),
 		baseFee,
+		[]interface{}{},
 	)
 }
 
@@ -18,7 +18,6 @@ import (
 
 	"helios-core/helios-chain/rpc/backend/mocks"
 	ethrpc "helios-core/helios-chain/rpc/types"
-	rpctypes "helios-core/helios-chain/rpc/types"
 	utiltx "helios-core/helios-chain/testutil/tx"
 	evmtypes "helios-core/helios-chain/x/evm/types"
 )
@@ -1082,7 +1081,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock(math.NewIntFromBigInt(tc.baseFee), tc.validator, tc.height)
 
-			block, err := suite.backend.RPCBlockFromTendermintBlock(tc.resBlock, tc.blockRes, tc.fullTx, make([]*rpctypes.CronTransactionReceiptRPC, 0))
+			block, err := suite.backend.RPCBlockFromTendermintBlock(tc.resBlock, tc.blockRes, tc.fullTx)
 
 			var expBlock map[string]interface{}
 			header := tc.resBlock.Block.Header
@@ -1121,6 +1120,7 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 				bloom,
 				common.BytesToAddress(tc.validator.Bytes()),
 				tc.baseFee,
+				[]interface{}{},
 			)
 
 			if tc.expPass {
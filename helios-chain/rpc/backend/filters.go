@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	evmostypes "helios-core/helios-chain/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -29,6 +31,26 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// GetIndexedLogs returns the logs of the blocks within [from, to] matching the addresses
+// and the topics from the log index of the EVM indexer. It returns ErrLogsNotIndexed when
+// the indexer is disabled or has not indexed the logs of the whole range yet.
+func (b *Backend) GetIndexedLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error) {
+	if b.indexer == nil {
+		return nil, evmostypes.ErrLogsNotIndexed
+	}
+	return b.indexer.GetLogs(from, to, addresses, topics, limit)
+}
+
+// GetIndexedCronHeights returns the heights of the blocks within [from, to] with cron
+// transactions from the EVM indexer. It returns ErrLogsNotIndexed when the indexer is
+// disabled or has not indexed the whole range yet.
+func (b *Backend) GetIndexedCronHeights(from, to int64) ([]int64, error) {
+	if b.indexer == nil {
+		return nil, evmostypes.ErrLogsNotIndexed
+	}
+	return b.indexer.GetCronHeights(from, to)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -219,3 +219,27 @@ func (b *Backend) GetAllHyperionSkippedNonces() ([]*hyperiontypes.SkippedNonceFu
 	}
 	return res.SkippedNonces, nil
 }
+
+func (b *Backend) GetHyperionOutboundQuota(chainId uint64, tokenAddress common.Address) (*hyperiontypes.QueryGetOutboundQuotaResponse, error) {
+	res, err := b.queryClient.Hyperion.QueryGetOutboundQuota(b.ctx, &hyperiontypes.QueryGetOutboundQuotaRequest{
+		ChainId:      chainId,
+		TokenAddress: tokenAddress.Hex(),
+	})
+	if err != nil {
+		b.logger.Error("GetHyperionOutboundQuota", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (b *Backend) GetHyperionCounterpartyHeader(chainId uint64, height uint64) (*hyperiontypes.CounterpartyHeader, error) {
+	res, err := b.queryClient.Hyperion.QueryGetCounterpartyHeader(b.ctx, &hyperiontypes.QueryGetCounterpartyHeaderRequest{
+		ChainId: chainId,
+		Height:  height,
+	})
+	if err != nil {
+		b.logger.Error("GetHyperionCounterpartyHeader", "error", err)
+		return nil, err
+	}
+	return res.Header, nil
+}
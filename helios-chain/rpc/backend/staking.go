@@ -426,6 +426,8 @@ func (b *Backend) GetAllWhitelistedAssets() ([]rpctypes.WhitelistedAssetRPC, err
 		whitelistedAssets = append(whitelistedAssets, rpctypes.WhitelistedAssetRPC{
 			Denom:                         asset.Denom,
 			BaseWeight:                    asset.BaseWeight,
+			EffectiveWeight:               asset.ConsensusWeight(),
+			MaxPowerShare:                 asset.MaxPowerShare,
 			ChainId:                       asset.ChainId,
 			ChainName:                     asset.ChainName,
 			Decimals:                      asset.Decimals,
@@ -478,7 +480,7 @@ func (b *Backend) GetDelegations(delegatorAddress common.Address) ([]rpctypes.De
 			baseWeight := math.NewIntFromUint64(1)
 			contractAddress := ""
 			if idx != -1 {
-				baseWeight = math.NewIntFromUint64(whitelistedAssetsResp.Assets[idx].GetBaseWeight())
+				baseWeight = math.NewIntFromUint64(whitelistedAssetsResp.Assets[idx].ConsensusWeight())
 				contractAddress = whitelistedAssetsResp.Assets[idx].ContractAddress
 			}
 
@@ -630,7 +632,7 @@ func (b *Backend) GetDelegation(address common.Address, validatorAddress common.
 		baseWeight := math.NewIntFromUint64(1)
 		contractAddress := ""
 		if idx != -1 {
-			baseWeight = math.NewIntFromUint64(whitelistedAssetsResp.Assets[idx].GetBaseWeight())
+			baseWeight = math.NewIntFromUint64(whitelistedAssetsResp.Assets[idx].ConsensusWeight())
 			contractAddress = whitelistedAssetsResp.Assets[idx].ContractAddress
 		}
 
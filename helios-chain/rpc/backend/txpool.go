@@ -0,0 +1,119 @@
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "helios-core/helios-chain/rpc/types"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+// TxPoolContent returns the Ethereum txs of the mempool grouped by sender and nonce. The
+// txs executable in a row from the account nonce of their sender are pending, the ones
+// held behind a nonce gap are queued.
+func (b *Backend) TxPoolContent() (
+	pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	return b.txPoolContent(nil)
+}
+
+// TxPoolContentFrom returns the pending and queued Ethereum txs of the mempool sent by
+// the address, keyed by nonce.
+func (b *Backend) TxPoolContentFrom(address common.Address) (
+	pending, queued map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	allPending, allQueued, err := b.txPoolContent(&address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending, queued = allPending[address], allQueued[address]
+	if pending == nil {
+		pending = make(map[uint64]*rpctypes.RPCTransaction)
+	}
+	if queued == nil {
+		queued = make(map[uint64]*rpctypes.RPCTransaction)
+	}
+	return pending, queued, nil
+}
+
+// txPoolContent classifies the Ethereum txs of the mempool, optionally only the ones of a sender
+func (b *Backend) txPoolContent(sender *common.Address) (
+	pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	senderTxs := make(map[common.Address][]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			rpctx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, 0, 0, nil, b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to build pending transaction", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			if sender != nil && rpctx.From != *sender {
+				continue
+			}
+
+			senderTxs[rpctx.From] = append(senderTxs[rpctx.From], rpctx)
+		}
+	}
+
+	pending = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	if len(senderTxs) == 0 {
+		return pending, queued, nil
+	}
+
+	// the account nonces are queried at the latest height, the gRPC queries reject negative heights
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	for from, fromTxs := range senderTxs {
+		accountNonce, err := b.GetTransactionCount(from, rpctypes.BlockNumber(latest))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sort.Slice(fromTxs, func(i, j int) bool {
+			return fromTxs[i].Nonce < fromTxs[j].Nonce
+		})
+
+		next := uint64(*accountNonce)
+		for _, rpctx := range fromTxs {
+			nonce := uint64(rpctx.Nonce)
+			switch {
+			case nonce < next:
+				// already executed or duplicated, the tx is evicted on the next recheck
+				continue
+			case nonce == next:
+				if pending[from] == nil {
+					pending[from] = make(map[uint64]*rpctypes.RPCTransaction)
+				}
+				pending[from][nonce] = rpctx
+				next++
+			default:
+				if queued[from] == nil {
+					queued[from] = make(map[uint64]*rpctypes.RPCTransaction)
+				}
+				queued[from][nonce] = rpctx
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
@@ -0,0 +1,108 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/metadata"
+
+	"helios-core/helios-chain/encoding"
+	"helios-core/helios-chain/rpc/backend/mocks"
+	rpctypes "helios-core/helios-chain/rpc/types"
+	utiltx "helios-core/helios-chain/testutil/tx"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+// buildSignedEthTx returns the encoded Ethereum tx of the sender with the nonce
+func (suite *BackendTestSuite) buildSignedEthTx(from common.Address, signer keyring.Signer, nonce uint64) types.Tx {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.backend.chainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(0),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	})
+	msgEthereumTx.From = from.Hex()
+	err := msgEthereumTx.Sign(ethtypes.LatestSignerForChainID(suite.backend.chainID), signer)
+	suite.Require().NoError(err)
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	suite.Require().NoError(err)
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	return txBz
+}
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	// the sender has an account with the nonce 2, the other one has no account yet
+	sender, senderPriv := utiltx.NewAddrKey()
+	senderSigner := utiltx.NewSigner(senderPriv)
+	other, otherPriv := utiltx.NewAddrKey()
+	otherSigner := utiltx.NewSigner(otherPriv)
+
+	senderAcc := client.TestAccount{Address: sdk.AccAddress(sender.Bytes()), Num: 1, Seq: 2}
+	suite.backend.clientCtx = suite.backend.clientCtx.
+		WithInterfaceRegistry(encoding.MakeConfig().InterfaceRegistry).
+		WithAccountRetriever(client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+			senderAcc.Address.String(): senderAcc,
+		}})
+
+	txs := []types.Tx{
+		suite.buildSignedEthTx(sender, senderSigner, 5),
+		suite.buildSignedEthTx(sender, senderSigner, 3),
+		// already executed
+		suite.buildSignedEthTx(sender, senderSigner, 0),
+		suite.buildSignedEthTx(sender, senderSigner, 2),
+		suite.buildSignedEthTx(sender, senderSigner, 1),
+		// duplicated nonce
+		suite.buildSignedEthTx(sender, senderSigner, 3),
+		// behind the missing nonce 0
+		suite.buildSignedEthTx(other, otherSigner, 1),
+	}
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	var header metadata.MD
+	RegisterUnconfirmedTxs(client, nil, txs)
+	RegisterParams(queryClient, &header, 1)
+	request := &authtypes.QueryAccountRequest{Address: senderAcc.Address.String()}
+	requestMarshal, err := request.Marshal()
+	suite.Require().NoError(err)
+	RegisterABCIQueryAccount(client, bytes.HexBytes(requestMarshal), tmrpcclient.ABCIQueryOptions{Height: 1, Prove: false}, senderAcc)
+
+	nonces := func(txs map[uint64]*rpctypes.RPCTransaction) []uint64 {
+		var nonces []uint64
+		for nonce, tx := range txs {
+			suite.Require().Equal(nonce, uint64(tx.Nonce))
+			nonces = append(nonces, nonce)
+		}
+		return nonces
+	}
+
+	pending, queued, err := suite.backend.TxPoolContent()
+	suite.Require().NoError(err)
+	suite.Require().Len(pending, 1)
+	suite.Require().ElementsMatch([]uint64{2, 3}, nonces(pending[sender]))
+	suite.Require().Len(queued, 2)
+	suite.Require().ElementsMatch([]uint64{5}, nonces(queued[sender]))
+	suite.Require().ElementsMatch([]uint64{1}, nonces(queued[other]))
+
+	pendingFrom, queuedFrom, err := suite.backend.TxPoolContentFrom(sender)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]uint64{2, 3}, nonces(pendingFrom))
+	suite.Require().ElementsMatch([]uint64{5}, nonces(queuedFrom))
+
+	pendingFrom, queuedFrom, err = suite.backend.TxPoolContentFrom(utiltx.GenerateAddress())
+	suite.Require().NoError(err)
+	suite.Require().Empty(pendingFrom)
+	suite.Require().Empty(queuedFrom)
+}
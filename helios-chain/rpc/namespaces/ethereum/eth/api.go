@@ -94,14 +94,15 @@ type EthereumAPI interface {
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error)
+	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride) (hexutil.Bytes, error)
+	SimulateV1(opts evmtypes.SimulateOptions, blockNrOrHash *rpctypes.BlockNumberOrHash) (interface{}, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
 	FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -194,6 +195,8 @@ type EthereumAPI interface {
 	GetHyperionNonceAlreadyObserved(hyperionId uint64, nonce uint64) (bool, error)
 	GetHyperionSkippedNonces(hyperionId uint64) ([]*hyperiontypes.SkippedNonceFullInfo, error)
 	GetAllHyperionSkippedNonces() ([]*hyperiontypes.SkippedNonceFullInfoWithHyperionId, error)
+	GetHyperionOutboundQuota(chainId uint64, tokenAddress common.Address) (*hyperiontypes.QueryGetOutboundQuotaResponse, error)
+	GetHyperionCounterpartyHeader(chainId uint64, height uint64) (*hyperiontypes.CounterpartyHeader, error)
 
 	GetCosmosTransactionByHashFormatted(txHash string) (*map[string]interface{}, error)
 }
@@ -439,7 +442,7 @@ func (e *PublicAPI) GetAccountLastTransactionsInfo(address common.Address) ([]*r
 // Call performs a raw contract call.
 func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
-	_ *rpctypes.StateOverride,
+	overrides *rpctypes.StateOverride,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
@@ -447,7 +450,7 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(args, blockNum, overrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -455,6 +458,22 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes a sequence of calls across simulated blocks built on top of the
+// given block, the state changes of every call are carried over to the next ones.
+func (e *PublicAPI) SimulateV1(opts evmtypes.SimulateOptions, blockNrOrHash *rpctypes.BlockNumberOrHash) (interface{}, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	if blockNrOrHash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		blockNrOrHash = &rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	}
+	blockNum, err := e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -477,9 +496,9 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides)
 }
 
 func (e *PublicAPI) FeeHistory(blockCount rpc.DecimalOrHex,
@@ -920,6 +939,16 @@ func (e *PublicAPI) GetAllHyperionSkippedNonces() ([]*hyperiontypes.SkippedNonce
 	return e.backend.GetAllHyperionSkippedNonces()
 }
 
+func (e *PublicAPI) GetHyperionOutboundQuota(chainId uint64, tokenAddress common.Address) (*hyperiontypes.QueryGetOutboundQuotaResponse, error) {
+	e.logger.Debug("eth_getHyperionOutboundQuota", "chainId", chainId, "tokenAddress", tokenAddress)
+	return e.backend.GetHyperionOutboundQuota(chainId, tokenAddress)
+}
+
+func (e *PublicAPI) GetHyperionCounterpartyHeader(chainId uint64, height uint64) (*hyperiontypes.CounterpartyHeader, error) {
+	e.logger.Debug("eth_getHyperionCounterpartyHeader", "chainId", chainId, "height", height)
+	return e.backend.GetHyperionCounterpartyHeader(chainId, height)
+}
+
 // Dans helios-chain/rpc/namespaces/ethereum/eth/api.go
 
 func (e *PublicAPI) GetBlockSignatures(blockHeight hexutil.Uint64) ([]*rpctypes.ValidatorSignature, error) {
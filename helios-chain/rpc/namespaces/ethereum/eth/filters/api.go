@@ -3,6 +3,7 @@ package filters
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -19,6 +20,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -46,8 +48,11 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	GetIndexedLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+	GetIndexedCronHeights(from, to int64) ([]int64, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 	GetBlockCronLogs(blockNumber rpctypes.BlockNumber) ([]*ethtypes.Log, error)
+	ChainID() (*hexutil.Big, error)
 
 	BloomStatus() (uint64, uint64)
 
@@ -196,12 +201,22 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
 // enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// The full transactions are sent instead of their hashes when fullTx is true.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
+	var chainID *big.Int
+	if fullTx != nil && *fullTx {
+		chainIDHex, err := api.backend.ChainID()
+		if err != nil {
+			return nil, err
+		}
+		chainID = chainIDHex.ToInt()
+	}
+
 	rpcSub := notifier.CreateSubscription()
 
 	ctx, cancelFn := context.WithTimeout(context.Background(), deadline)
@@ -241,9 +256,19 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 
 				for _, msg := range tx.GetMsgs() {
 					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-					if ok {
+					if !ok {
+						continue
+					}
+					if chainID == nil {
 						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash()) // #nosec G703
+						continue
+					}
+					rpctx, err := rpctypes.NewTransactionFromMsg(ethTx, common.Hash{}, 0, 0, nil, chainID)
+					if err != nil {
+						api.logger.Debug("failed to build pending transaction", "hash", ethTx.Hash, "error", err.Error())
+						continue
 					}
+					_ = notifier.Notify(rpcSub.ID, rpctx) // #nosec G703
 				}
 			case <-rpcSub.Err():
 				pendingTxSub.Unsubscribe(api.events)
@@ -10,6 +10,7 @@ import (
 	"helios-core/helios-chain/rpc/types"
 
 	rpctypes "helios-core/helios-chain/rpc/types"
+	evmostypes "helios-core/helios-chain/types"
 
 	"cosmossdk.io/log"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -156,6 +157,20 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	// the log index of the EVM indexer spares fetching the block results of every height,
+	// the blocks past the head have no logs yet
+	indexed, err := f.backend.GetIndexedLogs(from, min(to, head), f.criteria.Addresses, f.criteria.Topics, logLimit)
+	switch {
+	case err == nil:
+		cronHeights, err := f.backend.GetIndexedCronHeights(from, min(to, head))
+		if err != nil {
+			return nil, err
+		}
+		return f.withCronLogs(indexed, cronHeights, logLimit)
+	case !errors.Is(err, evmostypes.ErrLogsNotIndexed):
+		return nil, err
+	}
+
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
@@ -173,7 +188,7 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 			return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
 		}
 
-		cronFiltered, _ := f.cronBlockLogs(blockRes) // non blocking
+		cronFiltered, _ := f.cronBlockLogs(blockRes.Height) // non blocking
 
 		// check logs limit
 		if len(logs)+len(filtered)+len(cronFiltered) > logLimit {
@@ -208,9 +223,30 @@ func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtyp
 	return logs, nil
 }
 
+// withCronLogs merges the cron logs matching the filter criteria of the blocks with cron
+// transactions with the indexed logs, keeping the logs of each block after its tx logs.
+func (f *Filter) withCronLogs(indexed []*ethtypes.Log, cronHeights []int64, logLimit int) ([]*ethtypes.Log, error) {
+	logs := make([]*ethtypes.Log, 0, len(indexed))
+	for _, height := range cronHeights {
+		for len(indexed) > 0 && indexed[0].BlockNumber <= uint64(height) { //nolint:gosec // G115
+			logs = append(logs, indexed[0])
+			indexed = indexed[1:]
+		}
+
+		cronFiltered, _ := f.cronBlockLogs(height) // non blocking
+
+		// check logs limit
+		if len(logs)+len(indexed)+len(cronFiltered) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		logs = append(logs, cronFiltered...)
+	}
+	return append(logs, indexed...), nil
+}
+
 // cronBlockLogs returns the logs matching the filter criteria within a single block.
-func (f *Filter) cronBlockLogs(blockRes *tmrpctypes.ResultBlockResults) ([]*ethtypes.Log, error) {
-	cronUnFiltered, err := f.backend.GetBlockCronLogs(rpctypes.BlockNumber(blockRes.Height))
+func (f *Filter) cronBlockLogs(height int64) ([]*ethtypes.Log, error) {
+	cronUnFiltered, err := f.backend.GetBlockCronLogs(rpctypes.BlockNumber(height))
 	if err != nil {
 		return []*ethtypes.Log{}, nil
 	}
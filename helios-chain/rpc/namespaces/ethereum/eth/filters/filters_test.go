@@ -0,0 +1,88 @@
+package filters
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "helios-core/helios-chain/rpc/types"
+)
+
+// indexedBackend serves the logs of the log index and the cron logs of the blocks
+type indexedBackend struct {
+	Backend
+
+	head        int64
+	logs        []*ethtypes.Log
+	cronHeights []int64
+	cronLogs    map[int64][]*ethtypes.Log
+	cronQueries []int64
+}
+
+func (b *indexedBackend) HeaderByNumber(rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *indexedBackend) GetIndexedLogs(from, to int64, _ []common.Address, _ [][]common.Hash, _ int) ([]*ethtypes.Log, error) {
+	logs := []*ethtypes.Log{}
+	for _, log := range b.logs {
+		if int64(log.BlockNumber) >= from && int64(log.BlockNumber) <= to { //nolint:gosec // G115
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (b *indexedBackend) GetIndexedCronHeights(from, to int64) ([]int64, error) {
+	heights := []int64{}
+	for _, height := range b.cronHeights {
+		if height >= from && height <= to {
+			heights = append(heights, height)
+		}
+	}
+	return heights, nil
+}
+
+func (b *indexedBackend) GetBlockCronLogs(blockNum rpctypes.BlockNumber) ([]*ethtypes.Log, error) {
+	b.cronQueries = append(b.cronQueries, blockNum.Int64())
+	return b.cronLogs[blockNum.Int64()], nil
+}
+
+func TestLogsWithCronLogs(t *testing.T) {
+	contract := common.HexToAddress("0x1000")
+	cron := common.HexToAddress("0x2000")
+
+	txLog1 := &ethtypes.Log{Address: contract, BlockNumber: 2}
+	txLog2 := &ethtypes.Log{Address: contract, BlockNumber: 5}
+	cronLog1 := &ethtypes.Log{Address: cron, BlockNumber: 2}
+	cronLog2 := &ethtypes.Log{Address: cron, BlockNumber: 4}
+
+	backend := &indexedBackend{
+		head:        10,
+		logs:        []*ethtypes.Log{txLog1, txLog2},
+		cronHeights: []int64{2, 4},
+		cronLogs:    map[int64][]*ethtypes.Log{2: {cronLog1}, 4: {cronLog2}},
+	}
+
+	filter := NewRangeFilter(log.NewNopLogger(), backend, 1, 10, nil, nil)
+	logs, err := filter.Logs(context.Background(), 100, 100)
+	require.NoError(t, err)
+	// the cron logs of a block come after its tx logs
+	require.Equal(t, []*ethtypes.Log{txLog1, cronLog1, cronLog2, txLog2}, logs)
+	// the cron logs are only queried for the blocks with cron executions
+	require.Equal(t, []int64{2, 4}, backend.cronQueries)
+
+	filter = NewRangeFilter(log.NewNopLogger(), backend, 1, 10, []common.Address{cron}, nil)
+	backend.logs = nil
+	logs, err = filter.Logs(context.Background(), 100, 100)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{cronLog1, cronLog2}, logs)
+
+	_, err = NewRangeFilter(log.NewNopLogger(), backend, 1, 10, nil, nil).Logs(context.Background(), 1, 100)
+	require.ErrorContains(t, err, "query returned more than 1 results")
+}
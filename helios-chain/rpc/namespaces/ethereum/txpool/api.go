@@ -1,51 +1,135 @@
 package txpool
 
 import (
+	"fmt"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"helios-core/helios-chain/rpc/backend"
 	"helios-core/helios-chain/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The transaction pool is the CometBFT mempool, its Ethereum transactions are pending when they are
+// executable from the account nonce of their sender and queued when they are held behind a nonce gap.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for from, txs := range pending {
+		content["pending"][from.Hex()] = formatTxs(txs)
+	}
+	for from, txs := range queued {
+		content["queued"][from.Hex()] = formatTxs(txs)
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool sent by an address
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+	pending, queued, err := api.backend.TxPoolContentFrom(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatTxs(pending),
+		"queued":  formatTxs(queued),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for from, txs := range pending {
+		content["pending"][from.Hex()] = inspectTxs(txs)
+	}
+	for from, txs := range queued {
+		content["queued"][from.Hex()] = inspectTxs(txs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pending)),
+		"queued":  hexutil.Uint(countTxs(queued)),
+	}, nil
+}
+
+// formatTxs keys the transactions of a sender by their decimal nonce
+func formatTxs(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	res := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		res[fmt.Sprint(nonce)] = tx
+	}
+	return res
+}
+
+// inspectTxs summarizes the transactions of a sender keyed by their decimal nonce
+func inspectTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	res := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		gasPrice := tx.GasPrice
+		if tx.GasFeeCap != nil {
+			gasPrice = tx.GasFeeCap
+		}
+
+		if tx.To != nil {
+			res[fmt.Sprint(nonce)] = fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt())
+		} else {
+			res[fmt.Sprint(nonce)] = fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), gasPrice.ToInt())
+		}
+	}
+	return res
+}
+
+func countTxs(content map[common.Address]map[uint64]*types.RPCTransaction) int {
+	count := 0
+	for _, txs := range content {
+		count += len(txs)
 	}
+	return count
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "helios-core/helios-chain/x/evm/types"
 )
 
 // CoinInfoRPC represents comprehensive coin information for JSON-RPC
@@ -114,20 +116,17 @@ type AccountTokensBalance struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// TraceCallConfig is the config of debug_traceCall, the trace config extended
+// with the state overrides applied before the call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride `json:"stateOverrides"`
 }
 
 type FeeHistoryResult struct {
@@ -251,6 +250,8 @@ type ValidatorWithAssetsRPC struct {
 type WhitelistedAssetRPC struct {
 	Denom                         string                `json:"denom"`
 	BaseWeight                    uint64                `json:"baseWeight"`
+	EffectiveWeight               uint64                `json:"effectiveWeight"`
+	MaxPowerShare                 string                `json:"maxPowerShare"`
 	ChainId                       string                `json:"chainId"`
 	ChainName                     string                `json:"chainName"`
 	Decimals                      uint64                `json:"decimals"`
@@ -64,6 +64,21 @@ const (
 	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
 )
 
+// MemIAVL flags
+const (
+	MemIAVLEnable             = "memiavl.enable"
+	MemIAVLZeroCopy           = "memiavl.zero-copy"
+	MemIAVLAsyncCommitBuffer  = "memiavl.async-commit-buffer"
+	MemIAVLSnapshotKeepRecent = "memiavl.snapshot-keep-recent"
+	MemIAVLSnapshotInterval   = "memiavl.snapshot-interval"
+	MemIAVLCacheSize          = "memiavl.cache-size"
+)
+
+// VersionDB flags
+const (
+	VersionDBEnable = "versiondb.enable"
+)
+
 // TLS flags
 const (
 	TLSCertPath = "tls.certificate-path"
@@ -17,11 +17,12 @@ import (
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|logs]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
+		- logs: backfill the log index and the cron executions of the blocks indexed before they were introduced, from the first indexed block to the latest indexed block.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
@@ -35,8 +36,8 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			if direction != "backward" && direction != "forward" && direction != "logs" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward|logs, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
@@ -73,7 +74,7 @@ func NewIndexTxCmd() *cobra.Command {
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+				if err := idxer.IndexBlock(blk, resBlk.TxResults, resBlk.Events); err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -109,6 +110,31 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "logs":
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				latest, err := idxer.LastIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if first == -1 {
+					// nothing indexed yet, the other directions index the logs too
+					return nil
+				}
+				for i := max(first, blockStore.Base()); i <= latest; i++ {
+					indexed, err := idxer.HasBlockLogs(i)
+					if err != nil {
+						return err
+					}
+					if indexed {
+						continue
+					}
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
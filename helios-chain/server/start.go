@@ -52,9 +52,11 @@ import (
 
 	"helios-core/cmd/heliades/opendb"
 	"helios-core/helios-chain/indexer"
+	"helios-core/helios-chain/memiavl"
 	ethdebug "helios-core/helios-chain/rpc/namespaces/ethereum/debug"
 	"helios-core/helios-chain/server/config"
 	srvflags "helios-core/helios-chain/server/flags"
+	memiavlcfg "helios-core/helios-chain/store/config"
 	evmostypes "helios-core/helios-chain/types"
 )
 
@@ -210,6 +212,14 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
 
+	cmd.Flags().Bool(srvflags.MemIAVLEnable, config.DefaultMemIAVLEnable, "Define if memiavl should be used as the application state store")
+	cmd.Flags().Bool(srvflags.MemIAVLZeroCopy, config.DefaultZeroCopy, "Define if memiavl should return slices pointing to the mmap-ed snapshot files directly")
+	cmd.Flags().Int(srvflags.MemIAVLAsyncCommitBuffer, config.DefaultAsyncCommitBuffer, "Sets the size of the memiavl asynchronous commit queue (-1=synchronous commit)")
+	cmd.Flags().Uint32(srvflags.MemIAVLSnapshotKeepRecent, config.DefaultSnapshotKeepRecent, "Sets the number of old memiavl snapshots to keep")
+	cmd.Flags().Uint32(srvflags.MemIAVLSnapshotInterval, memiavl.DefaultSnapshotInterval, "Sets the block interval at which memiavl snapshots are taken")
+	cmd.Flags().Int(srvflags.MemIAVLCacheSize, memiavlcfg.DefaultCacheSize, "Sets the size of the cache of each memiavl store")
+	cmd.Flags().Bool(srvflags.VersionDBEnable, config.DefaultVersionDBEnable, "Define if versiondb should be used to serve the historical queries (requires a rocksdb build)")
+
 	cmd.Flags().Bool(srvflags.NotifierEnable, false, "Enable the notifier")
 	cmd.Flags().String(srvflags.NotifierURL, "https://network.helioschainlabs.org/", "The URL of the notifier")
 
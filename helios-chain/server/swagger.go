@@ -134,6 +134,8 @@ func getDefaultForType(methodName string, kind reflect.Kind, t reflect.Value) (s
 		return "rpctypes.WhitelistedAssetRPC", rpctypes.WhitelistedAssetRPC{
 			Denom:                         "default_denom",
 			BaseWeight:                    0,
+			EffectiveWeight:               0,
+			MaxPowerShare:                 "",
 			ChainId:                       "default_chain_id",
 			ChainName:                     "default_chain_name",
 			Decimals:                      0,
@@ -0,0 +1,241 @@
+package memiavlstore
+
+import (
+	"fmt"
+	"io"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/cachekv"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	ics23 "github.com/cosmos/ics23/go"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"helios-core/helios-chain/memiavl"
+)
+
+var (
+	_ types.CommitKVStore = (*Store)(nil)
+	_ types.Queryable     = (*Store)(nil)
+)
+
+// Store implements types.KVStore and types.CommitKVStore on top of a memiavl tree.
+//
+// Writes are buffered into a change set, which is applied to the tree by the root
+// multistore when the block is committed.
+type Store struct {
+	tree   *memiavl.Tree
+	logger log.Logger
+
+	// db is only set for stores opened on a read-only historical db, it keeps the
+	// db alive for as long as the store or one of its iterators is reachable.
+	db *memiavl.DB
+
+	changeSet memiavl.ChangeSet
+}
+
+// New returns a store backed by the given tree.
+func New(tree *memiavl.Tree, logger log.Logger) *Store {
+	return &Store{tree: tree, logger: logger}
+}
+
+// NewReadOnly returns a store backed by the tree of a read-only historical db,
+// the db is pinned by the store and its iterators.
+func NewReadOnly(db *memiavl.DB, tree *memiavl.Tree, logger log.Logger) *Store {
+	return &Store{tree: tree, logger: logger, db: db}
+}
+
+// Commit panics, the trees are committed together by the root multistore.
+func (st *Store) Commit() types.CommitID {
+	panic("memiavl store is not supposed to be committed alone")
+}
+
+// LastCommitID implements types.Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	return types.CommitID{
+		Version: st.tree.Version(),
+		Hash:    st.tree.RootHash(),
+	}
+}
+
+// WorkingHash implements types.Committer, the pending change set is only applied
+// by the root multistore, so it returns the hash of the last applied state.
+func (st *Store) WorkingHash() []byte {
+	return st.tree.RootHash()
+}
+
+// SetPruning is a no-op, memiavl manages the retention through its snapshots.
+func (st *Store) SetPruning(_ pruningtypes.PruningOptions) {}
+
+// GetPruning implements types.Committer.
+func (st *Store) GetPruning() pruningtypes.PruningOptions {
+	return pruningtypes.NewPruningOptions(pruningtypes.PruningDefault)
+}
+
+// GetStoreType implements types.Store.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeIAVL
+}
+
+// CacheWrap implements types.Store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements types.Store.
+func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Set implements types.KVStore.
+//
+// The write is only visible after the block is committed, it's only expected to be
+// called when the cache stores are written back at the end of the block.
+func (st *Store) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	st.changeSet.Pairs = append(st.changeSet.Pairs, &memiavl.KVPair{
+		Key: key, Value: value,
+	})
+}
+
+// Get implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	return st.tree.Get(key)
+}
+
+// Has implements types.KVStore.
+func (st *Store) Has(key []byte) bool {
+	return st.tree.Has(key)
+}
+
+// Delete implements types.KVStore.
+//
+// Like Set, the deletion is only visible after the block is committed.
+func (st *Store) Delete(key []byte) {
+	types.AssertValidKey(key)
+	st.changeSet.Pairs = append(st.changeSet.Pairs, &memiavl.KVPair{
+		Key: key, Delete: true,
+	})
+}
+
+// Iterator implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	return st.iterator(start, end, true)
+}
+
+// ReverseIterator implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return st.iterator(start, end, false)
+}
+
+func (st *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+	iter := st.tree.Iterator(start, end, ascending)
+	if st.db == nil {
+		return iter
+	}
+	return &pinnedIterator{Iterator: iter, store: st}
+}
+
+// PopChangeSet returns the pending change set and resets it.
+func (st *Store) PopChangeSet() memiavl.ChangeSet {
+	cs := st.changeSet
+	st.changeSet = memiavl.ChangeSet{}
+	return cs
+}
+
+// Query implements types.Queryable, it supports the same paths as the iavl store.
+func (st *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
+	if len(req.Data) == 0 {
+		return &types.ResponseQuery{}, errors.Wrap(types.ErrTxDecode, "query cannot be zero length")
+	}
+
+	res := &types.ResponseQuery{
+		Height: st.tree.Version(),
+	}
+
+	switch req.Path {
+	case "/key": // get by key
+		key := req.Data // data holds the key bytes
+
+		res.Key = key
+		res.Value = st.tree.Get(key)
+		if !req.Prove {
+			break
+		}
+
+		proof, err := getProofFromTree(st.tree, key, res.Value != nil)
+		if err != nil {
+			return &types.ResponseQuery{}, err
+		}
+		res.ProofOps = proof
+
+	case "/subspace":
+		subspace := req.Data
+		res.Key = subspace
+
+		var bz []byte
+		iterator := types.KVStorePrefixIterator(st, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			bz = appendPair(bz, iterator.Key(), iterator.Value())
+		}
+		if err := iterator.Close(); err != nil {
+			return &types.ResponseQuery{}, fmt.Errorf("failed to close iterator: %w", err)
+		}
+
+		res.Value = bz
+
+	default:
+		return &types.ResponseQuery{}, errors.Wrapf(types.ErrUnknownRequest, "unexpected query path: %v", req.Path)
+	}
+
+	return res, nil
+}
+
+// getProofFromTree returns the existence or absence proof of the key as merkle proof ops.
+func getProofFromTree(tree *memiavl.Tree, key []byte, exists bool) (*crypto.ProofOps, error) {
+	var (
+		commitmentProof *ics23.CommitmentProof
+		err             error
+	)
+
+	if exists {
+		commitmentProof, err = tree.GetMembershipProof(key)
+	} else {
+		commitmentProof, err = tree.GetNonMembershipProof(key)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidRequest, "failed to build proof: %s", err)
+	}
+
+	op := types.NewIavlCommitmentOp(key, commitmentProof)
+	return &crypto.ProofOps{Ops: []crypto.ProofOp{op.ProofOp()}}, nil
+}
+
+// appendPair appends the key/value pair as an entry of the `Pairs` message the iavl
+// store returns for subspace queries (cosmos.store.internal.kv.v1beta1), which is
+// not importable outside of the store module.
+func appendPair(bz, key, value []byte) []byte {
+	var pair []byte
+	if len(key) > 0 {
+		pair = protowire.AppendTag(pair, 1, protowire.BytesType)
+		pair = protowire.AppendBytes(pair, key)
+	}
+	if len(value) > 0 {
+		pair = protowire.AppendTag(pair, 2, protowire.BytesType)
+		pair = protowire.AppendBytes(pair, value)
+	}
+
+	bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+	return protowire.AppendBytes(bz, pair)
+}
+
+// pinnedIterator keeps the store, and thus the historical db backing it, alive until
+// the iterator itself is released.
+type pinnedIterator struct {
+	*memiavl.Iterator
+	store *Store
+}
@@ -0,0 +1,152 @@
+package rootmulti
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/types"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"helios-core/helios-chain/memiavl"
+)
+
+// Snapshot implements snapshottypes.Snapshotter. The items are streamed in the same
+// format as the sdk rootmulti store, so the snapshots can be restored by either store.
+func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) (returnErr error) {
+	if height == 0 {
+		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > math.MaxUint32 {
+		return fmt.Errorf("height overflows uint32: %d", height)
+	}
+
+	// the state sync interval doesn't have to be aligned with the memiavl snapshots,
+	// so the exporter replays the WAL up to the height when needed.
+	exporter, err := memiavl.NewMultiTreeExporter(rs.dir, uint32(height), true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		returnErr = errors.Join(returnErr, exporter.Close())
+	}()
+
+	for {
+		item, err := exporter.Next()
+		if err != nil {
+			if errors.Is(err, memiavl.ErrorExportDone) {
+				break
+			}
+			return err
+		}
+
+		switch item := item.(type) {
+		case *memiavl.ExportNode:
+			if err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_IAVL{
+					IAVL: &snapshottypes.SnapshotIAVLItem{
+						Key:     item.Key,
+						Value:   item.Value,
+						Height:  int32(item.Height),
+						Version: item.Version,
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		case string:
+			if err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_Store{
+					Store: &snapshottypes.SnapshotStoreItem{
+						Name: item,
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown item type %T", item)
+		}
+	}
+
+	return nil
+}
+
+// Restore implements snapshottypes.Snapshotter, it imports the snapshot as a new
+// memiavl snapshot and reloads the store on top of it.
+func (rs *Store) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if err := rs.Close(); err != nil {
+		return snapshottypes.SnapshotItem{}, fmt.Errorf("failed to close db: %w", err)
+	}
+	rs.db = nil
+
+	item, err := rs.restore(height, protoReader)
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	return item, rs.LoadLatestVersion()
+}
+
+func (rs *Store) restore(height uint64, protoReader protoio.Reader) (_ snapshottypes.SnapshotItem, returnErr error) {
+	importer, err := memiavl.NewMultiTreeImporter(rs.dir, height)
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	defer func() {
+		returnErr = errors.Join(returnErr, importer.Close())
+	}()
+
+	var snapshotItem snapshottypes.SnapshotItem
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if err := importer.AddTree(item.Store.Name); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+		case *snapshottypes.SnapshotItem_IAVL:
+			if item.IAVL.Height > math.MaxInt8 {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8)
+			}
+			node := &memiavl.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Height:  int8(item.IAVL.Height),
+				Version: item.IAVL.Version,
+			}
+			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+			importer.AddNode(node)
+		default:
+			// unknown element, could be an extension
+			break loop
+		}
+	}
+
+	if err := importer.Finalize(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	return snapshotItem, nil
+}
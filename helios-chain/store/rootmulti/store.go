@@ -0,0 +1,663 @@
+package rootmulti
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/listenkv"
+	"cosmossdk.io/store/mem"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	sdkrootmulti "cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/transient"
+	"cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"helios-core/helios-chain/memiavl"
+	"helios-core/helios-chain/store/memiavlstore"
+)
+
+// baseVersionKey is the application db key the sdk rootmulti store uses to track the
+// lowest retained version, baseapp reads it to pace the pruning of the archive stores.
+const baseVersionKey = "s/base"
+
+var (
+	_ types.CommitMultiStore = (*Store)(nil)
+	_ types.Queryable        = (*Store)(nil)
+)
+
+// Store is a CommitMultiStore backed by memiavl.
+//
+// The IAVL stores are the trees of a single memiavl.DB, which keeps its own WAL and
+// snapshots, so the versions are retained according to the memiavl snapshot settings
+// rather than the sdk pruning options. Transient and memory stores behave as in the
+// sdk rootmulti store.
+type Store struct {
+	dir    string
+	db     *memiavl.DB
+	appDB  dbm.DB
+	logger log.Logger
+	opts   memiavl.Options
+
+	lastCommitInfo *types.CommitInfo
+
+	storesParams map[types.StoreKey]storeParams
+	keysByName   map[string]types.StoreKey
+
+	// mtx guards stores, the memiavl trees might be swapped by a background snapshot
+	// rewrite, the store references are refreshed after each commit.
+	mtx    sync.RWMutex
+	stores map[types.StoreKey]types.CommitKVStore
+
+	listeners map[types.StoreKey]*types.MemoryListener
+}
+
+type storeParams struct {
+	key types.StoreKey
+	typ types.StoreType
+}
+
+// NewStore returns a memiavl backed multistore rooted at dir. The application db is
+// only used to record the base version consumed by the baseapp pruner.
+func NewStore(dir string, appDB dbm.DB, logger log.Logger, opts memiavl.Options) *Store {
+	return &Store{
+		dir:    dir,
+		appDB:  appDB,
+		logger: logger,
+		opts:   opts,
+
+		storesParams: make(map[types.StoreKey]storeParams),
+		keysByName:   make(map[string]types.StoreKey),
+		stores:       make(map[types.StoreKey]types.CommitKVStore),
+		listeners:    make(map[types.StoreKey]*types.MemoryListener),
+	}
+}
+
+// flush applies the pending change sets of the memiavl stores to the trees.
+func (rs *Store) flush() error {
+	var changeSets []*memiavl.NamedChangeSet
+	for key, store := range rs.stores {
+		memiavlStore, ok := store.(*memiavlstore.Store)
+		if !ok {
+			continue
+		}
+		cs := memiavlStore.PopChangeSet()
+		if len(cs.Pairs) > 0 {
+			changeSets = append(changeSets, &memiavl.NamedChangeSet{
+				Name:      key.Name(),
+				Changeset: cs,
+			})
+		}
+	}
+	sort.SliceStable(changeSets, func(i, j int) bool {
+		return changeSets[i].Name < changeSets[j].Name
+	})
+
+	return rs.db.ApplyChangeSets(changeSets)
+}
+
+// WorkingHash implements types.Committer.
+func (rs *Store) WorkingHash() []byte {
+	if err := rs.flush(); err != nil {
+		panic(err)
+	}
+	return convertCommitInfo(rs.db.WorkingCommitInfo()).Hash()
+}
+
+// Commit implements types.Committer.
+func (rs *Store) Commit() types.CommitID {
+	if err := rs.flush(); err != nil {
+		panic(err)
+	}
+
+	for _, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			_ = store.Commit()
+		}
+	}
+
+	if _, err := rs.db.Commit(); err != nil {
+		panic(err)
+	}
+
+	// the trees are replaced when a background snapshot rewrite completes
+	stores := make(map[types.StoreKey]types.CommitKVStore, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			stores[key] = store
+			continue
+		}
+
+		var err error
+		stores[key], err = rs.loadCommitStoreFromParams(rs.db, key, rs.storesParams[key])
+		if err != nil {
+			panic(fmt.Errorf("inconsistent store map, store %s not found", key.Name()))
+		}
+	}
+
+	lastCommitInfo := convertCommitInfo(rs.db.LastCommitInfo())
+
+	rs.mtx.Lock()
+	rs.stores = stores
+	rs.lastCommitInfo = lastCommitInfo
+	rs.mtx.Unlock()
+
+	return lastCommitInfo.CommitID()
+}
+
+// Close waits for the pending async commits and releases the memiavl db.
+func (rs *Store) Close() error {
+	if rs.db == nil {
+		return nil
+	}
+	return rs.db.Close()
+}
+
+// LastCommitID implements types.Committer.
+func (rs *Store) LastCommitID() types.CommitID {
+	rs.mtx.RLock()
+	lastCommitInfo := rs.lastCommitInfo
+	rs.mtx.RUnlock()
+
+	if lastCommitInfo == nil {
+		v, err := memiavl.GetLatestVersion(rs.dir)
+		if err != nil {
+			panic(fmt.Errorf("failed to get latest version: %w", err))
+		}
+		return types.CommitInfo{Version: v}.CommitID()
+	}
+
+	return lastCommitInfo.CommitID()
+}
+
+// SetPruning is a no-op, memiavl retains the versions covered by its snapshots.
+func (rs *Store) SetPruning(pruningtypes.PruningOptions) {}
+
+// GetPruning implements types.Committer.
+func (rs *Store) GetPruning() pruningtypes.PruningOptions {
+	return pruningtypes.NewPruningOptions(pruningtypes.PruningDefault)
+}
+
+// SetMetrics is a no-op, memiavl doesn't report store metrics.
+func (rs *Store) SetMetrics(metrics.StoreMetrics) {}
+
+// SetCommitSync is a no-op, the WAL durability is driven by the async commit buffer.
+func (rs *Store) SetCommitSync(bool) {}
+
+// GetCommitSync implements types.CommitMultiStore.
+func (rs *Store) GetCommitSync() bool {
+	return rs.opts.AsyncCommitBuffer < 0
+}
+
+// GetStoreType implements types.Store.
+func (rs *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeMulti
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (rs *Store) CacheWrap() types.CacheWrap {
+	return rs.CacheMultiStore().(types.CacheWrap)
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper.
+func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return rs.CacheWrap()
+}
+
+// CacheMultiStore implements types.MultiStore.
+func (rs *Store) CacheMultiStore() types.CacheMultiStore {
+	rs.mtx.RLock()
+	defer rs.mtx.RUnlock()
+
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(rs.stores))
+	for k, v := range rs.stores {
+		store := types.KVStore(v)
+		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
+		// set same listeners on cache store will observe duplicated writes.
+		if rs.ListeningEnabled(k) {
+			store = listenkv.NewStore(store, k, rs.listeners[k])
+		}
+		stores[k] = store
+	}
+	return cachemulti.NewStore(nil, stores, rs.keysByName, nil, nil)
+}
+
+// CacheMultiStoreWithVersion implements types.MultiStore, it's used by the queries
+// against historical heights.
+func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	if version == 0 || version == rs.LatestVersion() {
+		return rs.CacheMultiStore(), nil
+	}
+
+	db, err := rs.loadReadOnly(version)
+	if err != nil {
+		return nil, err
+	}
+	// the stores pin the db, release the mmap-ed snapshot once they are all collected
+	runtime.SetFinalizer(db, func(db *memiavl.DB) { _ = db.Close() })
+
+	rs.mtx.RLock()
+	defer rs.mtx.RUnlock()
+
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(rs.storesParams))
+	for k, params := range rs.storesParams {
+		if params.typ != types.StoreTypeIAVL {
+			// transient and memory stores are shared with the latest version
+			stores[k] = rs.stores[k]
+			continue
+		}
+
+		tree := db.TreeByName(k.Name())
+		if tree == nil {
+			// the store might not exist at that version
+			continue
+		}
+		stores[k] = memiavlstore.NewReadOnly(db, tree, rs.logger)
+	}
+
+	return cachemulti.NewStore(nil, stores, rs.keysByName, nil, nil), nil
+}
+
+// loadReadOnly opens the db at a past version, the returned values are always copied
+// out of the mmap-ed files so they outlive the db.
+func (rs *Store) loadReadOnly(version int64) (*memiavl.DB, error) {
+	if version < 0 || version > math.MaxUint32 {
+		return nil, fmt.Errorf("version overflows uint32: %d", version)
+	}
+
+	return memiavl.Load(rs.dir, memiavl.Options{
+		Logger:        rs.opts.Logger,
+		TargetVersion: uint32(version),
+		ReadOnly:      true,
+	})
+}
+
+// GetStore implements types.MultiStore.
+func (rs *Store) GetStore(key types.StoreKey) types.Store {
+	return rs.GetCommitKVStore(key)
+}
+
+// GetKVStore implements types.MultiStore.
+func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
+	s := rs.GetCommitKVStore(key)
+	if s == nil {
+		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
+	}
+	return s
+}
+
+// TracingEnabled implements types.MultiStore, tracing is not supported.
+func (rs *Store) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements types.MultiStore, tracing is not supported.
+func (rs *Store) SetTracer(io.Writer) types.MultiStore {
+	return rs
+}
+
+// SetTracingContext implements types.MultiStore, tracing is not supported.
+func (rs *Store) SetTracingContext(types.TraceContext) types.MultiStore {
+	return rs
+}
+
+// LatestVersion implements types.MultiStore.
+func (rs *Store) LatestVersion() int64 {
+	return rs.LastCommitID().Version
+}
+
+// PruneSnapshotHeight is a no-op, memiavl manages its own snapshot retention.
+func (rs *Store) PruneSnapshotHeight(int64) {}
+
+// SetSnapshotInterval is a no-op, memiavl manages its own snapshot retention.
+func (rs *Store) SetSnapshotInterval(uint64) {}
+
+// MountStoreWithDB implements types.CommitMultiStore, the db argument is ignored since
+// all the IAVL stores live in the memiavl db.
+func (rs *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, _ dbm.DB) {
+	if key == nil {
+		panic("MountStoreWithDB() key cannot be nil")
+	}
+	if _, ok := rs.storesParams[key]; ok {
+		panic(fmt.Sprintf("store duplicate store key %v", key))
+	}
+	if _, ok := rs.keysByName[key.Name()]; ok {
+		panic(fmt.Sprintf("store duplicate store key name %v", key))
+	}
+	rs.storesParams[key] = storeParams{key: key, typ: typ}
+	rs.keysByName[key.Name()] = key
+}
+
+// GetCommitStore implements types.CommitMultiStore.
+func (rs *Store) GetCommitStore(key types.StoreKey) types.CommitStore {
+	return rs.GetCommitKVStore(key)
+}
+
+// GetCommitKVStore implements types.CommitMultiStore.
+func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
+	rs.mtx.RLock()
+	defer rs.mtx.RUnlock()
+
+	return rs.stores[key]
+}
+
+// GetStoreByName returns the store mounted under name, or nil.
+func (rs *Store) GetStoreByName(name string) types.Store {
+	key := rs.keysByName[name]
+	if key == nil {
+		return nil
+	}
+	return rs.GetCommitKVStore(key)
+}
+
+// LoadLatestVersion implements types.CommitMultiStore.
+func (rs *Store) LoadLatestVersion() error {
+	return rs.LoadVersionAndUpgrade(0, nil)
+}
+
+// LoadLatestVersionAndUpgrade implements types.CommitMultiStore.
+func (rs *Store) LoadLatestVersionAndUpgrade(upgrades *types.StoreUpgrades) error {
+	return rs.LoadVersionAndUpgrade(0, upgrades)
+}
+
+// LoadVersion implements types.CommitMultiStore.
+func (rs *Store) LoadVersion(ver int64) error {
+	return rs.LoadVersionAndUpgrade(ver, nil)
+}
+
+// LoadVersionAndUpgrade implements types.CommitMultiStore, version 0 means the latest
+// version.
+func (rs *Store) LoadVersionAndUpgrade(version int64, upgrades *types.StoreUpgrades) error {
+	if version < 0 || version > math.MaxUint32 {
+		return fmt.Errorf("version overflows uint32: %d", version)
+	}
+
+	storesKeys := make([]types.StoreKey, 0, len(rs.storesParams))
+	for key := range rs.storesParams {
+		storesKeys = append(storesKeys, key)
+	}
+	// deterministic iteration order for upgrades
+	sort.Slice(storesKeys, func(i, j int) bool {
+		return storesKeys[i].Name() < storesKeys[j].Name()
+	})
+
+	initialStores := make([]string, 0, len(storesKeys))
+	for _, key := range storesKeys {
+		if rs.storesParams[key].typ == types.StoreTypeIAVL {
+			initialStores = append(initialStores, key.Name())
+		}
+	}
+
+	// the db holds an exclusive lock on the directory
+	if err := rs.Close(); err != nil {
+		return err
+	}
+	rs.db = nil
+
+	opts := rs.opts
+	opts.CreateIfMissing = true
+	opts.InitialStores = initialStores
+	opts.TargetVersion = uint32(version)
+	db, err := memiavl.Load(rs.dir, opts)
+	if err != nil {
+		return errorsmod.Wrapf(err, "fail to load memiavl at %s", rs.dir)
+	}
+
+	var treeUpgrades []*memiavl.TreeNameUpgrade
+	for _, key := range storesKeys {
+		if rs.storesParams[key].typ != types.StoreTypeIAVL {
+			continue
+		}
+		switch {
+		case upgrades.IsDeleted(key.Name()):
+			treeUpgrades = append(treeUpgrades, &memiavl.TreeNameUpgrade{Name: key.Name(), Delete: true})
+		case upgrades.IsAdded(key.Name()) || upgrades.RenamedFrom(key.Name()) != "":
+			treeUpgrades = append(treeUpgrades, &memiavl.TreeNameUpgrade{Name: key.Name(), RenameFrom: upgrades.RenamedFrom(key.Name())})
+		}
+	}
+
+	if len(treeUpgrades) > 0 {
+		if err := db.ApplyUpgrades(treeUpgrades); err != nil {
+			return errors.Join(err, db.Close())
+		}
+	}
+
+	stores := make(map[types.StoreKey]types.CommitKVStore, len(storesKeys))
+	for _, key := range storesKeys {
+		if upgrades.IsDeleted(key.Name()) {
+			continue
+		}
+		stores[key], err = rs.loadCommitStoreFromParams(db, key, rs.storesParams[key])
+		if err != nil {
+			return errors.Join(err, db.Close())
+		}
+	}
+
+	rs.db = db
+	rs.mtx.Lock()
+	rs.stores = stores
+	rs.lastCommitInfo = convertCommitInfo(db.LastCommitInfo())
+	rs.mtx.Unlock()
+
+	return nil
+}
+
+func (rs *Store) loadCommitStoreFromParams(db *memiavl.DB, key types.StoreKey, params storeParams) (types.CommitKVStore, error) {
+	switch params.typ {
+	case types.StoreTypeIAVL:
+		tree := db.TreeByName(key.Name())
+		if tree == nil {
+			return nil, fmt.Errorf("new store is not added in upgrades: %s", key.Name())
+		}
+		return memiavlstore.New(tree, rs.logger), nil
+
+	case types.StoreTypeTransient:
+		if _, ok := key.(*types.TransientStoreKey); !ok {
+			return nil, fmt.Errorf("invalid StoreKey for StoreTypeTransient: %s", key.String())
+		}
+		return transient.NewStore(), nil
+
+	case types.StoreTypeMemory:
+		if _, ok := key.(*types.MemoryStoreKey); !ok {
+			return nil, fmt.Errorf("unexpected key type for a MemoryStoreKey; got: %s", key.String())
+		}
+		return mem.NewStore(), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported store type %v for memiavl: %s", params.typ, key.Name())
+	}
+}
+
+// SetInterBlockCache is a no-op, memiavl maintains its own cache.
+func (rs *Store) SetInterBlockCache(types.MultiStorePersistentCache) {}
+
+// SetInitialVersion implements types.CommitMultiStore.
+func (rs *Store) SetInitialVersion(version int64) error {
+	return rs.db.SetInitialVersion(version)
+}
+
+// SetIAVLCacheSize is a no-op, the cache size is set through the memiavl options.
+func (rs *Store) SetIAVLCacheSize(int) {}
+
+// SetIAVLDisableFastNode is a no-op, memiavl has no fast node index.
+func (rs *Store) SetIAVLDisableFastNode(bool) {}
+
+// RollbackToVersion truncates the versions after target and reloads the store.
+func (rs *Store) RollbackToVersion(target int64) error {
+	if target <= 0 || target > math.MaxUint32 {
+		return fmt.Errorf("invalid rollback height target: %d", target)
+	}
+
+	if err := rs.Close(); err != nil {
+		return err
+	}
+	rs.db = nil
+
+	opts := rs.opts
+	opts.TargetVersion = uint32(target)
+	opts.LoadForOverwriting = true
+	db, err := memiavl.Load(rs.dir, opts)
+	if err != nil {
+		return err
+	}
+	if err := db.Close(); err != nil {
+		return err
+	}
+
+	return rs.LoadLatestVersion()
+}
+
+// PruneVersion is a no-op, the old versions are dropped with the memiavl snapshots.
+func (rs *Store) PruneVersion(int64) error {
+	return nil
+}
+
+// DeleteVersions is a no-op, the old versions are dropped with the memiavl snapshots.
+func (rs *Store) DeleteVersions([]int64) error {
+	return nil
+}
+
+// DeleteVersionsRange is a no-op, the old versions are dropped with the memiavl snapshots.
+func (rs *Store) DeleteVersionsRange(_, _ int64) error {
+	return nil
+}
+
+// DeleteFromBaseVersionTo only moves the base version forward, so that the baseapp
+// pruner keeps pruning the archive stores at the same pace as with the sdk store.
+func (rs *Store) DeleteFromBaseVersionTo(version int64) error {
+	if version <= 0 {
+		return fmt.Errorf("invalid version to delete: %d", version)
+	}
+
+	bz, err := gogotypes.StdInt64Marshal(version)
+	if err != nil {
+		return err
+	}
+	return rs.appDB.SetSync([]byte(baseVersionKey), bz)
+}
+
+// ListeningEnabled implements types.CommitMultiStore.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	if ls, ok := rs.listeners[key]; ok {
+		return ls != nil
+	}
+	return false
+}
+
+// AddListeners implements types.CommitMultiStore.
+func (rs *Store) AddListeners(keys []types.StoreKey) {
+	for i := range keys {
+		if rs.listeners[keys[i]] == nil {
+			rs.listeners[keys[i]] = types.NewMemoryListener()
+		}
+	}
+}
+
+// PopStateCache implements types.CommitMultiStore.
+func (rs *Store) PopStateCache() []*types.StoreKVPair {
+	var cache []*types.StoreKVPair
+	for _, ls := range rs.listeners {
+		if ls != nil {
+			cache = append(cache, ls.PopStateCache()...)
+		}
+	}
+	sort.SliceStable(cache, func(i, j int) bool {
+		return cache[i].StoreKey < cache[j].StoreKey
+	})
+	return cache
+}
+
+// Query implements types.Queryable, it serves the same paths as the sdk rootmulti store.
+func (rs *Store) Query(req *types.RequestQuery) (*types.ResponseQuery, error) {
+	storeName, subpath, err := parsePath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	version := req.Height
+	if version == 0 {
+		version = rs.LatestVersion()
+	}
+
+	db := rs.db
+	if version != rs.LatestVersion() {
+		db, err = rs.loadReadOnly(version)
+		if err != nil {
+			return nil, err
+		}
+		defer db.Close()
+	}
+
+	tree := db.TreeByName(storeName)
+	if tree == nil {
+		return nil, errorsmod.Wrapf(types.ErrUnknownRequest, "no such store: %s", storeName)
+	}
+
+	// trim the path and make the query
+	req.Path = subpath
+	res, err := memiavlstore.New(tree, rs.logger).Query(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Prove || !sdkrootmulti.RequireProof(subpath) {
+		return res, nil
+	}
+
+	if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, "proof is unexpectedly empty; ensure height has not been pruned")
+	}
+
+	// restore origin path and append proof op
+	commitInfo := convertCommitInfo(db.LastCommitInfo())
+	res.ProofOps.Ops = append(res.ProofOps.Ops, commitInfo.ProofOp(storeName))
+
+	return res, nil
+}
+
+// parsePath expects a format like /<storeName>[/<subpath>]
+// Must start with /, subpath may be empty
+// Returns error if it doesn't start with /
+func parsePath(path string) (storeName, subpath string, err error) {
+	if !strings.HasPrefix(path, "/") {
+		return storeName, subpath, errorsmod.Wrapf(types.ErrUnknownRequest, "invalid path: %s", path)
+	}
+
+	paths := strings.SplitN(path[1:], "/", 2)
+	storeName = paths[0]
+
+	if len(paths) == 2 {
+		subpath = "/" + paths[1]
+	}
+
+	return storeName, subpath, nil
+}
+
+// convertCommitInfo converts the memiavl commit info, the memiavl trees are the IAVL
+// stores, which are the only ones the sdk rootmulti store includes in the app hash.
+func convertCommitInfo(commitInfo *memiavl.CommitInfo) *types.CommitInfo {
+	storeInfos := make([]types.StoreInfo, len(commitInfo.StoreInfos))
+	for i, storeInfo := range commitInfo.StoreInfos {
+		storeInfos[i] = types.StoreInfo{
+			Name: storeInfo.Name,
+			CommitId: types.CommitID{
+				Version: storeInfo.CommitId.Version,
+				Hash:    storeInfo.CommitId.Hash,
+			},
+		}
+	}
+	return &types.CommitInfo{
+		Version:    commitInfo.Version,
+		StoreInfos: storeInfos,
+	}
+}
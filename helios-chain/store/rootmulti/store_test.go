@@ -0,0 +1,140 @@
+package rootmulti
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	sdkrootmulti "cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/memiavl"
+)
+
+var (
+	keyAcc   = types.NewKVStoreKey("acc")
+	keyBank  = types.NewKVStoreKey("bank")
+	tKeyBank = types.NewTransientStoreKey("transient_bank")
+	memKey   = types.NewMemoryStoreKey("mem_capability")
+)
+
+func mountStores(cms types.CommitMultiStore) {
+	cms.MountStoreWithDB(keyAcc, types.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(keyBank, types.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(tKeyBank, types.StoreTypeTransient, nil)
+	cms.MountStoreWithDB(memKey, types.StoreTypeMemory, nil)
+}
+
+func writeBlock(cms types.CommitMultiStore, height int) {
+	cache := cms.CacheMultiStore()
+	for i := 0; i < 10; i++ {
+		cache.GetKVStore(keyAcc).Set([]byte(fmt.Sprintf("acc-%d", i)), []byte(fmt.Sprintf("v%d-%d", height, i)))
+	}
+	cache.GetKVStore(keyBank).Set([]byte(fmt.Sprintf("bank-%d", height)), []byte("balance"))
+	cache.GetKVStore(keyBank).Delete([]byte(fmt.Sprintf("bank-%d", height-1)))
+	cache.GetKVStore(tKeyBank).Set([]byte("transient"), []byte("value"))
+	cache.GetKVStore(memKey).Set([]byte("mem"), []byte("value"))
+	cache.Write()
+}
+
+func newStore(t *testing.T, dir string) *Store {
+	t.Helper()
+	store := NewStore(dir, dbm.NewMemDB(), log.NewNopLogger(), memiavl.Options{AsyncCommitBuffer: -1})
+	mountStores(store)
+	require.NoError(t, store.LoadLatestVersion())
+	return store
+}
+
+func TestAppHashMatchesRootMulti(t *testing.T) {
+	store := newStore(t, t.TempDir())
+	defer store.Close()
+
+	sdkStore := sdkrootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	mountStores(sdkStore)
+	require.NoError(t, sdkStore.LoadLatestVersion())
+
+	for height := 1; height <= 5; height++ {
+		writeBlock(store, height)
+		writeBlock(sdkStore, height)
+
+		require.Equal(t, sdkStore.WorkingHash(), store.WorkingHash())
+		require.Equal(t, sdkStore.Commit(), store.Commit())
+	}
+}
+
+func TestCacheMultiStoreWithVersion(t *testing.T) {
+	store := newStore(t, t.TempDir())
+	defer store.Close()
+
+	for height := 1; height <= 3; height++ {
+		writeBlock(store, height)
+		store.Commit()
+	}
+
+	cache, err := store.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2-0"), cache.GetKVStore(keyAcc).Get([]byte("acc-0")))
+	require.Equal(t, []byte("balance"), cache.GetKVStore(keyBank).Get([]byte("bank-2")))
+	require.Nil(t, cache.GetKVStore(keyBank).Get([]byte("bank-3")))
+
+	latest, err := store.CacheMultiStoreWithVersion(0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v3-0"), latest.GetKVStore(keyAcc).Get([]byte("acc-0")))
+
+	res, err := store.Query(&types.RequestQuery{Path: "/acc/key", Data: []byte("acc-1"), Height: 1, Prove: true})
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1-1"), res.Value)
+	require.Len(t, res.ProofOps.Ops, 2)
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	source := newStore(t, t.TempDir())
+	defer source.Close()
+
+	for height := 1; height <= 3; height++ {
+		writeBlock(source, height)
+		source.Commit()
+	}
+
+	target := newStore(t, t.TempDir())
+	defer target.Close()
+
+	sdkTarget := sdkrootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	mountStores(sdkTarget)
+	require.NoError(t, sdkTarget.LoadLatestVersion())
+
+	// the snapshot stream is compatible with the sdk rootmulti store
+	for _, cms := range []types.CommitMultiStore{target, sdkTarget} {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			defer streamWriter.Close()
+			if err := source.Snapshot(2, streamWriter); err != nil {
+				streamWriter.CloseWithError(err)
+			}
+		}()
+
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		require.NoError(t, err)
+		_, err = cms.Restore(2, snapshottypes.CurrentFormat, streamReader)
+		require.NoError(t, err)
+		require.NoError(t, streamReader.Close())
+	}
+
+	cache, err := source.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2-0"), target.GetKVStore(keyAcc).Get([]byte("acc-0")))
+	require.Equal(t, cache.GetKVStore(keyBank).Get([]byte("bank-2")), target.GetKVStore(keyBank).Get([]byte("bank-2")))
+	require.Equal(t, int64(2), target.LastCommitID().Version)
+	require.Equal(t, sdkTarget.LastCommitID(), target.LastCommitID())
+
+	// the restored store keeps committing from the snapshot height
+	writeBlock(target, 3)
+	require.Equal(t, source.LastCommitID(), target.Commit())
+}
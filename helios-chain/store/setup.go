@@ -0,0 +1,55 @@
+package store
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	"helios-core/helios-chain/memiavl"
+	srvflags "helios-core/helios-chain/server/flags"
+	"helios-core/helios-chain/store/rootmulti"
+)
+
+// MemIAVLDirName is the name of the memiavl db directory under the node data directory.
+const MemIAVLDirName = "memiavl.db"
+
+// SetupMemIAVL prepends the memiavl setter to the baseapp options when memiavl is
+// enabled, so the default commit multistore is replaced before the other options,
+// e.g. the snapshot manager, capture it.
+func SetupMemIAVL(
+	logger log.Logger,
+	homePath string,
+	db dbm.DB,
+	appOpts servertypes.AppOptions,
+	baseAppOptions []func(*baseapp.BaseApp),
+) []func(*baseapp.BaseApp) {
+	if !cast.ToBool(appOpts.Get(srvflags.MemIAVLEnable)) {
+		return baseAppOptions
+	}
+
+	opts := memiavl.Options{
+		Logger:             logger.With("module", "memiavl"),
+		AsyncCommitBuffer:  cast.ToInt(appOpts.Get(srvflags.MemIAVLAsyncCommitBuffer)),
+		ZeroCopy:           cast.ToBool(appOpts.Get(srvflags.MemIAVLZeroCopy)),
+		SnapshotKeepRecent: cast.ToUint32(appOpts.Get(srvflags.MemIAVLSnapshotKeepRecent)),
+		SnapshotInterval:   cast.ToUint32(appOpts.Get(srvflags.MemIAVLSnapshotInterval)),
+		CacheSize:          cast.ToInt(appOpts.Get(srvflags.MemIAVLCacheSize)),
+	}
+
+	if opts.ZeroCopy {
+		// the cached addresses would retain the zero-copied slices beyond the block execution
+		sdk.SetAddrCacheEnabled(false)
+	}
+
+	dir := filepath.Join(homePath, "data", MemIAVLDirName)
+	setMemIAVL := func(bapp *baseapp.BaseApp) {
+		bapp.SetCMS(rootmulti.NewStore(dir, db, logger, opts))
+	}
+
+	return append([]func(*baseapp.BaseApp){setMemIAVL}, baseAppOptions...)
+}
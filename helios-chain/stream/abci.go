@@ -1,13 +1,21 @@
 package stream
 
 import (
+	stdjson "encoding/json"
 	"fmt"
+	"strconv"
+	"time"
+
 	"helios-core/helios-chain/stream/types"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+	hyperiontypes "helios-core/helios-chain/x/hyperion/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/json"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 func ABCIToBankBalances(ev abci.Event) (messages []*types.BankBalance, err error) {
@@ -41,3 +49,161 @@ func ABCIToBankBalances(ev abci.Event) (messages []*types.BankBalance, err error
 
 	return messages, nil
 }
+
+func ABCIToEvmLogs(ev abci.Event) (messages []*evmtypes.Log, err error) {
+	if Topic(ev.Type) != EvmLogs {
+		return nil, fmt.Errorf("unexpected topic: %s", ev.Type)
+	}
+
+	for _, attr := range ev.Attributes {
+		if attr.Key != evmtypes.AttributeKeyTxLog {
+			continue
+		}
+		var log evmtypes.Log
+		// the logs are encoded with encoding/json by the evm module
+		if err := stdjson.Unmarshal([]byte(attr.Value), &log); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal ABCI event to EvmLog: %w", err)
+		}
+		messages = append(messages, &log)
+	}
+
+	return messages, nil
+}
+
+func ABCIToStakingDelegation(ev abci.Event) (*types.StakingDelegation, error) {
+	switch Topic(ev.Type) {
+	case StakingDelegate, StakingUnbond, StakingCancelUnbonding:
+	default:
+		return nil, fmt.Errorf("unexpected topic: %s", ev.Type)
+	}
+
+	delegation := &types.StakingDelegation{Type: ev.Type}
+	for _, attr := range ev.Attributes {
+		switch attr.Key {
+		case stakingtypes.AttributeKeyDelegator:
+			delegation.Delegator = attr.Value
+		case stakingtypes.AttributeKeyValidator:
+			delegation.Validator = attr.Value
+		case sdk.AttributeKeyAmount:
+			amount, err := sdk.ParseCoinNormalized(attr.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the amount of the ABCI event to StakingDelegation: %w", err)
+			}
+			delegation.Amount = amount
+		case stakingtypes.AttributeKeyCompletionTime:
+			completionTime, err := time.Parse(time.RFC3339, attr.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the completion time of the ABCI event to StakingDelegation: %w", err)
+			}
+			delegation.CompletionTime = completionTime.UnixMilli()
+		}
+	}
+
+	return delegation, nil
+}
+
+func ABCIToHyperionDeposit(ev abci.Event) (*types.HyperionDeposit, error) {
+	if Topic(ev.Type) != HyperionDeposits {
+		return nil, fmt.Errorf("unexpected topic: %s", ev.Type)
+	}
+
+	msg, err := parseTypedEvent(ev)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ABCI event to HyperionDeposit: %w", err)
+	}
+	deposit := msg.(*hyperiontypes.EventDepositReceived)
+
+	return &types.HyperionDeposit{
+		HyperionId:          deposit.HyperionId,
+		EventNonce:          deposit.EventNonce,
+		Sender:              deposit.EthereumSender,
+		Receiver:            deposit.CosmosReceiver,
+		TokenContract:       deposit.TokenContract,
+		Amount:              deposit.Amount,
+		SentToCommunityPool: deposit.SentToCommunityPool,
+	}, nil
+}
+
+func ABCIToHyperionWithdrawal(ev abci.Event) (*types.HyperionWithdrawal, error) {
+	if Topic(ev.Type) != HyperionWithdrawals {
+		return nil, fmt.Errorf("unexpected topic: %s", ev.Type)
+	}
+
+	msg, err := parseTypedEvent(ev)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ABCI event to HyperionWithdrawal: %w", err)
+	}
+	withdrawal := msg.(*hyperiontypes.EventSendToChain)
+
+	return &types.HyperionWithdrawal{
+		HyperionId:   withdrawal.HyperionId,
+		OutgoingTxId: withdrawal.OutgoingTxId,
+		Sender:       withdrawal.Sender,
+		Receiver:     withdrawal.Receiver,
+		Amount:       withdrawal.Amount,
+		BridgeFee:    withdrawal.BridgeFee,
+	}, nil
+}
+
+func ABCIToHyperionBatchExecution(ev abci.Event) (*types.HyperionBatchExecution, error) {
+	if Topic(ev.Type) != HyperionBatchExecutions {
+		return nil, fmt.Errorf("unexpected topic: %s", ev.Type)
+	}
+
+	msg, err := parseTypedEvent(ev)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ABCI event to HyperionBatchExecution: %w", err)
+	}
+	batch := msg.(*hyperiontypes.EventOutgoingBatchExecuted)
+
+	return &types.HyperionBatchExecution{
+		HyperionId:    batch.HyperionId,
+		TokenContract: batch.TokenContract,
+		BatchNonce:    batch.BatchNonce,
+		TxIds:         batch.BatchTxIds,
+		Orchestrator:  batch.OrchestratorAddress,
+	}, nil
+}
+
+func ABCIToCronExecution(ev abci.Event) (execution *types.CronExecution, err error) {
+	if Topic(ev.Type) != CronExecutions {
+		return nil, fmt.Errorf("unexpected topic: %s", ev.Type)
+	}
+
+	execution = &types.CronExecution{}
+	for _, attr := range ev.Attributes {
+		switch attr.Key {
+		case "cron_id":
+			execution.CronId, err = strconv.ParseUint(attr.Value, 10, 64)
+		case "owner_address":
+			execution.Owner = attr.Value
+		case "cron_address":
+			execution.CronAddress = attr.Value
+		case "tx_hash":
+			execution.TxHash = attr.Value
+		case "nonce":
+			execution.Nonce, err = strconv.ParseUint(attr.Value, 10, 64)
+		case "gas_used":
+			execution.GasUsed, err = strconv.ParseUint(attr.Value, 10, 64)
+		case "error":
+			execution.Error = attr.Value
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s of the ABCI event to CronExecution: %w", attr.Key, err)
+		}
+	}
+
+	return execution, nil
+}
+
+// parseTypedEvent parses a typed event without the mode attribute added by the
+// BeginBlock and EndBlock, its value isn't JSON.
+func parseTypedEvent(ev abci.Event) (proto.Message, error) {
+	attributes := make([]abci.EventAttribute, 0, len(ev.Attributes))
+	for _, attr := range ev.Attributes {
+		if attr.Key != "mode" {
+			attributes = append(attributes, attr)
+		}
+	}
+	return sdk.ParseTypedEvent(abci.Event{Type: ev.Type, Attributes: attributes})
+}
@@ -0,0 +1,346 @@
+package stream
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/stream/types"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+	hyperiontypes "helios-core/helios-chain/x/hyperion/types"
+)
+
+// typedEvent returns the ABCI event of a typed event with the mode attribute set at the end of the block
+func typedEvent(t *testing.T, msg proto.Message) abci.Event {
+	ev, err := sdk.TypedEventToEvent(msg)
+	require.NoError(t, err)
+	ev.Attributes = append(ev.Attributes, abci.EventAttribute{Key: "mode", Value: "EndBlock"})
+	return abci.Event(ev)
+}
+
+func newEvent(eventType string, attributes ...string) abci.Event {
+	ev := abci.Event{Type: eventType}
+	for i := 0; i+1 < len(attributes); i += 2 {
+		ev.Attributes = append(ev.Attributes, abci.EventAttribute{Key: attributes[i], Value: attributes[i+1]})
+	}
+	return ev
+}
+
+func TestABCIToHyperionDeposit(t *testing.T) {
+	amount := sdk.NewInt64Coin("ahelios", 1000)
+	testCases := []struct {
+		name   string
+		event  abci.Event
+		exp    *types.HyperionDeposit
+		expErr string
+	}{
+		{
+			"deposit received",
+			typedEvent(t, &hyperiontypes.EventDepositReceived{
+				HyperionId:          11,
+				EventNonce:          42,
+				EthereumSender:      "0x0000000000000000000000000000000000000001",
+				CosmosReceiver:      "helios1receiver",
+				TokenContract:       "0x0000000000000000000000000000000000000002",
+				Amount:              amount,
+				SentToCommunityPool: true,
+			}),
+			&types.HyperionDeposit{
+				HyperionId:          11,
+				EventNonce:          42,
+				Sender:              "0x0000000000000000000000000000000000000001",
+				Receiver:            "helios1receiver",
+				TokenContract:       "0x0000000000000000000000000000000000000002",
+				Amount:              amount,
+				SentToCommunityPool: true,
+			},
+			"",
+		},
+		{
+			"unexpected topic",
+			typedEvent(t, &hyperiontypes.EventSendToChain{HyperionId: 11}),
+			nil,
+			"unexpected topic",
+		},
+		{
+			"invalid attribute",
+			newEvent(string(HyperionDeposits), "event_nonce", "not json"),
+			nil,
+			"failed to unmarshal ABCI event to HyperionDeposit",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deposit, err := ABCIToHyperionDeposit(tc.event)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, deposit)
+		})
+	}
+}
+
+func TestABCIToHyperionBatchExecution(t *testing.T) {
+	testCases := []struct {
+		name   string
+		event  abci.Event
+		exp    *types.HyperionBatchExecution
+		expErr string
+	}{
+		{
+			"batch executed",
+			typedEvent(t, &hyperiontypes.EventOutgoingBatchExecuted{
+				HyperionId:          11,
+				TokenContract:       "0x0000000000000000000000000000000000000002",
+				BatchNonce:          7,
+				BatchTxIds:          []uint64{1, 2, 3},
+				OrchestratorAddress: "helios1orchestrator",
+			}),
+			&types.HyperionBatchExecution{
+				HyperionId:    11,
+				TokenContract: "0x0000000000000000000000000000000000000002",
+				BatchNonce:    7,
+				TxIds:         []uint64{1, 2, 3},
+				Orchestrator:  "helios1orchestrator",
+			},
+			"",
+		},
+		{
+			"unexpected topic",
+			typedEvent(t, &hyperiontypes.EventDepositReceived{HyperionId: 11}),
+			nil,
+			"unexpected topic",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			batch, err := ABCIToHyperionBatchExecution(tc.event)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, batch)
+		})
+	}
+}
+
+func TestABCIToCronExecution(t *testing.T) {
+	testCases := []struct {
+		name   string
+		event  abci.Event
+		exp    *types.CronExecution
+		expErr string
+	}{
+		{
+			"cron executed",
+			newEvent(string(CronExecutions),
+				"cron_id", "3",
+				"owner_address", "0x0000000000000000000000000000000000000003",
+				"cron_address", "0x0000000000000000000000000000000000000004",
+				"tx_hash", "0xabcd",
+				"nonce", "12",
+				"gas_used", "21000",
+				"error", "",
+			),
+			&types.CronExecution{
+				CronId:      3,
+				Owner:       "0x0000000000000000000000000000000000000003",
+				CronAddress: "0x0000000000000000000000000000000000000004",
+				TxHash:      "0xabcd",
+				Nonce:       12,
+				GasUsed:     21000,
+			},
+			"",
+		},
+		{
+			"cron reverted",
+			newEvent(string(CronExecutions), "cron_id", "3", "gas_used", "30000", "error", "execution reverted"),
+			&types.CronExecution{CronId: 3, GasUsed: 30000, Error: "execution reverted"},
+			"",
+		},
+		{
+			"invalid cron id",
+			newEvent(string(CronExecutions), "cron_id", "three"),
+			nil,
+			"failed to parse cron_id",
+		},
+		{
+			"invalid gas used",
+			newEvent(string(CronExecutions), "cron_id", "3", "gas_used", "-1"),
+			nil,
+			"failed to parse gas_used",
+		},
+		{
+			"unexpected topic",
+			newEvent("ExecuteCrons", "cron_id", "3"),
+			nil,
+			"unexpected topic",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execution, err := ABCIToCronExecution(tc.event)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, execution)
+		})
+	}
+}
+
+func TestABCIToStakingDelegation(t *testing.T) {
+	completionTime := time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		name   string
+		event  abci.Event
+		exp    *types.StakingDelegation
+		expErr string
+	}{
+		{
+			"delegate",
+			newEvent(string(StakingDelegate),
+				stakingtypes.AttributeKeyValidator, "heliosvaloper1validator",
+				stakingtypes.AttributeKeyDelegator, "helios1delegator",
+				sdk.AttributeKeyAmount, "1000ahelios",
+			),
+			&types.StakingDelegation{
+				Type:      string(StakingDelegate),
+				Delegator: "helios1delegator",
+				Validator: "heliosvaloper1validator",
+				Amount:    sdk.NewInt64Coin("ahelios", 1000),
+			},
+			"",
+		},
+		{
+			"unbond",
+			newEvent(string(StakingUnbond),
+				stakingtypes.AttributeKeyValidator, "heliosvaloper1validator",
+				stakingtypes.AttributeKeyDelegator, "helios1delegator",
+				sdk.AttributeKeyAmount, "500ahelios",
+				stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339),
+			),
+			&types.StakingDelegation{
+				Type:           string(StakingUnbond),
+				Delegator:      "helios1delegator",
+				Validator:      "heliosvaloper1validator",
+				Amount:         sdk.NewInt64Coin("ahelios", 500),
+				CompletionTime: completionTime.UnixMilli(),
+			},
+			"",
+		},
+		{
+			"cancel unbonding",
+			newEvent(string(StakingCancelUnbonding),
+				stakingtypes.AttributeKeyValidator, "heliosvaloper1validator",
+				stakingtypes.AttributeKeyDelegator, "helios1delegator",
+				sdk.AttributeKeyAmount, "200ahelios",
+				stakingtypes.AttributeKeyCreationHeight, "10",
+			),
+			&types.StakingDelegation{
+				Type:      string(StakingCancelUnbonding),
+				Delegator: "helios1delegator",
+				Validator: "heliosvaloper1validator",
+				Amount:    sdk.NewInt64Coin("ahelios", 200),
+			},
+			"",
+		},
+		{
+			"invalid amount",
+			newEvent(string(StakingDelegate), sdk.AttributeKeyAmount, "ahelios"),
+			nil,
+			"failed to parse the amount",
+		},
+		{
+			"invalid completion time",
+			newEvent(string(StakingUnbond), stakingtypes.AttributeKeyCompletionTime, "tomorrow"),
+			nil,
+			"failed to parse the completion time",
+		},
+		{
+			"unexpected topic",
+			newEvent(stakingtypes.EventTypeRedelegate, sdk.AttributeKeyAmount, "1000ahelios"),
+			nil,
+			"unexpected topic",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delegation, err := ABCIToStakingDelegation(tc.event)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, delegation)
+		})
+	}
+}
+
+func TestABCIToEvmLogs(t *testing.T) {
+	log1 := &evmtypes.Log{
+		Address: "0x0000000000000000000000000000000000000005",
+		Topics:  []string{"0x01", "0x02"},
+		Data:    []byte{0x1},
+		TxHash:  "0xabcd",
+		Index:   1,
+	}
+	log2 := &evmtypes.Log{Address: "0x0000000000000000000000000000000000000006", Index: 2}
+	bz1, err := json.Marshal(log1)
+	require.NoError(t, err)
+	bz2, err := json.Marshal(log2)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		event  abci.Event
+		exp    []*evmtypes.Log
+		expErr string
+	}{
+		{
+			"logs of a transaction",
+			newEvent(string(EvmLogs), evmtypes.AttributeKeyTxLog, string(bz1), evmtypes.AttributeKeyTxLog, string(bz2)),
+			[]*evmtypes.Log{log1, log2},
+			"",
+		},
+		{
+			"other attributes are ignored",
+			newEvent(string(EvmLogs), "mode", "EndBlock"),
+			nil,
+			"",
+		},
+		{
+			"invalid log",
+			newEvent(string(EvmLogs), evmtypes.AttributeKeyTxLog, "{"),
+			nil,
+			"failed to unmarshal ABCI event to EvmLog",
+		},
+		{
+			"unexpected topic",
+			newEvent(evmtypes.EventTypeEthereumTx, evmtypes.AttributeKeyTxLog, string(bz1)),
+			nil,
+			"unexpected topic",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := ABCIToEvmLogs(tc.event)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, logs)
+		})
+	}
+}
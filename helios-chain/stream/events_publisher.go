@@ -15,7 +15,17 @@ import (
 
 type Topic string
 
-const BankBalances = Topic("cosmos.bank.v1beta1.EventSetBalances")
+const (
+	BankBalances            = Topic("cosmos.bank.v1beta1.EventSetBalances")
+	EvmLogs                 = Topic("tx_log")
+	StakingDelegate         = Topic("delegate")
+	StakingUnbond           = Topic("unbond")
+	StakingCancelUnbonding  = Topic("cancel_unbonding_delegation")
+	HyperionDeposits        = Topic("helios.hyperion.v1.EventDepositReceived")
+	HyperionWithdrawals     = Topic("helios.hyperion.v1.EventSendToChain")
+	HyperionBatchExecutions = Topic("helios.hyperion.v1.EventOutgoingBatchExecuted")
+	CronExecutions          = Topic("ExecuteCron")
+)
 
 const StreamEvents = "stream.events"
 
@@ -72,6 +82,7 @@ func (e *Publisher) Run(ctx context.Context) error {
 
 	go func() {
 		inBuffer := types.NewStreamResponseMap()
+		var beginBlockHeight uint64
 		for {
 			select {
 			case <-e.done:
@@ -91,6 +102,13 @@ func (e *Publisher) Run(ctx context.Context) error {
 					}
 				}
 
+				// the BeginBlock events are flushed too, the block is published once
+				// its second flush is received with the EndBlock events
+				if events.Flush && beginBlockHeight != events.Height {
+					beginBlockHeight = events.Height
+					continue
+				}
+
 				// all events for specific height are received
 				if events.Flush {
 					inBuffer.BlockHeight = events.Height
@@ -124,7 +142,25 @@ func (e *Publisher) Stop() error {
 
 func (e *Publisher) registerHandlers() {
 	// Register events
-	e.RegisterEventHandler(BankBalances, handleBankBalanceEvent)
+	for topic, handler := range defaultEventHandlers() {
+		e.RegisterEventHandler(topic, handler)
+	}
+}
+
+// defaultEventHandlers returns the handlers of the streamed events, they are used by
+// the publisher for the live blocks and by the stream server to replay the past ones.
+func defaultEventHandlers() map[Topic]eventHandler {
+	return map[Topic]eventHandler{
+		BankBalances:            handleBankBalanceEvent,
+		EvmLogs:                 handleEvmLogEvent,
+		StakingDelegate:         handleStakingDelegationEvent,
+		StakingUnbond:           handleStakingDelegationEvent,
+		StakingCancelUnbonding:  handleStakingDelegationEvent,
+		HyperionDeposits:        handleHyperionDepositEvent,
+		HyperionWithdrawals:     handleHyperionWithdrawalEvent,
+		HyperionBatchExecutions: handleHyperionBatchExecutionEvent,
+		CronExecutions:          handleCronExecutionEvent,
+	}
 }
 
 func (e *Publisher) RegisterEventHandler(topic Topic, handler eventHandler) {
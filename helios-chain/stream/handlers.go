@@ -5,6 +5,7 @@ import (
 	"helios-core/helios-chain/stream/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 func handleBankBalanceEvent(inBuffer *types.StreamResponseMap, ev abci.Event) error {
@@ -20,3 +21,61 @@ func handleBankBalanceEvent(inBuffer *types.StreamResponseMap, ev abci.Event) er
 	}
 	return nil
 }
+
+func handleEvmLogEvent(inBuffer *types.StreamResponseMap, ev abci.Event) error {
+	logs, err := ABCIToEvmLogs(ev)
+	if err != nil {
+		return fmt.Errorf("error converting ABCI event to EvmLog: %w", err)
+	}
+	for _, log := range logs {
+		address := common.HexToAddress(log.Address).Hex()
+		inBuffer.EvmLogsByAddress[address] = append(inBuffer.EvmLogsByAddress[address], log)
+	}
+	return nil
+}
+
+func handleStakingDelegationEvent(inBuffer *types.StreamResponseMap, ev abci.Event) error {
+	msg, err := ABCIToStakingDelegation(ev)
+	if err != nil {
+		return fmt.Errorf("error converting ABCI event to StakingDelegation: %w", err)
+	}
+	inBuffer.StakingDelegationsByDelegator[msg.Delegator] = append(inBuffer.StakingDelegationsByDelegator[msg.Delegator], msg)
+	return nil
+}
+
+func handleHyperionDepositEvent(inBuffer *types.StreamResponseMap, ev abci.Event) error {
+	msg, err := ABCIToHyperionDeposit(ev)
+	if err != nil {
+		return fmt.Errorf("error converting ABCI event to HyperionDeposit: %w", err)
+	}
+	inBuffer.HyperionDepositsByReceiver[msg.Receiver] = append(inBuffer.HyperionDepositsByReceiver[msg.Receiver], msg)
+	return nil
+}
+
+func handleHyperionWithdrawalEvent(inBuffer *types.StreamResponseMap, ev abci.Event) error {
+	msg, err := ABCIToHyperionWithdrawal(ev)
+	if err != nil {
+		return fmt.Errorf("error converting ABCI event to HyperionWithdrawal: %w", err)
+	}
+	inBuffer.HyperionWithdrawalsBySender[msg.Sender] = append(inBuffer.HyperionWithdrawalsBySender[msg.Sender], msg)
+	return nil
+}
+
+func handleHyperionBatchExecutionEvent(inBuffer *types.StreamResponseMap, ev abci.Event) error {
+	msg, err := ABCIToHyperionBatchExecution(ev)
+	if err != nil {
+		return fmt.Errorf("error converting ABCI event to HyperionBatchExecution: %w", err)
+	}
+	tokenContract := common.HexToAddress(msg.TokenContract).Hex()
+	inBuffer.HyperionBatchExecutionsByTokenContract[tokenContract] = append(inBuffer.HyperionBatchExecutionsByTokenContract[tokenContract], msg)
+	return nil
+}
+
+func handleCronExecutionEvent(inBuffer *types.StreamResponseMap, ev abci.Event) error {
+	msg, err := ABCIToCronExecution(ev)
+	if err != nil {
+		return fmt.Errorf("error converting ABCI event to CronExecution: %w", err)
+	}
+	inBuffer.CronExecutionsByOwner[msg.Owner] = append(inBuffer.CronExecutionsByOwner[msg.Owner], msg)
+	return nil
+}
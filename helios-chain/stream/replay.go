@@ -0,0 +1,86 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	"helios-core/helios-chain/stream/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// BlockResultsClient is the CometBFT client used to replay the blocks committed
+// before the subscription of a stream.
+type BlockResultsClient interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// MaxReplayBlocks is the maximum number of past blocks replayed when a stream starts
+// from a past height, every block replayed costs two queries to the node.
+const MaxReplayBlocks = 10000
+
+// replayRange returns the earliest and the latest heights of the blocks stored by the node
+func (s *StreamServer) replayRange(ctx context.Context) (earliest, latest uint64, err error) {
+	status, err := s.blockResultsClient.Status(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get the node status: %w", err)
+	}
+	return uint64(status.SyncInfo.EarliestBlockHeight), uint64(status.SyncInfo.LatestBlockHeight), nil
+}
+
+// replayBlock rebuilds the stream response of a committed block from its block results,
+// the events are handled in the order they are published: BeginBlock events, the events
+// of the successful transactions, then EndBlock events.
+func (s *StreamServer) replayBlock(ctx context.Context, height uint64) (*types.StreamResponseMap, error) {
+	h := int64(height)
+	block, err := s.blockResultsClient.Block(ctx, &h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block %d: %w", height, err)
+	}
+	results, err := s.blockResultsClient.BlockResults(ctx, &h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block results %d: %w", height, err)
+	}
+
+	beginBlockEvents := make([]abci.Event, 0)
+	endBlockEvents := make([]abci.Event, 0)
+	for _, ev := range results.FinalizeBlockEvents {
+		if eventMode(ev) == "BeginBlock" {
+			beginBlockEvents = append(beginBlockEvents, ev)
+		} else {
+			endBlockEvents = append(endBlockEvents, ev)
+		}
+	}
+
+	events := beginBlockEvents
+	for _, txResult := range results.TxsResults {
+		if txResult.IsOK() {
+			events = append(events, txResult.Events...)
+		}
+	}
+	events = append(events, endBlockEvents...)
+
+	inBuffer := types.NewStreamResponseMap()
+	inBuffer.BlockHeight = height
+	inBuffer.BlockTime = block.Block.Time
+	for _, ev := range events {
+		if handler, ok := s.eventHandlers[Topic(ev.Type)]; ok {
+			if err := handler(inBuffer, ev); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return inBuffer, nil
+}
+
+func eventMode(ev abci.Event) string {
+	for _, attr := range ev.Attributes {
+		if attr.Key == "mode" {
+			return attr.Value
+		}
+	}
+	return ""
+}
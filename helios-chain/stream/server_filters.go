@@ -2,12 +2,16 @@ package stream
 
 import (
 	"fmt"
-	"helios-core/helios-chain/stream/types"
+	"strings"
+
+	evmtypes "helios-core/helios-chain/x/evm/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var ErrInvalidParameters = fmt.Errorf("firstMap and secondMap must have the same length")
 
-func Filter[V types.BankBalance](itemMap map[string][]*V, filter []string) (out []*V) {
+func Filter[V any](itemMap map[string][]*V, filter []string) (out []*V) {
 	wildcard := false
 	if len(filter) > 0 {
 		wildcard = filter[0] == "*"
@@ -26,6 +30,53 @@ func Filter[V types.BankBalance](itemMap map[string][]*V, filter []string) (out
 	return
 }
 
+// FilterEvmLogs returns the logs of the filtered addresses matching the topics by
+// position, an empty position matches any topic.
+func FilterEvmLogs(logsByAddress map[string][]*evmtypes.Log, addresses []string, topics [][]string) (out []*evmtypes.Log) {
+	for _, log := range Filter[evmtypes.Log](logsByAddress, addresses) {
+		if matchTopics(log.Topics, topics) {
+			out = append(out, log)
+		}
+	}
+	return
+}
+
+// checksumAddresses normalizes the hex addresses of a filter to the keys of the
+// stream response map, the wildcard is kept as is.
+func checksumAddresses(addresses []string) []string {
+	out := make([]string, len(addresses))
+	for i, address := range addresses {
+		if address == "*" {
+			out[i] = address
+			continue
+		}
+		out[i] = common.HexToAddress(address).Hex()
+	}
+	return out
+}
+
+func matchTopics(logTopics []string, topics [][]string) bool {
+	if len(topics) > len(logTopics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		match := false
+		for _, topic := range sub {
+			if strings.EqualFold(topic, logTopics[i]) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
 func getMemAddr(i interface{}) string {
 	return fmt.Sprintf("%p", i)
 }
@@ -0,0 +1,91 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+func TestFilter(t *testing.T) {
+	a1, a2, b1 := "a1", "a2", "b1"
+	itemMap := map[string][]*string{
+		"a": {&a1, &a2},
+		"b": {&b1},
+	}
+
+	testCases := []struct {
+		name      string
+		filter    []string
+		exp       []*string
+		unordered bool
+	}{
+		{"no filter", []string{}, nil, false},
+		{"one key", []string{"a"}, []*string{&a1, &a2}, false},
+		{"keys in filter order", []string{"b", "a"}, []*string{&b1, &a1, &a2}, false},
+		{"unknown key", []string{"c"}, nil, false},
+		// the map iteration order isn't deterministic
+		{"wildcard", []string{"*"}, []*string{&a1, &a2, &b1}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := Filter(itemMap, tc.filter)
+			if tc.unordered {
+				require.ElementsMatch(t, tc.exp, out)
+				return
+			}
+			require.Equal(t, tc.exp, out)
+		})
+	}
+}
+
+func TestFilterEvmLogs(t *testing.T) {
+	const (
+		transfer = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+		approval = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+		from     = "0x0000000000000000000000000000000000000000000000000000000000000001"
+		to       = "0x0000000000000000000000000000000000000000000000000000000000000002"
+	)
+	token := checksumAddresses([]string{"0x00000000000000000000000000000000000000aa"})[0]
+	other := checksumAddresses([]string{"0x00000000000000000000000000000000000000bb"})[0]
+
+	transferLog := &evmtypes.Log{Address: token, Topics: []string{transfer, from, to}}
+	approvalLog := &evmtypes.Log{Address: token, Topics: []string{approval, from, to}}
+	otherLog := &evmtypes.Log{Address: other, Topics: []string{transfer, to, from}}
+	anonymousLog := &evmtypes.Log{Address: other}
+	logsByAddress := map[string][]*evmtypes.Log{
+		token: {transferLog, approvalLog},
+		other: {otherLog, anonymousLog},
+	}
+
+	testCases := []struct {
+		name      string
+		addresses []string
+		topics    [][]string
+		exp       []*evmtypes.Log
+	}{
+		{"address without topics", []string{token}, nil, []*evmtypes.Log{transferLog, approvalLog}},
+		{"event signature", []string{"*"}, [][]string{{transfer}}, []*evmtypes.Log{transferLog, otherLog}},
+		{"any of the topics at a position", []string{token}, [][]string{{transfer, approval}}, []*evmtypes.Log{transferLog, approvalLog}},
+		{"empty position matches any topic", []string{"*"}, [][]string{{}, {to}}, []*evmtypes.Log{otherLog}},
+		{"topics are case insensitive", []string{token}, [][]string{{"0xDDF252AD1BE2C89B69C2B068FC378DAA952BA7F163C4A11628F55A4DF523B3EF"}}, []*evmtypes.Log{transferLog}},
+		{"more topics than the log", []string{other}, [][]string{{}, {}, {}, {}}, nil},
+		{"no match", []string{other}, [][]string{{approval}}, nil},
+		{"unknown address", []string{"0x00000000000000000000000000000000000000Cc"}, nil, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ElementsMatch(t, tc.exp, FilterEvmLogs(logsByAddress, tc.addresses, tc.topics))
+		})
+	}
+}
+
+func TestChecksumAddresses(t *testing.T) {
+	out := checksumAddresses([]string{"*", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"})
+	require.Equal(t, []string{
+		"*",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	}, out)
+}
@@ -34,11 +34,13 @@ const (
 )
 
 type StreamServer struct {
-	bufferCapacity uint
-	Bus            *pubsub.Server
-	GrpcServer     *grpc.Server
-	listener       net.Listener
-	done           chan struct{}
+	bufferCapacity     uint
+	Bus                *pubsub.Server
+	GrpcServer         *grpc.Server
+	listener           net.Listener
+	done               chan struct{}
+	eventHandlers      map[Topic]eventHandler
+	blockResultsClient BlockResultsClient
 }
 
 func NewChainStreamServer(bus *pubsub.Server, appOpts servertypes.AppOptions) *StreamServer {
@@ -61,6 +63,7 @@ func NewChainStreamServer(bus *pubsub.Server, appOpts servertypes.AppOptions) *S
 	server := &StreamServer{
 		Bus:            bus,
 		bufferCapacity: 100,
+		eventHandlers:  defaultEventHandlers(),
 	}
 	grpcServer := grpc.NewServer(grpc.KeepaliveEnforcementPolicy(kaep), grpc.KeepaliveParams(kasp))
 	types.RegisterStreamServer(grpcServer, server)
@@ -97,6 +100,30 @@ func (s *StreamServer) Stream(req *types.StreamRequest, server types.Stream_Stre
 	if err := req.Validate(); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
+
+	// next is the height of the next block sent, the blocks are sent once and in order.
+	// The past blocks are replayed from the block results before subscribing to the live
+	// ones, the blocks committed in between are replayed when the first live one is received.
+	next := req.FromHeight
+	if next > 0 {
+		if s.blockResultsClient == nil {
+			return status.Error(codes.FailedPrecondition, "streaming from a past height is not supported by this node")
+		}
+		earliest, latest, err := s.replayRange(server.Context())
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if next < earliest {
+			return status.Errorf(codes.OutOfRange, "from height %d is below the earliest block height %d of this node", next, earliest)
+		}
+		if latest > next && latest-next >= MaxReplayBlocks {
+			return status.Errorf(codes.OutOfRange, "from height %d is more than %d blocks behind the latest block height %d", next, MaxReplayBlocks, latest)
+		}
+		if next, err = s.replayBlocks(req, server, next, latest); err != nil {
+			return err
+		}
+	}
+
 	clientId := uuid.New().String()
 	sub, err := s.Bus.Subscribe(context.Background(), clientId, types.Empty{}, int(s.bufferCapacity))
 	if err != nil {
@@ -112,7 +139,6 @@ func (s *StreamServer) Stream(req *types.StreamRequest, server types.Stream_Stre
 
 	ch := sub.Out()
 
-	var height uint64
 	for {
 		select {
 		case <-s.done:
@@ -127,35 +153,92 @@ func (s *StreamServer) Stream(req *types.StreamRequest, server types.Stream_Stre
 				continue
 			}
 
-			if height == 0 {
-				height = inResp.BlockHeight
-			} else if inResp.BlockHeight != height {
-				return status.Errorf(codes.Internal, "block height mismatch")
+			switch {
+			case next == 0:
+				next = inResp.BlockHeight
+			case inResp.BlockHeight < next:
+				// already replayed
+				continue
+			case inResp.BlockHeight > next:
+				if s.blockResultsClient == nil {
+					return status.Errorf(codes.Internal, "block height mismatch")
+				}
+				if next, err = s.replayBlocks(req, server, next, inResp.BlockHeight-1); err != nil {
+					return err
+				}
 			}
 
-			outResp := types.NewChainStreamResponse()
-
-			outResp.BlockHeight = height
-			outResp.BlockTime = inResp.BlockTime.UnixMilli()
-
-			if req.BankBalancesFilter != nil && inResp.BankBalancesByAccount != nil {
-				outResp.BankBalances = Filter[types.BankBalance](inResp.BankBalancesByAccount, req.BankBalancesFilter.Accounts)
-			}
-			err = server.Send(outResp)
-			if err != nil {
+			if err := server.Send(filterStreamResponse(req, inResp)); err != nil {
 				return status.Error(codes.Internal, err.Error())
 			}
-			height += 1
+			next = inResp.BlockHeight + 1
+		case <-sub.Canceled():
+			return status.Errorf(codes.Internal, "subscription canceled: %s", sub.Err())
 		case <-server.Context().Done():
 			return nil
 		}
 	}
 }
 
+// replayBlocks sends the committed blocks from one height to another, it returns the
+// height of the next block to send.
+func (s *StreamServer) replayBlocks(req *types.StreamRequest, server types.Stream_StreamServer, from, to uint64) (uint64, error) {
+	for height := from; height <= to; height++ {
+		inResp, err := s.replayBlock(server.Context(), height)
+		if err != nil {
+			return 0, status.Error(codes.Internal, err.Error())
+		}
+		if err := server.Send(filterStreamResponse(req, inResp)); err != nil {
+			return 0, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if to < from {
+		return from, nil
+	}
+	return to + 1, nil
+}
+
+// filterStreamResponse returns the stream response of a block with the filters of the request
+func filterStreamResponse(req *types.StreamRequest, inResp *types.StreamResponseMap) *types.StreamResponse {
+	outResp := types.NewChainStreamResponse()
+
+	outResp.BlockHeight = inResp.BlockHeight
+	outResp.BlockTime = inResp.BlockTime.UnixMilli()
+
+	if req.BankBalancesFilter != nil && inResp.BankBalancesByAccount != nil {
+		outResp.BankBalances = Filter[types.BankBalance](inResp.BankBalancesByAccount, req.BankBalancesFilter.Accounts)
+	}
+	if req.EvmLogsFilter != nil && inResp.EvmLogsByAddress != nil {
+		outResp.EvmLogs = FilterEvmLogs(inResp.EvmLogsByAddress, checksumAddresses(req.EvmLogsFilter.Addresses), req.EvmLogsFilter.TopicsList())
+	}
+	if req.StakingDelegationsFilter != nil && inResp.StakingDelegationsByDelegator != nil {
+		outResp.StakingDelegations = Filter[types.StakingDelegation](inResp.StakingDelegationsByDelegator, req.StakingDelegationsFilter.Delegators)
+	}
+	if req.HyperionDepositsFilter != nil && inResp.HyperionDepositsByReceiver != nil {
+		outResp.HyperionDeposits = Filter[types.HyperionDeposit](inResp.HyperionDepositsByReceiver, req.HyperionDepositsFilter.Receivers)
+	}
+	if req.HyperionWithdrawalsFilter != nil && inResp.HyperionWithdrawalsBySender != nil {
+		outResp.HyperionWithdrawals = Filter[types.HyperionWithdrawal](inResp.HyperionWithdrawalsBySender, req.HyperionWithdrawalsFilter.Senders)
+	}
+	if req.HyperionBatchExecutionsFilter != nil && inResp.HyperionBatchExecutionsByTokenContract != nil {
+		outResp.HyperionBatchExecutions = Filter[types.HyperionBatchExecution](inResp.HyperionBatchExecutionsByTokenContract, checksumAddresses(req.HyperionBatchExecutionsFilter.TokenContracts))
+	}
+	if req.CronExecutionsFilter != nil && inResp.CronExecutionsByOwner != nil {
+		outResp.CronExecutions = Filter[types.CronExecution](inResp.CronExecutionsByOwner, req.CronExecutionsFilter.Owners)
+	}
+	return outResp
+}
+
 func (s *StreamServer) WithBufferCapacity(capacity uint) {
 	s.bufferCapacity = capacity
 }
 
+// WithBlockResultsClient sets the client used to replay the past blocks, the streams
+// starting from a past height are refused without it.
+func (s *StreamServer) WithBlockResultsClient(client BlockResultsClient) {
+	s.blockResultsClient = client
+}
+
 func (s *StreamServer) GetCurrentServerPort() int {
 	if s.listener == nil {
 		return 0
@@ -0,0 +1,212 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"helios-core/helios-chain/stream/types"
+)
+
+// mockBlockResultsClient serves blocks without events from the earliest to the latest height
+type mockBlockResultsClient struct {
+	earliest, latest int64
+}
+
+func (c *mockBlockResultsClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		EarliestBlockHeight: c.earliest,
+		LatestBlockHeight:   c.latest,
+	}}, nil
+}
+
+func (c *mockBlockResultsClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	if *height < c.earliest {
+		return nil, fmt.Errorf("height %d is not available", *height)
+	}
+	return &coretypes.ResultBlock{Block: &cmttypes.Block{Header: cmttypes.Header{Height: *height}}}, nil
+}
+
+func (c *mockBlockResultsClient) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	if *height < c.earliest {
+		return nil, fmt.Errorf("height %d is not available", *height)
+	}
+	return &coretypes.ResultBlockResults{Height: *height, FinalizeBlockEvents: []abci.Event{}}, nil
+}
+
+// mockStream records the heights of the responses sent to the client
+type mockStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	heights chan uint64
+}
+
+func (s *mockStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockStream) Send(resp *types.StreamResponse) error {
+	s.heights <- resp.BlockHeight
+	return nil
+}
+
+type streamTest struct {
+	t      *testing.T
+	bus    *pubsub.Server
+	server *StreamServer
+	stream *mockStream
+	errCh  chan error
+}
+
+func newStreamTest(t *testing.T, client BlockResultsClient) *streamTest {
+	bus := pubsub.NewServer()
+	require.NoError(t, bus.Start())
+	t.Cleanup(func() { _ = bus.Stop() })
+
+	server := &StreamServer{Bus: bus, bufferCapacity: 100, eventHandlers: defaultEventHandlers()}
+	if client != nil {
+		server.WithBlockResultsClient(client)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &streamTest{
+		t:      t,
+		bus:    bus,
+		server: server,
+		stream: &mockStream{ctx: ctx, heights: make(chan uint64, 100)},
+		errCh:  make(chan error, 1),
+	}
+}
+
+func (s *streamTest) start(fromHeight uint64) {
+	req := &types.StreamRequest{
+		FromHeight:         fromHeight,
+		BankBalancesFilter: &types.BankBalancesFilter{Accounts: []string{"*"}},
+	}
+	go func() {
+		s.errCh <- s.server.Stream(req, s.stream)
+	}()
+}
+
+// waitLive waits for the stream to subscribe to the live blocks
+func (s *streamTest) waitLive() {
+	require.Eventually(s.t, func() bool {
+		return s.bus.NumClients() == 1
+	}, time.Second, time.Millisecond)
+}
+
+func (s *streamTest) publish(height uint64) {
+	inResp := types.NewStreamResponseMap()
+	inResp.BlockHeight = height
+	inResp.BlockTime = time.Now()
+	require.NoError(s.t, s.bus.Publish(context.Background(), inResp))
+}
+
+// requireHeights requires the next heights sent to the client
+func (s *streamTest) requireHeights(exp ...uint64) {
+	for _, height := range exp {
+		select {
+		case sent := <-s.stream.heights:
+			require.Equal(s.t, height, sent)
+		case err := <-s.errCh:
+			s.t.Fatalf("stream stopped before height %d: %v", height, err)
+		case <-time.After(time.Second):
+			s.t.Fatalf("height %d wasn't sent", height)
+		}
+	}
+}
+
+func (s *streamTest) requireNoHeight() {
+	select {
+	case sent := <-s.stream.heights:
+		s.t.Fatalf("unexpected height %d sent", sent)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestStreamReplayToLive(t *testing.T) {
+	client := &mockBlockResultsClient{earliest: 1, latest: 5}
+	s := newStreamTest(t, client)
+
+	// the past blocks are replayed before the subscription to the live ones
+	s.start(3)
+	s.requireHeights(3, 4, 5)
+	s.waitLive()
+
+	// the blocks committed between the replay and the first live block are replayed
+	client.latest = 8
+	s.publish(8)
+	s.requireHeights(6, 7, 8)
+
+	// the live blocks already sent are skipped, the heights only go up
+	s.publish(7)
+	s.publish(8)
+	s.publish(9)
+	s.requireHeights(9)
+	s.requireNoHeight()
+
+	// a block missed by the subscription is replayed before the next live one
+	client.latest = 11
+	s.publish(11)
+	s.requireHeights(10, 11)
+}
+
+func TestStreamLiveOnly(t *testing.T) {
+	s := newStreamTest(t, nil)
+
+	// without from height, the stream starts at the first live block
+	s.start(0)
+	s.waitLive()
+	s.publish(20)
+	s.publish(21)
+	s.requireHeights(20, 21)
+
+	// the blocks can't be replayed without a block results client
+	s.publish(23)
+	require.Equal(t, codes.Internal, status.Code(<-s.errCh))
+}
+
+func TestStreamFromHeightRejected(t *testing.T) {
+	testCases := []struct {
+		name       string
+		client     BlockResultsClient
+		fromHeight uint64
+		expCode    codes.Code
+	}{
+		{"no block results client", nil, 1, codes.FailedPrecondition},
+		{"below the earliest height", &mockBlockResultsClient{earliest: 100, latest: 200}, 99, codes.OutOfRange},
+		{"too far behind", &mockBlockResultsClient{earliest: 1, latest: MaxReplayBlocks + 1}, 1, codes.OutOfRange},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newStreamTest(t, tc.client)
+			s.start(tc.fromHeight)
+			require.Equal(t, tc.expCode, status.Code(<-s.errCh))
+			require.Zero(t, s.bus.NumClients())
+			s.requireNoHeight()
+		})
+	}
+}
+
+func TestStreamMaxReplayBlocks(t *testing.T) {
+	// the oldest height allowed replays exactly MaxReplayBlocks blocks
+	s := newStreamTest(t, &mockBlockResultsClient{earliest: 1, latest: MaxReplayBlocks})
+	s.stream.heights = make(chan uint64, MaxReplayBlocks)
+	s.start(1)
+	s.waitLive()
+	require.Len(t, s.stream.heights, MaxReplayBlocks)
+	for height := uint64(1); height <= MaxReplayBlocks; height++ {
+		require.Equal(t, height, <-s.stream.heights)
+	}
+}
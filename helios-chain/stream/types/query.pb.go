@@ -7,13 +7,14 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	types "helios-core/helios-chain/x/evm/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -31,7 +32,18 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StreamRequest struct {
-	BankBalancesFilter *BankBalancesFilter `protobuf:"bytes,1,opt,name=bank_balances_filter,json=bankBalancesFilter,proto3" json:"bank_balances_filter,omitempty"`
+	BankBalancesFilter            *BankBalancesFilter            `protobuf:"bytes,1,opt,name=bank_balances_filter,json=bankBalancesFilter,proto3" json:"bank_balances_filter,omitempty"`
+	EvmLogsFilter                 *EvmLogsFilter                 `protobuf:"bytes,2,opt,name=evm_logs_filter,json=evmLogsFilter,proto3" json:"evm_logs_filter,omitempty"`
+	StakingDelegationsFilter      *StakingDelegationsFilter      `protobuf:"bytes,3,opt,name=staking_delegations_filter,json=stakingDelegationsFilter,proto3" json:"staking_delegations_filter,omitempty"`
+	HyperionDepositsFilter        *HyperionDepositsFilter        `protobuf:"bytes,4,opt,name=hyperion_deposits_filter,json=hyperionDepositsFilter,proto3" json:"hyperion_deposits_filter,omitempty"`
+	HyperionWithdrawalsFilter     *HyperionWithdrawalsFilter     `protobuf:"bytes,5,opt,name=hyperion_withdrawals_filter,json=hyperionWithdrawalsFilter,proto3" json:"hyperion_withdrawals_filter,omitempty"`
+	HyperionBatchExecutionsFilter *HyperionBatchExecutionsFilter `protobuf:"bytes,6,opt,name=hyperion_batch_executions_filter,json=hyperionBatchExecutionsFilter,proto3" json:"hyperion_batch_executions_filter,omitempty"`
+	CronExecutionsFilter          *CronExecutionsFilter          `protobuf:"bytes,7,opt,name=cron_executions_filter,json=cronExecutionsFilter,proto3" json:"cron_executions_filter,omitempty"`
+	// from_height is the first block streamed, the blocks before the live ones are
+	// replayed from the block results of the node. The live blocks are streamed
+	// when it isn't set. It must be within the blocks stored by the node and at most
+	// 10000 blocks behind the latest one.
+	FromHeight uint64 `protobuf:"varint,8,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
 }
 
 func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
@@ -74,10 +86,65 @@ func (m *StreamRequest) GetBankBalancesFilter() *BankBalancesFilter {
 	return nil
 }
 
+func (m *StreamRequest) GetEvmLogsFilter() *EvmLogsFilter {
+	if m != nil {
+		return m.EvmLogsFilter
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetStakingDelegationsFilter() *StakingDelegationsFilter {
+	if m != nil {
+		return m.StakingDelegationsFilter
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetHyperionDepositsFilter() *HyperionDepositsFilter {
+	if m != nil {
+		return m.HyperionDepositsFilter
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetHyperionWithdrawalsFilter() *HyperionWithdrawalsFilter {
+	if m != nil {
+		return m.HyperionWithdrawalsFilter
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetHyperionBatchExecutionsFilter() *HyperionBatchExecutionsFilter {
+	if m != nil {
+		return m.HyperionBatchExecutionsFilter
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetCronExecutionsFilter() *CronExecutionsFilter {
+	if m != nil {
+		return m.CronExecutionsFilter
+	}
+	return nil
+}
+
+func (m *StreamRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
 type StreamResponse struct {
-	BlockHeight  uint64         `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	BlockTime    int64          `protobuf:"varint,2,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
-	BankBalances []*BankBalance `protobuf:"bytes,3,rep,name=bank_balances,json=bankBalances,proto3" json:"bank_balances,omitempty"`
+	BlockHeight             uint64                    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime               int64                     `protobuf:"varint,2,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	BankBalances            []*BankBalance            `protobuf:"bytes,3,rep,name=bank_balances,json=bankBalances,proto3" json:"bank_balances,omitempty"`
+	EvmLogs                 []*types.Log              `protobuf:"bytes,4,rep,name=evm_logs,json=evmLogs,proto3" json:"evm_logs,omitempty"`
+	StakingDelegations      []*StakingDelegation      `protobuf:"bytes,5,rep,name=staking_delegations,json=stakingDelegations,proto3" json:"staking_delegations,omitempty"`
+	HyperionDeposits        []*HyperionDeposit        `protobuf:"bytes,6,rep,name=hyperion_deposits,json=hyperionDeposits,proto3" json:"hyperion_deposits,omitempty"`
+	HyperionWithdrawals     []*HyperionWithdrawal     `protobuf:"bytes,7,rep,name=hyperion_withdrawals,json=hyperionWithdrawals,proto3" json:"hyperion_withdrawals,omitempty"`
+	HyperionBatchExecutions []*HyperionBatchExecution `protobuf:"bytes,8,rep,name=hyperion_batch_executions,json=hyperionBatchExecutions,proto3" json:"hyperion_batch_executions,omitempty"`
+	CronExecutions          []*CronExecution          `protobuf:"bytes,9,rep,name=cron_executions,json=cronExecutions,proto3" json:"cron_executions,omitempty"`
 }
 
 func (m *StreamResponse) Reset()         { *m = StreamResponse{} }
@@ -134,6 +201,48 @@ func (m *StreamResponse) GetBankBalances() []*BankBalance {
 	return nil
 }
 
+func (m *StreamResponse) GetEvmLogs() []*types.Log {
+	if m != nil {
+		return m.EvmLogs
+	}
+	return nil
+}
+
+func (m *StreamResponse) GetStakingDelegations() []*StakingDelegation {
+	if m != nil {
+		return m.StakingDelegations
+	}
+	return nil
+}
+
+func (m *StreamResponse) GetHyperionDeposits() []*HyperionDeposit {
+	if m != nil {
+		return m.HyperionDeposits
+	}
+	return nil
+}
+
+func (m *StreamResponse) GetHyperionWithdrawals() []*HyperionWithdrawal {
+	if m != nil {
+		return m.HyperionWithdrawals
+	}
+	return nil
+}
+
+func (m *StreamResponse) GetHyperionBatchExecutions() []*HyperionBatchExecution {
+	if m != nil {
+		return m.HyperionBatchExecutions
+	}
+	return nil
+}
+
+func (m *StreamResponse) GetCronExecutions() []*CronExecution {
+	if m != nil {
+		return m.CronExecutions
+	}
+	return nil
+}
+
 type BankBalance struct {
 	Account  string                                   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
@@ -186,22 +295,28 @@ func (m *BankBalance) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
-type BankBalancesFilter struct {
-	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+type StakingDelegation struct {
+	// type is the staking event: delegate, unbond or cancel_unbonding_delegation
+	Type      string      `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Delegator string      `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Validator string      `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount    types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// completion_time is the unix time in milliseconds of the end of the unbonding
+	CompletionTime int64 `protobuf:"varint,5,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
 }
 
-func (m *BankBalancesFilter) Reset()         { *m = BankBalancesFilter{} }
-func (m *BankBalancesFilter) String() string { return proto.CompactTextString(m) }
-func (*BankBalancesFilter) ProtoMessage()    {}
-func (*BankBalancesFilter) Descriptor() ([]byte, []int) {
+func (m *StakingDelegation) Reset()         { *m = StakingDelegation{} }
+func (m *StakingDelegation) String() string { return proto.CompactTextString(m) }
+func (*StakingDelegation) ProtoMessage()    {}
+func (*StakingDelegation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_59fb6958fb6ccc01, []int{3}
 }
-func (m *BankBalancesFilter) XXX_Unmarshal(b []byte) error {
+func (m *StakingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BankBalancesFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StakingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BankBalancesFilter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StakingDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -211,416 +326,4348 @@ func (m *BankBalancesFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BankBalancesFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BankBalancesFilter.Merge(m, src)
+func (m *StakingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingDelegation.Merge(m, src)
 }
-func (m *BankBalancesFilter) XXX_Size() int {
+func (m *StakingDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *BankBalancesFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_BankBalancesFilter.DiscardUnknown(m)
+func (m *StakingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BankBalancesFilter proto.InternalMessageInfo
+var xxx_messageInfo_StakingDelegation proto.InternalMessageInfo
 
-func (m *BankBalancesFilter) GetAccounts() []string {
+func (m *StakingDelegation) GetType() string {
 	if m != nil {
-		return m.Accounts
+		return m.Type
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*StreamRequest)(nil), "helios.stream.v1beta1.StreamRequest")
-	proto.RegisterType((*StreamResponse)(nil), "helios.stream.v1beta1.StreamResponse")
-	proto.RegisterType((*BankBalance)(nil), "helios.stream.v1beta1.BankBalance")
-	proto.RegisterType((*BankBalancesFilter)(nil), "helios.stream.v1beta1.BankBalancesFilter")
+func (m *StakingDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("helios/stream/v1beta1/query.proto", fileDescriptor_59fb6958fb6ccc01) }
-
-var fileDescriptor_59fb6958fb6ccc01 = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xae, 0xd7, 0x69, 0xac, 0xee, 0xc6, 0xc1, 0x1a, 0x52, 0xa8, 0x44, 0xda, 0x45, 0x4c, 0x0a,
-	0x87, 0xd9, 0x5d, 0x79, 0x00, 0xa4, 0x20, 0x01, 0x67, 0x83, 0x84, 0xc4, 0xa5, 0xb2, 0x8d, 0x49,
-	0xac, 0x36, 0x76, 0x17, 0x3b, 0x48, 0x7b, 0x8b, 0xdd, 0x79, 0x03, 0x9e, 0xa4, 0xc7, 0x1d, 0x39,
-	0x0d, 0xd4, 0xbe, 0x08, 0x9a, 0x9d, 0x44, 0x9b, 0x06, 0xea, 0x29, 0xbf, 0x7f, 0x7f, 0xdf, 0xff,
-	0x7d, 0xf9, 0x3f, 0xc3, 0xd3, 0x42, 0x2e, 0x95, 0xb1, 0xc4, 0xba, 0x4a, 0xb2, 0x92, 0x7c, 0xbf,
-	0xe0, 0xd2, 0xb1, 0x0b, 0x72, 0x59, 0xcb, 0xea, 0x0a, 0xaf, 0x2a, 0xe3, 0x0c, 0x7a, 0x16, 0x20,
-	0x38, 0x40, 0x70, 0x03, 0x19, 0xc5, 0xc2, 0xd8, 0xd2, 0x58, 0xc2, 0x99, 0x95, 0x1d, 0x4f, 0x18,
-	0xa5, 0x03, 0x6d, 0x74, 0x92, 0x9b, 0xdc, 0xf8, 0x92, 0xdc, 0x55, 0xa1, 0x9b, 0x54, 0xf0, 0xf8,
-	0xa3, 0x9f, 0x43, 0xe5, 0x65, 0x2d, 0xad, 0x43, 0x0c, 0x9e, 0x70, 0xa6, 0x17, 0x73, 0xce, 0x96,
-	0x4c, 0x0b, 0x69, 0xe7, 0xdf, 0xd4, 0xd2, 0xc9, 0x2a, 0x02, 0x13, 0x90, 0x0e, 0x67, 0xaf, 0xf0,
-	0x3f, 0xc5, 0x71, 0xc6, 0xf4, 0x22, 0x6b, 0x18, 0xef, 0x3c, 0x21, 0xdb, 0x5f, 0xdf, 0x8e, 0x01,
-	0x45, 0xfc, 0xd1, 0x4d, 0xf2, 0x03, 0xc0, 0xa7, 0xad, 0xa8, 0x5d, 0x19, 0x6d, 0x25, 0x3a, 0x85,
-	0x47, 0x7c, 0x69, 0xc4, 0x62, 0x5e, 0x48, 0x95, 0x17, 0xce, 0xab, 0xed, 0xd3, 0xa1, 0xef, 0x7d,
-	0xf0, 0x2d, 0xf4, 0x02, 0xc2, 0x00, 0x71, 0xaa, 0x94, 0xd1, 0xde, 0x04, 0xa4, 0x7d, 0x3a, 0xf0,
-	0x9d, 0x4f, 0xaa, 0x94, 0xe8, 0x3d, 0x3c, 0x7e, 0xe0, 0x3b, 0xea, 0x4f, 0xfa, 0xe9, 0x70, 0x96,
-	0xec, 0x36, 0x4c, 0x8f, 0xee, 0x7b, 0x4c, 0xae, 0x01, 0x1c, 0xde, 0xbb, 0x45, 0x11, 0x7c, 0xc2,
-	0x84, 0x30, 0xb5, 0x0e, 0xae, 0x06, 0xb4, 0x3d, 0xa2, 0x1c, 0x1e, 0x76, 0x6a, 0x7b, 0x5e, 0xed,
-	0x39, 0x0e, 0x21, 0xe0, 0xbb, 0x10, 0x3a, 0xad, 0xb7, 0x46, 0xe9, 0x6c, 0xba, 0xbe, 0x1d, 0xf7,
-	0x7e, 0xfe, 0x1e, 0xa7, 0xb9, 0x72, 0x45, 0xcd, 0xb1, 0x30, 0x25, 0x69, 0x12, 0x0b, 0x9f, 0x73,
-	0xfb, 0x75, 0x41, 0xdc, 0xd5, 0x4a, 0x5a, 0x4f, 0xb0, 0xb4, 0x1b, 0x9e, 0x4c, 0x21, 0x7a, 0xbc,
-	0x60, 0x34, 0x82, 0x87, 0x8d, 0x13, 0x1b, 0x81, 0x49, 0x3f, 0x1d, 0xd0, 0xee, 0x3c, 0x63, 0xf0,
-	0x20, 0x6c, 0x18, 0x7d, 0xee, 0xaa, 0x97, 0xff, 0x59, 0xc5, 0x83, 0xfc, 0x47, 0x67, 0x3b, 0x50,
-	0x21, 0xb0, 0x29, 0xc8, 0xde, 0xac, 0x37, 0x31, 0xb8, 0xd9, 0xc4, 0xe0, 0xcf, 0x26, 0x06, 0xd7,
-	0xdb, 0xb8, 0x77, 0xb3, 0x8d, 0x7b, 0xbf, 0xb6, 0x71, 0xef, 0xcb, 0x59, 0x98, 0x70, 0x2e, 0x4c,
-	0x25, 0x49, 0x5b, 0x17, 0x4c, 0xe9, 0xf6, 0x55, 0xfb, 0xbf, 0xe4, 0x07, 0xfe, 0x05, 0xbe, 0xfe,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x9f, 0x99, 0x18, 0xf3, 0x02, 0x00, 0x00,
+func (m *StakingDelegation) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// StreamClient is the client API for Stream service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type StreamClient interface {
-	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Stream_StreamClient, error)
+func (m *StakingDelegation) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
 }
 
-type streamClient struct {
-	cc grpc1.ClientConn
+func (m *StakingDelegation) GetCompletionTime() int64 {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return 0
 }
 
-func NewStreamClient(cc grpc1.ClientConn) StreamClient {
-	return &streamClient{cc}
+type HyperionDeposit struct {
+	HyperionId          uint64      `protobuf:"varint,1,opt,name=hyperion_id,json=hyperionId,proto3" json:"hyperion_id,omitempty"`
+	EventNonce          uint64      `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Sender              string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver            string      `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	TokenContract       string      `protobuf:"bytes,5,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount              types1.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	SentToCommunityPool bool        `protobuf:"varint,7,opt,name=sent_to_community_pool,json=sentToCommunityPool,proto3" json:"sent_to_community_pool,omitempty"`
 }
 
-func (c *streamClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Stream_StreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/helios.stream.v1beta1.Stream/Stream", opts...)
-	if err != nil {
-		return nil, err
+func (m *HyperionDeposit) Reset()         { *m = HyperionDeposit{} }
+func (m *HyperionDeposit) String() string { return proto.CompactTextString(m) }
+func (*HyperionDeposit) ProtoMessage()    {}
+func (*HyperionDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{4}
+}
+func (m *HyperionDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperionDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HyperionDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &streamStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+}
+func (m *HyperionDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperionDeposit.Merge(m, src)
+}
+func (m *HyperionDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperionDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperionDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperionDeposit proto.InternalMessageInfo
+
+func (m *HyperionDeposit) GetHyperionId() uint64 {
+	if m != nil {
+		return m.HyperionId
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *HyperionDeposit) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return x, nil
+	return 0
 }
 
-type Stream_StreamClient interface {
-	Recv() (*StreamResponse, error)
-	grpc.ClientStream
+func (m *HyperionDeposit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type streamStreamClient struct {
-	grpc.ClientStream
+func (m *HyperionDeposit) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-func (x *streamStreamClient) Recv() (*StreamResponse, error) {
-	m := new(StreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *HyperionDeposit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	return m, nil
+	return ""
 }
 
-// StreamServer is the server API for Stream service.
-type StreamServer interface {
-	Stream(*StreamRequest, Stream_StreamServer) error
+func (m *HyperionDeposit) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
 }
 
-// UnimplementedStreamServer can be embedded to have forward compatible implementations.
-type UnimplementedStreamServer struct {
+func (m *HyperionDeposit) GetSentToCommunityPool() bool {
+	if m != nil {
+		return m.SentToCommunityPool
+	}
+	return false
 }
 
-func (*UnimplementedStreamServer) Stream(req *StreamRequest, srv Stream_StreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+type HyperionWithdrawal struct {
+	HyperionId   uint64      `protobuf:"varint,1,opt,name=hyperion_id,json=hyperionId,proto3" json:"hyperion_id,omitempty"`
+	OutgoingTxId uint64      `protobuf:"varint,2,opt,name=outgoing_tx_id,json=outgoingTxId,proto3" json:"outgoing_tx_id,omitempty"`
+	Sender       string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver     string      `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount       types1.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	BridgeFee    types1.Coin `protobuf:"bytes,6,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
 }
 
-func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
-	s.RegisterService(&_Stream_serviceDesc, srv)
+func (m *HyperionWithdrawal) Reset()         { *m = HyperionWithdrawal{} }
+func (m *HyperionWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*HyperionWithdrawal) ProtoMessage()    {}
+func (*HyperionWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{5}
+}
+func (m *HyperionWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperionWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HyperionWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HyperionWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperionWithdrawal.Merge(m, src)
+}
+func (m *HyperionWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperionWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperionWithdrawal.DiscardUnknown(m)
 }
 
-func _Stream_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_HyperionWithdrawal proto.InternalMessageInfo
+
+func (m *HyperionWithdrawal) GetHyperionId() uint64 {
+	if m != nil {
+		return m.HyperionId
 	}
-	return srv.(StreamServer).Stream(m, &streamStreamServer{stream})
+	return 0
 }
 
-type Stream_StreamServer interface {
-	Send(*StreamResponse) error
-	grpc.ServerStream
+func (m *HyperionWithdrawal) GetOutgoingTxId() uint64 {
+	if m != nil {
+		return m.OutgoingTxId
+	}
+	return 0
 }
 
-type streamStreamServer struct {
-	grpc.ServerStream
+func (m *HyperionWithdrawal) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (x *streamStreamServer) Send(m *StreamResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *HyperionWithdrawal) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-var _Stream_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "helios.stream.v1beta1.Stream",
-	HandlerType: (*StreamServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Stream",
-			Handler:       _Stream_Stream_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "helios/stream/v1beta1/query.proto",
+func (m *HyperionWithdrawal) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
 }
 
-func (m *StreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HyperionWithdrawal) GetBridgeFee() types1.Coin {
+	if m != nil {
+		return m.BridgeFee
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *StreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type HyperionBatchExecution struct {
+	HyperionId    uint64   `protobuf:"varint,1,opt,name=hyperion_id,json=hyperionId,proto3" json:"hyperion_id,omitempty"`
+	TokenContract string   `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce    uint64   `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TxIds         []uint64 `protobuf:"varint,4,rep,packed,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	Orchestrator  string   `protobuf:"bytes,5,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 }
 
-func (m *StreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BankBalancesFilter != nil {
-		{
-			size, err := m.BankBalancesFilter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *HyperionBatchExecution) Reset()         { *m = HyperionBatchExecution{} }
+func (m *HyperionBatchExecution) String() string { return proto.CompactTextString(m) }
+func (*HyperionBatchExecution) ProtoMessage()    {}
+func (*HyperionBatchExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{6}
+}
+func (m *HyperionBatchExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperionBatchExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HyperionBatchExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *HyperionBatchExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperionBatchExecution.Merge(m, src)
+}
+func (m *HyperionBatchExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperionBatchExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperionBatchExecution.DiscardUnknown(m)
 }
 
-func (m *StreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HyperionBatchExecution proto.InternalMessageInfo
+
+func (m *HyperionBatchExecution) GetHyperionId() uint64 {
+	if m != nil {
+		return m.HyperionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HyperionBatchExecution) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
 }
 
-func (m *StreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BankBalances) > 0 {
-		for iNdEx := len(m.BankBalances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BankBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.BlockTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTime))
-		i--
-		dAtA[i] = 0x10
+func (m *HyperionBatchExecution) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *HyperionBatchExecution) GetTxIds() []uint64 {
+	if m != nil {
+		return m.TxIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BankBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HyperionBatchExecution) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BankBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CronExecution struct {
+	CronId      uint64 `protobuf:"varint,1,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`
+	Owner       string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	CronAddress string `protobuf:"bytes,3,opt,name=cron_address,json=cronAddress,proto3" json:"cron_address,omitempty"`
+	TxHash      string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Nonce       uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasUsed     uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Error       string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *BankBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *CronExecution) Reset()         { *m = CronExecution{} }
+func (m *CronExecution) String() string { return proto.CompactTextString(m) }
+func (*CronExecution) ProtoMessage()    {}
+func (*CronExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{7}
+}
+func (m *CronExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *CronExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronExecution.Merge(m, src)
+}
+func (m *CronExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronExecution.DiscardUnknown(m)
 }
 
-func (m *BankBalancesFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CronExecution proto.InternalMessageInfo
+
+func (m *CronExecution) GetCronId() uint64 {
+	if m != nil {
+		return m.CronId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BankBalancesFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CronExecution) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *BankBalancesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Accounts[iNdEx])
-			copy(dAtA[i:], m.Accounts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *CronExecution) GetCronAddress() string {
+	if m != nil {
+		return m.CronAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CronExecution) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *StreamRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BankBalancesFilter != nil {
-		l = m.BankBalancesFilter.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *CronExecution) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return n
+	return 0
 }
 
-func (m *StreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+func (m *CronExecution) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	if m.BlockTime != 0 {
-		n += 1 + sovQuery(uint64(m.BlockTime))
+	return 0
+}
+
+func (m *CronExecution) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.BankBalances) > 0 {
-		for _, e := range m.BankBalances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type BankBalancesFilter struct {
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *BankBalancesFilter) Reset()         { *m = BankBalancesFilter{} }
+func (m *BankBalancesFilter) String() string { return proto.CompactTextString(m) }
+func (*BankBalancesFilter) ProtoMessage()    {}
+func (*BankBalancesFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{8}
+}
+func (m *BankBalancesFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BankBalancesFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BankBalancesFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BankBalancesFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BankBalancesFilter.Merge(m, src)
+}
+func (m *BankBalancesFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *BankBalancesFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BankBalancesFilter.DiscardUnknown(m)
 }
 
-func (m *BankBalance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_BankBalancesFilter proto.InternalMessageInfo
+
+func (m *BankBalancesFilter) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
 	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// EvmLogsFilter matches the logs by contract address, the topics are matched by
+// position like in eth_getLogs, an empty position matches any topic.
+type EvmLogsFilter struct {
+	Addresses []string       `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Topics    []EvmLogTopics `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics"`
+}
+
+func (m *EvmLogsFilter) Reset()         { *m = EvmLogsFilter{} }
+func (m *EvmLogsFilter) String() string { return proto.CompactTextString(m) }
+func (*EvmLogsFilter) ProtoMessage()    {}
+func (*EvmLogsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{9}
+}
+func (m *EvmLogsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmLogsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmLogsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmLogsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmLogsFilter.Merge(m, src)
+}
+func (m *EvmLogsFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmLogsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmLogsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmLogsFilter proto.InternalMessageInfo
+
+func (m *EvmLogsFilter) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *EvmLogsFilter) GetTopics() []EvmLogTopics {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type EvmLogTopics struct {
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (m *EvmLogTopics) Reset()         { *m = EvmLogTopics{} }
+func (m *EvmLogTopics) String() string { return proto.CompactTextString(m) }
+func (*EvmLogTopics) ProtoMessage()    {}
+func (*EvmLogTopics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{10}
+}
+func (m *EvmLogTopics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmLogTopics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmLogTopics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvmLogTopics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmLogTopics.Merge(m, src)
+}
+func (m *EvmLogTopics) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmLogTopics) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmLogTopics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmLogTopics proto.InternalMessageInfo
+
+func (m *EvmLogTopics) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type StakingDelegationsFilter struct {
+	Delegators []string `protobuf:"bytes,1,rep,name=delegators,proto3" json:"delegators,omitempty"`
+}
+
+func (m *StakingDelegationsFilter) Reset()         { *m = StakingDelegationsFilter{} }
+func (m *StakingDelegationsFilter) String() string { return proto.CompactTextString(m) }
+func (*StakingDelegationsFilter) ProtoMessage()    {}
+func (*StakingDelegationsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{11}
+}
+func (m *StakingDelegationsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingDelegationsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingDelegationsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingDelegationsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingDelegationsFilter.Merge(m, src)
+}
+func (m *StakingDelegationsFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingDelegationsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingDelegationsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingDelegationsFilter proto.InternalMessageInfo
+
+func (m *StakingDelegationsFilter) GetDelegators() []string {
+	if m != nil {
+		return m.Delegators
+	}
+	return nil
+}
+
+type HyperionDepositsFilter struct {
+	Receivers []string `protobuf:"bytes,1,rep,name=receivers,proto3" json:"receivers,omitempty"`
+}
+
+func (m *HyperionDepositsFilter) Reset()         { *m = HyperionDepositsFilter{} }
+func (m *HyperionDepositsFilter) String() string { return proto.CompactTextString(m) }
+func (*HyperionDepositsFilter) ProtoMessage()    {}
+func (*HyperionDepositsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{12}
+}
+func (m *HyperionDepositsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperionDepositsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HyperionDepositsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HyperionDepositsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperionDepositsFilter.Merge(m, src)
+}
+func (m *HyperionDepositsFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperionDepositsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperionDepositsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperionDepositsFilter proto.InternalMessageInfo
+
+func (m *HyperionDepositsFilter) GetReceivers() []string {
+	if m != nil {
+		return m.Receivers
+	}
+	return nil
+}
+
+type HyperionWithdrawalsFilter struct {
+	Senders []string `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+}
+
+func (m *HyperionWithdrawalsFilter) Reset()         { *m = HyperionWithdrawalsFilter{} }
+func (m *HyperionWithdrawalsFilter) String() string { return proto.CompactTextString(m) }
+func (*HyperionWithdrawalsFilter) ProtoMessage()    {}
+func (*HyperionWithdrawalsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{13}
+}
+func (m *HyperionWithdrawalsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperionWithdrawalsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HyperionWithdrawalsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HyperionWithdrawalsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperionWithdrawalsFilter.Merge(m, src)
+}
+func (m *HyperionWithdrawalsFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperionWithdrawalsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperionWithdrawalsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperionWithdrawalsFilter proto.InternalMessageInfo
+
+func (m *HyperionWithdrawalsFilter) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+type HyperionBatchExecutionsFilter struct {
+	TokenContracts []string `protobuf:"bytes,1,rep,name=token_contracts,json=tokenContracts,proto3" json:"token_contracts,omitempty"`
+}
+
+func (m *HyperionBatchExecutionsFilter) Reset()         { *m = HyperionBatchExecutionsFilter{} }
+func (m *HyperionBatchExecutionsFilter) String() string { return proto.CompactTextString(m) }
+func (*HyperionBatchExecutionsFilter) ProtoMessage()    {}
+func (*HyperionBatchExecutionsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{14}
+}
+func (m *HyperionBatchExecutionsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperionBatchExecutionsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HyperionBatchExecutionsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HyperionBatchExecutionsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperionBatchExecutionsFilter.Merge(m, src)
+}
+func (m *HyperionBatchExecutionsFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperionBatchExecutionsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperionBatchExecutionsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperionBatchExecutionsFilter proto.InternalMessageInfo
+
+func (m *HyperionBatchExecutionsFilter) GetTokenContracts() []string {
+	if m != nil {
+		return m.TokenContracts
+	}
+	return nil
+}
+
+type CronExecutionsFilter struct {
+	Owners []string `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+}
+
+func (m *CronExecutionsFilter) Reset()         { *m = CronExecutionsFilter{} }
+func (m *CronExecutionsFilter) String() string { return proto.CompactTextString(m) }
+func (*CronExecutionsFilter) ProtoMessage()    {}
+func (*CronExecutionsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59fb6958fb6ccc01, []int{15}
+}
+func (m *CronExecutionsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronExecutionsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronExecutionsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CronExecutionsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronExecutionsFilter.Merge(m, src)
+}
+func (m *CronExecutionsFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronExecutionsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronExecutionsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronExecutionsFilter proto.InternalMessageInfo
+
+func (m *CronExecutionsFilter) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StreamRequest)(nil), "helios.stream.v1beta1.StreamRequest")
+	proto.RegisterType((*StreamResponse)(nil), "helios.stream.v1beta1.StreamResponse")
+	proto.RegisterType((*BankBalance)(nil), "helios.stream.v1beta1.BankBalance")
+	proto.RegisterType((*StakingDelegation)(nil), "helios.stream.v1beta1.StakingDelegation")
+	proto.RegisterType((*HyperionDeposit)(nil), "helios.stream.v1beta1.HyperionDeposit")
+	proto.RegisterType((*HyperionWithdrawal)(nil), "helios.stream.v1beta1.HyperionWithdrawal")
+	proto.RegisterType((*HyperionBatchExecution)(nil), "helios.stream.v1beta1.HyperionBatchExecution")
+	proto.RegisterType((*CronExecution)(nil), "helios.stream.v1beta1.CronExecution")
+	proto.RegisterType((*BankBalancesFilter)(nil), "helios.stream.v1beta1.BankBalancesFilter")
+	proto.RegisterType((*EvmLogsFilter)(nil), "helios.stream.v1beta1.EvmLogsFilter")
+	proto.RegisterType((*EvmLogTopics)(nil), "helios.stream.v1beta1.EvmLogTopics")
+	proto.RegisterType((*StakingDelegationsFilter)(nil), "helios.stream.v1beta1.StakingDelegationsFilter")
+	proto.RegisterType((*HyperionDepositsFilter)(nil), "helios.stream.v1beta1.HyperionDepositsFilter")
+	proto.RegisterType((*HyperionWithdrawalsFilter)(nil), "helios.stream.v1beta1.HyperionWithdrawalsFilter")
+	proto.RegisterType((*HyperionBatchExecutionsFilter)(nil), "helios.stream.v1beta1.HyperionBatchExecutionsFilter")
+	proto.RegisterType((*CronExecutionsFilter)(nil), "helios.stream.v1beta1.CronExecutionsFilter")
+}
+
+func init() { proto.RegisterFile("helios/stream/v1beta1/query.proto", fileDescriptor_59fb6958fb6ccc01) }
+
+var fileDescriptor_59fb6958fb6ccc01 = []byte{
+	// 1312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x36, 0x2d, 0x89, 0x96, 0x46, 0x7e, 0x34, 0x1b, 0xc7, 0xa1, 0xdd, 0x46, 0x56, 0xd8, 0x24,
+	0x75, 0x51, 0x84, 0x72, 0x92, 0x3e, 0x80, 0x1e, 0x5a, 0xc4, 0x79, 0xd4, 0x06, 0xd2, 0xa2, 0x60,
+	0x5c, 0x04, 0x2d, 0x0a, 0x10, 0x2b, 0x72, 0x43, 0x12, 0x16, 0xb9, 0x0a, 0x77, 0xa5, 0xd8, 0xe7,
+	0x5e, 0x7a, 0xcc, 0xa5, 0xb7, 0xfe, 0x82, 0x1e, 0x7b, 0xee, 0xa5, 0x87, 0x02, 0x39, 0xe6, 0xd8,
+	0x53, 0x5a, 0xc4, 0x7f, 0xa4, 0xd8, 0x5d, 0x92, 0x12, 0x2d, 0x51, 0x72, 0x7a, 0x12, 0x77, 0x76,
+	0x66, 0xbe, 0x7d, 0x7c, 0xdf, 0xec, 0x08, 0xae, 0x06, 0xa4, 0x17, 0x52, 0xd6, 0x61, 0x3c, 0x21,
+	0x38, 0xea, 0x0c, 0x6f, 0x75, 0x09, 0xc7, 0xb7, 0x3a, 0xcf, 0x06, 0x24, 0x39, 0xb1, 0xfa, 0x09,
+	0xe5, 0x14, 0x5d, 0x52, 0x2e, 0x96, 0x72, 0xb1, 0x52, 0x97, 0xad, 0x96, 0x4b, 0x59, 0x44, 0x59,
+	0xa7, 0x8b, 0x19, 0xc9, 0xe3, 0x5c, 0x1a, 0xc6, 0x2a, 0x6c, 0x6b, 0xdd, 0xa7, 0x3e, 0x95, 0x9f,
+	0x1d, 0xf1, 0x95, 0x5a, 0xb7, 0x08, 0x0f, 0x48, 0x12, 0x85, 0x31, 0xef, 0x90, 0xa1, 0xc0, 0x13,
+	0x3f, 0x6a, 0xce, 0xfc, 0x5d, 0x87, 0x95, 0xc7, 0x12, 0xc4, 0x26, 0xcf, 0x06, 0x84, 0x71, 0x84,
+	0x61, 0xbd, 0x8b, 0xe3, 0x23, 0xa7, 0x8b, 0x7b, 0x38, 0x76, 0x09, 0x73, 0x9e, 0x86, 0x3d, 0x4e,
+	0x12, 0x43, 0x6b, 0x6b, 0x3b, 0xcd, 0xdb, 0x1f, 0x5a, 0x53, 0x57, 0x66, 0xed, 0xe1, 0xf8, 0x68,
+	0x2f, 0x8d, 0x78, 0x28, 0x03, 0xf6, 0xaa, 0x2f, 0x5f, 0x6f, 0x6b, 0x36, 0xea, 0x4e, 0xcc, 0x20,
+	0x1b, 0xd6, 0xc8, 0x30, 0x72, 0x7a, 0xd4, 0xcf, 0xb3, 0x2f, 0xca, 0xec, 0xd7, 0x4a, 0xb2, 0x3f,
+	0x18, 0x46, 0x8f, 0xa8, 0x5f, 0x4c, 0xbc, 0x42, 0xc6, 0x8d, 0x88, 0xc1, 0x16, 0xe3, 0xf8, 0x28,
+	0x8c, 0x7d, 0xc7, 0x23, 0x3d, 0xe2, 0x63, 0x1e, 0xd2, 0x38, 0x4f, 0x5f, 0x91, 0xe9, 0x3b, 0x25,
+	0xe9, 0x1f, 0xab, 0xc0, 0xfb, 0xa3, 0xb8, 0x02, 0x92, 0xc1, 0x4a, 0xe6, 0x51, 0x04, 0x46, 0x70,
+	0xd2, 0x27, 0x49, 0x48, 0x63, 0xc7, 0x23, 0x7d, 0xca, 0x42, 0x9e, 0x43, 0x56, 0x25, 0xe4, 0xcd,
+	0x12, 0xc8, 0xfd, 0x34, 0xec, 0x7e, 0x1a, 0x55, 0x00, 0xdc, 0x08, 0xa6, 0xce, 0xa2, 0x21, 0xbc,
+	0x9b, 0xc3, 0x3d, 0x0f, 0x79, 0xe0, 0x25, 0xf8, 0x39, 0xee, 0xe5, 0x88, 0x35, 0x89, 0xb8, 0x3b,
+	0x07, 0xf1, 0xc9, 0x28, 0xb0, 0x00, 0xba, 0x19, 0x94, 0x39, 0xa0, 0x9f, 0x34, 0x68, 0xe7, 0xc0,
+	0x5d, 0xcc, 0xdd, 0xc0, 0x21, 0xc7, 0xc4, 0x1d, 0x14, 0x8e, 0x58, 0x97, 0xe8, 0x1f, 0xcf, 0x41,
+	0xdf, 0x13, 0xd1, 0x0f, 0xf2, 0xe0, 0xc2, 0x0a, 0xae, 0x04, 0xb3, 0x9c, 0x90, 0x0f, 0x1b, 0x6e,
+	0x42, 0xe3, 0x29, 0xd0, 0x4b, 0x12, 0xfa, 0xa3, 0x12, 0xe8, 0x7b, 0x09, 0x8d, 0x4b, 0x10, 0xd7,
+	0xdd, 0x29, 0x73, 0x68, 0x1b, 0x9a, 0x4f, 0x13, 0x1a, 0x39, 0x01, 0x09, 0xfd, 0x80, 0x1b, 0xf5,
+	0xb6, 0xb6, 0x53, 0xb5, 0x41, 0x98, 0xf6, 0xa5, 0xc5, 0xfc, 0xa5, 0x06, 0xab, 0x99, 0x68, 0x58,
+	0x9f, 0xc6, 0x8c, 0xa0, 0xab, 0xb0, 0xdc, 0xed, 0x51, 0xf7, 0x28, 0x0b, 0xd2, 0x64, 0x50, 0x53,
+	0xda, 0x54, 0x14, 0xba, 0x02, 0xa0, 0x5c, 0x78, 0x18, 0x11, 0x49, 0xf8, 0x8a, 0xdd, 0x90, 0x96,
+	0xc3, 0x30, 0x22, 0xe8, 0x2b, 0x58, 0x29, 0xe8, 0xce, 0xa8, 0xb4, 0x2b, 0x3b, 0xcd, 0xdb, 0xe6,
+	0x7c, 0xc1, 0xd9, 0xcb, 0xe3, 0x1a, 0x43, 0xbb, 0x50, 0xcf, 0xd4, 0x65, 0x54, 0x65, 0x8e, 0x4b,
+	0x56, 0x5e, 0x01, 0x2c, 0x21, 0xfd, 0xe1, 0x2d, 0xeb, 0x11, 0xf5, 0xed, 0xa5, 0x54, 0x41, 0xe8,
+	0x7b, 0xb8, 0x38, 0x45, 0x3b, 0x46, 0x4d, 0x06, 0xef, 0x9c, 0x57, 0x34, 0x36, 0x9a, 0xd4, 0x09,
+	0x7a, 0x0c, 0x17, 0x26, 0x14, 0x62, 0xe8, 0x32, 0xf1, 0x8d, 0xf3, 0x49, 0xc3, 0x7e, 0xe7, 0xac,
+	0x1a, 0xd0, 0x8f, 0xb0, 0x3e, 0x4d, 0x07, 0xc6, 0x52, 0xbb, 0x32, 0xa3, 0x44, 0x4d, 0x0a, 0xc0,
+	0xbe, 0x38, 0x85, 0xf3, 0x28, 0x84, 0xcd, 0x52, 0xb2, 0x1b, 0xf5, 0x76, 0xe5, 0x1c, 0xaa, 0x2e,
+	0x12, 0xd8, 0xbe, 0x5c, 0x42, 0x6c, 0xf4, 0x35, 0xac, 0x9d, 0xa1, 0xb4, 0xd1, 0x68, 0x57, 0x66,
+	0x14, 0xc2, 0x02, 0x97, 0xed, 0xd5, 0x22, 0x7d, 0xcd, 0x17, 0x1a, 0x34, 0xc7, 0x78, 0x81, 0x0c,
+	0x58, 0xc2, 0xae, 0x4b, 0x07, 0xb1, 0xe2, 0x63, 0xc3, 0xce, 0x86, 0xc8, 0x87, 0x7a, 0xce, 0xb3,
+	0x45, 0x89, 0xb8, 0x69, 0xa9, 0xb7, 0xc5, 0x12, 0x6f, 0xcb, 0x08, 0x8f, 0x86, 0xf1, 0xde, 0xee,
+	0xcb, 0xd7, 0xdb, 0x0b, 0xbf, 0xfd, 0xb3, 0xbd, 0xe3, 0x87, 0x3c, 0x18, 0x74, 0x2d, 0x97, 0x46,
+	0x9d, 0xf4, 0x21, 0x52, 0x3f, 0x37, 0x99, 0x77, 0xd4, 0xe1, 0x27, 0x7d, 0xc2, 0x64, 0x00, 0xb3,
+	0xf3, 0xe4, 0xe6, 0x5f, 0x1a, 0x5c, 0x98, 0x60, 0x0a, 0x42, 0x50, 0x15, 0xee, 0xe9, 0xaa, 0xe4,
+	0x37, 0x7a, 0x0f, 0x1a, 0x29, 0xf9, 0xa8, 0x7a, 0x0e, 0x1a, 0xf6, 0xc8, 0x20, 0x66, 0x87, 0xb8,
+	0x17, 0x7a, 0x72, 0xb6, 0xa2, 0x66, 0x73, 0x03, 0xfa, 0x0c, 0x74, 0x1c, 0xc9, 0x7d, 0xaa, 0xaa,
+	0x3b, 0x63, 0x33, 0x42, 0xf8, 0x0b, 0x76, 0xea, 0x8e, 0x3e, 0x80, 0x35, 0x97, 0x46, 0xfd, 0x1e,
+	0x11, 0xcb, 0x52, 0xc2, 0xac, 0x49, 0x61, 0xae, 0x8e, 0xcc, 0x42, 0x9d, 0xe6, 0xaf, 0x8b, 0xb0,
+	0x76, 0x86, 0x98, 0xa2, 0x4e, 0xe4, 0x44, 0x09, 0xbd, 0x54, 0xf2, 0x90, 0x99, 0x0e, 0x3c, 0xe1,
+	0x40, 0x86, 0x24, 0xe6, 0x4e, 0x4c, 0x63, 0x57, 0x49, 0xbe, 0x6a, 0x83, 0x34, 0x7d, 0x23, 0x2c,
+	0x68, 0x03, 0x74, 0x46, 0x62, 0x8f, 0x64, 0x5b, 0x4a, 0x47, 0x68, 0x0b, 0xea, 0x09, 0x71, 0x49,
+	0x38, 0x4c, 0xdf, 0x91, 0x86, 0x9d, 0x8f, 0xd1, 0x75, 0x58, 0xe5, 0xf4, 0x88, 0xc4, 0x8e, 0x4b,
+	0x63, 0x9e, 0x60, 0x97, 0xcb, 0x15, 0x37, 0xec, 0x15, 0x69, 0xbd, 0x97, 0x1a, 0xc7, 0x8e, 0x44,
+	0x7f, 0xbb, 0x23, 0xb9, 0x03, 0x1b, 0x4c, 0xac, 0x99, 0x53, 0xc7, 0xa5, 0x51, 0x34, 0x88, 0x43,
+	0x7e, 0xe2, 0xf4, 0x29, 0xed, 0xc9, 0x32, 0x5b, 0xb7, 0x2f, 0x8a, 0xd9, 0x43, 0x7a, 0x2f, 0x9b,
+	0xfb, 0x96, 0xd2, 0x9e, 0xf9, 0xf3, 0x22, 0xa0, 0x49, 0x7d, 0xcd, 0x3f, 0xa1, 0x6b, 0xb0, 0x4a,
+	0x07, 0xdc, 0xa7, 0xa2, 0xf4, 0xf0, 0x63, 0xe1, 0xa3, 0x0e, 0x69, 0x39, 0xb3, 0x1e, 0x1e, 0x1f,
+	0x78, 0xff, 0xeb, 0x98, 0x46, 0xfb, 0xaf, 0xbd, 0xdd, 0xfe, 0xbf, 0x00, 0xe8, 0x26, 0xa1, 0xe7,
+	0x13, 0xe7, 0x29, 0x21, 0xe7, 0x3d, 0xbc, 0x86, 0x0a, 0x79, 0x48, 0x88, 0xf9, 0x87, 0x06, 0x1b,
+	0xd3, 0xeb, 0xc0, 0xfc, 0xe3, 0x98, 0xbc, 0xdb, 0xc5, 0x69, 0x77, 0xbb, 0x0d, 0x4d, 0x55, 0x98,
+	0x14, 0xaf, 0x2a, 0x2a, 0x8f, 0x34, 0x29, 0x5e, 0x5d, 0x02, 0x5d, 0x9e, 0xa6, 0x7a, 0x00, 0xaa,
+	0x76, 0x8d, 0x1f, 0x1f, 0x78, 0x0c, 0x99, 0xb0, 0x4c, 0x13, 0x37, 0x20, 0x8c, 0x27, 0x52, 0x47,
+	0x8a, 0x38, 0x05, 0x9b, 0xf9, 0xa7, 0x06, 0x2b, 0x85, 0x2a, 0x83, 0x2e, 0xc3, 0x92, 0x9b, 0x8c,
+	0xaf, 0x58, 0x17, 0xc3, 0x03, 0x0f, 0xad, 0x43, 0x8d, 0x3e, 0x8f, 0x49, 0xa6, 0x56, 0x35, 0x10,
+	0x2f, 0xa1, 0x74, 0xc7, 0x9e, 0x97, 0x10, 0xc6, 0xd2, 0x2b, 0x6b, 0x0a, 0xdb, 0x5d, 0x65, 0x12,
+	0x19, 0xf9, 0xb1, 0x13, 0x60, 0x16, 0xa4, 0xd7, 0xa6, 0xf3, 0xe3, 0x7d, 0xcc, 0x02, 0x91, 0x51,
+	0x6d, 0xa9, 0x26, 0x81, 0xd4, 0x00, 0x6d, 0x42, 0xdd, 0xc7, 0xcc, 0x19, 0x30, 0xe2, 0xc9, 0xfb,
+	0xa8, 0xda, 0x4b, 0x3e, 0x66, 0xdf, 0x31, 0x22, 0x97, 0x40, 0x92, 0x84, 0xaa, 0x16, 0xa0, 0x61,
+	0xab, 0x81, 0xb9, 0x0b, 0x68, 0xb2, 0x1f, 0x15, 0x6c, 0x49, 0xcb, 0x1f, 0x33, 0xb4, 0x76, 0x45,
+	0xb0, 0x25, 0x1b, 0x9b, 0x7d, 0x58, 0x29, 0xf4, 0x98, 0xa2, 0xde, 0xa4, 0x1b, 0x20, 0x99, 0xf7,
+	0xc8, 0x80, 0xee, 0x82, 0xce, 0x69, 0x3f, 0x74, 0xb3, 0xe2, 0xf9, 0xfe, 0xcc, 0xbe, 0xf5, 0x50,
+	0xba, 0x66, 0x34, 0x53, 0x81, 0xe6, 0x0d, 0x58, 0x1e, 0x9f, 0x15, 0x1c, 0x4f, 0x53, 0x2a, 0xb4,
+	0xcc, 0xef, 0x73, 0x30, 0xca, 0xda, 0x53, 0xd4, 0x02, 0xc8, 0x2b, 0x64, 0x16, 0x37, 0x66, 0x31,
+	0x3f, 0x85, 0x8d, 0x33, 0x35, 0x6b, 0x6c, 0x7b, 0x99, 0x52, 0xf2, 0xed, 0xe5, 0x06, 0xf3, 0x13,
+	0xd8, 0x2c, 0xed, 0x16, 0xc5, 0xa3, 0xa2, 0xe4, 0x97, 0x05, 0x66, 0x43, 0x73, 0x1f, 0xae, 0xcc,
+	0x6c, 0xf3, 0x44, 0xb5, 0x2d, 0xd2, 0x3b, 0x4b, 0xb1, 0x5a, 0xe0, 0x37, 0x33, 0x2d, 0x58, 0x9f,
+	0xd6, 0xb5, 0x89, 0x43, 0x92, 0x24, 0xcb, 0x0f, 0x49, 0x8d, 0x6e, 0x63, 0xd0, 0x55, 0x3f, 0x86,
+	0x9e, 0xe4, 0x5f, 0xd7, 0x4a, 0xfb, 0x96, 0xb1, 0x7f, 0x3b, 0x5b, 0xd7, 0xe7, 0x78, 0xa9, 0xf6,
+	0x6e, 0x57, 0xdb, 0xfb, 0xf2, 0xe5, 0x9b, 0x96, 0xf6, 0xea, 0x4d, 0x4b, 0xfb, 0xf7, 0x4d, 0x4b,
+	0x7b, 0x71, 0xda, 0x5a, 0x78, 0x75, 0xda, 0x5a, 0xf8, 0xfb, 0xb4, 0xb5, 0xf0, 0xc3, 0x75, 0x95,
+	0xe1, 0xa6, 0x4b, 0x13, 0xd2, 0xc9, 0xbe, 0x03, 0x1c, 0xc6, 0xd9, 0x1f, 0x3c, 0xf9, 0x32, 0x76,
+	0x75, 0xf9, 0x87, 0xeb, 0xce, 0x7f, 0x03, 0x00, 0xd4, 0x6d, 0xcb, 0x10, 0xfe, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Stream_StreamClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Stream_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/helios.stream.v1beta1.Stream/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_StreamClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type streamStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamStreamClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	Stream(*StreamRequest, Stream_StreamServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) Stream(req *StreamRequest, srv Stream_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).Stream(m, &streamStreamServer{stream})
+}
+
+type Stream_StreamServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type streamStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamStreamServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Stream_serviceDesc = _Stream_serviceDesc
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "helios.stream.v1beta1.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _Stream_Stream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "helios/stream/v1beta1/query.proto",
+}
+
+func (m *StreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CronExecutionsFilter != nil {
+		{
+			size, err := m.CronExecutionsFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HyperionBatchExecutionsFilter != nil {
+		{
+			size, err := m.HyperionBatchExecutionsFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HyperionWithdrawalsFilter != nil {
+		{
+			size, err := m.HyperionWithdrawalsFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HyperionDepositsFilter != nil {
+		{
+			size, err := m.HyperionDepositsFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StakingDelegationsFilter != nil {
+		{
+			size, err := m.StakingDelegationsFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EvmLogsFilter != nil {
+		{
+			size, err := m.EvmLogsFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BankBalancesFilter != nil {
+		{
+			size, err := m.BankBalancesFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CronExecutions) > 0 {
+		for iNdEx := len(m.CronExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CronExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.HyperionBatchExecutions) > 0 {
+		for iNdEx := len(m.HyperionBatchExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HyperionBatchExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.HyperionWithdrawals) > 0 {
+		for iNdEx := len(m.HyperionWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HyperionWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.HyperionDeposits) > 0 {
+		for iNdEx := len(m.HyperionDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HyperionDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.StakingDelegations) > 0 {
+		for iNdEx := len(m.StakingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EvmLogs) > 0 {
+		for iNdEx := len(m.EvmLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BankBalances) > 0 {
+		for iNdEx := len(m.BankBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BankBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BankBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BankBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BankBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CompletionTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperionDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperionDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperionDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SentToCommunityPool {
+		i--
+		if m.SentToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HyperionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HyperionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperionWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperionWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperionWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OutgoingTxId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OutgoingTxId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HyperionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HyperionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperionBatchExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperionBatchExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperionBatchExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TxIds) > 0 {
+		dAtA13 := make([]byte, len(m.TxIds)*10)
+		var j12 int
+		for _, num := range m.TxIds {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HyperionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HyperionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CronAddress) > 0 {
+		i -= len(m.CronAddress)
+		copy(dAtA[i:], m.CronAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CronAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CronId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BankBalancesFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BankBalancesFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BankBalancesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmLogsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmLogsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmLogsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmLogTopics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmLogTopics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmLogTopics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingDelegationsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingDelegationsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingDelegationsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Delegators[iNdEx])
+			copy(dAtA[i:], m.Delegators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperionDepositsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperionDepositsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperionDepositsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Receivers[iNdEx])
+			copy(dAtA[i:], m.Receivers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Receivers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperionWithdrawalsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperionWithdrawalsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperionWithdrawalsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperionBatchExecutionsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperionBatchExecutionsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperionBatchExecutionsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenContracts) > 0 {
+		for iNdEx := len(m.TokenContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenContracts[iNdEx])
+			copy(dAtA[i:], m.TokenContracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronExecutionsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronExecutionsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronExecutionsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BankBalancesFilter != nil {
+		l = m.BankBalancesFilter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EvmLogsFilter != nil {
+		l = m.EvmLogsFilter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingDelegationsFilter != nil {
+		l = m.StakingDelegationsFilter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HyperionDepositsFilter != nil {
+		l = m.HyperionDepositsFilter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HyperionWithdrawalsFilter != nil {
+		l = m.HyperionWithdrawalsFilter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HyperionBatchExecutionsFilter != nil {
+		l = m.HyperionBatchExecutionsFilter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CronExecutionsFilter != nil {
+		l = m.CronExecutionsFilter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *StreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTime))
+	}
+	if len(m.BankBalances) > 0 {
+		for _, e := range m.BankBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EvmLogs) > 0 {
+		for _, e := range m.EvmLogs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.StakingDelegations) > 0 {
+		for _, e := range m.StakingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.HyperionDeposits) > 0 {
+		for _, e := range m.HyperionDeposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.HyperionWithdrawals) > 0 {
+		for _, e := range m.HyperionWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.HyperionBatchExecutions) > 0 {
+		for _, e := range m.HyperionBatchExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CronExecutions) > 0 {
+		for _, e := range m.CronExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BankBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CompletionTime != 0 {
+		n += 1 + sovQuery(uint64(m.CompletionTime))
+	}
+	return n
+}
+
+func (m *HyperionDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HyperionId != 0 {
+		n += 1 + sovQuery(uint64(m.HyperionId))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SentToCommunityPool {
+		n += 2
+	}
+	return n
+}
+
+func (m *HyperionWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HyperionId != 0 {
+		n += 1 + sovQuery(uint64(m.HyperionId))
+	}
+	if m.OutgoingTxId != 0 {
+		n += 1 + sovQuery(uint64(m.OutgoingTxId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *HyperionBatchExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HyperionId != 0 {
+		n += 1 + sovQuery(uint64(m.HyperionId))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	if len(m.TxIds) > 0 {
+		l = 0
+		for _, e := range m.TxIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CronExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronId != 0 {
+		n += 1 + sovQuery(uint64(m.CronId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CronAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BankBalancesFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvmLogsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvmLogTopics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakingDelegationsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for _, s := range m.Delegators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HyperionDepositsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receivers) > 0 {
+		for _, s := range m.Receivers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HyperionWithdrawalsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HyperionBatchExecutionsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenContracts) > 0 {
+		for _, s := range m.TokenContracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CronExecutionsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankBalancesFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BankBalancesFilter == nil {
+				m.BankBalancesFilter = &BankBalancesFilter{}
+			}
+			if err := m.BankBalancesFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmLogsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvmLogsFilter == nil {
+				m.EvmLogsFilter = &EvmLogsFilter{}
+			}
+			if err := m.EvmLogsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingDelegationsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingDelegationsFilter == nil {
+				m.StakingDelegationsFilter = &StakingDelegationsFilter{}
+			}
+			if err := m.StakingDelegationsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionDepositsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HyperionDepositsFilter == nil {
+				m.HyperionDepositsFilter = &HyperionDepositsFilter{}
+			}
+			if err := m.HyperionDepositsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionWithdrawalsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HyperionWithdrawalsFilter == nil {
+				m.HyperionWithdrawalsFilter = &HyperionWithdrawalsFilter{}
+			}
+			if err := m.HyperionWithdrawalsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionBatchExecutionsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HyperionBatchExecutionsFilter == nil {
+				m.HyperionBatchExecutionsFilter = &HyperionBatchExecutionsFilter{}
+			}
+			if err := m.HyperionBatchExecutionsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExecutionsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CronExecutionsFilter == nil {
+				m.CronExecutionsFilter = &CronExecutionsFilter{}
+			}
+			if err := m.CronExecutionsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankBalances = append(m.BankBalances, &BankBalance{})
+			if err := m.BankBalances[len(m.BankBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmLogs = append(m.EvmLogs, &types.Log{})
+			if err := m.EvmLogs[len(m.EvmLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingDelegations = append(m.StakingDelegations, &StakingDelegation{})
+			if err := m.StakingDelegations[len(m.StakingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HyperionDeposits = append(m.HyperionDeposits, &HyperionDeposit{})
+			if err := m.HyperionDeposits[len(m.HyperionDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HyperionWithdrawals = append(m.HyperionWithdrawals, &HyperionWithdrawal{})
+			if err := m.HyperionWithdrawals[len(m.HyperionWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionBatchExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HyperionBatchExecutions = append(m.HyperionBatchExecutions, &HyperionBatchExecution{})
+			if err := m.HyperionBatchExecutions[len(m.HyperionBatchExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExecutions = append(m.CronExecutions, &CronExecution{})
+			if err := m.CronExecutions[len(m.CronExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BankBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BankBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BankBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types1.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			m.CompletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HyperionDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HyperionDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HyperionDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionId", wireType)
+			}
+			m.HyperionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HyperionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SentToCommunityPool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HyperionWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HyperionWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HyperionWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionId", wireType)
+			}
+			m.HyperionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HyperionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxId", wireType)
+			}
+			m.OutgoingTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutgoingTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HyperionBatchExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HyperionBatchExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HyperionBatchExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionId", wireType)
+			}
+			m.HyperionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HyperionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TxIds = append(m.TxIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TxIds) == 0 {
+					m.TxIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TxIds = append(m.TxIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
+			}
+			m.CronId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BankBalancesFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BankBalancesFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BankBalancesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmLogsFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmLogsFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmLogsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, EvmLogTopics{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *BankBalancesFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, s := range m.Accounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *StreamRequest) Unmarshal(dAtA []byte) error {
+func (m *EvmLogTopics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -643,17 +4690,17 @@ func (m *StreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvmLogTopics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvmLogTopics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankBalancesFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -663,27 +4710,23 @@ func (m *StreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BankBalancesFilter == nil {
-				m.BankBalancesFilter = &BankBalancesFilter{}
-			}
-			if err := m.BankBalancesFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -706,7 +4749,7 @@ func (m *StreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamResponse) Unmarshal(dAtA []byte) error {
+func (m *StakingDelegationsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -729,17 +4772,17 @@ func (m *StreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakingDelegationsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakingDelegationsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -749,35 +4792,79 @@ func (m *StreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HyperionDepositsFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HyperionDepositsFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HyperionDepositsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -787,25 +4874,23 @@ func (m *StreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankBalances = append(m.BankBalances, &BankBalance{})
-			if err := m.BankBalances[len(m.BankBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receivers = append(m.Receivers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -828,7 +4913,7 @@ func (m *StreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BankBalance) Unmarshal(dAtA []byte) error {
+func (m *HyperionWithdrawalsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -851,15 +4936,15 @@ func (m *BankBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BankBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: HyperionWithdrawalsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BankBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HyperionWithdrawalsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -887,13 +4972,63 @@ func (m *BankBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HyperionBatchExecutionsFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HyperionBatchExecutionsFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HyperionBatchExecutionsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContracts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -903,25 +5038,23 @@ func (m *BankBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContracts = append(m.TokenContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -944,7 +5077,7 @@ func (m *BankBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BankBalancesFilter) Unmarshal(dAtA []byte) error {
+func (m *CronExecutionsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -967,15 +5100,15 @@ func (m *BankBalancesFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BankBalancesFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronExecutionsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BankBalancesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronExecutionsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1003,7 +5136,7 @@ func (m *BankBalancesFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5,7 +5,34 @@ func NewFullStreamRequest() *StreamRequest {
 		BankBalancesFilter: &BankBalancesFilter{
 			Accounts: []string{},
 		},
+		EvmLogsFilter: &EvmLogsFilter{
+			Addresses: []string{},
+		},
+		StakingDelegationsFilter: &StakingDelegationsFilter{
+			Delegators: []string{},
+		},
+		HyperionDepositsFilter: &HyperionDepositsFilter{
+			Receivers: []string{},
+		},
+		HyperionWithdrawalsFilter: &HyperionWithdrawalsFilter{
+			Senders: []string{},
+		},
+		HyperionBatchExecutionsFilter: &HyperionBatchExecutionsFilter{
+			TokenContracts: []string{},
+		},
+		CronExecutionsFilter: &CronExecutionsFilter{
+			Owners: []string{},
+		},
+	}
+}
+
+// TopicsList returns the topics of the filter by position.
+func (m *EvmLogsFilter) TopicsList() [][]string {
+	topics := make([][]string, len(m.Topics))
+	for i, sub := range m.Topics {
+		topics[i] = sub.Topics
 	}
+	return topics
 }
 
 // Empty query matches any set of events.
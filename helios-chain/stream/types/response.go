@@ -3,29 +3,55 @@ package types
 import (
 	"fmt"
 	"time"
+
+	evmtypes "helios-core/helios-chain/x/evm/types"
 )
 
 type StreamResponseMap struct {
-	tradeEventsCounter    uint64
-	BlockHeight           uint64
-	BlockTime             time.Time
-	BankBalancesByAccount map[string][]*BankBalance
+	tradeEventsCounter                     uint64
+	BlockHeight                            uint64
+	BlockTime                              time.Time
+	BankBalancesByAccount                  map[string][]*BankBalance
+	EvmLogsByAddress                       map[string][]*evmtypes.Log
+	StakingDelegationsByDelegator          map[string][]*StakingDelegation
+	HyperionDepositsByReceiver             map[string][]*HyperionDeposit
+	HyperionWithdrawalsBySender            map[string][]*HyperionWithdrawal
+	HyperionBatchExecutionsByTokenContract map[string][]*HyperionBatchExecution
+	CronExecutionsByOwner                  map[string][]*CronExecution
 }
 
 func NewStreamResponseMap() *StreamResponseMap {
 	return &StreamResponseMap{
-		BankBalancesByAccount: map[string][]*BankBalance{},
+		BankBalancesByAccount:                  map[string][]*BankBalance{},
+		EvmLogsByAddress:                       map[string][]*evmtypes.Log{},
+		StakingDelegationsByDelegator:          map[string][]*StakingDelegation{},
+		HyperionDepositsByReceiver:             map[string][]*HyperionDeposit{},
+		HyperionWithdrawalsBySender:            map[string][]*HyperionWithdrawal{},
+		HyperionBatchExecutionsByTokenContract: map[string][]*HyperionBatchExecution{},
+		CronExecutionsByOwner:                  map[string][]*CronExecution{},
 	}
 }
 
 func NewChainStreamResponse() *StreamResponse {
 	return &StreamResponse{
-		BankBalances: []*BankBalance{},
+		BankBalances:            []*BankBalance{},
+		EvmLogs:                 []*evmtypes.Log{},
+		StakingDelegations:      []*StakingDelegation{},
+		HyperionDeposits:        []*HyperionDeposit{},
+		HyperionWithdrawals:     []*HyperionWithdrawal{},
+		HyperionBatchExecutions: []*HyperionBatchExecution{},
+		CronExecutions:          []*CronExecution{},
 	}
 }
 
 func (m *StreamRequest) Validate() error {
-	if m.BankBalancesFilter == nil {
+	if m.BankBalancesFilter == nil &&
+		m.EvmLogsFilter == nil &&
+		m.StakingDelegationsFilter == nil &&
+		m.HyperionDepositsFilter == nil &&
+		m.HyperionWithdrawalsFilter == nil &&
+		m.HyperionBatchExecutionsFilter == nil &&
+		m.CronExecutionsFilter == nil {
 		return fmt.Errorf("at least one filter must be set")
 	}
 	return nil
@@ -17,8 +17,10 @@ import (
 	sdkmath "cosmossdk.io/math"
 	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	simutils "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -156,12 +158,20 @@ func createHeliosApp(chainID string, customBaseAppOptions ...func(*baseapp.BaseA
 	logger := log.NewNopLogger()
 	loadLatest := true
 
-	appOptions := simutils.NewAppOptionsWithFlagHome(app.DefaultNodeHome)
+	appOptions := simutils.AppOptionsMap{
+		flags.FlagHome: app.DefaultNodeHome,
+		// the upgrade module stops the node without trusted hosts
+		sdkserver.FlagUpgradeTrustHosts: "https://github.com/helios-network/helios-core/releases/download/",
+	}
 	baseAppOptions := append(customBaseAppOptions, baseapp.SetChainID(chainID)) //nolint:gocritic
 
 	return app.NewHeliosApp(
 		logger,
 		db,
+		map[string]dbm.DB{
+			"hyperion": dbm.NewMemDB(),
+			"chronos":  dbm.NewMemDB(),
+		},
 		nil,
 		loadLatest,
 		appOptions,
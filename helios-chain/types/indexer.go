@@ -1,19 +1,32 @@
 package types
 
 import (
+	"errors"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+// ErrLogsNotIndexed is returned by the indexer when the logs of some blocks of the
+// requested range have not been indexed.
+var ErrLogsNotIndexed = errors.New("logs of the block range are not indexed")
+
 // EVMTxIndexer defines the interface of custom eth tx indexer.
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
-	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
+	IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult, finalizeEvents []abci.Event) error
 
 	// GetByTxHash returns nil if tx not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// GetLogs returns the logs of the blocks within [from, to] matching the addresses and
+	// the topics, it returns ErrLogsNotIndexed if the range is not fully indexed.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+	// GetCronHeights returns the heights of the blocks within [from, to] with cron executions,
+	// it returns ErrLogsNotIndexed if the range is not fully indexed.
+	GetCronHeights(from, to int64) ([]int64, error)
 }
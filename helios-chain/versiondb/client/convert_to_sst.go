@@ -1,3 +1,6 @@
+//go:build rocksdb
+// +build rocksdb
+
 package client
 
 import (
@@ -14,8 +17,8 @@ import (
 	"github.com/linxGnu/grocksdb"
 	"github.com/spf13/cobra"
 
-	"github.com/crypto-org-chain/cronos/versiondb/extsort"
-	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"helios-core/helios-chain/versiondb/extsort"
+	"helios-core/helios-chain/versiondb/tsrocksdb"
 )
 
 const (
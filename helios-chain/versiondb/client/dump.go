@@ -1,3 +1,6 @@
+//go:build rocksdb
+// +build rocksdb
+
 package client
 
 import (
@@ -26,7 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 
-	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"helios-core/helios-chain/versiondb/tsrocksdb"
 )
 
 const DefaultChunkSize = 1000000
@@ -1,7 +1,10 @@
+//go:build rocksdb
+// +build rocksdb
+
 package client
 
 import (
-	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"helios-core/helios-chain/versiondb/tsrocksdb"
 	"github.com/linxGnu/grocksdb"
 	"github.com/spf13/cobra"
 )
@@ -1,3 +1,6 @@
+//go:build rocksdb
+// +build rocksdb
+
 package client
 
 import (
@@ -6,7 +9,7 @@ import (
 	"github.com/linxGnu/grocksdb"
 	"github.com/spf13/cobra"
 
-	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"helios-core/helios-chain/versiondb/tsrocksdb"
 )
 
 func IngestVersionDBSSTCmd() *cobra.Command {
@@ -1,3 +1,6 @@
+//go:build rocksdb
+// +build rocksdb
+
 package client
 
 import (
@@ -15,8 +18,8 @@ import (
 	"cosmossdk.io/store/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/server"
 
-	"github.com/crypto-org-chain/cronos/versiondb"
-	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"helios-core/helios-chain/versiondb"
+	"helios-core/helios-chain/versiondb/tsrocksdb"
 )
 
 // RestoreVersionDBCmd returns a command to restore a versiondb from local snapshot
@@ -1,3 +1,6 @@
+//go:build rocksdb
+// +build rocksdb
+
 package client
 
 import (
@@ -21,8 +24,8 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 
-	"github.com/crypto-org-chain/cronos/memiavl"
-	"github.com/crypto-org-chain/cronos/versiondb/extsort"
+	"helios-core/helios-chain/memiavl"
+	"helios-core/helios-chain/versiondb/extsort"
 )
 
 const (
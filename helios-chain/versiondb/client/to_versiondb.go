@@ -1,8 +1,11 @@
+//go:build rocksdb
+// +build rocksdb
+
 package client
 
 import (
 	"github.com/cosmos/iavl"
-	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
+	"helios-core/helios-chain/versiondb/tsrocksdb"
 	"github.com/spf13/cobra"
 )
 
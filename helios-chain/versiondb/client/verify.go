@@ -1,3 +1,6 @@
+//go:build rocksdb
+// +build rocksdb
+
 package client
 
 import (
@@ -19,7 +22,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 
-	"github.com/crypto-org-chain/cronos/memiavl"
+	"helios-core/helios-chain/memiavl"
 )
 
 func VerifyChangeSetCmd(defaultStores []string) *cobra.Command {
@@ -1,10 +1,13 @@
+//go:build rocksdb
+// +build rocksdb
+
 package tsrocksdb
 
 import (
 	"bytes"
 	"encoding/binary"
 
-	"github.com/crypto-org-chain/cronos/versiondb"
+	"helios-core/helios-chain/versiondb"
 	"github.com/linxGnu/grocksdb"
 )
 
@@ -1,3 +1,6 @@
+//go:build rocksdb
+// +build rocksdb
+
 package tsrocksdb
 
 import (
@@ -9,7 +12,7 @@ import (
 
 	"cosmossdk.io/store/types"
 	"github.com/cosmos/iavl"
-	"github.com/crypto-org-chain/cronos/versiondb"
+	"helios-core/helios-chain/versiondb"
 	"github.com/linxGnu/grocksdb"
 )
 
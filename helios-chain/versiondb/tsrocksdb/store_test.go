@@ -1,3 +1,6 @@
+//go:build rocksdb
+// +build rocksdb
+
 package tsrocksdb
 
 import (
@@ -6,7 +9,7 @@ import (
 
 	"cosmossdk.io/store/types"
 	dbm "github.com/cosmos/cosmos-db"
-	"github.com/crypto-org-chain/cronos/versiondb"
+	"helios-core/helios-chain/versiondb"
 	"github.com/linxGnu/grocksdb"
 	"github.com/stretchr/testify/require"
 )
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"helios-core/helios-chain/x/chronos/types"
+)
+
+// EndBlocker of chronos module
+//
+// It charges the active crons, pushes the crons ready for execution to the queue and
+// executes a deterministic batch of the queue within the per-block cron gas budget.
+func (k *Keeper) EndBlocker(ctx sdk.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+
+	k.StoreChangeCronRefundedLastBlockTotalCount(ctx, 0)
+	k.StoreChangeCronExecutedLastBlockTotalCount(ctx, 0)
+
+	if err := k.DeductFeesActivesCrons(ctx); err != nil {
+		k.Logger(ctx).Error("failed to deduct fees of active crons", "err", err)
+		return nil
+	}
+
+	k.PushReadyCronsToQueue(ctx)
+
+	batchFees := k.GetBatchFees(ctx)
+
+	// remove expired crons from the queue, they will be pushed again on their next execution block
+	for _, id := range batchFees.ExpiredIds {
+		cron, ok := k.GetCron(ctx, id)
+		if !ok {
+			continue
+		}
+		k.RemoveFromCronQueue(ctx, cron)
+		if cron.CronType == types.EVENT_TRIGGERED_CRON {
+			// the cron is queued again on the next matching log
+			k.StoreRemoveCronMatchedLog(ctx, cron.Id)
+			continue
+		}
+		if cron.CronType != types.CALLBACK_CONDITIONED_CRON {
+			cron, _ = k.GetCron(ctx, id)
+			k.scheduleNextExecution(ctx, &cron)
+			k.StoreSetCron(ctx, cron)
+		}
+	}
+
+	_, _, executedCrons := k.ExecuteCronsWithLimit(ctx, batchFees, 0, params.MaxCronGasPerBlock)
+
+	// remove crons from the queue after execution
+	for _, id := range executedCrons {
+		cron, ok := k.GetCron(ctx, id)
+		if !ok {
+			continue
+		}
+		k.RemoveFromCronQueue(ctx, cron)
+	}
+	// count what is left in the queue rather than deriving it from the batch: crons
+	// removed on execution errors or rescheduled during execution must not drift it
+	k.SetCronQueueCount(ctx, k.CountCronQueue(ctx))
+
+	return nil
+}
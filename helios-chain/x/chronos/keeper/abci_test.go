@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/chronos/types"
+)
+
+func TestEndBlockerDeterministicArchives(t *testing.T) {
+	params := types.DefaultParams()
+
+	run := func() *testNode {
+		node := newTestNode(t, params)
+		// crons are created with different fees and owners so that the queue ordering matters
+		node.addCron(ownerAddr(1), 2_000_000_000, 100_000)
+		node.addCron(ownerAddr(2), 5_000_000_000, 100_000)
+		node.addCron(ownerAddr(1), 5_000_000_000, 100_000)
+		node.addCron(ownerAddr(3), 1_000_000_000, 100_000)
+		node.addCron(ownerAddr(2), 3_000_000_000, 100_000)
+		require.NoError(t, node.keeper.EndBlocker(node.ctx))
+		return node
+	}
+
+	nodeA := run()
+	nodeB := run()
+
+	hashesA, found := nodeA.keeper.GetBlockTxHashs(nodeA.ctx, uint64(testHeight))
+	require.True(t, found)
+	hashesB, found := nodeB.keeper.GetBlockTxHashs(nodeB.ctx, uint64(testHeight))
+	require.True(t, found)
+	require.Len(t, hashesA, 5)
+	require.Equal(t, hashesA, hashesB)
+
+	var cronIDs []uint64
+	for nonce := uint64(1); nonce <= uint64(len(hashesA)); nonce++ {
+		resA, found := nodeA.keeper.GetCronTransactionResultByNonce(nodeA.ctx, nonce)
+		require.True(t, found)
+		resB, found := nodeB.keeper.GetCronTransactionResultByNonce(nodeB.ctx, nonce)
+		require.True(t, found)
+		require.Equal(t, resA, resB)
+		cronIDs = append(cronIDs, resA.CronId)
+	}
+
+	// highest fee first, round-robin across owners: owner 2 (5 gwei), owner 1 (5 gwei),
+	// owner 3, then the second cron of owners 2 and 1.
+	require.Equal(t, []uint64{2, 3, 4, 5, 1}, cronIDs)
+	require.Equal(t, uint64(5), nodeA.keeper.GetCronExecutedLastBlockCount(nodeA.ctx))
+	require.Equal(t, int32(0), nodeA.keeper.GetCronQueueCount(nodeA.ctx))
+}
+
+func TestGetBatchFeesFairScheduling(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxExecutionsPerOwnerPerBlock = 2
+	params.ExecutionsLimitPerBlock = 4
+
+	node := newTestNode(t, params)
+	for i := 0; i < 5; i++ {
+		node.addCron(ownerAddr(1), 9_000_000_000, 100_000)
+	}
+	node.addCron(ownerAddr(2), 1_000_000_000, 100_000)
+	node.addCron(ownerAddr(3), 1_000_000_000, 100_000)
+
+	node.keeper.PushReadyCronsToQueue(node.ctx)
+	batch := node.keeper.GetBatchFees(node.ctx)
+
+	require.Equal(t, uint64(7), batch.TotalQueueCount)
+	require.Equal(t, []uint64{1, 6, 7, 2}, batch.Ids)
+}
+
+func TestEndBlockerGasBudget(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxCronGasPerBlock = 130_000
+
+	node := newTestNode(t, params)
+	for i := 1; i <= 4; i++ {
+		node.addCron(ownerAddr(i), 1_000_000_000, 100_000)
+	}
+
+	require.NoError(t, node.keeper.EndBlocker(node.ctx))
+
+	hashes, found := node.keeper.GetBlockTxHashs(node.ctx, uint64(testHeight))
+	require.True(t, found)
+	require.Len(t, hashes, 2)
+	require.Equal(t, int32(2), node.keeper.GetCronQueueCount(node.ctx))
+
+	// the crons left out of the budget stay in the queue and run in the next block
+	for _, id := range []uint64{3, 4} {
+		cron, found := node.keeper.GetCron(node.ctx, id)
+		require.True(t, found)
+		require.True(t, node.keeper.ExistsInCronQueue(node.ctx, cron))
+	}
+
+	nextCtx := node.ctx.WithBlockHeight(testHeight + 1)
+	require.NoError(t, node.keeper.EndBlocker(nextCtx))
+
+	hashes, found = node.keeper.GetBlockTxHashs(nextCtx, uint64(testHeight+1))
+	require.True(t, found)
+	require.Len(t, hashes, 2)
+	require.Equal(t, int32(0), node.keeper.GetCronQueueCount(nextCtx))
+}
+
+func TestEndBlockerCancellationEmitsCronTransaction(t *testing.T) {
+	node := newTestNode(t, types.DefaultParams())
+	cron := node.addCron(ownerAddr(1), 1_000_000_000, 100_000)
+	cron.ExpirationBlock = uint64(testHeight)
+	node.keeper.StoreSetCron(node.ctx, cron)
+
+	require.NoError(t, node.keeper.EndBlocker(node.ctx))
+
+	// the expired cron is cancelled without being executed, its result is still stored
+	hashes, found := node.keeper.GetBlockTxHashs(node.ctx, uint64(testHeight))
+	require.True(t, found)
+	require.Len(t, hashes, 1)
+
+	var cronTxs, executions int
+	for _, event := range node.ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeCronTransaction:
+			cronTxs++
+		case types.EventTypeExecuteCron:
+			executions++
+		}
+	}
+	require.Equal(t, 1, cronTxs)
+	require.Zero(t, executions)
+}
+
+func TestEndBlockerTimeBasedSchedule(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		catchUpMode types.CatchUpMode
+		executed    bool
+	}{
+		{"skip missed executions", types.CatchUpMode_CATCH_UP_MODE_SKIP, false},
+		{"run missed executions once", types.CatchUpMode_CATCH_UP_MODE_RUN_ONCE, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			node := newTestNode(t, types.DefaultParams())
+			cron := node.addCron(ownerAddr(1), 1_000_000_000, 100_000)
+			schedule := types.Schedule{
+				Type:            types.ScheduleType_SCHEDULE_TYPE_INTERVAL,
+				IntervalSeconds: 60,
+				CatchUpMode:     tc.catchUpMode,
+			}
+			require.NoError(t, node.keeper.SetCronSchedule(node.ctx, &cron, schedule))
+			node.keeper.StoreSetCron(node.ctx, cron)
+			firstExecution := cron.NextExecutionTime
+
+			// not due before the first interval elapses
+			require.NoError(t, node.keeper.EndBlocker(node.ctx))
+			require.Equal(t, uint64(0), node.keeper.GetCronExecutedLastBlockCount(node.ctx))
+
+			// the chain was down for several intervals
+			ctx := node.ctx.WithBlockHeight(testHeight + 1).
+				WithBlockTime(node.ctx.BlockTime().Add(150 * time.Second))
+			require.NoError(t, node.keeper.EndBlocker(ctx))
+
+			executed := node.keeper.GetCronExecutedLastBlockCount(ctx) == 1
+			require.Equal(t, tc.executed, executed)
+
+			cron, found := node.keeper.GetCron(ctx, cron.Id)
+			require.True(t, found)
+			require.Equal(t, firstExecution+120, cron.NextExecutionTime)
+		})
+	}
+}
+
+func TestEndBlockerPausedCron(t *testing.T) {
+	node := newTestNode(t, types.DefaultParams())
+	cron := node.addCron(ownerAddr(1), 1_000_000_000, 100_000)
+	balance := node.keeper.CronBalance(node.ctx, cron)
+
+	require.NoError(t, node.keeper.PauseCron(node.ctx, cron))
+	cron, _ = node.keeper.GetCron(node.ctx, cron.Id)
+	require.Error(t, node.keeper.PauseCron(node.ctx, cron), "a paused cron can't be paused again")
+
+	// paused crons are neither executed, charged nor archived for inactivity
+	for _, height := range []int64{testHeight, testHeight + 200} {
+		ctx := node.ctx.WithBlockHeight(height)
+		require.NoError(t, node.keeper.EndBlocker(ctx))
+		require.Equal(t, uint64(0), node.keeper.GetCronExecutedLastBlockCount(ctx))
+	}
+	cron, found := node.keeper.GetCron(node.ctx, cron.Id)
+	require.True(t, found)
+	require.True(t, cron.Paused)
+	require.Equal(t, balance, node.keeper.CronBalance(node.ctx, cron))
+
+	resumeCtx := node.ctx.WithBlockHeight(testHeight + 200)
+	require.NoError(t, node.keeper.ResumeCron(resumeCtx, cron))
+	cron, _ = node.keeper.GetCron(resumeCtx, cron.Id)
+	require.False(t, cron.Paused)
+	require.Equal(t, uint64(testHeight+200)+cron.Frequency, cron.NextExecutionBlock)
+
+	nextCtx := node.ctx.WithBlockHeight(int64(cron.NextExecutionBlock))
+	require.NoError(t, node.keeper.EndBlocker(nextCtx))
+	require.Equal(t, uint64(1), node.keeper.GetCronExecutedLastBlockCount(nextCtx))
+}
+
+func TestEndBlockerEventTriggeredCron(t *testing.T) {
+	node := newTestNode(t, types.DefaultParams())
+	cron := node.addCron(ownerAddr(1), 1_000_000_000, 100_000)
+
+	emitter := common.BigToAddress(big.NewInt(0x2000))
+	transfer := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+	cron.AbiJson = types.EventTriggeredCronABI("onTransfer")
+	cron.MethodName = "onTransfer"
+	cron.Frequency = 0
+	cron.NextExecutionBlock = 0
+	cron.CronType = types.EVENT_TRIGGERED_CRON
+	cron.LogFilter = &types.LogFilter{Address: emitter.Hex(), Topics: []string{transfer.Hex()}}
+	node.keeper.StoreSetCron(node.ctx, cron)
+	node.keeper.StoreCronIndexByLogAddress(node.ctx, cron)
+	require.Equal(t, []uint64{cron.Id}, node.keeper.GetCronIdsByLogAddress(node.ctx, emitter))
+
+	// nothing runs until a matching log is emitted
+	require.NoError(t, node.keeper.EndBlocker(node.ctx))
+	require.Equal(t, uint64(0), node.keeper.GetCronExecutedLastBlockCount(node.ctx))
+
+	other := &ethtypes.Log{Address: emitter, Topics: []common.Hash{crypto.Keccak256Hash([]byte("Approval()"))}}
+	match := &ethtypes.Log{Address: emitter, Topics: []common.Hash{transfer, common.BigToHash(big.NewInt(7))}, Data: []byte{0x2a}}
+	require.NoError(t, node.keeper.Hooks().PostTxProcessing(node.ctx, nil, &ethtypes.Receipt{Logs: []*ethtypes.Log{other, match, match}}))
+
+	require.NoError(t, node.keeper.EndBlocker(node.ctx))
+	require.Equal(t, uint64(1), node.keeper.GetCronExecutedLastBlockCount(node.ctx), "a cron runs once per block")
+
+	contractABI, err := abi.JSON(strings.NewReader(cron.AbiJson))
+	require.NoError(t, err)
+	values, err := contractABI.Methods["onTransfer"].Inputs.Unpack(node.evmKeeper.calls[len(node.evmKeeper.calls)-1][4:])
+	require.NoError(t, err)
+	require.Equal(t, emitter, values[0])
+	require.Equal(t, [][32]byte{transfer, common.BigToHash(big.NewInt(7))}, values[1])
+	require.Equal(t, []byte{0x2a}, values[2])
+
+	// the cron stays registered for the next matches
+	nextCtx := node.ctx.WithBlockHeight(testHeight + 1)
+	require.NoError(t, node.keeper.EndBlocker(nextCtx))
+	require.Equal(t, uint64(0), node.keeper.GetCronExecutedLastBlockCount(nextCtx))
+	cron, found := node.keeper.GetCron(nextCtx, cron.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(1), cron.TotalExecutedTransactions)
+}
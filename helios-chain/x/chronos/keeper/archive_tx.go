@@ -293,6 +293,15 @@ func (k *Keeper) StoreSetTransactionHashInBlock(ctx sdk.Context, blockNumber uin
 		bz, _ := json.Marshal(&txHashes)
 		store.Set(GetBlockIDBytes(blockNumber), bz)
 	}
+
+	// lets the indexers find the blocks holding cron transactions
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCronTransaction,
+			sdk.NewAttribute("block_number", strconv.FormatUint(blockNumber, 10)),
+			sdk.NewAttribute("tx_hash", txHash),
+		),
+	)
 }
 
 func (k *Keeper) GetTxNonceByHash(ctx sdk.Context, txHash string) (uint64, bool) {
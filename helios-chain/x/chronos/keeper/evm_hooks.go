@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"helios-core/helios-chain/x/chronos/types"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for chronos keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks return the wrapper hooks struct for the Keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
+// the module keeper
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	h.k.MatchEventTriggeredCrons(ctx, receipt.Logs)
+	return nil
+}
+
+func (h Hooks) PostContractCreation(_ sdk.Context, _ common.Address, _ sdk.AccAddress) error {
+	return nil
+}
+
+// MatchEventTriggeredCrons queues the event triggered crons matching the logs of a
+// successful transaction.
+//
+// A cron runs at most once per block: the first matching log of the block is kept as
+// its calldata and the following ones are ignored until it has been executed.
+func (k *Keeper) MatchEventTriggeredCrons(ctx sdk.Context, logs []*ethtypes.Log) {
+	currentBlock := uint64(ctx.BlockHeight())
+
+	for _, log := range logs {
+		for _, cronId := range k.GetCronIdsByLogAddress(ctx, log.Address) {
+			cron, ok := k.GetCron(ctx, cronId)
+			if !ok || cron.Paused || cron.LogFilter == nil {
+				continue
+			}
+			if cron.ExpirationBlock != 0 && cron.ExpirationBlock <= currentBlock {
+				continue
+			}
+			if _, pending := k.GetCronMatchedLog(ctx, cron.Id); pending {
+				continue
+			}
+			if !cron.LogFilter.Matches(log) {
+				continue
+			}
+
+			k.StoreCronMatchedLog(ctx, cron.Id, types.NewCronMatchedLog(log))
+			if !k.ExistsInCronQueue(ctx, cron) {
+				k.AppendToCronQueue(ctx, cron)
+			}
+		}
+	}
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -91,6 +93,9 @@ func (k *Keeper) ExecuteCrons(ctx sdk.Context, batchFees *types.BatchFeesWithIds
 	}
 }
 
+// ExecuteCronsWithLimit executes the crons of the batch in order while the gas
+// budget allows it. A cron is only executed if its gas limit fits in the remaining
+// budget, so the budget can't be exceeded; skipped crons stay in the queue.
 func (k *Keeper) ExecuteCronsWithLimit(ctx sdk.Context, batchFees *types.BatchFeesWithIds, currentGasUsed uint64, cronsGasLimit uint64) (uint64, uint64, []uint64) {
 	telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.LabelExecuteReadyCrons)
 
@@ -103,6 +108,9 @@ func (k *Keeper) ExecuteCronsWithLimit(ctx sdk.Context, batchFees *types.BatchFe
 		if !ok {
 			continue
 		}
+		if currentGasUsed+totalGasUsed+cron.GasLimit > cronsGasLimit {
+			continue
+		}
 		gasUsed, err := k.executeCron(ctx, cron)
 		totalGasUsed += gasUsed
 		count++
@@ -111,15 +119,9 @@ func (k *Keeper) ExecuteCronsWithLimit(ctx sdk.Context, batchFees *types.BatchFe
 			k.Logger(ctx).Debug("Cron Executed With Error", "err", err)
 			k.emitCronCancelledEvent(ctx, cron)
 			k.RemoveCron(ctx, cron.Id, sdk.MustAccAddressFromBech32(cron.OwnerAddress))
-			if currentGasUsed+totalGasUsed >= cronsGasLimit {
-				break
-			}
 			continue
 		}
 		recordExecutedCron(err, cron)
-		if currentGasUsed+totalGasUsed >= cronsGasLimit {
-			break
-		}
 	}
 
 	return totalGasUsed, uint64(count), executedCrons
@@ -132,9 +134,20 @@ func (k *Keeper) PushReadyCronsToQueue(ctx sdk.Context) uint64 {
 		if cron.CronType == types.CALLBACK_CONDITIONED_CRON { // they are added in queue by the keeper Hyperion or other
 			continue
 		}
+		if cron.CronType == types.EVENT_TRIGGERED_CRON { // they are added in queue when a log matches
+			continue
+		}
 		if k.ExistsInCronQueue(ctx, cron) {
 			continue
 		}
+		// skip the missed executions of wall-clock crons when they don't catch up
+		if cron.Schedule.IsTimeBased() &&
+			cron.Schedule.CatchUpMode == types.CatchUpMode_CATCH_UP_MODE_SKIP &&
+			cron.Schedule.HasMissedExecutions(time.Unix(cron.NextExecutionTime, 0), ctx.BlockTime()) {
+			k.scheduleNextExecution(ctx, &cron)
+			k.StoreSetCron(ctx, cron)
+			continue
+		}
 		// check if the cron has enough balance to pay the fees
 		balance := k.CronBalance(ctx, cron)
 		if balance.IsNegative() || balance.BigInt().Cmp(big.NewInt(0)) < 0 {
@@ -189,7 +202,11 @@ func (k *Keeper) DeductFeesActivesCrons(ctx sdk.Context) error {
 			continue
 		}
 
-		if testnet.TESTNET_BLOCK_NUMBER_UPDATE_0 < int64(ctx.BlockHeight()) {
+		if cron.Paused { // paused crons are frozen until resumed
+			continue
+		}
+
+		if testnet.TESTNET_BLOCK_NUMBER_UPDATE_0 < int64(ctx.BlockHeight()) && !k.ExistsInCronQueue(ctx, cron) && !cron.Schedule.IsTimeBased() && cron.CronType != types.EVENT_TRIGGERED_CRON {
 			if cron.NextExecutionBlock < uint64(ctx.BlockHeight())-100 { // if the cron is not executed in the last 100 blocks, remove it
 				k.emitCronCancelledEvent(ctx, cron)
 				k.RemoveCron(ctx, cron.Id, sdk.MustAccAddressFromBech32(cron.OwnerAddress))
@@ -288,6 +305,9 @@ func (k *Keeper) AddCron(ctx sdk.Context, cron types.Cron) {
 	if testnet.TESTNET_BLOCK_NUMBER_UPDATE_1 < int64(ctx.BlockHeight()) {
 		k.StoreCronIndexByOwnerAddress(ctx, cron)
 	}
+	if cron.CronType == types.EVENT_TRIGGERED_CRON {
+		k.StoreCronIndexByLogAddress(ctx, cron)
+	}
 }
 
 func (k *Keeper) RemoveCron(ctx sdk.Context, id uint64, owner sdk.AccAddress) error {
@@ -315,6 +335,11 @@ func (k *Keeper) RemoveCron(ctx sdk.Context, id uint64, owner sdk.AccAddress) er
 		k.RemoveFromCronQueue(ctx, cron)
 	}
 
+	if cron.CronType == types.EVENT_TRIGGERED_CRON {
+		k.StoreRemoveCronIndexByLogAddress(ctx, cron)
+		k.StoreRemoveCronMatchedLog(ctx, cron.Id)
+	}
+
 	k.StoreRemoveCron(ctx, cron.Id)
 	k.StoreArchiveCron(ctx, cron)
 	k.StoreChangeTotalCount(ctx, -1)
@@ -323,6 +348,44 @@ func (k *Keeper) RemoveCron(ctx sdk.Context, id uint64, owner sdk.AccAddress) er
 	return nil
 }
 
+// PauseCron freezes the cron: it leaves the queue and is neither executed nor charged
+// until it is resumed.
+func (k *Keeper) PauseCron(ctx sdk.Context, cron types.Cron) error {
+	if cron.Paused {
+		return errors.Wrapf(errortypes.ErrInvalidRequest, "cron %d is already paused", cron.Id)
+	}
+	if k.ExistsInCronQueue(ctx, cron) {
+		if err := k.RemoveFromCronQueue(ctx, cron); err != nil {
+			return err
+		}
+		cron, _ = k.GetCron(ctx, cron.Id)
+	}
+	cron.Paused = true
+	k.StoreSetCron(ctx, cron)
+	return nil
+}
+
+// ResumeCron unfreezes the cron, its next execution is computed from the current block
+// so the executions missed while paused are not replayed.
+func (k *Keeper) ResumeCron(ctx sdk.Context, cron types.Cron) error {
+	if !cron.Paused {
+		return errors.Wrapf(errortypes.ErrInvalidRequest, "cron %d is not paused", cron.Id)
+	}
+	cron.Paused = false
+	if cron.CronType != types.CALLBACK_CONDITIONED_CRON {
+		k.scheduleNextExecution(ctx, &cron)
+	}
+	k.StoreSetCron(ctx, cron)
+
+	// callbacks received while paused are executed once resumed
+	if cron.CronType == types.CALLBACK_CONDITIONED_CRON {
+		if _, found := k.GetCronCallBackData(ctx, cron.Id); found && !k.ExistsInCronQueue(ctx, cron) {
+			k.AppendToCronQueue(ctx, cron)
+		}
+	}
+	return nil
+}
+
 func (k *Keeper) GetCronOrArchivedCron(ctx sdk.Context, id uint64) (types.Cron, bool) {
 	cron, ok := k.GetCron(ctx, id)
 	if ok {
@@ -387,6 +450,18 @@ func (k *Keeper) getCronsReadyForExecutionWithFilter(ctx sdk.Context) []types.Cr
 		var cron types.Cron
 		k.cdc.MustUnmarshal(iterator.Value(), &cron)
 
+		if cron.Paused {
+			continue
+		}
+
+		if cron.Schedule.IsTimeBased() {
+			if cron.NextExecutionTime <= ctx.BlockTime().Unix() &&
+				(cron.ExpirationBlock == 0 || currentBlock <= cron.ExpirationBlock) {
+				crons = append(crons, cron)
+			}
+			continue
+		}
+
 		if testnet.TESTNET_BLOCK_NUMBER_UPDATE_2 < int64(ctx.BlockHeight()) {
 			if currentBlock == cron.NextExecutionBlock &&
 				(cron.ExpirationBlock == 0 || currentBlock <= cron.ExpirationBlock) {
@@ -443,6 +518,33 @@ func ParseABIParam(typ abi.Type, param string) (interface{}, error) {
 		return strconv.ParseBool(param)
 	case abi.BytesTy:
 		return hexutil.Decode(param)
+	case abi.FixedBytesTy:
+		bz, err := hexutil.Decode(param)
+		if err != nil || len(bz) != typ.Size {
+			return nil, fmt.Errorf("invalid %s: %s", typ.String(), param)
+		}
+		value := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(value, reflect.ValueOf(bz))
+		return value.Interface(), nil
+	case abi.SliceTy:
+		// slices are serialized as a JSON array of strings
+		var items []string
+		if err := json.Unmarshal([]byte(param), &items); err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", typ.String(), param)
+		}
+		slice := reflect.MakeSlice(typ.GetType(), 0, len(items))
+		for _, item := range items {
+			parsed, err := ParseABIParam(*typ.Elem, item)
+			if err != nil {
+				return nil, err
+			}
+			value := reflect.ValueOf(parsed)
+			if !value.Type().AssignableTo(typ.Elem.GetType()) {
+				return nil, fmt.Errorf("unsupported ABI type: %s", typ.String())
+			}
+			slice = reflect.Append(slice, value)
+		}
+		return slice.Interface(), nil
 	default:
 		return nil, fmt.Errorf("unsupported ABI type: %s", typ.String())
 	}
@@ -767,10 +869,28 @@ func (k *Keeper) executeCron(ctx sdk.Context, cron types.Cron) (uint64, error) {
 		k.StoreSetCron(ctx, cron)
 	}
 
+	if cron.CronType == types.EVENT_TRIGGERED_CRON {
+		matchedLog, ok := k.GetCronMatchedLog(ctx, cron.Id)
+		if !ok {
+			return 0, nil
+		}
+		k.StoreRemoveCronMatchedLog(ctx, cron.Id)
+
+		// the matched log is the calldata of this execution only
+		params, err := matchedLog.CallbackParams()
+		if err != nil {
+			return 0, err
+		}
+		cron.Params = params
+	}
+
 	tx, err := k.GetCronTransaction(ctx, cron, nonce)
 	if err != nil {
 		return 0, err
 	}
+	if cron.CronType == types.EVENT_TRIGGERED_CRON {
+		cron.Params = []string{}
+	}
 
 	bytesTx, err := tx.MarshalBinary()
 	if err != nil {
@@ -819,8 +939,8 @@ func (k *Keeper) executeCron(ctx sdk.Context, cron types.Cron) (uint64, error) {
 		CronAddress: cmn.AnyToHexAddress(cron.Address).String(),
 	}
 
-	// Update the next execution block after successful execution
-	cron.NextExecutionBlock = uint64(ctx.BlockHeight()) + cron.Frequency
+	// Update the next execution after successful execution
+	k.scheduleNextExecution(ctx, &cron)
 	cron.TotalExecutedTransactions += 1
 
 	k.StoreSetCron(ctx, cron)
@@ -829,7 +949,56 @@ func (k *Keeper) executeCron(ctx sdk.Context, cron types.Cron) (uint64, error) {
 	k.StoreSetTransactionHashInBlock(ctx, cronTxResult.BlockNumber, tx.Hash().Hex())
 	k.StoreSetNonce(ctx, nonce+1)
 	k.StoreChangeCronExecutedLastBlockTotalCount(ctx, k.GetCronExecutedLastBlockCount(ctx)+1)
-	return resFromExecution.GasUsed, nil
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteCron,
+			sdk.NewAttribute("cron_id", fmt.Sprintf("%d", cron.Id)),
+			sdk.NewAttribute("owner_address", cron.OwnerAddress),
+			sdk.NewAttribute("cron_address", cronTxResult.CronAddress),
+			sdk.NewAttribute("tx_hash", cronTxResult.TxHash),
+			sdk.NewAttribute("nonce", fmt.Sprintf("%d", nonce)),
+			sdk.NewAttribute("gas_used", fmt.Sprintf("%d", res.GasUsed)),
+			sdk.NewAttribute("error", res.VmError),
+		),
+	)
+	return res.GasUsed, nil
+}
+
+// scheduleNextExecution moves the cron to its next execution, in blocks for block based
+// crons and in block time for wall-clock schedules.
+func (k *Keeper) scheduleNextExecution(ctx sdk.Context, cron *types.Cron) {
+	if cron.CronType == types.EVENT_TRIGGERED_CRON { // executed when a log matches
+		return
+	}
+	if !cron.Schedule.IsTimeBased() {
+		cron.NextExecutionBlock = uint64(ctx.BlockHeight()) + cron.Frequency
+		return
+	}
+
+	next, err := cron.Schedule.NextAfter(time.Unix(cron.NextExecutionTime, 0), ctx.BlockTime())
+	if err != nil {
+		k.Logger(ctx).Error("failed to compute next execution time", "cron_id", cron.Id, "err", err)
+		cron.NextExecutionTime = math.MaxInt64
+		return
+	}
+	cron.NextExecutionTime = next.Unix()
+}
+
+// SetCronSchedule attaches a wall-clock schedule to the cron and computes its first
+// execution time from the current block time.
+func (k *Keeper) SetCronSchedule(ctx sdk.Context, cron *types.Cron, schedule types.Schedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	next, err := schedule.NextAfter(ctx.BlockTime(), ctx.BlockTime())
+	if err != nil {
+		return errors.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	cron.Schedule = &schedule
+	cron.NextExecutionTime = next.Unix()
+	cron.NextExecutionBlock = 0
+	return nil
 }
 
 func (k *Keeper) BuildCronCanceledEvent(ctx sdk.Context, a abi.ABI, tx *ethtypes.Transaction, from, to common.Address, cronId uint64, success bool) (*evmtypes.Log, error) {
@@ -1167,6 +1336,56 @@ func (k *Keeper) StoreCronIndexByOwnerAddress(ctx sdk.Context, cron types.Cron)
 	store.Set(append([]byte(cmn.AnyToHexAddress(cron.OwnerAddress).Hex()), GetCronIDBytes(cron.Id)...), []byte{})
 }
 
+func (k *Keeper) StoreCronIndexByLogAddress(ctx sdk.Context, cron types.Cron) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronIndexByLogAddressKey)
+	store.Set(cronLogAddressIndexKey(cron), []byte{})
+}
+
+func (k *Keeper) StoreRemoveCronIndexByLogAddress(ctx sdk.Context, cron types.Cron) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronIndexByLogAddressKey)
+	store.Delete(cronLogAddressIndexKey(cron))
+}
+
+// GetCronIdsByLogAddress returns the IDs of the event triggered crons filtering the logs
+// of the given address, by increasing ID.
+func (k *Keeper) GetCronIdsByLogAddress(ctx sdk.Context, address common.Address) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.CronIndexByLogAddressKey, address.Bytes()...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ids := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return ids
+}
+
+func cronLogAddressIndexKey(cron types.Cron) []byte {
+	return append(common.HexToAddress(cron.LogFilter.Address).Bytes(), sdk.Uint64ToBigEndian(cron.Id)...)
+}
+
+func (k *Keeper) StoreCronMatchedLog(ctx sdk.Context, cronId uint64, matchedLog types.CronMatchedLog) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronMatchedLogKey)
+	store.Set(sdk.Uint64ToBigEndian(cronId), k.cdc.MustMarshal(&matchedLog))
+}
+
+func (k *Keeper) GetCronMatchedLog(ctx sdk.Context, cronId uint64) (types.CronMatchedLog, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronMatchedLogKey)
+	bz := store.Get(sdk.Uint64ToBigEndian(cronId))
+	if bz == nil {
+		return types.CronMatchedLog{}, false
+	}
+
+	var matchedLog types.CronMatchedLog
+	k.cdc.MustUnmarshal(bz, &matchedLog)
+	return matchedLog, true
+}
+
+func (k *Keeper) StoreRemoveCronMatchedLog(ctx sdk.Context, cronId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronMatchedLogKey)
+	store.Delete(sdk.Uint64ToBigEndian(cronId))
+}
+
 func (k *Keeper) StoreSetCronAddress(ctx sdk.Context, cron types.Cron) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronAddressKey)
 	store.Set([]byte(cron.Address), sdk.Uint64ToBigEndian(cron.Id))
@@ -1184,7 +1403,7 @@ func (k *Keeper) StoreCronCallBackData(ctx sdk.Context, cronId uint64, callbackD
 		k.Logger(ctx).Debug("Cron not found", "cronId", cronId)
 		return
 	}
-	if k.ExistsInCronQueue(ctx, cron) {
+	if cron.Paused || k.ExistsInCronQueue(ctx, cron) {
 		return
 	}
 	k.AppendToCronQueue(ctx, cron)
@@ -1280,11 +1499,19 @@ func (k *Keeper) RemoveFromCronQueue(ctx sdk.Context, cron types.Cron) error {
 	return errors.Wrap(errortypes.ErrNotFound, "tx id")
 }
 
+// GetBatchFees selects the queued crons to execute in the current block.
+//
+// The queue is walked by decreasing max gas price, then by queue timestamp and
+// cron ID, so every node builds the same batch. Crons are then picked round-robin
+// across owners (in order of their best queued cron) so that a single owner can't
+// starve the others, with at most MaxExecutionsPerOwnerPerBlock crons per owner and
+// ExecutionsLimitPerBlock crons in total. Crons left out stay in the queue for
+// the next blocks.
 func (k *Keeper) GetBatchFees(ctx sdk.Context) *types.BatchFeesWithIds {
 	params := k.GetParams(ctx)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
-	iter := prefixStore.Iterator(nil, nil)
-	// iterate over [SecondIndexOutgoingTXFeeKey][hyperionId] prefixes
+	// iterate over [SecondIndexOutgoingTXFeeKey][fee] prefixes, highest fee first
+	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 
 	batchFees := &types.BatchFeesWithIds{
@@ -1295,47 +1522,95 @@ func (k *Keeper) GetBatchFees(ctx sdk.Context) *types.BatchFeesWithIds {
 		TotalQueueCount: 0,
 	}
 
+	type queuedCron struct {
+		id  uint64
+		fee sdkmath.Int
+	}
+
+	owners := make([]string, 0)
+	queuedByOwner := make(map[string][]queuedCron)
+	blockTime := uint64(ctx.BlockTime().Unix())
+
 	for ; iter.Valid(); iter.Next() {
 		var ids types.IDSet
 		k.cdc.MustUnmarshal(iter.Value(), &ids)
 
-		key := iter.Key()
+		sdkFeeAmount := sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(iter.Key()))
 
-		feeAmountBytes := key[32:]
-		feeAmount := big.NewInt(0).SetBytes(feeAmountBytes)
-		sdkFeeAmount := sdkmath.NewIntFromBigInt(feeAmount)
+		queued := ids.Ids
+		sort.SliceStable(queued, func(i, j int) bool {
+			if queued[i].Timestamp != queued[j].Timestamp {
+				return queued[i].Timestamp < queued[j].Timestamp
+			}
+			return queued[i].Id < queued[j].Id
+		})
 
-		for _, idAndTimestamp := range ids.Ids {
-			if idAndTimestamp.Timestamp < uint64(ctx.BlockTime().Unix())-types.DefaultCronQueueTimeout {
+		for _, idAndTimestamp := range queued {
+			if idAndTimestamp.Timestamp+types.DefaultCronQueueTimeout < blockTime {
 				batchFees.ExpiredIds = append(batchFees.ExpiredIds, idAndTimestamp.Id)
-				// batchFees.TotalQueueCount++
 				continue
 			}
-			if batchFees.TotalQueueCount >= params.ExecutionsLimitPerBlock {
-				// check if the tx as greater fee than others txs in the batch
-				sort.SliceStable(batchFees.Fees, func(i, j int) bool {
-					return batchFees.Fees[i].GT(batchFees.Fees[j])
-				})
-				// if the tx has greater fee than the first tx in the batch, replace the first tx with the new tx
-				if batchFees.Fees[0].LT(sdkFeeAmount) {
-					batchFees.Fees = append(batchFees.Fees, sdkFeeAmount)
-					batchFees.Ids = append(batchFees.Ids, idAndTimestamp.Id)
-				}
-			} else {
-				// add fee amount
-				totalFees := batchFees.TotalFees
-				totalFees = totalFees.Add(sdkFeeAmount)
-				batchFees.TotalFees = totalFees
-				batchFees.Fees = append(batchFees.Fees, sdkFeeAmount)
-				batchFees.Ids = append(batchFees.Ids, idAndTimestamp.Id)
+			cron, ok := k.GetCron(ctx, idAndTimestamp.Id)
+			if !ok {
+				continue
+			}
+			if _, exists := queuedByOwner[cron.OwnerAddress]; !exists {
+				owners = append(owners, cron.OwnerAddress)
 			}
+			queuedByOwner[cron.OwnerAddress] = append(queuedByOwner[cron.OwnerAddress], queuedCron{id: cron.Id, fee: sdkFeeAmount})
 			batchFees.TotalQueueCount++
 		}
 	}
 
+	perOwnerLimit := params.MaxExecutionsPerOwnerPerBlock
+	for round := uint64(0); round < perOwnerLimit; round++ {
+		picked := false
+		for _, owner := range owners {
+			queued := queuedByOwner[owner]
+			if uint64(len(queued)) <= round {
+				continue
+			}
+			if uint64(len(batchFees.Ids)) >= params.ExecutionsLimitPerBlock {
+				return batchFees
+			}
+			batchFees.Ids = append(batchFees.Ids, queued[round].id)
+			batchFees.Fees = append(batchFees.Fees, queued[round].fee)
+			batchFees.TotalFees = batchFees.TotalFees.Add(queued[round].fee)
+			picked = true
+		}
+		if !picked {
+			break
+		}
+	}
+
 	return batchFees
 }
 
+// CountCronQueue walks the queue and counts the crons still waiting for execution,
+// leaving out the expired entries and the crons removed from the store.
+func (k *Keeper) CountCronQueue(ctx sdk.Context) int32 {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	blockTime := uint64(ctx.BlockTime().Unix())
+	count := int32(0)
+	for ; iter.Valid(); iter.Next() {
+		var ids types.IDSet
+		k.cdc.MustUnmarshal(iter.Value(), &ids)
+		for _, idAndTimestamp := range ids.Ids {
+			if idAndTimestamp.Timestamp+types.DefaultCronQueueTimeout < blockTime {
+				continue
+			}
+			if !k.StoreCronExists(ctx, idAndTimestamp.Id) {
+				continue
+			}
+			count++
+		}
+	}
+	return count
+}
+
 func (k *Keeper) GetCronQueueCount(ctx sdk.Context) int32 {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.CronQueueCountKey)
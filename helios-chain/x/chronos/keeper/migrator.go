@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "helios-core/helios-chain/x/chronos/migrations/v2"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 fills the params fields added with the gas-bounded scheduling.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(
+		ctx,
+		ctx.KVStore(m.keeper.storeKey),
+		m.keeper.cdc,
+	)
+}
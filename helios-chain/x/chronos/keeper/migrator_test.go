@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/chronos/keeper"
+	"helios-core/helios-chain/x/chronos/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	// params stored before the block gas budget and the per owner limit existed
+	legacy := types.DefaultParams()
+	legacy.CronActiveGasCostPerBlock = 20
+	legacy.MaxCronGasPerBlock = 0
+	legacy.MaxExecutionsPerOwnerPerBlock = 0
+
+	node := newTestNode(t, legacy)
+	require.NoError(t, keeper.NewMigrator(*node.keeper).Migrate1to2(node.ctx))
+
+	params := node.keeper.GetParams(node.ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, uint64(20), params.CronActiveGasCostPerBlock)
+	require.Equal(t, types.DefaultMaxCronGasPerBlock, params.MaxCronGasPerBlock)
+	require.Equal(t, types.DefaultMaxExecutionsPerOwnerPerBlock, params.MaxExecutionsPerOwnerPerBlock)
+
+	// params already set by governance are kept
+	params.MaxCronGasPerBlock = 1_000_000
+	require.NoError(t, node.keeper.SetParams(node.ctx, params))
+	require.NoError(t, keeper.NewMigrator(*node.keeper).Migrate1to2(node.ctx))
+	require.Equal(t, params, node.keeper.GetParams(node.ctx))
+}
@@ -67,6 +67,10 @@ func (k msgServer) CreateCron(goCtx context.Context, req *types.MsgCreateCron) (
 		return nil, errors.Wrap(errortypes.ErrInvalidRequest, "amountToDeposit must be greater than the estimated max fees")
 	}
 
+	if maxGas := k.keeper.GetParams(ctx).MaxCronGasPerBlock; req.GasLimit > maxGas {
+		return nil, errors.Wrapf(errortypes.ErrInvalidRequest, "gas_limit exceeds the cron gas limit per block: %d > %d", req.GasLimit, maxGas)
+	}
+
 	cronAddress := sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("cron_%d", newID)))) // Générer une adresse unique basée sur cronId
 	acc := k.keeper.accountKeeper.NewAccountWithAddress(ctx, cronAddress)
 	k.keeper.accountKeeper.SetAccount(ctx, acc)
@@ -92,6 +96,12 @@ func (k msgServer) CreateCron(goCtx context.Context, req *types.MsgCreateCron) (
 		CronType:                  types.LEGACY_CRON,
 	}
 
+	if req.Schedule.IsTimeBased() {
+		if err := k.keeper.SetCronSchedule(ctx, &newCron, *req.Schedule); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := k.keeper.CronInTransfer(ctx, newCron, amount); err != nil {
 		return nil, fmt.Errorf("initial transfer failed amount=%s", hexutil.EncodeBig(amount))
 	}
@@ -126,7 +136,27 @@ func (k msgServer) UpdateCron(goCtx context.Context, req *types.MsgUpdateCron) (
 	if cron.OwnerAddress != req.Sender {
 		return nil, errors.Wrap(errortypes.ErrUnauthorized, "only the owner can edit")
 	}
-	cron.Frequency = req.NewFrequency
+
+	if maxGas := k.keeper.GetParams(ctx).MaxCronGasPerBlock; req.NewGasLimit > maxGas {
+		return nil, errors.Wrapf(errortypes.ErrInvalidRequest, "gas_limit exceeds the cron gas limit per block: %d > %d", req.NewGasLimit, maxGas)
+	}
+	// a nil schedule keeps the current one, a blocks schedule switches back to the frequency
+	switch {
+	case req.NewSchedule.IsTimeBased():
+		if err := k.keeper.SetCronSchedule(ctx, &cron, *req.NewSchedule); err != nil {
+			return nil, err
+		}
+	case req.NewSchedule != nil || !cron.Schedule.IsTimeBased():
+		if req.NewFrequency == 0 {
+			return nil, errors.Wrap(errortypes.ErrInvalidRequest, "new_frequency must be greater than zero")
+		}
+		if cron.Schedule.IsTimeBased() {
+			cron.NextExecutionBlock = uint64(ctx.BlockHeight()) + req.NewFrequency
+		}
+		cron.Schedule = nil
+		cron.NextExecutionTime = 0
+		cron.Frequency = req.NewFrequency
+	}
 	cron.Params = req.NewParams
 	cron.ExpirationBlock = req.NewExpirationBlock
 	cron.GasLimit = req.NewGasLimit
@@ -184,6 +214,10 @@ func (k msgServer) CreateCallBackConditionedCron(goCtx context.Context, req *typ
 		return nil, errors.Wrapf(errortypes.ErrInsufficientFunds, fmt.Sprintf("Balance too low: %d (balance) < %d (amountToDeposit)", balance.BigInt(), amount))
 	}
 
+	if maxGas := k.keeper.GetParams(ctx).MaxCronGasPerBlock; req.GasLimit > maxGas {
+		return nil, errors.Wrapf(errortypes.ErrInvalidRequest, "gas_limit exceeds the cron gas limit per block: %d > %d", req.GasLimit, maxGas)
+	}
+
 	cronAddress := sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("cron_%d", newID)))) // Générer une adresse unique basée sur cronId
 	acc := k.keeper.accountKeeper.NewAccountWithAddress(ctx, cronAddress)
 	k.keeper.accountKeeper.SetAccount(ctx, acc)
@@ -230,3 +264,231 @@ func (k msgServer) CreateCallBackConditionedCron(goCtx context.Context, req *typ
 		CronAddress: cmn.AnyToHexAddress(newCron.Address).String(),
 	}, nil
 }
+
+// CreateEventTriggeredCron creates a cron executed when a block contains an EVM log matching its filter
+func (k msgServer) CreateEventTriggeredCron(goCtx context.Context, req *types.MsgCreateEventTriggeredCron) (*types.MsgCreateEventTriggeredCronResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.OwnerAddress != req.Sender {
+		return nil, errors.Wrap(errortypes.ErrUnauthorized, fmt.Sprintf("only the owner can create a cron %s != %s", req.OwnerAddress, req.Sender))
+	}
+
+	newID := k.keeper.StoreGetNextCronID(ctx)
+
+	if k.keeper.StoreCronExists(ctx, newID) { // impossible
+		return nil, fmt.Errorf("cron already exists with id=%d", newID)
+	}
+
+	amount := req.AmountToDeposit.BigInt() // ahelios
+
+	// check Balance of OwnerAddress
+	account := k.keeper.EvmKeeper.GetAccount(ctx, cmn.AnyToHexAddress(req.OwnerAddress))
+	balance := sdkmath.NewIntFromBigInt(account.Balance)
+
+	if balance.IsNegative() || balance.BigInt().Cmp(amount) < 0 {
+		return nil, errors.Wrapf(errortypes.ErrInsufficientFunds, fmt.Sprintf("Balance too low: %d (balance) < %d (amountToDeposit)", balance.BigInt(), amount))
+	}
+
+	if amount.Cmp(sdkmath.NewIntFromBigInt(req.MaxGasPrice.BigInt()).Mul(sdkmath.NewInt(int64(req.GasLimit))).BigInt()) <= 0 {
+		return nil, errors.Wrap(errortypes.ErrInvalidRequest, "amountToDeposit must be greater than the estimated max fees")
+	}
+
+	if maxGas := k.keeper.GetParams(ctx).MaxCronGasPerBlock; req.GasLimit > maxGas {
+		return nil, errors.Wrapf(errortypes.ErrInvalidRequest, "gas_limit exceeds the cron gas limit per block: %d > %d", req.GasLimit, maxGas)
+	}
+
+	logAddress := common.HexToAddress(req.LogFilter.Address)
+	if count := len(k.keeper.GetCronIdsByLogAddress(ctx, logAddress)); count >= types.MaxEventTriggeredCronsPerAddress {
+		return nil, errors.Wrapf(errortypes.ErrInvalidRequest, "too many event triggered crons on %s: %d", logAddress.Hex(), count)
+	}
+
+	cronAddress := sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("cron_%d", newID))))
+	acc := k.keeper.accountKeeper.NewAccountWithAddress(ctx, cronAddress)
+	k.keeper.accountKeeper.SetAccount(ctx, acc)
+
+	// initiate value
+	totalFeesPaid := sdkmath.NewInt(0)
+
+	newCron := types.Cron{
+		Id:                        newID,
+		Address:                   cronAddress.String(),
+		OwnerAddress:              req.OwnerAddress,
+		ContractAddress:           req.ContractAddress,
+		AbiJson:                   types.EventTriggeredCronABI(req.MethodName),
+		MethodName:                req.MethodName,
+		Params:                    []string{},
+		Frequency:                 0,
+		NextExecutionBlock:        0,
+		ExpirationBlock:           req.ExpirationBlock,
+		GasLimit:                  req.GasLimit,
+		MaxGasPrice:               req.MaxGasPrice,
+		TotalExecutedTransactions: 0,
+		TotalFeesPaid:             &totalFeesPaid,
+		CronType:                  types.EVENT_TRIGGERED_CRON,
+		QueueTimestamp:            -1,
+		LogFilter: &types.LogFilter{
+			Address: logAddress.Hex(),
+			Topics:  req.LogFilter.Topics,
+		},
+	}
+
+	if err := k.keeper.CronInTransfer(ctx, newCron, amount); err != nil {
+		return nil, fmt.Errorf("initial transfer failed amount=%s", hexutil.EncodeBig(amount))
+	}
+
+	k.keeper.AddCron(ctx, newCron)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"CreateCron",
+			sdk.NewAttribute("cron_id", fmt.Sprintf("%d", newCron.Id)),
+			sdk.NewAttribute("owner_address", req.OwnerAddress),
+			sdk.NewAttribute("contract_address", req.ContractAddress),
+			sdk.NewAttribute("method_name", req.MethodName),
+		),
+	)
+
+	return &types.MsgCreateEventTriggeredCronResponse{
+		CronId:      newCron.Id,
+		CronAddress: cmn.AnyToHexAddress(newCron.Address).String(),
+	}, nil
+}
+
+// DepositToCron tops up the cron wallet from the owner balance
+func (k msgServer) DepositToCron(goCtx context.Context, req *types.MsgDepositToCron) (*types.MsgDepositToCronResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cron, err := k.getOwnedCron(ctx, req.CronId, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := req.Amount.BigInt()
+	account := k.keeper.EvmKeeper.GetAccount(ctx, cmn.AnyToHexAddress(cron.OwnerAddress))
+	if account == nil || account.Balance.Cmp(amount) < 0 {
+		return nil, errors.Wrapf(errortypes.ErrInsufficientFunds, "balance too low to deposit %s", req.Amount.String())
+	}
+
+	if err := k.keeper.CronInTransfer(ctx, cron, amount); err != nil {
+		return nil, errors.Wrap(err, "deposit failed")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"DepositToCron",
+			sdk.NewAttribute("cron_id", fmt.Sprintf("%d", cron.Id)),
+			sdk.NewAttribute("owner_address", cron.OwnerAddress),
+			sdk.NewAttribute("amount", req.Amount.String()),
+		),
+	)
+
+	return &types.MsgDepositToCronResponse{Success: true}, nil
+}
+
+// WithdrawFromCron sends back part of the cron wallet balance to the owner
+func (k msgServer) WithdrawFromCron(goCtx context.Context, req *types.MsgWithdrawFromCron) (*types.MsgWithdrawFromCronResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cron, err := k.getOwnedCron(ctx, req.CronId, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if balance := k.keeper.CronBalance(ctx, cron); balance.LT(*req.Amount) {
+		return nil, errors.Wrapf(errortypes.ErrInsufficientFunds, "cron balance too low: %s < %s", balance.String(), req.Amount.String())
+	}
+
+	if err := k.keeper.CronOutTransfer(ctx, cron, req.Amount.BigInt()); err != nil {
+		return nil, errors.Wrap(err, "withdrawal failed")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"WithdrawFromCron",
+			sdk.NewAttribute("cron_id", fmt.Sprintf("%d", cron.Id)),
+			sdk.NewAttribute("owner_address", cron.OwnerAddress),
+			sdk.NewAttribute("amount", req.Amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawFromCronResponse{Success: true}, nil
+}
+
+// PauseCron freezes a cron without losing its id, address and history
+func (k msgServer) PauseCron(goCtx context.Context, req *types.MsgPauseCron) (*types.MsgPauseCronResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cron, err := k.getOwnedCron(ctx, req.CronId, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.PauseCron(ctx, cron); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"PauseCron",
+			sdk.NewAttribute("cron_id", fmt.Sprintf("%d", cron.Id)),
+			sdk.NewAttribute("owner_address", cron.OwnerAddress),
+		),
+	)
+
+	return &types.MsgPauseCronResponse{Success: true}, nil
+}
+
+// ResumeCron resumes a paused cron
+func (k msgServer) ResumeCron(goCtx context.Context, req *types.MsgResumeCron) (*types.MsgResumeCronResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cron, err := k.getOwnedCron(ctx, req.CronId, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.ResumeCron(ctx, cron); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"ResumeCron",
+			sdk.NewAttribute("cron_id", fmt.Sprintf("%d", cron.Id)),
+			sdk.NewAttribute("owner_address", cron.OwnerAddress),
+		),
+	)
+
+	return &types.MsgResumeCronResponse{Success: true}, nil
+}
+
+func (k msgServer) getOwnedCron(ctx sdk.Context, cronId uint64, sender string) (types.Cron, error) {
+	cron, found := k.keeper.GetCron(ctx, cronId)
+	if !found {
+		return types.Cron{}, errors.Wrapf(errortypes.ErrNotFound, "cron %d not found", cronId)
+	}
+	if cron.OwnerAddress != sender {
+		return types.Cron{}, errors.Wrap(errortypes.ErrUnauthorized, "only the owner can manage the cron")
+	}
+	return cron, nil
+}
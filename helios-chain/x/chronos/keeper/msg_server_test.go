@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/chronos/keeper"
+	"helios-core/helios-chain/x/chronos/types"
+)
+
+func TestMsgServerUpdateCron(t *testing.T) {
+	node := newTestNode(t, types.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(*node.keeper)
+	owner := ownerAddr(1)
+	gasPrice := sdkmath.NewInt(2_000_000_000)
+
+	blockCron := node.addCron(owner, 1_000_000_000, 100_000)
+	timeCron := node.addCron(owner, 1_000_000_000, 100_000)
+	schedule := types.Schedule{Type: types.ScheduleType_SCHEDULE_TYPE_INTERVAL, IntervalSeconds: 60}
+	require.NoError(t, node.keeper.SetCronSchedule(node.ctx, &timeCron, schedule))
+	node.keeper.StoreSetCron(node.ctx, timeCron)
+
+	update := func(cron types.Cron, frequency uint64) error {
+		_, err := msgServer.UpdateCron(node.ctx, &types.MsgUpdateCron{
+			CronId:         cron.Id,
+			OwnerAddress:   owner.String(),
+			Sender:         owner.String(),
+			NewFrequency:   frequency,
+			NewGasLimit:    200_000,
+			NewMaxGasPrice: &gasPrice,
+		})
+		return err
+	}
+
+	// a time based cron is updated without a frequency and keeps its schedule
+	require.NoError(t, update(timeCron, 0))
+	updated, found := node.keeper.GetCron(node.ctx, timeCron.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(200_000), updated.GasLimit)
+	require.Equal(t, schedule, *updated.Schedule)
+	require.Equal(t, timeCron.NextExecutionTime, updated.NextExecutionTime)
+
+	// a block based cron still needs its frequency
+	require.Error(t, update(blockCron, 0))
+	require.NoError(t, update(blockCron, 20))
+	updated, found = node.keeper.GetCron(node.ctx, blockCron.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(20), updated.Frequency)
+	require.Equal(t, uint64(200_000), updated.GasLimit)
+}
+
+func TestMsgServerDepositAndWithdraw(t *testing.T) {
+	node := newTestNode(t, types.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(*node.keeper)
+	owner := ownerAddr(1)
+	cron := node.addCron(owner, 1_000_000_000, 100_000)
+	ownerAddress := common.BytesToAddress(owner)
+
+	ownerBalance := new(big.Int).Set(node.evmKeeper.balances[ownerAddress])
+	cronBalance := node.keeper.CronBalance(node.ctx, cron)
+
+	deposit := func(sender string, amount sdkmath.Int) error {
+		_, err := msgServer.DepositToCron(node.ctx, &types.MsgDepositToCron{
+			CronId:       cron.Id,
+			OwnerAddress: sender,
+			Sender:       sender,
+			Amount:       &amount,
+		})
+		return err
+	}
+	withdraw := func(sender string, amount sdkmath.Int) error {
+		_, err := msgServer.WithdrawFromCron(node.ctx, &types.MsgWithdrawFromCron{
+			CronId:       cron.Id,
+			OwnerAddress: sender,
+			Sender:       sender,
+			Amount:       &amount,
+		})
+		return err
+	}
+
+	amount := sdkmath.NewInt(1e18)
+	require.NoError(t, deposit(owner.String(), amount))
+	require.Equal(t, cronBalance.Add(amount), node.keeper.CronBalance(node.ctx, cron))
+	require.Equal(t, new(big.Int).Sub(ownerBalance, amount.BigInt()), node.evmKeeper.balances[ownerAddress])
+
+	require.NoError(t, withdraw(owner.String(), amount))
+	require.Equal(t, cronBalance, node.keeper.CronBalance(node.ctx, cron))
+	require.Equal(t, ownerBalance, node.evmKeeper.balances[ownerAddress])
+
+	testCases := []struct {
+		name   string
+		run    func() error
+		expErr error
+	}{
+		{"deposit zero", func() error { return deposit(owner.String(), sdkmath.ZeroInt()) }, errortypes.ErrInvalidRequest},
+		{"deposit above the owner balance", func() error {
+			return deposit(owner.String(), sdkmath.NewIntFromBigInt(ownerBalance).AddRaw(1))
+		}, errortypes.ErrInsufficientFunds},
+		{"deposit by another account", func() error { return deposit(ownerAddr(2).String(), amount) }, errortypes.ErrUnauthorized},
+		{"withdraw zero", func() error { return withdraw(owner.String(), sdkmath.ZeroInt()) }, errortypes.ErrInvalidRequest},
+		{"withdraw above the cron balance", func() error {
+			return withdraw(owner.String(), cronBalance.AddRaw(1))
+		}, errortypes.ErrInsufficientFunds},
+		{"withdraw by another account", func() error { return withdraw(ownerAddr(2).String(), amount) }, errortypes.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, tc.run(), tc.expErr)
+			// failed requests don't move any funds
+			require.Equal(t, cronBalance, node.keeper.CronBalance(node.ctx, cron))
+			require.Equal(t, ownerBalance, node.evmKeeper.balances[ownerAddress])
+		})
+	}
+}
+
+func TestMsgServerCreateEventTriggeredCron(t *testing.T) {
+	node := newTestNode(t, types.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(*node.keeper)
+	owner := ownerAddr(1)
+	node.evmKeeper.balances[common.BytesToAddress(owner)] = big.NewInt(1e18)
+
+	gasPrice := sdkmath.NewInt(1_000_000_000)
+	maxCost := gasPrice.MulRaw(100_000)
+
+	create := func(maxGasPrice, deposit *sdkmath.Int) error {
+		_, err := msgServer.CreateEventTriggeredCron(node.ctx, &types.MsgCreateEventTriggeredCron{
+			OwnerAddress:    owner.String(),
+			Sender:          owner.String(),
+			ContractAddress: "0x0000000000000000000000000000000000001000",
+			MethodName:      "onTransfer",
+			LogFilter:       &types.LogFilter{Address: "0x0000000000000000000000000000000000002000"},
+			GasLimit:        100_000,
+			MaxGasPrice:     maxGasPrice,
+			AmountToDeposit: deposit,
+		})
+		return err
+	}
+
+	// crons which couldn't be charged are never stored
+	require.ErrorIs(t, create(nil, &maxCost), errortypes.ErrInvalidRequest)
+	require.ErrorIs(t, create(&gasPrice, nil), errortypes.ErrInvalidRequest)
+	require.ErrorIs(t, create(&gasPrice, &maxCost), errortypes.ErrInvalidRequest, "the deposit must cover the max cost of an execution")
+	require.Empty(t, node.keeper.GetAllCrons(node.ctx))
+}
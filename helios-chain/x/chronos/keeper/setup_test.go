@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/archivekv"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	cmn "helios-core/helios-chain/precompiles/common"
+	"helios-core/helios-chain/x/chronos/keeper"
+	"helios-core/helios-chain/x/chronos/types"
+	"helios-core/helios-chain/x/evm/core/vm"
+	"helios-core/helios-chain/x/evm/statedb"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+// testHeight is above every testnet upgrade height so the current code paths are used.
+const testHeight = int64(300_000)
+
+const tickABI = `[{"inputs":[],"name":"tick","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+var configureEVM sync.Once
+
+// mockEVMKeeper is a minimal deterministic EVM keeper: balances are kept in memory
+// and every message consumes a gas amount derived from its nonce.
+type mockEVMKeeper struct {
+	types.EVMKeeper
+
+	balances map[common.Address]*big.Int
+	calls    [][]byte
+}
+
+func newMockEVMKeeper() *mockEVMKeeper {
+	return &mockEVMKeeper{balances: make(map[common.Address]*big.Int)}
+}
+
+func (m *mockEVMKeeper) GetParams(_ sdk.Context) evmtypes.Params {
+	return evmtypes.DefaultParams()
+}
+
+func (m *mockEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int {
+	return big.NewInt(1_000_000_000)
+}
+
+func (m *mockEVMKeeper) GetMinGasPrice(_ sdk.Context) sdkmath.LegacyDec {
+	return sdkmath.LegacyZeroDec()
+}
+
+func (m *mockEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64 {
+	return 0
+}
+
+func (m *mockEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	balance, ok := m.balances[addr]
+	if !ok {
+		balance = big.NewInt(0)
+	}
+	return &statedb.Account{Balance: new(big.Int).Set(balance)}
+}
+
+func (m *mockEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, fees sdk.Coins, from common.Address) error {
+	balance := m.GetAccount(sdk.Context{}, from).Balance
+	amount := fees[0].Amount.BigInt()
+	if balance.Cmp(amount) < 0 {
+		return fmt.Errorf("insufficient funds")
+	}
+	m.balances[from] = balance.Sub(balance, amount)
+	return nil
+}
+
+func (m *mockEVMKeeper) RefundGas(_ sdk.Context, msg core.Message, leftoverGas uint64, _ string) error {
+	balance := m.GetAccount(sdk.Context{}, msg.From()).Balance
+	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
+	m.balances[msg.From()] = balance.Add(balance, remaining)
+	return nil
+}
+
+func (m *mockEVMKeeper) ApplyMessage(ctx sdk.Context, msg core.Message, _ vm.EVMLogger, _ bool) (*evmtypes.MsgEthereumTxResponse, error) {
+	m.calls = append(m.calls, msg.Data())
+	gasUsed := 21_000 + msg.Nonce()*100
+	if gasUsed > msg.Gas() {
+		gasUsed = msg.Gas()
+	}
+	return &evmtypes.MsgEthereumTxResponse{
+		GasUsed: gasUsed,
+		Logs: []*evmtypes.Log{{
+			Address:     msg.To().Hex(),
+			Topics:      []string{common.BigToHash(new(big.Int).SetUint64(msg.Nonce())).Hex()},
+			BlockNumber: uint64(ctx.BlockHeight()),
+		}},
+	}, nil
+}
+
+// mockBankKeeper moves the coins between the balances of the mock EVM keeper.
+type mockBankKeeper struct {
+	bankkeeper.Keeper
+
+	evmKeeper *mockEVMKeeper
+}
+
+func (m mockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	amount := amt[0].Amount.BigInt()
+	fromBalance := m.evmKeeper.GetAccount(sdk.Context{}, common.BytesToAddress(from)).Balance
+	if fromBalance.Cmp(amount) < 0 {
+		return fmt.Errorf("insufficient funds")
+	}
+	toBalance := m.evmKeeper.GetAccount(sdk.Context{}, common.BytesToAddress(to)).Balance
+	m.evmKeeper.balances[common.BytesToAddress(from)] = fromBalance.Sub(fromBalance, amount)
+	m.evmKeeper.balances[common.BytesToAddress(to)] = toBalance.Add(toBalance, amount)
+	return nil
+}
+
+// testNode is an independent chronos keeper with its own stores, standing for one node.
+type testNode struct {
+	ctx       sdk.Context
+	keeper    *keeper.Keeper
+	evmKeeper *mockEVMKeeper
+}
+
+func newTestNode(t *testing.T, params types.Params) *testNode {
+	configureEVM.Do(func() {
+		require.NoError(t, evmtypes.NewEVMConfigurator().WithEVMCoinInfo("ahelios", 18).Configure())
+	})
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	archiveStores := map[string]storetypes.ArchiveKVStore{
+		storeKey.Name(): archivekv.NewStore(storeKey.Name(), dbm.NewMemDB()),
+	}
+	header := cmtproto.Header{
+		Height: testHeight,
+		Time:   time.Unix(1_700_000_000, 0).UTC(),
+	}
+	ctx := sdk.NewContext(cms, archiveStores, header, false, log.NewNopLogger()).
+		WithHeaderHash(crypto.Sha256([]byte("block")))
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	evmKeeper := newMockEVMKeeper()
+	k := keeper.NewKeeper(cdc, storeKey, memKey, nil, evmKeeper, mockBankKeeper{evmKeeper: evmKeeper})
+	require.NoError(t, k.SetParams(ctx, params))
+
+	return &testNode{ctx: ctx, keeper: k, evmKeeper: evmKeeper}
+}
+
+// addCron registers a cron of the given owner, funded enough to run for a while,
+// which is due at the current block.
+func (n *testNode) addCron(owner sdk.AccAddress, maxGasPrice int64, gasLimit uint64) types.Cron {
+	id := n.keeper.StoreGetNextCronID(n.ctx)
+	cronAddress := sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("cron_%d", id))))
+	totalFeesPaid := sdkmath.ZeroInt()
+	gasPrice := sdkmath.NewInt(maxGasPrice)
+
+	cron := types.Cron{
+		Id:                 id,
+		Address:            cronAddress.String(),
+		OwnerAddress:       owner.String(),
+		ContractAddress:    common.BigToAddress(big.NewInt(0x1000)).Hex(),
+		AbiJson:            tickABI,
+		MethodName:         "tick",
+		Params:             []string{},
+		Frequency:          10,
+		NextExecutionBlock: uint64(n.ctx.BlockHeight()),
+		GasLimit:           gasLimit,
+		MaxGasPrice:        &gasPrice,
+		TotalFeesPaid:      &totalFeesPaid,
+		CronType:           types.LEGACY_CRON,
+		QueueTimestamp:     -1,
+	}
+	n.keeper.AddCron(n.ctx, cron)
+
+	n.evmKeeper.balances[cmn.AnyToHexAddress(cron.Address)] = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100))
+	n.evmKeeper.balances[common.BytesToAddress(owner)] = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100))
+	return cron
+}
+
+func ownerAddr(i int) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("owner_%d", i))))
+}
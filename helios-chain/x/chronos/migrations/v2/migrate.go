@@ -0,0 +1,45 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"helios-core/helios-chain/x/chronos/types"
+)
+
+// Migrate writes the default values of the block gas budget and the per owner
+// execution limit into the params stored before these fields existed.
+func Migrate(
+	_ sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &currParams)
+	}
+
+	defaults := types.DefaultParams()
+	if currParams.CronActiveGasCostPerBlock == 0 {
+		currParams.CronActiveGasCostPerBlock = defaults.CronActiveGasCostPerBlock
+	}
+	if currParams.ExecutionsLimitPerBlock == 0 {
+		currParams.ExecutionsLimitPerBlock = defaults.ExecutionsLimitPerBlock
+	}
+	if currParams.MaxCronGasPerBlock == 0 {
+		currParams.MaxCronGasPerBlock = defaults.MaxCronGasPerBlock
+	}
+	if currParams.MaxExecutionsPerOwnerPerBlock == 0 {
+		currParams.MaxExecutionsPerOwnerPerBlock = defaults.MaxExecutionsPerOwnerPerBlock
+	}
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&currParams)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -119,6 +119,11 @@ func (AppModule) QuerierRoute() string { return types.RouterKey }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate chronos from version 1 to 2: %v", err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -151,50 +156,5 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 
 // EndBlock contains the logic that is automatically triggered at the end of each block
 func (am AppModule) EndBlock(ctx context.Context) error {
-	// sdkctx := sdk.UnwrapSDKContext(ctx)
-
-	// am.keeper.StoreChangeCronRefundedLastBlockTotalCount(sdkctx, 0)
-	// am.keeper.StoreChangeCronExecutedLastBlockTotalCount(sdkctx, 0)
-
-	// // todo remove crons after delay of 10 hours of timeout
-	// am.keeper.DeductFeesActivesCrons(sdkctx)
-
-	// // todo push ready crons to the queue (priority based on the fees priority)
-	// am.keeper.PushReadyCronsToQueue(sdkctx)
-
-	// cronGasLimit := types.DefaultMaxCronGasPerBlock
-	// totalGasUsed := uint64(0)
-
-	// for {
-	// 	batchFees := am.keeper.GetBatchFees(sdkctx)
-
-	// 	gasUsed, tasksExecuted, executedCrons := am.keeper.ExecuteCronsWithLimit(sdkctx, batchFees, totalGasUsed, cronGasLimit)
-
-	// 	totalGasUsed += gasUsed
-
-	// 	// remove expired crons from the queue
-	// 	for _, id := range batchFees.ExpiredIds {
-	// 		cron, ok := am.keeper.GetCron(sdkctx, id)
-	// 		if !ok {
-	// 			continue
-	// 		}
-	// 		am.keeper.RemoveFromCronQueue(sdkctx, cron)
-	// 	}
-
-	// 	// remove crons from the queue after execution
-	// 	for _, id := range executedCrons {
-	// 		cron, ok := am.keeper.GetCron(sdkctx, id)
-	// 		if !ok {
-	// 			continue
-	// 		}
-	// 		am.keeper.RemoveFromCronQueue(sdkctx, cron)
-	// 	}
-	// 	am.keeper.SetCronQueueCount(sdkctx, int32(batchFees.TotalQueueCount))
-
-	// 	if gasUsed == 0 || tasksExecuted == 0 || totalGasUsed >= cronGasLimit {
-	// 		break
-	// 	}
-	// }
-
-	return nil
+	return am.keeper.EndBlocker(sdk.UnwrapSDKContext(ctx))
 }
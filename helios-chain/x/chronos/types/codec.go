@@ -18,9 +18,13 @@ var AminoCdc = codec.NewAminoCodec(amino) //nolint:staticcheck
 
 // Constants for Amino encoding (used for JSON compatibility)
 const (
-	createCron = "helios/chronos/MsgCreateCron"
-	updateCron = "helios/chronos/MsgUpdateCron"
-	cancelCron = "helios/chronos/MsgCancelCron"
+	createCron       = "helios/chronos/MsgCreateCron"
+	updateCron       = "helios/chronos/MsgUpdateCron"
+	cancelCron       = "helios/chronos/MsgCancelCron"
+	depositToCron    = "helios/chronos/MsgDepositToCron"
+	withdrawFromCron = "helios/chronos/MsgWithdrawFromCron"
+	pauseCron        = "helios/chronos/MsgPauseCron"
+	resumeCron       = "helios/chronos/MsgResumeCron"
 )
 
 // Init function to register codecs and seal Amino
@@ -37,6 +41,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateCron{},
 		&MsgUpdateCron{},
 		&MsgCancelCron{},
+		&MsgDepositToCron{},
+		&MsgWithdrawFromCron{},
+		&MsgPauseCron{},
+		&MsgResumeCron{},
 	)
 
 	// Register MsgService Descriptor
@@ -48,4 +56,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateCron{}, createCron, nil)
 	cdc.RegisterConcrete(&MsgUpdateCron{}, updateCron, nil)
 	cdc.RegisterConcrete(&MsgCancelCron{}, cancelCron, nil)
+	cdc.RegisterConcrete(&MsgDepositToCron{}, depositToCron, nil)
+	cdc.RegisterConcrete(&MsgWithdrawFromCron{}, withdrawFromCron, nil)
+	cdc.RegisterConcrete(&MsgPauseCron{}, pauseCron, nil)
+	cdc.RegisterConcrete(&MsgResumeCron{}, resumeCron, nil)
 }
@@ -1,3 +1,10 @@
 package types
 
-const ConsensusVersion = 1
+const ConsensusVersion = 2
+
+// EventTypeExecuteCron is the type of the event emitted for every cron execution
+const EventTypeExecuteCron = "ExecuteCron"
+
+// EventTypeCronTransaction is the type of the event emitted for every cron transaction
+// result stored in a block, the executions as well as the cancellations
+const EventTypeCronTransaction = "CronTransaction"
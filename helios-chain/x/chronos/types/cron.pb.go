@@ -51,6 +51,188 @@ func (ExecutionStage) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_40f0621bb4e9e794, []int{0}
 }
 
+// Defines how the execution times of a cron are computed
+type ScheduleType int32
+
+const (
+	// Execution every `frequency` blocks
+	ScheduleType_SCHEDULE_TYPE_BLOCKS ScheduleType = 0
+	// Execution following a standard 5-field cron expression evaluated against the block time (UTC)
+	ScheduleType_SCHEDULE_TYPE_CRON_EXPRESSION ScheduleType = 1
+	// Execution every `interval_seconds` seconds of block time
+	ScheduleType_SCHEDULE_TYPE_INTERVAL ScheduleType = 2
+)
+
+var ScheduleType_name = map[int32]string{
+	0: "SCHEDULE_TYPE_BLOCKS",
+	1: "SCHEDULE_TYPE_CRON_EXPRESSION",
+	2: "SCHEDULE_TYPE_INTERVAL",
+}
+
+var ScheduleType_value = map[string]int32{
+	"SCHEDULE_TYPE_BLOCKS":          0,
+	"SCHEDULE_TYPE_CRON_EXPRESSION": 1,
+	"SCHEDULE_TYPE_INTERVAL":        2,
+}
+
+func (x ScheduleType) String() string {
+	return proto.EnumName(ScheduleType_name, int32(x))
+}
+
+func (ScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_40f0621bb4e9e794, []int{1}
+}
+
+// Defines what happens when scheduled executions have been missed
+type CatchUpMode int32
+
+const (
+	// Missed executions are skipped, the cron runs again at its next scheduled time
+	CatchUpMode_CATCH_UP_MODE_SKIP CatchUpMode = 0
+	// Missed executions are collapsed into a single execution
+	CatchUpMode_CATCH_UP_MODE_RUN_ONCE CatchUpMode = 1
+)
+
+var CatchUpMode_name = map[int32]string{
+	0: "CATCH_UP_MODE_SKIP",
+	1: "CATCH_UP_MODE_RUN_ONCE",
+}
+
+var CatchUpMode_value = map[string]int32{
+	"CATCH_UP_MODE_SKIP":     0,
+	"CATCH_UP_MODE_RUN_ONCE": 1,
+}
+
+func (x CatchUpMode) String() string {
+	return proto.EnumName(CatchUpMode_name, int32(x))
+}
+
+func (CatchUpMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_40f0621bb4e9e794, []int{2}
+}
+
+// Wall-clock schedule of a cron
+type Schedule struct {
+	Type            ScheduleType `protobuf:"varint,1,opt,name=type,proto3,enum=helios.chronos.v1.ScheduleType" json:"type,omitempty"`
+	CronExpression  string       `protobuf:"bytes,2,opt,name=cron_expression,json=cronExpression,proto3" json:"cronExpression"`
+	IntervalSeconds uint64       `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"intervalSeconds"`
+	CatchUpMode     CatchUpMode  `protobuf:"varint,4,opt,name=catch_up_mode,json=catchUpMode,proto3,enum=helios.chronos.v1.CatchUpMode" json:"catchUpMode"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40f0621bb4e9e794, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetType() ScheduleType {
+	if m != nil {
+		return m.Type
+	}
+	return ScheduleType_SCHEDULE_TYPE_BLOCKS
+}
+
+func (m *Schedule) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *Schedule) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *Schedule) GetCatchUpMode() CatchUpMode {
+	if m != nil {
+		return m.CatchUpMode
+	}
+	return CatchUpMode_CATCH_UP_MODE_SKIP
+}
+
+// EVM log filter of an event triggered cron
+type LogFilter struct {
+	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics  []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (m *LogFilter) Reset()         { *m = LogFilter{} }
+func (m *LogFilter) String() string { return proto.CompactTextString(m) }
+func (*LogFilter) ProtoMessage()    {}
+func (*LogFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40f0621bb4e9e794, []int{1}
+}
+func (m *LogFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogFilter.Merge(m, src)
+}
+func (m *LogFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogFilter proto.InternalMessageInfo
+
+func (m *LogFilter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LogFilter) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
 // Cron for autonomous EVM smart-contract execution
 type Cron struct {
 	Id                        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -71,13 +253,17 @@ type Cron struct {
 	CronType                  string                 `protobuf:"bytes,16,opt,name=cron_type,json=cronType,proto3" json:"cronType"`
 	Archived                  bool                   `protobuf:"varint,17,opt,name=archived,proto3" json:"archived,omitempty"`
 	QueueTimestamp            int64                  `protobuf:"varint,18,opt,name=queue_timestamp,json=queueTimestamp,proto3" json:"queueTimestamp"`
+	Schedule                  *Schedule              `protobuf:"bytes,19,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	NextExecutionTime         int64                  `protobuf:"varint,20,opt,name=next_execution_time,json=nextExecutionTime,proto3" json:"nextExecutionTime"`
+	Paused                    bool                   `protobuf:"varint,21,opt,name=paused,proto3" json:"paused,omitempty"`
+	LogFilter                 *LogFilter             `protobuf:"bytes,22,opt,name=log_filter,json=logFilter,proto3" json:"logFilter"`
 }
 
 func (m *Cron) Reset()         { *m = Cron{} }
 func (m *Cron) String() string { return proto.CompactTextString(m) }
 func (*Cron) ProtoMessage()    {}
 func (*Cron) Descriptor() ([]byte, []int) {
-	return fileDescriptor_40f0621bb4e9e794, []int{0}
+	return fileDescriptor_40f0621bb4e9e794, []int{2}
 }
 func (m *Cron) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,60 +404,196 @@ func (m *Cron) GetQueueTimestamp() int64 {
 	return 0
 }
 
+func (m *Cron) GetSchedule() *Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *Cron) GetNextExecutionTime() int64 {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return 0
+}
+
+func (m *Cron) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Cron) GetLogFilter() *LogFilter {
+	if m != nil {
+		return m.LogFilter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("helios.chronos.v1.ExecutionStage", ExecutionStage_name, ExecutionStage_value)
+	proto.RegisterEnum("helios.chronos.v1.ScheduleType", ScheduleType_name, ScheduleType_value)
+	proto.RegisterEnum("helios.chronos.v1.CatchUpMode", CatchUpMode_name, CatchUpMode_value)
+	proto.RegisterType((*Schedule)(nil), "helios.chronos.v1.Schedule")
+	proto.RegisterType((*LogFilter)(nil), "helios.chronos.v1.LogFilter")
 	proto.RegisterType((*Cron)(nil), "helios.chronos.v1.Cron")
 }
 
 func init() { proto.RegisterFile("helios/chronos/v1/cron.proto", fileDescriptor_40f0621bb4e9e794) }
 
 var fileDescriptor_40f0621bb4e9e794 = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xdd, 0x4e, 0xdb, 0x48,
-	0x14, 0xc7, 0x63, 0x60, 0x49, 0x32, 0x21, 0x1f, 0xcc, 0xb2, 0x68, 0xf8, 0x8a, 0xc3, 0x5e, 0xac,
-	0xb2, 0x2b, 0x11, 0x8b, 0x5d, 0x6d, 0x55, 0xa9, 0x52, 0x25, 0x4c, 0x5d, 0x9a, 0x16, 0x05, 0x64,
-	0x52, 0xa9, 0x42, 0x6a, 0xdd, 0x89, 0x3d, 0x24, 0x53, 0x62, 0x8f, 0xf1, 0x4c, 0x68, 0x78, 0x8b,
-	0x3e, 0x4c, 0x1f, 0xa2, 0x97, 0xa8, 0x57, 0x55, 0x2f, 0xac, 0x0a, 0xee, 0xac, 0x3e, 0x44, 0xe5,
-	0x71, 0x1c, 0x92, 0xf4, 0xe3, 0xee, 0x9c, 0xff, 0xef, 0x7f, 0x4e, 0x8e, 0x4e, 0x8e, 0x07, 0x6c,
-	0xf6, 0x48, 0x9f, 0x32, 0xae, 0xd9, 0xbd, 0x80, 0x79, 0x8c, 0x6b, 0x97, 0xbb, 0x9a, 0x1d, 0x30,
-	0xaf, 0xe1, 0x07, 0x4c, 0x30, 0xb8, 0x9c, 0xd0, 0xc6, 0x88, 0x36, 0x2e, 0x77, 0xd7, 0x57, 0xba,
-	0xac, 0xcb, 0x24, 0xd5, 0xe2, 0x28, 0x31, 0xae, 0xaf, 0xd9, 0x8c, 0xbb, 0x8c, 0x5b, 0x09, 0x48,
-	0x92, 0x04, 0xfd, 0xf9, 0x35, 0x0b, 0x16, 0xf6, 0x03, 0xe6, 0xc1, 0x12, 0x98, 0xa3, 0x0e, 0x52,
-	0x6a, 0x4a, 0x7d, 0xc1, 0x9c, 0xa3, 0x0e, 0x44, 0x20, 0x8b, 0x1d, 0x27, 0x20, 0x9c, 0xa3, 0xb9,
-	0x9a, 0x52, 0xcf, 0x9b, 0x69, 0x0a, 0xff, 0x07, 0x45, 0xf6, 0xd6, 0x23, 0x81, 0x95, 0xf2, 0xf9,
-	0x98, 0xeb, 0x95, 0x28, 0x54, 0x97, 0x24, 0xd8, 0x4b, 0x74, 0x73, 0x2a, 0x83, 0x0f, 0x41, 0xc5,
-	0x66, 0x9e, 0x08, 0xb0, 0x2d, 0xc6, 0x95, 0x0b, 0xb2, 0xf2, 0xf7, 0x28, 0x54, 0xcb, 0x29, 0x4b,
-	0x8b, 0x67, 0x05, 0xf8, 0x17, 0xc8, 0xe1, 0x0e, 0xb5, 0xde, 0x70, 0xe6, 0xa1, 0xdf, 0x64, 0x5d,
-	0x21, 0x0a, 0xd5, 0x2c, 0xee, 0xd0, 0xa7, 0x9c, 0x79, 0x66, 0x1a, 0x40, 0x0d, 0x14, 0x5c, 0x22,
-	0x7a, 0xcc, 0xb1, 0x3c, 0xec, 0x12, 0xb4, 0x28, 0xad, 0xa5, 0x28, 0x54, 0x41, 0x22, 0xb7, 0xb0,
-	0x4b, 0xcc, 0x89, 0x18, 0xae, 0x82, 0x45, 0x1f, 0x07, 0xd8, 0xe5, 0x28, 0x5b, 0x9b, 0xaf, 0xe7,
-	0xcd, 0x51, 0x06, 0x37, 0x41, 0xfe, 0x2c, 0x20, 0x17, 0x03, 0xe2, 0xd9, 0x57, 0x28, 0x27, 0x17,
-	0x73, 0x27, 0xc0, 0x27, 0x60, 0xc5, 0x23, 0x43, 0x61, 0x91, 0x21, 0xb1, 0x07, 0x82, 0x32, 0xcf,
-	0xea, 0xf4, 0x99, 0x7d, 0x8e, 0xf2, 0xb1, 0x51, 0x5f, 0x8d, 0x42, 0x15, 0xc6, 0xdc, 0x48, 0xb1,
-	0x1e, 0x53, 0xf3, 0x07, 0x5a, 0xbc, 0x18, 0x32, 0xf4, 0x69, 0x80, 0x27, 0xba, 0x00, 0xd9, 0x45,
-	0x2e, 0xe6, 0x8e, 0x25, 0x2d, 0x66, 0x05, 0xf8, 0x0a, 0x94, 0xef, 0x86, 0xe0, 0x02, 0x77, 0x09,
-	0x2a, 0xd4, 0x94, 0x7a, 0xe9, 0xdf, 0xed, 0xc6, 0x77, 0x07, 0xd2, 0x18, 0xff, 0xf6, 0x49, 0x6c,
-	0xd4, 0x61, 0x14, 0xaa, 0x25, 0x32, 0xa5, 0x99, 0x33, 0x39, 0xfc, 0x1b, 0xe4, 0xbb, 0x98, 0x5b,
-	0x7d, 0xea, 0x52, 0x81, 0x96, 0xe4, 0x60, 0x4b, 0x51, 0xa8, 0xe6, 0xba, 0x98, 0x1f, 0xc6, 0x9a,
-	0x39, 0x8e, 0xe0, 0x29, 0x28, 0xba, 0x78, 0x68, 0xc5, 0x76, 0x3f, 0xa0, 0x36, 0x41, 0x45, 0xb9,
-	0xfd, 0x7b, 0x9f, 0x43, 0xf5, 0x8f, 0xe4, 0xec, 0xb8, 0x73, 0xde, 0xa0, 0x4c, 0x73, 0xb1, 0xe8,
-	0x35, 0x9a, 0x9e, 0x88, 0x42, 0xb5, 0xe0, 0xe2, 0xe1, 0x01, 0xe6, 0xc7, 0xb1, 0xff, 0xe3, 0xfb,
-	0x1d, 0x30, 0x3a, 0xcf, 0xa6, 0x27, 0xcc, 0x49, 0x04, 0x5f, 0x82, 0x0d, 0xc1, 0x04, 0xee, 0x8f,
-	0x36, 0x4e, 0x1c, 0x4b, 0x04, 0xd8, 0xe3, 0xd8, 0x8e, 0x27, 0xe5, 0xa8, 0x24, 0x07, 0xdb, 0x8a,
-	0x42, 0x75, 0x4d, 0xda, 0x8c, 0x91, 0xab, 0x3d, 0x61, 0x32, 0x7f, 0x8e, 0xe0, 0x6b, 0x50, 0x4e,
-	0xda, 0x9f, 0x11, 0xc2, 0x2d, 0x1f, 0x53, 0x07, 0x95, 0xe5, 0xf0, 0xf7, 0x7f, 0x35, 0x7c, 0x51,
-	0xd6, 0x3c, 0x26, 0x84, 0x1f, 0x63, 0xea, 0xcc, 0x8c, 0x3f, 0x0d, 0xe3, 0x3d, 0xc6, 0x1f, 0xaf,
-	0x25, 0xae, 0x7c, 0x82, 0x2a, 0xb2, 0xb7, 0xdc, 0x63, 0x2c, 0xb6, 0xaf, 0x7c, 0x62, 0x8e, 0x23,
-	0xb8, 0x0e, 0x72, 0x38, 0xb0, 0x7b, 0xf4, 0x92, 0x38, 0x68, 0xb9, 0xa6, 0xd4, 0x73, 0xe6, 0x38,
-	0x87, 0x0f, 0x40, 0xf9, 0x62, 0x40, 0x06, 0xc4, 0x12, 0xd4, 0x25, 0x5c, 0x60, 0xd7, 0x47, 0xb0,
-	0xa6, 0xd4, 0xe7, 0x93, 0xff, 0x52, 0xa2, 0x76, 0x4a, 0xcc, 0x99, 0xfc, 0x9f, 0x36, 0x28, 0x4d,
-	0x5f, 0x00, 0x54, 0xc1, 0x86, 0xf1, 0xc2, 0xd8, 0x7f, 0xde, 0x6e, 0x1e, 0xb5, 0xac, 0x93, 0xf6,
-	0xde, 0x81, 0x61, 0x19, 0xad, 0x47, 0x96, 0x7e, 0x78, 0xb4, 0xff, 0xcc, 0x30, 0x2b, 0x19, 0xb8,
-	0x0d, 0xb6, 0x66, 0x0d, 0xba, 0x71, 0xd0, 0x6c, 0x8d, 0x2d, 0x8a, 0xae, 0x7f, 0xb8, 0xa9, 0x2a,
-	0xd7, 0x37, 0x55, 0xe5, 0xcb, 0x4d, 0x55, 0x79, 0x77, 0x5b, 0xcd, 0x5c, 0xdf, 0x56, 0x33, 0x9f,
-	0x6e, 0xab, 0x99, 0xd3, 0x7a, 0x72, 0x81, 0x3b, 0x36, 0x0b, 0x88, 0x96, 0xc6, 0x3d, 0x4c, 0x3d,
-	0x6d, 0x38, 0x7e, 0xd4, 0xe2, 0x7d, 0xf0, 0xce, 0xa2, 0x7c, 0x8f, 0xfe, 0xfb, 0x16, 0x00, 0x00,
-	0xff, 0xff, 0x83, 0x35, 0xfa, 0xbd, 0xf3, 0x04, 0x00, 0x00,
+	// 1026 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x5d, 0x6f, 0x1a, 0x47,
+	0x17, 0xf6, 0x62, 0x5e, 0x1b, 0x06, 0xf3, 0xe1, 0xf1, 0x87, 0x26, 0xb6, 0xc3, 0x12, 0x5f, 0xbc,
+	0xa2, 0x96, 0x02, 0x4a, 0xa2, 0x7e, 0x48, 0x55, 0x2b, 0x19, 0xbc, 0xb1, 0x49, 0x1c, 0x40, 0x03,
+	0xae, 0xd2, 0x48, 0xed, 0x74, 0xbc, 0x3b, 0x86, 0x6d, 0xd8, 0x9d, 0xcd, 0xce, 0xe2, 0xe2, 0x7f,
+	0xd1, 0xfe, 0x97, 0xfe, 0x88, 0x5e, 0x5a, 0xbd, 0xaa, 0x7a, 0xb1, 0xaa, 0xec, 0x3b, 0x7e, 0x45,
+	0x35, 0xb3, 0x2c, 0x5f, 0x71, 0x73, 0x77, 0xce, 0x79, 0x9e, 0x39, 0xf3, 0xf0, 0x70, 0xf6, 0x0c,
+	0x38, 0xe8, 0xb3, 0x81, 0xcd, 0x45, 0xd5, 0xec, 0xfb, 0xdc, 0xe5, 0xa2, 0x7a, 0xfd, 0xac, 0x6a,
+	0xfa, 0xdc, 0xad, 0x78, 0x3e, 0x0f, 0x38, 0xdc, 0x8c, 0xd0, 0xca, 0x04, 0xad, 0x5c, 0x3f, 0xdb,
+	0xdb, 0xee, 0xf1, 0x1e, 0x57, 0x68, 0x55, 0x46, 0x11, 0x71, 0xef, 0x91, 0xc9, 0x85, 0xc3, 0x05,
+	0x89, 0x80, 0x28, 0x89, 0xa0, 0xc3, 0xdf, 0x12, 0x20, 0xd5, 0x31, 0xfb, 0xcc, 0x1a, 0x0e, 0x18,
+	0x7c, 0x01, 0x92, 0xc1, 0x8d, 0xc7, 0x90, 0x56, 0xd2, 0xca, 0xb9, 0xe7, 0x7a, 0xe5, 0xa3, 0xfe,
+	0x95, 0x98, 0xda, 0xbd, 0xf1, 0x18, 0x56, 0x64, 0xf8, 0x35, 0xc8, 0x4b, 0x4d, 0x84, 0x8d, 0x3c,
+	0x9f, 0x09, 0x61, 0x73, 0x17, 0x25, 0x4a, 0x5a, 0x39, 0x5d, 0x83, 0xe3, 0x50, 0xcf, 0x49, 0xc8,
+	0x98, 0x22, 0x78, 0x29, 0x87, 0xdf, 0x82, 0x82, 0xed, 0x06, 0xcc, 0xbf, 0xa6, 0x03, 0x22, 0x98,
+	0xc9, 0x5d, 0x4b, 0xa0, 0xd5, 0x92, 0x56, 0x4e, 0xd6, 0xb6, 0xc6, 0xa1, 0x9e, 0x8f, 0xb1, 0x4e,
+	0x04, 0xe1, 0xe5, 0x02, 0xec, 0x80, 0xac, 0x49, 0x03, 0xb3, 0x4f, 0x86, 0x1e, 0x71, 0xb8, 0xc5,
+	0x50, 0x52, 0x49, 0x2f, 0x3e, 0x20, 0xbd, 0x2e, 0x79, 0x17, 0xde, 0x1b, 0x6e, 0xb1, 0x5a, 0x7e,
+	0x1c, 0xea, 0x19, 0x73, 0x56, 0xc0, 0xf3, 0xc9, 0xe1, 0x37, 0x20, 0x7d, 0xce, 0x7b, 0x2f, 0xed,
+	0x41, 0xc0, 0x7c, 0x88, 0xc0, 0x3a, 0xb5, 0x2c, 0xa9, 0x57, 0xd9, 0x92, 0xc6, 0x71, 0x0a, 0x77,
+	0xc1, 0x5a, 0xc0, 0x3d, 0xdb, 0x14, 0x28, 0x51, 0x5a, 0x2d, 0xa7, 0xf1, 0x24, 0x3b, 0xbc, 0x4d,
+	0x83, 0x64, 0xdd, 0xe7, 0x2e, 0xcc, 0x81, 0x84, 0x6d, 0xa9, 0x53, 0x49, 0x9c, 0xb0, 0xad, 0xf9,
+	0x56, 0x89, 0xc5, 0x56, 0x9f, 0x83, 0x2c, 0xff, 0xc5, 0x65, 0x3e, 0x89, 0xf1, 0x55, 0xe5, 0x60,
+	0x61, 0x1c, 0xea, 0x1b, 0x0a, 0x38, 0x8e, 0xea, 0x78, 0x21, 0x93, 0xee, 0x99, 0xdc, 0x0d, 0x7c,
+	0x6a, 0x06, 0xd3, 0x93, 0x49, 0x75, 0x52, 0xb9, 0x17, 0x63, 0xf1, 0xe1, 0xe5, 0x02, 0xfc, 0x3f,
+	0x48, 0xd1, 0x4b, 0x9b, 0xfc, 0x2c, 0xb8, 0x8b, 0xfe, 0xa7, 0xce, 0x65, 0xc6, 0xa1, 0xbe, 0x4e,
+	0x2f, 0xed, 0x57, 0x82, 0xbb, 0x38, 0x0e, 0x60, 0x15, 0x64, 0x1c, 0x16, 0xf4, 0xb9, 0x45, 0x5c,
+	0xea, 0x30, 0xb4, 0xa6, 0xa8, 0xb9, 0x71, 0xa8, 0x83, 0xa8, 0xdc, 0xa4, 0x0e, 0xc3, 0x73, 0xb1,
+	0xb4, 0xc6, 0xa3, 0x3e, 0x75, 0x04, 0x5a, 0x8f, 0xac, 0x89, 0x32, 0x78, 0x00, 0xd2, 0x57, 0x3e,
+	0xfb, 0x30, 0x64, 0xae, 0x79, 0x83, 0x52, 0xca, 0x98, 0x59, 0x01, 0x9e, 0x81, 0x6d, 0x97, 0x8d,
+	0x02, 0xc2, 0x46, 0xcc, 0x1c, 0x06, 0x36, 0x77, 0xc9, 0xe5, 0x80, 0x9b, 0xef, 0x51, 0x5a, 0x0d,
+	0xc4, 0xee, 0x38, 0xd4, 0xa1, 0xc4, 0x8d, 0x18, 0xae, 0x49, 0x14, 0x3f, 0x50, 0x93, 0xc6, 0xb0,
+	0x91, 0x67, 0xfb, 0x74, 0xae, 0x0b, 0x98, 0x8d, 0xd5, 0x0c, 0x8b, 0x5a, 0x2c, 0x17, 0xe0, 0x8f,
+	0x20, 0x3f, 0x13, 0x21, 0x02, 0xda, 0x63, 0x28, 0xa3, 0x06, 0xeb, 0xc9, 0x03, 0x83, 0x35, 0xbd,
+	0xbb, 0x23, 0x89, 0xd1, 0xd8, 0xb3, 0x85, 0x1a, 0x5e, 0xca, 0xe1, 0x67, 0x20, 0xdd, 0xa3, 0x82,
+	0x0c, 0x6c, 0xc7, 0x0e, 0xd0, 0x86, 0x12, 0xb6, 0x31, 0x0e, 0xf5, 0x54, 0x8f, 0x8a, 0x73, 0x59,
+	0xc3, 0xd3, 0x08, 0xbe, 0x03, 0x59, 0x87, 0x8e, 0x88, 0xa4, 0x7b, 0xbe, 0x6d, 0x32, 0x94, 0x55,
+	0xee, 0x7f, 0xf1, 0x77, 0xa8, 0xef, 0x44, 0x5f, 0xb2, 0xb0, 0xde, 0x57, 0x6c, 0x5e, 0x75, 0x68,
+	0xd0, 0xaf, 0x34, 0xdc, 0x40, 0x8e, 0xb6, 0x43, 0x47, 0xa7, 0x54, 0xb4, 0x25, 0xff, 0xcf, 0xdf,
+	0x9f, 0x82, 0xc9, 0x17, 0xdf, 0x70, 0x03, 0x3c, 0x0f, 0xc1, 0x1f, 0xc0, 0x7e, 0xc0, 0x03, 0x3a,
+	0x98, 0x38, 0xce, 0x2c, 0x12, 0xf8, 0xd4, 0x15, 0xd4, 0x94, 0x4a, 0x05, 0xca, 0x29, 0x61, 0x8f,
+	0xc7, 0xa1, 0xfe, 0x48, 0xd1, 0x8c, 0x09, 0xab, 0x3b, 0x47, 0xc2, 0xff, 0x0d, 0xc1, 0x9f, 0x40,
+	0x3e, 0x6a, 0x7f, 0xc5, 0x98, 0x20, 0x1e, 0xb5, 0x2d, 0x94, 0x57, 0xe2, 0xbf, 0xfa, 0x94, 0xf8,
+	0xac, 0x3a, 0xf3, 0x92, 0x31, 0xd1, 0xa6, 0xb6, 0xb5, 0x24, 0x7f, 0x11, 0x94, 0x3e, 0xaa, 0xdd,
+	0xa3, 0xb6, 0x56, 0x41, 0xf5, 0x56, 0x3e, 0xca, 0xa2, 0x5a, 0x51, 0xd3, 0x08, 0xee, 0x81, 0x14,
+	0xf5, 0xcd, 0xbe, 0x7d, 0xcd, 0x2c, 0xb4, 0x59, 0xd2, 0xca, 0x29, 0x3c, 0xcd, 0xe5, 0x0a, 0xfb,
+	0x30, 0x64, 0x43, 0x46, 0x02, 0xdb, 0x61, 0x22, 0xa0, 0x8e, 0x87, 0x60, 0x49, 0x2b, 0xaf, 0x46,
+	0xff, 0xa5, 0x82, 0xba, 0x31, 0x82, 0x97, 0x72, 0xf8, 0x25, 0x48, 0x89, 0xc9, 0x56, 0x44, 0x5b,
+	0x25, 0xad, 0x9c, 0x79, 0xbe, 0xff, 0x89, 0xc5, 0x89, 0xa7, 0x64, 0x68, 0x80, 0xad, 0xa5, 0x71,
+	0x97, 0xd7, 0xa3, 0x6d, 0x75, 0xf3, 0xce, 0x38, 0xd4, 0x37, 0x17, 0x26, 0x5b, 0xde, 0x88, 0x3f,
+	0x2e, 0x45, 0xdf, 0xda, 0x50, 0x30, 0x0b, 0xed, 0xa8, 0x9f, 0x35, 0xc9, 0xe0, 0x2b, 0x00, 0x06,
+	0xbc, 0x47, 0xae, 0xd4, 0x1a, 0x43, 0xbb, 0x4a, 0xd9, 0xc1, 0x03, 0xca, 0xa6, 0xab, 0xae, 0x96,
+	0x1d, 0x87, 0x7a, 0x7a, 0x10, 0xa7, 0x78, 0x16, 0x1e, 0x75, 0x41, 0x6e, 0x71, 0xca, 0xa1, 0x0e,
+	0xf6, 0x8d, 0xb7, 0x46, 0xfd, 0xa2, 0xdb, 0x68, 0x35, 0x49, 0xa7, 0x7b, 0x7c, 0x6a, 0x10, 0xa3,
+	0x79, 0x42, 0x6a, 0xe7, 0xad, 0xfa, 0x6b, 0x03, 0x17, 0x56, 0xe0, 0x13, 0xf0, 0x78, 0x99, 0x50,
+	0x33, 0x4e, 0x1b, 0xcd, 0x29, 0x45, 0x3b, 0xea, 0x81, 0x8d, 0xf9, 0xf7, 0x04, 0x22, 0xb0, 0xdd,
+	0xa9, 0x9f, 0x19, 0x27, 0x17, 0xe7, 0x06, 0xe9, 0x7e, 0xdf, 0x36, 0x22, 0x6a, 0x27, 0x6a, 0xb6,
+	0x88, 0xd4, 0x71, 0xab, 0x49, 0x8c, 0xb7, 0x6d, 0x6c, 0x74, 0x3a, 0x8d, 0x56, 0xb3, 0xa0, 0xc1,
+	0x3d, 0xb0, 0xbb, 0x48, 0x69, 0x34, 0xbb, 0x06, 0xfe, 0xee, 0xf8, 0xbc, 0x90, 0x38, 0x3a, 0x06,
+	0x99, 0xb9, 0xed, 0x0f, 0x77, 0x01, 0xac, 0x1f, 0x77, 0xeb, 0x67, 0xe4, 0xa2, 0x4d, 0xde, 0xb4,
+	0x4e, 0x0c, 0xd2, 0x79, 0xdd, 0x68, 0x17, 0x56, 0x64, 0x8b, 0xc5, 0x3a, 0xbe, 0x68, 0x92, 0x56,
+	0xb3, 0x6e, 0x14, 0xb4, 0x5a, 0xed, 0x8f, 0xbb, 0xa2, 0x76, 0x7b, 0x57, 0xd4, 0xfe, 0xb9, 0x2b,
+	0x6a, 0xbf, 0xde, 0x17, 0x57, 0x6e, 0xef, 0x8b, 0x2b, 0x7f, 0xdd, 0x17, 0x57, 0xde, 0x95, 0x23,
+	0x4b, 0x9f, 0x9a, 0xdc, 0x67, 0xd5, 0x38, 0xee, 0x53, 0xdb, 0xad, 0x8e, 0xa6, 0xef, 0xb6, 0x9c,
+	0x4f, 0x71, 0xb9, 0xa6, 0x9e, 0xdc, 0x17, 0xff, 0x0e, 0x00, 0xe4, 0x68, 0x05, 0x22, 0xd6, 0x07,
+	0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CatchUpMode != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.CatchUpMode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintCron(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Cron) Marshal() (dAtA []byte, err error) {
@@ -294,6 +616,53 @@ func (m *Cron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LogFilter != nil {
+		{
+			size, err := m.LogFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCron(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.NextExecutionTime != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.NextExecutionTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCron(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.QueueTimestamp != 0 {
 		i = encodeVarintCron(dAtA, i, uint64(m.QueueTimestamp))
 		i--
@@ -439,6 +808,47 @@ func encodeVarintCron(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovCron(uint64(m.Type))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovCron(uint64(m.IntervalSeconds))
+	}
+	if m.CatchUpMode != 0 {
+		n += 1 + sovCron(uint64(m.CatchUpMode))
+	}
+	return n
+}
+
+func (m *LogFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovCron(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Cron) Size() (n int) {
 	if m == nil {
 		return 0
@@ -474,50 +884,317 @@ func (m *Cron) Size() (n int) {
 			n += 1 + l + sovCron(uint64(l))
 		}
 	}
-	if m.Frequency != 0 {
-		n += 1 + sovCron(uint64(m.Frequency))
-	}
-	if m.NextExecutionBlock != 0 {
-		n += 1 + sovCron(uint64(m.NextExecutionBlock))
-	}
-	if m.ExpirationBlock != 0 {
-		n += 1 + sovCron(uint64(m.ExpirationBlock))
-	}
-	if m.ExecutionStage != 0 {
-		n += 1 + sovCron(uint64(m.ExecutionStage))
-	}
-	if m.GasLimit != 0 {
-		n += 1 + sovCron(uint64(m.GasLimit))
-	}
-	if m.MaxGasPrice != nil {
-		l = m.MaxGasPrice.Size()
-		n += 1 + l + sovCron(uint64(l))
-	}
-	if m.TotalExecutedTransactions != 0 {
-		n += 1 + sovCron(uint64(m.TotalExecutedTransactions))
-	}
-	if m.TotalFeesPaid != nil {
-		l = m.TotalFeesPaid.Size()
-		n += 1 + l + sovCron(uint64(l))
-	}
-	l = len(m.CronType)
-	if l > 0 {
-		n += 2 + l + sovCron(uint64(l))
-	}
-	if m.Archived {
-		n += 3
-	}
-	if m.QueueTimestamp != 0 {
-		n += 2 + sovCron(uint64(m.QueueTimestamp))
+	if m.Frequency != 0 {
+		n += 1 + sovCron(uint64(m.Frequency))
+	}
+	if m.NextExecutionBlock != 0 {
+		n += 1 + sovCron(uint64(m.NextExecutionBlock))
+	}
+	if m.ExpirationBlock != 0 {
+		n += 1 + sovCron(uint64(m.ExpirationBlock))
+	}
+	if m.ExecutionStage != 0 {
+		n += 1 + sovCron(uint64(m.ExecutionStage))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCron(uint64(m.GasLimit))
+	}
+	if m.MaxGasPrice != nil {
+		l = m.MaxGasPrice.Size()
+		n += 1 + l + sovCron(uint64(l))
+	}
+	if m.TotalExecutedTransactions != 0 {
+		n += 1 + sovCron(uint64(m.TotalExecutedTransactions))
+	}
+	if m.TotalFeesPaid != nil {
+		l = m.TotalFeesPaid.Size()
+		n += 1 + l + sovCron(uint64(l))
+	}
+	l = len(m.CronType)
+	if l > 0 {
+		n += 2 + l + sovCron(uint64(l))
+	}
+	if m.Archived {
+		n += 3
+	}
+	if m.QueueTimestamp != 0 {
+		n += 2 + sovCron(uint64(m.QueueTimestamp))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 2 + l + sovCron(uint64(l))
+	}
+	if m.NextExecutionTime != 0 {
+		n += 2 + sovCron(uint64(m.NextExecutionTime))
+	}
+	if m.Paused {
+		n += 3
+	}
+	if m.LogFilter != nil {
+		l = m.LogFilter.Size()
+		n += 2 + l + sovCron(uint64(l))
+	}
+	return n
+}
+
+func sovCron(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCron(x uint64) (n int) {
+	return sovCron(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpMode", wireType)
+			}
+			m.CatchUpMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpMode |= CatchUpMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCron(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *LogFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCron(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovCron(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCron(x uint64) (n int) {
-	return sovCron(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *Cron) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1016,6 +1693,117 @@ func (m *Cron) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			m.NextExecutionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExecutionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogFilter == nil {
+				m.LogFilter = &LogFilter{}
+			}
+			if err := m.LogFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCron(dAtA[iNdEx:])
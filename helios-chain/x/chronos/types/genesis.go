@@ -28,7 +28,24 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("invalid owner address (%s): %w", elem.OwnerAddress, err)
 		}
 
-		if elem.ContractAddress == "" || elem.MethodName == "" || elem.Frequency == 0 {
+		if elem.ContractAddress == "" || elem.MethodName == "" {
+			return fmt.Errorf("cron fields cannot be empty or zero")
+		}
+
+		switch {
+		case elem.CronType == EVENT_TRIGGERED_CRON:
+			if elem.LogFilter == nil {
+				return fmt.Errorf("missing log filter for cron %d", elem.Id)
+			}
+			if err := elem.LogFilter.Validate(); err != nil {
+				return fmt.Errorf("invalid log filter for cron %d: %w", elem.Id, err)
+			}
+		case elem.CronType == CALLBACK_CONDITIONED_CRON:
+		case elem.Schedule.IsTimeBased():
+			if err := elem.Schedule.Validate(); err != nil {
+				return fmt.Errorf("invalid schedule for cron %d: %w", elem.Id, err)
+			}
+		case elem.Frequency == 0:
 			return fmt.Errorf("cron fields cannot be empty or zero")
 		}
 	}
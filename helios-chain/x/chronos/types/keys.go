@@ -37,6 +37,8 @@ const (
 	prefixSecondIndexOutgoingTXFeeKey                 // 17
 	prefixCronQueueCountKey                           // 18
 	prefixCronIndexByOwnerAddressKey                  // 19
+	prefixCronIndexByLogAddressKey                    // 20
+	prefixCronMatchedLogKey                           // 21
 )
 
 var (
@@ -85,6 +87,12 @@ var (
 	CronQueueCountKey = []byte{prefixCronQueueCountKey}
 
 	CronIndexByOwnerAddressKey = []byte{prefixCronIndexByOwnerAddressKey}
+
+	// CronIndexByLogAddressKey indexes the event triggered crons by the address of their log filter
+	CronIndexByLogAddressKey = []byte{prefixCronIndexByLogAddressKey}
+
+	// CronMatchedLogKey is the prefix for storing the log matched by an event triggered cron
+	CronMatchedLogKey = []byte{prefixCronMatchedLogKey}
 )
 
 var (
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxLogFilterTopics is the number of topics of an EVM log
+	MaxLogFilterTopics = 4
+
+	// MaxEventTriggeredCronsPerAddress bounds the event triggered crons matched against
+	// the logs of a single contract.
+	MaxEventTriggeredCronsPerAddress = 20
+)
+
+// Validate checks the log filter is well formed
+func (f LogFilter) Validate() error {
+	if !common.IsHexAddress(f.Address) || !strings.HasPrefix(f.Address, "0x") {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "log filter address is invalid")
+	}
+	if len(f.Topics) > MaxLogFilterTopics {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "log filter can't have more than %d topics", MaxLogFilterTopics)
+	}
+	for i, topic := range f.Topics {
+		if topic == "" {
+			continue
+		}
+		if bz, err := hexutil.Decode(topic); err != nil || len(bz) != common.HashLength {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "log filter topic %d is invalid", i)
+		}
+	}
+	return nil
+}
+
+// Matches returns true when the log is emitted by the filter address and its topics
+// match the non empty topics of the filter.
+func (f LogFilter) Matches(log *ethtypes.Log) bool {
+	if log.Address != common.HexToAddress(f.Address) {
+		return false
+	}
+	for i, topic := range f.Topics {
+		if topic == "" {
+			continue
+		}
+		if i >= len(log.Topics) || log.Topics[i] != common.HexToHash(topic) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewCronMatchedLog records the log matched by an event triggered cron
+func NewCronMatchedLog(log *ethtypes.Log) CronMatchedLog {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Hex()
+	}
+	return CronMatchedLog{
+		Address:  log.Address.Hex(),
+		Topics:   topics,
+		Data:     log.Data,
+		TxHash:   log.TxHash.Hex(),
+		LogIndex: uint64(log.Index),
+	}
+}
+
+// CallbackParams returns the params of the (address emitter, bytes32[] topics, bytes data)
+// callback of event triggered crons.
+func (m CronMatchedLog) CallbackParams() ([]string, error) {
+	topics, err := json.Marshal(m.Topics)
+	if err != nil {
+		return nil, err
+	}
+	return []string{m.Address, string(topics), hexutil.Encode(m.Data)}, nil
+}
+
+// EventTriggeredCronABI returns the ABI of the callback of event triggered crons
+func EventTriggeredCronABI(methodName string) string {
+	return `[ { "inputs": [ { "internalType": "address", "name": "emitter", "type": "address" }, { "internalType": "bytes32[]", "name": "topics", "type": "bytes32[]" }, { "internalType": "bytes", "name": "data", "type": "bytes" } ], "name": "` + methodName + `", "outputs": [], "payable": false, "stateMutability": "nonpayable", "type": "function" } ]`
+}
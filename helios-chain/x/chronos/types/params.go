@@ -12,11 +12,14 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 var (
 	KeyCronActiveGasCostPerBlock = []byte("CronActiveGasCostPerBlock")
 	KeyExecutionsLimitPerBlock   = []byte("ExecutionsLimitPerBlock")
+	KeyMaxCronGasPerBlock        = []byte("MaxCronGasPerBlock")
+	KeyMaxExecutionsPerOwner     = []byte("MaxExecutionsPerOwnerPerBlock")
 
-	DefaultCronActiveGasCostPerBlock = uint64(10)         // 10 Gas
-	DefaultExecutionsLimitPerBlock   = uint64(100)        // 100
-	DefaultCronQueueTimeout          = uint64(3600)       // 1 hour
-	DefaultMaxCronGasPerBlock        = uint64(50_000_000) // 50M (10% of the block gas limit)
+	DefaultCronActiveGasCostPerBlock     = uint64(10)         // 10 Gas
+	DefaultExecutionsLimitPerBlock       = uint64(100)        // 100
+	DefaultCronQueueTimeout              = uint64(3600)       // 1 hour
+	DefaultMaxCronGasPerBlock            = uint64(50_000_000) // 50M (10% of the block gas limit)
+	DefaultMaxExecutionsPerOwnerPerBlock = uint64(10)         // 10
 )
 
 // ParamKeyTable returns the param key table for the cron module
@@ -25,16 +28,28 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(cronActiveGasCostPerBlock uint64, executionsLimitPerBlock uint64) Params {
+func NewParams(
+	cronActiveGasCostPerBlock uint64,
+	executionsLimitPerBlock uint64,
+	maxCronGasPerBlock uint64,
+	maxExecutionsPerOwnerPerBlock uint64,
+) Params {
 	return Params{
-		CronActiveGasCostPerBlock: cronActiveGasCostPerBlock,
-		ExecutionsLimitPerBlock:   executionsLimitPerBlock,
+		CronActiveGasCostPerBlock:     cronActiveGasCostPerBlock,
+		ExecutionsLimitPerBlock:       executionsLimitPerBlock,
+		MaxCronGasPerBlock:            maxCronGasPerBlock,
+		MaxExecutionsPerOwnerPerBlock: maxExecutionsPerOwnerPerBlock,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultCronActiveGasCostPerBlock, DefaultExecutionsLimitPerBlock)
+	return NewParams(
+		DefaultCronActiveGasCostPerBlock,
+		DefaultExecutionsLimitPerBlock,
+		DefaultMaxCronGasPerBlock,
+		DefaultMaxExecutionsPerOwnerPerBlock,
+	)
 }
 
 // ParamSetPairs returns the param set pairs for the cron module
@@ -42,6 +57,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyCronActiveGasCostPerBlock, &p.CronActiveGasCostPerBlock, validateCronActiveGasCostPerBlock),
 		paramtypes.NewParamSetPair(KeyExecutionsLimitPerBlock, &p.ExecutionsLimitPerBlock, validateExecutionsLimitPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxCronGasPerBlock, &p.MaxCronGasPerBlock, validateMaxCronGasPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxExecutionsPerOwner, &p.MaxExecutionsPerOwnerPerBlock, validateMaxExecutionsPerOwnerPerBlock),
 	}
 }
 
@@ -53,6 +70,12 @@ func (p Params) Validate() error {
 	if err := validateExecutionsLimitPerBlock(p.ExecutionsLimitPerBlock); err != nil {
 		return err
 	}
+	if err := validateMaxCronGasPerBlock(p.MaxCronGasPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxExecutionsPerOwnerPerBlock(p.MaxExecutionsPerOwnerPerBlock); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -83,3 +106,25 @@ func validateExecutionsLimitPerBlock(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMaxCronGasPerBlock(i interface{}) error {
+	l, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if l == 0 {
+		return fmt.Errorf("max cron gas per block cannot be zero")
+	}
+	return nil
+}
+
+func validateMaxExecutionsPerOwnerPerBlock(i interface{}) error {
+	l, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if l == 0 {
+		return fmt.Errorf("limit cannot be zero")
+	}
+	return nil
+}
@@ -29,6 +29,10 @@ type Params struct {
 	CronActiveGasCostPerBlock uint64 `protobuf:"varint,1,opt,name=cron_active_gas_cost_per_block,json=cronActiveGasCostPerBlock,proto3" json:"cron_active_gas_cost_per_block,omitempty"`
 	// maximum executions per block
 	ExecutionsLimitPerBlock uint64 `protobuf:"varint,2,opt,name=executions_limit_per_block,json=executionsLimitPerBlock,proto3" json:"executions_limit_per_block,omitempty"`
+	// maximum gas consumed by cron executions in a single block
+	MaxCronGasPerBlock uint64 `protobuf:"varint,3,opt,name=max_cron_gas_per_block,json=maxCronGasPerBlock,proto3" json:"max_cron_gas_per_block,omitempty"`
+	// maximum executions per owner in a single block
+	MaxExecutionsPerOwnerPerBlock uint64 `protobuf:"varint,4,opt,name=max_executions_per_owner_per_block,json=maxExecutionsPerOwnerPerBlock,proto3" json:"max_executions_per_owner_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -77,6 +81,20 @@ func (m *Params) GetExecutionsLimitPerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxCronGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxCronGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExecutionsPerOwnerPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExecutionsPerOwnerPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "helios.chronos.v1.Params")
 }
@@ -84,23 +102,26 @@ func init() {
 func init() { proto.RegisterFile("helios/chronos/v1/params.proto", fileDescriptor_cd175cea699159aa) }
 
 var fileDescriptor_cd175cea699159aa = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x48, 0xcd, 0xc9,
-	0xcc, 0x2f, 0xd6, 0x4f, 0xce, 0x28, 0xca, 0xcf, 0xcb, 0x2f, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xc8, 0xeb, 0x41,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44,
-	0xa1, 0x52, 0x1f, 0x23, 0x17, 0x5b, 0x00, 0x58, 0xa7, 0x90, 0x23, 0x97, 0x5c, 0x72, 0x51, 0x7e,
-	0x5e, 0x7c, 0x62, 0x72, 0x49, 0x66, 0x59, 0x6a, 0x7c, 0x7a, 0x62, 0x71, 0x7c, 0x72, 0x7e, 0x71,
-	0x49, 0x7c, 0x41, 0x6a, 0x51, 0x7c, 0x52, 0x4e, 0x7e, 0x72, 0xb6, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x4b, 0x90, 0x24, 0x48, 0x95, 0x23, 0x58, 0x91, 0x7b, 0x62, 0xb1, 0x73, 0x7e, 0x71, 0x49, 0x40,
-	0x6a, 0x91, 0x13, 0x48, 0x81, 0x90, 0x35, 0x97, 0x54, 0x6a, 0x45, 0x6a, 0x72, 0x69, 0x49, 0x66,
-	0x7e, 0x5e, 0x71, 0x7c, 0x4e, 0x66, 0x6e, 0x26, 0xb2, 0x76, 0x26, 0xb0, 0x76, 0x71, 0x84, 0x0a,
-	0x1f, 0x90, 0x02, 0x98, 0x66, 0x2b, 0x96, 0x19, 0x0b, 0xe4, 0x19, 0x9c, 0x9c, 0x4e, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x03, 0xe2, 0x27, 0xdd, 0xe4, 0xfc, 0xa2, 0x54,
-	0x7d, 0x18, 0x3b, 0x23, 0x31, 0x33, 0x4f, 0xbf, 0x02, 0x1e, 0x0e, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0xbf, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0xc9, 0xcc, 0x21, 0x26,
-	0x01, 0x00, 0x00,
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0xdb, 0x39, 0x76, 0xc8, 0xcd, 0x22, 0xfe, 0x19, 0x18, 0x65, 0xa7, 0x5d, 0x5c, 0x98,
+	0xde, 0xf4, 0xb4, 0x0d, 0x19, 0x82, 0xe0, 0xf0, 0xe8, 0x25, 0x64, 0x21, 0xb4, 0xc1, 0x36, 0x6f,
+	0x49, 0x62, 0xad, 0x1f, 0x42, 0xf0, 0xe8, 0xd1, 0x8f, 0xe3, 0x71, 0x47, 0x8f, 0xd2, 0x7e, 0x11,
+	0x49, 0xea, 0xba, 0xde, 0x5e, 0x78, 0x7e, 0xbf, 0xbc, 0xe1, 0x79, 0x11, 0x4e, 0x44, 0x2a, 0xc1,
+	0x10, 0x9e, 0x68, 0x50, 0x60, 0x48, 0x31, 0x25, 0x39, 0xd3, 0x2c, 0x33, 0x93, 0x5c, 0x83, 0x85,
+	0x68, 0xbf, 0xc9, 0x27, 0xff, 0xf9, 0xa4, 0x98, 0x0e, 0x0f, 0x62, 0x88, 0xc1, 0xa7, 0xc4, 0x4d,
+	0x0d, 0x38, 0x7a, 0xef, 0xa1, 0xc1, 0xca, 0x9b, 0xd1, 0x0c, 0x61, 0xae, 0x41, 0x51, 0xc6, 0xad,
+	0x2c, 0x04, 0x8d, 0x99, 0xa1, 0x1c, 0x8c, 0xa5, 0xb9, 0xd0, 0x74, 0x9d, 0x02, 0x7f, 0x3e, 0x0e,
+	0xcf, 0xc3, 0x71, 0xff, 0xf1, 0xc4, 0x51, 0x33, 0x0f, 0x2d, 0x99, 0x59, 0x80, 0xb1, 0x2b, 0xa1,
+	0xe7, 0x0e, 0x88, 0x6e, 0xd0, 0x50, 0x94, 0x82, 0xbf, 0x58, 0x09, 0xca, 0xd0, 0x54, 0x66, 0xb2,
+	0xab, 0xf7, 0xbc, 0x7e, 0xb4, 0x23, 0xee, 0x1d, 0xd0, 0xca, 0x97, 0xe8, 0x30, 0x63, 0x25, 0xf5,
+	0x7f, 0x70, 0xcb, 0x77, 0xe2, 0x9e, 0x17, 0xa3, 0x8c, 0x95, 0x0b, 0x0d, 0x6a, 0xc9, 0x4c, 0xeb,
+	0xdc, 0xa1, 0x91, 0x73, 0x3a, 0x4b, 0x9d, 0x05, 0xaf, 0x4a, 0xe8, 0x8e, 0xdf, 0xf7, 0xfe, 0x69,
+	0xc6, 0xca, 0xdb, 0x16, 0x5c, 0x09, 0xfd, 0xe0, 0xb0, 0xed, 0x53, 0xd7, 0xfd, 0xcf, 0xaf, 0xb3,
+	0x60, 0x3e, 0xff, 0xae, 0x70, 0xb8, 0xa9, 0x70, 0xf8, 0x5b, 0xe1, 0xf0, 0xa3, 0xc6, 0xc1, 0xa6,
+	0xc6, 0xc1, 0x4f, 0x8d, 0x83, 0xa7, 0x71, 0x53, 0xe9, 0x05, 0x07, 0x2d, 0xc8, 0x76, 0x4e, 0x98,
+	0x54, 0xa4, 0x6c, 0xcf, 0x60, 0xdf, 0x72, 0x61, 0xd6, 0x03, 0x5f, 0xed, 0xd5, 0xdf, 0x00, 0xf1,
+	0xd6, 0x09, 0x39, 0xa5, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +144,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxExecutionsPerOwnerPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExecutionsPerOwnerPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxCronGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCronGasPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ExecutionsLimitPerBlock != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.ExecutionsLimitPerBlock))
 		i--
@@ -159,6 +190,12 @@ func (m *Params) Size() (n int) {
 	if m.ExecutionsLimitPerBlock != 0 {
 		n += 1 + sovParams(uint64(m.ExecutionsLimitPerBlock))
 	}
+	if m.MaxCronGasPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxCronGasPerBlock))
+	}
+	if m.MaxExecutionsPerOwnerPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExecutionsPerOwnerPerBlock))
+	}
 	return n
 }
 
@@ -235,6 +272,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCronGasPerBlock", wireType)
+			}
+			m.MaxCronGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCronGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionsPerOwnerPerBlock", wireType)
+			}
+			m.MaxExecutionsPerOwnerPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionsPerOwnerPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,280 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxScheduleSearchYears bounds the search of the next time matching a cron expression
+// (e.g. "0 0 30 2 *" never matches).
+const MaxScheduleSearchYears = 5
+
+// IsTimeBased returns true when the schedule is evaluated against the block time
+// instead of the block height.
+func (s *Schedule) IsTimeBased() bool {
+	return s != nil && s.Type != ScheduleType_SCHEDULE_TYPE_BLOCKS
+}
+
+// Validate checks the schedule is well formed
+func (s Schedule) Validate() error {
+	switch s.Type {
+	case ScheduleType_SCHEDULE_TYPE_BLOCKS:
+		return nil
+	case ScheduleType_SCHEDULE_TYPE_CRON_EXPRESSION:
+		if _, err := ParseCronExpression(s.CronExpression); err != nil {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	case ScheduleType_SCHEDULE_TYPE_INTERVAL:
+		if s.IntervalSeconds == 0 {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "interval_seconds must be greater than zero")
+		}
+	default:
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown schedule type %d", s.Type)
+	}
+
+	if _, ok := CatchUpMode_name[int32(s.CatchUpMode)]; !ok {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown catch up mode %d", s.CatchUpMode)
+	}
+	return nil
+}
+
+// NextAfter returns the first execution time strictly after now.
+//
+// Interval schedules are anchored on the last scheduled time so that they don't drift
+// with the block times. Cron expressions are absolute and only depend on now.
+func (s Schedule) NextAfter(last, now time.Time) (time.Time, error) {
+	switch s.Type {
+	case ScheduleType_SCHEDULE_TYPE_CRON_EXPRESSION:
+		expr, err := ParseCronExpression(s.CronExpression)
+		if err != nil {
+			return time.Time{}, err
+		}
+		next, ok := expr.Next(now)
+		if !ok {
+			return time.Time{}, fmt.Errorf("cron expression %q never matches", s.CronExpression)
+		}
+		return next, nil
+	case ScheduleType_SCHEDULE_TYPE_INTERVAL:
+		interval := int64(s.IntervalSeconds)
+		if interval <= 0 {
+			return time.Time{}, fmt.Errorf("invalid interval %d", s.IntervalSeconds)
+		}
+		if last.After(now) {
+			return last, nil
+		}
+		elapsed := now.Unix() - last.Unix()
+		return time.Unix(last.Unix()+(elapsed/interval+1)*interval, 0).UTC(), nil
+	default:
+		return time.Time{}, fmt.Errorf("schedule type %s is not time based", s.Type)
+	}
+}
+
+// HasMissedExecutions returns true when at least one execution following the due
+// execution at last is also due at now, meaning executions have been missed.
+func (s Schedule) HasMissedExecutions(last, now time.Time) bool {
+	next, err := s.NextAfter(last, last)
+	if err != nil {
+		return false
+	}
+	return !next.After(now)
+}
+
+// CronExpression is a parsed standard 5-field cron expression
+// (minute, hour, day of month, month, day of week), evaluated in UTC.
+type CronExpression struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+type cronField struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minuteField = cronField{min: 0, max: 59}
+	hourField   = cronField{min: 0, max: 23}
+	domField    = cronField{min: 1, max: 31}
+	monthField  = cronField{min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// ParseCronExpression parses a standard 5-field cron expression. Fields accept "*",
+// values, ranges ("1-5"), lists ("1,15") and steps ("*/15", "0-30/10"); months and
+// days of week accept their three letters names. The @yearly, @monthly, @weekly,
+// @daily and @hourly macros are supported as well.
+func ParseCronExpression(spec string) (*CronExpression, error) {
+	spec = strings.TrimSpace(spec)
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields, got %d", spec, len(fields))
+	}
+
+	var (
+		expr CronExpression
+		err  error
+	)
+	if expr.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %w", err)
+	}
+	if expr.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %w", err)
+	}
+	if expr.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, fmt.Errorf("invalid day of month field: %w", err)
+	}
+	if expr.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, fmt.Errorf("invalid month field: %w", err)
+	}
+	if expr.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, fmt.Errorf("invalid day of week field: %w", err)
+	}
+	// 7 is an alias of sunday
+	if expr.dow&(1<<7) != 0 {
+		expr.dow |= 1
+	}
+	expr.domStar = strings.HasPrefix(fields[2], "*")
+	expr.dowStar = strings.HasPrefix(fields[4], "*")
+
+	return &expr, nil
+}
+
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		b, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func (f cronField) parsePart(part string) (uint64, error) {
+	rangeAndStep := strings.Split(part, "/")
+	if len(rangeAndStep) > 2 {
+		return 0, fmt.Errorf("invalid step in %q", part)
+	}
+
+	var start, end uint
+	step := uint(1)
+
+	if rangeAndStep[0] == "*" {
+		start, end = f.min, f.max
+	} else {
+		bounds := strings.Split(rangeAndStep[0], "-")
+		if len(bounds) > 2 {
+			return 0, fmt.Errorf("invalid range %q", rangeAndStep[0])
+		}
+		var err error
+		if start, err = f.value(bounds[0]); err != nil {
+			return 0, err
+		}
+		end = start
+		if len(bounds) == 2 {
+			if end, err = f.value(bounds[1]); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if len(rangeAndStep) == 2 {
+		s, err := strconv.ParseUint(rangeAndStep[1], 10, 8)
+		if err != nil || s == 0 {
+			return 0, fmt.Errorf("invalid step %q", rangeAndStep[1])
+		}
+		step = uint(s)
+		// "5/15" means starting at 5 until the max of the field
+		if rangeAndStep[0] != "*" && !strings.Contains(rangeAndStep[0], "-") {
+			end = f.max
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("invalid range %d-%d", start, end)
+	}
+
+	var bits uint64
+	for v := start; v <= end; v += step {
+		bits |= 1 << v
+	}
+	return bits, nil
+}
+
+func (f cronField) value(s string) (uint, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if uint(v) < f.min || uint(v) > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, f.min, f.max)
+	}
+	return uint(v), nil
+}
+
+// Next returns the first time strictly after t matching the expression. It returns
+// false if nothing matches within MaxScheduleSearchYears.
+func (e *CronExpression) Next(t time.Time) (time.Time, bool) {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(MaxScheduleSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if e.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !e.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if e.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if e.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// dayMatches follows the cron convention: when both the day of month and the day of
+// week are restricted, a day matching either of them matches.
+func (e *CronExpression) dayMatches(t time.Time) bool {
+	domMatch := e.dom&(1<<uint(t.Day())) != 0
+	dowMatch := e.dow&(1<<uint(t.Weekday())) != 0
+	if e.domStar || e.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/chronos/types"
+)
+
+func TestCronExpressionNext(t *testing.T) {
+	// 2024-01-01 is a monday
+	from := time.Date(2024, 1, 1, 10, 7, 30, 0, time.UTC)
+
+	testCases := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 1, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)},
+		{"30 8-12/2 * * *", time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)},
+		{"0 0 * * fri", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// day of month and day of week are or-ed when both are restricted
+		{"0 0 15 * sun", time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		expr, err := types.ParseCronExpression(tc.spec)
+		require.NoError(t, err, tc.spec)
+		next, ok := expr.Next(from)
+		require.True(t, ok, tc.spec)
+		require.Equal(t, tc.next, next, tc.spec)
+	}
+}
+
+func TestParseCronExpressionInvalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "*/0 * * * *", "5-1 * * * *", "* * * foo *"} {
+		_, err := types.ParseCronExpression(spec)
+		require.Error(t, err, spec)
+	}
+
+	expr, err := types.ParseCronExpression("0 0 30 2 *")
+	require.NoError(t, err)
+	_, ok := expr.Next(time.Unix(0, 0))
+	require.False(t, ok)
+}
+
+func TestScheduleIntervalNextAfter(t *testing.T) {
+	schedule := types.Schedule{Type: types.ScheduleType_SCHEDULE_TYPE_INTERVAL, IntervalSeconds: 60}
+	last := time.Unix(1_000, 0)
+
+	next, err := schedule.NextAfter(last, time.Unix(1_010, 0))
+	require.NoError(t, err)
+	require.Equal(t, int64(1_060), next.Unix())
+
+	// the interval stays anchored on the schedule, not on the block times
+	next, err = schedule.NextAfter(last, time.Unix(1_250, 0))
+	require.NoError(t, err)
+	require.Equal(t, int64(1_300), next.Unix())
+
+	require.False(t, schedule.HasMissedExecutions(last, time.Unix(1_059, 0)))
+	require.True(t, schedule.HasMissedExecutions(last, time.Unix(1_060, 0)))
+}
@@ -48,6 +48,10 @@ func (msg *MsgCreateCron) Validate() error {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "method_name cannot be empty")
 	}
 
+	if msg.Schedule.IsTimeBased() {
+		return msg.Schedule.Validate()
+	}
+
 	if msg.Frequency == 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "frequency must be greater than zero")
 	}
@@ -76,6 +80,38 @@ func (msg *MsgCreateCallBackConditionedCron) Validate() error {
 	return nil
 }
 
+func (msg *MsgCreateEventTriggeredCron) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return errors.Wrap(err, "owner_address is invalid")
+	}
+
+	if !strings.HasPrefix(msg.ContractAddress, "0x") || len(msg.ContractAddress) != 42 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "contract_address is invalid")
+	}
+
+	if msg.MethodName == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "method_name cannot be empty")
+	}
+
+	if msg.GasLimit == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gas_limit must be greater than zero")
+	}
+
+	if msg.MaxGasPrice == nil || !msg.MaxGasPrice.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "max_gas_price must be greater than zero")
+	}
+
+	if msg.AmountToDeposit == nil || !msg.AmountToDeposit.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "amount_to_deposit must be greater than zero")
+	}
+
+	if msg.LogFilter == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "log_filter cannot be empty")
+	}
+
+	return msg.LogFilter.Validate()
+}
+
 //----------------------------------------------------------------
 
 var _ sdk.Msg = &MsgUpdateCron{}
@@ -109,7 +145,13 @@ func (msg *MsgUpdateCron) Validate() error {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cron_id must be valid")
 	}
 
-	if msg.NewFrequency == 0 {
+	if msg.NewSchedule.IsTimeBased() {
+		return msg.NewSchedule.Validate()
+	}
+
+	// a nil schedule keeps the current one, the msg server only requires the frequency
+	// when the cron is block based
+	if msg.NewSchedule != nil && msg.NewFrequency == 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "new_frequency must be greater than zero")
 	}
 
@@ -151,3 +193,155 @@ func (msg *MsgCancelCron) Validate() error {
 
 	return nil
 }
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgDepositToCron{}
+
+func (msg *MsgDepositToCron) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDepositToCron) Type() string {
+	return "deposit-to-cron"
+}
+
+func (msg *MsgDepositToCron) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgDepositToCron) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgDepositToCron) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return errors.Wrap(err, "owner_address is invalid")
+	}
+
+	if msg.CronId == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cron_id must be valid")
+	}
+
+	if msg.Amount == nil || !msg.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than zero")
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgWithdrawFromCron{}
+
+func (msg *MsgWithdrawFromCron) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawFromCron) Type() string {
+	return "withdraw-from-cron"
+}
+
+func (msg *MsgWithdrawFromCron) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgWithdrawFromCron) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgWithdrawFromCron) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return errors.Wrap(err, "owner_address is invalid")
+	}
+
+	if msg.CronId == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cron_id must be valid")
+	}
+
+	if msg.Amount == nil || !msg.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "amount must be greater than zero")
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgPauseCron{}
+
+func (msg *MsgPauseCron) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPauseCron) Type() string {
+	return "pause-cron"
+}
+
+func (msg *MsgPauseCron) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgPauseCron) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgPauseCron) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return errors.Wrap(err, "owner_address is invalid")
+	}
+
+	if msg.CronId == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cron_id must be valid")
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgResumeCron{}
+
+func (msg *MsgResumeCron) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResumeCron) Type() string {
+	return "resume-cron"
+}
+
+func (msg *MsgResumeCron) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgResumeCron) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgResumeCron) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return errors.Wrap(err, "owner_address is invalid")
+	}
+
+	if msg.CronId == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "cron_id must be valid")
+	}
+
+	return nil
+}
@@ -45,6 +45,7 @@ type MsgCreateCron struct {
 	MaxGasPrice     *cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=max_gas_price,json=maxGasPrice,proto3,customtype=cosmossdk.io/math.Int" json:"max_gas_price,omitempty"`
 	Sender          string                 `protobuf:"bytes,10,opt,name=sender,proto3" json:"sender,omitempty"`
 	AmountToDeposit *cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=amount_to_deposit,json=amountToDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"amount_to_deposit,omitempty"`
+	Schedule        *Schedule              `protobuf:"bytes,12,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
 func (m *MsgCreateCron) Reset()         { *m = MsgCreateCron{} }
@@ -143,6 +144,13 @@ func (m *MsgCreateCron) GetSender() string {
 	return ""
 }
 
+func (m *MsgCreateCron) GetSchedule() *Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
 type MsgCreateCronResponse struct {
 	CronId      uint64 `protobuf:"varint,1,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`
 	CronAddress string `protobuf:"bytes,2,opt,name=cron_address,json=cronAddress,proto3" json:"cron_address,omitempty"`
@@ -335,6 +343,152 @@ func (m *MsgCreateCallBackConditionedCronResponse) GetCronAddress() string {
 	return ""
 }
 
+type MsgCreateEventTriggeredCron struct {
+	OwnerAddress    string                 `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	MethodName      string                 `protobuf:"bytes,3,opt,name=method_name,json=methodName,proto3" json:"method_name,omitempty"`
+	LogFilter       *LogFilter             `protobuf:"bytes,4,opt,name=log_filter,json=logFilter,proto3" json:"log_filter,omitempty"`
+	ExpirationBlock uint64                 `protobuf:"varint,5,opt,name=expiration_block,json=expirationBlock,proto3" json:"expiration_block,omitempty"`
+	GasLimit        uint64                 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	MaxGasPrice     *cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_gas_price,json=maxGasPrice,proto3,customtype=cosmossdk.io/math.Int" json:"max_gas_price,omitempty"`
+	Sender          string                 `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	AmountToDeposit *cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=amount_to_deposit,json=amountToDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"amount_to_deposit,omitempty"`
+}
+
+func (m *MsgCreateEventTriggeredCron) Reset()         { *m = MsgCreateEventTriggeredCron{} }
+func (m *MsgCreateEventTriggeredCron) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEventTriggeredCron) ProtoMessage()    {}
+func (*MsgCreateEventTriggeredCron) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{4}
+}
+func (m *MsgCreateEventTriggeredCron) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEventTriggeredCron) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEventTriggeredCron.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEventTriggeredCron) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEventTriggeredCron.Merge(m, src)
+}
+func (m *MsgCreateEventTriggeredCron) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEventTriggeredCron) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEventTriggeredCron.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEventTriggeredCron proto.InternalMessageInfo
+
+func (m *MsgCreateEventTriggeredCron) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateEventTriggeredCron) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateEventTriggeredCron) GetMethodName() string {
+	if m != nil {
+		return m.MethodName
+	}
+	return ""
+}
+
+func (m *MsgCreateEventTriggeredCron) GetLogFilter() *LogFilter {
+	if m != nil {
+		return m.LogFilter
+	}
+	return nil
+}
+
+func (m *MsgCreateEventTriggeredCron) GetExpirationBlock() uint64 {
+	if m != nil {
+		return m.ExpirationBlock
+	}
+	return 0
+}
+
+func (m *MsgCreateEventTriggeredCron) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MsgCreateEventTriggeredCron) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgCreateEventTriggeredCronResponse struct {
+	CronId      uint64 `protobuf:"varint,1,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`
+	CronAddress string `protobuf:"bytes,2,opt,name=cron_address,json=cronAddress,proto3" json:"cron_address,omitempty"`
+}
+
+func (m *MsgCreateEventTriggeredCronResponse) Reset()         { *m = MsgCreateEventTriggeredCronResponse{} }
+func (m *MsgCreateEventTriggeredCronResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEventTriggeredCronResponse) ProtoMessage()    {}
+func (*MsgCreateEventTriggeredCronResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{5}
+}
+func (m *MsgCreateEventTriggeredCronResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEventTriggeredCronResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEventTriggeredCronResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEventTriggeredCronResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEventTriggeredCronResponse.Merge(m, src)
+}
+func (m *MsgCreateEventTriggeredCronResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEventTriggeredCronResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEventTriggeredCronResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEventTriggeredCronResponse proto.InternalMessageInfo
+
+func (m *MsgCreateEventTriggeredCronResponse) GetCronId() uint64 {
+	if m != nil {
+		return m.CronId
+	}
+	return 0
+}
+
+func (m *MsgCreateEventTriggeredCronResponse) GetCronAddress() string {
+	if m != nil {
+		return m.CronAddress
+	}
+	return ""
+}
+
 type MsgUpdateCron struct {
 	CronId             uint64                 `protobuf:"varint,1,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`
 	OwnerAddress       string                 `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
@@ -344,13 +498,14 @@ type MsgUpdateCron struct {
 	NewGasLimit        uint64                 `protobuf:"varint,6,opt,name=new_gas_limit,json=newGasLimit,proto3" json:"new_gas_limit,omitempty"`
 	NewMaxGasPrice     *cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=new_max_gas_price,json=newMaxGasPrice,proto3,customtype=cosmossdk.io/math.Int" json:"new_max_gas_price,omitempty"`
 	Sender             string                 `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	NewSchedule        *Schedule              `protobuf:"bytes,9,opt,name=new_schedule,json=newSchedule,proto3" json:"new_schedule,omitempty"`
 }
 
 func (m *MsgUpdateCron) Reset()         { *m = MsgUpdateCron{} }
 func (m *MsgUpdateCron) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCron) ProtoMessage()    {}
 func (*MsgUpdateCron) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0791d34d8d50f9af, []int{4}
+	return fileDescriptor_0791d34d8d50f9af, []int{6}
 }
 func (m *MsgUpdateCron) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,6 +583,13 @@ func (m *MsgUpdateCron) GetSender() string {
 	return ""
 }
 
+func (m *MsgUpdateCron) GetNewSchedule() *Schedule {
+	if m != nil {
+		return m.NewSchedule
+	}
+	return nil
+}
+
 type MsgUpdateCronResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
@@ -436,7 +598,7 @@ func (m *MsgUpdateCronResponse) Reset()         { *m = MsgUpdateCronResponse{} }
 func (m *MsgUpdateCronResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCronResponse) ProtoMessage()    {}
 func (*MsgUpdateCronResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0791d34d8d50f9af, []int{5}
+	return fileDescriptor_0791d34d8d50f9af, []int{7}
 }
 func (m *MsgUpdateCronResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,7 +644,7 @@ func (m *MsgCancelCron) Reset()         { *m = MsgCancelCron{} }
 func (m *MsgCancelCron) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelCron) ProtoMessage()    {}
 func (*MsgCancelCron) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0791d34d8d50f9af, []int{6}
+	return fileDescriptor_0791d34d8d50f9af, []int{8}
 }
 func (m *MsgCancelCron) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +702,7 @@ func (m *MsgCancelCronResponse) Reset()         { *m = MsgCancelCronResponse{} }
 func (m *MsgCancelCronResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelCronResponse) ProtoMessage()    {}
 func (*MsgCancelCronResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0791d34d8d50f9af, []int{7}
+	return fileDescriptor_0791d34d8d50f9af, []int{9}
 }
 func (m *MsgCancelCronResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,945 +738,3152 @@ func (m *MsgCancelCronResponse) GetSuccess() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*MsgCreateCron)(nil), "helios.chronos.v1.MsgCreateCron")
-	proto.RegisterType((*MsgCreateCronResponse)(nil), "helios.chronos.v1.MsgCreateCronResponse")
-	proto.RegisterType((*MsgCreateCallBackConditionedCron)(nil), "helios.chronos.v1.MsgCreateCallBackConditionedCron")
-	proto.RegisterType((*MsgCreateCallBackConditionedCronResponse)(nil), "helios.chronos.v1.MsgCreateCallBackConditionedCronResponse")
-	proto.RegisterType((*MsgUpdateCron)(nil), "helios.chronos.v1.MsgUpdateCron")
-	proto.RegisterType((*MsgUpdateCronResponse)(nil), "helios.chronos.v1.MsgUpdateCronResponse")
-	proto.RegisterType((*MsgCancelCron)(nil), "helios.chronos.v1.MsgCancelCron")
-	proto.RegisterType((*MsgCancelCronResponse)(nil), "helios.chronos.v1.MsgCancelCronResponse")
+type MsgDepositToCron struct {
+	OwnerAddress string                 `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	CronId       uint64                 `protobuf:"varint,2,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`
+	Amount       *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount,omitempty"`
+	Sender       string                 `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func init() { proto.RegisterFile("helios/chronos/v1/tx.proto", fileDescriptor_0791d34d8d50f9af) }
-
-var fileDescriptor_0791d34d8d50f9af = []byte{
-	// 825 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0xc7, 0x71, 0xc8, 0x9f, 0x09, 0x29, 0xc5, 0x82, 0x62, 0xd2, 0x62, 0xd2, 0x70, 0x68, 0x8a,
-	0x44, 0xdc, 0x94, 0x5b, 0x7b, 0x6a, 0x68, 0x8b, 0x68, 0x4b, 0x85, 0x4c, 0x2b, 0xa1, 0x5e, 0xac,
-	0x89, 0x3d, 0x38, 0x2e, 0xf1, 0x8c, 0x3b, 0x33, 0xc1, 0xe1, 0x56, 0xf5, 0x13, 0xb4, 0x5f, 0xa1,
-	0x1f, 0xa0, 0xe2, 0xd0, 0x0f, 0xb1, 0x47, 0xb4, 0xa7, 0xd5, 0x1e, 0x56, 0x08, 0x0e, 0x7c, 0x8d,
-	0xd5, 0x8c, 0x9d, 0x38, 0xd9, 0x24, 0x64, 0x59, 0xed, 0xde, 0xf6, 0x96, 0xf7, 0xde, 0x6f, 0xde,
-	0xbc, 0x79, 0xbf, 0xdf, 0x7b, 0x31, 0xa8, 0x74, 0x50, 0xd7, 0x27, 0xcc, 0x74, 0x3a, 0x94, 0x60,
-	0xc2, 0xcc, 0x8b, 0xa6, 0xc9, 0xfb, 0x8d, 0x90, 0x12, 0x4e, 0xb4, 0x95, 0x38, 0xd6, 0x48, 0x62,
-	0x8d, 0x8b, 0x66, 0xc5, 0x70, 0x08, 0x0b, 0x08, 0x33, 0xdb, 0x90, 0x21, 0xf3, 0xa2, 0xd9, 0x46,
-	0x1c, 0x36, 0x4d, 0x87, 0xf8, 0x38, 0x3e, 0x52, 0x59, 0x4f, 0xe2, 0x01, 0xf3, 0x44, 0xaa, 0x80,
-	0x79, 0x49, 0x60, 0x23, 0x0e, 0xd8, 0xd2, 0x32, 0x63, 0x23, 0x09, 0xad, 0x7a, 0xc4, 0x23, 0xb1,
-	0x5f, 0xfc, 0x4a, 0xbc, 0x86, 0x47, 0x88, 0xd7, 0x45, 0xa6, 0xb4, 0xda, 0xbd, 0x33, 0x33, 0xa2,
-	0x30, 0x0c, 0x11, 0x4d, 0x4e, 0xd5, 0x6e, 0x54, 0x50, 0x3e, 0x62, 0xde, 0x3e, 0x45, 0x90, 0xa3,
-	0x7d, 0x4a, 0xb0, 0xb6, 0x0d, 0xca, 0x24, 0xc2, 0x88, 0xda, 0xd0, 0x75, 0x29, 0x62, 0x4c, 0x57,
-	0xaa, 0x4a, 0xbd, 0x68, 0x2d, 0x49, 0xe7, 0x37, 0xb1, 0x4f, 0xfb, 0x1c, 0x7c, 0xe8, 0x10, 0xcc,
-	0x29, 0x74, 0xf8, 0x10, 0x97, 0x91, 0xb8, 0xe5, 0x81, 0x7f, 0x00, 0xdd, 0x00, 0x05, 0xd8, 0xf6,
-	0xed, 0xdf, 0x19, 0xc1, 0xba, 0x2a, 0x21, 0x79, 0xd8, 0xf6, 0x7f, 0x60, 0x04, 0x6b, 0x5b, 0xa0,
-	0x14, 0x20, 0xde, 0x21, 0xae, 0x8d, 0x61, 0x80, 0xf4, 0xac, 0x8c, 0x82, 0xd8, 0xf5, 0x33, 0x0c,
-	0x90, 0xf6, 0x11, 0xc8, 0x85, 0x90, 0xc2, 0x80, 0xe9, 0x8b, 0x55, 0xb5, 0x5e, 0xb4, 0x12, 0x4b,
-	0xfb, 0x04, 0x14, 0xcf, 0x28, 0xfa, 0xa3, 0x87, 0xb0, 0x73, 0xa9, 0xe7, 0xaa, 0x4a, 0x3d, 0x6b,
-	0xa5, 0x0e, 0x51, 0x1c, 0xea, 0x87, 0x3e, 0x85, 0xdc, 0x27, 0xd8, 0x6e, 0x77, 0x89, 0x73, 0xae,
-	0xe7, 0x25, 0x68, 0x39, 0xf5, 0xb7, 0x84, 0x5b, 0xfb, 0x18, 0x14, 0x3d, 0xc8, 0xec, 0xae, 0x1f,
-	0xf8, 0x5c, 0x2f, 0x48, 0x4c, 0xc1, 0x83, 0xec, 0x27, 0x61, 0x6b, 0x3f, 0x82, 0x72, 0x00, 0xfb,
-	0xb6, 0x00, 0x84, 0xd4, 0x77, 0x90, 0x5e, 0x14, 0x05, 0xb6, 0x3e, 0x7b, 0xfe, 0x62, 0x6b, 0x2d,
-	0x6e, 0x3d, 0x73, 0xcf, 0x1b, 0x3e, 0x31, 0x03, 0xc8, 0x3b, 0x8d, 0x43, 0xcc, 0x9f, 0xfe, 0xbf,
-	0x0b, 0x12, 0x4e, 0x0e, 0x31, 0xb7, 0x4a, 0x01, 0xec, 0x1f, 0x40, 0x76, 0x2c, 0xce, 0x8a, 0xa7,
-	0x30, 0x84, 0x5d, 0x44, 0x75, 0x20, 0x9f, 0x99, 0x58, 0xda, 0x09, 0x58, 0x81, 0x01, 0xe9, 0x61,
-	0x6e, 0x73, 0x62, 0xbb, 0x28, 0x24, 0xcc, 0xe7, 0x7a, 0xe9, 0x71, 0x17, 0x2d, 0xc7, 0x19, 0x7e,
-	0x21, 0xdf, 0xc6, 0xe7, 0xbf, 0x2a, 0xfd, 0x75, 0x7f, 0xb5, 0x93, 0xdc, 0x50, 0x3b, 0x01, 0x6b,
-	0x63, 0x0c, 0x5b, 0x88, 0x85, 0x04, 0x33, 0xa4, 0xad, 0x83, 0xbc, 0x43, 0x09, 0xb6, 0x7d, 0x57,
-	0x72, 0x9c, 0xb5, 0x72, 0xc2, 0x3c, 0x74, 0xb5, 0x4f, 0xc1, 0x92, 0x0c, 0x8c, 0x33, 0x5b, 0x12,
-	0xbe, 0x84, 0xd5, 0xda, 0xbf, 0x2a, 0xa8, 0xa6, 0x59, 0x61, 0xb7, 0xdb, 0x82, 0xce, 0xf9, 0x3e,
-	0xc1, 0xae, 0x2f, 0x9a, 0x8b, 0xdc, 0x77, 0x22, 0xa5, 0xb9, 0x7a, 0x79, 0xcf, 0xfc, 0x18, 0xf3,
-	0x67, 0xa0, 0x3e, 0x8f, 0xa3, 0xb7, 0x22, 0x86, 0x9b, 0x8c, 0x5c, 0x22, 0xbf, 0x86, 0xee, 0x60,
-	0x89, 0xcc, 0xcc, 0x36, 0x21, 0x89, 0xcc, 0x14, 0x49, 0x6c, 0x83, 0x32, 0x46, 0x91, 0x9d, 0x8e,
-	0xb8, 0x2a, 0x73, 0x2c, 0x61, 0x14, 0x7d, 0x3f, 0x9c, 0xf2, 0x4d, 0x00, 0x04, 0x28, 0xd9, 0x0f,
-	0x59, 0xb9, 0x1f, 0x8a, 0x18, 0x45, 0xc7, 0xf1, 0x8a, 0xf8, 0x02, 0xac, 0x8a, 0xf0, 0x84, 0x1c,
-	0x16, 0x65, 0x2a, 0x0d, 0xa3, 0xe8, 0xbb, 0x57, 0x14, 0x51, 0x8b, 0x6f, 0x4d, 0x55, 0x11, 0x2f,
-	0x96, 0x12, 0x46, 0xd1, 0xc1, 0x40, 0x18, 0x16, 0x58, 0x11, 0x98, 0x71, 0x71, 0xe4, 0x1f, 0xc7,
-	0xd9, 0x07, 0x18, 0x45, 0x47, 0x53, 0xf5, 0x51, 0x18, 0xd5, 0xc7, 0x38, 0x95, 0x4d, 0x39, 0xc4,
-	0x69, 0x87, 0x87, 0xbc, 0xe9, 0x20, 0xcf, 0x7a, 0x8e, 0x33, 0x98, 0xae, 0x82, 0x35, 0x30, 0x6b,
-	0x34, 0xde, 0xec, 0x10, 0x3b, 0xa8, 0xfb, 0xfa, 0xe3, 0x38, 0xc2, 0x5c, 0x66, 0x8c, 0xb9, 0xb4,
-	0x4c, 0x75, 0x5e, 0x99, 0xe9, 0x9d, 0xf3, 0xcb, 0xfc, 0xf2, 0x3f, 0x15, 0xa8, 0x47, 0xcc, 0xd3,
-	0x4e, 0x01, 0x18, 0xf9, 0x17, 0xaa, 0x36, 0x26, 0xfe, 0x35, 0x1b, 0x63, 0x5b, 0xac, 0x52, 0x9f,
-	0x87, 0x18, 0xde, 0xfd, 0x8f, 0x02, 0x36, 0x1f, 0x5e, 0x54, 0x7b, 0x0f, 0xe6, 0x9a, 0x7e, 0xa8,
-	0xf2, 0xf5, 0x1b, 0x1c, 0x1a, 0xd6, 0x74, 0x0a, 0xc0, 0xc8, 0xb8, 0xcc, 0x78, 0x6d, 0x8a, 0x98,
-	0xf5, 0xda, 0x29, 0x82, 0x10, 0x7d, 0x4c, 0x39, 0x9f, 0xd5, 0xc7, 0x21, 0x62, 0x66, 0x1f, 0x27,
-	0x38, 0xac, 0x2c, 0xfe, 0x79, 0x7f, 0xb5, 0xa3, 0xb4, 0x5a, 0x4f, 0x6e, 0x0d, 0xe5, 0xfa, 0xd6,
-	0x50, 0x6e, 0x6e, 0x0d, 0xe5, 0xef, 0x3b, 0x63, 0xe1, 0xfa, 0xce, 0x58, 0x78, 0x76, 0x67, 0x2c,
-	0xfc, 0x56, 0x8f, 0x33, 0xed, 0x3a, 0x84, 0x22, 0x73, 0xf0, 0xbb, 0x03, 0x7d, 0x6c, 0xf6, 0x87,
-	0x5f, 0x46, 0xfc, 0x32, 0x44, 0xac, 0x9d, 0x93, 0x5f, 0x1f, 0x7b, 0x2f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x74, 0xa2, 0x03, 0xdf, 0x38, 0x09, 0x00, 0x00,
+func (m *MsgDepositToCron) Reset()         { *m = MsgDepositToCron{} }
+func (m *MsgDepositToCron) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToCron) ProtoMessage()    {}
+func (*MsgDepositToCron) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateCron(ctx context.Context, in *MsgCreateCron, opts ...grpc.CallOption) (*MsgCreateCronResponse, error)
-	CreateCallBackConditionedCron(ctx context.Context, in *MsgCreateCallBackConditionedCron, opts ...grpc.CallOption) (*MsgCreateCallBackConditionedCronResponse, error)
-	UpdateCron(ctx context.Context, in *MsgUpdateCron, opts ...grpc.CallOption) (*MsgUpdateCronResponse, error)
-	CancelCron(ctx context.Context, in *MsgCancelCron, opts ...grpc.CallOption) (*MsgCancelCronResponse, error)
+func (m *MsgDepositToCron) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgDepositToCron) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToCron.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgDepositToCron) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToCron.Merge(m, src)
 }
-
-func (c *msgClient) CreateCron(ctx context.Context, in *MsgCreateCron, opts ...grpc.CallOption) (*MsgCreateCronResponse, error) {
-	out := new(MsgCreateCronResponse)
-	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/CreateCron", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDepositToCron) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToCron) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToCron.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateCallBackConditionedCron(ctx context.Context, in *MsgCreateCallBackConditionedCron, opts ...grpc.CallOption) (*MsgCreateCallBackConditionedCronResponse, error) {
-	out := new(MsgCreateCallBackConditionedCronResponse)
-	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/CreateCallBackConditionedCron", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositToCron proto.InternalMessageInfo
+
+func (m *MsgDepositToCron) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateCron(ctx context.Context, in *MsgUpdateCron, opts ...grpc.CallOption) (*MsgUpdateCronResponse, error) {
-	out := new(MsgUpdateCronResponse)
-	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/UpdateCron", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositToCron) GetCronId() uint64 {
+	if m != nil {
+		return m.CronId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CancelCron(ctx context.Context, in *MsgCancelCron, opts ...grpc.CallOption) (*MsgCancelCronResponse, error) {
-	out := new(MsgCancelCronResponse)
-	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/CancelCron", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDepositToCron) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateCron(context.Context, *MsgCreateCron) (*MsgCreateCronResponse, error)
-	CreateCallBackConditionedCron(context.Context, *MsgCreateCallBackConditionedCron) (*MsgCreateCallBackConditionedCronResponse, error)
-	UpdateCron(context.Context, *MsgUpdateCron) (*MsgUpdateCronResponse, error)
-	CancelCron(context.Context, *MsgCancelCron) (*MsgCancelCronResponse, error)
+type MsgDepositToCronResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgDepositToCronResponse) Reset()         { *m = MsgDepositToCronResponse{} }
+func (m *MsgDepositToCronResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToCronResponse) ProtoMessage()    {}
+func (*MsgDepositToCronResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{11}
 }
-
-func (*UnimplementedMsgServer) CreateCron(ctx context.Context, req *MsgCreateCron) (*MsgCreateCronResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCron not implemented")
+func (m *MsgDepositToCronResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateCallBackConditionedCron(ctx context.Context, req *MsgCreateCallBackConditionedCron) (*MsgCreateCallBackConditionedCronResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCallBackConditionedCron not implemented")
+func (m *MsgDepositToCronResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToCronResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateCron(ctx context.Context, req *MsgUpdateCron) (*MsgUpdateCronResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCron not implemented")
+func (m *MsgDepositToCronResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToCronResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelCron(ctx context.Context, req *MsgCancelCron) (*MsgCancelCronResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelCron not implemented")
+func (m *MsgDepositToCronResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgDepositToCronResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToCronResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateCron)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateCron(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.chronos.v1.Msg/CreateCron",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateCron(ctx, req.(*MsgCreateCron))
+var xxx_messageInfo_MsgDepositToCronResponse proto.InternalMessageInfo
+
+func (m *MsgDepositToCronResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_CreateCallBackConditionedCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateCallBackConditionedCron)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateCallBackConditionedCron(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.chronos.v1.Msg/CreateCallBackConditionedCron",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateCallBackConditionedCron(ctx, req.(*MsgCreateCallBackConditionedCron))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgWithdrawFromCron struct {
+	OwnerAddress string                 `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	CronId       uint64                 `protobuf:"varint,2,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`
+	Amount       *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount,omitempty"`
+	Sender       string                 `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func _Msg_UpdateCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCron)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCron(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.chronos.v1.Msg/UpdateCron",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCron(ctx, req.(*MsgUpdateCron))
+func (m *MsgWithdrawFromCron) Reset()         { *m = MsgWithdrawFromCron{} }
+func (m *MsgWithdrawFromCron) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromCron) ProtoMessage()    {}
+func (*MsgWithdrawFromCron) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{12}
+}
+func (m *MsgWithdrawFromCron) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromCron) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromCron.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWithdrawFromCron) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromCron.Merge(m, src)
+}
+func (m *MsgWithdrawFromCron) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromCron) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromCron.DiscardUnknown(m)
 }
 
-func _Msg_CancelCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelCron)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelCron(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.chronos.v1.Msg/CancelCron",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelCron(ctx, req.(*MsgCancelCron))
+var xxx_messageInfo_MsgWithdrawFromCron proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromCron) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "helios.chronos.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateCron",
-			Handler:    _Msg_CreateCron_Handler,
-		},
-		{
-			MethodName: "CreateCallBackConditionedCron",
-			Handler:    _Msg_CreateCallBackConditionedCron_Handler,
-		},
-		{
-			MethodName: "UpdateCron",
-			Handler:    _Msg_UpdateCron_Handler,
-		},
-		{
-			MethodName: "CancelCron",
-			Handler:    _Msg_CancelCron_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "helios/chronos/v1/tx.proto",
+func (m *MsgWithdrawFromCron) GetCronId() uint64 {
+	if m != nil {
+		return m.CronId
+	}
+	return 0
 }
 
-func (m *MsgCreateCron) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawFromCron) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateCron) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawFromCronResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (m *MsgCreateCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AmountToDeposit != nil {
-		{
-			size := m.AmountToDeposit.Size()
-			i -= size
-			if _, err := m.AmountToDeposit.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.MaxGasPrice != nil {
-		{
-			size := m.MaxGasPrice.Size()
-			i -= size
-			if _, err := m.MaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgWithdrawFromCronResponse) Reset()         { *m = MsgWithdrawFromCronResponse{} }
+func (m *MsgWithdrawFromCronResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromCronResponse) ProtoMessage()    {}
+func (*MsgWithdrawFromCronResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{13}
+}
+func (m *MsgWithdrawFromCronResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromCronResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromCronResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.ExpirationBlock != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationBlock))
-		i--
-		dAtA[i] = 0x38
+		return b[:n], nil
 	}
-	if m.Frequency != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Frequency))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *MsgWithdrawFromCronResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromCronResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFromCronResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromCronResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromCronResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFromCronResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromCronResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if len(m.Params) > 0 {
-		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Params[iNdEx])
-			copy(dAtA[i:], m.Params[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Params[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+	return false
+}
+
+type MsgPauseCron struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	CronId       uint64 `protobuf:"varint,2,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`
+	Sender       string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgPauseCron) Reset()         { *m = MsgPauseCron{} }
+func (m *MsgPauseCron) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseCron) ProtoMessage()    {}
+func (*MsgPauseCron) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{14}
+}
+func (m *MsgPauseCron) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseCron) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseCron.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MethodName) > 0 {
-		i -= len(m.MethodName)
-		copy(dAtA[i:], m.MethodName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MethodName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AbiJson) > 0 {
-		i -= len(m.AbiJson)
-		copy(dAtA[i:], m.AbiJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AbiJson)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgPauseCron) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseCron.Merge(m, src)
+}
+func (m *MsgPauseCron) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseCron) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseCron.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseCron proto.InternalMessageInfo
+
+func (m *MsgPauseCron) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgPauseCron) GetCronId() uint64 {
+	if m != nil {
+		return m.CronId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateCronResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPauseCron) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateCronResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgPauseCronResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (m *MsgCreateCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CronAddress) > 0 {
-		i -= len(m.CronAddress)
-		copy(dAtA[i:], m.CronAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CronAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CronId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgPauseCronResponse) Reset()         { *m = MsgPauseCronResponse{} }
+func (m *MsgPauseCronResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseCronResponse) ProtoMessage()    {}
+func (*MsgPauseCronResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{15}
+}
+func (m *MsgPauseCronResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseCronResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseCronResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgPauseCronResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseCronResponse.Merge(m, src)
+}
+func (m *MsgPauseCronResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseCronResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseCronResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateCallBackConditionedCron) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPauseCronResponse proto.InternalMessageInfo
+
+func (m *MsgPauseCronResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgCreateCallBackConditionedCron) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgResumeCron struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	CronId       uint64 `protobuf:"varint,2,opt,name=cron_id,json=cronId,proto3" json:"cron_id,omitempty"`
+	Sender       string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgCreateCallBackConditionedCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AmountToDeposit != nil {
-		{
-			size := m.AmountToDeposit.Size()
-			i -= size
-			if _, err := m.AmountToDeposit.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.MaxGasPrice != nil {
-		{
-			size := m.MaxGasPrice.Size()
-			i -= size
-			if _, err := m.MaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgResumeCron) Reset()         { *m = MsgResumeCron{} }
+func (m *MsgResumeCron) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeCron) ProtoMessage()    {}
+func (*MsgResumeCron) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{16}
+}
+func (m *MsgResumeCron) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeCron) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeCron.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x4a
+		return b[:n], nil
 	}
-	if m.GasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x40
+}
+func (m *MsgResumeCron) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeCron.Merge(m, src)
+}
+func (m *MsgResumeCron) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeCron) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeCron.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeCron proto.InternalMessageInfo
+
+func (m *MsgResumeCron) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	if m.ExpirationBlock != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationBlock))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *MsgResumeCron) GetCronId() uint64 {
+	if m != nil {
+		return m.CronId
 	}
-	if len(m.MethodName) > 0 {
-		i -= len(m.MethodName)
-		copy(dAtA[i:], m.MethodName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MethodName)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *MsgResumeCron) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+type MsgResumeCronResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *MsgResumeCronResponse) Reset()         { *m = MsgResumeCronResponse{} }
+func (m *MsgResumeCronResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeCronResponse) ProtoMessage()    {}
+func (*MsgResumeCronResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0791d34d8d50f9af, []int{17}
+}
+func (m *MsgResumeCronResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeCronResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeCronResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgResumeCronResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeCronResponse.Merge(m, src)
+}
+func (m *MsgResumeCronResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeCronResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeCronResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeCronResponse proto.InternalMessageInfo
+
+func (m *MsgResumeCronResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *MsgCreateCallBackConditionedCronResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgCreateCron)(nil), "helios.chronos.v1.MsgCreateCron")
+	proto.RegisterType((*MsgCreateCronResponse)(nil), "helios.chronos.v1.MsgCreateCronResponse")
+	proto.RegisterType((*MsgCreateCallBackConditionedCron)(nil), "helios.chronos.v1.MsgCreateCallBackConditionedCron")
+	proto.RegisterType((*MsgCreateCallBackConditionedCronResponse)(nil), "helios.chronos.v1.MsgCreateCallBackConditionedCronResponse")
+	proto.RegisterType((*MsgCreateEventTriggeredCron)(nil), "helios.chronos.v1.MsgCreateEventTriggeredCron")
+	proto.RegisterType((*MsgCreateEventTriggeredCronResponse)(nil), "helios.chronos.v1.MsgCreateEventTriggeredCronResponse")
+	proto.RegisterType((*MsgUpdateCron)(nil), "helios.chronos.v1.MsgUpdateCron")
+	proto.RegisterType((*MsgUpdateCronResponse)(nil), "helios.chronos.v1.MsgUpdateCronResponse")
+	proto.RegisterType((*MsgCancelCron)(nil), "helios.chronos.v1.MsgCancelCron")
+	proto.RegisterType((*MsgCancelCronResponse)(nil), "helios.chronos.v1.MsgCancelCronResponse")
+	proto.RegisterType((*MsgDepositToCron)(nil), "helios.chronos.v1.MsgDepositToCron")
+	proto.RegisterType((*MsgDepositToCronResponse)(nil), "helios.chronos.v1.MsgDepositToCronResponse")
+	proto.RegisterType((*MsgWithdrawFromCron)(nil), "helios.chronos.v1.MsgWithdrawFromCron")
+	proto.RegisterType((*MsgWithdrawFromCronResponse)(nil), "helios.chronos.v1.MsgWithdrawFromCronResponse")
+	proto.RegisterType((*MsgPauseCron)(nil), "helios.chronos.v1.MsgPauseCron")
+	proto.RegisterType((*MsgPauseCronResponse)(nil), "helios.chronos.v1.MsgPauseCronResponse")
+	proto.RegisterType((*MsgResumeCron)(nil), "helios.chronos.v1.MsgResumeCron")
+	proto.RegisterType((*MsgResumeCronResponse)(nil), "helios.chronos.v1.MsgResumeCronResponse")
+}
+
+func init() { proto.RegisterFile("helios/chronos/v1/tx.proto", fileDescriptor_0791d34d8d50f9af) }
+
+var fileDescriptor_0791d34d8d50f9af = []byte{
+	// 1116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0x9f, 0x9b, 0x34, 0x7f, 0x4e, 0x5a, 0xda, 0x9a, 0x96, 0x79, 0x6e, 0x97, 0x86, 0x56, 0x62,
+	0x61, 0x68, 0xf1, 0xda, 0x21, 0x26, 0x31, 0x09, 0x44, 0xcb, 0x3a, 0x15, 0x16, 0x54, 0xb9, 0x9d,
+	0x98, 0x78, 0xb1, 0x6e, 0xec, 0x5b, 0xc7, 0xab, 0x7d, 0x6f, 0x76, 0xaf, 0xd3, 0x74, 0x6f, 0x68,
+	0x9f, 0x00, 0x1e, 0x79, 0x43, 0x7c, 0x82, 0x49, 0x20, 0x24, 0xbe, 0x01, 0x8f, 0x13, 0x4f, 0x68,
+	0x0f, 0x08, 0xb5, 0x0f, 0xfb, 0x1a, 0xc8, 0x7f, 0xe3, 0x34, 0x4e, 0x9d, 0x42, 0xa9, 0x84, 0x78,
+	0xf3, 0x39, 0xe7, 0x77, 0xfe, 0xf9, 0xfc, 0xee, 0x3d, 0x4e, 0x40, 0x6e, 0x63, 0xdb, 0xa2, 0x5c,
+	0xd1, 0xdb, 0x8c, 0x12, 0xca, 0x95, 0xc3, 0x35, 0xc5, 0x3d, 0x6a, 0x74, 0x18, 0x75, 0xa9, 0x38,
+	0x17, 0xd8, 0x1a, 0xa1, 0xad, 0x71, 0xb8, 0x26, 0x57, 0x75, 0xca, 0x1d, 0xca, 0x95, 0x16, 0xe2,
+	0x58, 0x39, 0x5c, 0x6b, 0x61, 0x17, 0xad, 0x29, 0x3a, 0xb5, 0x48, 0xe0, 0x22, 0x5f, 0x0d, 0xed,
+	0x0e, 0x37, 0xbd, 0x50, 0x0e, 0x37, 0x43, 0xc3, 0xb5, 0xc0, 0xa0, 0xf9, 0x92, 0x12, 0x08, 0xa1,
+	0x69, 0xde, 0xa4, 0x26, 0x0d, 0xf4, 0xde, 0x53, 0xa8, 0xad, 0x9a, 0x94, 0x9a, 0x36, 0x56, 0x7c,
+	0xa9, 0xd5, 0xdd, 0x57, 0x7a, 0x0c, 0x75, 0x3a, 0x98, 0x45, 0x5e, 0x4b, 0xc3, 0x85, 0xeb, 0x8c,
+	0x86, 0x75, 0xac, 0x7c, 0x97, 0x87, 0xe9, 0x26, 0x37, 0x37, 0x19, 0x46, 0x2e, 0xde, 0x64, 0x94,
+	0x88, 0xab, 0x30, 0x4d, 0x7b, 0x04, 0x33, 0x0d, 0x19, 0x06, 0xc3, 0x9c, 0x4b, 0x42, 0x4d, 0xa8,
+	0x97, 0xd5, 0x29, 0x5f, 0xf9, 0x49, 0xa0, 0x13, 0xdf, 0x85, 0x59, 0x9d, 0x12, 0x97, 0x21, 0xdd,
+	0x8d, 0x71, 0x13, 0x3e, 0x6e, 0x26, 0xd2, 0x47, 0xd0, 0x6b, 0x50, 0x42, 0x2d, 0x4b, 0x7b, 0xc2,
+	0x29, 0x91, 0x72, 0x3e, 0xa4, 0x88, 0x5a, 0xd6, 0x67, 0x9c, 0x12, 0x71, 0x19, 0x2a, 0x0e, 0x76,
+	0xdb, 0xd4, 0xd0, 0x08, 0x72, 0xb0, 0x94, 0xf7, 0xad, 0x10, 0xa8, 0xbe, 0x40, 0x0e, 0x16, 0xdf,
+	0x82, 0x42, 0x07, 0x31, 0xe4, 0x70, 0x69, 0xb2, 0x96, 0xab, 0x97, 0xd5, 0x50, 0x12, 0x97, 0xa0,
+	0xbc, 0xcf, 0xf0, 0xd3, 0x2e, 0x26, 0xfa, 0x33, 0xa9, 0x50, 0x13, 0xea, 0x79, 0xb5, 0xaf, 0xf0,
+	0x8a, 0xc3, 0x47, 0x1d, 0x8b, 0x21, 0xd7, 0xa2, 0x44, 0x6b, 0xd9, 0x54, 0x3f, 0x90, 0x8a, 0x3e,
+	0x68, 0xa6, 0xaf, 0xdf, 0xf0, 0xd4, 0xe2, 0x22, 0x94, 0x4d, 0xc4, 0x35, 0xdb, 0x72, 0x2c, 0x57,
+	0x2a, 0xf9, 0x98, 0x92, 0x89, 0xf8, 0x43, 0x4f, 0x16, 0x3f, 0x87, 0x69, 0x07, 0x1d, 0x69, 0x1e,
+	0xa0, 0xc3, 0x2c, 0x1d, 0x4b, 0x65, 0xaf, 0xc0, 0x8d, 0x1b, 0xaf, 0xfe, 0x58, 0x5e, 0x08, 0x06,
+	0xc3, 0x8d, 0x83, 0x86, 0x45, 0x15, 0x07, 0xb9, 0xed, 0xc6, 0x36, 0x71, 0x7f, 0xfb, 0xe9, 0x16,
+	0x84, 0x13, 0xdb, 0x26, 0xae, 0x5a, 0x71, 0xd0, 0xd1, 0x03, 0xc4, 0x77, 0x3c, 0x5f, 0xaf, 0x15,
+	0x8e, 0x89, 0x81, 0x99, 0x04, 0x7e, 0x9b, 0xa1, 0x24, 0xee, 0xc2, 0x1c, 0x72, 0x68, 0x97, 0xb8,
+	0x9a, 0x4b, 0x35, 0x03, 0x77, 0x28, 0xb7, 0x5c, 0xa9, 0x72, 0xbe, 0x44, 0x33, 0x41, 0x84, 0x3d,
+	0xfa, 0x69, 0xe0, 0x2f, 0xde, 0x85, 0x12, 0xd7, 0xdb, 0xd8, 0xe8, 0xda, 0x58, 0x9a, 0xaa, 0x09,
+	0xf5, 0xca, 0xfa, 0x62, 0x63, 0x88, 0xa3, 0x8d, 0xdd, 0x10, 0xa2, 0xc6, 0xe0, 0x0f, 0x2b, 0xcf,
+	0x5f, 0xbf, 0xb8, 0x19, 0x96, 0xb6, 0xb2, 0x0b, 0x0b, 0x03, 0xd4, 0x50, 0x31, 0xef, 0x50, 0xc2,
+	0xb1, 0x78, 0x15, 0x8a, 0x1e, 0x85, 0x34, 0xcb, 0xf0, 0xc9, 0x91, 0x57, 0x0b, 0x9e, 0xb8, 0x6d,
+	0x88, 0x6f, 0xc3, 0x94, 0x6f, 0x18, 0xa4, 0x44, 0xc5, 0xd3, 0x85, 0x74, 0x58, 0xf9, 0x21, 0x07,
+	0xb5, 0x7e, 0x54, 0x64, 0xdb, 0x1b, 0x48, 0x3f, 0xd8, 0xa4, 0xc4, 0xb0, 0xbc, 0xa9, 0x60, 0xe3,
+	0x5f, 0xe1, 0x60, 0x26, 0xd1, 0xfe, 0xf7, 0x94, 0x19, 0x9c, 0xfc, 0x3e, 0xd4, 0xb3, 0x66, 0x74,
+	0x21, 0x64, 0x78, 0x95, 0x83, 0xc5, 0x38, 0xd1, 0xfd, 0x43, 0x4c, 0xdc, 0x3d, 0x66, 0x99, 0x26,
+	0x66, 0x97, 0xc3, 0x83, 0xdc, 0x10, 0x0f, 0xee, 0x01, 0xd8, 0xd4, 0xd4, 0xf6, 0x2d, 0xdb, 0xc5,
+	0xcc, 0xe7, 0x49, 0x65, 0x7d, 0x29, 0xe5, 0xe8, 0x3c, 0xa4, 0xe6, 0x96, 0x8f, 0x51, 0xcb, 0x76,
+	0xf4, 0x98, 0x4a, 0xa2, 0xc9, 0x31, 0x48, 0x54, 0xc8, 0x22, 0x51, 0xf1, 0x42, 0x48, 0x54, 0xca,
+	0x26, 0x51, 0xf9, 0x22, 0x49, 0x84, 0x60, 0xf5, 0x8c, 0xd9, 0x5e, 0x08, 0x7f, 0xbe, 0xcf, 0xf9,
+	0xdb, 0xeb, 0x51, 0xc7, 0x88, 0xb6, 0xd7, 0xc8, 0x68, 0x43, 0x54, 0x9a, 0x48, 0xa1, 0xd2, 0x2a,
+	0x4c, 0x13, 0xdc, 0xd3, 0xfa, 0xbb, 0x25, 0xe7, 0xc7, 0x98, 0x22, 0xb8, 0xb7, 0x15, 0xe9, 0xc4,
+	0xeb, 0x00, 0x1e, 0x28, 0x5c, 0x4c, 0x79, 0x7f, 0x31, 0x95, 0x09, 0xee, 0xed, 0xf8, 0x0a, 0xf1,
+	0x36, 0xcc, 0x7b, 0xe6, 0x11, 0x4c, 0x10, 0x09, 0xee, 0xdd, 0x3f, 0x45, 0x86, 0x95, 0x20, 0xeb,
+	0x69, 0x42, 0x54, 0x08, 0xee, 0x3d, 0x88, 0x38, 0xa1, 0xc2, 0x9c, 0x87, 0xf9, 0x47, 0xbc, 0x78,
+	0x83, 0xe0, 0x5e, 0x73, 0x0c, 0x6a, 0x7c, 0x04, 0x5e, 0xc3, 0x5a, 0xbc, 0x41, 0xca, 0xd9, 0x1b,
+	0xc4, 0xab, 0x75, 0x37, 0x75, 0x89, 0xac, 0xc1, 0xc2, 0xc0, 0x84, 0xe2, 0xb9, 0x4b, 0x50, 0xe4,
+	0x5d, 0x5d, 0x8f, 0x4e, 0x75, 0x49, 0x8d, 0xc4, 0x15, 0x16, 0x7c, 0x92, 0x20, 0xa2, 0x63, 0x7b,
+	0xfc, 0x6b, 0x20, 0x31, 0xf9, 0x89, 0x81, 0xc9, 0xf7, 0xdb, 0xcc, 0x25, 0xdb, 0x4c, 0x2b, 0xb3,
+	0x9f, 0x73, 0x8c, 0x32, 0x7f, 0x16, 0x60, 0xb6, 0xc9, 0xcd, 0x90, 0xfb, 0x7b, 0xf4, 0x02, 0x4a,
+	0xfd, 0x18, 0x0a, 0xc1, 0x91, 0x92, 0x72, 0xe7, 0x1b, 0x6d, 0xe8, 0x96, 0xe8, 0x35, 0x3f, 0xba,
+	0xd7, 0xf7, 0x41, 0x3a, 0x5d, 0xf7, 0x18, 0xed, 0xfe, 0x22, 0xc0, 0x9b, 0x4d, 0x6e, 0x7e, 0x69,
+	0xb9, 0x6d, 0x83, 0xa1, 0xde, 0x16, 0xa3, 0xce, 0x7f, 0xa6, 0xe3, 0xbb, 0xb0, 0x98, 0x52, 0xfa,
+	0x18, 0x4d, 0x3f, 0x85, 0xa9, 0x26, 0x37, 0x77, 0x50, 0x97, 0xe3, 0xcb, 0x62, 0xe2, 0x6d, 0x98,
+	0x4f, 0xa6, 0x1c, 0xfb, 0xbc, 0xa8, 0x98, 0x77, 0x1d, 0x7c, 0xb9, 0xe7, 0xa5, 0x9f, 0x33, 0xbb,
+	0xcc, 0xf5, 0x1f, 0x8b, 0x90, 0x6b, 0x72, 0x53, 0x7c, 0x0c, 0x90, 0xf8, 0xb9, 0x51, 0x4b, 0xb9,
+	0x56, 0x06, 0xbe, 0x3a, 0xe5, 0x7a, 0x16, 0x22, 0xce, 0xfd, 0xad, 0x00, 0xd7, 0xcf, 0xfe, 0xb0,
+	0xbc, 0x73, 0x66, 0xac, 0x74, 0x27, 0xf9, 0xde, 0xdf, 0x70, 0x8a, 0x6b, 0x7a, 0x2e, 0x80, 0x34,
+	0xf2, 0xfb, 0xa6, 0x71, 0x56, 0xe4, 0x61, 0xbc, 0xfc, 0xc1, 0xf9, 0xf0, 0x71, 0x11, 0x8f, 0x01,
+	0x12, 0x3b, 0x72, 0xc4, 0x2b, 0xef, 0x23, 0xe4, 0x7a, 0x16, 0x22, 0x19, 0x39, 0x71, 0x51, 0x8f,
+	0x1a, 0x66, 0x8c, 0x90, 0xeb, 0x59, 0x88, 0x38, 0x32, 0x82, 0xe9, 0x53, 0x57, 0x6b, 0xba, 0xeb,
+	0x00, 0x48, 0x7e, 0x6f, 0x0c, 0x50, 0x9c, 0xe2, 0x09, 0xcc, 0x0e, 0x5d, 0x67, 0xef, 0xa4, 0x07,
+	0x38, 0x8d, 0x93, 0x1b, 0xe3, 0xe1, 0xe2, 0x5c, 0x8f, 0xa0, 0xdc, 0xbf, 0x46, 0x96, 0xd3, 0x9d,
+	0x63, 0x80, 0x7c, 0x23, 0x03, 0x90, 0x7c, 0xff, 0x89, 0x83, 0x3f, 0xe2, 0xfd, 0xf7, 0x11, 0x72,
+	0x3d, 0x0b, 0x11, 0x45, 0x96, 0x27, 0xbf, 0x7e, 0xfd, 0xe2, 0xa6, 0xb0, 0xb1, 0xf1, 0xeb, 0x71,
+	0x55, 0x78, 0x79, 0x5c, 0x15, 0xfe, 0x3c, 0xae, 0x0a, 0xdf, 0x9c, 0x54, 0xaf, 0xbc, 0x3c, 0xa9,
+	0x5e, 0xf9, 0xfd, 0xa4, 0x7a, 0xe5, 0xab, 0x7a, 0x10, 0xe9, 0x96, 0x4e, 0x19, 0x56, 0xa2, 0xe7,
+	0x36, 0xb2, 0x88, 0x72, 0x14, 0xff, 0xd9, 0xe0, 0x3e, 0xeb, 0x60, 0xde, 0x2a, 0xf8, 0xff, 0x35,
+	0xdc, 0xf9, 0x6b, 0x00, 0x29, 0xf2, 0x65, 0x5d, 0x44, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateCron(ctx context.Context, in *MsgCreateCron, opts ...grpc.CallOption) (*MsgCreateCronResponse, error)
+	CreateCallBackConditionedCron(ctx context.Context, in *MsgCreateCallBackConditionedCron, opts ...grpc.CallOption) (*MsgCreateCallBackConditionedCronResponse, error)
+	CreateEventTriggeredCron(ctx context.Context, in *MsgCreateEventTriggeredCron, opts ...grpc.CallOption) (*MsgCreateEventTriggeredCronResponse, error)
+	UpdateCron(ctx context.Context, in *MsgUpdateCron, opts ...grpc.CallOption) (*MsgUpdateCronResponse, error)
+	CancelCron(ctx context.Context, in *MsgCancelCron, opts ...grpc.CallOption) (*MsgCancelCronResponse, error)
+	DepositToCron(ctx context.Context, in *MsgDepositToCron, opts ...grpc.CallOption) (*MsgDepositToCronResponse, error)
+	WithdrawFromCron(ctx context.Context, in *MsgWithdrawFromCron, opts ...grpc.CallOption) (*MsgWithdrawFromCronResponse, error)
+	PauseCron(ctx context.Context, in *MsgPauseCron, opts ...grpc.CallOption) (*MsgPauseCronResponse, error)
+	ResumeCron(ctx context.Context, in *MsgResumeCron, opts ...grpc.CallOption) (*MsgResumeCronResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateCron(ctx context.Context, in *MsgCreateCron, opts ...grpc.CallOption) (*MsgCreateCronResponse, error) {
+	out := new(MsgCreateCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/CreateCron", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateCallBackConditionedCronResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateCallBackConditionedCron(ctx context.Context, in *MsgCreateCallBackConditionedCron, opts ...grpc.CallOption) (*MsgCreateCallBackConditionedCronResponse, error) {
+	out := new(MsgCreateCallBackConditionedCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/CreateCallBackConditionedCron", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateCallBackConditionedCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CronAddress) > 0 {
-		i -= len(m.CronAddress)
-		copy(dAtA[i:], m.CronAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CronAddress)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) CreateEventTriggeredCron(ctx context.Context, in *MsgCreateEventTriggeredCron, opts ...grpc.CallOption) (*MsgCreateEventTriggeredCronResponse, error) {
+	out := new(MsgCreateEventTriggeredCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/CreateEventTriggeredCron", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CronId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *msgClient) UpdateCron(ctx context.Context, in *MsgUpdateCron, opts ...grpc.CallOption) (*MsgUpdateCronResponse, error) {
+	out := new(MsgUpdateCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/UpdateCron", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateCron) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelCron(ctx context.Context, in *MsgCancelCron, opts ...grpc.CallOption) (*MsgCancelCronResponse, error) {
+	out := new(MsgCancelCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/CancelCron", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateCron) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.NewMaxGasPrice != nil {
-		{
-			size := m.NewMaxGasPrice.Size()
-			i -= size
-			if _, err := m.NewMaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.NewGasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewGasLimit))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.NewExpirationBlock != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewExpirationBlock))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.NewParams) > 0 {
-		for iNdEx := len(m.NewParams) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NewParams[iNdEx])
-			copy(dAtA[i:], m.NewParams[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.NewParams[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.NewFrequency != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewFrequency))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CronId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) DepositToCron(ctx context.Context, in *MsgDepositToCron, opts ...grpc.CallOption) (*MsgDepositToCronResponse, error) {
+	out := new(MsgDepositToCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/DepositToCron", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateCronResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawFromCron(ctx context.Context, in *MsgWithdrawFromCron, opts ...grpc.CallOption) (*MsgWithdrawFromCronResponse, error) {
+	out := new(MsgWithdrawFromCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/WithdrawFromCron", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateCronResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgUpdateCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) PauseCron(ctx context.Context, in *MsgPauseCron, opts ...grpc.CallOption) (*MsgPauseCronResponse, error) {
+	out := new(MsgPauseCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/PauseCron", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelCron) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ResumeCron(ctx context.Context, in *MsgResumeCron, opts ...grpc.CallOption) (*MsgResumeCronResponse, error) {
+	out := new(MsgResumeCronResponse)
+	err := c.cc.Invoke(ctx, "/helios.chronos.v1.Msg/ResumeCron", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelCron) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateCron(context.Context, *MsgCreateCron) (*MsgCreateCronResponse, error)
+	CreateCallBackConditionedCron(context.Context, *MsgCreateCallBackConditionedCron) (*MsgCreateCallBackConditionedCronResponse, error)
+	CreateEventTriggeredCron(context.Context, *MsgCreateEventTriggeredCron) (*MsgCreateEventTriggeredCronResponse, error)
+	UpdateCron(context.Context, *MsgUpdateCron) (*MsgUpdateCronResponse, error)
+	CancelCron(context.Context, *MsgCancelCron) (*MsgCancelCronResponse, error)
+	DepositToCron(context.Context, *MsgDepositToCron) (*MsgDepositToCronResponse, error)
+	WithdrawFromCron(context.Context, *MsgWithdrawFromCron) (*MsgWithdrawFromCronResponse, error)
+	PauseCron(context.Context, *MsgPauseCron) (*MsgPauseCronResponse, error)
+	ResumeCron(context.Context, *MsgResumeCron) (*MsgResumeCronResponse, error)
 }
 
-func (m *MsgCancelCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CronId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgCancelCronResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateCron(ctx context.Context, req *MsgCreateCron) (*MsgCreateCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCron not implemented")
 }
-
-func (m *MsgCancelCronResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) CreateCallBackConditionedCron(ctx context.Context, req *MsgCreateCallBackConditionedCron) (*MsgCreateCallBackConditionedCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCallBackConditionedCron not implemented")
+}
+func (*UnimplementedMsgServer) CreateEventTriggeredCron(ctx context.Context, req *MsgCreateEventTriggeredCron) (*MsgCreateEventTriggeredCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventTriggeredCron not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCron(ctx context.Context, req *MsgUpdateCron) (*MsgUpdateCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCron not implemented")
+}
+func (*UnimplementedMsgServer) CancelCron(ctx context.Context, req *MsgCancelCron) (*MsgCancelCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCron not implemented")
+}
+func (*UnimplementedMsgServer) DepositToCron(ctx context.Context, req *MsgDepositToCron) (*MsgDepositToCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToCron not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromCron(ctx context.Context, req *MsgWithdrawFromCron) (*MsgWithdrawFromCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromCron not implemented")
+}
+func (*UnimplementedMsgServer) PauseCron(ctx context.Context, req *MsgPauseCron) (*MsgPauseCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCron not implemented")
+}
+func (*UnimplementedMsgServer) ResumeCron(ctx context.Context, req *MsgResumeCron) (*MsgResumeCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCron not implemented")
 }
 
-func (m *MsgCancelCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_CreateCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateCron) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCron(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/CreateCron",
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCron(ctx, req.(*MsgCreateCron))
 	}
-	l = len(m.AbiJson)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateCallBackConditionedCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCallBackConditionedCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.MethodName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCallBackConditionedCron(ctx, in)
 	}
-	if len(m.Params) > 0 {
-		for _, s := range m.Params {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/CreateCallBackConditionedCron",
 	}
-	if m.Frequency != 0 {
-		n += 1 + sovTx(uint64(m.Frequency))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCallBackConditionedCron(ctx, req.(*MsgCreateCallBackConditionedCron))
 	}
-	if m.ExpirationBlock != 0 {
-		n += 1 + sovTx(uint64(m.ExpirationBlock))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEventTriggeredCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEventTriggeredCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEventTriggeredCron(ctx, in)
 	}
-	if m.MaxGasPrice != nil {
-		l = m.MaxGasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/CreateEventTriggeredCron",
 	}
-	if m.AmountToDeposit != nil {
-		l = m.AmountToDeposit.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEventTriggeredCron(ctx, req.(*MsgCreateEventTriggeredCron))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateCronResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CronId != 0 {
-		n += 1 + sovTx(uint64(m.CronId))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCron(ctx, in)
 	}
-	l = len(m.CronAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/UpdateCron",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCron(ctx, req.(*MsgUpdateCron))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateCallBackConditionedCron) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CancelCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelCron(ctx, in)
 	}
-	l = len(m.MethodName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/CancelCron",
 	}
-	if m.ExpirationBlock != 0 {
-		n += 1 + sovTx(uint64(m.ExpirationBlock))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelCron(ctx, req.(*MsgCancelCron))
 	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositToCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.MaxGasPrice != nil {
-		l = m.MaxGasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToCron(ctx, in)
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/DepositToCron",
 	}
-	if m.AmountToDeposit != nil {
-		l = m.AmountToDeposit.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToCron(ctx, req.(*MsgDepositToCron))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateCallBackConditionedCronResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawFromCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CronId != 0 {
-		n += 1 + sovTx(uint64(m.CronId))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromCron(ctx, in)
 	}
-	l = len(m.CronAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/WithdrawFromCron",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromCron(ctx, req.(*MsgWithdrawFromCron))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCron) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CronId != 0 {
-		n += 1 + sovTx(uint64(m.CronId))
+func _Msg_PauseCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).PauseCron(ctx, in)
 	}
-	if m.NewFrequency != 0 {
-		n += 1 + sovTx(uint64(m.NewFrequency))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/PauseCron",
 	}
-	if len(m.NewParams) > 0 {
-		for _, s := range m.NewParams {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseCron(ctx, req.(*MsgPauseCron))
 	}
-	if m.NewExpirationBlock != 0 {
-		n += 1 + sovTx(uint64(m.NewExpirationBlock))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeCron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeCron)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.NewGasLimit != 0 {
-		n += 1 + sovTx(uint64(m.NewGasLimit))
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeCron(ctx, in)
 	}
-	if m.NewMaxGasPrice != nil {
-		l = m.NewMaxGasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.chronos.v1.Msg/ResumeCron",
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeCron(ctx, req.(*MsgResumeCron))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCronResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	return n
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "helios.chronos.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCron",
+			Handler:    _Msg_CreateCron_Handler,
+		},
+		{
+			MethodName: "CreateCallBackConditionedCron",
+			Handler:    _Msg_CreateCallBackConditionedCron_Handler,
+		},
+		{
+			MethodName: "CreateEventTriggeredCron",
+			Handler:    _Msg_CreateEventTriggeredCron_Handler,
+		},
+		{
+			MethodName: "UpdateCron",
+			Handler:    _Msg_UpdateCron_Handler,
+		},
+		{
+			MethodName: "CancelCron",
+			Handler:    _Msg_CancelCron_Handler,
+		},
+		{
+			MethodName: "DepositToCron",
+			Handler:    _Msg_DepositToCron_Handler,
+		},
+		{
+			MethodName: "WithdrawFromCron",
+			Handler:    _Msg_WithdrawFromCron_Handler,
+		},
+		{
+			MethodName: "PauseCron",
+			Handler:    _Msg_PauseCron_Handler,
+		},
+		{
+			MethodName: "ResumeCron",
+			Handler:    _Msg_ResumeCron_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "helios/chronos/v1/tx.proto",
 }
 
-func (m *MsgCancelCron) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CronId != 0 {
-		n += 1 + sovTx(uint64(m.CronId))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelCronResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	return n
+func (m *MsgCreateCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+func (m *MsgCreateCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AmountToDeposit != nil {
+		{
+			size := m.AmountToDeposit.Size()
+			i -= size
+			if _, err := m.AmountToDeposit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxGasPrice != nil {
+		{
+			size := m.MaxGasPrice.Size()
+			i -= size
+			if _, err := m.MaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpirationBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Frequency != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Frequency))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Params[iNdEx])
+			copy(dAtA[i:], m.Params[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Params[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MethodName) > 0 {
+		i -= len(m.MethodName)
+		copy(dAtA[i:], m.MethodName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MethodName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AbiJson) > 0 {
+		i -= len(m.AbiJson)
+		copy(dAtA[i:], m.AbiJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AbiJson)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CronAddress) > 0 {
+		i -= len(m.CronAddress)
+		copy(dAtA[i:], m.CronAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CronAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCallBackConditionedCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCallBackConditionedCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCallBackConditionedCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmountToDeposit != nil {
+		{
+			size := m.AmountToDeposit.Size()
+			i -= size
+			if _, err := m.AmountToDeposit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxGasPrice != nil {
+		{
+			size := m.MaxGasPrice.Size()
+			i -= size
+			if _, err := m.MaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpirationBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MethodName) > 0 {
+		i -= len(m.MethodName)
+		copy(dAtA[i:], m.MethodName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MethodName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCallBackConditionedCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCallBackConditionedCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCallBackConditionedCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CronAddress) > 0 {
+		i -= len(m.CronAddress)
+		copy(dAtA[i:], m.CronAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CronAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEventTriggeredCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEventTriggeredCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEventTriggeredCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmountToDeposit != nil {
+		{
+			size := m.AmountToDeposit.Size()
+			i -= size
+			if _, err := m.AmountToDeposit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxGasPrice != nil {
+		{
+			size := m.MaxGasPrice.Size()
+			i -= size
+			if _, err := m.MaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpirationBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LogFilter != nil {
+		{
+			size, err := m.LogFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MethodName) > 0 {
+		i -= len(m.MethodName)
+		copy(dAtA[i:], m.MethodName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MethodName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEventTriggeredCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEventTriggeredCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEventTriggeredCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CronAddress) > 0 {
+		i -= len(m.CronAddress)
+		copy(dAtA[i:], m.CronAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CronAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewSchedule != nil {
+		{
+			size, err := m.NewSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.NewMaxGasPrice != nil {
+		{
+			size := m.NewMaxGasPrice.Size()
+			i -= size
+			if _, err := m.NewMaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NewGasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NewExpirationBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewExpirationBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewParams) > 0 {
+		for iNdEx := len(m.NewParams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewParams[iNdEx])
+			copy(dAtA[i:], m.NewParams[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.NewParams[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NewFrequency != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewFrequency))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeCron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeCron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeCron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CronId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CronId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AbiJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MethodName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Params) > 0 {
+		for _, s := range m.Params {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Frequency != 0 {
+		n += 1 + sovTx(uint64(m.Frequency))
+	}
+	if m.ExpirationBlock != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationBlock))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if m.MaxGasPrice != nil {
+		l = m.MaxGasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountToDeposit != nil {
+		l = m.AmountToDeposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	l = len(m.CronAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCallBackConditionedCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MethodName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationBlock != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationBlock))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if m.MaxGasPrice != nil {
+		l = m.MaxGasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountToDeposit != nil {
+		l = m.AmountToDeposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCallBackConditionedCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	l = len(m.CronAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEventTriggeredCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MethodName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LogFilter != nil {
+		l = m.LogFilter.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationBlock != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationBlock))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	if m.MaxGasPrice != nil {
+		l = m.MaxGasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountToDeposit != nil {
+		l = m.AmountToDeposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEventTriggeredCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	l = len(m.CronAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewFrequency != 0 {
+		n += 1 + sovTx(uint64(m.NewFrequency))
+	}
+	if len(m.NewParams) > 0 {
+		for _, s := range m.NewParams {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NewExpirationBlock != 0 {
+		n += 1 + sovTx(uint64(m.NewExpirationBlock))
+	}
+	if m.NewGasLimit != 0 {
+		n += 1 + sovTx(uint64(m.NewGasLimit))
+	}
+	if m.NewMaxGasPrice != nil {
+		l = m.NewMaxGasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewSchedule != nil {
+		l = m.NewSchedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCancelCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDepositToCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositToCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFromCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPauseCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResumeCron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CronId != 0 {
+		n += 1 + sovTx(uint64(m.CronId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCron: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCron: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbiJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbiJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlock", wireType)
+			}
+			m.ExpirationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxGasPrice = &v
+			if err := m.MaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountToDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountToDeposit = &v
+			if err := m.AmountToDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCronResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCronResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
+			}
+			m.CronId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCallBackConditionedCron: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCallBackConditionedCron: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlock", wireType)
+			}
+			m.ExpirationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxGasPrice = &v
+			if err := m.MaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountToDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountToDeposit = &v
+			if err := m.AmountToDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCallBackConditionedCronResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCallBackConditionedCronResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCallBackConditionedCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
+			}
+			m.CronId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEventTriggeredCron) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -1522,19 +3891,189 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCron: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCron: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEventTriggeredCron: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEventTriggeredCron: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogFilter == nil {
+				m.LogFilter = &LogFilter{}
+			}
+			if err := m.LogFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlock", wireType)
+			}
+			m.ExpirationBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1562,11 +4101,15 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.MaxGasPrice = &v
+			if err := m.MaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1594,11 +4137,11 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbiJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountToDeposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1626,11 +4169,84 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbiJson = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.AmountToDeposit = &v
+			if err := m.AmountToDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEventTriggeredCronResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEventTriggeredCronResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEventTriggeredCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
+			}
+			m.CronId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MethodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1658,11 +4274,80 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MethodName = string(dAtA[iNdEx:postIndex])
+			m.CronAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCron: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCron: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
+			}
+			m.CronId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1690,13 +4375,13 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, string(dAtA[iNdEx:postIndex]))
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFrequency", wireType)
 			}
-			m.Frequency = 0
+			m.NewFrequency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1706,16 +4391,48 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Frequency |= uint64(b&0x7F) << shift
+				m.NewFrequency |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewParams = append(m.NewParams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExpirationBlock", wireType)
 			}
-			m.ExpirationBlock = 0
+			m.NewExpirationBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1725,16 +4442,16 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationBlock |= uint64(b&0x7F) << shift
+				m.NewExpirationBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGasLimit", wireType)
 			}
-			m.GasLimit = 0
+			m.NewGasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1744,14 +4461,14 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				m.NewGasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMaxGasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1780,12 +4497,12 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v cosmossdk_io_math.Int
-			m.MaxGasPrice = &v
-			if err := m.MaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NewMaxGasPrice = &v
+			if err := m.NewMaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -1817,11 +4534,11 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountToDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSchedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1831,25 +4548,25 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.AmountToDeposit = &v
-			if err := m.AmountToDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewSchedule == nil {
+				m.NewSchedule = &Schedule{}
+			}
+			if err := m.NewSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1874,7 +4591,7 @@ func (m *MsgCreateCron) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCronResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCronResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1897,36 +4614,17 @@ func (m *MsgCreateCronResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCronResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCronResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
-			}
-			m.CronId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CronId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1936,24 +4634,12 @@ func (m *MsgCreateCronResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CronAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1975,7 +4661,7 @@ func (m *MsgCreateCronResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelCron) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1998,10 +4684,10 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCallBackConditionedCron: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelCron: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCallBackConditionedCron: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelCron: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2037,10 +4723,10 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
 			}
-			var stringLen uint64
+			m.CronId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2050,27 +4736,14 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CronId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MethodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2098,32 +4771,63 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MethodName = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlock", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ExpirationBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpirationBlock |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 8:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelCronResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelCronResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.GasLimit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2133,14 +4837,65 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositToCron) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositToCron: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositToCron: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2168,15 +4923,30 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.MaxGasPrice = &v
-			if err := m.MaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
+			}
+			m.CronId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2204,11 +4974,15 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountToDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2236,11 +5010,7 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.AmountToDeposit = &v
-			if err := m.AmountToDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2263,7 +5033,7 @@ func (m *MsgCreateCallBackConditionedCron) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCallBackConditionedCronResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositToCronResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2286,36 +5056,17 @@ func (m *MsgCreateCallBackConditionedCronResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCallBackConditionedCronResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositToCronResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCallBackConditionedCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositToCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
-			}
-			m.CronId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CronId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2325,24 +5076,12 @@ func (m *MsgCreateCallBackConditionedCronResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CronAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2364,7 +5103,7 @@ func (m *MsgCreateCallBackConditionedCronResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromCron) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2387,32 +5126,13 @@ func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCron: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromCron: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCron: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromCron: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
-			}
-			m.CronId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CronId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
@@ -2444,11 +5164,11 @@ func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
 			}
 			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
 			}
-			m.NewFrequency = 0
+			m.CronId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2458,14 +5178,14 @@ func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewFrequency |= uint64(b&0x7F) << shift
+				m.CronId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2493,13 +5213,17 @@ func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewParams = append(m.NewParams, string(dAtA[iNdEx:postIndex]))
+			var v cosmossdk_io_math.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewExpirationBlock", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.NewExpirationBlock = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2509,16 +5233,79 @@ func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewExpirationBlock |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromCronResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromCronResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewGasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.NewGasLimit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2528,14 +5315,65 @@ func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewGasLimit |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseCron) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseCron: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseCron: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMaxGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2563,13 +5401,28 @@ func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.NewMaxGasPrice = &v
-			if err := m.NewMaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronId", wireType)
+			}
+			m.CronId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -2622,7 +5475,7 @@ func (m *MsgUpdateCron) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCronResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseCronResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2645,10 +5498,10 @@ func (m *MsgUpdateCronResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCronResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseCronResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2692,7 +5545,7 @@ func (m *MsgUpdateCronResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelCron) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeCron) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2715,10 +5568,10 @@ func (m *MsgCancelCron) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelCron: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeCron: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelCron: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeCron: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2825,7 +5678,7 @@ func (m *MsgCancelCron) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelCronResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeCronResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2848,10 +5701,10 @@ func (m *MsgCancelCronResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelCronResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeCronResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/chronos/types"
+)
+
+var testOwner = sdk.AccAddress(crypto.AddressHash([]byte("owner"))).String()
+
+func TestMsgUpdateCronValidate(t *testing.T) {
+	interval := &types.Schedule{Type: types.ScheduleType_SCHEDULE_TYPE_INTERVAL, IntervalSeconds: 60}
+	blocks := &types.Schedule{Type: types.ScheduleType_SCHEDULE_TYPE_BLOCKS}
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgUpdateCron
+		expErr bool
+	}{
+		{"invalid owner", types.MsgUpdateCron{CronId: 1, OwnerAddress: "owner", NewFrequency: 10}, true},
+		{"missing cron id", types.MsgUpdateCron{OwnerAddress: testOwner, NewFrequency: 10}, true},
+		{"keep schedule with a frequency", types.MsgUpdateCron{CronId: 1, OwnerAddress: testOwner, NewFrequency: 10}, false},
+		// the msg server requires the frequency if the kept schedule is block based
+		{"keep schedule without a frequency", types.MsgUpdateCron{CronId: 1, OwnerAddress: testOwner}, false},
+		{"time based schedule", types.MsgUpdateCron{CronId: 1, OwnerAddress: testOwner, NewSchedule: interval}, false},
+		{
+			"invalid time based schedule",
+			types.MsgUpdateCron{CronId: 1, OwnerAddress: testOwner, NewSchedule: &types.Schedule{Type: types.ScheduleType_SCHEDULE_TYPE_INTERVAL}},
+			true,
+		},
+		{"blocks schedule with a frequency", types.MsgUpdateCron{CronId: 1, OwnerAddress: testOwner, NewSchedule: blocks, NewFrequency: 10}, false},
+		{"blocks schedule without a frequency", types.MsgUpdateCron{CronId: 1, OwnerAddress: testOwner, NewSchedule: blocks}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCreateEventTriggeredCronValidate(t *testing.T) {
+	gasPrice := sdkmath.NewInt(1_000_000_000)
+	deposit := sdkmath.NewInt(1e18)
+	zero := sdkmath.ZeroInt()
+
+	valid := func() types.MsgCreateEventTriggeredCron {
+		return types.MsgCreateEventTriggeredCron{
+			OwnerAddress:    testOwner,
+			ContractAddress: "0x0000000000000000000000000000000000001000",
+			MethodName:      "onTransfer",
+			LogFilter:       &types.LogFilter{Address: "0x0000000000000000000000000000000000002000"},
+			GasLimit:        100_000,
+			MaxGasPrice:     &gasPrice,
+			AmountToDeposit: &deposit,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(msg *types.MsgCreateEventTriggeredCron)
+		expErr   bool
+	}{
+		{"valid", func(*types.MsgCreateEventTriggeredCron) {}, false},
+		{"zero gas limit", func(msg *types.MsgCreateEventTriggeredCron) { msg.GasLimit = 0 }, true},
+		{"nil max gas price", func(msg *types.MsgCreateEventTriggeredCron) { msg.MaxGasPrice = nil }, true},
+		{"zero max gas price", func(msg *types.MsgCreateEventTriggeredCron) { msg.MaxGasPrice = &zero }, true},
+		{"nil amount to deposit", func(msg *types.MsgCreateEventTriggeredCron) { msg.AmountToDeposit = nil }, true},
+		{"zero amount to deposit", func(msg *types.MsgCreateEventTriggeredCron) { msg.AmountToDeposit = &zero }, true},
+		{"nil log filter", func(msg *types.MsgCreateEventTriggeredCron) { msg.LogFilter = nil }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := valid()
+			tc.malleate(&msg)
+			err := msg.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
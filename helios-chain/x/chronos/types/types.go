@@ -20,6 +20,7 @@ import (
 var (
 	LEGACY_CRON               = "1"
 	CALLBACK_CONDITIONED_CRON = "2"
+	EVENT_TRIGGERED_CRON      = "3"
 )
 
 type EVMKeeper interface {
@@ -77,6 +77,83 @@ func (m *CronCallBackData) GetError() []byte {
 	return nil
 }
 
+// EVM log matched by an event triggered cron, waiting for its execution
+type CronMatchedLog struct {
+	Address  string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics   []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data     []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	TxHash   string   `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"txHash"`
+	LogIndex uint64   `protobuf:"varint,5,opt,name=log_index,json=logIndex,proto3" json:"logIndex"`
+}
+
+func (m *CronMatchedLog) Reset()         { *m = CronMatchedLog{} }
+func (m *CronMatchedLog) String() string { return proto.CompactTextString(m) }
+func (*CronMatchedLog) ProtoMessage()    {}
+func (*CronMatchedLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd54e5dd8b5f8dac, []int{1}
+}
+func (m *CronMatchedLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronMatchedLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronMatchedLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CronMatchedLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronMatchedLog.Merge(m, src)
+}
+func (m *CronMatchedLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronMatchedLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronMatchedLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronMatchedLog proto.InternalMessageInfo
+
+func (m *CronMatchedLog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CronMatchedLog) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *CronMatchedLog) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CronMatchedLog) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *CronMatchedLog) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
 type CronTransactionResult struct {
 	Tx          []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
 	Result      []byte `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -93,7 +170,7 @@ func (m *CronTransactionResult) Reset()         { *m = CronTransactionResult{} }
 func (m *CronTransactionResult) String() string { return proto.CompactTextString(m) }
 func (*CronTransactionResult) ProtoMessage()    {}
 func (*CronTransactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd54e5dd8b5f8dac, []int{1}
+	return fileDescriptor_dd54e5dd8b5f8dac, []int{2}
 }
 func (m *CronTransactionResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +292,7 @@ func (m *CronTransactionReceiptRPC) Reset()         { *m = CronTransactionReceip
 func (m *CronTransactionReceiptRPC) String() string { return proto.CompactTextString(m) }
 func (*CronTransactionReceiptRPC) ProtoMessage()    {}
 func (*CronTransactionReceiptRPC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd54e5dd8b5f8dac, []int{2}
+	return fileDescriptor_dd54e5dd8b5f8dac, []int{3}
 }
 func (m *CronTransactionReceiptRPC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +472,7 @@ func (m *CronTransactionRPC) Reset()         { *m = CronTransactionRPC{} }
 func (m *CronTransactionRPC) String() string { return proto.CompactTextString(m) }
 func (*CronTransactionRPC) ProtoMessage()    {}
 func (*CronTransactionRPC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd54e5dd8b5f8dac, []int{3}
+	return fileDescriptor_dd54e5dd8b5f8dac, []int{4}
 }
 func (m *CronTransactionRPC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +639,7 @@ func (m *CronStatistics) Reset()         { *m = CronStatistics{} }
 func (m *CronStatistics) String() string { return proto.CompactTextString(m) }
 func (*CronStatistics) ProtoMessage()    {}
 func (*CronStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd54e5dd8b5f8dac, []int{4}
+	return fileDescriptor_dd54e5dd8b5f8dac, []int{5}
 }
 func (m *CronStatistics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +712,7 @@ func (m *IdAndTimestamp) Reset()         { *m = IdAndTimestamp{} }
 func (m *IdAndTimestamp) String() string { return proto.CompactTextString(m) }
 func (*IdAndTimestamp) ProtoMessage()    {}
 func (*IdAndTimestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd54e5dd8b5f8dac, []int{5}
+	return fileDescriptor_dd54e5dd8b5f8dac, []int{6}
 }
 func (m *IdAndTimestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +763,7 @@ func (m *IDSet) Reset()         { *m = IDSet{} }
 func (m *IDSet) String() string { return proto.CompactTextString(m) }
 func (*IDSet) ProtoMessage()    {}
 func (*IDSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd54e5dd8b5f8dac, []int{6}
+	return fileDescriptor_dd54e5dd8b5f8dac, []int{7}
 }
 func (m *IDSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,7 +811,7 @@ func (m *BatchFeesWithIds) Reset()         { *m = BatchFeesWithIds{} }
 func (m *BatchFeesWithIds) String() string { return proto.CompactTextString(m) }
 func (*BatchFeesWithIds) ProtoMessage()    {}
 func (*BatchFeesWithIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd54e5dd8b5f8dac, []int{7}
+	return fileDescriptor_dd54e5dd8b5f8dac, []int{8}
 }
 func (m *BatchFeesWithIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,6 +863,7 @@ func (m *BatchFeesWithIds) GetTotalQueueCount() uint64 {
 
 func init() {
 	proto.RegisterType((*CronCallBackData)(nil), "helios.chronos.v1.CronCallBackData")
+	proto.RegisterType((*CronMatchedLog)(nil), "helios.chronos.v1.CronMatchedLog")
 	proto.RegisterType((*CronTransactionResult)(nil), "helios.chronos.v1.CronTransactionResult")
 	proto.RegisterType((*CronTransactionReceiptRPC)(nil), "helios.chronos.v1.CronTransactionReceiptRPC")
 	proto.RegisterType((*CronTransactionRPC)(nil), "helios.chronos.v1.CronTransactionRPC")
@@ -798,78 +876,82 @@ func init() {
 func init() { proto.RegisterFile("helios/chronos/v1/types.proto", fileDescriptor_dd54e5dd8b5f8dac) }
 
 var fileDescriptor_dd54e5dd8b5f8dac = []byte{
-	// 1125 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
-	0x13, 0x36, 0x25, 0x59, 0xb2, 0x56, 0xb2, 0x2c, 0x6d, 0xe2, 0x80, 0x31, 0xfe, 0x88, 0xfa, 0x55,
-	0x20, 0x50, 0x8a, 0x54, 0x82, 0x93, 0x4b, 0x0f, 0x46, 0x00, 0xcb, 0x49, 0x5b, 0x01, 0x41, 0xe1,
-	0x6e, 0x12, 0x14, 0xe8, 0x45, 0x58, 0x93, 0x6b, 0x89, 0xb0, 0xc8, 0x55, 0x77, 0x97, 0x84, 0xfa,
-	0x14, 0xed, 0x9b, 0xf4, 0x35, 0x72, 0x0c, 0x7a, 0x69, 0xd1, 0x03, 0x51, 0xd8, 0x37, 0x3d, 0x45,
-	0x31, 0xbb, 0xa4, 0xa8, 0xc8, 0x36, 0x9c, 0x1c, 0x7a, 0xe2, 0xcc, 0x37, 0xb3, 0x1f, 0x87, 0xb3,
-	0xdf, 0x2c, 0x17, 0x3d, 0x9a, 0xb2, 0x99, 0xcf, 0xe5, 0xc0, 0x9d, 0x0a, 0x1e, 0x72, 0x39, 0x88,
-	0x0f, 0x07, 0xea, 0x97, 0x39, 0x93, 0xfd, 0xb9, 0xe0, 0x8a, 0xe3, 0x96, 0x09, 0xf7, 0xd3, 0x70,
-	0x3f, 0x3e, 0x3c, 0xb8, 0x3f, 0xe1, 0x13, 0xae, 0xa3, 0x03, 0xb0, 0x4c, 0xe2, 0xc1, 0x01, 0x53,
-	0x53, 0x26, 0x02, 0x3f, 0x54, 0x03, 0x16, 0x07, 0x40, 0xc3, 0xe2, 0xc0, 0xc4, 0xba, 0x47, 0xa8,
-	0x79, 0x22, 0x78, 0x78, 0x42, 0x67, 0xb3, 0x21, 0x75, 0x2f, 0x5e, 0x52, 0x45, 0x31, 0x46, 0x25,
-	0x8f, 0x2a, 0x6a, 0x5b, 0x1d, 0xab, 0x57, 0x27, 0xda, 0xc6, 0xf7, 0xd1, 0x36, 0x13, 0x82, 0x0b,
-	0xbb, 0xa0, 0x41, 0xe3, 0x74, 0xff, 0x28, 0xa0, 0x7d, 0x58, 0xfe, 0x56, 0xd0, 0x50, 0x52, 0x57,
-	0xf9, 0x3c, 0x24, 0x4c, 0x46, 0x33, 0x85, 0x1b, 0xa8, 0xa0, 0x16, 0x29, 0x43, 0x41, 0x2d, 0xf0,
-	0x03, 0x54, 0x16, 0x3a, 0x92, 0x12, 0xa4, 0x1e, 0xf0, 0x86, 0x3c, 0x74, 0x99, 0x5d, 0xec, 0x58,
-	0xbd, 0x12, 0x31, 0x0e, 0x54, 0x70, 0x2e, 0x78, 0x60, 0x97, 0x3a, 0x56, 0xaf, 0x4a, 0xb4, 0x8d,
-	0x9f, 0xa1, 0xfa, 0xd9, 0x8c, 0xbb, 0x17, 0xe3, 0x30, 0x0a, 0xce, 0x98, 0xb0, 0xb7, 0x61, 0xc1,
-	0x70, 0x6f, 0x99, 0x38, 0x35, 0x8d, 0x7f, 0xaf, 0x61, 0xb2, 0xee, 0xe0, 0xa7, 0x08, 0x99, 0x35,
-	0x53, 0x2a, 0xa7, 0x76, 0x19, 0xd8, 0x86, 0xbb, 0xcb, 0xc4, 0xa9, 0x6a, 0xf4, 0x3b, 0x2a, 0xa7,
-	0x24, 0x37, 0xf1, 0x17, 0xa8, 0xe2, 0x0a, 0x1e, 0x8e, 0x7d, 0xcf, 0xae, 0x68, 0x72, 0xb4, 0x4c,
-	0x9c, 0x32, 0x40, 0x23, 0x8f, 0xa4, 0x4f, 0x28, 0x43, 0x27, 0x51, 0xcf, 0x13, 0x4c, 0x4a, 0x7b,
-	0x47, 0x93, 0xea, 0x32, 0x00, 0x3f, 0x36, 0x30, 0x59, 0x77, 0xf0, 0x53, 0x54, 0x51, 0x0b, 0x53,
-	0x43, 0x55, 0xa7, 0xdf, 0x5b, 0x26, 0xce, 0x9e, 0xca, 0x9b, 0xa6, 0x2b, 0x29, 0xab, 0x05, 0x3c,
-	0xbb, 0xbf, 0x96, 0xd1, 0xc3, 0x6b, 0x4d, 0x75, 0x99, 0x3f, 0x57, 0xe4, 0xf4, 0x04, 0x77, 0x51,
-	0x59, 0x2a, 0xaa, 0x22, 0xa9, 0x9b, 0x5b, 0x35, 0x35, 0x1a, 0x84, 0xa4, 0x4f, 0xfc, 0x0a, 0xdd,
-	0x73, 0xa3, 0x20, 0x9a, 0x51, 0xe5, 0xc7, 0x6c, 0x3c, 0xa1, 0x72, 0x1c, 0x49, 0xe6, 0xe9, 0xce,
-	0x57, 0x87, 0xfb, 0xcb, 0xc4, 0x69, 0xe5, 0xe1, 0x6f, 0xa9, 0x7c, 0x27, 0x99, 0x47, 0xae, 0x43,
-	0xd0, 0xbd, 0x19, 0x9f, 0xc8, 0xf1, 0xd9, 0x8c, 0xf3, 0x40, 0x6f, 0x50, 0xda, 0x3d, 0x40, 0x87,
-	0x00, 0x92, 0xdc, 0xc4, 0x4f, 0x50, 0x09, 0x1c, 0xbb, 0xd4, 0x29, 0xf6, 0x6a, 0xcf, 0xf6, 0xfb,
-	0x2b, 0xd1, 0xf5, 0x41, 0x6d, 0xf1, 0x61, 0xff, 0x35, 0x9f, 0x10, 0x9d, 0x82, 0x5f, 0xa0, 0xe6,
-	0xda, 0xc7, 0x9b, 0xc6, 0x6c, 0xdf, 0xde, 0x98, 0x4d, 0x00, 0xd6, 0xbb, 0x3c, 0x54, 0x82, 0xba,
-	0x6a, 0xb5, 0x0f, 0xe5, 0x7c, 0x7d, 0x16, 0xcb, 0xf6, 0x62, 0x13, 0xc0, 0x8f, 0xd1, 0xce, 0xaa,
-	0x29, 0x15, 0xbd, 0xae, 0xb6, 0x4c, 0x9c, 0xca, 0x24, 0x6d, 0x45, 0x66, 0x6c, 0xc8, 0x67, 0xe7,
-	0x0e, 0xf9, 0x6c, 0x0a, 0xb4, 0x9a, 0x2b, 0xe3, 0x56, 0x81, 0x1e, 0xa3, 0xd6, 0x7a, 0x27, 0xfc,
-	0xd0, 0x63, 0x0b, 0x1b, 0xe9, 0x85, 0xf7, 0x97, 0x89, 0xb3, 0xde, 0xa6, 0x11, 0xc4, 0xc8, 0x35,
-	0x64, 0x35, 0x2b, 0xb5, 0xb5, 0x59, 0x81, 0xe9, 0xe3, 0x76, 0x5d, 0x23, 0x05, 0xc5, 0x21, 0x07,
-	0x4e, 0x0e, 0x7b, 0xd7, 0xe4, 0x80, 0xbd, 0x36, 0x91, 0x0d, 0x8d, 0x66, 0x13, 0xd9, 0x44, 0x45,
-	0xc1, 0x94, 0xbd, 0xa7, 0x41, 0x30, 0xa1, 0x5d, 0x71, 0x30, 0x36, 0xe3, 0xdf, 0xcc, 0xdb, 0x15,
-	0x07, 0xaf, 0x00, 0x22, 0x99, 0xb1, 0x3e, 0x3f, 0xad, 0x4f, 0x9e, 0x1f, 0x7c, 0xf7, 0xfc, 0x74,
-	0x7f, 0x2f, 0x21, 0xbc, 0x39, 0x11, 0xa7, 0x27, 0x1b, 0xdb, 0x63, 0x7d, 0xe6, 0xf6, 0x14, 0x3e,
-	0x61, 0x7b, 0x1e, 0xa3, 0x1d, 0x77, 0x4a, 0x7d, 0xfd, 0x49, 0xc5, 0xfc, 0xcb, 0x35, 0x36, 0xf2,
-	0x48, 0x66, 0xdc, 0x78, 0x5e, 0x35, 0x51, 0x71, 0x42, 0xa5, 0xd1, 0x35, 0x01, 0x13, 0x3f, 0x41,
-	0x55, 0x90, 0xdd, 0x5c, 0xf8, 0x2e, 0x4b, 0xf5, 0x5a, 0x5f, 0x26, 0x0e, 0x68, 0xf1, 0x14, 0x30,
-	0xb2, 0xb2, 0x80, 0x50, 0x7f, 0x54, 0xc5, 0x10, 0x82, 0x0d, 0x47, 0xa5, 0x1f, 0xce, 0x23, 0x65,
-	0x84, 0x48, 0x8c, 0x93, 0x1f, 0xa0, 0x55, 0x83, 0x9a, 0x03, 0xb4, 0x8e, 0x2c, 0x61, 0x74, 0x44,
-	0x2c, 0x01, 0x9e, 0x4c, 0xf5, 0x61, 0xc9, 0x6b, 0xe2, 0xb8, 0x51, 0x83, 0xbb, 0x9f, 0xab, 0x41,
-	0xad, 0xaf, 0xc6, 0x9a, 0xbe, 0xea, 0xc8, 0x8a, 0x53, 0x15, 0x59, 0x31, 0x94, 0x19, 0xd3, 0x59,
-	0xc4, 0x8c, 0x80, 0x88, 0x71, 0xfe, 0x3b, 0xc5, 0xfc, 0x59, 0x40, 0x0d, 0x50, 0xcc, 0x1b, 0x45,
-	0x95, 0x2f, 0x95, 0xef, 0xc2, 0x21, 0x8c, 0x34, 0x8d, 0xcb, 0xa3, 0x50, 0x69, 0xb5, 0x94, 0x8c,
-	0x5a, 0x00, 0x3d, 0x01, 0x90, 0xe4, 0x26, 0x1e, 0xa0, 0xda, 0xcf, 0x11, 0x8b, 0x58, 0x9a, 0x5e,
-	0xd0, 0xe9, 0x8d, 0x65, 0xe2, 0x20, 0x0d, 0x9b, 0xfc, 0x35, 0x1b, 0x7f, 0x8d, 0x1a, 0x54, 0xb8,
-	0x53, 0x3f, 0x66, 0xde, 0x18, 0x68, 0xa4, 0xf9, 0xa3, 0x0d, 0x5b, 0xcb, 0xc4, 0xd9, 0xcd, 0x22,
-	0x50, 0x92, 0x24, 0x1f, 0xbb, 0xf8, 0x1d, 0x7a, 0x28, 0xd8, 0x79, 0x14, 0x7a, 0xcc, 0x1b, 0xcf,
-	0xa8, 0x54, 0x63, 0x23, 0x53, 0xf3, 0xe2, 0x92, 0x26, 0x39, 0x58, 0x26, 0xce, 0x83, 0x2c, 0xe9,
-	0x35, 0x95, 0x6a, 0x08, 0x29, 0xa6, 0x88, 0x5b, 0x70, 0xa0, 0x65, 0x0b, 0xe6, 0x46, 0xea, 0x26,
-	0xda, 0xed, 0x9c, 0x36, 0x4b, 0xda, 0xa4, 0xbd, 0x19, 0xef, 0xbe, 0x40, 0x8d, 0x91, 0x77, 0x1c,
-	0x7a, 0x6f, 0xfd, 0x80, 0x49, 0x45, 0x83, 0x39, 0xe8, 0xc9, 0xf7, 0x4c, 0x43, 0x49, 0xc1, 0xf7,
-	0xf0, 0xff, 0x50, 0x55, 0x65, 0x41, 0xd3, 0x38, 0x92, 0x03, 0xdd, 0x23, 0xb4, 0x3d, 0x7a, 0xf9,
-	0x86, 0x29, 0xfc, 0x1c, 0x15, 0x7d, 0x0f, 0xfe, 0x62, 0xf0, 0xbb, 0xf8, 0x7f, 0xff, 0xda, 0x65,
-	0xa6, 0xff, 0xf1, 0x6b, 0x08, 0x64, 0x77, 0x2f, 0x2d, 0xd4, 0x1c, 0x52, 0xe5, 0x4e, 0xbf, 0x61,
-	0x4c, 0xfe, 0xe8, 0xab, 0xe9, 0xc8, 0x93, 0xf8, 0x08, 0x21, 0xc5, 0x15, 0x9d, 0x8d, 0xcf, 0x19,
-	0xcb, 0x7e, 0x8b, 0x8f, 0xde, 0x27, 0xce, 0xd6, 0xdf, 0x89, 0xb3, 0xef, 0x72, 0x19, 0x70, 0x29,
-	0xbd, 0x8b, 0xbe, 0xcf, 0x07, 0x01, 0x55, 0xd3, 0xfe, 0x08, 0x76, 0x5a, 0x2f, 0x00, 0x12, 0x98,
-	0x53, 0xa8, 0xa3, 0xd0, 0x29, 0xf6, 0x4a, 0xfa, 0x25, 0xf8, 0x10, 0x95, 0x34, 0x53, 0xb1, 0x53,
-	0xbc, 0x9b, 0x49, 0xa7, 0x62, 0x07, 0xd5, 0xd8, 0x62, 0xee, 0x0b, 0xe6, 0x8d, 0x81, 0xac, 0xa4,
-	0xc9, 0x50, 0x0a, 0x41, 0x8d, 0x5f, 0xa2, 0x96, 0xa9, 0x71, 0x5d, 0x55, 0x7a, 0x17, 0xc8, 0x9e,
-	0x0e, 0xfc, 0xb0, 0x92, 0xd2, 0x70, 0xf8, 0xfe, 0xb2, 0x6d, 0x7d, 0xb8, 0x6c, 0x5b, 0xff, 0x5c,
-	0xb6, 0xad, 0xdf, 0xae, 0xda, 0x5b, 0x1f, 0xae, 0xda, 0x5b, 0x7f, 0x5d, 0xb5, 0xb7, 0x7e, 0xea,
-	0x99, 0x2e, 0x7d, 0xe5, 0x72, 0xc1, 0x06, 0x99, 0x0d, 0x67, 0xd0, 0x60, 0xb1, 0xba, 0x25, 0xea,
-	0x2b, 0xe2, 0x59, 0x59, 0x5f, 0xef, 0x9e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xde, 0x70, 0xbf,
-	0x27, 0x44, 0x0a, 0x00, 0x00,
+	// 1189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xdb, 0xb6,
+	0x17, 0x8f, 0x6c, 0xc5, 0x8e, 0x19, 0xc7, 0xb1, 0xd9, 0xa6, 0x50, 0x83, 0x7f, 0xad, 0xfc, 0x3d,
+	0xa0, 0x48, 0x87, 0xce, 0x46, 0xda, 0xcb, 0x0e, 0x45, 0x81, 0x3a, 0xed, 0x36, 0x03, 0xdd, 0xd0,
+	0xb1, 0x2d, 0x06, 0xec, 0x22, 0x30, 0x12, 0x2b, 0x0b, 0x95, 0x44, 0x4f, 0xa4, 0x04, 0xef, 0x53,
+	0x6c, 0x9f, 0x62, 0xd7, 0x7d, 0x8d, 0x1e, 0x8b, 0x5d, 0x36, 0xec, 0x20, 0x0c, 0xe9, 0xcd, 0x9f,
+	0x62, 0x78, 0xa4, 0x64, 0xa9, 0x4e, 0x8a, 0xb4, 0x87, 0x9d, 0xf4, 0xde, 0x8f, 0x8f, 0x4f, 0x8f,
+	0x3f, 0xfe, 0x1e, 0x49, 0x74, 0x6b, 0xce, 0xc2, 0x80, 0x8b, 0x89, 0x3b, 0x4f, 0x78, 0xcc, 0xc5,
+	0x24, 0x3b, 0x99, 0xc8, 0x9f, 0x17, 0x4c, 0x8c, 0x17, 0x09, 0x97, 0x1c, 0x0f, 0xf4, 0xf0, 0xb8,
+	0x18, 0x1e, 0x67, 0x27, 0x87, 0xd7, 0x7d, 0xee, 0x73, 0x35, 0x3a, 0x01, 0x4b, 0x07, 0x1e, 0x1e,
+	0x32, 0x39, 0x67, 0x49, 0x14, 0xc4, 0x72, 0xc2, 0xb2, 0x08, 0xd2, 0xb0, 0x2c, 0xd2, 0x63, 0xa3,
+	0x07, 0xa8, 0x7f, 0x9a, 0xf0, 0xf8, 0x94, 0x86, 0xe1, 0x94, 0xba, 0xaf, 0x1f, 0x53, 0x49, 0x31,
+	0x46, 0xa6, 0x47, 0x25, 0xb5, 0x8c, 0x23, 0xe3, 0xb8, 0x4b, 0x94, 0x8d, 0xaf, 0xa3, 0x6d, 0x96,
+	0x24, 0x3c, 0xb1, 0x1a, 0x0a, 0xd4, 0xce, 0xe8, 0x37, 0x03, 0xf5, 0x60, 0xfa, 0xb7, 0x54, 0xba,
+	0x73, 0xe6, 0x3d, 0xe5, 0x3e, 0xb6, 0x50, 0x9b, 0x7a, 0x5e, 0xc2, 0x84, 0x50, 0xf3, 0x3b, 0xa4,
+	0x74, 0xf1, 0x0d, 0xd4, 0x92, 0x7c, 0x11, 0xb8, 0xc2, 0x6a, 0x1c, 0x35, 0x8f, 0x3b, 0xa4, 0xf0,
+	0xd6, 0xbf, 0x6b, 0xd6, 0x7e, 0xf7, 0x19, 0x6a, 0xcb, 0xa5, 0x33, 0xa7, 0x62, 0x6e, 0x99, 0x90,
+	0x65, 0x8a, 0x56, 0xb9, 0xdd, 0x92, 0xcb, 0x6f, 0xa8, 0x98, 0x93, 0xe2, 0x8b, 0xef, 0xa0, 0x4e,
+	0xc8, 0x7d, 0x27, 0x88, 0x3d, 0xb6, 0xb4, 0xb6, 0x8f, 0x8c, 0x63, 0x73, 0xda, 0x5d, 0xe5, 0xf6,
+	0x4e, 0xc8, 0xfd, 0x19, 0x60, 0x64, 0x6d, 0x8d, 0xfe, 0x68, 0xa0, 0x03, 0x28, 0xf4, 0x45, 0x42,
+	0x63, 0x41, 0x5d, 0x19, 0xf0, 0x98, 0x30, 0x91, 0x86, 0x12, 0xf7, 0x50, 0x43, 0x2e, 0x8b, 0xa5,
+	0x36, 0xe4, 0x12, 0xaa, 0x4c, 0xd4, 0x48, 0xb1, 0xd2, 0xc2, 0x03, 0x02, 0x62, 0x1e, 0xbb, 0x4c,
+	0x95, 0x69, 0x12, 0xed, 0x40, 0xed, 0xaf, 0x12, 0x1e, 0xe9, 0x22, 0x89, 0xb2, 0xf1, 0x3d, 0xd4,
+	0x3d, 0x0b, 0xb9, 0xfb, 0xda, 0x89, 0xd3, 0xe8, 0x8c, 0x25, 0x45, 0x65, 0xfb, 0xab, 0xdc, 0xde,
+	0x55, 0xf8, 0x77, 0x0a, 0x26, 0x75, 0x07, 0xdf, 0x45, 0x48, 0xcf, 0x51, 0x4b, 0x6e, 0xa9, 0x25,
+	0xef, 0xad, 0x72, 0xbb, 0xa3, 0x50, 0xb5, 0xea, 0xca, 0x04, 0x76, 0xdc, 0x84, 0xc7, 0x4e, 0xe0,
+	0x59, 0x6d, 0x95, 0x5c, 0xb1, 0x03, 0xd0, 0xcc, 0x23, 0xc5, 0x17, 0xca, 0x50, 0x41, 0xe5, 0x6e,
+	0xec, 0xa8, 0xa4, 0xaa, 0x0c, 0xc0, 0x1f, 0x69, 0x98, 0xd4, 0x1d, 0x7c, 0xb7, 0xa2, 0xbd, 0xa3,
+	0xc2, 0xaf, 0xad, 0x72, 0x7b, 0x5f, 0x56, 0xa4, 0xd5, 0xf9, 0x1f, 0xfd, 0xd2, 0x42, 0x37, 0x2f,
+	0x90, 0xea, 0xb2, 0x60, 0x21, 0xc9, 0xb3, 0x53, 0x3c, 0x42, 0x2d, 0x21, 0xa9, 0x4c, 0x0b, 0x1d,
+	0xe8, 0x1a, 0x35, 0x42, 0x8a, 0x2f, 0x7e, 0x82, 0xae, 0xb9, 0x69, 0x94, 0x86, 0x54, 0x06, 0x19,
+	0x73, 0x7c, 0x2a, 0x9c, 0x54, 0x30, 0x4f, 0x31, 0xdf, 0x99, 0x1e, 0xac, 0x72, 0x7b, 0x50, 0x0d,
+	0x7f, 0x4d, 0xc5, 0x4b, 0xc1, 0x3c, 0x72, 0x11, 0x02, 0xf6, 0x42, 0xee, 0x0b, 0xe7, 0x2c, 0xe4,
+	0x3c, 0xb2, 0x9a, 0x15, 0x7b, 0x80, 0x4e, 0x01, 0x24, 0x95, 0x89, 0xef, 0x20, 0x13, 0x1c, 0xcb,
+	0x3c, 0x6a, 0x1e, 0xef, 0xde, 0x3b, 0x18, 0xaf, 0xbb, 0x63, 0x0c, 0x6d, 0x91, 0x9d, 0x8c, 0x9f,
+	0x72, 0x9f, 0xa8, 0x10, 0xfc, 0x10, 0xf5, 0x6b, 0x8b, 0xd7, 0xc4, 0x6c, 0x7f, 0x98, 0x98, 0x4d,
+	0x00, 0xe6, 0xbb, 0x3c, 0x96, 0x09, 0x75, 0xe5, 0x7a, 0x1f, 0x5a, 0xd5, 0xfc, 0x72, 0xac, 0xdc,
+	0x8b, 0x4d, 0x00, 0xdf, 0x46, 0x3b, 0x6b, 0x52, 0xda, 0x6a, 0xde, 0xee, 0x2a, 0xb7, 0xdb, 0x7e,
+	0x41, 0x45, 0xdb, 0xaf, 0x08, 0xa8, 0xc9, 0x67, 0xe7, 0x0a, 0xf9, 0x6c, 0x0a, 0xb4, 0x53, 0x29,
+	0xe3, 0x83, 0x02, 0x7d, 0x84, 0x06, 0x75, 0x26, 0x74, 0xcf, 0x21, 0x35, 0xf1, 0xfa, 0x2a, 0xb7,
+	0xeb, 0x34, 0xe9, 0xde, 0xbb, 0x80, 0xac, 0x7b, 0x65, 0xb7, 0xd6, 0x2b, 0xd0, 0x7d, 0xdc, 0xea,
+	0x2a, 0xa4, 0x21, 0x39, 0xc4, 0xc0, 0x11, 0x67, 0xed, 0xe9, 0x18, 0xb0, 0x6b, 0x1d, 0xd9, 0x53,
+	0x68, 0xe1, 0xe1, 0x3e, 0x6a, 0x26, 0x4c, 0x5a, 0xfb, 0x0a, 0x04, 0x13, 0xe8, 0xca, 0x22, 0x47,
+	0x9f, 0x53, 0xfd, 0x8a, 0xae, 0x2c, 0x7a, 0x02, 0x10, 0x29, 0x8d, 0x7a, 0xff, 0x0c, 0x3e, 0xba,
+	0x7f, 0xf0, 0xd5, 0xfd, 0x33, 0xfa, 0xdd, 0x44, 0x78, 0xb3, 0x23, 0x9e, 0x9d, 0x6e, 0x6c, 0x8f,
+	0xf1, 0x89, 0xdb, 0xd3, 0xf8, 0x88, 0xed, 0xb9, 0x8d, 0x76, 0xdc, 0x39, 0x0d, 0xd4, 0x92, 0x9a,
+	0xd5, 0xca, 0x15, 0x36, 0xf3, 0x48, 0x69, 0x5c, 0x7a, 0x5e, 0xf5, 0x51, 0xd3, 0xa7, 0x42, 0xeb,
+	0x9a, 0x80, 0x09, 0x07, 0x2b, 0xc8, 0x6e, 0x91, 0x04, 0x2e, 0x2b, 0xf4, 0xaa, 0x0e, 0x56, 0x9f,
+	0x8a, 0x67, 0x80, 0x91, 0xb5, 0x05, 0x09, 0xd5, 0xa2, 0xda, 0x3a, 0x21, 0xd8, 0x70, 0x54, 0x06,
+	0xf1, 0x22, 0x95, 0x5a, 0x88, 0x44, 0x3b, 0xd5, 0x01, 0xda, 0xd1, 0xa8, 0x72, 0x70, 0x17, 0x19,
+	0x89, 0xd6, 0x11, 0x31, 0x12, 0xf0, 0x44, 0xa1, 0x0f, 0x43, 0x5c, 0x10, 0xc7, 0xa5, 0x1a, 0xdc,
+	0xfb, 0x54, 0x0d, 0x2a, 0x7d, 0xf5, 0x6a, 0xfa, 0xea, 0x22, 0x23, 0x2b, 0x54, 0x64, 0x64, 0x50,
+	0x66, 0x46, 0xc3, 0x94, 0x69, 0x01, 0x11, 0xed, 0xfc, 0x77, 0x8a, 0xf9, 0xb3, 0xa1, 0x6f, 0xd0,
+	0xe7, 0x92, 0xca, 0x40, 0x48, 0xb8, 0x0f, 0xef, 0x22, 0xa4, 0xd2, 0xb8, 0x3c, 0x8d, 0xa5, 0x52,
+	0x8b, 0xa9, 0xd5, 0x02, 0xe8, 0x29, 0x80, 0xa4, 0x32, 0xf1, 0x04, 0xed, 0xfe, 0x94, 0xb2, 0x94,
+	0x15, 0xe1, 0x0d, 0x15, 0xde, 0x5b, 0xe5, 0x36, 0x52, 0xb0, 0x8e, 0xaf, 0xd9, 0xf8, 0x4b, 0xd4,
+	0xa3, 0x89, 0x3b, 0x0f, 0x32, 0xe6, 0x39, 0x90, 0x46, 0xe8, 0x1b, 0x6d, 0x3a, 0x58, 0xe5, 0xf6,
+	0x5e, 0x39, 0x02, 0x25, 0x09, 0xf2, 0xbe, 0x8b, 0x5f, 0xa2, 0x9b, 0x09, 0x7b, 0x95, 0xc6, 0x1e,
+	0xf3, 0x9c, 0x90, 0x0a, 0xe9, 0x68, 0x99, 0xea, 0x1f, 0x9b, 0x2a, 0xc9, 0xe1, 0x2a, 0xb7, 0x6f,
+	0x94, 0x41, 0x4f, 0xa9, 0x90, 0x53, 0x08, 0xd1, 0x45, 0x7c, 0x00, 0x87, 0xb4, 0x6c, 0xc9, 0xdc,
+	0x54, 0x5e, 0x96, 0x76, 0xbb, 0x4a, 0x5b, 0x06, 0x6d, 0xa6, 0xbd, 0x1c, 0x1f, 0x3d, 0x44, 0xbd,
+	0x99, 0xf7, 0x28, 0xf6, 0x5e, 0x04, 0x11, 0x13, 0x92, 0x46, 0x0b, 0xd0, 0x53, 0xe0, 0x69, 0x42,
+	0x49, 0x23, 0xf0, 0xf0, 0xff, 0x50, 0x47, 0x96, 0x83, 0x9a, 0x38, 0x52, 0x01, 0xa3, 0x07, 0x68,
+	0x7b, 0xf6, 0xf8, 0x39, 0x93, 0xf8, 0x3e, 0x6a, 0x06, 0x1e, 0xdc, 0x62, 0x70, 0x5d, 0xfc, 0x7f,
+	0x7c, 0xe1, 0xd5, 0x35, 0x7e, 0xff, 0x37, 0x04, 0xa2, 0x47, 0xe7, 0x06, 0xea, 0x4f, 0xe1, 0x55,
+	0xf4, 0x15, 0x63, 0xe2, 0x87, 0x40, 0xce, 0x67, 0x9e, 0xc0, 0x0f, 0x10, 0x92, 0x5c, 0xd2, 0xd0,
+	0x79, 0xc5, 0x58, 0x79, 0x2d, 0xde, 0x7a, 0x93, 0xdb, 0x5b, 0x7f, 0xe7, 0xf6, 0x81, 0xcb, 0x45,
+	0xc4, 0x85, 0xf0, 0x5e, 0x8f, 0x03, 0x3e, 0x89, 0xa8, 0x9c, 0x8f, 0x67, 0xb0, 0xd3, 0x6a, 0x02,
+	0x24, 0xc1, 0x7d, 0x5d, 0x07, 0x3c, 0x9e, 0x4c, 0xf5, 0x13, 0x7c, 0x82, 0x4c, 0x95, 0xa9, 0x79,
+	0xd4, 0xbc, 0x3a, 0x93, 0x0a, 0xc5, 0x36, 0xda, 0x65, 0xcb, 0x45, 0x90, 0x30, 0xcf, 0x09, 0x3c,
+	0x7d, 0x07, 0x9a, 0x04, 0x15, 0x10, 0xd4, 0xf8, 0x39, 0x1a, 0xe8, 0x1a, 0xeb, 0xaa, 0x52, 0xbb,
+	0x40, 0xf6, 0xd5, 0xc0, 0xf7, 0x6b, 0x29, 0x4d, 0xa7, 0x6f, 0xce, 0x87, 0xc6, 0xdb, 0xf3, 0xa1,
+	0xf1, 0xcf, 0xf9, 0xd0, 0xf8, 0xf5, 0xdd, 0x70, 0xeb, 0xed, 0xbb, 0xe1, 0xd6, 0x5f, 0xef, 0x86,
+	0x5b, 0x3f, 0x1e, 0x6b, 0x96, 0xbe, 0x70, 0x79, 0xc2, 0x26, 0xa5, 0x0d, 0x67, 0xd0, 0x64, 0xb9,
+	0x7e, 0xce, 0xaa, 0xb7, 0xec, 0x59, 0x4b, 0xbd, 0x43, 0xef, 0xff, 0x3b, 0x00, 0x2c, 0xcb, 0x1c,
+	0xed, 0xed, 0x0a, 0x00, 0x00,
 }
 
 func (m *CronCallBackData) Marshal() (dAtA []byte, err error) {
@@ -909,6 +991,64 @@ func (m *CronCallBackData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronMatchedLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronMatchedLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronMatchedLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CronTransactionResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1536,6 +1676,36 @@ func (m *CronCallBackData) Size() (n int) {
 	return n
 }
 
+func (m *CronMatchedLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTypes(uint64(m.LogIndex))
+	}
+	return n
+}
+
 func (m *CronTransactionResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1952,6 +2122,205 @@ func (m *CronCallBackData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CronMatchedLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronMatchedLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronMatchedLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CronTransactionResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
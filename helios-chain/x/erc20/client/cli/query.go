@@ -24,6 +24,9 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetAssetPricesCmd(),
+		GetFeeTokensCmd(),
+		GetFeeTokenPreferenceCmd(),
 	)
 	return cmd
 }
@@ -126,3 +129,95 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAssetPricesCmd queries the price table of the whitelisted assets
+func GetAssetPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset-prices",
+		Short: "Gets the prices of the whitelisted assets",
+		Long:  "Gets the prices of the whitelisted assets used to derive their effective weights",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAssetPricesRequest{}
+
+			res, err := queryClient.AssetPrices(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeTokensCmd queries the tokens accepted to pay the fees of the Ethereum transactions
+func GetFeeTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-tokens",
+		Short: "Gets the fee tokens",
+		Long:  "Gets the tokens accepted to pay the fees of the Ethereum transactions along with their conversion rates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeTokensRequest{}
+
+			res, err := queryClient.FeeTokens(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeTokenPreferenceCmd queries the fee token chosen by an account
+func GetFeeTokenPreferenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token-preference ADDRESS",
+		Short: "Gets the fee token chosen by an account",
+		Long:  "Gets the fee token chosen by an account, an empty denom means the account pays its fees with the EVM denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeTokenPreferenceRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.FeeTokenPreference(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -30,6 +30,7 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewConvertERC20Cmd(),
+		NewSetFeeTokenPreferenceCmd(),
 	)
 	return txCmd
 }
@@ -80,3 +81,35 @@ func NewConvertERC20Cmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetFeeTokenPreferenceCmd returns a CLI command handler for choosing the token the
+// fees of the Ethereum transactions of the sender are paid with
+func NewSetFeeTokenPreferenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-token-preference [DENOM]",
+		Short: "Pay the fees of your Ethereum transactions with a fee token. When the denom [optional] is omitted, the fees are paid with the EVM denom again.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetFeeTokenPreference{
+				Sender: cliCtx.GetFromAddress().String(),
+			}
+			if len(args) == 1 {
+				msg.Denom = args[0]
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -65,6 +65,22 @@ func InitGenesis(
 		// }
 
 	}
+
+	for _, price := range data.AssetPrices {
+		k.SetAssetPrice(ctx, price)
+	}
+
+	for _, feeToken := range data.FeeTokens {
+		k.SetFeeToken(ctx, feeToken)
+	}
+
+	for _, preference := range data.FeeTokenPreferences {
+		k.SetAccountFeeToken(ctx, sdk.MustAccAddressFromBech32(preference.Address), preference.Denom)
+	}
+
+	for _, creatorToken := range data.CreatorTokens {
+		k.SetCreatorToken(ctx, creatorToken)
+	}
 }
 
 func addTokenToConsensusWhitelist(ctx sdk.Context, k keeper.Keeper, pair types.TokenPair, bankKeeper bankkeeper.Keeper) {
@@ -143,7 +159,11 @@ func createHeliosMetadata(ctx sdk.Context, k keeper.Keeper, pair types.TokenPair
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
+		Params:              k.GetParams(ctx),
+		TokenPairs:          k.GetTokenPairs(ctx),
+		AssetPrices:         k.GetAssetPrices(ctx),
+		FeeTokens:           k.GetFeeTokens(ctx),
+		FeeTokenPreferences: k.GetFeeTokenPreferences(ctx),
+		CreatorTokens:       k.GetCreatorTokens(ctx),
 	}
 }
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"helios-core/helios-chain/x/erc20/types"
+)
+
+// GetAssetPrices returns the price table of the whitelisted assets.
+func (k Keeper) GetAssetPrices(ctx sdk.Context) []types.AssetPrice {
+	prices := []types.AssetPrice{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixAssetPrice)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.AssetPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// GetAssetPrice returns the price of a whitelisted asset
+func (k Keeper) GetAssetPrice(ctx sdk.Context, denom string) (types.AssetPrice, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.AssetPrice{}, false
+	}
+
+	var price types.AssetPrice
+	k.cdc.MustUnmarshal(bz, &price)
+	return price, true
+}
+
+// SetAssetPrice stores the price of a whitelisted asset, the price is used to derive the
+// effective weight of the asset at the end of the next dynamic weights epoch.
+// It is exposed to the price sources other than governance, e.g. bridge attestations.
+func (k Keeper) SetAssetPrice(ctx sdk.Context, price types.AssetPrice) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	store.Set([]byte(price.Denom), k.cdc.MustMarshal(&price))
+}
+
+// DeleteAssetPrice removes the price of a whitelisted asset, its effective weight goes
+// back to its base weight at the end of the next dynamic weights epoch.
+func (k Keeper) DeleteAssetPrice(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	store.Delete([]byte(denom))
+}
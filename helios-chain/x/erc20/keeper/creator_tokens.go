@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"helios-core/helios-chain/x/erc20/types"
+)
+
+// GetCreatorTokens returns the admin controls of the tokens created from the
+// ERC20Creator templates.
+func (k Keeper) GetCreatorTokens(ctx sdk.Context) []types.CreatorToken {
+	creatorTokens := []types.CreatorToken{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixCreatorToken)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var creatorToken types.CreatorToken
+		k.cdc.MustUnmarshal(iterator.Value(), &creatorToken)
+
+		creatorTokens = append(creatorTokens, creatorToken)
+	}
+
+	return creatorTokens
+}
+
+// GetCreatorToken returns the admin controls of the token with the given denom
+func (k Keeper) GetCreatorToken(ctx sdk.Context, denom string) (types.CreatorToken, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCreatorToken)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.CreatorToken{}, false
+	}
+
+	var creatorToken types.CreatorToken
+	k.cdc.MustUnmarshal(bz, &creatorToken)
+	return creatorToken, true
+}
+
+// SetCreatorToken stores the admin controls of a token
+func (k Keeper) SetCreatorToken(ctx sdk.Context, creatorToken types.CreatorToken) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCreatorToken)
+	store.Set([]byte(creatorToken.Denom), k.cdc.MustMarshal(&creatorToken))
+}
+
+// MintCreatorToken mints new tokens to the recipient. Only the admin can mint, and only
+// while the token is mintable, not paused and below its supply cap.
+//
+// The ERC20 contract of the token is served by its dynamic precompile, which reads the
+// bank balances and supply, so minting the bank coins is enough to keep both views in sync.
+func (k Keeper) MintCreatorToken(
+	ctx sdk.Context,
+	caller, recipient sdk.AccAddress,
+	denom string,
+	amount math.Int,
+) error {
+	creatorToken, err := k.getAdminCreatorToken(ctx, caller, denom)
+	if err != nil {
+		return err
+	}
+	if !creatorToken.Mintable {
+		return errorsmod.Wrapf(types.ErrCreatorTokenNotAllowed, "%s is not mintable", denom)
+	}
+	if creatorToken.Paused {
+		return errorsmod.Wrapf(types.ErrCreatorTokenPaused, "cannot mint %s", denom)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "mint amount must be positive: %s", amount)
+	}
+
+	if creatorToken.IsCapped() {
+		supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+		if supply.Add(amount).GT(creatorToken.SupplyCap) {
+			return errorsmod.Wrapf(
+				types.ErrCreatorTokenCapReached,
+				"supply %s + %s exceeds the cap %s of %s", supply, amount, creatorToken.SupplyCap, denom,
+			)
+		}
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(denom, amount)}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatorTokenMint,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyReceiver, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// BurnCreatorToken burns tokens of the holder, any holder can burn its own tokens while
+// the token is burnable and not paused.
+func (k Keeper) BurnCreatorToken(
+	ctx sdk.Context,
+	holder sdk.AccAddress,
+	denom string,
+	amount math.Int,
+) error {
+	creatorToken, found := k.GetCreatorToken(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCreatorTokenNotFound, "denom '%s'", denom)
+	}
+	if !creatorToken.Burnable {
+		return errorsmod.Wrapf(types.ErrCreatorTokenNotAllowed, "%s is not burnable", denom)
+	}
+	if creatorToken.Paused {
+		return errorsmod.Wrapf(types.ErrCreatorTokenPaused, "cannot burn %s", denom)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "burn amount must be positive: %s", amount)
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(denom, amount)}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatorTokenBurn,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAccount, holder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// SetCreatorTokenAdmin hands the admin role of the token over to a new admin, an empty
+// new admin renounces the role for good.
+func (k Keeper) SetCreatorTokenAdmin(
+	ctx sdk.Context,
+	caller sdk.AccAddress,
+	denom string,
+	newAdmin sdk.AccAddress,
+) error {
+	creatorToken, err := k.getAdminCreatorToken(ctx, caller, denom)
+	if err != nil {
+		return err
+	}
+
+	creatorToken.Admin = ""
+	if !newAdmin.Empty() {
+		creatorToken.Admin = newAdmin.String()
+	}
+	k.SetCreatorToken(ctx, creatorToken)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatorTokenSetAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAdmin, creatorToken.Admin),
+		),
+	)
+
+	return nil
+}
+
+// SetCreatorTokenPaused pauses or resumes the transfers of a pausable token.
+func (k Keeper) SetCreatorTokenPaused(
+	ctx sdk.Context,
+	caller sdk.AccAddress,
+	denom string,
+	paused bool,
+) error {
+	creatorToken, err := k.getAdminCreatorToken(ctx, caller, denom)
+	if err != nil {
+		return err
+	}
+	if !creatorToken.Pausable {
+		return errorsmod.Wrapf(types.ErrCreatorTokenNotAllowed, "%s is not pausable", denom)
+	}
+
+	creatorToken.Paused = paused
+	k.SetCreatorToken(ctx, creatorToken)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreatorTokenSetPaused,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(paused)),
+		),
+	)
+
+	return nil
+}
+
+// CreatorTokenSendRestriction is a bank send restriction rejecting the transfers of the
+// paused creator tokens. It covers the bank sends as well as the ERC20 transfers, which
+// are served from the bank balances by the dynamic precompiles.
+func (k Keeper) CreatorTokenSendRestriction(
+	goCtx context.Context,
+	_, to sdk.AccAddress,
+	amt sdk.Coin,
+) (sdk.AccAddress, error) {
+	creatorToken, found := k.GetCreatorToken(sdk.UnwrapSDKContext(goCtx), amt.Denom)
+	if found && creatorToken.Paused {
+		return to, errorsmod.Wrapf(types.ErrCreatorTokenPaused, "cannot transfer %s", amt.Denom)
+	}
+	return to, nil
+}
+
+// getAdminCreatorToken returns the creator token of the denom if the caller is its admin
+func (k Keeper) getAdminCreatorToken(ctx sdk.Context, caller sdk.AccAddress, denom string) (types.CreatorToken, error) {
+	creatorToken, found := k.GetCreatorToken(ctx, denom)
+	if !found {
+		return types.CreatorToken{}, errorsmod.Wrapf(types.ErrCreatorTokenNotFound, "denom '%s'", denom)
+	}
+	if !creatorToken.IsAdmin(caller) {
+		return types.CreatorToken{}, errorsmod.Wrapf(types.ErrCreatorTokenNotAdmin, "%s is not the admin of %s", caller, denom)
+	}
+	return creatorToken, nil
+}
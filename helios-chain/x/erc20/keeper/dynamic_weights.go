@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "helios-core/helios-chain/x/epochs/types"
+	"helios-core/helios-chain/x/erc20/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks wrapper struct for the erc20 keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct for erc20 epoch hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeEpochStart is a no-op for the erc20 module
+func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochEnd recomputes the effective weights of the whitelisted assets at the end
+// of the dynamic weights epoch, the update is discarded when it fails.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	dynamicWeightsEpoch := h.k.GetDynamicWeightsEpoch(ctx)
+	if dynamicWeightsEpoch == "" || dynamicWeightsEpoch != epochIdentifier {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := h.k.UpdateEffectiveWeights(cacheCtx); err != nil {
+		h.k.Logger(ctx).Error("failed to update the effective weights", "epoch", epochNumber, "error", err)
+		return
+	}
+	writeCache()
+}
+
+// assetPower is the amount staked of an asset and the voting power it confers
+type assetPower struct {
+	baseAmount     math.Int
+	weightedAmount math.Int
+}
+
+// getAssetPowers returns the amounts staked of each asset across the validators and the
+// total voting power conferred by all the assets.
+func (k Keeper) getAssetPowers(ctx sdk.Context) (map[string]assetPower, math.Int, error) {
+	validators, err := k.stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return nil, math.Int{}, err
+	}
+
+	powers := make(map[string]assetPower)
+	total := math.ZeroInt()
+	for _, validator := range validators {
+		for _, weight := range validator.TotalAssetWeights {
+			power, found := powers[weight.Denom]
+			if !found {
+				power = assetPower{baseAmount: math.ZeroInt(), weightedAmount: math.ZeroInt()}
+			}
+			power.baseAmount = power.baseAmount.Add(weight.BaseAmount)
+			power.weightedAmount = power.weightedAmount.Add(weight.WeightedAmount)
+			powers[weight.Denom] = power
+			total = total.Add(weight.WeightedAmount)
+		}
+	}
+	return powers, total, nil
+}
+
+// UpdateEffectiveWeights derives the effective weight of each active whitelisted asset
+// from its base weight scaled by its price and capped by its maximum share of the total
+// voting power, the delegations of the assets whose weight changed are rescaled.
+// The voting powers are taken before any update so the result doesn't depend on the order of the assets.
+func (k Keeper) UpdateEffectiveWeights(ctx sdk.Context) error {
+	powers, totalPower, err := k.getAssetPowers(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get the asset voting powers")
+	}
+
+	for _, asset := range k.GetAllWhitelistedAssets(ctx) {
+		if asset.Archived {
+			continue
+		}
+
+		target := asset.BaseWeight
+		if price, found := k.GetAssetPrice(ctx, asset.Denom); found {
+			target = price.TargetWeight(asset.BaseWeight)
+		}
+
+		maxShare, err := types.ParsePowerShare(asset.MaxPowerShare)
+		if err != nil {
+			return errorsmod.Wrapf(err, "asset %s", asset.Denom)
+		}
+		if power, found := powers[asset.Denom]; found {
+			target = types.CapWeight(target, maxShare, power.baseAmount, totalPower.Sub(power.weightedAmount))
+		}
+
+		if err := k.setEffectiveWeight(ctx, asset, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setEffectiveWeight stores the effective weight of an asset and rescales its delegations
+// by the ratio between the new and the current weight.
+func (k Keeper) setEffectiveWeight(ctx sdk.Context, asset types.Asset, weight uint64) error {
+	current := asset.ConsensusWeight()
+	if weight == current || current == 0 {
+		return nil
+	}
+
+	currentDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(current))
+	weightDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(weight))
+	increase := weight > current
+	percentage := weightDec.Sub(currentDec).Abs().Quo(currentDec)
+
+	asset.EffectiveWeight = weight
+	if err := k.UpdateAssetInConsensusWhitelist(ctx, asset); err != nil {
+		return errorsmod.Wrapf(err, "failed to update asset %s in whitelist", asset.Denom)
+	}
+
+	if err := k.UpdateAssetNativeSharesWeight(ctx, asset.Denom, percentage, increase, 0); err != nil {
+		return errorsmod.Wrapf(err, "failed to update native delegation shares weight for asset %s", asset.Denom)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateEffectiveWeight,
+			sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+			sdk.NewAttribute(types.AttributeKeyBaseWeight, strconv.FormatUint(asset.BaseWeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyEffectiveWeight, strconv.FormatUint(weight, 10)),
+		),
+	)
+	return nil
+}
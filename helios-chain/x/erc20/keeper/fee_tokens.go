@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"helios-core/helios-chain/x/erc20/types"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+// GetFeeTokens returns the tokens accepted to pay the fees of the Ethereum transactions.
+func (k Keeper) GetFeeTokens(ctx sdk.Context) []types.FeeToken {
+	feeTokens := []types.FeeToken{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixFeeToken)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var feeToken types.FeeToken
+		k.cdc.MustUnmarshal(iterator.Value(), &feeToken)
+
+		feeTokens = append(feeTokens, feeToken)
+	}
+
+	return feeTokens
+}
+
+// GetFeeToken returns the fee token of the given denom
+func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (types.FeeToken, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.FeeToken{}, false
+	}
+
+	var feeToken types.FeeToken
+	k.cdc.MustUnmarshal(bz, &feeToken)
+	return feeToken, true
+}
+
+// SetFeeToken stores a fee token along with its conversion rate
+func (k Keeper) SetFeeToken(ctx sdk.Context, feeToken types.FeeToken) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+	store.Set([]byte(feeToken.Denom), k.cdc.MustMarshal(&feeToken))
+}
+
+// DeleteFeeToken removes a fee token. The preferences of the accounts pointing to it are
+// kept but ignored, those accounts pay their fees with the EVM denom again.
+func (k Keeper) DeleteFeeToken(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+	store.Delete([]byte(denom))
+}
+
+// GetFeeTokenPreferences returns the fee tokens chosen by the accounts.
+func (k Keeper) GetFeeTokenPreferences(ctx sdk.Context) []types.FeeTokenPreference {
+	preferences := []types.FeeTokenPreference{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixFeeTokenPref)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.AccAddress(iterator.Key()[len(types.KeyPrefixFeeTokenPref):])
+		preferences = append(preferences, types.FeeTokenPreference{
+			Address: address.String(),
+			Denom:   string(iterator.Value()),
+		})
+	}
+
+	return preferences
+}
+
+// GetAccountFeeToken returns the denom of the fee token chosen by the account
+func (k Keeper) GetAccountFeeToken(ctx sdk.Context, address sdk.AccAddress) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenPref)
+	bz := store.Get(address.Bytes())
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetAccountFeeToken stores the fee token chosen by the account
+func (k Keeper) SetAccountFeeToken(ctx sdk.Context, address sdk.AccAddress, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenPref)
+	store.Set(address.Bytes(), []byte(denom))
+}
+
+// DeleteAccountFeeToken removes the fee token chosen by the account
+func (k Keeper) DeleteAccountFeeToken(ctx sdk.Context, address sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenPref)
+	store.Delete(address.Bytes())
+}
+
+// GetFeePayment returns how the payer pays the fees of its Ethereum transactions. It
+// returns false when the payer pays with the EVM denom, either because it did not opt in
+// or because its fee token has been removed or its token pair disabled since.
+func (k Keeper) GetFeePayment(ctx sdk.Context, payer sdk.AccAddress) (evmtypes.FeePayment, bool) {
+	denom, found := k.GetAccountFeeToken(ctx, payer)
+	if !found {
+		return evmtypes.FeePayment{}, false
+	}
+
+	feeToken, found := k.GetFeeToken(ctx, denom)
+	if !found {
+		return evmtypes.FeePayment{}, false
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, denom))
+	if !found || !pair.Enabled {
+		return evmtypes.FeePayment{}, false
+	}
+
+	return feeToken.FeePayment(), true
+}
+
+// DeductFees sends the fee token equivalent of the given EVM denom fees from the payer to
+// the fee collector. It returns the deducted coins.
+func (k Keeper) DeductFees(ctx sdk.Context, payment evmtypes.FeePayment, payer sdk.AccAddress, fees *big.Int) (sdk.Coins, error) {
+	coins := sdk.Coins{sdk.NewCoin(payment.Denom, payment.ConvertFee(fees))}
+	if coins.IsZero() {
+		return sdk.Coins{}, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, coins); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to deduct fees of %s in %s", payer, payment.Denom)
+	}
+
+	return coins, nil
+}
@@ -149,3 +149,53 @@ func (k Keeper) WhitelistedAssets(c context.Context, req *types.QueryWhitelisted
 		Pagination: pageRes,
 	}, nil
 }
+
+// AssetPrices returns the price table of the whitelisted assets
+func (k Keeper) AssetPrices(c context.Context, req *types.QueryAssetPricesRequest) (*types.QueryAssetPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAssetPricesResponse{
+		Prices: k.GetAssetPrices(ctx),
+	}, nil
+}
+
+// FeeTokens returns the tokens accepted to pay the fees of the Ethereum transactions
+func (k Keeper) FeeTokens(c context.Context, req *types.QueryFeeTokensRequest) (*types.QueryFeeTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeTokensResponse{
+		FeeTokens: k.GetFeeTokens(ctx),
+	}, nil
+}
+
+// FeeTokenPreference returns the fee token chosen by an account
+func (k Keeper) FeeTokenPreference(c context.Context, req *types.QueryFeeTokenPreferenceRequest) (*types.QueryFeeTokenPreferenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var address sdk.AccAddress
+	if common.IsHexAddress(req.Address) {
+		address = common.HexToAddress(req.Address).Bytes()
+	} else {
+		var err error
+		if address, err = sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, _ := k.GetAccountFeeToken(ctx, address)
+
+	return &types.QueryFeeTokenPreferenceResponse{
+		Denom: denom,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	transferkeeper "helios-core/helios-chain/x/ibc/transfer/keeper"
+	tokenfactorykeeper "helios-core/helios-chain/x/tokenfactory/keeper"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -29,6 +30,9 @@ type Keeper struct {
 	stakingKeeper  types.StakingKeeper
 	authzKeeper    authzkeeper.Keeper
 	transferKeeper *transferkeeper.Keeper
+	// tokenFactoryKeeper serves the mint and burn methods of the ERC20 precompiles
+	// of the tokenfactory denoms
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -74,3 +78,8 @@ func (k *Keeper) GetStore(ctx sdk.Context) storetypes.KVStore {
 func (k *Keeper) SetTransferKeeper(tk *transferkeeper.Keeper) {
 	k.transferKeeper = tk
 }
+
+// SetTokenFactoryKeeper sets the tokenfactory keeper
+func (k *Keeper) SetTokenFactoryKeeper(tfk *tokenfactorykeeper.Keeper) {
+	k.tokenFactoryKeeper = tfk
+}
@@ -17,6 +17,7 @@ import (
 
 	"helios-core/helios-chain/contracts"
 	"helios-core/helios-chain/x/erc20/types"
+	evmtypes "helios-core/helios-chain/x/evm/types"
 )
 
 var _ types.MsgServer = &Keeper{}
@@ -517,11 +518,174 @@ func applyWeightAdjustment(asset types.Asset, direction string, adjustmentFactor
 	switch direction {
 	case "up":
 		asset.BaseWeight += uint64(float64(asset.BaseWeight) * adjustmentFactor)
+		// the effective weight follows the delegations rescaled by the same factor
+		asset.EffectiveWeight += uint64(float64(asset.EffectiveWeight) * adjustmentFactor)
 		increaseWeight = true
 	case "down":
 		asset.BaseWeight -= uint64(float64(asset.BaseWeight) * adjustmentFactor)
+		asset.EffectiveWeight -= uint64(float64(asset.EffectiveWeight) * adjustmentFactor)
 	default:
 		return asset, false, errorsmod.Wrapf(types.ErrInvalidAssetQuery, "invalid direction: %s", direction)
 	}
 	return asset, increaseWeight, nil
 }
+
+// SetAssetPrices implements the gRPC MsgServer interface for setting the prices
+// of the whitelisted assets via governance
+func (k *Keeper) SetAssetPrices(goCtx context.Context, msg *types.MsgSetAssetPrices) (*types.MsgSetAssetPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, price := range msg.Prices {
+		if !k.IsAssetWhitelisted(ctx, price.Denom) {
+			return nil, errorsmod.Wrapf(types.ErrAssetNotFound, "asset %s is not whitelisted", price.Denom)
+		}
+
+		price.UpdatedHeight = ctx.BlockHeight()
+		k.SetAssetPrice(ctx, price)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetAssetPrice,
+				sdk.NewAttribute(types.AttributeKeyDenom, price.Denom),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.Price.String()),
+				sdk.NewAttribute(types.AttributeKeyReferencePrice, price.ReferencePrice.String()),
+			),
+		)
+	}
+
+	return &types.MsgSetAssetPricesResponse{}, nil
+}
+
+// SetAssetPowerCaps implements the gRPC MsgServer interface for setting the maximum
+// share of the total voting power of the whitelisted assets via governance
+func (k *Keeper) SetAssetPowerCaps(goCtx context.Context, msg *types.MsgSetAssetPowerCaps) (*types.MsgSetAssetPowerCapsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, powerCap := range msg.Caps {
+		asset, err := k.GetAssetFromWhitelist(ctx, powerCap.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		asset.MaxPowerShare = powerCap.MaxPowerShare
+		if err := k.UpdateAssetInConsensusWhitelist(ctx, asset); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to update asset %s in whitelist", powerCap.Denom)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetAssetPowerCap,
+				sdk.NewAttribute(types.AttributeKeyDenom, powerCap.Denom),
+				sdk.NewAttribute(types.AttributeKeyMaxPowerShare, powerCap.MaxPowerShare),
+			),
+		)
+	}
+
+	return &types.MsgSetAssetPowerCapsResponse{}, nil
+}
+
+// SetFeeTokens implements the gRPC MsgServer interface for setting the tokens accepted to
+// pay the fees of the Ethereum transactions via governance
+func (k *Keeper) SetFeeTokens(goCtx context.Context, msg *types.MsgSetFeeTokens) (*types.MsgSetFeeTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, feeToken := range msg.FeeTokens {
+		if feeToken.Denom == evmtypes.GetEVMCoinDenom() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "fee token %s is the EVM denom", feeToken.Denom)
+		}
+
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, feeToken.Denom))
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "fee token %s", feeToken.Denom)
+		}
+		if !pair.Enabled {
+			return nil, errorsmod.Wrapf(types.ErrERC20TokenPairDisabled, "fee token %s", feeToken.Denom)
+		}
+		// the fees are collected by the bank module, the ERC20 balances of the token pairs
+		// owned by external contracts are out of its reach
+		if !pair.IsNativeCoin() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "fee token %s must be a native coin", feeToken.Denom)
+		}
+
+		k.SetFeeToken(ctx, feeToken)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetFeeToken,
+				sdk.NewAttribute(types.AttributeKeyDenom, feeToken.Denom),
+				sdk.NewAttribute(types.AttributeKeyConversionRate, feeToken.ConversionRate.String()),
+			),
+		)
+	}
+
+	return &types.MsgSetFeeTokensResponse{}, nil
+}
+
+// RemoveFeeTokens implements the gRPC MsgServer interface for removing fee tokens
+// via governance
+func (k *Keeper) RemoveFeeTokens(goCtx context.Context, msg *types.MsgRemoveFeeTokens) (*types.MsgRemoveFeeTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, denom := range msg.Denoms {
+		if _, found := k.GetFeeToken(ctx, denom); !found {
+			return nil, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", denom)
+		}
+
+		k.DeleteFeeToken(ctx, denom)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveFeeToken,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			),
+		)
+	}
+
+	return &types.MsgRemoveFeeTokensResponse{}, nil
+}
+
+// SetFeeTokenPreference implements the gRPC MsgServer interface for choosing the token
+// the sender pays the fees of its Ethereum transactions with
+func (k *Keeper) SetFeeTokenPreference(goCtx context.Context, msg *types.MsgSetFeeTokenPreference) (*types.MsgSetFeeTokenPreferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Denom == "" {
+		k.DeleteAccountFeeToken(ctx, sender)
+	} else {
+		if _, found := k.GetFeeToken(ctx, msg.Denom); !found {
+			return nil, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", msg.Denom)
+		}
+		k.SetAccountFeeToken(ctx, sender, msg.Denom)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeTokenPreference,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+	)
+
+	return &types.MsgSetFeeTokenPreferenceResponse{}, nil
+}
@@ -14,7 +14,9 @@ const addressLength = 42
 // GetParams returns the total set of erc20 parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
-	return types.NewParams(enableErc20, []string{}, []string{})
+	params = types.NewParams(enableErc20, []string{}, []string{})
+	params.DynamicWeightsEpoch = k.GetDynamicWeightsEpoch(ctx)
+	return params
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -25,6 +27,7 @@ func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
 
 	// Direct storage without expensive operations
 	k.setERC20Enabled(ctx, newParams.EnableErc20)
+	k.setDynamicWeightsEpoch(ctx, newParams.DynamicWeightsEpoch)
 
 	return nil
 }
@@ -45,6 +48,23 @@ func (k Keeper) setERC20Enabled(ctx sdk.Context, enable bool) {
 	store.Delete(types.ParamStoreKeyEnableErc20)
 }
 
+// GetDynamicWeightsEpoch returns the epoch identifier at the end of which the effective
+// weights are recomputed, an empty identifier means the dynamic weights are disabled
+func (k Keeper) GetDynamicWeightsEpoch(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.ParamStoreKeyDynamicWeightsEpoch))
+}
+
+// setDynamicWeightsEpoch sets the DynamicWeightsEpoch param in the store
+func (k Keeper) setDynamicWeightsEpoch(ctx sdk.Context, epochIdentifier string) {
+	store := ctx.KVStore(k.storeKey)
+	if epochIdentifier == "" {
+		store.Delete(types.ParamStoreKeyDynamicWeightsEpoch)
+		return
+	}
+	store.Set(types.ParamStoreKeyDynamicWeightsEpoch, []byte(epochIdentifier))
+}
+
 func (k Keeper) IsDynamicPrecompileEnabled(ctx sdk.Context, address common.Address) bool {
 	store := ctx.KVStore(k.storeKey)
 	exists := store.Has(append(types.ParamStoreKeyDynamicPrecompilePrefix, address.Bytes()...))
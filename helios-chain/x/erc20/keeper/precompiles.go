@@ -6,6 +6,8 @@ import (
 	"helios-core/helios-chain/precompiles/erc20"
 	"helios-core/helios-chain/precompiles/werc20"
 	"helios-core/helios-chain/x/evm/core/vm"
+	tokenfactorykeeper "helios-core/helios-chain/x/tokenfactory/keeper"
+	tokenfactorytypes "helios-core/helios-chain/x/tokenfactory/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -51,7 +53,17 @@ func (k Keeper) InstantiateERC20Precompile(ctx sdk.Context, contractAddr common.
 		return werc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
 	}
 
-	return erc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+	precompile, err := erc20.NewPrecompile(pair, k.bankKeeper, k.authzKeeper, *k.transferKeeper)
+	if err != nil {
+		return nil, err
+	}
+
+	// the admin of a tokenfactory denom can mint and burn through its ERC20 precompile
+	if _, _, err := tokenfactorytypes.DeconstructDenom(pair.Denom); err == nil && k.tokenFactoryKeeper != nil {
+		precompile.WithTokenFactory(tokenfactorykeeper.NewMsgServerImpl(*k.tokenFactoryKeeper))
+	}
+
+	return precompile, nil
 }
 
 // IsAvailableERC20Precompile returns true if the given precompile address
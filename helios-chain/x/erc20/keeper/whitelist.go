@@ -30,8 +30,14 @@ func (k Keeper) AddAssetToConsensusWhitelist(ctx sdk.Context, asset types.Asset)
 		return errorsmod.Wrapf(err, "failed to check existence of asset %s", asset.Denom)
 	}
 
+	if _, err := types.ParsePowerShare(asset.MaxPowerShare); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "asset %s: %s", asset.Denom, err)
+	}
+
 	// making sure the asset is not archived by default received call from the proposal handler
 	asset.Archived = false
+	// the effective weight is only derived from the asset price by the dynamic weights
+	asset.EffectiveWeight = 0
 
 	// Asset does not exist, proceed to add it
 	store := k.GetStore(ctx)
@@ -96,7 +102,9 @@ func (k Keeper) ArchiveAssetInConsensusWhitelist(ctx sdk.Context, denom string)
 		return errorsmod.Wrapf(types.ErrAssetAlreadyArchived, "asset %s is already archived", denom)
 	}
 
-	originalWeight := asset.BaseWeight
+	originalWeight := asset.ConsensusWeight()
+	// the effective weight is derived again from the asset price once unarchived
+	asset.EffectiveWeight = 0
 	if originalWeight <= 1 {
 		// Cannot archive an asset with weight 1 or less, or already archived with weight 1.
 		// Mark as archived just in case, but no weight change needed.
@@ -162,6 +170,7 @@ func (k Keeper) UnarchiveAssetInConsensusWhitelist(ctx sdk.Context, denom string
 	// Update asset state
 	asset.Archived = false
 	asset.BaseWeight = newWeight
+	asset.EffectiveWeight = 0
 
 	// Save the updated asset state first
 	if err := k.UpdateAssetInConsensusWhitelist(ctx, asset); err != nil {
@@ -191,8 +200,10 @@ func (a assetAdapter) GetContractAddress() string {
 	return a.asset.ContractAddress
 }
 
+// GetBaseWeight returns the weight applied to the staked amounts of the asset,
+// which is the effective weight of the asset when it is derived from its price.
 func (a assetAdapter) GetBaseWeight() uint64 {
-	return a.asset.BaseWeight
+	return a.asset.ConsensusWeight()
 }
 
 // ConvertAssetsToErc20Assets converts Asset types to Erc20Asset interface types
@@ -160,9 +160,12 @@ func applyWeightAdjustment(asset types.Asset, direction string, adjustmentFactor
 	switch direction {
 	case "up":
 		asset.BaseWeight += uint64(float64(asset.BaseWeight) * adjustmentFactor)
+		// the effective weight follows the delegations rescaled by the same factor
+		asset.EffectiveWeight += uint64(float64(asset.EffectiveWeight) * adjustmentFactor)
 		increaseWeight = true
 	case "down":
 		asset.BaseWeight -= uint64(float64(asset.BaseWeight) * adjustmentFactor)
+		asset.EffectiveWeight -= uint64(float64(asset.EffectiveWeight) * adjustmentFactor)
 	default:
 		return asset, false, errorsmod.Wrapf(types.ErrInvalidAssetQuery, "invalid direction: %s", direction)
 	}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// ConsensusWeight returns the weight applied to the staked amounts of the asset,
+// the effective weight when it has been derived from the asset price and the base weight otherwise.
+func (a Asset) ConsensusWeight() uint64 {
+	if a.EffectiveWeight != 0 {
+		return a.EffectiveWeight
+	}
+	return a.BaseWeight
+}
+
+// ParsePowerShare parses the maximum share of the total voting power of an asset,
+// an empty share returns zero which disables the cap.
+func ParsePowerShare(share string) (math.LegacyDec, error) {
+	if strings.TrimSpace(share) == "" {
+		return math.LegacyZeroDec(), nil
+	}
+
+	dec, err := math.LegacyNewDecFromStr(share)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid max power share %s: %w", share, err)
+	}
+	if !dec.IsPositive() || dec.GT(math.LegacyOneDec()) {
+		return math.LegacyDec{}, fmt.Errorf("max power share must be within (0, 1]: %s", share)
+	}
+	return dec, nil
+}
+
+// Validate performs a stateless validation of the asset price
+func (p AssetPrice) Validate() error {
+	if strings.TrimSpace(p.Denom) == "" {
+		return fmt.Errorf("asset price denom cannot be empty")
+	}
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return fmt.Errorf("price of asset %s must be positive", p.Denom)
+	}
+	if p.ReferencePrice.IsNil() || !p.ReferencePrice.IsPositive() {
+		return fmt.Errorf("reference price of asset %s must be positive", p.Denom)
+	}
+	return nil
+}
+
+// TargetWeight returns the weight of an asset scaled by the ratio between its price
+// and its reference price, the weight never goes below 1.
+func (p AssetPrice) TargetWeight(baseWeight uint64) uint64 {
+	weight := math.LegacyNewDecFromInt(math.NewIntFromUint64(baseWeight)).
+		Mul(p.Price).
+		Quo(p.ReferencePrice).
+		TruncateInt()
+	if weight.LT(math.OneInt()) {
+		return 1
+	}
+	if !weight.IsUint64() {
+		return baseWeight
+	}
+	return weight.Uint64()
+}
+
+// Validate performs a stateless validation of the asset power cap
+func (c AssetPowerCap) Validate() error {
+	if strings.TrimSpace(c.Denom) == "" {
+		return fmt.Errorf("asset power cap denom cannot be empty")
+	}
+	_, err := ParsePowerShare(c.MaxPowerShare)
+	return err
+}
+
+// CapWeight returns the highest weight not exceeding the given one for which the staked
+// base amount of an asset confers at most maxShare of the total voting power, otherPower
+// being the voting power conferred by the other assets. The weight never goes below 1.
+func CapWeight(weight uint64, maxShare math.LegacyDec, baseAmount, otherPower math.Int) uint64 {
+	if !maxShare.IsPositive() || maxShare.GTE(math.LegacyOneDec()) {
+		return weight
+	}
+	// the asset confers the whole voting power whatever its weight
+	if !baseAmount.IsPositive() || !otherPower.IsPositive() {
+		return weight
+	}
+
+	// share = baseAmount*w / (baseAmount*w + otherPower) <= maxShare
+	// => w <= maxShare*otherPower / ((1-maxShare)*baseAmount)
+	maxWeight := maxShare.MulInt(otherPower).
+		Quo(math.LegacyOneDec().Sub(maxShare).MulInt(baseAmount)).
+		TruncateInt()
+	if maxWeight.LT(math.OneInt()) {
+		return 1
+	}
+	if maxWeight.IsUint64() && maxWeight.Uint64() < weight {
+		return maxWeight.Uint64()
+	}
+	return weight
+}
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+	"helios-core/helios-chain/x/erc20/types"
+)
+
+type AssetTestSuite struct {
+	suite.Suite
+}
+
+func TestAssetSuite(t *testing.T) {
+	suite.Run(t, new(AssetTestSuite))
+}
+
+func (suite *AssetTestSuite) TestConsensusWeight() {
+	asset := types.Asset{Denom: "usdt", BaseWeight: 10}
+	suite.Require().Equal(uint64(10), asset.ConsensusWeight())
+
+	asset.EffectiveWeight = 7
+	suite.Require().Equal(uint64(7), asset.ConsensusWeight())
+}
+
+func (suite *AssetTestSuite) TestParsePowerShare() {
+	testCases := []struct {
+		msg        string
+		share      string
+		expShare   math.LegacyDec
+		expectPass bool
+	}{
+		{msg: "empty share disables the cap", share: "", expShare: math.LegacyZeroDec(), expectPass: true},
+		{msg: "valid share", share: "0.25", expShare: math.LegacyNewDecWithPrec(25, 2), expectPass: true},
+		{msg: "whole voting power", share: "1", expShare: math.LegacyOneDec(), expectPass: true},
+		{msg: "zero share", share: "0", expectPass: false},
+		{msg: "negative share", share: "-0.1", expectPass: false},
+		{msg: "share above one", share: "1.5", expectPass: false},
+		{msg: "invalid share", share: "quarter", expectPass: false},
+	}
+
+	for _, tc := range testCases {
+		share, err := types.ParsePowerShare(tc.share)
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().True(tc.expShare.Equal(share), tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *AssetTestSuite) TestAssetPriceValidate() {
+	testCases := []struct {
+		msg        string
+		price      types.AssetPrice
+		expectPass bool
+	}{
+		{msg: "valid price", price: types.AssetPrice{Denom: "usdt", Price: math.LegacyOneDec(), ReferencePrice: math.LegacyOneDec()}, expectPass: true},
+		{msg: "empty denom", price: types.AssetPrice{Price: math.LegacyOneDec(), ReferencePrice: math.LegacyOneDec()}, expectPass: false},
+		{msg: "nil price", price: types.AssetPrice{Denom: "usdt", ReferencePrice: math.LegacyOneDec()}, expectPass: false},
+		{msg: "zero price", price: types.AssetPrice{Denom: "usdt", Price: math.LegacyZeroDec(), ReferencePrice: math.LegacyOneDec()}, expectPass: false},
+		{msg: "zero reference price", price: types.AssetPrice{Denom: "usdt", Price: math.LegacyOneDec(), ReferencePrice: math.LegacyZeroDec()}, expectPass: false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.price.Validate()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *AssetTestSuite) TestTargetWeight() {
+	testCases := []struct {
+		msg       string
+		price     math.LegacyDec
+		refPrice  math.LegacyDec
+		expWeight uint64
+	}{
+		{msg: "price at reference", price: math.LegacyNewDec(2000), refPrice: math.LegacyNewDec(2000), expWeight: 100},
+		{msg: "price doubled", price: math.LegacyNewDec(4000), refPrice: math.LegacyNewDec(2000), expWeight: 200},
+		{msg: "price dropped by a third", price: math.LegacyNewDec(1000), refPrice: math.LegacyNewDec(1500), expWeight: 66},
+		{msg: "weight never goes below one", price: math.LegacyNewDecWithPrec(1, 3), refPrice: math.LegacyNewDec(2000), expWeight: 1},
+	}
+
+	for _, tc := range testCases {
+		price := types.AssetPrice{Denom: "weth", Price: tc.price, ReferencePrice: tc.refPrice}
+		suite.Require().Equal(tc.expWeight, price.TargetWeight(100), tc.msg)
+	}
+}
+
+func (suite *AssetTestSuite) TestCapWeight() {
+	testCases := []struct {
+		msg        string
+		weight     uint64
+		maxShare   math.LegacyDec
+		baseAmount math.Int
+		otherPower math.Int
+		expWeight  uint64
+	}{
+		{msg: "no cap", weight: 100, maxShare: math.LegacyZeroDec(), baseAmount: math.NewInt(1000), otherPower: math.NewInt(1000), expWeight: 100},
+		{msg: "cap of the whole voting power", weight: 100, maxShare: math.LegacyOneDec(), baseAmount: math.NewInt(1000), otherPower: math.NewInt(1000), expWeight: 100},
+		{msg: "nothing staked", weight: 100, maxShare: math.LegacyNewDecWithPrec(5, 1), baseAmount: math.ZeroInt(), otherPower: math.NewInt(1000), expWeight: 100},
+		{msg: "no other asset", weight: 100, maxShare: math.LegacyNewDecWithPrec(5, 1), baseAmount: math.NewInt(1000), otherPower: math.ZeroInt(), expWeight: 100},
+		// 1000*w / (1000*w + 300000) <= 0.25 => w <= 100
+		{msg: "below the cap", weight: 80, maxShare: math.LegacyNewDecWithPrec(25, 2), baseAmount: math.NewInt(1000), otherPower: math.NewInt(300000), expWeight: 80},
+		{msg: "capped", weight: 150, maxShare: math.LegacyNewDecWithPrec(25, 2), baseAmount: math.NewInt(1000), otherPower: math.NewInt(300000), expWeight: 100},
+		{msg: "capped to one", weight: 150, maxShare: math.LegacyNewDecWithPrec(1, 2), baseAmount: math.NewInt(1000000), otherPower: math.NewInt(1000), expWeight: 1},
+	}
+
+	for _, tc := range testCases {
+		weight := types.CapWeight(tc.weight, tc.maxShare, tc.baseAmount, tc.otherPower)
+		suite.Require().Equal(tc.expWeight, weight, tc.msg)
+	}
+}
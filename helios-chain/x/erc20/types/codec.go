@@ -36,6 +36,11 @@ const (
 	addAssetConsensusMsg    = "evmos/x/erc20/MsgAddAssetConsensus"
 	removeAssetConsensusMsg = "evmos/x/erc20/MsgRemoveAssetConsensus"
 	updateAssetConsensusMsg = "evmos/x/erc20/MsgUpdateAssetConsensus"
+	setAssetPricesMsg       = "evmos/x/erc20/MsgSetAssetPrices"
+	setAssetPowerCapsMsg    = "evmos/x/erc20/MsgSetAssetPowerCaps"
+	setFeeTokensMsg         = "evmos/x/erc20/MsgSetFeeTokens"
+	removeFeeTokensMsg      = "evmos/x/erc20/MsgRemoveFeeTokens"
+	setFeeTokenPreference   = "evmos/x/erc20/MsgSetFeeTokenPreference"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -56,6 +61,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAddAssetConsensus{},
 		&MsgRemoveAssetConsensus{},
 		&MsgUpdateAssetConsensus{},
+		&MsgSetAssetPrices{},
+		&MsgSetAssetPowerCaps{},
+		&MsgSetFeeTokens{},
+		&MsgRemoveFeeTokens{},
+		&MsgSetFeeTokenPreference{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -86,4 +96,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddAssetConsensus{}, addAssetConsensusMsg, nil)
 	cdc.RegisterConcrete(&MsgRemoveAssetConsensus{}, removeAssetConsensusMsg, nil)
 	cdc.RegisterConcrete(&MsgUpdateAssetConsensus{}, updateAssetConsensusMsg, nil)
+	cdc.RegisterConcrete(&MsgSetAssetPrices{}, setAssetPricesMsg, nil)
+	cdc.RegisterConcrete(&MsgSetAssetPowerCaps{}, setAssetPowerCapsMsg, nil)
+	cdc.RegisterConcrete(&MsgSetFeeTokens{}, setFeeTokensMsg, nil)
+	cdc.RegisterConcrete(&MsgRemoveFeeTokens{}, removeFeeTokensMsg, nil)
+	cdc.RegisterConcrete(&MsgSetFeeTokenPreference{}, setFeeTokenPreference, nil)
 }
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the creator token
+func (ct CreatorToken) Validate() error {
+	if err := sdk.ValidateDenom(ct.Denom); err != nil {
+		return fmt.Errorf("invalid creator token denom: %w", err)
+	}
+	if ct.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(ct.Admin); err != nil {
+			return fmt.Errorf("invalid admin of creator token %s: %w", ct.Denom, err)
+		}
+	}
+	if ct.SupplyCap.IsNil() || ct.SupplyCap.IsNegative() {
+		return fmt.Errorf("supply cap of creator token %s cannot be negative", ct.Denom)
+	}
+	if ct.Paused && !ct.Pausable {
+		return fmt.Errorf("creator token %s is paused but not pausable", ct.Denom)
+	}
+	return nil
+}
+
+// IsCapped returns true if the total supply of the token is capped
+func (ct CreatorToken) IsCapped() bool {
+	return ct.SupplyCap.IsPositive()
+}
+
+// IsAdmin returns true if the account is the admin of the token, a renounced token
+// has no admin
+func (ct CreatorToken) IsAdmin(account sdk.AccAddress) bool {
+	return ct.Admin != "" && ct.Admin == account.String()
+}
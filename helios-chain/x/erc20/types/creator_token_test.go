@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"helios-core/helios-chain/x/erc20/types"
+)
+
+type CreatorTokenTestSuite struct {
+	suite.Suite
+}
+
+func TestCreatorTokenSuite(t *testing.T) {
+	suite.Run(t, new(CreatorTokenTestSuite))
+}
+
+func (suite *CreatorTokenTestSuite) TestCreatorTokenValidate() {
+	admin := sdk.AccAddress([]byte("admin_______________")).String()
+
+	testCases := []struct {
+		msg          string
+		creatorToken types.CreatorToken
+		expectPass   bool
+	}{
+		{msg: "valid creator token", creatorToken: types.CreatorToken{Denom: "launch", Admin: admin, SupplyCap: math.NewInt(1000), Mintable: true}, expectPass: true},
+		{msg: "valid uncapped creator token", creatorToken: types.CreatorToken{Denom: "launch", Admin: admin, SupplyCap: math.ZeroInt()}, expectPass: true},
+		{msg: "valid renounced creator token", creatorToken: types.CreatorToken{Denom: "launch", SupplyCap: math.ZeroInt()}, expectPass: true},
+		{msg: "valid paused creator token", creatorToken: types.CreatorToken{Denom: "launch", Admin: admin, SupplyCap: math.ZeroInt(), Pausable: true, Paused: true}, expectPass: true},
+		{msg: "invalid denom", creatorToken: types.CreatorToken{Denom: "", SupplyCap: math.ZeroInt()}, expectPass: false},
+		{msg: "invalid admin", creatorToken: types.CreatorToken{Denom: "launch", Admin: "invalid", SupplyCap: math.ZeroInt()}, expectPass: false},
+		{msg: "nil supply cap", creatorToken: types.CreatorToken{Denom: "launch", Admin: admin}, expectPass: false},
+		{msg: "negative supply cap", creatorToken: types.CreatorToken{Denom: "launch", Admin: admin, SupplyCap: math.NewInt(-1)}, expectPass: false},
+		{msg: "paused but not pausable", creatorToken: types.CreatorToken{Denom: "launch", Admin: admin, SupplyCap: math.ZeroInt(), Paused: true}, expectPass: false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.creatorToken.Validate()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *CreatorTokenTestSuite) TestCreatorTokenIsAdmin() {
+	admin := sdk.AccAddress([]byte("admin_______________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+
+	creatorToken := types.CreatorToken{Denom: "launch", Admin: admin.String(), SupplyCap: math.ZeroInt()}
+	suite.Require().True(creatorToken.IsAdmin(admin))
+	suite.Require().False(creatorToken.IsAdmin(other))
+
+	creatorToken.Admin = ""
+	suite.Require().False(creatorToken.IsAdmin(admin))
+}
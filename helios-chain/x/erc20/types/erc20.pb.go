@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -510,6 +511,8 @@ type Asset struct {
 	BaseWeight      uint64 `protobuf:"varint,6,opt,name=base_weight,json=baseWeight,proto3" json:"base_weight,omitempty"`
 	Symbol          string `protobuf:"bytes,7,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Archived        bool   `protobuf:"varint,8,opt,name=archived,proto3" json:"archived,omitempty"`
+	EffectiveWeight uint64 `protobuf:"varint,9,opt,name=effective_weight,json=effectiveWeight,proto3" json:"effective_weight,omitempty"`
+	MaxPowerShare   string `protobuf:"bytes,10,opt,name=max_power_share,json=maxPowerShare,proto3" json:"max_power_share,omitempty"`
 }
 
 func (m *Asset) Reset()         { *m = Asset{} }
@@ -601,6 +604,20 @@ func (m *Asset) GetArchived() bool {
 	return false
 }
 
+func (m *Asset) GetEffectiveWeight() uint64 {
+	if m != nil {
+		return m.EffectiveWeight
+	}
+	return 0
+}
+
+func (m *Asset) GetMaxPowerShare() string {
+	if m != nil {
+		return m.MaxPowerShare
+	}
+	return ""
+}
+
 type WeightUpdate struct {
 	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Magnitude string `protobuf:"bytes,2,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
@@ -661,6 +678,326 @@ func (m *WeightUpdate) GetDirection() string {
 	return ""
 }
 
+// AssetPrice defines the price of a whitelisted asset used to derive its
+// effective weight from its base weight.
+type AssetPrice struct {
+	// denom of the whitelisted asset
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the current price of the asset
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// reference_price is the price at which the effective weight equals the
+	// base weight
+	ReferencePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=reference_price,json=referencePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reference_price"`
+	// updated_height is the block height of the last price update
+	UpdatedHeight int64 `protobuf:"varint,4,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+}
+
+func (m *AssetPrice) Reset()         { *m = AssetPrice{} }
+func (m *AssetPrice) String() string { return proto.CompactTextString(m) }
+func (*AssetPrice) ProtoMessage()    {}
+func (*AssetPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd1aac195f42018d, []int{10}
+}
+func (m *AssetPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPrice.Merge(m, src)
+}
+func (m *AssetPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPrice proto.InternalMessageInfo
+
+func (m *AssetPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetPrice) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+// AssetPowerCap defines the maximum share of the total voting power conferred
+// by a whitelisted asset.
+type AssetPowerCap struct {
+	// denom of the whitelisted asset
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_power_share is the maximum share as a decimal between 0 and 1, an
+	// empty value disables the cap
+	MaxPowerShare string `protobuf:"bytes,2,opt,name=max_power_share,json=maxPowerShare,proto3" json:"max_power_share,omitempty"`
+}
+
+func (m *AssetPowerCap) Reset()         { *m = AssetPowerCap{} }
+func (m *AssetPowerCap) String() string { return proto.CompactTextString(m) }
+func (*AssetPowerCap) ProtoMessage()    {}
+func (*AssetPowerCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd1aac195f42018d, []int{11}
+}
+func (m *AssetPowerCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPowerCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPowerCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetPowerCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPowerCap.Merge(m, src)
+}
+func (m *AssetPowerCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPowerCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPowerCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPowerCap proto.InternalMessageInfo
+
+func (m *AssetPowerCap) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetPowerCap) GetMaxPowerShare() string {
+	if m != nil {
+		return m.MaxPowerShare
+	}
+	return ""
+}
+
+// FeeToken defines a token accepted to pay the fees of the Ethereum
+// transactions instead of the EVM denom.
+type FeeToken struct {
+	// denom of the bank coin of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate is the amount of the token, in its base denom, equivalent
+	// to one EVM coin (10^18 in the 18 decimals representation of the EVM denom)
+	ConversionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_rate"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd1aac195f42018d, []int{12}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FeeTokenPreference defines the fee token an account pays the fees of its
+// Ethereum transactions with.
+type FeeTokenPreference struct {
+	// address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom of the fee token
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *FeeTokenPreference) Reset()         { *m = FeeTokenPreference{} }
+func (m *FeeTokenPreference) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenPreference) ProtoMessage()    {}
+func (*FeeTokenPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd1aac195f42018d, []int{13}
+}
+func (m *FeeTokenPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenPreference.Merge(m, src)
+}
+func (m *FeeTokenPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenPreference proto.InternalMessageInfo
+
+func (m *FeeTokenPreference) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeTokenPreference) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// CreatorToken defines the admin controls of a token created from a template of
+// the ERC20Creator precompile.
+type CreatorToken struct {
+	// denom of the bank coin of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// admin is the account allowed to mint, pause and hand over the token, it is
+	// empty once the admin role has been renounced
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// supply_cap is the maximum total supply of the token, zero for an uncapped
+	// supply
+	SupplyCap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap"`
+	// mintable defines if the admin can mint new tokens
+	Mintable bool `protobuf:"varint,4,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// burnable defines if the holders can burn their tokens
+	Burnable bool `protobuf:"varint,5,opt,name=burnable,proto3" json:"burnable,omitempty"`
+	// pausable defines if the admin can pause the transfers of the token
+	Pausable bool `protobuf:"varint,6,opt,name=pausable,proto3" json:"pausable,omitempty"`
+	// paused defines if the transfers of the token are paused
+	Paused bool `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *CreatorToken) Reset()         { *m = CreatorToken{} }
+func (m *CreatorToken) String() string { return proto.CompactTextString(m) }
+func (*CreatorToken) ProtoMessage()    {}
+func (*CreatorToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd1aac195f42018d, []int{14}
+}
+func (m *CreatorToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatorToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatorToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreatorToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatorToken.Merge(m, src)
+}
+func (m *CreatorToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatorToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatorToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatorToken proto.InternalMessageInfo
+
+func (m *CreatorToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CreatorToken) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *CreatorToken) GetMintable() bool {
+	if m != nil {
+		return m.Mintable
+	}
+	return false
+}
+
+func (m *CreatorToken) GetBurnable() bool {
+	if m != nil {
+		return m.Burnable
+	}
+	return false
+}
+
+func (m *CreatorToken) GetPausable() bool {
+	if m != nil {
+		return m.Pausable
+	}
+	return false
+}
+
+func (m *CreatorToken) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("helios.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "helios.erc20.v1.TokenPair")
@@ -673,65 +1010,90 @@ func init() {
 	proto.RegisterType((*UpdateAssetConsensusProposal)(nil), "helios.erc20.v1.UpdateAssetConsensusProposal")
 	proto.RegisterType((*Asset)(nil), "helios.erc20.v1.Asset")
 	proto.RegisterType((*WeightUpdate)(nil), "helios.erc20.v1.WeightUpdate")
+	proto.RegisterType((*AssetPrice)(nil), "helios.erc20.v1.AssetPrice")
+	proto.RegisterType((*AssetPowerCap)(nil), "helios.erc20.v1.AssetPowerCap")
+	proto.RegisterType((*FeeToken)(nil), "helios.erc20.v1.FeeToken")
+	proto.RegisterType((*FeeTokenPreference)(nil), "helios.erc20.v1.FeeTokenPreference")
+	proto.RegisterType((*CreatorToken)(nil), "helios.erc20.v1.CreatorToken")
 }
 
 func init() { proto.RegisterFile("helios/erc20/v1/erc20.proto", fileDescriptor_dd1aac195f42018d) }
 
 var fileDescriptor_dd1aac195f42018d = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0x4e, 0x62, 0xbf, 0xa4, 0x8e, 0xbb, 0x4a, 0xab, 0xad, 0x49, 0x1c, 0xcb, 0x48,
-	0x25, 0x54, 0xaa, 0xb7, 0x09, 0x07, 0xa4, 0x4a, 0x08, 0x52, 0xc7, 0x48, 0x41, 0x8d, 0x13, 0x6d,
-	0x13, 0x15, 0x71, 0xb1, 0xc6, 0x3b, 0x8f, 0xcd, 0xa8, 0xbb, 0x33, 0xd6, 0xce, 0xc4, 0xa1, 0x07,
-	0xce, 0x54, 0x9c, 0xb8, 0x70, 0xaf, 0x84, 0xb8, 0x73, 0xe0, 0x47, 0x54, 0x9c, 0x7a, 0xe4, 0x84,
-	0x50, 0x22, 0x01, 0x7f, 0x81, 0x0b, 0x42, 0x3b, 0x33, 0xeb, 0x84, 0x20, 0x72, 0x20, 0xcd, 0x65,
-	0xb5, 0xdf, 0xf7, 0xde, 0xbc, 0x79, 0xdf, 0x7b, 0x6f, 0x66, 0xe0, 0xad, 0x43, 0x4c, 0xb8, 0x54,
-	0x01, 0x66, 0xd1, 0xc6, 0x83, 0x60, 0xbc, 0x6e, 0x7f, 0x3a, 0xa3, 0x4c, 0x6a, 0xe9, 0x2d, 0x5a,
-	0x63, 0xc7, 0x72, 0xe3, 0xf5, 0xc6, 0x4d, 0x9a, 0x72, 0x21, 0x03, 0xf3, 0xb5, 0x3e, 0x8d, 0x66,
-	0x24, 0x55, 0x2a, 0x55, 0x30, 0xa4, 0xe2, 0x59, 0x30, 0x5e, 0x1f, 0xa2, 0xa6, 0xeb, 0x06, 0x38,
-	0xfb, 0x1d, 0x6b, 0x1f, 0x18, 0x14, 0x58, 0xe0, 0x4c, 0x4b, 0xb1, 0x8c, 0xa5, 0xe5, 0xf3, 0x3f,
-	0xcb, 0xb6, 0xbf, 0x27, 0x50, 0xdd, 0x97, 0xcf, 0x50, 0xec, 0x51, 0x9e, 0x79, 0x6f, 0xc3, 0x0d,
-	0xb3, 0xfb, 0x80, 0x32, 0x96, 0xa1, 0x52, 0x3e, 0x69, 0x91, 0xb5, 0x6a, 0xb8, 0x60, 0xc8, 0x4d,
-	0xcb, 0x79, 0x4b, 0x30, 0xc3, 0x50, 0xc8, 0xd4, 0x9f, 0x32, 0x46, 0x0b, 0x3c, 0x1f, 0xe6, 0x50,
-	0xd0, 0x61, 0x82, 0xcc, 0x9f, 0x6e, 0x91, 0xb5, 0x4a, 0x58, 0x40, 0xef, 0x03, 0xa8, 0x45, 0x52,
-	0xe8, 0x8c, 0x46, 0x7a, 0x20, 0x8f, 0x05, 0x66, 0x7e, 0xb9, 0x45, 0xd6, 0x6a, 0x1b, 0xb7, 0x3b,
-	0x17, 0x04, 0x77, 0x76, 0x73, 0x6b, 0x78, 0xa3, 0xf0, 0x36, 0xf0, 0x61, 0xf9, 0x8f, 0x97, 0xab,
-	0xa4, 0xfd, 0x2d, 0x81, 0xa5, 0x10, 0x63, 0xae, 0x34, 0x66, 0x5d, 0xc9, 0xc5, 0x5e, 0x26, 0x47,
-	0x52, 0xd1, 0x24, 0xcf, 0x46, 0x73, 0x9d, 0xa0, 0x4b, 0xd5, 0x02, 0xaf, 0x05, 0xf3, 0x0c, 0x55,
-	0x94, 0xf1, 0x91, 0xe6, 0x52, 0xb8, 0x4c, 0xcf, 0x53, 0xde, 0x87, 0x50, 0x49, 0x51, 0x53, 0x46,
-	0x35, 0xf5, 0xa7, 0x5b, 0xd3, 0x6b, 0xf3, 0x1b, 0x2b, 0x1d, 0x57, 0x2f, 0x53, 0x4f, 0x57, 0xdc,
-	0xce, 0x8e, 0x73, 0x7a, 0x54, 0x7e, 0xf5, 0xcb, 0x6a, 0x29, 0x9c, 0x2c, 0x32, 0x79, 0x95, 0xda,
-	0x4f, 0xa0, 0x5e, 0xa4, 0x52, 0x78, 0xfe, 0x23, 0x34, 0xf9, 0x1f, 0xa1, 0xdb, 0x5f, 0xc2, 0xad,
-	0x42, 0x6b, 0x2f, 0xec, 0x6e, 0x3c, 0xb8, 0xb2, 0xd8, 0xbb, 0x50, 0x33, 0x45, 0x76, 0x6d, 0x45,
-	0x65, 0x24, 0x57, 0xc3, 0x0b, 0xac, 0xd3, 0xa4, 0x60, 0x65, 0x5f, 0xc6, 0x71, 0x82, 0x66, 0x30,
-	0xba, 0x52, 0x8c, 0x31, 0x53, 0x5c, 0x5e, 0xbd, 0xe6, 0xf9, 0xba, 0x3c, 0xa4, 0x99, 0x90, 0x7c,
-	0x5d, 0x0e, 0x5c, 0x83, 0xff, 0x22, 0xb0, 0xbc, 0xc9, 0x58, 0x1f, 0x8f, 0x37, 0x95, 0x42, 0xdd,
-	0x95, 0x42, 0xa1, 0x50, 0x47, 0xea, 0xca, 0x9b, 0x76, 0x60, 0x96, 0xe6, 0x11, 0x95, 0x6b, 0xf3,
-	0xbf, 0xc7, 0xce, 0x6c, 0x18, 0x3a, 0x2f, 0xef, 0x1d, 0x58, 0xe4, 0x82, 0x6b, 0x4e, 0x93, 0x01,
-	0xc3, 0x91, 0x54, 0x5c, 0x9b, 0x79, 0x2d, 0x87, 0x35, 0x47, 0x6f, 0x59, 0xf6, 0xe1, 0xce, 0x8b,
-	0x97, 0xab, 0xa5, 0x3c, 0xf7, 0x9f, 0x7e, 0xbc, 0xdf, 0x70, 0xfd, 0x8d, 0xe5, 0x78, 0xd2, 0xde,
-	0xae, 0x14, 0x1a, 0x85, 0xfe, 0xfa, 0xf7, 0x1f, 0xee, 0xb5, 0xed, 0x81, 0xbf, 0x4c, 0x5f, 0xfb,
-	0x37, 0x02, 0xcb, 0x21, 0xa6, 0x72, 0x8c, 0x6f, 0xb8, 0x00, 0xb7, 0x61, 0xd6, 0x1c, 0xd1, 0xa2,
-	0xe9, 0x0e, 0x5d, 0xa7, 0xd0, 0xcb, 0x74, 0xb4, 0xbf, 0x9a, 0x82, 0xe5, 0x83, 0x11, 0xa3, 0xfa,
-	0x4d, 0x0b, 0x7d, 0x1f, 0xe6, 0x8e, 0x4c, 0x5c, 0x35, 0x39, 0xd1, 0x17, 0x5b, 0xfd, 0x14, 0x79,
-	0x7c, 0xa8, 0xed, 0xee, 0x61, 0xe1, 0x7d, 0x9d, 0x95, 0xb8, 0x4c, 0x68, 0xfb, 0x4f, 0x02, 0x33,
-	0xc6, 0x74, 0x76, 0xa7, 0x92, 0xf3, 0x77, 0xea, 0xbb, 0x50, 0x9f, 0xdc, 0x9c, 0xc5, 0x8d, 0x6c,
-	0x75, 0x2f, 0x16, 0x7c, 0x71, 0x29, 0xdf, 0x81, 0x4a, 0x74, 0x48, 0xb9, 0x18, 0x70, 0xe6, 0x4e,
-	0xd7, 0x9c, 0xc1, 0xdb, 0xcc, 0x5b, 0x01, 0xb0, 0x26, 0x41, 0x53, 0x34, 0xc2, 0xaa, 0x61, 0xd5,
-	0x30, 0x7d, 0x9a, 0xa2, 0xd7, 0x80, 0x0a, 0xc3, 0x88, 0xa7, 0x34, 0x51, 0xfe, 0x8c, 0x51, 0x3d,
-	0xc1, 0xde, 0x2a, 0xcc, 0x0f, 0xa9, 0xc2, 0xc1, 0xb1, 0x29, 0x9b, 0x3f, 0x6b, 0xcc, 0x90, 0x53,
-	0xb6, 0x90, 0xf9, 0x6c, 0xa9, 0xe7, 0xe9, 0x50, 0x26, 0xfe, 0x9c, 0x89, 0xeb, 0x50, 0x1e, 0x94,
-	0x66, 0xd1, 0x21, 0x1f, 0x23, 0xf3, 0x2b, 0xe6, 0x39, 0x98, 0x60, 0x77, 0xde, 0x3f, 0x87, 0x85,
-	0xf3, 0xcd, 0xf8, 0x8f, 0x0a, 0x2c, 0x43, 0x35, 0xa5, 0xb1, 0xe0, 0xfa, 0x88, 0xa1, 0x93, 0x7e,
-	0x46, 0xe4, 0x56, 0xc6, 0x33, 0x8c, 0xcc, 0x40, 0x58, 0xd5, 0x67, 0x84, 0xdd, 0xe7, 0xde, 0x27,
-	0x30, 0x63, 0xde, 0x11, 0xef, 0x16, 0xdc, 0xdc, 0x7d, 0xda, 0xef, 0x85, 0x83, 0x83, 0xfe, 0x93,
-	0xbd, 0x5e, 0x77, 0xfb, 0xe3, 0xed, 0xde, 0x56, 0xbd, 0xe4, 0xd5, 0x61, 0xc1, 0xd2, 0x3b, 0xbb,
-	0x5b, 0x07, 0x8f, 0x7b, 0x75, 0xe2, 0x79, 0x50, 0xb3, 0x4c, 0xef, 0xd3, 0xfd, 0x5e, 0xd8, 0xdf,
-	0x7c, 0x5c, 0x9f, 0x6a, 0x94, 0x5f, 0x7c, 0xd7, 0x2c, 0x3d, 0xfa, 0xe8, 0xd5, 0x49, 0x93, 0xbc,
-	0x3e, 0x69, 0x92, 0x5f, 0x4f, 0x9a, 0xe4, 0x9b, 0xd3, 0x66, 0xe9, 0xf5, 0x69, 0xb3, 0xf4, 0xf3,
-	0x69, 0xb3, 0xf4, 0xd9, 0x5d, 0x3b, 0x68, 0xf7, 0x23, 0x99, 0x61, 0x50, 0xfc, 0xe7, 0x25, 0x0e,
-	0xbe, 0x70, 0x8f, 0xbd, 0x7e, 0x3e, 0x42, 0x35, 0x9c, 0x35, 0xaf, 0xee, 0x7b, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x3f, 0x4c, 0x73, 0xc1, 0x09, 0x08, 0x00, 0x00,
+	// 1165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6b, 0x1b, 0xc7,
+	0x17, 0xd7, 0xfa, 0xa7, 0xf4, 0x62, 0x4b, 0xca, 0xe2, 0x84, 0x8d, 0xe2, 0xc8, 0x66, 0xbf, 0x7c,
+	0x53, 0x37, 0xc5, 0x52, 0xec, 0x1e, 0x0a, 0x81, 0xd2, 0xda, 0xb2, 0xd2, 0x3a, 0xc4, 0x8e, 0x58,
+	0x27, 0xa4, 0xe4, 0xd0, 0x65, 0xb4, 0xfb, 0x2c, 0x0d, 0xd1, 0xee, 0x2c, 0x3b, 0x23, 0x39, 0x86,
+	0xf6, 0xdc, 0x50, 0x7a, 0xe8, 0xa5, 0xf7, 0x40, 0xe9, 0xbd, 0x87, 0xfc, 0x11, 0xa1, 0xf4, 0x10,
+	0x72, 0x2a, 0x3d, 0x84, 0x92, 0x40, 0xdb, 0x53, 0xff, 0x84, 0x52, 0xe6, 0xc7, 0xae, 0x9d, 0x1f,
+	0xf6, 0x21, 0x4e, 0x2e, 0x62, 0xdf, 0xe7, 0xcd, 0xbc, 0x37, 0x9f, 0xcf, 0x7b, 0xf3, 0x34, 0x70,
+	0xbe, 0x8f, 0x03, 0xca, 0x78, 0x13, 0xd3, 0x60, 0xf5, 0x72, 0x73, 0xb4, 0xa2, 0x3f, 0x1a, 0x49,
+	0xca, 0x04, 0xb3, 0x2b, 0xda, 0xd9, 0xd0, 0xd8, 0x68, 0xa5, 0x76, 0x9a, 0x44, 0x34, 0x66, 0x4d,
+	0xf5, 0xab, 0xd7, 0xd4, 0xea, 0x01, 0xe3, 0x11, 0xe3, 0xcd, 0x2e, 0x89, 0xef, 0x36, 0x47, 0x2b,
+	0x5d, 0x14, 0x64, 0x45, 0x19, 0xc6, 0x7f, 0x4e, 0xfb, 0x7d, 0x65, 0x35, 0xb5, 0x61, 0x5c, 0x73,
+	0x3d, 0xd6, 0x63, 0x1a, 0x97, 0x5f, 0x1a, 0x75, 0x7f, 0xb2, 0xa0, 0x74, 0x93, 0xdd, 0xc5, 0xb8,
+	0x43, 0x68, 0x6a, 0xff, 0x0f, 0x66, 0x55, 0x76, 0x9f, 0x84, 0x61, 0x8a, 0x9c, 0x3b, 0xd6, 0xa2,
+	0xb5, 0x54, 0xf2, 0x66, 0x14, 0xb8, 0xa6, 0x31, 0x7b, 0x0e, 0x26, 0x43, 0x8c, 0x59, 0xe4, 0x8c,
+	0x29, 0xa7, 0x36, 0x6c, 0x07, 0xa6, 0x31, 0x26, 0xdd, 0x01, 0x86, 0xce, 0xf8, 0xa2, 0xb5, 0x54,
+	0xf4, 0x32, 0xd3, 0xfe, 0x18, 0xca, 0x01, 0x8b, 0x45, 0x4a, 0x02, 0xe1, 0xb3, 0xbd, 0x18, 0x53,
+	0x67, 0x62, 0xd1, 0x5a, 0x2a, 0xaf, 0x9e, 0x6d, 0xbc, 0x44, 0xb8, 0x71, 0x43, 0x7a, 0xbd, 0xd9,
+	0x6c, 0xb5, 0x32, 0xaf, 0x4c, 0xfc, 0xfd, 0x60, 0xc1, 0x72, 0x7f, 0xb0, 0x60, 0xce, 0xc3, 0x1e,
+	0xe5, 0x02, 0xd3, 0x16, 0xa3, 0x71, 0x27, 0x65, 0x09, 0xe3, 0x64, 0x20, 0x4f, 0x23, 0xa8, 0x18,
+	0xa0, 0x39, 0xaa, 0x36, 0xec, 0x45, 0x38, 0x15, 0x22, 0x0f, 0x52, 0x9a, 0x08, 0xca, 0x62, 0x73,
+	0xd2, 0xc3, 0x90, 0xfd, 0x09, 0x14, 0x23, 0x14, 0x24, 0x24, 0x82, 0x38, 0xe3, 0x8b, 0xe3, 0x4b,
+	0xa7, 0x56, 0x2f, 0x34, 0x8c, 0x5e, 0x4a, 0x4f, 0x23, 0x6e, 0x63, 0xcb, 0x2c, 0x5a, 0x9f, 0x78,
+	0xf4, 0x74, 0xa1, 0xe0, 0xe5, 0x9b, 0xd4, 0xb9, 0x0a, 0xee, 0x0e, 0x54, 0xb3, 0xa3, 0x64, 0x2b,
+	0x5f, 0x08, 0x6d, 0xbd, 0x41, 0x68, 0xf7, 0x6b, 0x38, 0x93, 0x71, 0x6d, 0x7b, 0xad, 0xd5, 0xcb,
+	0x27, 0x26, 0x7b, 0x11, 0xca, 0x4a, 0x64, 0x53, 0x56, 0xe4, 0x8a, 0x72, 0xc9, 0x7b, 0x09, 0x35,
+	0x9c, 0x38, 0x5c, 0xb8, 0xc9, 0x7a, 0xbd, 0x01, 0xaa, 0xc6, 0x68, 0xb1, 0x78, 0x84, 0x29, 0xa7,
+	0xec, 0xe4, 0x9a, 0xcb, 0x7d, 0x32, 0xa4, 0x33, 0x6e, 0xf6, 0x49, 0xc3, 0x14, 0xf8, 0x5f, 0x0b,
+	0xe6, 0xd7, 0xc2, 0x70, 0x1b, 0xf7, 0xd6, 0x38, 0x47, 0xd1, 0x62, 0x31, 0xc7, 0x98, 0x0f, 0xf9,
+	0x89, 0x93, 0x36, 0x60, 0x8a, 0xc8, 0x88, 0xdc, 0x94, 0xf9, 0xd5, 0xb6, 0x53, 0x09, 0x3d, 0xb3,
+	0xca, 0x7e, 0x0f, 0x2a, 0x34, 0xa6, 0x82, 0x92, 0x81, 0x1f, 0x62, 0xc2, 0x38, 0x15, 0xaa, 0x5f,
+	0x27, 0xbc, 0xb2, 0x81, 0x37, 0x34, 0x7a, 0x65, 0xeb, 0xfe, 0x83, 0x85, 0x82, 0x3c, 0xfb, 0x2f,
+	0x0f, 0x97, 0x6b, 0xa6, 0xbe, 0x3d, 0x36, 0xca, 0xcb, 0xdb, 0x62, 0xb1, 0xc0, 0x58, 0x7c, 0xfb,
+	0xd7, 0xcf, 0x97, 0x5c, 0x7d, 0xe1, 0x8f, 0xe3, 0xe7, 0xfe, 0x69, 0xc1, 0xbc, 0x87, 0x11, 0x1b,
+	0xe1, 0x5b, 0x16, 0xe0, 0x2c, 0x4c, 0xa9, 0x2b, 0x9a, 0x15, 0xdd, 0x58, 0xef, 0x92, 0xe8, 0x71,
+	0x3c, 0xdc, 0x6f, 0xc6, 0x60, 0xfe, 0x56, 0x12, 0x12, 0xf1, 0xb6, 0x89, 0x7e, 0x04, 0xd3, 0x43,
+	0x15, 0x97, 0xe7, 0x37, 0xfa, 0xe5, 0x52, 0xdf, 0x46, 0xda, 0xeb, 0x0b, 0x9d, 0xdd, 0xcb, 0x56,
+	0xbf, 0x4b, 0x25, 0x8e, 0x23, 0xea, 0xfe, 0x3a, 0x06, 0x93, 0xca, 0x75, 0x30, 0x53, 0xad, 0xc3,
+	0x33, 0xf5, 0x7d, 0xa8, 0xe6, 0x93, 0x33, 0x9b, 0xc8, 0x9a, 0x77, 0x25, 0xc3, 0xb3, 0xa1, 0x7c,
+	0x0e, 0x8a, 0x41, 0x9f, 0xd0, 0xd8, 0xa7, 0xa1, 0xb9, 0x5d, 0xd3, 0xca, 0xde, 0x0c, 0xed, 0x0b,
+	0x00, 0xda, 0x15, 0x93, 0x08, 0x15, 0xb1, 0x92, 0x57, 0x52, 0xc8, 0x36, 0x89, 0xd0, 0xae, 0x41,
+	0x31, 0xc4, 0x80, 0x46, 0x64, 0xc0, 0x9d, 0x49, 0xc5, 0x3a, 0xb7, 0xed, 0x05, 0x38, 0xd5, 0x25,
+	0x1c, 0xfd, 0x3d, 0x25, 0x9b, 0x33, 0xa5, 0xdc, 0x20, 0x21, 0x2d, 0xa4, 0xec, 0x2d, 0xbe, 0x1f,
+	0x75, 0xd9, 0xc0, 0x99, 0x56, 0x71, 0x8d, 0x25, 0x83, 0x92, 0x34, 0xe8, 0xd3, 0x11, 0x86, 0x4e,
+	0x51, 0xfd, 0x1d, 0xe4, 0xb6, 0x64, 0x85, 0xbb, 0xbb, 0x18, 0x08, 0x3a, 0xca, 0x23, 0x97, 0x54,
+	0xe4, 0x4a, 0x8e, 0x9b, 0xf0, 0x17, 0xa1, 0x12, 0x91, 0x7b, 0x7e, 0xc2, 0xf6, 0x30, 0xf5, 0x79,
+	0x9f, 0xa4, 0xe8, 0x80, 0xca, 0x33, 0x1b, 0x91, 0x7b, 0x1d, 0x89, 0xee, 0x48, 0xd0, 0x8c, 0x90,
+	0x5d, 0x98, 0x39, 0x5c, 0xdf, 0x23, 0x44, 0x9d, 0x87, 0x52, 0x44, 0x7a, 0x31, 0x15, 0xc3, 0x10,
+	0x8d, 0x9a, 0x07, 0x80, 0xf4, 0x86, 0x34, 0x95, 0x87, 0x60, 0xd9, 0x98, 0x3a, 0x00, 0x4c, 0x9e,
+	0x7f, 0x2c, 0x00, 0x55, 0xb6, 0x4e, 0x4a, 0x83, 0xa3, 0xd2, 0x7c, 0x06, 0x93, 0x89, 0x74, 0xeb,
+	0x14, 0xeb, 0x2b, 0x72, 0xc4, 0xff, 0xfe, 0x74, 0xe1, 0xbc, 0xee, 0x1a, 0x1e, 0xde, 0x6d, 0x50,
+	0xd6, 0x8c, 0x88, 0xe8, 0x37, 0xae, 0x63, 0x8f, 0x04, 0xfb, 0x1b, 0x18, 0x3c, 0x79, 0xb8, 0x0c,
+	0xa6, 0xa9, 0x36, 0x30, 0xf0, 0xf4, 0x7e, 0xfb, 0x0e, 0x54, 0x52, 0xdc, 0xc5, 0x14, 0xe3, 0x00,
+	0x7d, 0x1d, 0x72, 0xfc, 0x4d, 0x43, 0x96, 0xf3, 0x48, 0xfa, 0xe8, 0xff, 0x87, 0xb2, 0xbe, 0x03,
+	0xa1, 0xdf, 0xd7, 0x85, 0x90, 0xed, 0x31, 0xee, 0xcd, 0x1a, 0xf4, 0x73, 0x05, 0xba, 0x5b, 0x30,
+	0xab, 0xf9, 0x4a, 0xc5, 0x5b, 0x24, 0x39, 0x82, 0xf2, 0x6b, 0xaa, 0x35, 0xf6, 0x9a, 0x6a, 0xb9,
+	0x5f, 0x41, 0xf1, 0x2a, 0xea, 0x3f, 0x97, 0x23, 0x22, 0xdd, 0x81, 0x4a, 0x90, 0xff, 0xed, 0xf8,
+	0x29, 0x11, 0x27, 0x90, 0xb1, 0x7c, 0x10, 0xc9, 0x23, 0x02, 0xdd, 0x2f, 0xc1, 0xce, 0xb2, 0x77,
+	0x72, 0x39, 0xec, 0x55, 0x98, 0x7e, 0xe1, 0xcd, 0xb3, 0xee, 0x3c, 0x79, 0xb8, 0x3c, 0x67, 0xc2,
+	0x98, 0x4b, 0xb6, 0x23, 0x52, 0x1a, 0xf7, 0xbc, 0x6c, 0xe1, 0xeb, 0x1f, 0x42, 0xee, 0x77, 0x63,
+	0x30, 0xd3, 0x4a, 0x91, 0x08, 0x96, 0x1e, 0x47, 0xb1, 0x01, 0x93, 0x24, 0x8c, 0xa8, 0x19, 0x64,
+	0xc7, 0xa4, 0xd3, 0xcb, 0xec, 0x6b, 0x00, 0x7c, 0x98, 0x24, 0x83, 0x7d, 0x3f, 0x20, 0x89, 0xe9,
+	0x80, 0x0f, 0x8c, 0x1a, 0x67, 0x5e, 0x55, 0x63, 0x33, 0x16, 0x87, 0x74, 0xd8, 0x8c, 0x85, 0x57,
+	0xd2, 0xdb, 0x65, 0xf9, 0x6a, 0x50, 0x8c, 0x68, 0x2c, 0xe4, 0xf3, 0x4c, 0x15, 0xbc, 0xe8, 0xe5,
+	0xb6, 0xf4, 0x75, 0x87, 0xa9, 0x7a, 0xba, 0xa9, 0x71, 0x50, 0xf4, 0x72, 0x5b, 0xfa, 0x12, 0x32,
+	0xe4, 0xca, 0x37, 0xa5, 0x7d, 0x99, 0x2d, 0x27, 0x81, 0xfc, 0xc6, 0x50, 0x4d, 0x82, 0xa2, 0x67,
+	0xac, 0x4b, 0xd7, 0x60, 0x52, 0xbd, 0xe3, 0xec, 0x33, 0x70, 0xfa, 0xc6, 0xed, 0xed, 0xb6, 0xe7,
+	0xdf, 0xda, 0xde, 0xe9, 0xb4, 0x5b, 0x9b, 0x57, 0x37, 0xdb, 0x1b, 0xd5, 0x82, 0x5d, 0x85, 0x19,
+	0x0d, 0x6f, 0xdd, 0xd8, 0xb8, 0x75, 0xbd, 0x5d, 0xb5, 0x6c, 0x1b, 0xca, 0x1a, 0x69, 0x7f, 0x71,
+	0xb3, 0xed, 0x6d, 0xaf, 0x5d, 0xaf, 0x8e, 0xd5, 0x26, 0xee, 0xff, 0x58, 0x2f, 0xac, 0x7f, 0xfa,
+	0xe8, 0x59, 0xdd, 0x7a, 0xfc, 0xac, 0x6e, 0xfd, 0xf1, 0xac, 0x6e, 0x7d, 0xff, 0xbc, 0x5e, 0x78,
+	0xfc, 0xbc, 0x5e, 0xf8, 0xed, 0x79, 0xbd, 0x70, 0xe7, 0xa2, 0x1e, 0xf4, 0xcb, 0x01, 0x4b, 0xb1,
+	0x99, 0x7d, 0xcb, 0x11, 0xd7, 0xbc, 0x67, 0x1e, 0xdb, 0x62, 0x3f, 0x41, 0xde, 0x9d, 0x52, 0xaf,
+	0xde, 0x0f, 0xff, 0x1b, 0x00, 0x33, 0x19, 0xe2, 0xc7, 0x89, 0x0b, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -954,6 +1316,12 @@ func (this *Asset) Equal(that interface{}) bool {
 	if this.Archived != that1.Archived {
 		return false
 	}
+	if this.EffectiveWeight != that1.EffectiveWeight {
+		return false
+	}
+	if this.MaxPowerShare != that1.MaxPowerShare {
+		return false
+	}
 	return true
 }
 func (this *WeightUpdate) Equal(that interface{}) bool {
@@ -1399,6 +1767,18 @@ func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxPowerShare) > 0 {
+		i -= len(m.MaxPowerShare)
+		copy(dAtA[i:], m.MaxPowerShare)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.MaxPowerShare)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.EffectiveWeight != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.EffectiveWeight))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Archived {
 		i--
 		if m.Archived {
@@ -1501,49 +1881,305 @@ func (m *WeightUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
-	offset -= sovErc20(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AssetPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TokenPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AssetPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Enabled {
-		n += 2
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
 	}
-	if m.ContractOwner != 0 {
-		n += 1 + sovErc20(uint64(m.ContractOwner))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterCoinProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssetPowerCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *AssetPowerCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPowerCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxPowerShare) > 0 {
+		i -= len(m.MaxPowerShare)
+		copy(dAtA[i:], m.MaxPowerShare)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.MaxPowerShare)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTokenPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatorToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatorToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatorToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Pausable {
+		i--
+		if m.Pausable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Burnable {
+		i--
+		if m.Burnable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Mintable {
+		i--
+		if m.Mintable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
 	}
 	l = len(m.Description)
 	if l > 0 {
@@ -1730,37 +2366,675 @@ func (m *Asset) Size() (n int) {
 	if m.Archived {
 		n += 2
 	}
-	return n
-}
+	if m.EffectiveWeight != 0 {
+		n += 1 + sovErc20(uint64(m.EffectiveWeight))
+	}
+	l = len(m.MaxPowerShare)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *WeightUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Magnitude)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovErc20(uint64(m.UpdatedHeight))
+	}
+	return n
+}
+
+func (m *AssetPowerCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.MaxPowerShare)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *FeeTokenPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *CreatorToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Mintable {
+		n += 2
+	}
+	if m.Burnable {
+		n += 2
+	}
+	if m.Pausable {
+		n += 2
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, types.Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, types.Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *WeightUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Magnitude)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Direction)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovErc20(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozErc20(x uint64) (n int) {
-	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
+func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,15 +3057,15 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1819,11 +3093,11 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1851,13 +3125,13 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1867,31 +3141,24 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
 			}
-			m.ContractOwner = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowErc20
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractOwner |= Owner(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1913,7 +3180,7 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+func (m *AddNewAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1936,10 +3203,10 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddNewAssetConsensusProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddNewAssetConsensusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2008,7 +3275,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2035,11 +3302,30 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assets = append(m.Assets, &Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			m.InitialDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -2061,7 +3347,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
+func (m *RemoveAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2084,17 +3370,17 @@ func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveAssetConsensusProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveAssetConsensusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -2104,26 +3390,107 @@ func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthErc20
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthErc20
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			m.InitialDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -2145,7 +3512,7 @@ func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2168,10 +3535,10 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateAssetConsensusProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateAssetConsensusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2236,13 +3603,47 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &WeightUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
 			}
-			var stringLen uint64
+			m.InitialDeposit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -2252,24 +3653,11 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.InitialDeposit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthErc20
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -2291,7 +3679,7 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
+func (m *Asset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2314,15 +3702,15 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2350,11 +3738,11 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2382,11 +3770,11 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2414,61 +3802,11 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipErc20(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddNewAssetConsensusProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowErc20
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddNewAssetConsensusProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddNewAssetConsensusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2496,11 +3834,49 @@ func (m *AddNewAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ChainName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseWeight", wireType)
+			}
+			m.BaseWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2528,13 +3904,13 @@ func (m *AddNewAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -2544,31 +3920,36 @@ func (m *AddNewAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthErc20
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Archived = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveWeight", wireType)
 			}
-			m.Assets = append(m.Assets, &Asset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EffectiveWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerShare", wireType)
 			}
-			m.InitialDeposit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -2578,11 +3959,24 @@ func (m *AddNewAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialDeposit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPowerShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -2604,7 +3998,7 @@ func (m *AddNewAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveAssetConsensusProposal) Unmarshal(dAtA []byte) error {
+func (m *WeightUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2627,15 +4021,15 @@ func (m *RemoveAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveAssetConsensusProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveAssetConsensusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2663,11 +4057,11 @@ func (m *RemoveAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Magnitude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2695,11 +4089,11 @@ func (m *RemoveAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Magnitude = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2727,27 +4121,8 @@ func (m *RemoveAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Direction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
-			}
-			m.InitialDeposit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowErc20
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialDeposit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -2769,7 +4144,7 @@ func (m *RemoveAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateAssetConsensusProposal) Unmarshal(dAtA []byte) error {
+func (m *AssetPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2792,15 +4167,15 @@ func (m *UpdateAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateAssetConsensusProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateAssetConsensusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2828,11 +4203,11 @@ func (m *UpdateAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2860,13 +4235,15 @@ func (m *UpdateAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -2876,31 +4253,31 @@ func (m *UpdateAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthErc20
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthErc20
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, &WeightUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
 			}
-			m.InitialDeposit = 0
+			m.UpdatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -2910,7 +4287,7 @@ func (m *UpdateAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialDeposit |= uint64(b&0x7F) << shift
+				m.UpdatedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2936,7 +4313,7 @@ func (m *UpdateAssetConsensusProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Asset) Unmarshal(dAtA []byte) error {
+func (m *AssetPowerCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2959,10 +4336,10 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetPowerCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetPowerCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2999,7 +4376,7 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3027,11 +4404,61 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.MaxPowerShare = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3059,11 +4486,11 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3091,49 +4518,63 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainName = string(dAtA[iNdEx:postIndex])
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowErc20
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseWeight", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BaseWeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowErc20
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BaseWeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
 			}
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3161,13 +4602,13 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -3177,12 +4618,24 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Archived = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -3204,7 +4657,7 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WeightUpdate) Unmarshal(dAtA []byte) error {
+func (m *CreatorToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3227,10 +4680,10 @@ func (m *WeightUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatorToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatorToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3267,7 +4720,7 @@ func (m *WeightUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Magnitude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3295,11 +4748,11 @@ func (m *WeightUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Magnitude = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3327,8 +4780,90 @@ func (m *WeightUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Direction = string(dAtA[iNdEx:postIndex])
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burnable = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pausable = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -28,6 +28,16 @@ var (
 	ErrAssetAlreadyArchived    = errorsmod.Register(ModuleName, 19, "asset already archived")
 	ErrAssetNotArchived        = errorsmod.Register(ModuleName, 20, "asset not archived")
 	ErrInvalidAssetQuery       = errorsmod.Register(ModuleName, 21, "invalid asset query format")
+
+	// Fee token errors
+	ErrFeeTokenNotFound = errorsmod.Register(ModuleName, 22, "fee token not found")
+
+	// Creator token errors
+	ErrCreatorTokenNotFound   = errorsmod.Register(ModuleName, 23, "creator token not found")
+	ErrCreatorTokenNotAdmin   = errorsmod.Register(ModuleName, 24, "caller is not the creator token admin")
+	ErrCreatorTokenNotAllowed = errorsmod.Register(ModuleName, 25, "operation not allowed by the creator token template")
+	ErrCreatorTokenPaused     = errorsmod.Register(ModuleName, 26, "creator token is paused")
+	ErrCreatorTokenCapReached = errorsmod.Register(ModuleName, 27, "creator token supply cap exceeded")
 )
 
 // AssetNotFoundError is used for better type checking of asset not found errors
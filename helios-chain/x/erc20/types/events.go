@@ -15,6 +15,17 @@ const (
 	EventTypeAddAssetConsensus      = "add_asset_consensus"
 	EventTypeRemoveAssetConsensus   = "remove_asset_consensus"
 	EventTypeUpdateAssetConsensus   = "update_asset_consensus"
+	EventTypeSetAssetPrice          = "set_asset_price"
+	EventTypeSetAssetPowerCap       = "set_asset_power_cap"
+	EventTypeUpdateEffectiveWeight  = "update_effective_weight"
+	EventTypeSetFeeToken            = "set_fee_token"
+	EventTypeRemoveFeeToken         = "remove_fee_token"
+	EventTypeSetFeeTokenPreference  = "set_fee_token_preference"
+	EventTypeERC20Created           = "erc20_created"
+	EventTypeCreatorTokenMint       = "creator_token_mint"
+	EventTypeCreatorTokenBurn       = "creator_token_burn"
+	EventTypeCreatorTokenSetAdmin   = "creator_token_set_admin"
+	EventTypeCreatorTokenSetPaused  = "creator_token_set_paused"
 
 	AttributeCoinSourceChannel  = "source_channel"
 	AttributeKeyCosmosCoin      = "cosmos_coin"
@@ -22,6 +33,20 @@ const (
 	AttributeKeyReceiver        = "receiver"
 	AttributeKeyDenom           = "denom"
 	AttributeKeyContractAddress = "contract_address"
+	AttributeKeyPrice           = "price"
+	AttributeKeyReferencePrice  = "reference_price"
+	AttributeKeyMaxPowerShare   = "max_power_share"
+	AttributeKeyBaseWeight      = "base_weight"
+	AttributeKeyEffectiveWeight = "effective_weight"
+	AttributeKeyConversionRate  = "conversion_rate"
+	AttributeKeyAccount         = "account"
+	AttributeKeySymbol          = "symbol"
+	AttributeKeyDecimals        = "decimals"
+	AttributeKeySupply          = "supply"
+	AttributeKeySupplyCap       = "supply_cap"
+	AttributeKeyAdmin           = "admin"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyPaused          = "paused"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
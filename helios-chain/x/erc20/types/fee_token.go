@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "helios-core/helios-chain/x/evm/types"
+)
+
+// Validate performs a stateless validation of the fee token
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+	if ft.ConversionRate.IsNil() || !ft.ConversionRate.IsPositive() {
+		return fmt.Errorf("conversion rate of fee token %s must be positive", ft.Denom)
+	}
+	return nil
+}
+
+// FeePayment returns the payment of the fees of the Ethereum transactions with the fee token
+func (ft FeeToken) FeePayment() evmtypes.FeePayment {
+	return evmtypes.FeePayment{
+		Denom:          ft.Denom,
+		ConversionRate: ft.ConversionRate,
+	}
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"helios-core/helios-chain/x/erc20/types"
+)
+
+type FeeTokenTestSuite struct {
+	suite.Suite
+}
+
+func TestFeeTokenSuite(t *testing.T) {
+	suite.Run(t, new(FeeTokenTestSuite))
+}
+
+func (suite *FeeTokenTestSuite) TestFeeTokenValidate() {
+	testCases := []struct {
+		msg        string
+		feeToken   types.FeeToken
+		expectPass bool
+	}{
+		{msg: "valid fee token", feeToken: types.FeeToken{Denom: "usdt", ConversionRate: math.LegacyNewDecWithPrec(25, 2)}, expectPass: true},
+		{msg: "invalid denom", feeToken: types.FeeToken{Denom: "", ConversionRate: math.LegacyOneDec()}, expectPass: false},
+		{msg: "nil rate", feeToken: types.FeeToken{Denom: "usdt"}, expectPass: false},
+		{msg: "zero rate", feeToken: types.FeeToken{Denom: "usdt", ConversionRate: math.LegacyZeroDec()}, expectPass: false},
+		{msg: "negative rate", feeToken: types.FeeToken{Denom: "usdt", ConversionRate: math.LegacyNewDec(-1)}, expectPass: false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.feeToken.Validate()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *FeeTokenTestSuite) TestMsgSetFeeTokensValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	usdt := types.FeeToken{Denom: "usdt", ConversionRate: math.LegacyOneDec()}
+
+	testCases := []struct {
+		msg        string
+		setMsg     types.MsgSetFeeTokens
+		expectPass bool
+	}{
+		{msg: "valid msg", setMsg: types.MsgSetFeeTokens{Authority: authority, FeeTokens: []types.FeeToken{usdt}}, expectPass: true},
+		{msg: "invalid authority", setMsg: types.MsgSetFeeTokens{Authority: "invalid", FeeTokens: []types.FeeToken{usdt}}, expectPass: false},
+		{msg: "empty fee tokens", setMsg: types.MsgSetFeeTokens{Authority: authority}, expectPass: false},
+		{msg: "duplicated fee token", setMsg: types.MsgSetFeeTokens{Authority: authority, FeeTokens: []types.FeeToken{usdt, usdt}}, expectPass: false},
+		{msg: "invalid fee token", setMsg: types.MsgSetFeeTokens{Authority: authority, FeeTokens: []types.FeeToken{{Denom: "usdt"}}}, expectPass: false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.setMsg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *FeeTokenTestSuite) TestMsgSetFeeTokenPreferenceValidateBasic() {
+	sender := sdk.AccAddress([]byte("sender")).String()
+
+	testCases := []struct {
+		msg        string
+		prefMsg    types.MsgSetFeeTokenPreference
+		expectPass bool
+	}{
+		{msg: "valid msg", prefMsg: types.MsgSetFeeTokenPreference{Sender: sender, Denom: "usdt"}, expectPass: true},
+		{msg: "empty denom clears the preference", prefMsg: types.MsgSetFeeTokenPreference{Sender: sender}, expectPass: true},
+		{msg: "invalid sender", prefMsg: types.MsgSetFeeTokenPreference{Sender: "invalid", Denom: "usdt"}, expectPass: false},
+		{msg: "invalid denom", prefMsg: types.MsgSetFeeTokenPreference{Sender: sender, Denom: "1"}, expectPass: false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.prefMsg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	evmostypes "helios-core/helios-chain/types"
 )
 
@@ -55,6 +57,59 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenPrice := make(map[string]bool)
+	for _, price := range gs.AssetPrices {
+		if seenPrice[price.Denom] {
+			return fmt.Errorf("asset price duplicated on genesis: '%s'", price.Denom)
+		}
+		if err := price.Validate(); err != nil {
+			return err
+		}
+		seenPrice[price.Denom] = true
+	}
+
+	seenFeeToken := make(map[string]bool)
+	for _, feeToken := range gs.FeeTokens {
+		if seenFeeToken[feeToken.Denom] {
+			return fmt.Errorf("fee token duplicated on genesis: '%s'", feeToken.Denom)
+		}
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+		if !seenDenom[feeToken.Denom] {
+			return fmt.Errorf("fee token '%s' has no token pair on genesis", feeToken.Denom)
+		}
+		seenFeeToken[feeToken.Denom] = true
+	}
+
+	seenPreference := make(map[string]bool)
+	for _, preference := range gs.FeeTokenPreferences {
+		if seenPreference[preference.Address] {
+			return fmt.Errorf("fee token preference duplicated on genesis: '%s'", preference.Address)
+		}
+		if _, err := sdk.AccAddressFromBech32(preference.Address); err != nil {
+			return fmt.Errorf("invalid fee token preference address '%s': %w", preference.Address, err)
+		}
+		if !seenFeeToken[preference.Denom] {
+			return fmt.Errorf("fee token preference of '%s' is not a fee token: '%s'", preference.Address, preference.Denom)
+		}
+		seenPreference[preference.Address] = true
+	}
+
+	seenCreatorToken := make(map[string]bool)
+	for _, creatorToken := range gs.CreatorTokens {
+		if seenCreatorToken[creatorToken.Denom] {
+			return fmt.Errorf("creator token duplicated on genesis: '%s'", creatorToken.Denom)
+		}
+		if err := creatorToken.Validate(); err != nil {
+			return err
+		}
+		if !seenDenom[creatorToken.Denom] {
+			return fmt.Errorf("creator token '%s' has no token pair on genesis", creatorToken.Denom)
+		}
+		seenCreatorToken[creatorToken.Denom] = true
+	}
+
 	// Check if params are valid
 	if err := gs.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid params on genesis: %w", err)
@@ -30,6 +30,17 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// asset_prices is the price table of the whitelisted assets at genesis
+	AssetPrices []AssetPrice `protobuf:"bytes,3,rep,name=asset_prices,json=assetPrices,proto3" json:"asset_prices"`
+	// fee_tokens is the list of the tokens accepted to pay the fees of the
+	// Ethereum transactions at genesis
+	FeeTokens []FeeToken `protobuf:"bytes,4,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// fee_token_preferences is the list of the fee tokens chosen by the accounts
+	// at genesis
+	FeeTokenPreferences []FeeTokenPreference `protobuf:"bytes,5,rep,name=fee_token_preferences,json=feeTokenPreferences,proto3" json:"fee_token_preferences"`
+	// creator_tokens is the list of the admin controls of the tokens created
+	// from the ERC20Creator templates at genesis
+	CreatorTokens []CreatorToken `protobuf:"bytes,6,rep,name=creator_tokens,json=creatorTokens,proto3" json:"creator_tokens"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +90,34 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetAssetPrices() []AssetPrice {
+	if m != nil {
+		return m.AssetPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTokenPreferences() []FeeTokenPreference {
+	if m != nil {
+		return m.FeeTokenPreferences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreatorTokens() []CreatorToken {
+	if m != nil {
+		return m.CreatorTokens
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -91,6 +130,10 @@ type Params struct {
 	// dynamic_precompiles defines the slice of hex addresses of the
 	// active precompiles that are used to interact with Bank coins as ERC20s
 	DynamicPrecompiles []string `protobuf:"bytes,4,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// dynamic_weights_epoch is the epoch identifier at the end of which the
+	// effective weights of the whitelisted assets are recomputed from their
+	// prices, an empty value disables the dynamic weights
+	DynamicWeightsEpoch string `protobuf:"bytes,5,opt,name=dynamic_weights_epoch,json=dynamicWeightsEpoch,proto3" json:"dynamic_weights_epoch,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -147,6 +190,13 @@ func (m *Params) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetDynamicWeightsEpoch() string {
+	if m != nil {
+		return m.DynamicWeightsEpoch
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "helios.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "helios.erc20.v1.Params")
@@ -155,29 +205,37 @@ func init() {
 func init() { proto.RegisterFile("helios/erc20/v1/genesis.proto", fileDescriptor_546362ecf3773729) }
 
 var fileDescriptor_546362ecf3773729 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x48, 0xcd, 0xc9,
-	0xcc, 0x2f, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x48, 0xeb, 0x81, 0xa5,
-	0xf5, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94,
-	0x34, 0xba, 0x11, 0x10, 0xc5, 0x10, 0x49, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4,
-	0x82, 0x88, 0x2a, 0x4d, 0x62, 0xe4, 0xe2, 0x71, 0x87, 0x58, 0x14, 0x5c, 0x92, 0x58, 0x92, 0x2a,
-	0x64, 0xc5, 0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d,
-	0x24, 0xae, 0x87, 0x66, 0xb1, 0x5e, 0x00, 0x58, 0xda, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15,
-	0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0x75, 0x08, 0xb9, 0x71, 0x71, 0x97, 0xe4, 0x67, 0xa7, 0xe6,
-	0xc5, 0x17, 0x24, 0x66, 0x16, 0x15, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x49, 0x61, 0x18,
-	0x10, 0x02, 0x52, 0x13, 0x90, 0x98, 0x59, 0x84, 0x6c, 0x06, 0x57, 0x09, 0x4c, 0xb4, 0x58, 0x69,
-	0x22, 0x23, 0x17, 0x1b, 0xc4, 0x16, 0x21, 0x45, 0x2e, 0x9e, 0xd4, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4,
-	0x78, 0xb0, 0x76, 0xb0, 0xa3, 0x38, 0x82, 0xb8, 0x21, 0x62, 0xae, 0x20, 0x21, 0x21, 0x5d, 0x2e,
-	0xa1, 0xbc, 0xc4, 0x92, 0xcc, 0xb2, 0xd4, 0xf8, 0x82, 0xa2, 0xd4, 0xe4, 0xfc, 0xdc, 0x82, 0xcc,
-	0x9c, 0xd4, 0x62, 0x09, 0x66, 0x05, 0x66, 0x0d, 0xce, 0x20, 0x41, 0x88, 0x4c, 0x00, 0x42, 0x42,
-	0x48, 0x9f, 0x4b, 0x38, 0xa5, 0x32, 0x2f, 0x31, 0x37, 0x33, 0x19, 0x45, 0x3d, 0x0b, 0x58, 0xbd,
-	0x10, 0x54, 0x0a, 0x49, 0x83, 0x17, 0x0b, 0x07, 0x93, 0x00, 0xb3, 0x93, 0xc3, 0x89, 0x47, 0x72,
-	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7,
-	0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0x41, 0xfc, 0xa7, 0x9b, 0x9c, 0x5f, 0x94, 0xaa,
-	0x0f, 0x63, 0x67, 0x24, 0x66, 0xe6, 0xe9, 0x57, 0x40, 0x63, 0xa2, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0x1c, 0xe2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0xca, 0xbb, 0x5b, 0xe9,
-	0x01, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x9b, 0xb5, 0xab, 0x56, 0xa7, 0xfc, 0x99, 0xc7, 0x44, 0xe8, 0xb4, 0x50, 0x86, 0x84,
+	0x2a, 0xa4, 0x25, 0xac, 0xdc, 0x38, 0xc1, 0xa6, 0x0d, 0xc1, 0x85, 0xa8, 0x20, 0x21, 0x71, 0x89,
+	0xdc, 0xf0, 0xb6, 0xb5, 0x68, 0xe2, 0xc8, 0xaf, 0x55, 0xd8, 0xb7, 0xe0, 0x63, 0x70, 0xe4, 0x4b,
+	0x20, 0xed, 0xb8, 0x1b, 0x9c, 0x10, 0x6a, 0x0f, 0x7c, 0x0d, 0x64, 0x3b, 0x29, 0x61, 0x11, 0x97,
+	0xc8, 0x7a, 0x9f, 0xdf, 0xfb, 0xf8, 0x8d, 0x9d, 0x90, 0xfd, 0x19, 0xcc, 0xb9, 0xc0, 0x10, 0x64,
+	0x32, 0x7c, 0x14, 0x2e, 0x8e, 0xc2, 0x29, 0x64, 0x80, 0x1c, 0x83, 0x5c, 0x0a, 0x25, 0xe8, 0x0d,
+	0x8b, 0x03, 0x83, 0x83, 0xc5, 0x51, 0x6f, 0x9b, 0xa5, 0x3c, 0x13, 0xa1, 0x79, 0xda, 0x4c, 0x6f,
+	0xef, 0xaa, 0xc2, 0x86, 0x2d, 0xbc, 0x35, 0x15, 0x53, 0x61, 0x96, 0xa1, 0x5e, 0xd9, 0xea, 0xc1,
+	0xf7, 0x26, 0xe9, 0x3e, 0xb7, 0x1b, 0xbd, 0x56, 0x4c, 0x01, 0x7d, 0x42, 0xda, 0x39, 0x93, 0x2c,
+	0x45, 0xcf, 0xe9, 0x3b, 0x03, 0x77, 0x78, 0x3b, 0xb8, 0xb2, 0x71, 0x10, 0x19, 0x7c, 0xdc, 0xb9,
+	0xf8, 0x79, 0xb7, 0xf1, 0xe5, 0xf7, 0xd7, 0x87, 0xce, 0xa8, 0xe8, 0xa0, 0x67, 0xc4, 0x55, 0xe2,
+	0x03, 0x64, 0x71, 0xce, 0xb8, 0x44, 0x6f, 0xa3, 0xdf, 0x1c, 0xb8, 0xc3, 0x5e, 0x4d, 0xf0, 0x46,
+	0x67, 0x22, 0xc6, 0x65, 0xd5, 0x41, 0x54, 0x59, 0x45, 0xfa, 0x82, 0x74, 0x19, 0x22, 0xa8, 0x38,
+	0x97, 0x3c, 0x01, 0xf4, 0x9a, 0x46, 0xb4, 0x57, 0x13, 0x3d, 0xd3, 0xa1, 0x48, 0x67, 0xaa, 0x26,
+	0x97, 0xad, 0xcb, 0x48, 0x4f, 0x08, 0x99, 0x00, 0xc4, 0x46, 0x8e, 0x5e, 0xcb, 0x88, 0xee, 0xd4,
+	0x44, 0x67, 0x00, 0x66, 0xa8, 0xaa, 0xa6, 0x33, 0x29, 0x8a, 0x48, 0xc7, 0x64, 0x77, 0x2d, 0x89,
+	0x73, 0x09, 0x13, 0x90, 0x90, 0xe9, 0xc1, 0x36, 0x8d, 0xef, 0xfe, 0x7f, 0x7d, 0xd1, 0x3a, 0x5b,
+	0x35, 0xef, 0x4c, 0x6a, 0x18, 0xe9, 0x2b, 0x72, 0x3d, 0x91, 0xc0, 0x94, 0x90, 0xe5, 0xb0, 0x6d,
+	0x23, 0xdf, 0xaf, 0xc9, 0x4f, 0x6c, 0xac, 0x36, 0xf0, 0xb5, 0xa4, 0x02, 0xf0, 0xe0, 0x9b, 0x43,
+	0xda, 0xf6, 0xaa, 0xe8, 0x3d, 0xd2, 0x85, 0x8c, 0x8d, 0xe7, 0x10, 0x1b, 0x89, 0xb9, 0xd9, 0xad,
+	0x91, 0x6b, 0x6b, 0xa7, 0xba, 0x44, 0x0f, 0x09, 0xcd, 0x98, 0xe2, 0x0b, 0xd0, 0xef, 0x97, 0x88,
+	0x34, 0xe7, 0xf3, 0xe2, 0xe0, 0x3b, 0xa3, 0x6d, 0x4b, 0xa2, 0xbf, 0x80, 0x86, 0x64, 0xe7, 0xfd,
+	0x79, 0xc6, 0x52, 0x9e, 0xfc, 0x93, 0x6f, 0x99, 0x3c, 0x2d, 0x50, 0xb5, 0x61, 0x48, 0x76, 0xcb,
+	0x86, 0x8f, 0xc0, 0xa7, 0x33, 0x85, 0x31, 0xe4, 0x22, 0x99, 0x79, 0x9b, 0x7d, 0x67, 0xd0, 0x19,
+	0x95, 0xb6, 0xb7, 0x96, 0x9d, 0x6a, 0xf4, 0xb2, 0xb5, 0xb5, 0x71, 0xb3, 0x79, 0xfc, 0xf4, 0x62,
+	0xe9, 0x3b, 0x97, 0x4b, 0xdf, 0xf9, 0xb5, 0xf4, 0x9d, 0xcf, 0x2b, 0xbf, 0x71, 0xb9, 0xf2, 0x1b,
+	0x3f, 0x56, 0x7e, 0xe3, 0xdd, 0x03, 0x7b, 0x32, 0x87, 0x89, 0x90, 0x10, 0x96, 0xeb, 0x19, 0xe3,
+	0x59, 0xf8, 0xa9, 0xf8, 0x05, 0xd4, 0x79, 0x0e, 0x38, 0x6e, 0x9b, 0x4f, 0xfd, 0xf1, 0x9f, 0x01,
+	0x00, 0xcd, 0x87, 0xed, 0x14, 0x62, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -200,6 +258,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CreatorTokens) > 0 {
+		for iNdEx := len(m.CreatorTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeTokenPreferences) > 0 {
+		for iNdEx := len(m.FeeTokenPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokenPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AssetPrices) > 0 {
+		for iNdEx := len(m.AssetPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -247,6 +361,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DynamicWeightsEpoch) > 0 {
+		i -= len(m.DynamicWeightsEpoch)
+		copy(dAtA[i:], m.DynamicWeightsEpoch)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DynamicWeightsEpoch)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -303,6 +424,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AssetPrices) > 0 {
+		for _, e := range m.AssetPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokenPreferences) > 0 {
+		for _, e := range m.FeeTokenPreferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreatorTokens) > 0 {
+		for _, e := range m.CreatorTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -327,6 +472,10 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.DynamicWeightsEpoch)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -432,6 +581,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetPrices = append(m.AssetPrices, AssetPrice{})
+			if err := m.AssetPrices[len(m.AssetPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokenPreferences = append(m.FeeTokenPreferences, FeeTokenPreference{})
+			if err := m.FeeTokenPreferences[len(m.FeeTokenPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorTokens = append(m.CreatorTokens, CreatorToken{})
+			if err := m.CreatorTokens[len(m.CreatorTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -566,6 +851,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicWeightsEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicWeightsEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
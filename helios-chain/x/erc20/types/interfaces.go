@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"helios-core/helios-chain/x/evm/core/vm"
 
@@ -30,6 +31,7 @@ type AccountKeeper interface {
 type StakingKeeper interface {
 	BondDenom(ctx context.Context) (string, error)
 	UpdateAssetWeight(ctx sdk.Context, denom string, percentage math.LegacyDec, increase bool, originalWeight uint64) error
+	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
 }
 
 // EVMKeeper defines the expected EVM keeper interface used on erc20
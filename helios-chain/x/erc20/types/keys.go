@@ -33,6 +33,10 @@ const (
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
+	prefixAssetPrice
+	prefixFeeToken
+	prefixFeeTokenPreference
+	prefixCreatorToken
 )
 
 // KVStore key prefixes
@@ -41,4 +45,8 @@ var (
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
 	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
+	KeyPrefixAssetPrice       = []byte{prefixAssetPrice}
+	KeyPrefixFeeToken         = []byte{prefixFeeToken}
+	KeyPrefixFeeTokenPref     = []byte{prefixFeeTokenPreference}
+	KeyPrefixCreatorToken     = []byte{prefixCreatorToken}
 )
@@ -23,6 +23,11 @@ var (
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgSetAssetPrices{}
+	_ sdk.HasValidateBasic = &MsgSetAssetPowerCaps{}
+	_ sdk.HasValidateBasic = &MsgSetFeeTokens{}
+	_ sdk.HasValidateBasic = &MsgRemoveFeeTokens{}
+	_ sdk.HasValidateBasic = &MsgSetFeeTokenPreference{}
 )
 
 const (
@@ -105,3 +110,109 @@ func (m *MsgToggleConversion) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetAssetPrices) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if len(m.Prices) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "prices cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, price := range m.Prices {
+		if seen[price.Denom] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated price for asset %s", price.Denom)
+		}
+		if err := price.Validate(); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+		seen[price.Denom] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetAssetPowerCaps) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if len(m.Caps) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "caps cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, powerCap := range m.Caps {
+		if seen[powerCap.Denom] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated power cap for asset %s", powerCap.Denom)
+		}
+		if err := powerCap.Validate(); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+		seen[powerCap.Denom] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFeeTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if len(m.FeeTokens) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee tokens cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, feeToken := range m.FeeTokens {
+		if seen[feeToken.Denom] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated fee token %s", feeToken.Denom)
+		}
+		if err := feeToken.Validate(); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+		seen[feeToken.Denom] = true
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveFeeTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if len(m.Denoms) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "denoms cannot be empty")
+	}
+
+	for _, denom := range m.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFeeTokenPreference) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "Invalid sender address")
+	}
+
+	if m.Denom != "" {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"helios-core/helios-chain/types"
 	"helios-core/helios-chain/utils"
+	epochstypes "helios-core/helios-chain/x/epochs/types"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -39,9 +40,10 @@ func GetWEVMOSContractHex(chainID string) string {
 
 // Parameter store key
 var (
-	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
-	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
-	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+	ParamStoreKeyEnableErc20         = []byte("EnableErc20")
+	ParamStoreKeyDynamicPrecompiles  = []byte("DynamicPrecompiles")
+	ParamStoreKeyNativePrecompiles   = []byte("NativePrecompiles")
+	ParamStoreKeyDynamicWeightsEpoch = []byte("DynamicWeightsEpoch")
 
 	ParamStoreKeyDynamicPrecompilePrefix = []byte("DP")
 	ParamStoreKeyNativePrecompilePrefix  = []byte("NP")
@@ -89,6 +91,13 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	// an empty epoch identifier disables the dynamic weights
+	if p.DynamicWeightsEpoch != "" {
+		if err := epochstypes.ValidateEpochIdentifierString(p.DynamicWeightsEpoch); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
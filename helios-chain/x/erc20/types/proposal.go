@@ -187,6 +187,11 @@ func (p *AddNewAssetConsensusProposal) ValidateBasic() error {
 		if asset.BaseWeight == 0 {
 			return errorsmod.Wrap(v1beta1.ErrInvalidLengthQuery, "asset base weight must be greater than zero")
 		}
+
+		// Validate voting power cap
+		if _, err := ParsePowerShare(asset.MaxPowerShare); err != nil {
+			return errorsmod.Wrap(v1beta1.ErrInvalidLengthQuery, err.Error())
+		}
 	}
 
 	return nil
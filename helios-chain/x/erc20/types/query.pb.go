@@ -415,6 +415,270 @@ func (m *QueryWhitelistedAssetsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAssetPricesRequest is the request type for the Query/AssetPrices RPC
+// method.
+type QueryAssetPricesRequest struct {
+}
+
+func (m *QueryAssetPricesRequest) Reset()         { *m = QueryAssetPricesRequest{} }
+func (m *QueryAssetPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetPricesRequest) ProtoMessage()    {}
+func (*QueryAssetPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecdc417ac789c39d, []int{8}
+}
+func (m *QueryAssetPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetPricesRequest.Merge(m, src)
+}
+func (m *QueryAssetPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetPricesRequest proto.InternalMessageInfo
+
+// QueryAssetPricesResponse is the response type for the Query/AssetPrices RPC
+// method.
+type QueryAssetPricesResponse struct {
+	// prices is a slice of all the asset prices
+	Prices []AssetPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *QueryAssetPricesResponse) Reset()         { *m = QueryAssetPricesResponse{} }
+func (m *QueryAssetPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetPricesResponse) ProtoMessage()    {}
+func (*QueryAssetPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecdc417ac789c39d, []int{9}
+}
+func (m *QueryAssetPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetPricesResponse.Merge(m, src)
+}
+func (m *QueryAssetPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetPricesResponse proto.InternalMessageInfo
+
+func (m *QueryAssetPricesResponse) GetPrices() []AssetPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+// QueryFeeTokensRequest is the request type for the Query/FeeTokens RPC method.
+type QueryFeeTokensRequest struct {
+}
+
+func (m *QueryFeeTokensRequest) Reset()         { *m = QueryFeeTokensRequest{} }
+func (m *QueryFeeTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokensRequest) ProtoMessage()    {}
+func (*QueryFeeTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecdc417ac789c39d, []int{10}
+}
+func (m *QueryFeeTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokensRequest.Merge(m, src)
+}
+func (m *QueryFeeTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokensRequest proto.InternalMessageInfo
+
+// QueryFeeTokensResponse is the response type for the Query/FeeTokens RPC
+// method.
+type QueryFeeTokensResponse struct {
+	// fee_tokens is a slice of all the fee tokens
+	FeeTokens []FeeToken `protobuf:"bytes,1,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+}
+
+func (m *QueryFeeTokensResponse) Reset()         { *m = QueryFeeTokensResponse{} }
+func (m *QueryFeeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokensResponse) ProtoMessage()    {}
+func (*QueryFeeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecdc417ac789c39d, []int{11}
+}
+func (m *QueryFeeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokensResponse.Merge(m, src)
+}
+func (m *QueryFeeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokensResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokensResponse) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+// QueryFeeTokenPreferenceRequest is the request type for the
+// Query/FeeTokenPreference RPC method.
+type QueryFeeTokenPreferenceRequest struct {
+	// address is the bech32 or hex address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFeeTokenPreferenceRequest) Reset()         { *m = QueryFeeTokenPreferenceRequest{} }
+func (m *QueryFeeTokenPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenPreferenceRequest) ProtoMessage()    {}
+func (*QueryFeeTokenPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecdc417ac789c39d, []int{12}
+}
+func (m *QueryFeeTokenPreferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenPreferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenPreferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenPreferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenPreferenceRequest.Merge(m, src)
+}
+func (m *QueryFeeTokenPreferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenPreferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenPreferenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenPreferenceRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokenPreferenceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryFeeTokenPreferenceResponse is the response type for the
+// Query/FeeTokenPreference RPC method.
+type QueryFeeTokenPreferenceResponse struct {
+	// denom is the denom of the fee token chosen by the account, empty when the
+	// account pays its fees with the EVM denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFeeTokenPreferenceResponse) Reset()         { *m = QueryFeeTokenPreferenceResponse{} }
+func (m *QueryFeeTokenPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenPreferenceResponse) ProtoMessage()    {}
+func (*QueryFeeTokenPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecdc417ac789c39d, []int{13}
+}
+func (m *QueryFeeTokenPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenPreferenceResponse.Merge(m, src)
+}
+func (m *QueryFeeTokenPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenPreferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenPreferenceResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokenPreferenceResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryBalanceOfRequest is the request type for the Query/BalanceOf RPC method
 type QueryERC20BalanceOfRequest struct {
 	// address is the ethereum hex address to query the balance for.
@@ -427,7 +691,7 @@ func (m *QueryERC20BalanceOfRequest) Reset()         { *m = QueryERC20BalanceOfR
 func (m *QueryERC20BalanceOfRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryERC20BalanceOfRequest) ProtoMessage()    {}
 func (*QueryERC20BalanceOfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecdc417ac789c39d, []int{8}
+	return fileDescriptor_ecdc417ac789c39d, []int{14}
 }
 func (m *QueryERC20BalanceOfRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +744,7 @@ func (m *QueryERC20BalanceOfResponse) Reset()         { *m = QueryERC20BalanceOf
 func (m *QueryERC20BalanceOfResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryERC20BalanceOfResponse) ProtoMessage()    {}
 func (*QueryERC20BalanceOfResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecdc417ac789c39d, []int{9}
+	return fileDescriptor_ecdc417ac789c39d, []int{15}
 }
 func (m *QueryERC20BalanceOfResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,6 +789,12 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "helios.erc20.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryWhitelistedAssetsRequest)(nil), "helios.erc20.v1.QueryWhitelistedAssetsRequest")
 	proto.RegisterType((*QueryWhitelistedAssetsResponse)(nil), "helios.erc20.v1.QueryWhitelistedAssetsResponse")
+	proto.RegisterType((*QueryAssetPricesRequest)(nil), "helios.erc20.v1.QueryAssetPricesRequest")
+	proto.RegisterType((*QueryAssetPricesResponse)(nil), "helios.erc20.v1.QueryAssetPricesResponse")
+	proto.RegisterType((*QueryFeeTokensRequest)(nil), "helios.erc20.v1.QueryFeeTokensRequest")
+	proto.RegisterType((*QueryFeeTokensResponse)(nil), "helios.erc20.v1.QueryFeeTokensResponse")
+	proto.RegisterType((*QueryFeeTokenPreferenceRequest)(nil), "helios.erc20.v1.QueryFeeTokenPreferenceRequest")
+	proto.RegisterType((*QueryFeeTokenPreferenceResponse)(nil), "helios.erc20.v1.QueryFeeTokenPreferenceResponse")
 	proto.RegisterType((*QueryERC20BalanceOfRequest)(nil), "helios.erc20.v1.QueryERC20BalanceOfRequest")
 	proto.RegisterType((*QueryERC20BalanceOfResponse)(nil), "helios.erc20.v1.QueryERC20BalanceOfResponse")
 }
@@ -532,51 +802,63 @@ func init() {
 func init() { proto.RegisterFile("helios/erc20/v1/query.proto", fileDescriptor_ecdc417ac789c39d) }
 
 var fileDescriptor_ecdc417ac789c39d = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4e, 0x14, 0x4f,
-	0x10, 0xc7, 0xb7, 0xf9, 0x85, 0x25, 0x5b, 0x24, 0x3f, 0x43, 0x8b, 0xb0, 0x0e, 0x30, 0x90, 0x01,
-	0x17, 0xc2, 0x9f, 0x69, 0x76, 0x35, 0x31, 0x7a, 0x52, 0x54, 0xbc, 0x98, 0x08, 0x1b, 0x13, 0x13,
-	0x0f, 0x62, 0xef, 0xd2, 0x0c, 0x13, 0x97, 0xe9, 0x61, 0xba, 0x41, 0x09, 0xe1, 0x62, 0xe2, 0xc5,
-	0x93, 0x89, 0x4f, 0x40, 0xe2, 0xc1, 0x78, 0xf2, 0x31, 0x38, 0x92, 0x78, 0x31, 0x1e, 0x8c, 0x01,
-	0x13, 0x5f, 0xc3, 0x6c, 0x77, 0xcf, 0xee, 0xce, 0xce, 0xc2, 0x72, 0xe0, 0x42, 0xba, 0xab, 0xeb,
-	0x5b, 0xf5, 0xa9, 0x9a, 0x2a, 0x16, 0x46, 0x36, 0x59, 0xcd, 0xe7, 0x82, 0xb0, 0xa8, 0x5a, 0x5a,
-	0x24, 0xbb, 0x45, 0xb2, 0xbd, 0xc3, 0xa2, 0x3d, 0x37, 0x8c, 0xb8, 0xe4, 0xf8, 0x8a, 0x7e, 0x74,
-	0xd5, 0xa3, 0xbb, 0x5b, 0xb4, 0x06, 0xe8, 0x96, 0x1f, 0x70, 0xa2, 0xfe, 0x6a, 0x1f, 0x6b, 0xb6,
-	0xca, 0xc5, 0x16, 0x17, 0xa4, 0x42, 0x05, 0xd3, 0x62, 0xb2, 0x5b, 0xac, 0x30, 0x49, 0x8b, 0x24,
-	0xa4, 0x9e, 0x1f, 0x50, 0xe9, 0xf3, 0xc0, 0xf8, 0x0e, 0x7a, 0xdc, 0xe3, 0xea, 0x48, 0xea, 0x27,
-	0x63, 0x1d, 0xf5, 0x38, 0xf7, 0x6a, 0x8c, 0xd0, 0xd0, 0x27, 0x34, 0x08, 0xb8, 0x54, 0x12, 0x61,
-	0x5e, 0x53, 0x80, 0x1a, 0x46, 0x3f, 0x8e, 0xb5, 0x3f, 0x7a, 0x2c, 0x60, 0xc2, 0x37, 0x5a, 0xe7,
-	0x15, 0x0c, 0xad, 0xd6, 0x89, 0x9e, 0xf1, 0xd7, 0x2c, 0x58, 0xa1, 0x7e, 0x24, 0xca, 0x6c, 0x7b,
-	0x87, 0x09, 0x89, 0x97, 0x01, 0x9a, 0x74, 0x79, 0x34, 0x81, 0x66, 0xfa, 0x4b, 0x05, 0x57, 0x97,
-	0xe2, 0xd6, 0x4b, 0x71, 0x75, 0x1f, 0x4c, 0x29, 0xee, 0x0a, 0xf5, 0x98, 0xd1, 0x96, 0x5b, 0x94,
-	0xce, 0x57, 0x04, 0xc3, 0xa9, 0x14, 0x22, 0xe4, 0x81, 0x60, 0x78, 0x19, 0xfa, 0x65, 0xdd, 0xba,
-	0x16, 0xd6, 0xcd, 0x79, 0x34, 0xf1, 0xdf, 0x4c, 0x7f, 0xc9, 0x72, 0xdb, 0x7a, 0xea, 0x36, 0x94,
-	0x4b, 0xb9, 0xa3, 0x5f, 0xe3, 0x99, 0x2f, 0x7f, 0xbf, 0xcd, 0xa2, 0x32, 0xc8, 0x46, 0x3c, 0xfc,
-	0x38, 0xc1, 0xda, 0xa3, 0x58, 0xa7, 0xbb, 0xb2, 0x6a, 0x88, 0x04, 0xec, 0x02, 0x5c, 0x4b, 0xb2,
-	0xc6, 0xdd, 0x18, 0x84, 0x5e, 0x95, 0x4f, 0x35, 0x22, 0x57, 0xd6, 0x17, 0xe7, 0x65, 0x7b, 0xf7,
-	0x1a, 0x95, 0x3d, 0x04, 0x68, 0x56, 0x66, 0xba, 0x77, 0xc1, 0xc2, 0x72, 0x8d, 0xc2, 0x9c, 0x41,
-	0xc0, 0x2a, 0xfe, 0x0a, 0x8d, 0xe8, 0x56, 0xfc, 0x65, 0x9c, 0x55, 0xb8, 0x9a, 0xb0, 0x9a, 0x94,
-	0x77, 0x21, 0x1b, 0x2a, 0x8b, 0x49, 0x37, 0x9c, 0x4a, 0xa7, 0x05, 0xad, 0xb9, 0x8c, 0xc2, 0xf1,
-	0x60, 0x4c, 0x85, 0x7c, 0xbe, 0xe9, 0x4b, 0x56, 0xf3, 0x85, 0x64, 0xeb, 0xf7, 0x85, 0x60, 0xf2,
-	0xd2, 0xa7, 0xe1, 0x33, 0x02, 0xfb, 0xac, 0x4c, 0xa6, 0x8e, 0x3b, 0x90, 0xa5, 0xca, 0x62, 0xe6,
-	0x61, 0x28, 0x55, 0x87, 0x12, 0x24, 0xca, 0xd0, 0x82, 0xcb, 0x9b, 0x83, 0x27, 0x60, 0x29, 0xca,
-	0x47, 0xe5, 0x07, 0xa5, 0xc5, 0x25, 0x5a, 0xa3, 0x41, 0x95, 0x3d, 0xdd, 0x88, 0x9b, 0x91, 0x87,
-	0x3e, 0xba, 0xbe, 0x1e, 0x31, 0x21, 0xcc, 0x38, 0xc4, 0xd7, 0xe6, 0x98, 0xf4, 0xb4, 0x8e, 0xc9,
-	0x6d, 0x18, 0xe9, 0x18, 0xcd, 0x14, 0x9c, 0x87, 0xbe, 0x8a, 0x36, 0xc6, 0xe1, 0xcc, 0xb5, 0xf4,
-	0xb3, 0x17, 0x7a, 0x95, 0x12, 0xbf, 0x47, 0x00, 0xcd, 0x05, 0xc2, 0xd3, 0xa9, 0x9e, 0x74, 0xde,
-	0x62, 0x6b, 0xa6, 0xbb, 0xa3, 0xa6, 0x70, 0xa6, 0xde, 0x7d, 0xff, 0xf3, 0xa9, 0xc7, 0xc6, 0xa3,
-	0xa4, 0xfd, 0x3f, 0x46, 0xcb, 0x8a, 0xe2, 0x0f, 0x08, 0x72, 0x0d, 0x31, 0x2e, 0x74, 0x89, 0x1e,
-	0x53, 0x4c, 0x77, 0xf5, 0x33, 0x10, 0xf3, 0x0a, 0xa2, 0x80, 0xa7, 0xce, 0x83, 0x20, 0xfb, 0xea,
-	0x72, 0x80, 0x25, 0x64, 0xf5, 0x48, 0xe3, 0xc9, 0xce, 0x09, 0x12, 0x7b, 0x63, 0x4d, 0x9d, 0xef,
-	0x64, 0x10, 0xc6, 0x15, 0xc2, 0x75, 0x3c, 0x9c, 0x42, 0xd0, 0xbb, 0x82, 0x0f, 0x11, 0x0c, 0xa4,
-	0xa6, 0x17, 0xbb, 0x9d, 0x83, 0x9f, 0xb5, 0x50, 0x16, 0xb9, 0xb0, 0xbf, 0xe1, 0x9a, 0x53, 0x5c,
-	0x37, 0xf0, 0x64, 0x8a, 0xeb, 0x4d, 0x53, 0xb3, 0x66, 0x16, 0xe1, 0x10, 0xc1, 0xff, 0xc9, 0x69,
-	0xc3, 0x73, 0x9d, 0x13, 0x76, 0x9c, 0x70, 0x6b, 0xfe, 0x62, 0xce, 0x06, 0xed, 0x96, 0x42, 0x73,
-	0xf1, 0x7c, 0x0a, 0xcd, 0x0c, 0x32, 0xdf, 0x20, 0xfb, 0x66, 0x45, 0x0e, 0xe2, 0xaf, 0xb7, 0x74,
-	0xef, 0xe8, 0xc4, 0x46, 0xc7, 0x27, 0x36, 0xfa, 0x7d, 0x62, 0xa3, 0x8f, 0xa7, 0x76, 0xe6, 0xf8,
-	0xd4, 0xce, 0xfc, 0x38, 0xb5, 0x33, 0x2f, 0x0a, 0x3a, 0xcc, 0x42, 0x95, 0x47, 0x8c, 0xc4, 0xe7,
-	0x4d, 0xea, 0x07, 0xe4, 0xad, 0x09, 0x2d, 0xf7, 0x42, 0x26, 0x2a, 0x59, 0xf5, 0x1b, 0x76, 0xf3,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x85, 0xe6, 0xb7, 0xa2, 0x07, 0x00, 0x00,
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x41, 0x71, 0xe5, 0x17, 0x09, 0xd4, 0x21, 0x24, 0xce, 0xa6, 0x5e, 0x57, 0x9b,
+	0xd4, 0x0e, 0x6d, 0xba, 0x13, 0x1b, 0xa4, 0x88, 0x1e, 0x10, 0xa4, 0x10, 0x2e, 0x48, 0xb8, 0x16,
+	0x12, 0x52, 0x25, 0x30, 0x63, 0x7b, 0xbc, 0x59, 0x61, 0xef, 0x6c, 0x77, 0xb6, 0x81, 0xaa, 0xea,
+	0x01, 0x24, 0x0e, 0x70, 0x42, 0xe2, 0xcc, 0x01, 0x89, 0x03, 0xe2, 0xd4, 0x8f, 0xd1, 0x63, 0x25,
+	0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x1a, 0xc8, 0x33, 0x6f, 0xd7, 0x5e, 0x8f, 0x1d, 0xfb, 0x90,
+	0x4b, 0xe4, 0x99, 0x79, 0xff, 0xf7, 0x7e, 0x6f, 0xf6, 0xcd, 0x5f, 0x81, 0x9d, 0x53, 0x3e, 0x08,
+	0x84, 0xa4, 0x3c, 0xee, 0x36, 0x0e, 0xe9, 0x59, 0x9d, 0x3e, 0x7a, 0xcc, 0xe3, 0x27, 0x5e, 0x14,
+	0x8b, 0x44, 0x90, 0xd7, 0xf4, 0xa1, 0xa7, 0x0e, 0xbd, 0xb3, 0xba, 0x7d, 0x9d, 0x0d, 0x83, 0x50,
+	0x50, 0xf5, 0x57, 0xc7, 0xd8, 0xb7, 0xbb, 0x42, 0x0e, 0x85, 0xa4, 0x1d, 0x26, 0xb9, 0x16, 0xd3,
+	0xb3, 0x7a, 0x87, 0x27, 0xac, 0x4e, 0x23, 0xe6, 0x07, 0x21, 0x4b, 0x02, 0x11, 0x62, 0xec, 0x86,
+	0x2f, 0x7c, 0xa1, 0x7e, 0xd2, 0xd1, 0x2f, 0xdc, 0xbd, 0xe1, 0x0b, 0xe1, 0x0f, 0x38, 0x65, 0x51,
+	0x40, 0x59, 0x18, 0x8a, 0x44, 0x49, 0x24, 0x9e, 0x1a, 0x80, 0x1a, 0x46, 0x1f, 0x96, 0xa7, 0x0f,
+	0x7d, 0x1e, 0x72, 0x19, 0xa0, 0xd6, 0xfd, 0x12, 0x36, 0x1f, 0x8c, 0x88, 0x3e, 0x15, 0x5f, 0xf1,
+	0xb0, 0xc9, 0x82, 0x58, 0xb6, 0xf8, 0xa3, 0xc7, 0x5c, 0x26, 0xe4, 0x04, 0x60, 0x4c, 0x57, 0xb2,
+	0x6e, 0x5a, 0xfb, 0xeb, 0x8d, 0xaa, 0xa7, 0x5b, 0xf1, 0x46, 0xad, 0x78, 0xfa, 0x1e, 0xb0, 0x15,
+	0xaf, 0xc9, 0x7c, 0x8e, 0xda, 0xd6, 0x84, 0xd2, 0xfd, 0xc3, 0x82, 0x2d, 0xa3, 0x84, 0x8c, 0x44,
+	0x28, 0x39, 0x39, 0x81, 0xf5, 0x64, 0xb4, 0xdb, 0x8e, 0x46, 0xdb, 0x25, 0xeb, 0xe6, 0x2b, 0xfb,
+	0xeb, 0x0d, 0xdb, 0x9b, 0xba, 0x53, 0x2f, 0x53, 0x1e, 0x17, 0x5f, 0xfc, 0x5d, 0x59, 0xf9, 0xfd,
+	0xbf, 0xe7, 0xb7, 0xad, 0x16, 0x24, 0x59, 0x3e, 0xf2, 0x51, 0x8e, 0x75, 0x55, 0xb1, 0xd6, 0x16,
+	0xb2, 0x6a, 0x88, 0x1c, 0xec, 0x5d, 0x78, 0x23, 0xcf, 0x9a, 0xde, 0xc6, 0x06, 0xac, 0xa9, 0x7a,
+	0xea, 0x22, 0x8a, 0x2d, 0xbd, 0x70, 0xbf, 0x98, 0xbe, 0xbd, 0xac, 0xb3, 0x0f, 0x00, 0xc6, 0x9d,
+	0xe1, 0xed, 0x2d, 0xd9, 0x58, 0x31, 0x6b, 0xcc, 0xdd, 0x00, 0xa2, 0xf2, 0x37, 0x59, 0xcc, 0x86,
+	0xe9, 0x97, 0x71, 0x1f, 0xc0, 0xeb, 0xb9, 0x5d, 0x2c, 0x79, 0x0f, 0x0a, 0x91, 0xda, 0xc1, 0x72,
+	0x5b, 0x46, 0x39, 0x2d, 0x98, 0xac, 0x85, 0x0a, 0xd7, 0x87, 0xb2, 0x4a, 0xf9, 0xd9, 0x69, 0x90,
+	0xf0, 0x41, 0x20, 0x13, 0xde, 0x7b, 0x5f, 0x4a, 0x9e, 0x5c, 0xf9, 0x34, 0xfc, 0x66, 0x81, 0x33,
+	0xaf, 0x12, 0xf6, 0xf1, 0x0e, 0x14, 0x98, 0xda, 0xc1, 0x79, 0xd8, 0x34, 0xfa, 0x50, 0x82, 0x5c,
+	0x1b, 0x5a, 0x70, 0x75, 0x73, 0xb0, 0x8d, 0x33, 0xab, 0x2a, 0x35, 0xe3, 0xa0, 0xcb, 0xb3, 0xdb,
+	0x7f, 0x08, 0x25, 0xf3, 0x08, 0xd1, 0xdf, 0x85, 0x42, 0xa4, 0x76, 0x10, 0x7d, 0x67, 0x36, 0xba,
+	0x52, 0xe5, 0x3f, 0x83, 0x52, 0xb9, 0x5b, 0x38, 0x7e, 0x27, 0x9c, 0xab, 0xd1, 0xc8, 0x8a, 0x7e,
+	0x0e, 0x9b, 0xd3, 0x07, 0x58, 0xf2, 0x3e, 0x40, 0x9f, 0xf3, 0xb6, 0x9a, 0x99, 0xb4, 0xec, 0xb6,
+	0x51, 0x36, 0xd5, 0xe5, 0xe6, 0xac, 0x9f, 0x26, 0x73, 0xef, 0xe1, 0x47, 0x49, 0xc3, 0x9a, 0x31,
+	0xef, 0xf3, 0x98, 0x87, 0xdd, 0xf4, 0x1b, 0x92, 0x12, 0x5c, 0x63, 0xbd, 0x5e, 0xcc, 0xa5, 0xc4,
+	0x17, 0x90, 0x2e, 0xdd, 0x23, 0xa8, 0xcc, 0xd5, 0x22, 0xe3, 0x06, 0xac, 0xf5, 0x78, 0x28, 0x86,
+	0xe9, 0xe3, 0x51, 0x0b, 0xf7, 0x63, 0xb0, 0x95, 0xf0, 0xc3, 0xd6, 0xfd, 0xc6, 0xe1, 0x31, 0x1b,
+	0xb0, 0xb0, 0xcb, 0x3f, 0xe9, 0x2f, 0x2c, 0x38, 0x7e, 0x8a, 0xab, 0x93, 0x4f, 0xf1, 0x08, 0x76,
+	0x66, 0x66, 0x43, 0x84, 0x12, 0x5c, 0xeb, 0xe8, 0xcd, 0x34, 0x1d, 0x2e, 0x1b, 0xbf, 0x14, 0x61,
+	0x4d, 0x29, 0xc9, 0xf7, 0x16, 0xc0, 0xd8, 0xa4, 0x48, 0xcd, 0xb8, 0xc5, 0xd9, 0x4e, 0x69, 0xef,
+	0x2f, 0x0e, 0xd4, 0x14, 0xee, 0xde, 0x77, 0x7f, 0xfe, 0xfb, 0xf3, 0xaa, 0x43, 0x6e, 0xd0, 0x69,
+	0x57, 0x9e, 0xb0, 0x41, 0xf2, 0xa3, 0x05, 0xc5, 0x4c, 0x4c, 0xaa, 0x0b, 0xb2, 0xa7, 0x14, 0xb5,
+	0x85, 0x71, 0x08, 0x71, 0xa0, 0x20, 0xaa, 0x64, 0xef, 0x32, 0x08, 0xfa, 0x54, 0x2d, 0x9e, 0x91,
+	0x04, 0x0a, 0xda, 0x36, 0xc8, 0xee, 0xec, 0x02, 0x39, 0x6f, 0xb2, 0xf7, 0x2e, 0x0f, 0x42, 0x84,
+	0x8a, 0x42, 0xd8, 0x26, 0x5b, 0x06, 0x82, 0xf6, 0x23, 0xf2, 0xab, 0x05, 0xd7, 0x0d, 0x87, 0x20,
+	0xde, 0xec, 0xe4, 0xf3, 0x4c, 0xcb, 0xa6, 0x4b, 0xc7, 0x23, 0xd7, 0x1d, 0xc5, 0x75, 0x8b, 0xec,
+	0x1a, 0x5c, 0x5f, 0x8f, 0x35, 0x6d, 0x34, 0x9b, 0x1f, 0x2c, 0x58, 0x9f, 0x30, 0x01, 0x32, 0x67,
+	0x0c, 0x4c, 0x0b, 0xb1, 0xdf, 0x5c, 0x22, 0x12, 0x89, 0x6e, 0x29, 0xa2, 0x0a, 0x29, 0x1b, 0x44,
+	0x8a, 0xa2, 0xad, 0x8d, 0x83, 0x7c, 0x6b, 0x41, 0x31, 0xf3, 0x86, 0x79, 0x23, 0x33, 0xed, 0x2a,
+	0x76, 0x6d, 0x61, 0x1c, 0x52, 0xec, 0x2a, 0x8a, 0x32, 0xd9, 0x31, 0x28, 0xc6, 0xde, 0x43, 0x9e,
+	0x5b, 0x40, 0x4c, 0x13, 0x20, 0xf4, 0xf2, 0x22, 0x86, 0xd5, 0xd8, 0x87, 0xcb, 0x0b, 0x10, 0xef,
+	0x48, 0xe1, 0xd5, 0x09, 0x9d, 0x8f, 0xd7, 0x8e, 0x32, 0x19, 0x7d, 0x8a, 0x4e, 0xf2, 0x6c, 0x34,
+	0x66, 0xaf, 0xe6, 0x0d, 0x83, 0xdc, 0x99, 0x5d, 0x7d, 0xa6, 0x49, 0xd9, 0x07, 0xcb, 0x05, 0x23,
+	0xe6, 0xdb, 0x0a, 0xd3, 0x23, 0x07, 0x06, 0x26, 0x7a, 0x91, 0xe8, 0x8f, 0xd9, 0xd2, 0x07, 0x78,
+	0xfc, 0xde, 0x8b, 0x73, 0xc7, 0x7a, 0x79, 0xee, 0x58, 0xff, 0x9c, 0x3b, 0xd6, 0x4f, 0x17, 0xce,
+	0xca, 0xcb, 0x0b, 0x67, 0xe5, 0xaf, 0x0b, 0x67, 0xe5, 0x61, 0x55, 0xa7, 0xb9, 0xdb, 0x15, 0x31,
+	0xa7, 0xe9, 0xef, 0x53, 0x16, 0x84, 0xf4, 0x1b, 0x4c, 0x9d, 0x3c, 0x89, 0xb8, 0xec, 0x14, 0xd4,
+	0xbf, 0x7a, 0x6f, 0xfd, 0x3f, 0x00, 0x62, 0x59, 0xc3, 0x9f, 0xc9, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -599,6 +881,13 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// WhitelistedAssets retrieves all whitelisted assets
 	WhitelistedAssets(ctx context.Context, in *QueryWhitelistedAssetsRequest, opts ...grpc.CallOption) (*QueryWhitelistedAssetsResponse, error)
+	// AssetPrices retrieves the price table of the whitelisted assets
+	AssetPrices(ctx context.Context, in *QueryAssetPricesRequest, opts ...grpc.CallOption) (*QueryAssetPricesResponse, error)
+	// FeeTokens retrieves the tokens accepted to pay the fees of the Ethereum
+	// transactions
+	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+	// FeeTokenPreference retrieves the fee token chosen by an account
+	FeeTokenPreference(ctx context.Context, in *QueryFeeTokenPreferenceRequest, opts ...grpc.CallOption) (*QueryFeeTokenPreferenceResponse, error)
 	// BalanceOf queries the balance of an ERC20 token for a single account.
 	ERC20BalanceOf(ctx context.Context, in *QueryERC20BalanceOfRequest, opts ...grpc.CallOption) (*QueryERC20BalanceOfResponse, error)
 }
@@ -647,6 +936,33 @@ func (c *queryClient) WhitelistedAssets(ctx context.Context, in *QueryWhiteliste
 	return out, nil
 }
 
+func (c *queryClient) AssetPrices(ctx context.Context, in *QueryAssetPricesRequest, opts ...grpc.CallOption) (*QueryAssetPricesResponse, error) {
+	out := new(QueryAssetPricesResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Query/AssetPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
+	out := new(QueryFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Query/FeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokenPreference(ctx context.Context, in *QueryFeeTokenPreferenceRequest, opts ...grpc.CallOption) (*QueryFeeTokenPreferenceResponse, error) {
+	out := new(QueryFeeTokenPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Query/FeeTokenPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ERC20BalanceOf(ctx context.Context, in *QueryERC20BalanceOfRequest, opts ...grpc.CallOption) (*QueryERC20BalanceOfResponse, error) {
 	out := new(QueryERC20BalanceOfResponse)
 	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Query/ERC20BalanceOf", in, out, opts...)
@@ -666,6 +982,13 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// WhitelistedAssets retrieves all whitelisted assets
 	WhitelistedAssets(context.Context, *QueryWhitelistedAssetsRequest) (*QueryWhitelistedAssetsResponse, error)
+	// AssetPrices retrieves the price table of the whitelisted assets
+	AssetPrices(context.Context, *QueryAssetPricesRequest) (*QueryAssetPricesResponse, error)
+	// FeeTokens retrieves the tokens accepted to pay the fees of the Ethereum
+	// transactions
+	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+	// FeeTokenPreference retrieves the fee token chosen by an account
+	FeeTokenPreference(context.Context, *QueryFeeTokenPreferenceRequest) (*QueryFeeTokenPreferenceResponse, error)
 	// BalanceOf queries the balance of an ERC20 token for a single account.
 	ERC20BalanceOf(context.Context, *QueryERC20BalanceOfRequest) (*QueryERC20BalanceOfResponse, error)
 }
@@ -686,6 +1009,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) WhitelistedAssets(ctx context.Context, req *QueryWhitelistedAssetsRequest) (*QueryWhitelistedAssetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedAssets not implemented")
 }
+func (*UnimplementedQueryServer) AssetPrices(ctx context.Context, req *QueryAssetPricesRequest) (*QueryAssetPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetPrices not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokenPreference(ctx context.Context, req *QueryFeeTokenPreferenceRequest) (*QueryFeeTokenPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokenPreference not implemented")
+}
 func (*UnimplementedQueryServer) ERC20BalanceOf(ctx context.Context, req *QueryERC20BalanceOfRequest) (*QueryERC20BalanceOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ERC20BalanceOf not implemented")
 }
@@ -766,6 +1098,60 @@ func _Query_WhitelistedAssets_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AssetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Query/AssetPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetPrices(ctx, req.(*QueryAssetPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Query/FeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokenPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokenPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Query/FeeTokenPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokenPreference(ctx, req.(*QueryFeeTokenPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ERC20BalanceOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryERC20BalanceOfRequest)
 	if err := dec(in); err != nil {
@@ -784,6 +1170,7 @@ func _Query_ERC20BalanceOf_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helios.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -804,6 +1191,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WhitelistedAssets",
 			Handler:    _Query_WhitelistedAssets_Handler,
 		},
+		{
+			MethodName: "AssetPrices",
+			Handler:    _Query_AssetPrices_Handler,
+		},
+		{
+			MethodName: "FeeTokens",
+			Handler:    _Query_FeeTokens_Handler,
+		},
+		{
+			MethodName: "FeeTokenPreference",
+			Handler:    _Query_FeeTokenPreference_Handler,
+		},
 		{
 			MethodName: "ERC20BalanceOf",
 			Handler:    _Query_ERC20BalanceOf_Handler,
@@ -1100,7 +1499,7 @@ func (m *QueryWhitelistedAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryERC20BalanceOfRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetPricesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1110,34 +1509,20 @@ func (m *QueryERC20BalanceOfRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryERC20BalanceOfRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetPricesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryERC20BalanceOfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryERC20BalanceOfResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAssetPricesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1147,31 +1532,225 @@ func (m *QueryERC20BalanceOfResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryERC20BalanceOfResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAssetPricesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryERC20BalanceOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAssetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		i -= len(m.Balance)
-		copy(dAtA[i:], m.Balance)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenPreferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenPreferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenPreferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20BalanceOfRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20BalanceOfRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20BalanceOfRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20BalanceOfResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20BalanceOfResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20BalanceOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
@@ -1286,6 +1865,80 @@ func (m *QueryWhitelistedAssetsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAssetPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAssetPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeTokenPreferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryERC20BalanceOfRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2032,6 +2685,438 @@ func (m *QueryWhitelistedAssetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAssetPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, AssetPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokenPreferenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenPreferenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenPreferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokenPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryERC20BalanceOfRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -809,864 +809,1189 @@ func (m *MsgUpdateAssetConsensusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateAssetConsensusResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgConvertERC20)(nil), "helios.erc20.v1.MsgConvertERC20")
-	proto.RegisterType((*MsgConvertERC20Response)(nil), "helios.erc20.v1.MsgConvertERC20Response")
-	proto.RegisterType((*MsgConvertCoin)(nil), "helios.erc20.v1.MsgConvertCoin")
-	proto.RegisterType((*MsgConvertCoinResponse)(nil), "helios.erc20.v1.MsgConvertCoinResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "helios.erc20.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "helios.erc20.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRegisterERC20)(nil), "helios.erc20.v1.MsgRegisterERC20")
-	proto.RegisterType((*MsgRegisterERC20Response)(nil), "helios.erc20.v1.MsgRegisterERC20Response")
-	proto.RegisterType((*MsgToggleConversion)(nil), "helios.erc20.v1.MsgToggleConversion")
-	proto.RegisterType((*MsgToggleConversionResponse)(nil), "helios.erc20.v1.MsgToggleConversionResponse")
-	proto.RegisterType((*MsgAddAssetConsensus)(nil), "helios.erc20.v1.MsgAddAssetConsensus")
-	proto.RegisterType((*MsgAddAssetConsensusResponse)(nil), "helios.erc20.v1.MsgAddAssetConsensusResponse")
-	proto.RegisterType((*MsgRemoveAssetConsensus)(nil), "helios.erc20.v1.MsgRemoveAssetConsensus")
-	proto.RegisterType((*MsgRemoveAssetConsensusResponse)(nil), "helios.erc20.v1.MsgRemoveAssetConsensusResponse")
-	proto.RegisterType((*MsgUpdateAssetConsensus)(nil), "helios.erc20.v1.MsgUpdateAssetConsensus")
-	proto.RegisterType((*MsgUpdateAssetConsensusResponse)(nil), "helios.erc20.v1.MsgUpdateAssetConsensusResponse")
+// MsgSetAssetPrices is the Msg/SetAssetPrices request type for setting the
+// prices of the whitelisted assets.
+type MsgSetAssetPrices struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// prices is the list of asset prices to set
+	Prices []AssetPrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
 }
 
-func init() { proto.RegisterFile("helios/erc20/v1/tx.proto", fileDescriptor_a553f77c501c46b9) }
-
-var fileDescriptor_a553f77c501c46b9 = []byte{
-	// 926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6b, 0x24, 0x45,
-	0x14, 0x4e, 0x27, 0xd9, 0xd1, 0x54, 0xd6, 0x4d, 0xb6, 0x9d, 0x4d, 0x3a, 0xbd, 0x9b, 0x4e, 0xd2,
-	0x98, 0x65, 0x36, 0x98, 0xee, 0xcc, 0xec, 0x2a, 0x32, 0x20, 0x98, 0x09, 0x1e, 0x3c, 0x0c, 0x48,
-	0xab, 0x08, 0x0b, 0x12, 0x2a, 0xdd, 0x65, 0x4d, 0xb1, 0xe9, 0xaa, 0xa1, 0xab, 0x32, 0x6c, 0x6e,
-	0xb2, 0x78, 0xd1, 0x93, 0xe0, 0xc9, 0xb3, 0x08, 0x1e, 0x73, 0x10, 0x3c, 0xf8, 0x07, 0x16, 0x4f,
-	0x8b, 0x7b, 0x11, 0x0f, 0x8b, 0x24, 0x42, 0xfe, 0x86, 0x54, 0x57, 0x4d, 0x67, 0xa6, 0xab, 0x77,
-	0x77, 0x08, 0xb9, 0x84, 0xa9, 0xf7, 0xbe, 0xf7, 0xde, 0xf7, 0xbd, 0xf7, 0xaa, 0x3a, 0xc0, 0xe9,
-	0xa1, 0x43, 0xc2, 0x78, 0x88, 0xb2, 0xb8, 0xb5, 0x13, 0x0e, 0x9a, 0xa1, 0x78, 0x1c, 0xf4, 0x33,
-	0x26, 0x98, 0xbd, 0xa0, 0x3c, 0x41, 0xee, 0x09, 0x06, 0x4d, 0xf7, 0x26, 0x4c, 0x09, 0x65, 0x61,
-	0xfe, 0x57, 0x61, 0x5c, 0x2f, 0x66, 0x3c, 0x65, 0x3c, 0x3c, 0x80, 0x1c, 0x85, 0x83, 0xe6, 0x01,
-	0x12, 0xb0, 0x19, 0xc6, 0x8c, 0x50, 0xed, 0x5f, 0xd6, 0xfe, 0x94, 0x63, 0x99, 0x3b, 0xe5, 0x58,
-	0x3b, 0x56, 0x94, 0x63, 0x3f, 0x3f, 0x85, 0xea, 0xa0, 0x5d, 0xb7, 0xcb, 0x8c, 0x14, 0x01, 0xe5,
-	0x5c, 0x2d, 0x3b, 0x31, 0xa2, 0x88, 0x93, 0x61, 0x6c, 0x1d, 0x33, 0xcc, 0x54, 0x4e, 0xf9, 0x4b,
-	0x5b, 0xef, 0x60, 0xc6, 0xf0, 0x21, 0x0a, 0x61, 0x9f, 0x84, 0x90, 0x52, 0x26, 0xa0, 0x20, 0x8c,
-	0xea, 0x18, 0xff, 0xb9, 0x05, 0x16, 0xba, 0x1c, 0xef, 0x31, 0x3a, 0x40, 0x99, 0xf8, 0x38, 0xda,
-	0x6b, 0xed, 0xd8, 0xf7, 0xc0, 0x62, 0xcc, 0xa8, 0xc8, 0x60, 0x2c, 0xf6, 0x61, 0x92, 0x64, 0x88,
-	0x73, 0xc7, 0x5a, 0xb7, 0x1a, 0x73, 0xd1, 0xc2, 0xd0, 0xbe, 0xab, 0xcc, 0x76, 0x1b, 0xd4, 0x60,
-	0xca, 0x8e, 0xa8, 0x70, 0xa6, 0x25, 0xa0, 0xe3, 0x3f, 0x7d, 0xb1, 0x36, 0xf5, 0xcf, 0x8b, 0xb5,
-	0x5b, 0x4a, 0x14, 0x4f, 0x1e, 0x05, 0x84, 0x85, 0x29, 0x14, 0xbd, 0xe0, 0x13, 0x2a, 0x7e, 0x3d,
-	0x3f, 0xd9, 0xb2, 0x22, 0x1d, 0x61, 0xbb, 0xe0, 0xcd, 0x0c, 0xc5, 0x88, 0x0c, 0x50, 0xe6, 0xcc,
-	0xe4, 0xe9, 0x8b, 0xb3, 0xbd, 0x04, 0x6a, 0x1c, 0xd1, 0x04, 0x65, 0xce, 0x6c, 0xee, 0xd1, 0xa7,
-	0xf6, 0xe6, 0x93, 0xf3, 0x93, 0x2d, 0x7d, 0xf8, 0xfe, 0xfc, 0x64, 0xeb, 0x16, 0x1a, 0xc8, 0x0e,
-	0x97, 0x14, 0xf8, 0x2b, 0x60, 0xb9, 0x64, 0x8a, 0x10, 0xef, 0x33, 0xca, 0x91, 0x7f, 0x0c, 0x6e,
-	0x5c, 0xb8, 0xf6, 0x18, 0xa1, 0xf6, 0x7d, 0x30, 0x2b, 0x87, 0x96, 0x4b, 0x9c, 0x6f, 0xad, 0x04,
-	0x7a, 0x1e, 0x72, 0xaa, 0x81, 0x9e, 0x6a, 0x20, 0x81, 0x9d, 0x59, 0x29, 0x2e, 0xca, 0xc1, 0x63,
-	0xe4, 0xa7, 0x5f, 0x4a, 0x7e, 0x66, 0x94, 0xbc, 0xef, 0x80, 0xa5, 0xf1, 0xd2, 0x05, 0xa9, 0xdf,
-	0xd5, 0x14, 0xbe, 0xe8, 0x27, 0x50, 0xa0, 0x4f, 0x61, 0x06, 0x53, 0x6e, 0xbf, 0x0f, 0xe6, 0xe0,
-	0x91, 0xe8, 0xb1, 0x8c, 0x88, 0x63, 0xd5, 0xfe, 0x8e, 0xf3, 0xd7, 0x6f, 0xdb, 0x75, 0x4d, 0x4f,
-	0x4f, 0xe0, 0x33, 0x91, 0x11, 0x8a, 0xa3, 0x0b, 0xa8, 0x1c, 0x49, 0x3f, 0xcf, 0x90, 0xf3, 0x9a,
-	0x6f, 0x2d, 0x07, 0xa5, 0x55, 0x0e, 0x54, 0x81, 0xce, 0x9c, 0x94, 0xa3, 0x47, 0xa2, 0x22, 0xda,
-	0x3b, 0xb2, 0xbd, 0x17, 0xb9, 0x64, 0x87, 0x57, 0x55, 0x87, 0x1f, 0xeb, 0xa5, 0x2b, 0xb1, 0xd4,
-	0x9d, 0x1e, 0x35, 0x15, 0xa2, 0x7e, 0xb1, 0xc0, 0x62, 0x97, 0xe3, 0x08, 0x61, 0xc2, 0x05, 0xca,
-	0xd4, 0x6e, 0x5d, 0x56, 0xd5, 0x5d, 0x70, 0x23, 0x27, 0xa0, 0xf7, 0x11, 0x49, 0x75, 0x33, 0x8d,
-	0xb9, 0xa8, 0x64, 0x6d, 0x37, 0x4d, 0x05, 0x9e, 0xa1, 0x60, 0x8c, 0x92, 0xef, 0x02, 0xa7, 0x6c,
-	0x2b, 0x34, 0xfc, 0x64, 0x81, 0xb7, 0xbb, 0x1c, 0x7f, 0xce, 0x30, 0x3e, 0x44, 0x6a, 0x72, 0x9c,
-	0x30, 0x7a, 0x69, 0x19, 0x75, 0x70, 0x4d, 0xb0, 0x47, 0x88, 0xea, 0x9d, 0x51, 0x87, 0xf6, 0x03,
-	0x93, 0xf4, 0x86, 0x41, 0xba, 0xcc, 0xc1, 0x5f, 0x05, 0xb7, 0x2b, 0xcc, 0x05, 0xf5, 0x3f, 0x2c,
-	0x50, 0xef, 0x72, 0xbc, 0x9b, 0x24, 0xbb, 0x9c, 0x23, 0xb1, 0x27, 0x8d, 0x94, 0x1f, 0x5d, 0x7e,
-	0xb1, 0x1e, 0x80, 0x1a, 0x94, 0x99, 0x54, 0xeb, 0xe7, 0x5b, 0x4b, 0xc6, 0x62, 0xe5, 0x85, 0xf4,
-	0x35, 0xd1, 0xd8, 0xf6, 0x7b, 0xa6, 0x36, 0xdf, 0xd0, 0x66, 0x90, 0xf4, 0x3d, 0x70, 0xa7, 0xca,
-	0x5e, 0xa8, 0xfb, 0xd9, 0xca, 0x17, 0x2f, 0x42, 0x29, 0x1b, 0xa0, 0x2b, 0x12, 0xb8, 0x04, 0x6a,
-	0x09, 0xa2, 0x2c, 0x1d, 0xee, 0x96, 0x3e, 0xb5, 0x3f, 0x30, 0x25, 0x6c, 0x56, 0xec, 0x94, 0xc9,
-	0xc4, 0xdf, 0x00, 0x6b, 0x2f, 0x71, 0x15, 0x42, 0xfe, 0xb4, 0x46, 0x6e, 0xd0, 0x15, 0x09, 0xf9,
-	0x10, 0xbc, 0x71, 0x94, 0xe7, 0x1b, 0x8e, 0x6a, 0xd5, 0x18, 0xd5, 0x97, 0x88, 0xe0, 0x9e, 0x50,
-	0x55, 0xf5, 0xc4, 0x86, 0x31, 0x93, 0xe9, 0xad, 0x22, 0xac, 0xf5, 0x56, 0xb9, 0x86, 0x7a, 0x5b,
-	0xdf, 0xd5, 0xc0, 0x4c, 0x97, 0x63, 0xfb, 0x5b, 0x0b, 0x5c, 0x1f, 0xfb, 0xea, 0xac, 0x1b, 0x1c,
-	0x4b, 0x4f, 0xb8, 0xdb, 0x78, 0x1d, 0xa2, 0x68, 0x6a, 0xe3, 0xc9, 0xf3, 0xff, 0x7e, 0x9c, 0xf6,
-	0xed, 0xf5, 0x50, 0xf1, 0x1e, 0xf9, 0xbe, 0x87, 0xb1, 0x0a, 0xd8, 0xcf, 0x6d, 0xf6, 0x43, 0x70,
-	0x7d, 0xec, 0xd5, 0xad, 0x64, 0x31, 0x8a, 0xa8, 0x66, 0x51, 0xf5, 0x00, 0xda, 0x5f, 0x81, 0xb7,
-	0xc6, 0x1f, 0xbf, 0x8d, 0xaa, 0xd0, 0x31, 0x88, 0x7b, 0xef, 0xb5, 0x90, 0x22, 0xfd, 0xd7, 0x60,
-	0xd1, 0x78, 0x97, 0xde, 0xa9, 0x0a, 0x2f, 0xa3, 0xdc, 0x77, 0x27, 0x41, 0x15, 0x75, 0x08, 0xb8,
-	0x69, 0x3e, 0x22, 0x9b, 0x55, 0x29, 0x0c, 0x98, 0xbb, 0x3d, 0x11, 0xac, 0x28, 0x95, 0x81, 0x7a,
-	0xe5, 0x8d, 0x6e, 0x54, 0x77, 0xc5, 0x44, 0xba, 0x3b, 0x93, 0x22, 0x47, 0x6b, 0x56, 0x5e, 0xbe,
-	0x57, 0xcc, 0x79, 0x92, 0x9a, 0xaf, 0xba, 0x04, 0xee, 0xb5, 0x6f, 0xe4, 0x67, 0xb7, 0xf3, 0xd1,
-	0xd3, 0x53, 0xcf, 0x7a, 0x76, 0xea, 0x59, 0xff, 0x9e, 0x7a, 0xd6, 0x0f, 0x67, 0xde, 0xd4, 0xb3,
-	0x33, 0x6f, 0xea, 0xef, 0x33, 0x6f, 0xea, 0xe1, 0x5d, 0x95, 0x71, 0x3b, 0x66, 0x19, 0x0a, 0x87,
-	0xbf, 0x7b, 0x90, 0xd0, 0xe2, 0x0a, 0x8a, 0xe3, 0x3e, 0xe2, 0x07, 0xb5, 0xfc, 0xbf, 0xb8, 0xfb,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xd6, 0xdc, 0xf3, 0xc9, 0x0a, 0x00, 0x00,
+func (m *MsgSetAssetPrices) Reset()         { *m = MsgSetAssetPrices{} }
+func (m *MsgSetAssetPrices) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetPrices) ProtoMessage()    {}
+func (*MsgSetAssetPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{16}
+}
+func (m *MsgSetAssetPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAssetPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetPrices.Merge(m, src)
+}
+func (m *MsgSetAssetPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetPrices.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetAssetPrices proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
-	// UpdateParams defines a governance operation for updating the x/erc20 module
-	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
-	// account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// RegisterERC20 defines a governance operation for registering a token pair
-	// for the specified erc20 contract. The authority is hard-coded to the Cosmos
-	// SDK x/gov module account
-	RegisterERC20(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error)
-	// ToggleConversion defines a governance operation for enabling/disablen a
-	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
-	// module account
-	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
-	// AddAssetConsensus defines a governance operation for adding new assets
-	// to the consensus whitelist. The authority is hard-coded to the Cosmos SDK
-	// x/gov module account
-	AddAssetConsensus(ctx context.Context, in *MsgAddAssetConsensus, opts ...grpc.CallOption) (*MsgAddAssetConsensusResponse, error)
-	// RemoveAssetConsensus defines a governance operation for removing assets
-	// from the consensus whitelist. The authority is hard-coded to the Cosmos SDK
-	// x/gov module account
-	RemoveAssetConsensus(ctx context.Context, in *MsgRemoveAssetConsensus, opts ...grpc.CallOption) (*MsgRemoveAssetConsensusResponse, error)
-	// UpdateAssetConsensus defines a governance operation for updating asset
-	// weights in the consensus whitelist. The authority is hard-coded to the
-	// Cosmos SDK x/gov module account
-	UpdateAssetConsensus(ctx context.Context, in *MsgUpdateAssetConsensus, opts ...grpc.CallOption) (*MsgUpdateAssetConsensusResponse, error)
+func (m *MsgSetAssetPrices) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetAssetPrices) GetPrices() []AssetPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSetAssetPricesResponse defines the response structure for executing a
+// MsgSetAssetPrices message.
+type MsgSetAssetPricesResponse struct {
 }
 
-func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error) {
-	out := new(MsgConvertERC20Response)
-	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/ConvertERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAssetPricesResponse) Reset()         { *m = MsgSetAssetPricesResponse{} }
+func (m *MsgSetAssetPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetPricesResponse) ProtoMessage()    {}
+func (*MsgSetAssetPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{17}
+}
+func (m *MsgSetAssetPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetAssetPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetPricesResponse.Merge(m, src)
+}
+func (m *MsgSetAssetPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetPricesResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetAssetPricesResponse proto.InternalMessageInfo
+
+// MsgSetAssetPowerCaps is the Msg/SetAssetPowerCaps request type for setting
+// the voting power caps of the whitelisted assets.
+type MsgSetAssetPowerCaps struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// caps is the list of asset power caps to set
+	Caps []AssetPowerCap `protobuf:"bytes,2,rep,name=caps,proto3" json:"caps"`
 }
 
-func (c *msgClient) RegisterERC20(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error) {
-	out := new(MsgRegisterERC20Response)
-	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/RegisterERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAssetPowerCaps) Reset()         { *m = MsgSetAssetPowerCaps{} }
+func (m *MsgSetAssetPowerCaps) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetPowerCaps) ProtoMessage()    {}
+func (*MsgSetAssetPowerCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{18}
+}
+func (m *MsgSetAssetPowerCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetPowerCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetPowerCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetAssetPowerCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetPowerCaps.Merge(m, src)
+}
+func (m *MsgSetAssetPowerCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetPowerCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetPowerCaps.DiscardUnknown(m)
 }
 
-func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error) {
-	out := new(MsgToggleConversionResponse)
-	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/ToggleConversion", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetAssetPowerCaps proto.InternalMessageInfo
+
+func (m *MsgSetAssetPowerCaps) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddAssetConsensus(ctx context.Context, in *MsgAddAssetConsensus, opts ...grpc.CallOption) (*MsgAddAssetConsensusResponse, error) {
-	out := new(MsgAddAssetConsensusResponse)
-	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/AddAssetConsensus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAssetPowerCaps) GetCaps() []AssetPowerCap {
+	if m != nil {
+		return m.Caps
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RemoveAssetConsensus(ctx context.Context, in *MsgRemoveAssetConsensus, opts ...grpc.CallOption) (*MsgRemoveAssetConsensusResponse, error) {
-	out := new(MsgRemoveAssetConsensusResponse)
-	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/RemoveAssetConsensus", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetAssetPowerCapsResponse defines the response structure for executing a
+// MsgSetAssetPowerCaps message.
+type MsgSetAssetPowerCapsResponse struct {
 }
 
-func (c *msgClient) UpdateAssetConsensus(ctx context.Context, in *MsgUpdateAssetConsensus, opts ...grpc.CallOption) (*MsgUpdateAssetConsensusResponse, error) {
-	out := new(MsgUpdateAssetConsensusResponse)
-	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/UpdateAssetConsensus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAssetPowerCapsResponse) Reset()         { *m = MsgSetAssetPowerCapsResponse{} }
+func (m *MsgSetAssetPowerCapsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAssetPowerCapsResponse) ProtoMessage()    {}
+func (*MsgSetAssetPowerCapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{19}
+}
+func (m *MsgSetAssetPowerCapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAssetPowerCapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAssetPowerCapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
-	// UpdateParams defines a governance operation for updating the x/erc20 module
-	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
-	// account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// RegisterERC20 defines a governance operation for registering a token pair
-	// for the specified erc20 contract. The authority is hard-coded to the Cosmos
-	// SDK x/gov module account
-	RegisterERC20(context.Context, *MsgRegisterERC20) (*MsgRegisterERC20Response, error)
-	// ToggleConversion defines a governance operation for enabling/disablen a
-	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
-	// module account
-	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
-	// AddAssetConsensus defines a governance operation for adding new assets
-	// to the consensus whitelist. The authority is hard-coded to the Cosmos SDK
-	// x/gov module account
-	AddAssetConsensus(context.Context, *MsgAddAssetConsensus) (*MsgAddAssetConsensusResponse, error)
-	// RemoveAssetConsensus defines a governance operation for removing assets
-	// from the consensus whitelist. The authority is hard-coded to the Cosmos SDK
-	// x/gov module account
-	RemoveAssetConsensus(context.Context, *MsgRemoveAssetConsensus) (*MsgRemoveAssetConsensusResponse, error)
-	// UpdateAssetConsensus defines a governance operation for updating asset
-	// weights in the consensus whitelist. The authority is hard-coded to the
-	// Cosmos SDK x/gov module account
-	UpdateAssetConsensus(context.Context, *MsgUpdateAssetConsensus) (*MsgUpdateAssetConsensusResponse, error)
+func (m *MsgSetAssetPowerCapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAssetPowerCapsResponse.Merge(m, src)
+}
+func (m *MsgSetAssetPowerCapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAssetPowerCapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAssetPowerCapsResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSetAssetPowerCapsResponse proto.InternalMessageInfo
+
+// MsgSetFeeTokens is the Msg/SetFeeTokens request type for setting the tokens
+// accepted to pay the fees of the Ethereum transactions.
+type MsgSetFeeTokens struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// fee_tokens is the list of fee tokens to set
+	FeeTokens []FeeToken `protobuf:"bytes,2,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
 }
 
-func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
+func (m *MsgSetFeeTokens) Reset()         { *m = MsgSetFeeTokens{} }
+func (m *MsgSetFeeTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokens) ProtoMessage()    {}
+func (*MsgSetFeeTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{20}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSetFeeTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RegisterERC20(ctx context.Context, req *MsgRegisterERC20) (*MsgRegisterERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20 not implemented")
+func (m *MsgSetFeeTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ToggleConversion(ctx context.Context, req *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
+func (m *MsgSetFeeTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokens.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddAssetConsensus(ctx context.Context, req *MsgAddAssetConsensus) (*MsgAddAssetConsensusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAssetConsensus not implemented")
+func (m *MsgSetFeeTokens) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveAssetConsensus(ctx context.Context, req *MsgRemoveAssetConsensus) (*MsgRemoveAssetConsensusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAssetConsensus not implemented")
+func (m *MsgSetFeeTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokens.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateAssetConsensus(ctx context.Context, req *MsgUpdateAssetConsensus) (*MsgUpdateAssetConsensusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssetConsensus not implemented")
+
+var xxx_messageInfo_MsgSetFeeTokens proto.InternalMessageInfo
+
+func (m *MsgSetFeeTokens) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetFeeTokens) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
 }
 
-func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertERC20)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertERC20(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.erc20.v1.Msg/ConvertERC20",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertERC20(ctx, req.(*MsgConvertERC20))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetFeeTokensResponse defines the response structure for executing a
+// MsgSetFeeTokens message.
+type MsgSetFeeTokensResponse struct {
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.erc20.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSetFeeTokensResponse) Reset()         { *m = MsgSetFeeTokensResponse{} }
+func (m *MsgSetFeeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokensResponse) ProtoMessage()    {}
+func (*MsgSetFeeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{21}
 }
-
-func _Msg_RegisterERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterERC20)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterERC20(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.erc20.v1.Msg/RegisterERC20",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterERC20(ctx, req.(*MsgRegisterERC20))
+func (m *MsgSetFeeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetFeeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokensResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokensResponse.DiscardUnknown(m)
 }
 
-func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgToggleConversion)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ToggleConversion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.erc20.v1.Msg/ToggleConversion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ToggleConversion(ctx, req.(*MsgToggleConversion))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetFeeTokensResponse proto.InternalMessageInfo
+
+// MsgRemoveFeeTokens is the Msg/RemoveFeeTokens request type for removing
+// tokens from the fee tokens.
+type MsgRemoveFeeTokens struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denoms is the list of the denoms of the fee tokens to remove
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
 
-func _Msg_AddAssetConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAssetConsensus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAssetConsensus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.erc20.v1.Msg/AddAssetConsensus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAssetConsensus(ctx, req.(*MsgAddAssetConsensus))
+func (m *MsgRemoveFeeTokens) Reset()         { *m = MsgRemoveFeeTokens{} }
+func (m *MsgRemoveFeeTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeTokens) ProtoMessage()    {}
+func (*MsgRemoveFeeTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{22}
+}
+func (m *MsgRemoveFeeTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveFeeTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeTokens.Merge(m, src)
+}
+func (m *MsgRemoveFeeTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeTokens.DiscardUnknown(m)
 }
 
-func _Msg_RemoveAssetConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveAssetConsensus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveAssetConsensus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.erc20.v1.Msg/RemoveAssetConsensus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveAssetConsensus(ctx, req.(*MsgRemoveAssetConsensus))
+var xxx_messageInfo_MsgRemoveFeeTokens proto.InternalMessageInfo
+
+func (m *MsgRemoveFeeTokens) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateAssetConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateAssetConsensus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateAssetConsensus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/helios.erc20.v1.Msg/UpdateAssetConsensus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateAssetConsensus(ctx, req.(*MsgUpdateAssetConsensus))
+func (m *MsgRemoveFeeTokens) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "helios.erc20.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertERC20",
-			Handler:    _Msg_ConvertERC20_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RegisterERC20",
-			Handler:    _Msg_RegisterERC20_Handler,
-		},
-		{
-			MethodName: "ToggleConversion",
-			Handler:    _Msg_ToggleConversion_Handler,
-		},
-		{
-			MethodName: "AddAssetConsensus",
-			Handler:    _Msg_AddAssetConsensus_Handler,
-		},
-		{
-			MethodName: "RemoveAssetConsensus",
-			Handler:    _Msg_RemoveAssetConsensus_Handler,
-		},
-		{
-			MethodName: "UpdateAssetConsensus",
-			Handler:    _Msg_UpdateAssetConsensus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "helios/erc20/v1/tx.proto",
+// MsgRemoveFeeTokensResponse defines the response structure for executing a
+// MsgRemoveFeeTokens message.
+type MsgRemoveFeeTokensResponse struct {
 }
 
-func (m *MsgConvertERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveFeeTokensResponse) Reset()         { *m = MsgRemoveFeeTokensResponse{} }
+func (m *MsgRemoveFeeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeTokensResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{23}
 }
-
-func (m *MsgConvertERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveFeeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRemoveFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveFeeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeTokensResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeTokensResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveFeeTokensResponse proto.InternalMessageInfo
+
+// MsgSetFeeTokenPreference is the Msg/SetFeeTokenPreference request type for
+// choosing the fee token an account pays the fees of its Ethereum transactions
+// with.
+type MsgSetFeeTokenPreference struct {
+	// sender is the address of the account
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom is the denom of the fee token, an empty denom pays the fees with the
+	// EVM denom again
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetFeeTokenPreference) Reset()         { *m = MsgSetFeeTokenPreference{} }
+func (m *MsgSetFeeTokenPreference) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenPreference) ProtoMessage()    {}
+func (*MsgSetFeeTokenPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{24}
 }
-
-func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeeTokenPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFeeTokenPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenPreference.Merge(m, src)
+}
+func (m *MsgSetFeeTokenPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenPreference.DiscardUnknown(m)
 }
 
-func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_MsgSetFeeTokenPreference proto.InternalMessageInfo
+
+func (m *MsgSetFeeTokenPreference) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgSetFeeTokenPreference) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+// MsgSetFeeTokenPreferenceResponse defines the response structure for
+// executing a MsgSetFeeTokenPreference message.
+type MsgSetFeeTokenPreferenceResponse struct {
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) Reset()         { *m = MsgSetFeeTokenPreferenceResponse{} }
+func (m *MsgSetFeeTokenPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenPreferenceResponse) ProtoMessage()    {}
+func (*MsgSetFeeTokenPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a553f77c501c46b9, []int{25}
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenPreferenceResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenPreferenceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgSetFeeTokenPreferenceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgConvertERC20)(nil), "helios.erc20.v1.MsgConvertERC20")
+	proto.RegisterType((*MsgConvertERC20Response)(nil), "helios.erc20.v1.MsgConvertERC20Response")
+	proto.RegisterType((*MsgConvertCoin)(nil), "helios.erc20.v1.MsgConvertCoin")
+	proto.RegisterType((*MsgConvertCoinResponse)(nil), "helios.erc20.v1.MsgConvertCoinResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "helios.erc20.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "helios.erc20.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterERC20)(nil), "helios.erc20.v1.MsgRegisterERC20")
+	proto.RegisterType((*MsgRegisterERC20Response)(nil), "helios.erc20.v1.MsgRegisterERC20Response")
+	proto.RegisterType((*MsgToggleConversion)(nil), "helios.erc20.v1.MsgToggleConversion")
+	proto.RegisterType((*MsgToggleConversionResponse)(nil), "helios.erc20.v1.MsgToggleConversionResponse")
+	proto.RegisterType((*MsgAddAssetConsensus)(nil), "helios.erc20.v1.MsgAddAssetConsensus")
+	proto.RegisterType((*MsgAddAssetConsensusResponse)(nil), "helios.erc20.v1.MsgAddAssetConsensusResponse")
+	proto.RegisterType((*MsgRemoveAssetConsensus)(nil), "helios.erc20.v1.MsgRemoveAssetConsensus")
+	proto.RegisterType((*MsgRemoveAssetConsensusResponse)(nil), "helios.erc20.v1.MsgRemoveAssetConsensusResponse")
+	proto.RegisterType((*MsgUpdateAssetConsensus)(nil), "helios.erc20.v1.MsgUpdateAssetConsensus")
+	proto.RegisterType((*MsgUpdateAssetConsensusResponse)(nil), "helios.erc20.v1.MsgUpdateAssetConsensusResponse")
+	proto.RegisterType((*MsgSetAssetPrices)(nil), "helios.erc20.v1.MsgSetAssetPrices")
+	proto.RegisterType((*MsgSetAssetPricesResponse)(nil), "helios.erc20.v1.MsgSetAssetPricesResponse")
+	proto.RegisterType((*MsgSetAssetPowerCaps)(nil), "helios.erc20.v1.MsgSetAssetPowerCaps")
+	proto.RegisterType((*MsgSetAssetPowerCapsResponse)(nil), "helios.erc20.v1.MsgSetAssetPowerCapsResponse")
+	proto.RegisterType((*MsgSetFeeTokens)(nil), "helios.erc20.v1.MsgSetFeeTokens")
+	proto.RegisterType((*MsgSetFeeTokensResponse)(nil), "helios.erc20.v1.MsgSetFeeTokensResponse")
+	proto.RegisterType((*MsgRemoveFeeTokens)(nil), "helios.erc20.v1.MsgRemoveFeeTokens")
+	proto.RegisterType((*MsgRemoveFeeTokensResponse)(nil), "helios.erc20.v1.MsgRemoveFeeTokensResponse")
+	proto.RegisterType((*MsgSetFeeTokenPreference)(nil), "helios.erc20.v1.MsgSetFeeTokenPreference")
+	proto.RegisterType((*MsgSetFeeTokenPreferenceResponse)(nil), "helios.erc20.v1.MsgSetFeeTokenPreferenceResponse")
 }
 
-func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("helios/erc20/v1/tx.proto", fileDescriptor_a553f77c501c46b9) }
+
+var fileDescriptor_a553f77c501c46b9 = []byte{
+	// 1212 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6b, 0x24, 0xc5,
+	0x17, 0x4f, 0x27, 0xd9, 0xf9, 0x7e, 0xf3, 0x12, 0xf3, 0xa3, 0xcd, 0x8f, 0x49, 0x27, 0x99, 0x4c,
+	0x5a, 0x13, 0x26, 0xd1, 0x4c, 0x67, 0x26, 0xeb, 0xb2, 0x0e, 0x28, 0x26, 0x41, 0xc1, 0xc3, 0x40,
+	0x98, 0x5d, 0x11, 0x02, 0x12, 0x3b, 0x3d, 0x95, 0x4e, 0xb3, 0x99, 0xae, 0xa1, 0xab, 0x67, 0xdc,
+	0xdc, 0x64, 0xf1, 0xe4, 0x41, 0x04, 0x4f, 0x7a, 0x15, 0xc1, 0x63, 0x0e, 0x82, 0xa0, 0x07, 0xaf,
+	0x8b, 0xa7, 0xc5, 0xbd, 0x88, 0x87, 0x45, 0x12, 0x21, 0xff, 0x86, 0x74, 0x55, 0x75, 0x4d, 0x77,
+	0x57, 0x4f, 0x66, 0x18, 0xf7, 0x12, 0x52, 0xef, 0x7d, 0xea, 0xbd, 0xcf, 0xa7, 0xde, 0xeb, 0x7a,
+	0x35, 0x90, 0x3d, 0x43, 0xe7, 0x0e, 0x26, 0x06, 0xf2, 0xac, 0xf2, 0x8e, 0xd1, 0x2e, 0x19, 0xfe,
+	0xe3, 0x62, 0xd3, 0xc3, 0x3e, 0x56, 0xa7, 0x98, 0xa7, 0x48, 0x3d, 0xc5, 0x76, 0x49, 0x9b, 0x31,
+	0x1b, 0x8e, 0x8b, 0x0d, 0xfa, 0x97, 0x61, 0xb4, 0x9c, 0x85, 0x49, 0x03, 0x13, 0xe3, 0xc4, 0x24,
+	0xc8, 0x68, 0x97, 0x4e, 0x90, 0x6f, 0x96, 0x0c, 0x0b, 0x3b, 0x2e, 0xf7, 0x2f, 0x70, 0x7f, 0x83,
+	0xd8, 0x41, 0xec, 0x06, 0xb1, 0xb9, 0x63, 0x91, 0x39, 0x8e, 0xe9, 0xca, 0x60, 0x0b, 0xee, 0x5a,
+	0x4a, 0x32, 0x62, 0x04, 0x98, 0x73, 0x25, 0xe9, 0xb4, 0x91, 0x8b, 0x88, 0x13, 0xee, 0x9d, 0xb5,
+	0xb1, 0x8d, 0x59, 0xcc, 0xe0, 0x3f, 0x6e, 0x5d, 0xb6, 0x31, 0xb6, 0xcf, 0x91, 0x61, 0x36, 0x1d,
+	0xc3, 0x74, 0x5d, 0xec, 0x9b, 0xbe, 0x83, 0x5d, 0xbe, 0x47, 0x7f, 0xae, 0xc0, 0x54, 0x95, 0xd8,
+	0x07, 0xd8, 0x6d, 0x23, 0xcf, 0x7f, 0xbf, 0x76, 0x50, 0xde, 0x51, 0x37, 0x61, 0xda, 0xc2, 0xae,
+	0xef, 0x99, 0x96, 0x7f, 0x6c, 0xd6, 0xeb, 0x1e, 0x22, 0x24, 0xab, 0xe4, 0x95, 0xc2, 0x58, 0x6d,
+	0x2a, 0xb4, 0xef, 0x31, 0xb3, 0x5a, 0x81, 0x8c, 0xd9, 0xc0, 0x2d, 0xd7, 0xcf, 0x0e, 0x07, 0x80,
+	0x7d, 0xfd, 0xe9, 0x8b, 0xd5, 0xa1, 0xbf, 0x5e, 0xac, 0xce, 0x31, 0x51, 0xa4, 0xfe, 0xa8, 0xe8,
+	0x60, 0xa3, 0x61, 0xfa, 0x67, 0xc5, 0x0f, 0x5d, 0xff, 0xc7, 0x9b, 0xcb, 0x2d, 0xa5, 0xc6, 0x77,
+	0xa8, 0x1a, 0xfc, 0xdf, 0x43, 0x16, 0x72, 0xda, 0xc8, 0xcb, 0x8e, 0xd0, 0xf0, 0x62, 0xad, 0xce,
+	0x43, 0x86, 0x20, 0xb7, 0x8e, 0xbc, 0xec, 0x28, 0xf5, 0xf0, 0x55, 0x65, 0xfd, 0xc9, 0xcd, 0xe5,
+	0x16, 0x5f, 0x7c, 0x79, 0x73, 0xb9, 0x35, 0x87, 0xda, 0xc1, 0x09, 0x27, 0x14, 0xe8, 0x8b, 0xb0,
+	0x90, 0x30, 0xd5, 0x10, 0x69, 0x62, 0x97, 0x20, 0xfd, 0x02, 0x26, 0x3b, 0xae, 0x03, 0xec, 0xb8,
+	0xea, 0x2e, 0x8c, 0x06, 0x45, 0xa3, 0x12, 0xc7, 0xcb, 0x8b, 0x45, 0x5e, 0x8f, 0xa0, 0xaa, 0x45,
+	0x5e, 0xd5, 0x62, 0x00, 0xdc, 0x1f, 0x0d, 0xc4, 0xd5, 0x28, 0x38, 0x46, 0x7e, 0xb8, 0x2b, 0xf9,
+	0x91, 0x28, 0x79, 0x3d, 0x0b, 0xf3, 0xf1, 0xd4, 0x82, 0xd4, 0xcf, 0xac, 0x0a, 0x1f, 0x35, 0xeb,
+	0xa6, 0x8f, 0x0e, 0x4d, 0xcf, 0x6c, 0x10, 0xf5, 0x1e, 0x8c, 0x99, 0x2d, 0xff, 0x0c, 0x7b, 0x8e,
+	0x7f, 0xc1, 0x8e, 0x7f, 0x3f, 0xfb, 0xc7, 0x4f, 0xdb, 0xb3, 0x9c, 0x1e, 0xaf, 0xc0, 0x03, 0xdf,
+	0x73, 0x5c, 0xbb, 0xd6, 0x81, 0x06, 0x25, 0x69, 0xd2, 0x08, 0x94, 0xd7, 0x78, 0x79, 0xa1, 0x98,
+	0x68, 0xe5, 0x22, 0x4b, 0xb0, 0x3f, 0x16, 0xc8, 0xe1, 0x25, 0x61, 0x3b, 0x2a, 0x3b, 0xc1, 0xf1,
+	0x76, 0x62, 0x05, 0x27, 0xbc, 0xc2, 0x4e, 0xf8, 0x31, 0x6f, 0xba, 0x04, 0x4b, 0x7e, 0xd2, 0x51,
+	0x93, 0x10, 0xf5, 0x83, 0x02, 0xd3, 0x55, 0x62, 0xd7, 0x90, 0xed, 0x10, 0x1f, 0x79, 0xac, 0xb7,
+	0x06, 0x55, 0xb5, 0x01, 0x93, 0x94, 0x00, 0xef, 0x47, 0x14, 0xa8, 0x1b, 0x29, 0x8c, 0xd5, 0x12,
+	0xd6, 0x4a, 0x49, 0x56, 0x90, 0x93, 0x14, 0xc4, 0x28, 0xe9, 0x1a, 0x64, 0x93, 0x36, 0xa1, 0xe1,
+	0x5b, 0x05, 0x5e, 0xad, 0x12, 0xfb, 0x21, 0xb6, 0xed, 0x73, 0xc4, 0x2a, 0x47, 0x1c, 0xec, 0x0e,
+	0x2c, 0x63, 0x16, 0xee, 0xf8, 0xf8, 0x11, 0x72, 0x79, 0xcf, 0xb0, 0x45, 0xe5, 0xae, 0x4c, 0x7a,
+	0x4d, 0x22, 0x9d, 0xe4, 0xa0, 0xaf, 0xc0, 0x52, 0x8a, 0x59, 0x50, 0xff, 0x55, 0x81, 0xd9, 0x2a,
+	0xb1, 0xf7, 0xea, 0xf5, 0x3d, 0x42, 0x90, 0x7f, 0x10, 0x18, 0x5d, 0xd2, 0x1a, 0xbc, 0xb1, 0xee,
+	0x42, 0xc6, 0x0c, 0x22, 0xb1, 0xa3, 0x1f, 0x2f, 0xcf, 0x4b, 0x8d, 0x45, 0x13, 0xf1, 0xcf, 0x84,
+	0x63, 0x2b, 0x6f, 0xc9, 0xda, 0x74, 0x49, 0x9b, 0x44, 0x52, 0xcf, 0xc1, 0x72, 0x9a, 0x5d, 0xa8,
+	0xfb, 0x5e, 0xa1, 0x8d, 0x57, 0x43, 0x0d, 0xdc, 0x46, 0x2f, 0x49, 0xe0, 0x3c, 0x64, 0xea, 0xc8,
+	0xc5, 0x8d, 0xb0, 0xb7, 0xf8, 0xaa, 0x72, 0x5f, 0x96, 0xb0, 0x9e, 0xd2, 0x53, 0x32, 0x13, 0x7d,
+	0x0d, 0x56, 0xbb, 0xb8, 0x84, 0x90, 0xdf, 0x95, 0xc8, 0x17, 0xf4, 0x92, 0x84, 0xbc, 0x03, 0xff,
+	0x6b, 0xd1, 0x78, 0x61, 0xa9, 0x56, 0xa4, 0x52, 0x7d, 0x8c, 0x1c, 0xfb, 0xcc, 0x67, 0x59, 0x79,
+	0xc5, 0xc2, 0x3d, 0xfd, 0xe9, 0x4d, 0x23, 0xcc, 0xf5, 0xa6, 0xb9, 0x84, 0xde, 0x5f, 0x14, 0x98,
+	0xa9, 0x12, 0xfb, 0x01, 0xf2, 0x29, 0xe0, 0xd0, 0x73, 0x2c, 0x34, 0xb8, 0xd2, 0xb7, 0x21, 0xd3,
+	0xa4, 0x11, 0xb8, 0xd0, 0xa5, 0xf4, 0x9e, 0xa4, 0x59, 0xc2, 0xc6, 0x64, 0x1b, 0x2a, 0x65, 0x59,
+	0xe5, 0xaa, 0xa4, 0x32, 0x4e, 0x53, 0x5f, 0x82, 0x45, 0xc9, 0x28, 0x94, 0xfd, 0xc6, 0x3e, 0x38,
+	0xe1, 0xc5, 0x9f, 0x21, 0xef, 0xc0, 0x6c, 0x0e, 0x2e, 0xee, 0x3e, 0x8c, 0x5a, 0x66, 0x33, 0x94,
+	0x96, 0xeb, 0x22, 0x8d, 0xa7, 0x11, 0xd3, 0xc9, 0x6c, 0xf6, 0xf9, 0xd1, 0x49, 0x44, 0xf9, 0x47,
+	0x27, 0xd9, 0xa3, 0x57, 0xca, 0x14, 0x03, 0x7c, 0x80, 0xd0, 0xc3, 0xe0, 0xea, 0x1a, 0x5c, 0xdc,
+	0xbb, 0x00, 0xa7, 0x08, 0x1d, 0xd3, 0x0b, 0x30, 0x94, 0xb8, 0x28, 0x49, 0x0c, 0xf3, 0x70, 0x75,
+	0x63, 0xa7, 0x61, 0xde, 0xfe, 0x46, 0x55, 0x94, 0x29, 0x1f, 0x55, 0x51, 0x53, 0xf4, 0x9a, 0x57,
+	0xc5, 0x87, 0xfa, 0xdf, 0xb5, 0x75, 0xbb, 0x48, 0x76, 0x65, 0xce, 0xf9, 0x2e, 0x17, 0x49, 0x87,
+	0xf6, 0x32, 0x68, 0xb2, 0x55, 0x30, 0xff, 0x4e, 0x81, 0x6c, 0x5c, 0xd5, 0xa1, 0x87, 0x4e, 0x91,
+	0x87, 0x5c, 0x0b, 0xa9, 0x3b, 0xe2, 0x21, 0xd2, 0x8b, 0x3c, 0xc7, 0x05, 0xf3, 0x89, 0x72, 0x0d,
+	0xe7, 0x13, 0x5d, 0x54, 0xee, 0x25, 0x5e, 0x5d, 0x1b, 0xb7, 0x1d, 0x74, 0x27, 0xbf, 0xae, 0x43,
+	0xbe, 0x9b, 0x2f, 0x14, 0x50, 0xfe, 0x0a, 0x60, 0xa4, 0x4a, 0x6c, 0xf5, 0x0b, 0x05, 0x26, 0x62,
+	0xaf, 0xd0, 0xbc, 0xd4, 0x0c, 0x89, 0x27, 0x9d, 0x56, 0xe8, 0x85, 0x10, 0xa7, 0x54, 0x78, 0xf2,
+	0xfc, 0x9f, 0x6f, 0x86, 0x75, 0x35, 0x6f, 0x30, 0xe6, 0x91, 0xf7, 0xbe, 0x61, 0xb1, 0x0d, 0xc7,
+	0xd4, 0xa6, 0x1e, 0xc1, 0x44, 0xec, 0x15, 0x96, 0xca, 0x22, 0x8a, 0xd0, 0x0a, 0xbd, 0x10, 0x21,
+	0x0b, 0xf5, 0x13, 0x78, 0x25, 0xfe, 0x18, 0x5a, 0x4b, 0xdb, 0x1a, 0x83, 0x68, 0x9b, 0x3d, 0x21,
+	0x22, 0xfc, 0x29, 0x4c, 0x4b, 0xef, 0x94, 0xd7, 0xd3, 0xb6, 0x27, 0x51, 0xda, 0x9b, 0xfd, 0xa0,
+	0x44, 0x1e, 0x07, 0x66, 0xe4, 0x47, 0xc5, 0x7a, 0x5a, 0x08, 0x09, 0xa6, 0x6d, 0xf7, 0x05, 0x13,
+	0xa9, 0x3c, 0x98, 0x4d, 0x9d, 0xf0, 0x85, 0xf4, 0x53, 0x91, 0x91, 0xda, 0x4e, 0xbf, 0xc8, 0x68,
+	0xce, 0xd4, 0x61, 0x7c, 0x4b, 0x9d, 0xfb, 0xc9, 0x79, 0xdb, 0x50, 0x54, 0x3f, 0x85, 0xc9, 0xc4,
+	0x40, 0xd4, 0xd3, 0x62, 0xc4, 0x31, 0xda, 0x56, 0x6f, 0x4c, 0xb4, 0x68, 0xf2, 0x60, 0x5a, 0xbf,
+	0x35, 0x40, 0x08, 0xd3, 0xb6, 0xfb, 0x82, 0x89, 0x54, 0x47, 0x30, 0x11, 0x9b, 0x10, 0xf9, 0x2e,
+	0xdb, 0x05, 0x42, 0x2b, 0xf4, 0x42, 0x88, 0xd8, 0x16, 0x4c, 0x25, 0x2f, 0xe9, 0xd7, 0xba, 0x57,
+	0xb8, 0x93, 0xe1, 0x8d, 0x3e, 0x40, 0x22, 0x49, 0x0b, 0xe6, 0xd2, 0xef, 0xd3, 0xcd, 0x1e, 0x3c,
+	0x3b, 0x50, 0xad, 0xd4, 0x37, 0x34, 0x4c, 0xab, 0xdd, 0xf9, 0x3c, 0xf8, 0x2d, 0xb6, 0xff, 0xde,
+	0xd3, 0xab, 0x9c, 0xf2, 0xec, 0x2a, 0xa7, 0xfc, 0x7d, 0x95, 0x53, 0xbe, 0xbe, 0xce, 0x0d, 0x3d,
+	0xbb, 0xce, 0x0d, 0xfd, 0x79, 0x9d, 0x1b, 0x3a, 0xda, 0x60, 0x21, 0xb7, 0x2d, 0xec, 0x21, 0x23,
+	0xfc, 0xff, 0xcc, 0x74, 0x5c, 0x71, 0x13, 0xfb, 0x17, 0x4d, 0x44, 0x4e, 0x32, 0xf4, 0xa7, 0xfd,
+	0xee, 0xbf, 0x03, 0x00, 0xb0, 0x3f, 0x94, 0xf5, 0xde, 0x10, 0x00, 0x00,
 }
 
-func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
+	// UpdateParams defines a governance operation for updating the x/erc20 module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterERC20 defines a governance operation for registering a token pair
+	// for the specified erc20 contract. The authority is hard-coded to the Cosmos
+	// SDK x/gov module account
+	RegisterERC20(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error)
+	// ToggleConversion defines a governance operation for enabling/disablen a
+	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
+	// module account
+	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// AddAssetConsensus defines a governance operation for adding new assets
+	// to the consensus whitelist. The authority is hard-coded to the Cosmos SDK
+	// x/gov module account
+	AddAssetConsensus(ctx context.Context, in *MsgAddAssetConsensus, opts ...grpc.CallOption) (*MsgAddAssetConsensusResponse, error)
+	// RemoveAssetConsensus defines a governance operation for removing assets
+	// from the consensus whitelist. The authority is hard-coded to the Cosmos SDK
+	// x/gov module account
+	RemoveAssetConsensus(ctx context.Context, in *MsgRemoveAssetConsensus, opts ...grpc.CallOption) (*MsgRemoveAssetConsensusResponse, error)
+	// UpdateAssetConsensus defines a governance operation for updating asset
+	// weights in the consensus whitelist. The authority is hard-coded to the
+	// Cosmos SDK x/gov module account
+	UpdateAssetConsensus(ctx context.Context, in *MsgUpdateAssetConsensus, opts ...grpc.CallOption) (*MsgUpdateAssetConsensusResponse, error)
+	// SetAssetPrices defines a governance operation for setting the prices of
+	// the whitelisted assets used to derive their effective weights. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	SetAssetPrices(ctx context.Context, in *MsgSetAssetPrices, opts ...grpc.CallOption) (*MsgSetAssetPricesResponse, error)
+	// SetAssetPowerCaps defines a governance operation for setting the maximum
+	// share of the total voting power conferred by the whitelisted assets. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	SetAssetPowerCaps(ctx context.Context, in *MsgSetAssetPowerCaps, opts ...grpc.CallOption) (*MsgSetAssetPowerCapsResponse, error)
+	// SetFeeTokens defines a governance operation for setting the tokens
+	// accepted to pay the fees of the Ethereum transactions and their conversion
+	// rates. The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetFeeTokens(ctx context.Context, in *MsgSetFeeTokens, opts ...grpc.CallOption) (*MsgSetFeeTokensResponse, error)
+	// RemoveFeeTokens defines a governance operation for removing tokens from
+	// the fee tokens. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	RemoveFeeTokens(ctx context.Context, in *MsgRemoveFeeTokens, opts ...grpc.CallOption) (*MsgRemoveFeeTokensResponse, error)
+	// SetFeeTokenPreference defines a method for an account to pay the fees of
+	// its Ethereum transactions with a fee token
+	SetFeeTokenPreference(ctx context.Context, in *MsgSetFeeTokenPreference, opts ...grpc.CallOption) (*MsgSetFeeTokenPreferenceResponse, error)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error) {
+	out := new(MsgConvertERC20Response)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/ConvertERC20", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RegisterERC20(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error) {
+	out := new(MsgRegisterERC20Response)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/RegisterERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error) {
+	out := new(MsgToggleConversionResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/ToggleConversion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddAssetConsensus(ctx context.Context, in *MsgAddAssetConsensus, opts ...grpc.CallOption) (*MsgAddAssetConsensusResponse, error) {
+	out := new(MsgAddAssetConsensusResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/AddAssetConsensus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RemoveAssetConsensus(ctx context.Context, in *MsgRemoveAssetConsensus, opts ...grpc.CallOption) (*MsgRemoveAssetConsensusResponse, error) {
+	out := new(MsgRemoveAssetConsensusResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/RemoveAssetConsensus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateAssetConsensus(ctx context.Context, in *MsgUpdateAssetConsensus, opts ...grpc.CallOption) (*MsgUpdateAssetConsensusResponse, error) {
+	out := new(MsgUpdateAssetConsensusResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/UpdateAssetConsensus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRegisterERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Erc20Addresses) > 0 {
-		for iNdEx := len(m.Erc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Erc20Addresses[iNdEx])
-			copy(dAtA[i:], m.Erc20Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetAssetPrices(ctx context.Context, in *MsgSetAssetPrices, opts ...grpc.CallOption) (*MsgSetAssetPricesResponse, error) {
+	out := new(MsgSetAssetPricesResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/SetAssetPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRegisterERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAssetPowerCaps(ctx context.Context, in *MsgSetAssetPowerCaps, opts ...grpc.CallOption) (*MsgSetAssetPowerCapsResponse, error) {
+	out := new(MsgSetAssetPowerCapsResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/SetAssetPowerCaps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRegisterERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRegisterERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgToggleConversion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetFeeTokens(ctx context.Context, in *MsgSetFeeTokens, opts ...grpc.CallOption) (*MsgSetFeeTokensResponse, error) {
+	out := new(MsgSetFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/SetFeeTokens", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgToggleConversion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgToggleConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RemoveFeeTokens(ctx context.Context, in *MsgRemoveFeeTokens, opts ...grpc.CallOption) (*MsgRemoveFeeTokensResponse, error) {
+	out := new(MsgRemoveFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/RemoveFeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgToggleConversionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetFeeTokenPreference(ctx context.Context, in *MsgSetFeeTokenPreference, opts ...grpc.CallOption) (*MsgSetFeeTokenPreferenceResponse, error) {
+	out := new(MsgSetFeeTokenPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/helios.erc20.v1.Msg/SetFeeTokenPreference", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgToggleConversionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
+	// UpdateParams defines a governance operation for updating the x/erc20 module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterERC20 defines a governance operation for registering a token pair
+	// for the specified erc20 contract. The authority is hard-coded to the Cosmos
+	// SDK x/gov module account
+	RegisterERC20(context.Context, *MsgRegisterERC20) (*MsgRegisterERC20Response, error)
+	// ToggleConversion defines a governance operation for enabling/disablen a
+	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
+	// module account
+	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// AddAssetConsensus defines a governance operation for adding new assets
+	// to the consensus whitelist. The authority is hard-coded to the Cosmos SDK
+	// x/gov module account
+	AddAssetConsensus(context.Context, *MsgAddAssetConsensus) (*MsgAddAssetConsensusResponse, error)
+	// RemoveAssetConsensus defines a governance operation for removing assets
+	// from the consensus whitelist. The authority is hard-coded to the Cosmos SDK
+	// x/gov module account
+	RemoveAssetConsensus(context.Context, *MsgRemoveAssetConsensus) (*MsgRemoveAssetConsensusResponse, error)
+	// UpdateAssetConsensus defines a governance operation for updating asset
+	// weights in the consensus whitelist. The authority is hard-coded to the
+	// Cosmos SDK x/gov module account
+	UpdateAssetConsensus(context.Context, *MsgUpdateAssetConsensus) (*MsgUpdateAssetConsensusResponse, error)
+	// SetAssetPrices defines a governance operation for setting the prices of
+	// the whitelisted assets used to derive their effective weights. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	SetAssetPrices(context.Context, *MsgSetAssetPrices) (*MsgSetAssetPricesResponse, error)
+	// SetAssetPowerCaps defines a governance operation for setting the maximum
+	// share of the total voting power conferred by the whitelisted assets. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	SetAssetPowerCaps(context.Context, *MsgSetAssetPowerCaps) (*MsgSetAssetPowerCapsResponse, error)
+	// SetFeeTokens defines a governance operation for setting the tokens
+	// accepted to pay the fees of the Ethereum transactions and their conversion
+	// rates. The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetFeeTokens(context.Context, *MsgSetFeeTokens) (*MsgSetFeeTokensResponse, error)
+	// RemoveFeeTokens defines a governance operation for removing tokens from
+	// the fee tokens. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	RemoveFeeTokens(context.Context, *MsgRemoveFeeTokens) (*MsgRemoveFeeTokensResponse, error)
+	// SetFeeTokenPreference defines a method for an account to pay the fees of
+	// its Ethereum transactions with a fee token
+	SetFeeTokenPreference(context.Context, *MsgSetFeeTokenPreference) (*MsgSetFeeTokenPreferenceResponse, error)
 }
 
-func (m *MsgToggleConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgAddAssetConsensus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterERC20(ctx context.Context, req *MsgRegisterERC20) (*MsgRegisterERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20 not implemented")
+}
+func (*UnimplementedMsgServer) ToggleConversion(ctx context.Context, req *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
+}
+func (*UnimplementedMsgServer) AddAssetConsensus(ctx context.Context, req *MsgAddAssetConsensus) (*MsgAddAssetConsensusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAssetConsensus not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAssetConsensus(ctx context.Context, req *MsgRemoveAssetConsensus) (*MsgRemoveAssetConsensusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAssetConsensus not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAssetConsensus(ctx context.Context, req *MsgUpdateAssetConsensus) (*MsgUpdateAssetConsensusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssetConsensus not implemented")
+}
+func (*UnimplementedMsgServer) SetAssetPrices(ctx context.Context, req *MsgSetAssetPrices) (*MsgSetAssetPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetPrices not implemented")
+}
+func (*UnimplementedMsgServer) SetAssetPowerCaps(ctx context.Context, req *MsgSetAssetPowerCaps) (*MsgSetAssetPowerCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetPowerCaps not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeTokens(ctx context.Context, req *MsgSetFeeTokens) (*MsgSetFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokens not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeTokens(ctx context.Context, req *MsgRemoveFeeTokens) (*MsgRemoveFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeTokens not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeTokenPreference(ctx context.Context, req *MsgSetFeeTokenPreference) (*MsgSetFeeTokenPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokenPreference not implemented")
 }
 
-func (m *MsgAddAssetConsensus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgAddAssetConsensus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/ConvertERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20(ctx, req.(*MsgConvertERC20))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddAssetConsensusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddAssetConsensusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddAssetConsensusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveAssetConsensus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/RegisterERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20(ctx, req.(*MsgRegisterERC20))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveAssetConsensus) MarshalTo(dAtA []byte) (int, error) {
+func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgToggleConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ToggleConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/ToggleConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ToggleConversion(ctx, req.(*MsgToggleConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAssetConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAssetConsensus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAssetConsensus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/AddAssetConsensus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAssetConsensus(ctx, req.(*MsgAddAssetConsensus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAssetConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAssetConsensus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAssetConsensus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/RemoveAssetConsensus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAssetConsensus(ctx, req.(*MsgRemoveAssetConsensus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAssetConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAssetConsensus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAssetConsensus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/UpdateAssetConsensus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAssetConsensus(ctx, req.(*MsgUpdateAssetConsensus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAssetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAssetPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAssetPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/SetAssetPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAssetPrices(ctx, req.(*MsgSetAssetPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAssetPowerCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAssetPowerCaps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAssetPowerCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/SetAssetPowerCaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAssetPowerCaps(ctx, req.(*MsgSetAssetPowerCaps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/SetFeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokens(ctx, req.(*MsgSetFeeTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/RemoveFeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeTokens(ctx, req.(*MsgRemoveFeeTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTokenPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokenPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokenPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.erc20.v1.Msg/SetFeeTokenPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokenPreference(ctx, req.(*MsgSetFeeTokenPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "helios.erc20.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertERC20",
+			Handler:    _Msg_ConvertERC20_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterERC20",
+			Handler:    _Msg_RegisterERC20_Handler,
+		},
+		{
+			MethodName: "ToggleConversion",
+			Handler:    _Msg_ToggleConversion_Handler,
+		},
+		{
+			MethodName: "AddAssetConsensus",
+			Handler:    _Msg_AddAssetConsensus_Handler,
+		},
+		{
+			MethodName: "RemoveAssetConsensus",
+			Handler:    _Msg_RemoveAssetConsensus_Handler,
+		},
+		{
+			MethodName: "UpdateAssetConsensus",
+			Handler:    _Msg_UpdateAssetConsensus_Handler,
+		},
+		{
+			MethodName: "SetAssetPrices",
+			Handler:    _Msg_SetAssetPrices_Handler,
+		},
+		{
+			MethodName: "SetAssetPowerCaps",
+			Handler:    _Msg_SetAssetPowerCaps_Handler,
+		},
+		{
+			MethodName: "SetFeeTokens",
+			Handler:    _Msg_SetFeeTokens_Handler,
+		},
+		{
+			MethodName: "RemoveFeeTokens",
+			Handler:    _Msg_RemoveFeeTokens_Handler,
+		},
+		{
+			MethodName: "SetFeeTokenPreference",
+			Handler:    _Msg_SetFeeTokenPreference_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "helios/erc20/v1/tx.proto",
+}
+
+func (m *MsgConvertERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveAssetConsensus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAssetConsensusResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1676,12 +2001,12 @@ func (m *MsgRemoveAssetConsensusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveAssetConsensusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveAssetConsensusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1689,7 +2014,7 @@ func (m *MsgRemoveAssetConsensusResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAssetConsensus) Marshal() (dAtA []byte, err error) {
+func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1699,41 +2024,44 @@ func (m *MsgUpdateAssetConsensus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateAssetConsensus) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateAssetConsensus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAssetConsensusResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1743,12 +2071,12 @@ func (m *MsgUpdateAssetConsensusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateAssetConsensusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateAssetConsensusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1756,246 +2084,2008 @@ func (m *MsgUpdateAssetConsensusResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgConvertERC20) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConvertERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgConvertCoin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConvertCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRegisterERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterERC20) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.Erc20Addresses) > 0 {
-		for _, s := range m.Erc20Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Erc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Erc20Addresses[iNdEx])
+			copy(dAtA[i:], m.Erc20Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgToggleConversion) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRegisterERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgToggleConversionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAssetConsensus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgToggleConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgToggleConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgToggleConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgToggleConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAssetConsensus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAssetConsensus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAssetConsensus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAssetConsensusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddAssetConsensusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAssetConsensusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAssetConsensusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAssetConsensus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveAssetConsensus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAssetConsensus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAssetConsensus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveAssetConsensusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveAssetConsensusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAssetConsensusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAssetConsensusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAssetConsensus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateAssetConsensus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAssetConsensus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAssetConsensus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAssetConsensusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateAssetConsensusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAssetConsensusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAssetConsensusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetPowerCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetPowerCaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetPowerCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Caps) > 0 {
+		for iNdEx := len(m.Caps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Caps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAssetPowerCapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAssetPowerCapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAssetPowerCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Erc20Addresses) > 0 {
+		for _, s := range m.Erc20Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgToggleConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgToggleConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAssetConsensus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAssetConsensusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAssetConsensus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveAssetConsensusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAssetConsensus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAssetConsensusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAssetPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAssetPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAssetPowerCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Caps) > 0 {
+		for _, e := range m.Caps {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAssetPowerCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeTokenPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgToggleConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgToggleConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAssetConsensus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2018,15 +4108,15 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAssetConsensus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAssetConsensus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2054,13 +4144,13 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2070,90 +4160,26 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assets = append(m.Assets, Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2175,7 +4201,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAssetConsensusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2198,10 +4224,10 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAssetConsensusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAssetConsensusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2225,7 +4251,7 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAssetConsensus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2248,48 +4274,15 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAssetConsensus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAssetConsensus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2317,11 +4310,11 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2349,7 +4342,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2372,7 +4365,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAssetConsensusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2395,10 +4388,10 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAssetConsensusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAssetConsensusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2422,7 +4415,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAssetConsensus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,10 +4438,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAssetConsensus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAssetConsensus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2485,7 +4478,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2512,7 +4505,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Updates = append(m.Updates, WeightUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2537,7 +4531,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAssetConsensusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2560,10 +4554,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAssetConsensusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAssetConsensusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2587,7 +4581,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAssetPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2610,10 +4604,10 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAssetPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAssetPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2650,9 +4644,9 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2662,23 +4656,25 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Prices = append(m.Prices, AssetPrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2701,7 +4697,7 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAssetPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2724,10 +4720,10 @@ func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAssetPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAssetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2751,7 +4747,7 @@ func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAssetPowerCaps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2774,10 +4770,10 @@ func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleConversion: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAssetPowerCaps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAssetPowerCaps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2814,9 +4810,9 @@ func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2826,23 +4822,25 @@ func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Caps = append(m.Caps, AssetPowerCap{})
+			if err := m.Caps[len(m.Caps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2865,7 +4863,7 @@ func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAssetPowerCapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2888,10 +4886,10 @@ func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleConversionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAssetPowerCapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAssetPowerCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2915,7 +4913,7 @@ func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAssetConsensus) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2938,10 +4936,10 @@ func (m *MsgAddAssetConsensus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAssetConsensus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAssetConsensus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2978,7 +4976,7 @@ func (m *MsgAddAssetConsensus) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3005,8 +5003,8 @@ func (m *MsgAddAssetConsensus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, Asset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3031,7 +5029,7 @@ func (m *MsgAddAssetConsensus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAssetConsensusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3054,10 +5052,10 @@ func (m *MsgAddAssetConsensusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAssetConsensusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAssetConsensusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3081,7 +5079,7 @@ func (m *MsgAddAssetConsensusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAssetConsensus) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3104,10 +5102,10 @@ func (m *MsgRemoveAssetConsensus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAssetConsensus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAssetConsensus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3195,7 +5193,7 @@ func (m *MsgRemoveAssetConsensus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAssetConsensusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3218,10 +5216,10 @@ func (m *MsgRemoveAssetConsensusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAssetConsensusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAssetConsensusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3245,7 +5243,7 @@ func (m *MsgRemoveAssetConsensusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAssetConsensus) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeTokenPreference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3268,15 +5266,15 @@ func (m *MsgUpdateAssetConsensus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAssetConsensus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeTokenPreference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAssetConsensus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeTokenPreference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3304,13 +5302,13 @@ func (m *MsgUpdateAssetConsensus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3320,25 +5318,23 @@ func (m *MsgUpdateAssetConsensus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, WeightUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3361,7 +5357,7 @@ func (m *MsgUpdateAssetConsensus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAssetConsensusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeTokenPreferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3384,10 +5380,10 @@ func (m *MsgUpdateAssetConsensusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAssetConsensusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeTokenPreferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAssetConsensusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeTokenPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -50,6 +50,11 @@ type dummyStatedb struct {
 
 func (*dummyStatedb) GetRefund() uint64                       { return 1337 }
 func (*dummyStatedb) GetBalance(addr common.Address) *big.Int { return new(big.Int) }
+func (*dummyStatedb) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return common.Hash{}
+}
+func (*dummyStatedb) SetTransientState(addr common.Address, key, value common.Hash) {}
+func (*dummyStatedb) Suicide6780(common.Address)                                    {}
 
 type vmContext struct {
 	blockCtx vm.BlockContext
@@ -44,14 +44,31 @@ type BlockContext struct {
 	Difficulty  *big.Int       // Provides information for DIFFICULTY
 	BaseFee     *big.Int       // Provides information for BASEFEE
 	Random      *common.Hash   // Provides information for RANDOM
+	BlobBaseFee *big.Int       // Provides information for BLOBBASEFEE
 }
 
 // TxContext provides the EVM with information about a transaction.
 // All fields can change between transactions.
 type TxContext struct {
 	// Message information
-	Origin   common.Address // Provides information for ORIGIN
-	GasPrice *big.Int       // Provides information for GASPRICE
+	Origin     common.Address // Provides information for ORIGIN
+	GasPrice   *big.Int       // Provides information for GASPRICE
+	BlobHashes []common.Hash  // Provides information for BLOBHASH
+}
+
+// Rules wraps the go-ethereum chain rules with the forks that are not exported
+// by the go-ethereum chain rules.
+type Rules struct {
+	params.Rules
+	IsCancun bool
+}
+
+// NewRules returns the chain rules of the given chain config at the given block number.
+func NewRules(chainConfig *params.ChainConfig, num *big.Int, isMerge bool) Rules {
+	return Rules{
+		Rules:    chainConfig.Rules(num, isMerge),
+		IsCancun: chainConfig.IsCancun(num),
+	}
 }
 
 // EVM is the Ethereum Virtual Machine base object and provides
@@ -75,7 +92,7 @@ type EVM struct {
 	// chainConfig contains information about the current chain
 	chainConfig *params.ChainConfig
 	// chain rules contains the chain rules for the current epoch
-	chainRules params.Rules
+	chainRules Rules
 	// virtual machine configuration options used to initialise the
 	// evm.
 	Config Config
@@ -109,12 +126,12 @@ func NewEVM(blockCtx BlockContext, txCtx TxContext, statedb StateDB, chainConfig
 		StateDB:     statedb,
 		Config:      config,
 		chainConfig: chainConfig,
-		chainRules:  chainConfig.Rules(blockCtx.BlockNumber, blockCtx.Random != nil),
+		chainRules:  NewRules(chainConfig, blockCtx.BlockNumber, blockCtx.Random != nil),
 		hooks:       newNoopOpCodeHooks(),
 	}
 	// set the default precompiles
-	evm.activePrecompiles = DefaultActivePrecompiles(evm.chainRules)
-	evm.precompiles = DefaultPrecompiles(evm.chainRules)
+	evm.activePrecompiles = DefaultActivePrecompiles(evm.chainRules.Rules)
+	evm.precompiles = DefaultPrecompiles(evm.chainRules.Rules)
 	evm.interpreter = NewEVMInterpreter(evm, config)
 
 	return evm
@@ -129,12 +146,12 @@ func NewEVMWithHooks(hooks OpCodeHooks, blockCtx BlockContext, txCtx TxContext,
 		StateDB:     statedb,
 		Config:      config,
 		chainConfig: chainConfig,
-		chainRules:  chainConfig.Rules(blockCtx.BlockNumber, blockCtx.Random != nil),
+		chainRules:  NewRules(chainConfig, blockCtx.BlockNumber, blockCtx.Random != nil),
 		hooks:       hooks,
 	}
 	// set the default precompiles
-	evm.activePrecompiles = DefaultActivePrecompiles(evm.chainRules)
-	evm.precompiles = DefaultPrecompiles(evm.chainRules)
+	evm.activePrecompiles = DefaultActivePrecompiles(evm.chainRules.Rules)
+	evm.precompiles = DefaultPrecompiles(evm.chainRules.Rules)
 	evm.interpreter = NewEVMInterpreter(evm, config)
 
 	return evm
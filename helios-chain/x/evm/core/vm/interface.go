@@ -49,6 +49,12 @@ type StateDB interface {
 
 	Suicide(common.Address) bool
 	HasSuicided(common.Address) bool
+	// Suicide6780 marks the account as suicided only if it was created in the
+	// same transaction (EIP-6780)
+	Suicide6780(common.Address)
+
+	GetTransientState(addr common.Address, key common.Hash) common.Hash
+	SetTransientState(addr common.Address, key, value common.Hash)
 
 	// Exist reports whether the given account exists in state.
 	// Notably this should also return true for suicided accounts.
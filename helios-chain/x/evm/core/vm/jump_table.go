@@ -55,14 +55,20 @@ var (
 	BerlinInstructionSet           = newBerlinInstructionSet()
 	LondonInstructionSet           = newLondonInstructionSet()
 	MergeInstructionSet            = newMergeInstructionSet()
+	ShanghaiInstructionSet         = newShanghaiInstructionSet()
+	CancunInstructionSet           = newCancunInstructionSet()
 )
 
 // JumpTable contains the EVM opcodes supported at a given fork.
 type JumpTable [256]*operation
 
 // DefaultJumpTable defines the default jump table used by the EVM interpreter.
-func DefaultJumpTable(rules params.Rules) (jumpTable *JumpTable) {
+func DefaultJumpTable(rules Rules) (jumpTable *JumpTable) {
 	switch {
+	case rules.IsCancun:
+		jumpTable = &CancunInstructionSet
+	case rules.IsShanghai:
+		jumpTable = &ShanghaiInstructionSet
 	case rules.IsMerge:
 		jumpTable = &MergeInstructionSet
 	case rules.IsLondon:
@@ -117,6 +123,29 @@ func (jt JumpTable) MustValidate() {
 	}
 }
 
+// newCancunInstructionSet returns the shanghai instructions with the cancun ones.
+func newCancunInstructionSet() JumpTable {
+	instructionSet := newShanghaiInstructionSet()
+	enable4844(&instructionSet) // EIP-4844 (BLOBHASH opcode)
+	enable7516(&instructionSet) // EIP-7516 (BLOBBASEFEE opcode)
+	enable1153(&instructionSet) // EIP-1153 "Transient Storage"
+	enable5656(&instructionSet) // EIP-5656 (MCOPY opcode)
+	enable6780(&instructionSet) // EIP-6780 SELFDESTRUCT only in same transaction
+	instructionSet.MustValidate()
+	return instructionSet
+}
+
+// newShanghaiInstructionSet returns the london instructions with the shanghai ones.
+// NOTE: the instructions are built on top of the london ones instead of the merge ones
+// as the block context doesn't provide the RANDOM value.
+func newShanghaiInstructionSet() JumpTable {
+	instructionSet := newLondonInstructionSet()
+	enable3855(&instructionSet) // PUSH0 instruction
+	enable3860(&instructionSet) // Limit and meter initcode
+	instructionSet.MustValidate()
+	return instructionSet
+}
+
 func newMergeInstructionSet() JumpTable {
 	instructionSet := newLondonInstructionSet()
 	instructionSet[RANDOM] = &operation{
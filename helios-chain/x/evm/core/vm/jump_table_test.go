@@ -17,8 +17,10 @@
 package vm
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,3 +35,26 @@ func TestJumpTableCopy(t *testing.T) {
 	require.Equal(t, uint64(100), deepCopy[SLOAD].constantGas)
 	require.Equal(t, uint64(0), tbl[SLOAD].constantGas)
 }
+
+// TestInstructionSets tests that the fork instruction sets are valid and gate the new opcodes
+func TestInstructionSets(t *testing.T) {
+	london := newLondonInstructionSet()
+	shanghai := newShanghaiInstructionSet()
+	cancun := newCancunInstructionSet()
+
+	for _, op := range []OpCode{PUSH0, TLOAD, TSTORE, MCOPY, BLOBHASH, BLOBBASEFEE} {
+		require.True(t, isUndefined(london[op]), op.String())
+	}
+	require.False(t, isUndefined(shanghai[PUSH0]))
+	for _, op := range []OpCode{TLOAD, TSTORE, MCOPY, BLOBHASH, BLOBBASEFEE} {
+		require.True(t, isUndefined(shanghai[op]), op.String())
+		require.False(t, isUndefined(cancun[op]), op.String())
+	}
+
+	require.True(t, DefaultJumpTable(Rules{Rules: params.Rules{IsShanghai: true}}) == &ShanghaiInstructionSet)
+	require.True(t, DefaultJumpTable(Rules{Rules: params.Rules{IsShanghai: true}, IsCancun: true}) == &CancunInstructionSet)
+}
+
+func isUndefined(op *operation) bool {
+	return reflect.ValueOf(op.execute).Pointer() == reflect.ValueOf(opUndefined).Pointer()
+}
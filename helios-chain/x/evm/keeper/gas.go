@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core"
@@ -12,6 +13,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"helios-core/helios-chain/x/evm/core/vm"
 	"helios-core/helios-chain/x/evm/types"
 )
 
@@ -21,7 +23,28 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	gas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	// EIP-3860: charge the init code words of contract creations
+	if isContractCreation && cfg.IsShanghai(height) {
+		lenWords := toWordSize(uint64(len(msg.Data())))
+		if (math.MaxUint64-gas)/vm.InitCodeWordGas < lenWords {
+			return 0, core.ErrGasUintOverflow
+		}
+		gas += lenWords * vm.InitCodeWordGas
+	}
+	return gas, nil
+}
+
+// toWordSize returns the ceiled word size required for init code payment calculation.
+func toWordSize(size uint64) uint64 {
+	if size > math.MaxUint64-31 {
+		return math.MaxUint64/32 + 1
+	}
+	return (size + 31) / 32
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -39,6 +62,13 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	case 1:
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		if payment, ok := types.FeePaymentFromContext(ctx); ok {
+			// the fees have been paid with a fee token, the refund is made with it too
+			refundedCoins = sdk.Coins{sdk.NewCoin(payment.Denom, payment.ConvertAmount(remaining))}
+			if refundedCoins.IsZero() {
+				return nil
+			}
+		}
 
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
 		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
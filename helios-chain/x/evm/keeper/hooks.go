@@ -12,7 +12,10 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks        = MultiEvmHooks{}
+	_ types.CallFramesHooks = MultiEvmHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -33,6 +36,16 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	return nil
 }
 
+// RequireCallFrames returns true if one of the underlying hooks requires the call frames
+func (mh MultiEvmHooks) RequireCallFrames(ctx sdk.Context, msg core.Message) bool {
+	for i := range mh {
+		if h, ok := mh[i].(types.CallFramesHooks); ok && h.RequireCallFrames(ctx, msg) {
+			return true
+		}
+	}
+	return false
+}
+
 func (mh MultiEvmHooks) PostContractCreation(ctx sdk.Context, contractAddress common.Address, deployerAddress sdk.AccAddress) error {
 	for i := range mh {
 		if err := mh[i].PostContractCreation(ctx, contractAddress, deployerAddress); err != nil {
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/evm/keeper"
+)
+
+type noopHook struct{}
+
+func (noopHook) PostTxProcessing(sdk.Context, core.Message, *ethtypes.Receipt) error {
+	return nil
+}
+
+func (noopHook) PostContractCreation(sdk.Context, common.Address, sdk.AccAddress) error {
+	return nil
+}
+
+// callFramesHook requires the call frames when set
+type callFramesHook struct {
+	noopHook
+	require bool
+}
+
+func (h callFramesHook) RequireCallFrames(sdk.Context, core.Message) bool {
+	return h.require
+}
+
+func TestMultiEvmHooksRequireCallFrames(t *testing.T) {
+	testCases := []struct {
+		name  string
+		hooks keeper.MultiEvmHooks
+		exp   bool
+	}{
+		{"no hooks", keeper.NewMultiEvmHooks(), false},
+		{"hooks without call frames", keeper.NewMultiEvmHooks(noopHook{}), false},
+		{"call frames not required", keeper.NewMultiEvmHooks(noopHook{}, callFramesHook{}), false},
+		{"call frames required by one hook", keeper.NewMultiEvmHooks(callFramesHook{}, noopHook{}, callFramesHook{require: true}), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, tc.hooks.RequireCallFrames(sdk.Context{}, nil))
+		})
+	}
+}
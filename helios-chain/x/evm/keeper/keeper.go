@@ -325,6 +325,31 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	return result, nil
 }
 
+// SetCallFramesGasTransient sets the gas used by the call frames of the transaction at the given index.
+func (k Keeper) SetCallFramesGasTransient(ctx sdk.Context, txIndex uint64, frames []types.CallFrameGas) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.CallFrameGasPrefix(txIndex))
+	for _, frame := range frames {
+		store.Set(frame.Address.Bytes(), sdk.Uint64ToBigEndian(frame.GasUsed))
+	}
+}
+
+// GetCallFramesGasTransient returns the gas used by the call frames of the transaction at the given
+// index, ordered by address.
+func (k Keeper) GetCallFramesGasTransient(ctx sdk.Context, txIndex uint64) []types.CallFrameGas {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.CallFrameGasPrefix(txIndex))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var frames []types.CallFrameGas
+	for ; iterator.Valid(); iterator.Next() {
+		frames = append(frames, types.CallFrameGas{
+			Address: common.BytesToAddress(iterator.Key()),
+			GasUsed: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return frames
+}
+
 func (k *Keeper) SetErc20Keeper(erc20Keeper types.Erc20Keeper) {
 	k.erc20Keeper = erc20Keeper
 }
@@ -347,6 +372,12 @@ func (k *Keeper) CleanHooks() *Keeper {
 	return k
 }
 
+// RequireCallFrames returns true if the hooks use the gas used by the call frames of the transaction
+func (k *Keeper) RequireCallFrames(ctx sdk.Context, msg core.Message) bool {
+	h, ok := k.hooks.(types.CallFramesHooks)
+	return ok && h.RequireCallFrames(ctx, msg)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	logFile, _ := os.OpenFile("/tmp/helios-debug.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
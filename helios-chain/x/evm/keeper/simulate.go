@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evmostypes "helios-core/helios-chain/types"
+	evmante "helios-core/helios-chain/x/evm/ante"
+	"helios-core/helios-chain/x/evm/core/vm"
+	"helios-core/helios-chain/x/evm/statedb"
+	"helios-core/helios-chain/x/evm/types"
+)
+
+// simulateBlocks executes the simulated blocks of eth_simulateV1 one after the other,
+// committing the state changes of every call to ctx so they are seen by the next ones.
+func (k *Keeper) simulateBlocks(ctx sdk.Context, opts types.SimulateOptions, req *types.QuerySimulateV1Request) ([]*types.SimulateBlockResult, error) {
+	proposerAddress := GetProposerAddress(ctx, req.ProposerAddress)
+
+	defaultGasLimit := req.GasCap
+	if req.BlockMaxGas > 0 {
+		defaultGasLimit = uint64(req.BlockMaxGas)
+	}
+
+	number := uint64(ctx.BlockHeight())         //nolint:gosec // G115
+	timestamp := uint64(ctx.BlockTime().Unix()) //nolint:gosec // G115
+	parentHash := common.BytesToHash(ctx.HeaderHash())
+
+	results := make([]*types.SimulateBlockResult, 0, len(opts.BlockStateCalls))
+	for i, block := range opts.BlockStateCalls {
+		number, timestamp = number+1, timestamp+1
+		gasLimit := defaultGasLimit
+		overrides := block.BlockOverrides
+		if overrides == nil {
+			overrides = &types.BlockOverrides{}
+		}
+		if overrides.Number != nil {
+			number = uint64(*overrides.Number)
+		}
+		if overrides.Time != nil {
+			timestamp = uint64(*overrides.Time)
+		}
+		if overrides.GasLimit != nil {
+			gasLimit = uint64(*overrides.GasLimit)
+		}
+
+		blockCtx := ctx.
+			WithBlockHeight(int64(number)).                      //nolint:gosec // G115
+			WithBlockTime(time.Unix(int64(timestamp), 0).UTC()). //nolint:gosec // G115
+			WithConsensusParams(tmproto.ConsensusParams{
+				Block: &tmproto.BlockParams{MaxGas: int64(gasLimit)}, //nolint:gosec // G115
+			})
+
+		cfg, err := k.EVMConfig(blockCtx, proposerAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+		}
+		if overrides.FeeRecipient != nil {
+			cfg.CoinBase = *overrides.FeeRecipient
+		}
+		switch {
+		case overrides.BaseFeePerGas != nil:
+			cfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+		case !opts.Validation:
+			cfg.BaseFee = big.NewInt(0)
+		}
+
+		if err := k.applySimulatedStateOverride(blockCtx, block.StateOverrides); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err.Error())
+		}
+
+		calls, gasUsed, err := k.simulateCalls(blockCtx, cfg, block.Calls, opts.Validation, req.GasCap, gasLimit)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err.Error())
+		}
+
+		header := &ethtypes.Header{
+			ParentHash: parentHash,
+			Coinbase:   cfg.CoinBase,
+			Difficulty: big.NewInt(0),
+			Number:     new(big.Int).SetUint64(number),
+			GasLimit:   gasLimit,
+			GasUsed:    gasUsed,
+			Time:       timestamp,
+			BaseFee:    cfg.BaseFee,
+		}
+		hash := header.Hash()
+		for _, call := range calls {
+			for _, log := range call.Logs {
+				log.BlockHash = hash
+				log.BlockNumber = number
+			}
+		}
+
+		results = append(results, &types.SimulateBlockResult{
+			Number:        hexutil.Uint64(number),
+			Hash:          hash,
+			ParentHash:    parentHash,
+			Timestamp:     hexutil.Uint64(timestamp),
+			GasLimit:      hexutil.Uint64(gasLimit),
+			GasUsed:       hexutil.Uint64(gasUsed),
+			Miner:         cfg.CoinBase,
+			BaseFeePerGas: (*hexutil.Big)(cfg.BaseFee),
+			Calls:         calls,
+		})
+		parentHash = hash
+	}
+	return results, nil
+}
+
+// simulateCalls executes and commits the calls of a simulated block, it returns their
+// results with the gas used by the block. The calls which can't be included in a block
+// (invalid nonce, fee or intrinsic gas) fail the whole simulation.
+func (k *Keeper) simulateCalls(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	calls []types.TransactionArgs,
+	validation bool,
+	gasCap, gasLimit uint64,
+) ([]types.SimulateCallResult, uint64, error) {
+	txConfig := statedb.NewEmptyTxConfig(common.Hash{})
+	results := make([]types.SimulateCallResult, 0, len(calls))
+	gasUsed := uint64(0)
+
+	for i, args := range calls {
+		from := args.GetFrom()
+		nonce := k.GetNonce(ctx, from)
+		if args.Nonce != nil && validation && uint64(*args.Nonce) != nonce {
+			return nil, 0, fmt.Errorf("call %d: invalid nonce of %s: expected %d, got %d", i, from.Hex(), nonce, uint64(*args.Nonce))
+		}
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+
+		if gasUsed >= gasLimit {
+			return nil, 0, fmt.Errorf("call %d: block gas limit reached", i)
+		}
+		callGasCap := gasLimit - gasUsed
+		if gasCap != 0 && gasCap < callGasCap {
+			callGasCap = gasCap
+		}
+
+		msg, err := args.ToMessage(callGasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, 0, fmt.Errorf("call %d: %w", i, err)
+		}
+		if validation && cfg.BaseFee != nil && msg.GasFeeCap().Cmp(cfg.BaseFee) < 0 {
+			return nil, 0, fmt.Errorf("call %d: max fee per gas %s less than block base fee %s", i, msg.GasFeeCap(), cfg.BaseFee)
+		}
+
+		txConfig.TxHash = args.ToTransaction().AsTransaction().Hash()
+		callCtx := evmante.BuildEvmExecutionCtx(ctx).
+			WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(msg.Gas()))
+		res, err := k.ApplyMessageWithConfig(callCtx, msg, nil, true, cfg, txConfig)
+		if err != nil {
+			return nil, 0, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		// the nonce is increased by the ante handler for the transactions, contract
+		// creations already have it increased by ApplyMessageWithConfig
+		if msg.To() != nil {
+			account := k.GetAccountOrEmpty(ctx, from)
+			account.Nonce = nonce + 1
+			if err := k.SetAccount(ctx, from, account); err != nil {
+				return nil, 0, errorsmod.Wrapf(err, "call %d: failed to increase the nonce", i)
+			}
+		}
+
+		result := types.SimulateCallResult{
+			ReturnData: res.Ret,
+			Logs:       types.LogsToEthereum(res.Logs),
+			GasUsed:    hexutil.Uint64(res.GasUsed),
+			Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if result.Logs == nil {
+			result.Logs = []*ethtypes.Log{}
+		}
+		if res.Failed() {
+			result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			result.Error = &types.SimulateCallError{Code: types.SimulateErrCodeVMError, Message: res.VmError}
+			if res.VmError == vm.ErrExecutionReverted.Error() {
+				result.Error.Code = types.SimulateErrCodeReverted
+				result.Error.Data = hexutil.Encode(res.Ret)
+			}
+		}
+		results = append(results, result)
+
+		gasUsed += res.GasUsed
+		txConfig.TxIndex++
+		txConfig.LogIndex += uint(len(res.Logs))
+	}
+	return results, gasUsed, nil
+}
+
+// applySimulatedStateOverride applies and commits the state overrides of a simulated block.
+// The storage replaced with state is cleared slot by slot, the replacement done by
+// StateDB.ApplyStateOverride isn't committed.
+func (k *Keeper) applySimulatedStateOverride(ctx sdk.Context, overrides types.StateOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	committed := make(types.StateOverride, len(overrides))
+	for addr, account := range overrides {
+		if account.State != nil {
+			stateDiff := make(map[common.Hash]common.Hash)
+			k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				stateDiff[key] = common.Hash{}
+				return true
+			})
+			for key, value := range *account.State {
+				stateDiff[key] = value
+			}
+			account.State, account.StateDiff = nil, &stateDiff
+		}
+		committed[addr] = account
+	}
+
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	if err := stateDB.ApplyStateOverride(committed); err != nil {
+		return err
+	}
+	return stateDB.Commit()
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"helios-core/helios-chain/server/config"
+	"helios-core/helios-chain/x/evm/types"
+)
+
+var (
+	// counterCode increments the slot 0 of the contract and returns its new value
+	counterCode = hexutil.MustDecode("0x6000546001018060005560005260206000f3")
+	// counterAddr is an address without code, the code of the counter is set with a state override
+	counterAddr = common.HexToAddress("0x000000000000000000000000000000000000c0de")
+)
+
+func slotValue(v int64) common.Hash {
+	return common.BigToHash(big.NewInt(v))
+}
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	balanceAddr := common.HexToAddress("0x000000000000000000000000000000000000ba1a")
+
+	getRequest := func(args types.TransactionArgs, overrides types.StateOverride) *types.QueryTraceCallRequest {
+		ctx := suite.network.GetContext()
+		argsBz, err := json.Marshal(args)
+		suite.Require().NoError(err)
+		req := &types.QueryTraceCallRequest{
+			Args:        argsBz,
+			GasCap:      config.DefaultGasCap,
+			TraceConfig: &types.TraceConfig{},
+			BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+			ChainId:     suite.network.GetEIP155ChainID().Int64(),
+			BlockTime:   ctx.BlockTime(),
+		}
+		if overrides != nil {
+			req.Overrides, err = json.Marshal(overrides)
+			suite.Require().NoError(err)
+		}
+		return req
+	}
+
+	code := hexutil.Bytes(counterCode)
+	state := map[common.Hash]common.Hash{{}: slotValue(5)}
+	// selfBalanceCode returns the balance of the contract
+	selfBalanceCode := hexutil.Bytes(hexutil.MustDecode("0x4760005260206000f3"))
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+
+	testCases := []struct {
+		name           string
+		args           types.TransactionArgs
+		overrides      types.StateOverride
+		expPass        bool
+		expReturnValue string
+	}{
+		{
+			"no code without overrides",
+			types.TransactionArgs{From: &sender, To: &counterAddr},
+			nil,
+			true,
+			"",
+		},
+		{
+			"code and state overrides",
+			types.TransactionArgs{From: &sender, To: &counterAddr},
+			types.StateOverride{counterAddr: {Code: &code, State: &state}},
+			true,
+			common.Bytes2Hex(slotValue(6).Bytes()),
+		},
+		{
+			"code and state diff overrides",
+			types.TransactionArgs{From: &sender, To: &counterAddr},
+			types.StateOverride{counterAddr: {Code: &code, StateDiff: &state}},
+			true,
+			common.Bytes2Hex(slotValue(6).Bytes()),
+		},
+		{
+			"balance override",
+			types.TransactionArgs{From: &sender, To: &balanceAddr},
+			types.StateOverride{balanceAddr: {Code: &selfBalanceCode, Balance: &balance}},
+			true,
+			common.Bytes2Hex(slotValue(1e18).Bytes()),
+		},
+		{
+			"state and state diff overrides together",
+			types.TransactionArgs{From: &sender, To: &counterAddr},
+			types.StateOverride{counterAddr: {Code: &code, State: &state, StateDiff: &state}},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.network.GetEvmClient().TraceCall(suite.network.GetContext(), getRequest(tc.args, tc.overrides))
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var trace struct {
+				Failed      bool   `json:"failed"`
+				ReturnValue string `json:"returnValue"`
+			}
+			suite.Require().NoError(json.Unmarshal(res.Data, &trace))
+			suite.Require().False(trace.Failed)
+			suite.Require().Equal(tc.expReturnValue, trace.ReturnValue)
+
+			// the overrides only apply to the traced call
+			ctx := suite.network.GetContext()
+			k := suite.network.App.EvmKeeper
+			suite.Require().False(k.IsContract(ctx, counterAddr))
+			suite.Require().Equal(common.Hash{}, k.GetState(ctx, counterAddr, common.Hash{}))
+			suite.Require().False(k.IsContract(ctx, balanceAddr))
+			suite.Require().Zero(k.GetBalance(ctx, balanceAddr).Sign())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	code := hexutil.Bytes(counterCode)
+	stateDiff := map[common.Hash]common.Hash{{}: slotValue(5)}
+	emptyState := map[common.Hash]common.Hash{}
+	callCounter := types.TransactionArgs{From: &sender, To: &counterAddr}
+
+	simulate := func(opts types.SimulateOptions) ([]types.SimulateBlockResult, error) {
+		ctx := suite.network.GetContext()
+		optsBz, err := json.Marshal(opts)
+		suite.Require().NoError(err)
+		res, err := suite.network.GetEvmClient().SimulateV1(ctx, &types.QuerySimulateV1Request{
+			Opts:        optsBz,
+			GasCap:      config.DefaultGasCap,
+			BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+			ChainId:     suite.network.GetEIP155ChainID().Int64(),
+			BlockNumber: ctx.BlockHeight(),
+			BlockTime:   ctx.BlockTime(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		var results []types.SimulateBlockResult
+		suite.Require().NoError(json.Unmarshal(res.Data, &results))
+		return results, nil
+	}
+
+	suite.Run("state overrides and state changes are carried to the next blocks", func() {
+		results, err := simulate(types.SimulateOptions{
+			BlockStateCalls: []types.SimulateBlock{
+				{
+					StateOverrides: types.StateOverride{counterAddr: {Code: &code, StateDiff: &stateDiff}},
+					Calls:          []types.TransactionArgs{callCounter, callCounter},
+				},
+				{
+					Calls: []types.TransactionArgs{callCounter},
+				},
+				{
+					// state replaces the whole storage of the counter
+					StateOverrides: types.StateOverride{counterAddr: {State: &emptyState}},
+					Calls:          []types.TransactionArgs{callCounter},
+				},
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 3)
+
+		expValues := [][]int64{{6, 7}, {8}, {1}}
+		baseNumber := uint64(suite.network.GetContext().BlockHeight()) //nolint:gosec // G115
+		for i, block := range results {
+			suite.Require().Equal(baseNumber+uint64(i)+1, uint64(block.Number))
+			if i > 0 {
+				suite.Require().Equal(results[i-1].Hash, block.ParentHash)
+			}
+			suite.Require().Len(block.Calls, len(expValues[i]))
+			for j, call := range block.Calls {
+				suite.Require().Nil(call.Error)
+				suite.Require().Equal(slotValue(expValues[i][j]).Bytes(), []byte(call.ReturnData))
+			}
+		}
+
+		// the simulation doesn't change the state of the chain
+		ctx := suite.network.GetContext()
+		k := suite.network.App.EvmKeeper
+		suite.Require().False(k.IsContract(ctx, counterAddr))
+		suite.Require().Equal(common.Hash{}, k.GetState(ctx, counterAddr, common.Hash{}))
+	})
+
+	suite.Run("block overrides", func() {
+		number := hexutil.Uint64(suite.network.GetContext().BlockHeight() + 10) //nolint:gosec // G115
+		feeRecipient := common.HexToAddress("0x00000000000000000000000000000000000fee00")
+		results, err := simulate(types.SimulateOptions{
+			BlockStateCalls: []types.SimulateBlock{
+				{
+					BlockOverrides: &types.BlockOverrides{Number: &number, FeeRecipient: &feeRecipient},
+					StateOverrides: types.StateOverride{counterAddr: {Code: &code}},
+					Calls:          []types.TransactionArgs{callCounter},
+				},
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(results, 1)
+		suite.Require().Equal(number, results[0].Number)
+		suite.Require().Equal(feeRecipient, results[0].Miner)
+		suite.Require().Equal(slotValue(1).Bytes(), []byte(results[0].Calls[0].ReturnData))
+	})
+
+	suite.Run("invalid nonce with validation", func() {
+		nonce := hexutil.Uint64(100)
+		_, err := simulate(types.SimulateOptions{
+			Validation: true,
+			BlockStateCalls: []types.SimulateBlock{
+				{Calls: []types.TransactionArgs{{From: &sender, To: &counterAddr, Nonce: &nonce}}},
+			},
+		})
+		suite.Require().ErrorContains(err, "invalid nonce")
+	})
+
+	suite.Run("blocks out of order", func() {
+		number := hexutil.Uint64(suite.network.GetContext().BlockHeight()) //nolint:gosec // G115
+		_, err := simulate(types.SimulateOptions{
+			BlockStateCalls: []types.SimulateBlock{
+				{BlockOverrides: &types.BlockOverrides{Number: &number}},
+			},
+		})
+		suite.Require().ErrorContains(err, "block numbers must be in order")
+	})
+}
@@ -169,8 +169,20 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commit := ctx.CacheContext()
 
+	// record the gas used by the call frames only when the hooks use it to attribute the fees,
+	// the tracer enables the debug mode of the EVM. The operator tracer is not forwarded, it
+	// must never run while delivering a transaction.
+	var (
+		tracer     vm.EVMLogger
+		frameTrace *types.CallFrameTracer
+	)
+	if k.RequireCallFrames(ctx, msg) {
+		frameTrace = types.NewCallFrameTracer(nil)
+		tracer = frameTrace
+	}
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -217,6 +229,9 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 
 	if !res.Failed() {
 		receipt.Status = ethtypes.ReceiptStatusSuccessful
+		if frameTrace != nil {
+			k.SetCallFramesGasTransient(tmpCtx, uint64(txConfig.TxIndex), frameTrace.Frames())
+		}
 		// Only call hooks if tx executed successfully.
 		if err = k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
 			// If hooks return error, revert the whole tx.
@@ -322,6 +337,11 @@ func (k *Keeper) ApplyMessageWithConfig(
 	)
 
 	stateDB := statedb.New(ctx, k, txConfig)
+	if cfg.Overrides != nil {
+		if err := stateDB.ApplyStateOverride(cfg.Overrides); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+		}
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -352,10 +372,20 @@ func (k *Keeper) ApplyMessageWithConfig(
 	}
 	leftoverGas -= intrinsicGas
 
+	// EIP-3860: limit the size of the init code of contract creations
+	isShanghai := cfg.ChainConfig.IsShanghai(evm.Context.BlockNumber)
+	if contractCreation && isShanghai && len(msg.Data()) > vm.MaxInitCodeSize {
+		return nil, errorsmod.Wrapf(vm.ErrMaxInitCodeSizeExceeded, "code size %d limit %d", len(msg.Data()), vm.MaxInitCodeSize)
+	}
+
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
 	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
 		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
+		// EIP-3651: warm coinbase
+		if isShanghai {
+			stateDB.AddAddressToAccessList(evm.Context.Coinbase)
+		}
 	}
 
 	if contractCreation {
@@ -472,6 +472,77 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRefundGasWithFeeToken() {
+	// one EVM coin is worth 10^6 units of the fee token
+	payment := types.FeePayment{Denom: "ausdt", ConversionRate: sdkmath.LegacyNewDec(1_000_000)}
+
+	// FeeCollector account is pre-funded with the fee token for the refund to work
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(payment.Denom, sdkmath.NewInt(1e6))),
+		},
+	}
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := keyring.GetKey(0)
+	recipient := keyring.GetAddr(1)
+
+	testCases := []struct {
+		name        string
+		leftoverGas uint64
+		expRefund   sdkmath.Int
+	}{
+		{
+			name:        "the leftover gas is refunded in the fee token",
+			leftoverGas: 10_000,
+			expRefund:   sdkmath.NewInt(10),
+		},
+		{
+			name:        "a refund worth less than one unit of the fee token is dropped",
+			leftoverGas: 1,
+			expRefund:   sdkmath.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			coreMsg, err := txFactory.GenerateGethCoreMsg(
+				sender.Priv,
+				types.EvmTxArgs{
+					To:       &recipient,
+					Amount:   big.NewInt(100),
+					GasPrice: big.NewInt(1e9),
+				},
+			)
+			suite.Require().NoError(err)
+
+			ctx := types.ContextWithFeePayment(unitNetwork.GetContext(), payment)
+			bankKeeper := unitNetwork.App.BankKeeper
+			prevTokenBalance := bankKeeper.GetBalance(ctx, sender.AccAddr, payment.Denom)
+			prevBalance := bankKeeper.GetBalance(ctx, sender.AccAddr, unitNetwork.GetDenom())
+
+			err = unitNetwork.App.EvmKeeper.RefundGas(ctx, coreMsg, tc.leftoverGas, unitNetwork.GetDenom())
+			suite.Require().NoError(err)
+
+			// the refund is made in the fee token only
+			tokenBalance := bankKeeper.GetBalance(ctx, sender.AccAddr, payment.Denom)
+			suite.Require().Equal(prevTokenBalance.Amount.Add(tc.expRefund), tokenBalance.Amount)
+			suite.Require().Equal(prevBalance, bankKeeper.GetBalance(ctx, sender.AccAddr, unitNetwork.GetDenom()))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	suite.SetupTest()
 	testCases := []struct {
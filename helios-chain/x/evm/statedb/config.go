@@ -44,4 +44,7 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides are applied to the StateDB before the execution of the message,
+	// used by the eth_call like queries only
+	Overrides types.StateOverride
 }
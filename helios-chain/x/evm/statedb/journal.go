@@ -140,6 +140,12 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes to the transient storage
+	transientStorageChange struct {
+		account       *common.Address
+		key, prevalue common.Hash
+	}
 	precompileCallChange struct {
 		multiStore storetypes.CacheMultiStore
 		events     sdk.Events
@@ -159,6 +165,7 @@ var (
 	_ JournalEntry = accessListAddAccountChange{}
 	_ JournalEntry = accessListAddSlotChange{}
 	_ JournalEntry = precompileCallChange{}
+	_ JournalEntry = transientStorageChange{}
 )
 
 func (pc precompileCallChange) Revert(s *StateDB) {
@@ -277,3 +284,11 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch transientStorageChange) Revert(s *StateDB) {
+	s.setTransientState(*ch.account, ch.key, ch.prevalue)
+}
+
+func (ch transientStorageChange) Dirtied() *common.Address {
+	return nil
+}
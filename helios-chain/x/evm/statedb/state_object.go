@@ -63,6 +63,10 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// the account was created in the current transaction
+	created bool
+	// the storage is overridden, the slots not in originStorage are empty
+	storageOverridden bool
 }
 
 // newObject creates a state object.
@@ -219,6 +223,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.storageOverridden {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
@@ -252,3 +259,13 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// overrideStorage replaces the whole storage, it isn't journaled
+func (s *stateObject) overrideStorage(storage map[common.Hash]common.Hash) {
+	s.originStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.originStorage[key] = value
+	}
+	s.dirtyStorage = make(Storage)
+	s.storageOverridden = true
+}
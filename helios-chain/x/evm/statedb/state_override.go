@@ -0,0 +1,54 @@
+package statedb
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"helios-core/helios-chain/x/evm/types"
+)
+
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
+// SetStorage replaces the whole storage of account, the slots not in storage read as empty.
+// It is only meant for the state overrides of simulated calls: the slots not in storage are
+// not deleted from the keeper if the StateDB is committed.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.overrideStorage(storage)
+	}
+}
+
+// ApplyStateOverride applies the overrides of eth_call like queries before the execution.
+func (s *StateDB) ApplyStateOverride(overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			s.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			s.SetBalance(addr, (*account.Balance).ToInt())
+		}
+		if account.State != nil {
+			s.SetStorage(addr, *account.State)
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				s.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
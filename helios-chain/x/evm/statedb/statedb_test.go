@@ -6,11 +6,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 	"helios-core/helios-chain/x/evm/core/vm"
 	"helios-core/helios-chain/x/evm/statedb"
+	"helios-core/helios-chain/x/evm/types"
 )
 
 var (
@@ -126,6 +128,47 @@ func (suite *StateDBTestSuite) TestAccountOverride() {
 	suite.Require().Equal(uint64(0), db.GetNonce(address))
 }
 
+func (suite *StateDBTestSuite) TestApplyStateOverride() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(10))
+	value2 := common.BigToHash(big.NewInt(20))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	db.SetState(address, key2, value2)
+	db.SetState(address2, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	nonce := hexutil.Uint64(7)
+	code := hexutil.Bytes{0x60, 0x00}
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	state := map[common.Hash]common.Hash{key1: value2}
+	stateDiff := map[common.Hash]common.Hash{key2: value1}
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().NoError(db.ApplyStateOverride(types.StateOverride{
+		address:  {Nonce: &nonce, Code: &code, Balance: &balance, State: &state},
+		address2: {StateDiff: &stateDiff},
+	}))
+
+	suite.Require().Equal(uint64(7), db.GetNonce(address))
+	suite.Require().Equal([]byte(code), db.GetCode(address))
+	suite.Require().Equal(big.NewInt(1000), db.GetBalance(address))
+	// state replaces the whole storage
+	suite.Require().Equal(value2, db.GetState(address, key1))
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key2))
+	suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key2))
+	// stateDiff only replaces the given slots
+	suite.Require().Equal(value1, db.GetState(address2, key1))
+	suite.Require().Equal(value1, db.GetState(address2, key2))
+
+	suite.Require().Error(db.ApplyStateOverride(types.StateOverride{
+		address3: {State: &state, StateDiff: &stateDiff},
+	}))
+}
+
 func (suite *StateDBTestSuite) TestDBError() {
 	testCases := []struct {
 		name     string
@@ -387,6 +430,59 @@ func (suite *StateDBTestSuite) TestNestedSnapshot() {
 	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+
+	rev1 := db.Snapshot()
+	db.SetTransientState(address, key, value1)
+
+	rev2 := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+
+	db.RevertToSnapshot(rev2)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev1)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// transient storage is never committed
+	db.SetTransientState(address, key, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
+func (suite *StateDBTestSuite) TestSuicide6780() {
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetNonce(address, 1)
+	suite.Require().NoError(db.Commit())
+
+	// the account existed before the transaction
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.Suicide6780(address)
+	suite.Require().False(db.HasSuicided(address))
+
+	// the account is created in the transaction
+	db.CreateAccount(address2)
+	db.SetNonce(address2, 1)
+	db.Suicide6780(address2)
+	suite.Require().True(db.HasSuicided(address2))
+
+	// missing account
+	db.Suicide6780(address3)
+	suite.Require().False(db.HasSuicided(address3))
+}
+
 func (suite *StateDBTestSuite) TestInvalidSnapshotId() {
 	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
 	suite.Require().Panics(func() {
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	"helios-core/helios-chain/x/evm/core/vm"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CallFrameGas is the gas used by the frames of a transaction executing the code of an address,
+// the gas used by the nested calls is accounted to their own frames.
+type CallFrameGas struct {
+	Address common.Address
+	GasUsed uint64
+}
+
+var _ vm.EVMLogger = &CallFrameTracer{}
+
+// CallFrameTracer is a vm.EVMLogger recording the gas used by each call frame of a transaction,
+// every capture is forwarded to the wrapped tracer.
+type CallFrameTracer struct {
+	vm.EVMLogger
+
+	stack  []callFrame
+	frames []CallFrameGas
+	index  map[common.Address]int
+}
+
+type callFrame struct {
+	address     common.Address
+	childrenGas uint64
+}
+
+// NewCallFrameTracer creates a CallFrameTracer wrapping the given tracer.
+func NewCallFrameTracer(tracer vm.EVMLogger) *CallFrameTracer {
+	if tracer == nil {
+		tracer = NewNoOpTracer()
+	}
+	return &CallFrameTracer{
+		EVMLogger: tracer,
+		index:     make(map[common.Address]int),
+	}
+}
+
+// Frames returns the gas used by the frames of each address in the order their first frame returned.
+func (t *CallFrameTracer) Frames() []CallFrameGas {
+	return t.frames
+}
+
+// CaptureStart implements vm.EVMLogger interface
+func (t *CallFrameTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.stack = append(t.stack, callFrame{address: to})
+	t.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+// CaptureEnd implements vm.EVMLogger interface
+func (t *CallFrameTracer) CaptureEnd(output []byte, gasUsed uint64, tm time.Duration, err error) {
+	t.exitFrame(gasUsed)
+	t.EVMLogger.CaptureEnd(output, gasUsed, tm, err)
+}
+
+// CaptureEnter implements vm.EVMLogger interface
+func (t *CallFrameTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.stack = append(t.stack, callFrame{address: to})
+	t.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit implements vm.EVMLogger interface
+func (t *CallFrameTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.exitFrame(gasUsed)
+	t.EVMLogger.CaptureExit(output, gasUsed, err)
+}
+
+// exitFrame pops the current frame and accounts the gas it used itself, the gas used by the
+// frame is then added to the gas of the children of its parent.
+func (t *CallFrameTracer) exitFrame(gasUsed uint64) {
+	if len(t.stack) == 0 {
+		return
+	}
+	frame := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	if len(t.stack) > 0 {
+		t.stack[len(t.stack)-1].childrenGas += gasUsed
+	}
+
+	selfGas := uint64(0)
+	if gasUsed > frame.childrenGas {
+		selfGas = gasUsed - frame.childrenGas
+	}
+
+	i, found := t.index[frame.address]
+	if !found {
+		i = len(t.frames)
+		t.index[frame.address] = i
+		t.frames = append(t.frames, CallFrameGas{Address: frame.address})
+	}
+	t.frames[i].GasUsed += selfGas
+}
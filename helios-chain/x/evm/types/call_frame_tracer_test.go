@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/evm/core/vm"
+)
+
+func TestCallFrameTracer(t *testing.T) {
+	router := common.HexToAddress("0x1")
+	pool := common.HexToAddress("0x2")
+	token := common.HexToAddress("0x3")
+
+	tracer := NewCallFrameTracer(nil)
+	tracer.CaptureStart(nil, common.Address{}, router, false, nil, 100_000, nil)
+	// router -> pool -> token
+	tracer.CaptureEnter(vm.CALL, router, pool, nil, 80_000, nil)
+	tracer.CaptureEnter(vm.CALL, pool, token, nil, 50_000, nil)
+	tracer.CaptureExit(nil, 10_000, nil)
+	tracer.CaptureExit(nil, 30_000, nil)
+	// router -> token
+	tracer.CaptureEnter(vm.STATICCALL, router, token, nil, 40_000, nil)
+	tracer.CaptureExit(nil, 5_000, nil)
+	tracer.CaptureEnd(nil, 45_000, 0, nil)
+
+	require.Equal(t, []CallFrameGas{
+		{Address: token, GasUsed: 15_000},
+		{Address: pool, GasUsed: 20_000},
+		{Address: router, GasUsed: 10_000},
+	}, tracer.Frames())
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feePaymentContextKey is the context key of the fee payment of the Ethereum transaction
+type feePaymentContextKey struct{}
+
+// FeePayment defines the token paying the fees of an Ethereum transaction
+// instead of the EVM denom.
+type FeePayment struct {
+	// Denom of the bank coin of the fee token
+	Denom string
+	// ConversionRate is the amount of the fee token equivalent to one EVM coin,
+	// i.e. 10^18 in the 18 decimals representation of the EVM denom.
+	ConversionRate sdkmath.LegacyDec
+}
+
+// ContextWithFeePayment returns a context recording the fee token paying the fees of
+// the Ethereum transaction, so that the refund and the fee distribution use it too.
+func ContextWithFeePayment(ctx sdk.Context, payment FeePayment) sdk.Context {
+	return ctx.WithValue(feePaymentContextKey{}, payment)
+}
+
+// FeePaymentFromContext returns the fee token paying the fees of the Ethereum transaction
+// if it is not paid with the EVM denom. A zero FeePayment records a payment with the EVM denom.
+func FeePaymentFromContext(ctx sdk.Context) (FeePayment, bool) {
+	payment, ok := ctx.Value(feePaymentContextKey{}).(FeePayment)
+	return payment, ok && payment.Denom != ""
+}
+
+// ConvertFee returns the amount of the fee token to pay for a fee in the 18 decimals
+// representation of the EVM denom, rounded up.
+func (p FeePayment) ConvertFee(amount *big.Int) sdkmath.Int {
+	return p.convert(amount).Ceil().TruncateInt()
+}
+
+// ConvertAmount returns the amount of the fee token equivalent to an amount in the
+// 18 decimals representation of the EVM denom, rounded down. It is used for the
+// amounts paid back from the collected fees.
+func (p FeePayment) ConvertAmount(amount *big.Int) sdkmath.Int {
+	return p.convert(amount).TruncateInt()
+}
+
+func (p FeePayment) convert(amount *big.Int) sdkmath.LegacyDec {
+	return p.ConversionRate.
+		MulInt(sdkmath.NewIntFromBigInt(amount)).
+		QuoInt(sdkmath.NewIntWithDecimal(1, int(EighteenDecimals)))
+}
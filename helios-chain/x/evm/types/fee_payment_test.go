@@ -0,0 +1,41 @@
+package types
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeePaymentConversion(t *testing.T) {
+	// 1 EVM coin is worth 0.25 fee token base units
+	payment := FeePayment{Denom: "usdt", ConversionRate: sdkmath.LegacyNewDecWithPrec(25, 2)}
+	oneCoin := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	require.Equal(t, int64(1), payment.ConvertFee(oneCoin).Int64())
+	require.Equal(t, int64(0), payment.ConvertAmount(oneCoin).Int64())
+
+	fourCoins := new(big.Int).Mul(oneCoin, big.NewInt(4))
+	require.Equal(t, int64(1), payment.ConvertFee(fourCoins).Int64())
+	require.Equal(t, int64(1), payment.ConvertAmount(fourCoins).Int64())
+
+	require.Equal(t, int64(0), payment.ConvertFee(big.NewInt(0)).Int64())
+}
+
+func TestFeePaymentContext(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+
+	_, ok := FeePaymentFromContext(ctx)
+	require.False(t, ok)
+
+	payment := FeePayment{Denom: "usdt", ConversionRate: sdkmath.LegacyOneDec()}
+	got, ok := FeePaymentFromContext(ContextWithFeePayment(ctx, payment))
+	require.True(t, ok)
+	require.Equal(t, payment.Denom, got.Denom)
+
+	_, ok = FeePaymentFromContext(ContextWithFeePayment(ctx, FeePayment{}))
+	require.False(t, ok)
+}
@@ -89,3 +89,9 @@ type EvmHooks interface {
 	// Must be called after contract creation, if return an error, the whole transaction is reverted.
 	PostContractCreation(ctx sdk.Context, contractAddress common.Address, deployerAddress sdk.AccAddress) error
 }
+
+// CallFramesHooks is implemented by the EvmHooks using the gas used by the call frames of the
+// transactions, the frames are only recorded when one of the hooks requires them.
+type CallFramesHooks interface {
+	RequireCallFrames(ctx sdk.Context, msg core.Message) bool
+}
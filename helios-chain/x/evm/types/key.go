@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -36,6 +37,7 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientCallFrameGas
 )
 
 // KVStore key prefixes
@@ -53,8 +55,16 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+
+	KeyPrefixTransientCallFrameGas = []byte{prefixTransientCallFrameGas}
 )
 
+// CallFrameGasPrefix returns a prefix to iterate over the gas used by the call frames of a
+// transaction.
+func CallFrameGasPrefix(txIndex uint64) []byte {
+	return append(KeyPrefixTransientCallFrameGas, sdk.Uint64ToBigEndian(txIndex)...)
+}
+
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
 func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
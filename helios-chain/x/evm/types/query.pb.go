@@ -798,6 +798,8 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the json encoded state overrides (StateOverride) applied before the call
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -861,6 +863,13 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1246,6 +1255,334 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// overrides is the json encoded state overrides applied before the call
+	Overrides []byte `protobuf:"bytes,4,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_number of the block the call is traced on
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the block the call is traced on
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the block the call is traced on
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the block the call is traced on
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the block the call is traced on
+	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{22}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceCallRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{23}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// QuerySimulateV1Request defines SimulateV1 request
+type QuerySimulateV1Request struct {
+	// opts is the json encoded payload of eth_simulateV1 (blockStateCalls, validation...)
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// block_number of the base block the simulated blocks are built on
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash (hex) of the base block
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of the base block
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the base block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the base block
+	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+}
+
+func (m *QuerySimulateV1Request) Reset()         { *m = QuerySimulateV1Request{} }
+func (m *QuerySimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateV1Request) ProtoMessage()    {}
+func (*QuerySimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{24}
+}
+func (m *QuerySimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateV1Request.Merge(m, src)
+}
+func (m *QuerySimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateV1Request proto.InternalMessageInfo
+
+func (m *QuerySimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *QuerySimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QuerySimulateV1Request) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QuerySimulateV1Request) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QuerySimulateV1Request) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QuerySimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QuerySimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QuerySimulateV1Request) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+// QuerySimulateV1Response defines SimulateV1 response
+type QuerySimulateV1Response struct {
+	// data is the json encoded list of simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QuerySimulateV1Response) Reset()         { *m = QuerySimulateV1Response{} }
+func (m *QuerySimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateV1Response) ProtoMessage()    {}
+func (*QuerySimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{25}
+}
+func (m *QuerySimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateV1Response.Merge(m, src)
+}
+func (m *QuerySimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateV1Response proto.InternalMessageInfo
+
+func (m *QuerySimulateV1Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1255,7 +1592,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{26}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1294,7 +1631,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{27}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,7 +1668,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{24}
+	return fileDescriptor_e15a877459347994, []int{28}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1707,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{25}
+	return fileDescriptor_e15a877459347994, []int{29}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +1744,7 @@ func (m *QueryConfigRequest) Reset()         { *m = QueryConfigRequest{} }
 func (m *QueryConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigRequest) ProtoMessage()    {}
 func (*QueryConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{26}
+	return fileDescriptor_e15a877459347994, []int{30}
 }
 func (m *QueryConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1446,7 +1783,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{27}
+	return fileDescriptor_e15a877459347994, []int{31}
 }
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1490,7 +1827,7 @@ func (m *QueryTotalTransactionCountRequest) Reset()         { *m = QueryTotalTra
 func (m *QueryTotalTransactionCountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalTransactionCountRequest) ProtoMessage()    {}
 func (*QueryTotalTransactionCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{28}
+	return fileDescriptor_e15a877459347994, []int{32}
 }
 func (m *QueryTotalTransactionCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1529,7 +1866,7 @@ func (m *QueryTotalTransactionCountResponse) Reset()         { *m = QueryTotalTr
 func (m *QueryTotalTransactionCountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalTransactionCountResponse) ProtoMessage()    {}
 func (*QueryTotalTransactionCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{29}
+	return fileDescriptor_e15a877459347994, []int{33}
 }
 func (m *QueryTotalTransactionCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,6 +1925,10 @@ func init() {
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
+	proto.RegisterType((*QuerySimulateV1Request)(nil), "ethermint.evm.v1.QuerySimulateV1Request")
+	proto.RegisterType((*QuerySimulateV1Response)(nil), "ethermint.evm.v1.QuerySimulateV1Response")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "ethermint.evm.v1.QueryGlobalMinGasPriceRequest")
@@ -1601,114 +1942,123 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1709 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x13, 0xc1,
-	0x15, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x30, 0x43, 0x02, 0xce, 0x92, 0xd8, 0xc9, 0x42, 0x48,
-	0xa0, 0x64, 0x97, 0x04, 0x8a, 0xd4, 0x56, 0x6a, 0x9b, 0x58, 0x21, 0x50, 0xa0, 0xa2, 0xdb, 0xa8,
-	0x87, 0x4a, 0x95, 0x35, 0x5e, 0x0f, 0xeb, 0x55, 0xbc, 0x3b, 0x66, 0x67, 0x6c, 0x39, 0x20, 0x0e,
-	0x45, 0x15, 0x2d, 0xea, 0x05, 0xa9, 0xb7, 0xf6, 0xc2, 0xb1, 0x52, 0x2f, 0xed, 0x89, 0x7f, 0x81,
-	0x23, 0x52, 0x2f, 0x55, 0x0f, 0xb4, 0x82, 0x4a, 0xed, 0xdf, 0xd0, 0x53, 0x35, 0x3f, 0xd6, 0xf6,
-	0xda, 0x5e, 0x3b, 0x54, 0xf4, 0xd6, 0x4b, 0x32, 0x3b, 0xf3, 0xde, 0xfb, 0xbe, 0x79, 0xf3, 0x66,
-	0xde, 0x67, 0xb0, 0x82, 0x59, 0x1d, 0x87, 0xbe, 0x17, 0x30, 0x0b, 0xb7, 0x7d, 0xab, 0xbd, 0x63,
-	0x3d, 0x6d, 0xe1, 0xf0, 0xc4, 0x6c, 0x86, 0x84, 0x11, 0x98, 0xef, 0xae, 0x9a, 0xb8, 0xed, 0x9b,
-	0xed, 0x1d, 0xfd, 0x1c, 0xf2, 0xbd, 0x80, 0x58, 0xe2, 0xaf, 0x34, 0xd2, 0xaf, 0x3b, 0x84, 0xfa,
-	0x84, 0x5a, 0x55, 0x44, 0xb1, 0xf4, 0xb6, 0xda, 0x3b, 0x55, 0xcc, 0xd0, 0x8e, 0xd5, 0x44, 0xae,
-	0x17, 0x20, 0xe6, 0x91, 0x40, 0xd9, 0xea, 0x43, 0x70, 0x3c, 0xae, 0x5c, 0x5b, 0x1e, 0x5a, 0x63,
-	0x1d, 0xb5, 0xb4, 0xe8, 0x12, 0x97, 0x88, 0xa1, 0xc5, 0x47, 0x6a, 0x76, 0xc5, 0x25, 0xc4, 0x6d,
-	0x60, 0x0b, 0x35, 0x3d, 0x0b, 0x05, 0x01, 0x61, 0x02, 0x89, 0xaa, 0xd5, 0x92, 0x5a, 0x15, 0x5f,
-	0xd5, 0xd6, 0x13, 0x8b, 0x79, 0x3e, 0xa6, 0x0c, 0xf9, 0x4d, 0x69, 0x60, 0x7c, 0x0b, 0x9c, 0xff,
-	0x11, 0x67, 0xbb, 0xe7, 0x38, 0xa4, 0x15, 0x30, 0x1b, 0x3f, 0x6d, 0x61, 0xca, 0x60, 0x01, 0x64,
-	0x50, 0xad, 0x16, 0x62, 0x4a, 0x0b, 0xda, 0x9a, 0xb6, 0x35, 0x67, 0x47, 0x9f, 0xdf, 0xce, 0xfe,
-	0xea, 0x6d, 0x69, 0xea, 0x5f, 0x6f, 0x4b, 0x53, 0x86, 0x03, 0x16, 0xe3, 0xae, 0xb4, 0x49, 0x02,
-	0x8a, 0xb9, 0x6f, 0x15, 0x35, 0x50, 0xe0, 0xe0, 0xc8, 0x57, 0x7d, 0xc2, 0x4b, 0x60, 0xce, 0x21,
-	0x35, 0x5c, 0xa9, 0x23, 0x5a, 0x2f, 0x4c, 0x8b, 0xb5, 0x2c, 0x9f, 0xb8, 0x87, 0x68, 0x1d, 0x2e,
-	0x82, 0x99, 0x80, 0x70, 0xa7, 0xd4, 0x9a, 0xb6, 0x95, 0xb6, 0xe5, 0x87, 0xf1, 0x3d, 0xb0, 0x2c,
-	0x40, 0xca, 0x22, 0xbd, 0xff, 0x05, 0xcb, 0x57, 0x1a, 0xd0, 0x47, 0x45, 0x50, 0x64, 0x37, 0xc0,
-	0x19, 0x79, 0x72, 0x95, 0x78, 0xa4, 0x05, 0x39, 0xbb, 0x27, 0x27, 0xa1, 0x0e, 0xb2, 0x94, 0x83,
-	0x72, 0x7e, 0xd3, 0x82, 0x5f, 0xf7, 0x9b, 0x87, 0x40, 0x32, 0x6a, 0x25, 0x68, 0xf9, 0x55, 0x1c,
-	0xaa, 0x1d, 0x2c, 0xa8, 0xd9, 0x1f, 0x8a, 0x49, 0xe3, 0x01, 0x58, 0x11, 0x3c, 0x7e, 0x82, 0x1a,
-	0x5e, 0x0d, 0x31, 0x12, 0x0e, 0x6c, 0x66, 0x1d, 0xcc, 0x3b, 0x24, 0x18, 0xe4, 0x91, 0xe3, 0x73,
-	0x7b, 0x43, 0xbb, 0xfa, 0xb5, 0x06, 0x56, 0x13, 0xa2, 0xa9, 0x8d, 0x6d, 0x82, 0xb3, 0x11, 0xab,
-	0x78, 0xc4, 0x88, 0xec, 0x57, 0xdc, 0x5a, 0x54, 0x44, 0xfb, 0xf2, 0x9c, 0xbf, 0xe4, 0x78, 0x6e,
-	0xaa, 0x22, 0xea, 0xba, 0x4e, 0x2a, 0x22, 0xe3, 0x81, 0x02, 0xfb, 0x31, 0x23, 0x21, 0x72, 0x27,
-	0x83, 0xc1, 0x3c, 0x48, 0x1d, 0xe3, 0x13, 0x55, 0x6f, 0x7c, 0xd8, 0x07, 0x7f, 0x43, 0xc1, 0x77,
-	0x83, 0x29, 0xf8, 0x45, 0x30, 0xd3, 0x46, 0x8d, 0x56, 0x04, 0x2e, 0x3f, 0x8c, 0x3b, 0x20, 0xaf,
-	0x4a, 0xa9, 0xf6, 0x45, 0x9b, 0xdc, 0x04, 0xe7, 0xfa, 0xfc, 0x14, 0x04, 0x04, 0x69, 0x5e, 0xfb,
-	0xc2, 0x6b, 0xde, 0x16, 0x63, 0xe3, 0x19, 0x80, 0xc2, 0xf0, 0xa8, 0xf3, 0x90, 0xb8, 0x34, 0x82,
-	0x80, 0x20, 0x2d, 0x6e, 0x8c, 0x8c, 0x2f, 0xc6, 0xf0, 0x2e, 0x00, 0xbd, 0x77, 0x45, 0xec, 0x2d,
-	0xb7, 0x7b, 0xd5, 0x94, 0x45, 0x6b, 0xf2, 0x47, 0xc8, 0x94, 0x4f, 0x98, 0x7a, 0x84, 0xcc, 0xc7,
-	0xbd, 0x54, 0xd9, 0x7d, 0x9e, 0x7d, 0x24, 0x5f, 0x6b, 0x2a, 0xb1, 0x11, 0xb8, 0xe2, 0x79, 0x0d,
-	0xa4, 0x1b, 0xc4, 0xe5, 0xbb, 0x4b, 0x6d, 0xe5, 0x76, 0x97, 0xcc, 0xc1, 0xd7, 0xd0, 0x7c, 0x48,
-	0x5c, 0x5b, 0x98, 0xc0, 0xc3, 0x11, 0xa4, 0x36, 0x27, 0x92, 0x92, 0x38, 0xfd, 0xac, 0x8c, 0x45,
-	0x95, 0x87, 0xc7, 0x28, 0x44, 0x7e, 0x94, 0x07, 0xc3, 0x56, 0x04, 0xa3, 0x59, 0x45, 0xf0, 0x3b,
-	0x60, 0xb6, 0x29, 0x66, 0x44, 0x82, 0x72, 0xbb, 0x85, 0x61, 0x8a, 0xd2, 0x63, 0x7f, 0xee, 0xfd,
-	0xc7, 0xd2, 0xd4, 0xef, 0xff, 0xf9, 0xc7, 0xeb, 0x9a, 0xad, 0x5c, 0x8c, 0x77, 0x1a, 0x38, 0x73,
-	0xc0, 0xea, 0x65, 0xd4, 0x68, 0xf4, 0xa5, 0x1b, 0x85, 0x2e, 0x8d, 0x0e, 0x86, 0x8f, 0xe1, 0x45,
-	0x90, 0x71, 0x11, 0xad, 0x38, 0xa8, 0xa9, 0xee, 0xc8, 0xac, 0x8b, 0x68, 0x19, 0x35, 0xe1, 0xcf,
-	0x40, 0xbe, 0x19, 0x92, 0x26, 0xa1, 0x38, 0xec, 0xde, 0x33, 0x7e, 0x47, 0xe6, 0xf7, 0x77, 0xff,
-	0xfd, 0xb1, 0x64, 0xba, 0x1e, 0xab, 0xb7, 0xaa, 0xa6, 0x43, 0x7c, 0x4b, 0x35, 0x08, 0xf9, 0x6f,
-	0x9b, 0xd6, 0x8e, 0x2d, 0x76, 0xd2, 0xc4, 0xd4, 0x2c, 0xf7, 0x2e, 0xb8, 0x7d, 0x36, 0x8a, 0x15,
-	0x5d, 0xce, 0x65, 0x90, 0x75, 0xea, 0xc8, 0x0b, 0x2a, 0x5e, 0xad, 0x90, 0x5e, 0xd3, 0xb6, 0x52,
-	0x76, 0x46, 0x7c, 0xdf, 0xaf, 0x19, 0x47, 0xe0, 0xfc, 0x01, 0x65, 0x9e, 0x8f, 0x18, 0x3e, 0x44,
-	0xbd, 0x6c, 0xe4, 0x41, 0xca, 0x45, 0x92, 0x7c, 0xda, 0xe6, 0x43, 0x3e, 0x13, 0x62, 0x26, 0x78,
-	0xcf, 0xdb, 0x7c, 0xc8, 0xa3, 0xb6, 0xfd, 0x0a, 0x0e, 0x43, 0x22, 0x2f, 0xf4, 0x9c, 0x9d, 0x69,
-	0xfb, 0x07, 0xfc, 0xd3, 0x78, 0x9d, 0x8e, 0xaa, 0x20, 0x44, 0x0e, 0x3e, 0xea, 0x44, 0x49, 0xd9,
-	0x01, 0x29, 0x9f, 0xba, 0x2a, 0xc3, 0xa5, 0xe1, 0x0c, 0x3f, 0xa2, 0xee, 0x01, 0x9f, 0xc3, 0x2d,
-	0xff, 0xa8, 0x63, 0x73, 0x5b, 0xf8, 0x7d, 0x30, 0xcf, 0x78, 0x90, 0x8a, 0x43, 0x82, 0x27, 0x9e,
-	0x2b, 0x90, 0x72, 0xbb, 0xab, 0xc3, 0xbe, 0x02, 0xaa, 0x2c, 0x8c, 0xec, 0x1c, 0xeb, 0x7d, 0xc0,
-	0x32, 0x98, 0x6f, 0x86, 0xb8, 0x86, 0x1d, 0x4c, 0x29, 0x09, 0x69, 0x21, 0x2d, 0x4a, 0x70, 0x22,
-	0x7a, 0xcc, 0x89, 0xbf, 0xab, 0xd5, 0x06, 0x71, 0x8e, 0xa3, 0x17, 0x6c, 0x46, 0xa4, 0x31, 0x27,
-	0xe6, 0xe4, 0xfb, 0x05, 0x57, 0x01, 0x90, 0x26, 0xe2, 0x9a, 0xcd, 0x8a, 0x8c, 0xcc, 0x89, 0x19,
-	0xd1, 0x99, 0xee, 0x45, 0xcb, 0xbc, 0x79, 0x16, 0x32, 0x62, 0x1b, 0xba, 0x29, 0x3b, 0xab, 0x19,
-	0x75, 0x56, 0xf3, 0x28, 0xea, 0xac, 0xfb, 0x0b, 0xbc, 0xcc, 0xde, 0xfc, 0xad, 0xa4, 0xc9, 0x52,
-	0x93, 0x91, 0xf8, 0xf2, 0xc8, 0x6a, 0xc9, 0xfe, 0x6f, 0xaa, 0x65, 0x2e, 0x56, 0x2d, 0xd0, 0x00,
-	0x0b, 0x72, 0x0f, 0x3e, 0xea, 0x54, 0x78, 0x81, 0x80, 0xbe, 0x34, 0x3c, 0x42, 0x9d, 0x43, 0x44,
-	0x7f, 0x90, 0xce, 0x4e, 0xe7, 0x53, 0x76, 0x96, 0x75, 0x2a, 0x5e, 0x50, 0xc3, 0x1d, 0xe3, 0xba,
-	0x7a, 0x1c, 0xbb, 0xa5, 0xd0, 0x7b, 0xb9, 0x6a, 0x88, 0xa1, 0xe8, 0x82, 0xf0, 0xb1, 0xf1, 0x2e,
-	0x05, 0x2e, 0xf4, 0x8c, 0xf7, 0x79, 0xd4, 0xbe, 0xd2, 0x61, 0x9d, 0xe8, 0xfd, 0x98, 0x5c, 0x3a,
-	0xac, 0x43, 0xbf, 0x42, 0xe9, 0xfc, 0xff, 0xd4, 0x4f, 0x79, 0xea, 0xc6, 0x36, 0xb8, 0x38, 0x74,
-	0x70, 0x63, 0x0e, 0x7a, 0xa9, 0xdb, 0xeb, 0x29, 0xbe, 0x8b, 0xa3, 0x9e, 0x62, 0x3c, 0xec, 0xf6,
-	0x71, 0x35, 0xad, 0x42, 0xdc, 0x06, 0x59, 0xfe, 0xf0, 0x57, 0x9e, 0x60, 0xd5, 0x4b, 0xf7, 0x97,
-	0xff, 0xfa, 0xb1, 0xb4, 0x24, 0x77, 0x48, 0x6b, 0xc7, 0xa6, 0x47, 0x2c, 0x1f, 0xb1, 0xba, 0x79,
-	0x3f, 0x60, 0xbc, 0xc7, 0x0b, 0x6f, 0xa3, 0xa4, 0xd4, 0xcd, 0x61, 0x83, 0x54, 0x51, 0xe3, 0x91,
-	0x17, 0x1c, 0x22, 0xfa, 0x38, 0xf4, 0xba, 0xd2, 0xc2, 0x70, 0x40, 0x31, 0xc9, 0x40, 0x01, 0xef,
-	0x81, 0x05, 0xdf, 0x0b, 0xf8, 0xa6, 0x2b, 0x4d, 0xbe, 0xa0, 0xd0, 0x57, 0xf9, 0x29, 0x25, 0x33,
-	0xc8, 0xf9, 0xbd, 0x50, 0xdd, 0x2e, 0xa4, 0xea, 0xab, 0xbb, 0xd3, 0xf3, 0xb1, 0x59, 0x85, 0xf7,
-	0x4d, 0x30, 0xab, 0x8a, 0x55, 0x4b, 0x2a, 0xd6, 0x32, 0x3f, 0x15, 0xe5, 0xa6, 0x8c, 0x8d, 0xcb,
-	0x60, 0x5d, 0x66, 0x9f, 0x30, 0xd4, 0x38, 0x0a, 0x51, 0x40, 0x91, 0xc3, 0x5b, 0x60, 0xb9, 0x4f,
-	0x1a, 0x1a, 0x07, 0xc0, 0x18, 0x67, 0xa4, 0x18, 0x94, 0x40, 0x8e, 0x71, 0x83, 0x8a, 0x90, 0x66,
-	0xaa, 0x03, 0x00, 0x31, 0x25, 0x0c, 0x77, 0x5f, 0xe5, 0xc1, 0x8c, 0x88, 0x03, 0x7f, 0xae, 0x81,
-	0x8c, 0x12, 0x8c, 0x70, 0x63, 0x98, 0xe8, 0x88, 0x5f, 0x04, 0xfa, 0xd5, 0x49, 0x66, 0x92, 0x85,
-	0xb1, 0xf9, 0xf2, 0xcf, 0xff, 0xf8, 0xcd, 0xf4, 0x3a, 0x2c, 0xf1, 0xdf, 0x2f, 0x84, 0x46, 0xbf,
-	0x62, 0x94, 0x60, 0xb4, 0x9e, 0xab, 0x0b, 0xf0, 0x02, 0xfe, 0x56, 0x03, 0x0b, 0x31, 0x4d, 0x0e,
-	0xbf, 0x91, 0x00, 0x31, 0x4a, 0xfb, 0xeb, 0x37, 0x4e, 0x67, 0xac, 0x58, 0x99, 0x82, 0xd5, 0x16,
-	0xbc, 0x1a, 0x67, 0x15, 0x49, 0xff, 0x21, 0x72, 0x7f, 0xd0, 0x40, 0x7e, 0x50, 0x5a, 0x43, 0x33,
-	0x01, 0x32, 0x41, 0xd1, 0xeb, 0xd6, 0xa9, 0xed, 0x15, 0xcb, 0x3b, 0x82, 0xe5, 0x4d, 0x68, 0xc6,
-	0x59, 0xb6, 0x23, 0xfb, 0x1e, 0xd1, 0xfe, 0x5f, 0x0a, 0x2f, 0xe0, 0x4b, 0x0d, 0x64, 0x94, 0x80,
-	0x4e, 0x3c, 0xce, 0xb8, 0x36, 0x4f, 0x3c, 0xce, 0x01, 0x1d, 0x6e, 0x6c, 0x09, 0x4a, 0x06, 0x5c,
-	0x8b, 0x53, 0x52, 0x62, 0x9c, 0xf6, 0xa5, 0xec, 0x97, 0x1a, 0xc8, 0x28, 0x19, 0x9d, 0x48, 0x22,
-	0xae, 0xd9, 0x13, 0x49, 0x0c, 0xa8, 0x71, 0x63, 0x5b, 0x90, 0xd8, 0x84, 0x1b, 0x71, 0x12, 0x54,
-	0x9a, 0xf5, 0x38, 0x58, 0xcf, 0x8f, 0xf1, 0xc9, 0x0b, 0xd8, 0x06, 0x69, 0xae, 0xb4, 0xa1, 0x91,
-	0x58, 0x22, 0x5d, 0xf9, 0xae, 0x5f, 0x1e, 0x6b, 0xa3, 0xf0, 0x37, 0x04, 0x7e, 0x09, 0xae, 0x0e,
-	0x56, 0x4f, 0x2d, 0x96, 0x01, 0x0a, 0x66, 0xa5, 0xd0, 0x84, 0x57, 0x12, 0xa2, 0xc6, 0xf4, 0xac,
-	0xbe, 0x31, 0xc1, 0x4a, 0xa1, 0xaf, 0x08, 0xf4, 0x0b, 0x70, 0x31, 0x8e, 0x2e, 0x05, 0x2c, 0x64,
-	0x20, 0xa3, 0xf4, 0x2b, 0x5c, 0x1b, 0x8e, 0x17, 0x97, 0xb6, 0xfa, 0xe6, 0xa4, 0xee, 0x1b, 0x61,
-	0x16, 0x05, 0x66, 0x01, 0x5e, 0x88, 0x63, 0x62, 0x56, 0xaf, 0x38, 0x1c, 0xea, 0x19, 0xc8, 0xf5,
-	0x89, 0xcf, 0x53, 0x20, 0x8f, 0xd8, 0xeb, 0x08, 0xf5, 0x6a, 0x18, 0x02, 0x77, 0x05, 0xea, 0x03,
-	0xb8, 0xca, 0x94, 0x3f, 0xe7, 0xb0, 0x03, 0x32, 0x4a, 0x91, 0x24, 0xd6, 0x59, 0x5c, 0xbc, 0x26,
-	0xd6, 0xd9, 0x80, 0xb0, 0x49, 0xda, 0xb5, 0x94, 0x22, 0xac, 0x03, 0x7f, 0xa1, 0x01, 0xd0, 0x6b,
-	0x93, 0x70, 0x6b, 0x5c, 0xd8, 0x7e, 0x09, 0xa4, 0x5f, 0x3b, 0x85, 0xa5, 0xe2, 0xb0, 0x2e, 0x38,
-	0x5c, 0x82, 0xcb, 0xa3, 0x38, 0x88, 0xbe, 0xcd, 0x13, 0xa0, 0xda, 0xec, 0x98, 0xdb, 0xde, 0xdf,
-	0x9d, 0xc7, 0xdc, 0xf6, 0x58, 0xb7, 0x4e, 0x4a, 0x40, 0xd4, 0xc1, 0xe1, 0xef, 0x34, 0x70, 0x6e,
-	0xa8, 0xe5, 0xc2, 0xa4, 0x77, 0x2e, 0xa9, 0x7b, 0xeb, 0x37, 0x4f, 0xef, 0xa0, 0x88, 0x5d, 0x16,
-	0xc4, 0x56, 0xe1, 0xa5, 0x38, 0xb1, 0x58, 0x87, 0xe7, 0xf7, 0x4f, 0xa9, 0xbf, 0x2b, 0x89, 0xb7,
-	0xba, 0xaf, 0x93, 0x27, 0xde, 0xbf, 0x78, 0x67, 0x4f, 0xba, 0x7f, 0xb2, 0x81, 0xc3, 0x3f, 0x69,
-	0x60, 0x69, 0x64, 0x5f, 0x86, 0xb7, 0x92, 0x0e, 0x7d, 0x4c, 0xab, 0xd7, 0x6f, 0x7f, 0x99, 0xd3,
-	0xf8, 0x07, 0x52, 0xca, 0x01, 0xd6, 0xf3, 0x92, 0xd2, 0x60, 0xff, 0xbb, 0xef, 0x3f, 0x15, 0xb5,
-	0x0f, 0x9f, 0x8a, 0xda, 0xdf, 0x3f, 0x15, 0xb5, 0x37, 0x9f, 0x8b, 0x53, 0x1f, 0x3e, 0x17, 0xa7,
-	0xfe, 0xf2, 0xb9, 0x38, 0xf5, 0xd3, 0x2b, 0x75, 0xdc, 0xf0, 0x08, 0xdd, 0x76, 0x48, 0x88, 0xad,
-	0x68, 0xcc, 0x65, 0x8b, 0xd5, 0x11, 0x41, 0x85, 0x1c, 0xad, 0xce, 0x0a, 0xf9, 0x7b, 0xeb, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x3b, 0x99, 0x71, 0x38, 0x15, 0x00, 0x00,
+	// 1841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x94, 0x28, 0x3d, 0x4a, 0x89, 0x3c, 0xa2, 0x1c, 0x6a, 0x2d, 0x89, 0xf2, 0xda,
+	0xfa, 0xb0, 0x6b, 0xef, 0x5a, 0x4a, 0x1a, 0xa0, 0x2d, 0xd0, 0xd6, 0x12, 0x14, 0x25, 0x8d, 0x5d,
+	0xb8, 0x8c, 0x90, 0x43, 0x81, 0x82, 0x18, 0x2e, 0xc7, 0xcb, 0x85, 0xb8, 0x3b, 0xcc, 0xce, 0x90,
+	0xa0, 0x13, 0x18, 0x68, 0x83, 0xa2, 0x6d, 0xd0, 0x8b, 0x81, 0xde, 0xda, 0x4b, 0x8e, 0x05, 0x7a,
+	0x69, 0x4f, 0xfd, 0x17, 0x72, 0x2a, 0x02, 0x14, 0x05, 0x8a, 0x1e, 0xdc, 0xc2, 0x2e, 0xd0, 0xde,
+	0x7a, 0xef, 0xa9, 0x98, 0x8f, 0x25, 0x77, 0x45, 0x2e, 0x49, 0xa7, 0xc9, 0xcd, 0x17, 0x69, 0x3e,
+	0xde, 0xc7, 0xef, 0x7d, 0xcc, 0xdb, 0xf7, 0x08, 0x1b, 0x84, 0x37, 0x49, 0x14, 0xf8, 0x21, 0x77,
+	0x48, 0x37, 0x70, 0xba, 0x07, 0xce, 0x07, 0x1d, 0x12, 0x3d, 0xb2, 0xdb, 0x11, 0xe5, 0x14, 0xad,
+	0xf4, 0x6f, 0x6d, 0xd2, 0x0d, 0xec, 0xee, 0x81, 0x79, 0x09, 0x07, 0x7e, 0x48, 0x1d, 0xf9, 0x57,
+	0x11, 0x99, 0x37, 0x5d, 0xca, 0x02, 0xca, 0x9c, 0x3a, 0x66, 0x44, 0x71, 0x3b, 0xdd, 0x83, 0x3a,
+	0xe1, 0xf8, 0xc0, 0x69, 0x63, 0xcf, 0x0f, 0x31, 0xf7, 0x69, 0xa8, 0x69, 0xcd, 0x21, 0x75, 0x42,
+	0xae, 0xba, 0x5b, 0x1f, 0xba, 0xe3, 0x3d, 0x7d, 0x55, 0xf2, 0xa8, 0x47, 0xe5, 0xd2, 0x11, 0x2b,
+	0x7d, 0xba, 0xe1, 0x51, 0xea, 0xb5, 0x88, 0x83, 0xdb, 0xbe, 0x83, 0xc3, 0x90, 0x72, 0xa9, 0x89,
+	0xe9, 0xdb, 0x8a, 0xbe, 0x95, 0xbb, 0x7a, 0xe7, 0xa1, 0xc3, 0xfd, 0x80, 0x30, 0x8e, 0x83, 0xb6,
+	0x22, 0xb0, 0xbe, 0x01, 0xab, 0x3f, 0x10, 0x68, 0xef, 0xba, 0x2e, 0xed, 0x84, 0xbc, 0x4a, 0x3e,
+	0xe8, 0x10, 0xc6, 0x51, 0x19, 0x0a, 0xb8, 0xd1, 0x88, 0x08, 0x63, 0x65, 0x63, 0xdb, 0xd8, 0x5f,
+	0xac, 0xc6, 0xdb, 0x6f, 0x2e, 0xfc, 0xe2, 0xd3, 0xca, 0xcc, 0xbf, 0x3f, 0xad, 0xcc, 0x58, 0x2e,
+	0x94, 0xd2, 0xac, 0xac, 0x4d, 0x43, 0x46, 0x04, 0x6f, 0x1d, 0xb7, 0x70, 0xe8, 0x92, 0x98, 0x57,
+	0x6f, 0xd1, 0x15, 0x58, 0x74, 0x69, 0x83, 0xd4, 0x9a, 0x98, 0x35, 0xcb, 0xb3, 0xf2, 0x6e, 0x41,
+	0x1c, 0xbc, 0x8d, 0x59, 0x13, 0x95, 0x60, 0x2e, 0xa4, 0x82, 0x29, 0xb7, 0x6d, 0xec, 0xe7, 0xab,
+	0x6a, 0x63, 0x7d, 0x07, 0xd6, 0xa5, 0x92, 0x63, 0xe9, 0xde, 0x2f, 0x80, 0xf2, 0x67, 0x06, 0x98,
+	0xa3, 0x24, 0x68, 0xb0, 0x3b, 0xf0, 0x8a, 0x8a, 0x5c, 0x2d, 0x2d, 0x69, 0x59, 0x9d, 0xde, 0x55,
+	0x87, 0xc8, 0x84, 0x05, 0x26, 0x94, 0x0a, 0x7c, 0xb3, 0x12, 0x5f, 0x7f, 0x2f, 0x44, 0x60, 0x25,
+	0xb5, 0x16, 0x76, 0x82, 0x3a, 0x89, 0xb4, 0x05, 0xcb, 0xfa, 0xf4, 0xfb, 0xf2, 0xd0, 0x7a, 0x17,
+	0x36, 0x24, 0x8e, 0xf7, 0x71, 0xcb, 0x6f, 0x60, 0x4e, 0xa3, 0x0b, 0xc6, 0x5c, 0x85, 0x25, 0x97,
+	0x86, 0x17, 0x71, 0x14, 0xc5, 0xd9, 0xdd, 0x21, 0xab, 0x7e, 0x69, 0xc0, 0x66, 0x86, 0x34, 0x6d,
+	0xd8, 0x1e, 0xbc, 0x1a, 0xa3, 0x4a, 0x4b, 0x8c, 0xc1, 0x7e, 0x89, 0xa6, 0xc5, 0x49, 0x74, 0xa4,
+	0xe2, 0xfc, 0x22, 0xe1, 0xb9, 0x03, 0xa5, 0x34, 0xeb, 0xa4, 0x24, 0xb2, 0xde, 0xd5, 0xca, 0xde,
+	0xe3, 0x34, 0xc2, 0xde, 0x64, 0x65, 0x68, 0x05, 0x72, 0xe7, 0xe4, 0x91, 0xce, 0x37, 0xb1, 0x4c,
+	0xa8, 0xbf, 0x05, 0xa5, 0xb4, 0x30, 0xad, 0xbe, 0x04, 0x73, 0x5d, 0xdc, 0xea, 0xc4, 0xca, 0xd5,
+	0xc6, 0x7a, 0x13, 0x56, 0x74, 0x2a, 0x35, 0x5e, 0xc8, 0xc8, 0x3d, 0xb8, 0x94, 0xe0, 0xd3, 0x2a,
+	0x10, 0xe4, 0x45, 0xee, 0x4b, 0xae, 0xa5, 0xaa, 0x5c, 0x5b, 0x1f, 0x02, 0x92, 0x84, 0x67, 0xbd,
+	0x7b, 0xd4, 0x63, 0xb1, 0x0a, 0x04, 0x79, 0xf9, 0x62, 0x94, 0x7c, 0xb9, 0x46, 0x6f, 0x01, 0x0c,
+	0xea, 0x8a, 0xb4, 0xad, 0x78, 0xb8, 0x6b, 0xab, 0xa4, 0xb5, 0x45, 0x11, 0xb2, 0x55, 0x09, 0xd3,
+	0x45, 0xc8, 0x7e, 0x30, 0x70, 0x55, 0x35, 0xc1, 0x99, 0x00, 0xf9, 0x89, 0x01, 0xab, 0x29, 0xe5,
+	0x1a, 0xe7, 0x0d, 0xc8, 0xb7, 0xa8, 0x27, 0xac, 0xcb, 0xed, 0x17, 0x0f, 0xd7, 0xec, 0x8b, 0xd5,
+	0xd0, 0xbe, 0x47, 0xbd, 0xaa, 0x24, 0x41, 0xa7, 0x23, 0x40, 0xed, 0x4d, 0x04, 0xa5, 0xf4, 0x24,
+	0x51, 0x59, 0x25, 0xed, 0x87, 0x07, 0x38, 0xc2, 0x41, 0xec, 0x07, 0xab, 0x0a, 0xab, 0xa9, 0x53,
+	0x0d, 0xf0, 0x5b, 0x30, 0xdf, 0x96, 0x27, 0xd2, 0x41, 0xc5, 0xc3, 0xf2, 0x30, 0x44, 0xc5, 0x71,
+	0xb4, 0xf8, 0xd9, 0xd3, 0xca, 0xcc, 0x6f, 0xff, 0xf5, 0xfb, 0x9b, 0x46, 0x55, 0xb3, 0x58, 0x7f,
+	0x31, 0xe0, 0x95, 0x13, 0xde, 0x3c, 0xc6, 0xad, 0x56, 0xc2, 0xdd, 0x38, 0xf2, 0x58, 0x1c, 0x18,
+	0xb1, 0x46, 0xaf, 0x41, 0xc1, 0xc3, 0xac, 0xe6, 0xe2, 0xb6, 0x7e, 0x23, 0xf3, 0x1e, 0x66, 0xc7,
+	0xb8, 0x8d, 0x7e, 0x04, 0x2b, 0xed, 0x88, 0xb6, 0x29, 0x23, 0x51, 0xff, 0x9d, 0x89, 0x37, 0xb2,
+	0x74, 0x74, 0xf8, 0xdf, 0xa7, 0x15, 0xdb, 0xf3, 0x79, 0xb3, 0x53, 0xb7, 0x5d, 0x1a, 0x38, 0xfa,
+	0x03, 0xa1, 0xfe, 0xdd, 0x66, 0x8d, 0x73, 0x87, 0x3f, 0x6a, 0x13, 0x66, 0x1f, 0x0f, 0x1e, 0x78,
+	0xf5, 0xd5, 0x58, 0x56, 0xfc, 0x38, 0xd7, 0x61, 0xc1, 0x6d, 0x62, 0x3f, 0xac, 0xf9, 0x8d, 0x72,
+	0x7e, 0xdb, 0xd8, 0xcf, 0x55, 0x0b, 0x72, 0xff, 0x4e, 0x03, 0x6d, 0xc0, 0x22, 0xed, 0x92, 0x28,
+	0xf2, 0x1b, 0x84, 0x95, 0xe7, 0x24, 0xd6, 0xc1, 0x81, 0x75, 0x06, 0xab, 0x27, 0x8c, 0xfb, 0x01,
+	0xe6, 0xe4, 0x14, 0x0f, 0x7c, 0xb5, 0x02, 0x39, 0x0f, 0x2b, 0xd3, 0xf2, 0x55, 0xb1, 0x14, 0x27,
+	0x11, 0xe1, 0xd2, 0xaa, 0xa5, 0xaa, 0x58, 0x0a, 0x9d, 0xdd, 0xa0, 0x46, 0xa2, 0x88, 0xaa, 0xe7,
+	0xbe, 0x58, 0x2d, 0x74, 0x83, 0x13, 0xb1, 0xb5, 0x3e, 0xc9, 0xc7, 0x39, 0x12, 0x61, 0x97, 0x9c,
+	0xf5, 0x62, 0x97, 0x1d, 0x40, 0x2e, 0x60, 0x9e, 0xf6, 0x7f, 0x65, 0xd8, 0xff, 0xf7, 0x99, 0x77,
+	0x22, 0xce, 0x48, 0x27, 0x38, 0xeb, 0x55, 0x05, 0x2d, 0xfa, 0x2e, 0x2c, 0x71, 0x21, 0xa4, 0xe6,
+	0xd2, 0xf0, 0xa1, 0xef, 0x49, 0x4d, 0xc5, 0xc3, 0xcd, 0x61, 0x5e, 0xa9, 0xea, 0x58, 0x12, 0x55,
+	0x8b, 0x7c, 0xb0, 0x41, 0xc7, 0xb0, 0xd4, 0x8e, 0x48, 0x83, 0xb8, 0x84, 0x31, 0x1a, 0xb1, 0x72,
+	0x7e, 0x3b, 0x37, 0x8d, 0xf6, 0x14, 0x93, 0xa8, 0xba, 0xf5, 0x16, 0x75, 0xcf, 0xe3, 0xfa, 0x36,
+	0x27, 0x9d, 0x5c, 0x94, 0x67, 0xaa, 0xba, 0xa1, 0x4d, 0x00, 0x45, 0x22, 0x1f, 0xe1, 0xbc, 0xf4,
+	0xc8, 0xa2, 0x3c, 0x91, 0xdf, 0xad, 0xb7, 0xe3, 0x6b, 0xf1, 0x69, 0x2d, 0x17, 0xa4, 0x19, 0xa6,
+	0xad, 0xbe, 0xbb, 0x76, 0xfc, 0xdd, 0xb5, 0xcf, 0xe2, 0xef, 0xee, 0xd1, 0xb2, 0x48, 0xc2, 0x27,
+	0x7f, 0xaf, 0x18, 0x2a, 0x11, 0x95, 0x24, 0x71, 0x3d, 0x32, 0x97, 0x16, 0xbe, 0x9a, 0x5c, 0x5a,
+	0x4c, 0xe7, 0x92, 0x05, 0xcb, 0xca, 0x86, 0x00, 0xf7, 0x6a, 0x22, 0x41, 0x20, 0xe1, 0x86, 0xfb,
+	0xb8, 0x77, 0x8a, 0xd9, 0xf7, 0xf2, 0x0b, 0xb3, 0x2b, 0xb9, 0xea, 0x02, 0xef, 0xd5, 0xfc, 0xb0,
+	0x41, 0x7a, 0xd6, 0x4d, 0x5d, 0x3a, 0xfb, 0xa9, 0x30, 0xa8, 0x6b, 0x0d, 0xcc, 0x71, 0xfc, 0x7c,
+	0xc4, 0xda, 0xfa, 0x63, 0x0e, 0x2e, 0x0f, 0x88, 0x8f, 0x84, 0xd4, 0x44, 0xea, 0xf0, 0x5e, 0x5c,
+	0x5d, 0x26, 0xa7, 0x0e, 0xef, 0xb1, 0x2f, 0x21, 0x75, 0x5e, 0x46, 0x7d, 0xca, 0xa8, 0x5b, 0xb7,
+	0xe1, 0xb5, 0xa1, 0xc0, 0x8d, 0x09, 0xf4, 0x9f, 0x72, 0xb0, 0x36, 0xa0, 0xff, 0xc2, 0x55, 0xf5,
+	0xff, 0x8f, 0x70, 0xaa, 0x3a, 0xe6, 0x2f, 0x54, 0xc7, 0x97, 0xf1, 0x9f, 0x3a, 0xfe, 0xb7, 0xe0,
+	0xf2, 0xc5, 0x78, 0x8e, 0x09, 0xff, 0x7f, 0x66, 0x35, 0xf9, 0x7b, 0x7e, 0xd0, 0x69, 0x61, 0x4e,
+	0xde, 0x3f, 0x48, 0xc4, 0x9f, 0xb6, 0x79, 0x3f, 0xfe, 0x62, 0x9d, 0x1d, 0xff, 0x97, 0xf1, 0x79,
+	0xd1, 0xf7, 0x99, 0x74, 0xf8, 0x98, 0x00, 0xad, 0xf5, 0x3b, 0x75, 0x46, 0xde, 0x22, 0x71, 0x47,
+	0x68, 0xdd, 0x83, 0x52, 0xfa, 0x58, 0x8b, 0x78, 0x03, 0x16, 0x44, 0xdb, 0x56, 0x7b, 0x48, 0x74,
+	0x27, 0x7c, 0xb4, 0xfe, 0xb7, 0xa7, 0x95, 0x35, 0x65, 0x21, 0x6b, 0x9c, 0xdb, 0x3e, 0x75, 0x02,
+	0xcc, 0x9b, 0xf6, 0x3b, 0x21, 0x17, 0x1d, 0xba, 0xe4, 0xb6, 0x2a, 0x7a, 0x36, 0x39, 0x6d, 0xd1,
+	0x3a, 0x6e, 0xdd, 0xf7, 0xc3, 0x53, 0xcc, 0x1e, 0x44, 0x7e, 0x7f, 0x30, 0xb0, 0x5c, 0xd8, 0xca,
+	0x22, 0xd0, 0x8a, 0xef, 0xc2, 0x72, 0xe0, 0x87, 0xc2, 0xe8, 0x5a, 0x5b, 0x5c, 0x68, 0xed, 0x9b,
+	0x22, 0x4a, 0xd9, 0x08, 0x8a, 0xc1, 0x40, 0x54, 0xbf, 0x87, 0xd4, 0xd5, 0xa1, 0x6f, 0xe9, 0x6a,
+	0xea, 0x54, 0xeb, 0xfb, 0x3a, 0xcc, 0xeb, 0x52, 0x63, 0x64, 0x95, 0x9a, 0x63, 0x11, 0x15, 0xcd,
+	0xa6, 0x89, 0xad, 0x6b, 0x70, 0x55, 0xbd, 0x0e, 0xca, 0x71, 0xeb, 0x2c, 0xc2, 0x21, 0xc3, 0xae,
+	0x68, 0x60, 0x8f, 0x13, 0x83, 0x9d, 0x75, 0x02, 0xd6, 0x38, 0x22, 0x8d, 0xa0, 0x02, 0x45, 0x2e,
+	0x08, 0x6a, 0x72, 0xb0, 0xd2, 0x1d, 0x1a, 0xc8, 0x23, 0x49, 0x78, 0xf8, 0x04, 0xc1, 0x9c, 0x94,
+	0x83, 0x7e, 0x62, 0x40, 0x41, 0x8f, 0x7b, 0x68, 0x67, 0x18, 0xe8, 0x88, 0x79, 0xde, 0xdc, 0x9d,
+	0x44, 0xa6, 0x50, 0x58, 0x7b, 0x1f, 0xff, 0xf9, 0x9f, 0xbf, 0x9a, 0xbd, 0x8a, 0x2a, 0xe2, 0xd7,
+	0x07, 0xca, 0xe2, 0xdf, 0x20, 0xf4, 0xb8, 0xe7, 0x7c, 0xa4, 0x1f, 0xc0, 0x63, 0xf4, 0x6b, 0x03,
+	0x96, 0x53, 0x13, 0x35, 0xfa, 0x5a, 0x86, 0x8a, 0x51, 0x93, 0xbb, 0x79, 0x6b, 0x3a, 0x62, 0x8d,
+	0xca, 0x96, 0xa8, 0xf6, 0xd1, 0x6e, 0x1a, 0x55, 0x3c, 0xb8, 0x0f, 0x81, 0xfb, 0x9d, 0x01, 0x2b,
+	0x17, 0x07, 0x63, 0x64, 0x67, 0xa8, 0xcc, 0x98, 0xc7, 0x4d, 0x67, 0x6a, 0x7a, 0x8d, 0xf2, 0x4d,
+	0x89, 0xf2, 0x0e, 0xb2, 0xd3, 0x28, 0xbb, 0x31, 0xfd, 0x00, 0x68, 0x72, 0xce, 0x7f, 0x8c, 0x3e,
+	0x36, 0xa0, 0xa0, 0xc7, 0xdf, 0xcc, 0x70, 0xa6, 0x27, 0x6b, 0x73, 0x77, 0x12, 0x99, 0x86, 0xb4,
+	0x2f, 0x21, 0x59, 0x68, 0x3b, 0x0d, 0x49, 0x8f, 0xd2, 0x2c, 0xe1, 0xb2, 0x9f, 0x1b, 0x50, 0xd0,
+	0x43, 0x70, 0x26, 0x88, 0xf4, 0xc4, 0x6d, 0xee, 0x4e, 0x22, 0xd3, 0x20, 0x6e, 0x4b, 0x10, 0x7b,
+	0x68, 0x27, 0x0d, 0x82, 0x29, 0xb2, 0x01, 0x06, 0xe7, 0xa3, 0x73, 0xf2, 0xe8, 0x31, 0xea, 0x42,
+	0x5e, 0xcc, 0xc9, 0xc8, 0xca, 0x4c, 0x91, 0xfe, 0xf0, 0x6d, 0x5e, 0x1b, 0x4b, 0xa3, 0xf5, 0xef,
+	0x48, 0xfd, 0x15, 0xb4, 0x79, 0x31, 0x7b, 0x1a, 0x29, 0x0f, 0x30, 0x98, 0x57, 0x63, 0x22, 0xba,
+	0x9e, 0x21, 0x35, 0x35, 0x8d, 0x9a, 0x3b, 0x13, 0xa8, 0xb4, 0xf6, 0x0d, 0xa9, 0xfd, 0x32, 0x2a,
+	0xa5, 0xb5, 0xab, 0xf1, 0x13, 0x71, 0x28, 0xe8, 0xe9, 0x13, 0x6d, 0x0f, 0xcb, 0x4b, 0x0f, 0xa6,
+	0xe6, 0xde, 0xa4, 0xee, 0x38, 0xd6, 0xb9, 0x25, 0x75, 0x96, 0xd1, 0xe5, 0xb4, 0x4e, 0xc2, 0x9b,
+	0x35, 0x57, 0xa8, 0xfa, 0x10, 0x8a, 0x89, 0xe1, 0x70, 0x0a, 0xcd, 0x23, 0x6c, 0x1d, 0x31, 0x5d,
+	0x5a, 0x96, 0xd4, 0xbb, 0x81, 0xcc, 0x0b, 0x7a, 0x35, 0xa9, 0x28, 0xe7, 0xa8, 0x07, 0x05, 0x3d,
+	0x31, 0x64, 0xe6, 0x59, 0x7a, 0xb8, 0x34, 0x77, 0x27, 0x91, 0x8d, 0xb7, 0x5a, 0x35, 0x92, 0xbc,
+	0x87, 0x7e, 0x6a, 0x00, 0x0c, 0xda, 0x58, 0xb4, 0x3f, 0x4e, 0x6c, 0x72, 0x44, 0x31, 0x6f, 0x4c,
+	0x41, 0xa9, 0x31, 0x5c, 0x95, 0x18, 0xae, 0xa0, 0xf5, 0x51, 0x18, 0xe4, 0x77, 0x1b, 0xfd, 0xd8,
+	0x80, 0xc5, 0x7e, 0x37, 0x85, 0xf6, 0xc6, 0xc9, 0x4e, 0x86, 0x60, 0x7f, 0x32, 0xa1, 0xc6, 0xb0,
+	0x2d, 0x31, 0x98, 0xa8, 0x3c, 0x0a, 0x83, 0x8c, 0xbf, 0xf0, 0xc4, 0xa0, 0x61, 0xc8, 0xf4, 0xc4,
+	0x50, 0x13, 0x67, 0xde, 0x98, 0x82, 0x72, 0xbc, 0x27, 0x98, 0xa6, 0xac, 0x75, 0x0f, 0x44, 0x2a,
+	0xe8, 0x86, 0x63, 0x4c, 0xdd, 0x4b, 0xf6, 0x29, 0xe6, 0xee, 0x24, 0xb2, 0xf1, 0xa9, 0x10, 0xf7,
+	0x32, 0xe8, 0x37, 0x06, 0x5c, 0x1a, 0x6a, 0x3e, 0x50, 0x56, 0xc5, 0xcf, 0xea, 0x63, 0xcc, 0x3b,
+	0xd3, 0x33, 0x68, 0x60, 0xd7, 0x24, 0xb0, 0x4d, 0x74, 0x25, 0x0d, 0x2c, 0xd5, 0xeb, 0x88, 0x4a,
+	0xa4, 0xa7, 0x98, 0xeb, 0x99, 0xf5, 0x2d, 0xd1, 0xd3, 0x98, 0x3b, 0x13, 0xa8, 0xc6, 0x57, 0x22,
+	0xd5, 0xca, 0xa0, 0x3f, 0x18, 0xb0, 0x36, 0xb2, 0x43, 0x41, 0xaf, 0x67, 0x65, 0xde, 0x98, 0xa6,
+	0xc7, 0x7c, 0xe3, 0xc5, 0x98, 0xc6, 0x7f, 0x2a, 0x54, 0x63, 0xc4, 0x07, 0x5c, 0xaa, 0x49, 0x3a,
+	0xfa, 0xf6, 0x67, 0xcf, 0xb6, 0x8c, 0xcf, 0x9f, 0x6d, 0x19, 0xff, 0x78, 0xb6, 0x65, 0x3c, 0x79,
+	0xbe, 0x35, 0xf3, 0xf9, 0xf3, 0xad, 0x99, 0xbf, 0x3e, 0xdf, 0x9a, 0xf9, 0xe1, 0xf5, 0x26, 0x69,
+	0xf9, 0x94, 0xdd, 0x76, 0x69, 0x44, 0x9c, 0x78, 0x2d, 0x1a, 0x38, 0xa7, 0x27, 0x85, 0xca, 0xc6,
+	0xbc, 0x3e, 0x2f, 0x07, 0x81, 0xd7, 0xff, 0x37, 0x00, 0x7c, 0xe8, 0xfa, 0xfa, 0x00, 0x1a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1747,6 +2097,10 @@ type QueryClient interface {
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
@@ -1868,26 +2222,44 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
-func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
-	out := new(QueryBaseFeeResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error) {
-	out := new(QueryGlobalMinGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/GlobalMinGasPrice", in, out, opts...)
+func (c *queryClient) SimulateV1(ctx context.Context, in *QuerySimulateV1Request, opts ...grpc.CallOption) (*QuerySimulateV1Response, error) {
+	out := new(QuerySimulateV1Response)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/SimulateV1", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error) {
-	out := new(QueryConfigResponse)
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error) {
+	out := new(QueryGlobalMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/GlobalMinGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error) {
+	out := new(QueryConfigResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/Config", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1930,6 +2302,10 @@ type QueryServer interface {
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *QuerySimulateV1Request) (*QuerySimulateV1Response, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
@@ -1981,6 +2357,12 @@ func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxR
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *QuerySimulateV1Request) (*QuerySimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -2196,6 +2578,42 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*QuerySimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -2268,6 +2686,7 @@ func _Query_TotalTransactionCount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2316,6 +2735,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2903,6 +3330,13 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -3216,6 +3650,229 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3705,6 +4362,10 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3834,18 +4495,122 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
+func (m *QueryTraceCallRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QuerySimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -5576,6 +6341,40 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6488,6 +7287,762 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateV1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateV1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
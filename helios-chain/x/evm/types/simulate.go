@@ -0,0 +1,120 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks simulated by a single eth_simulateV1 request
+	MaxSimulateBlocks = 256
+
+	// SimulateErrCodeReverted is the error code of the simulated calls which reverted
+	SimulateErrCodeReverted = 3
+	// SimulateErrCodeVMError is the error code of the simulated calls which failed in the EVM
+	SimulateErrCodeVMError = -32015
+)
+
+// SimulateOptions is the payload of eth_simulateV1.
+type SimulateOptions struct {
+	BlockStateCalls []SimulateBlock `json:"blockStateCalls"`
+	TraceTransfers  bool            `json:"traceTransfers"`
+	// Validation enables the nonce and base fee checks, the base fee of the simulated
+	// blocks defaults to zero when disabled.
+	Validation bool `json:"validation"`
+	// ReturnFullTransactions is accepted for compatibility, the simulated blocks only
+	// return the results of their calls.
+	ReturnFullTransactions bool `json:"returnFullTransactions"`
+}
+
+// SimulateBlock is a simulated block: the overrides applied at its beginning and
+// the calls executed in it.
+type SimulateBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides,omitempty"`
+	StateOverrides StateOverride     `json:"stateOverrides,omitempty"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides are the fields of the block context which can be overridden in a
+// simulated block.
+type BlockOverrides struct {
+	Number        *hexutil.Uint64 `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// SimulateCallResult is the result of a simulated call.
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*ethtypes.Log    `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *SimulateCallError `json:"error,omitempty"`
+}
+
+// SimulateCallError is the error of a failed simulated call.
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimulateBlockResult is a simulated block with the results of its calls.
+type SimulateBlockResult struct {
+	Number        hexutil.Uint64       `json:"number"`
+	Hash          common.Hash          `json:"hash"`
+	ParentHash    common.Hash          `json:"parentHash"`
+	Timestamp     hexutil.Uint64       `json:"timestamp"`
+	GasLimit      hexutil.Uint64       `json:"gasLimit"`
+	GasUsed       hexutil.Uint64       `json:"gasUsed"`
+	Miner         common.Address       `json:"miner"`
+	BaseFeePerGas *hexutil.Big         `json:"baseFeePerGas"`
+	Calls         []SimulateCallResult `json:"calls"`
+}
+
+// Validate checks the simulated blocks are in order and their overrides can be applied.
+func (opts SimulateOptions) Validate(baseNumber, baseTime uint64) error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks, got %d max %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+	if opts.TraceTransfers {
+		return errors.New("traceTransfers is not supported")
+	}
+
+	number, timestamp := baseNumber, baseTime
+	for i, block := range opts.BlockStateCalls {
+		if err := block.StateOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+		if block.BlockOverrides == nil {
+			number, timestamp = number+1, timestamp+1
+			continue
+		}
+		if block.BlockOverrides.Number != nil {
+			if uint64(*block.BlockOverrides.Number) <= number {
+				return fmt.Errorf("block %d: block numbers must be in order: %d <= %d", i, uint64(*block.BlockOverrides.Number), number)
+			}
+			number = uint64(*block.BlockOverrides.Number)
+		} else {
+			number++
+		}
+		if block.BlockOverrides.Time != nil {
+			if uint64(*block.BlockOverrides.Time) <= timestamp {
+				return fmt.Errorf("block %d: block timestamps must be in order: %d <= %d", i, uint64(*block.BlockOverrides.Time), timestamp)
+			}
+			timestamp = uint64(*block.BlockOverrides.Time)
+		} else {
+			timestamp++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateOptionsValidate(t *testing.T) {
+	number := func(n uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&n) }
+	state := map[common.Hash]common.Hash{}
+
+	testCases := []struct {
+		name    string
+		opts    SimulateOptions
+		expPass bool
+	}{
+		{"empty", SimulateOptions{}, false},
+		{"default blocks", SimulateOptions{BlockStateCalls: []SimulateBlock{{}, {}}}, true},
+		{"increasing numbers", SimulateOptions{BlockStateCalls: []SimulateBlock{
+			{BlockOverrides: &BlockOverrides{Number: number(11)}},
+			{},
+			{BlockOverrides: &BlockOverrides{Number: number(20), Time: number(1000)}},
+		}}, true},
+		{"number of base block", SimulateOptions{BlockStateCalls: []SimulateBlock{
+			{BlockOverrides: &BlockOverrides{Number: number(10)}},
+		}}, false},
+		{"decreasing numbers", SimulateOptions{BlockStateCalls: []SimulateBlock{
+			{BlockOverrides: &BlockOverrides{Number: number(15)}},
+			{BlockOverrides: &BlockOverrides{Number: number(12)}},
+		}}, false},
+		{"decreasing timestamps", SimulateOptions{BlockStateCalls: []SimulateBlock{
+			{BlockOverrides: &BlockOverrides{Time: number(1000)}},
+			{BlockOverrides: &BlockOverrides{Time: number(1000)}},
+		}}, false},
+		{"invalid state override", SimulateOptions{BlockStateCalls: []SimulateBlock{
+			{StateOverrides: StateOverride{common.HexToAddress("0x1000"): {State: &state, StateDiff: &state}}},
+		}}, false},
+		{"trace transfers", SimulateOptions{BlockStateCalls: []SimulateBlock{{}}, TraceTransfers: true}, false},
+		{"too many blocks", SimulateOptions{BlockStateCalls: make([]SimulateBlock, MaxSimulateBlocks+1)}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.opts.Validate(10, 100)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
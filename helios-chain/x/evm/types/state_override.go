@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate checks the overrides can be applied
+func (so StateOverride) Validate() error {
+	for addr, account := range so {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// GetNonce returns the overridden nonce of the account or else nonce
+func (so StateOverride) GetNonce(addr common.Address, nonce uint64) uint64 {
+	if account, found := so[addr]; found && account.Nonce != nil {
+		return uint64(*account.Nonce)
+	}
+	return nonce
+}
+
+// GetBalance returns the overridden balance of the account if any
+func (so StateOverride) GetBalance(addr common.Address) (*big.Int, bool) {
+	if account, found := so[addr]; found && account.Balance != nil && *account.Balance != nil {
+		return (*account.Balance).ToInt(), true
+	}
+	return nil, false
+}
+
+// ParseStateOverride decodes the JSON encoded overrides of an EthCallRequest,
+// empty bytes meaning no override.
+func ParseStateOverride(bz []byte) (StateOverride, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var overrides StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return nil, err
+	}
+	if err := overrides.Validate(); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
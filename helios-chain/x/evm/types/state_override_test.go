@@ -0,0 +1,40 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStateOverride(t *testing.T) {
+	addr := common.HexToAddress("0x1000")
+	testCases := []struct {
+		name    string
+		bz      string
+		expPass bool
+	}{
+		{"empty", "", true},
+		{"nonce and balance", `{"0x0000000000000000000000000000000000001000":{"nonce":"0x5","balance":"0x64"}}`, true},
+		{"state", `{"0x0000000000000000000000000000000000001000":{"state":{"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000000002"}}}`, true},
+		{"state and stateDiff", `{"0x0000000000000000000000000000000000001000":{"state":{},"stateDiff":{}}}`, false},
+		{"invalid json", `{"0x1000":`, false},
+	}
+
+	for _, tc := range testCases {
+		overrides, err := ParseStateOverride([]byte(tc.bz))
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		if tc.name == "nonce and balance" {
+			require.Equal(t, uint64(5), overrides.GetNonce(addr, 1))
+			balance, found := overrides.GetBalance(addr)
+			require.True(t, found)
+			require.Equal(t, big.NewInt(100), balance)
+		}
+		require.Equal(t, uint64(1), overrides.GetNonce(common.HexToAddress("0x2000"), 1))
+	}
+}
@@ -51,6 +51,7 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 			h.k.CleanAllBatchesAndTxs(ctx, counterpartyChainParams.HyperionId)
 			h.k.CleanPoolTransactions(ctx, counterpartyChainParams.HyperionId)
 			h.k.CleanAttestations(ctx, counterpartyChainParams.HyperionId)
+			h.k.CleanPendingClaimSlashings(ctx, counterpartyChainParams.HyperionId)
 			h.k.CleanBatchConfirms(ctx, counterpartyChainParams.HyperionId)
 			h.k.CleanLastEventByValidator(ctx, counterpartyChainParams.HyperionId) // clean last event by validator (it's last events nonce of each validators)
 		}
@@ -84,7 +85,9 @@ func (h *BlockHandler) createValsets(ctx sdk.Context, params *types.Counterparty
 	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) ||
 		(types.BridgeValidators(h.k.GetCurrentValset(ctx, params.HyperionId).Members).PowerDiff(latestValset.Members) > 0.05) {
 		// if the conditions are true, put in a new validator set request to be signed and submitted to Ethereum
-		h.k.SetValsetRequest(ctx, params.HyperionId, params.OffsetValsetNonce)
+		if _, err := h.k.SetValsetRequest(ctx, params.HyperionId, params.OffsetValsetNonce); err != nil {
+			h.k.Logger(ctx).Error("failed to create valset request", "error", err, "hyperion_id", params.HyperionId)
+		}
 	}
 }
 
@@ -120,6 +123,8 @@ func (h *BlockHandler) pruneAttestations(ctx sdk.Context, counterParty *types.Co
 			// we delete all attestations earlier than the current event nonce
 			if nonce < lastObservedEventNonce {
 				if att.Observed {
+					// keep the votes at this nonce, conflicting ones included, for the claim slashing
+					h.k.QueueClaimSlashing(ctx, att.HyperionId, nonce, attmap[nonce])
 					h.k.DeleteAttestation(ctx, att.HyperionId, att)
 					h.k.StoreNonceObserved(ctx, att.HyperionId, nonce, att.Height)
 
@@ -205,6 +210,7 @@ func (h *BlockHandler) slashing(ctx sdk.Context, params *types.CounterpartyChain
 	// Slash validator for not confirming valset requests, batch requests and not attesting claims rightfully
 	h.valsetSlashing(ctx, params)
 	h.batchSlashing(ctx, params)
+	h.claimSlashing(ctx, params)
 }
 
 // Iterate over all attestations currently being voted on in order of nonce and
@@ -558,6 +564,127 @@ func (h *BlockHandler) batchSlashing(ctx sdk.Context, params *types.Counterparty
 	}
 }
 
+// claimSlashing slashes the bonded orchestrators who didn't vote for the observed attestation of
+// an event nonce once the signed claims window has passed: the ones who didn't vote at all are
+// slashed and jailed, the ones who voted for a conflicting claim are slashed.
+func (h *BlockHandler) claimSlashing(ctx sdk.Context, params *types.CounterpartyChainParams) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
+	defer doneFn()
+
+	maxHeight := uint64(0)
+
+	// don't slash in the beginning before there aren't even SignedClaimsWindow blocks yet
+	if uint64(ctx.BlockHeight()) > params.SignedClaimsWindow {
+		maxHeight = uint64(ctx.BlockHeight()) - params.SignedClaimsWindow
+	} else {
+		// we can't slash anyone if this window has not yet passed
+		return
+	}
+
+	// nonces are iterated in ASC order, the iteration stops at the first nonce still in the window
+	nonces := make([]uint64, 0)
+	pendingAttestations := make(map[uint64][]*types.Attestation)
+	h.k.IteratePendingClaimSlashings(ctx, params.HyperionId, func(nonce uint64, attestations []*types.Attestation) bool {
+		if observed := types.GetObservedAttestation(attestations); observed != nil && observed.Height > maxHeight {
+			// the next nonces were observed later, the late votes can still be recorded
+			return true
+		}
+		nonces = append(nonces, nonce)
+		pendingAttestations[nonce] = attestations
+		return false
+	})
+
+	if len(nonces) == 0 {
+		return
+	}
+
+	currentBondedSet, err := h.k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		h.k.Logger(ctx).Error("failed to get bonded validators", "error", err, "hyperion_id", params.HyperionId)
+		return
+	}
+	// the bonded set is loaded once, the validators jailed along the way are tracked here
+	jailed := make(map[string]bool)
+
+	for _, nonce := range nonces {
+		attestations := pendingAttestations[nonce]
+
+		observed := types.GetObservedAttestation(attestations)
+		if observed == nil {
+			h.k.DeletePendingClaimSlashing(ctx, params.HyperionId, nonce)
+			continue
+		}
+
+		for i := range currentBondedSet {
+			if currentBondedSet[i].IsJailed() || jailed[currentBondedSet[i].GetOperator()] {
+				continue
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(currentBondedSet[i].GetOperator())
+			if err != nil {
+				continue
+			}
+			if _, exists := h.k.GetEthAddressByValidator(ctx, params.HyperionId, valAddr); !exists {
+				// if the validator has no eth address, it means that the validator is not an orchestrator
+				// so we can skip the validator
+				continue
+			}
+
+			// Don't slash validators who joined after the claim was made
+			consAddr, _ := currentBondedSet[i].GetConsAddr()
+			valSigningInfo, err := h.k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			if exist := err == nil; !exist || valSigningInfo.StartHeight > int64(observed.Height) {
+				continue
+			}
+
+			var (
+				reason        string
+				slashFraction math.LegacyDec
+			)
+			switch types.GetClaimFault(attestations, valAddr.String()) {
+			case types.ClaimFaultMissing:
+				reason, slashFraction = "missing_claim", params.SlashFractionClaim
+			case types.ClaimFaultConflicting:
+				reason, slashFraction = "conflicting_claim", params.SlashFractionConflictingClaim
+			default:
+				continue
+			}
+
+			consPower := currentBondedSet[i].ConsensusPower(h.k.StakingKeeper.PowerReduction(ctx))
+			slashAmount, err := h.k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), consPower, slashFraction)
+			if err != nil {
+				h.k.Logger(ctx).Error("failed to slash validator", "error", err, "validator", valAddr.String(), "reason", reason)
+				continue
+			}
+			if reason == "missing_claim" {
+				if err := h.k.StakingKeeper.Jail(ctx, consAddr); err != nil {
+					h.k.Logger(ctx).Error("failed to jail validator", "error", err, "validator", valAddr.String())
+				} else {
+					jailed[currentBondedSet[i].GetOperator()] = true
+				}
+			}
+
+			// nolint:errcheck //ignored on purpose
+			ctx.EventManager().EmitTypedEvent(&types.EventValidatorSlash{
+				HyperionId:       params.HyperionId,
+				Power:            consPower,
+				Reason:           reason,
+				ConsensusAddress: sdk.ConsAddress(consAddr).String(),
+				OperatorAddress:  currentBondedSet[i].OperatorAddress,
+				Moniker:          currentBondedSet[i].GetMoniker(),
+			})
+
+			// nolint:errcheck //ignored on purpose
+			h.k.AddSlashData(ctx, sdk.AccAddress(valAddr), params.HyperionId, types.SlashData{
+				SlashTimestamp: uint64(ctx.BlockTime().Unix()),
+				SlashAmount:    slashAmount,
+			})
+		}
+
+		h.k.DeletePendingClaimSlashing(ctx, params.HyperionId, nonce)
+	}
+}
+
 func (h *BlockHandler) pruneValsets(ctx sdk.Context, params *types.CounterpartyChainParams) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
 	defer doneFn()
@@ -632,7 +759,7 @@ func (h *BlockHandler) executeExternalDataTxs(ctx sdk.Context, counterParty *typ
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
 	defer doneFn()
 
-	if counterParty.BridgeChainType != "evm" {
+	if counterParty.BridgeChainType != types.ChainTypeEVM {
 		return
 	}
 
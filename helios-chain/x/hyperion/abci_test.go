@@ -0,0 +1,201 @@
+package hyperion_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/hyperion"
+	"helios-core/helios-chain/x/hyperion/testhyperion"
+	"helios-core/helios-chain/x/hyperion/types"
+)
+
+type claimSlashingTest struct {
+	t       *testing.T
+	input   testhyperion.TestInput
+	handler *hyperion.BlockHandler
+	ctx     sdk.Context
+}
+
+func newClaimSlashingTest(t *testing.T) *claimSlashingTest {
+	input, ctx := testhyperion.SetupFiveValChain(t)
+	k := &input.HyperionKeeper
+	hyperionId := testhyperion.TestingHyperionEthereumParams.HyperionId
+	for i, val := range testhyperion.ValAddrs {
+		k.SetEthAddressForValidator(ctx, hyperionId, val, testhyperion.EthAddrs[i])
+		k.SetOrchestratorValidator(ctx, hyperionId, val, testhyperion.AccAddrs[i])
+	}
+
+	// only the claim slashing is tested, the valsets aren't confirmed
+	params := k.GetParams(ctx)
+	params.CounterpartyChainParams[0].SignedValsetsWindow = 1000
+	params.CounterpartyChainParams[0].UnbondSlashingValsetsWindow = 1000
+	k.SetParams(ctx, params)
+
+	s := &claimSlashingTest{t: t, input: input, handler: hyperion.NewBlockHandler(*k), ctx: ctx}
+	// the first end blocker resets the state of the counterparty chain
+	s.endBlock()
+	return s
+}
+
+// nextBlock moves the context to the next block
+func (s *claimSlashingTest) nextBlock() {
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+}
+
+// endBlock runs the end blocker and returns the slashing reasons by validator
+func (s *claimSlashingTest) endBlock() map[string][]string {
+	s.handler.EndBlocker(s.ctx)
+
+	slashes := make(map[string][]string)
+	for _, event := range s.ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		if slash, ok := msg.(*types.EventValidatorSlash); ok {
+			slashes[slash.OperatorAddress] = append(slashes[slash.OperatorAddress], slash.Reason)
+		}
+	}
+	return slashes
+}
+
+// attest submits the claim of the validator i for the event nonce
+func (s *claimSlashingTest) attest(i int, nonce uint64, ethHeight uint64) error {
+	claim := &types.MsgValsetUpdatedClaim{
+		HyperionId:   testhyperion.TestingHyperionEthereumParams.HyperionId,
+		EventNonce:   nonce,
+		ValsetNonce:  nonce,
+		BlockHeight:  ethHeight,
+		RewardAmount: math.ZeroInt(),
+		RewardToken:  "0x0000000000000000000000000000000000000000",
+		Orchestrator: testhyperion.AccAddrs[i].String(),
+	}
+	anyClaim, err := codectypes.NewAnyWithValue(claim)
+	require.NoError(s.t, err)
+	_, err = s.input.HyperionKeeper.Attest(s.ctx, claim, anyClaim)
+	return err
+}
+
+// attestOnTime submits the claims of the first four validators, enough for the claim to be observed
+func (s *claimSlashingTest) attestOnTime(nonce uint64, ethHeight uint64) {
+	for i := 0; i < 4; i++ {
+		require.NoError(s.t, s.attest(i, nonce, ethHeight))
+	}
+}
+
+func TestClaimSlashing(t *testing.T) {
+	s := newClaimSlashingTest(t)
+	k := &s.input.HyperionKeeper
+	hyperionId := testhyperion.TestingHyperionEthereumParams.HyperionId
+	late := testhyperion.ValAddrs[4]
+
+	// nonce 1: the validator votes once the nonce is observed, before the attestations are pruned
+	s.nextBlock()
+	s.attestOnTime(1, 100)
+	require.Empty(t, s.endBlock())
+	require.Equal(t, uint64(1), k.GetLastObservedEventNonce(s.ctx, hyperionId))
+
+	s.nextBlock()
+	require.NoError(t, s.attest(4, 1, 100))
+	require.ErrorIs(t, s.attest(4, 1, 100), types.ErrAttestationAlreadyVoted)
+	require.Equal(t, uint64(1), k.GetLastEventByValidatorAndHyperionId(s.ctx, hyperionId, late).EthereumEventNonce)
+
+	// nonce 2: the validator votes once the attestations are pruned for the claim slashing
+	s.attestOnTime(2, 101)
+	require.Empty(t, s.endBlock())
+
+	// nonce 3: the validator votes for a conflicting claim
+	s.nextBlock()
+	require.NoError(t, s.attest(4, 3, 999))
+	s.attestOnTime(3, 102)
+	require.Empty(t, s.endBlock())
+	require.Empty(t, k.GetPendingClaimSlashing(s.ctx, hyperionId, 3))
+	require.NotEmpty(t, k.GetPendingClaimSlashing(s.ctx, hyperionId, 2))
+
+	s.nextBlock()
+	require.NoError(t, s.attest(4, 2, 101))
+	// once observed, the conflicting voters can't vote for the observed claim and
+	// conflicting claims are rejected
+	require.ErrorIs(t, s.attest(4, 3, 102), types.ErrAttestationAlreadyVoted)
+	require.ErrorIs(t, s.attest(4, 2, 998), types.ErrAttestationAlreadyObserved)
+
+	// nonce 4: the validator doesn't vote
+	s.attestOnTime(4, 103)
+	require.Empty(t, s.endBlock())
+
+	// nonce 5 is observed so that the nonce 4 is pruned for the claim slashing
+	s.nextBlock()
+	for i := range testhyperion.ValAddrs {
+		require.NoError(t, s.attest(i, 5, 104))
+	}
+	require.Empty(t, s.endBlock())
+
+	// the nonces are slashed once the signed claims window has passed, in order
+	window := int64(testhyperion.TestingHyperionEthereumParams.SignedClaimsWindow)
+	expSlashes := map[uint64][]string{3: {"conflicting_claim"}, 4: {"missing_claim"}}
+	firstNonceHeight := s.ctx.BlockHeight() - 4
+	for nonce := uint64(1); nonce <= 4; nonce++ {
+		for s.ctx.BlockHeight() < firstNonceHeight+int64(nonce-1)+window-1 {
+			s.nextBlock()
+			require.Empty(t, s.endBlock())
+		}
+		require.NotEmpty(t, k.GetPendingClaimSlashing(s.ctx, hyperionId, nonce))
+
+		s.nextBlock()
+		slashes := s.endBlock()
+		require.Empty(t, k.GetPendingClaimSlashing(s.ctx, hyperionId, nonce))
+		if exp, found := expSlashes[nonce]; found {
+			require.Equal(t, map[string][]string{late.String(): exp}, slashes, "nonce %d", nonce)
+		} else {
+			require.Empty(t, slashes, "nonce %d", nonce)
+		}
+	}
+
+	// only the missing voter is jailed
+	validator, err := s.input.StakingKeeper.GetValidator(s.ctx, late)
+	require.NoError(t, err)
+	require.True(t, validator.IsJailed())
+	for _, val := range testhyperion.ValAddrs[:4] {
+		validator, err := s.input.StakingKeeper.GetValidator(s.ctx, val)
+		require.NoError(t, err)
+		require.False(t, validator.IsJailed())
+	}
+
+	// the late votes aren't recorded once the claim slashing is done
+	require.ErrorIs(t, s.attest(4, 4, 103), types.ErrAttestationAlreadyObserved)
+}
+
+func TestClaimSlashingWindowBound(t *testing.T) {
+	s := newClaimSlashingTest(t)
+	k := &s.input.HyperionKeeper
+	hyperionId := testhyperion.TestingHyperionEthereumParams.HyperionId
+	window := int64(testhyperion.TestingHyperionEthereumParams.SignedClaimsWindow)
+
+	s.nextBlock()
+	s.attestOnTime(1, 100)
+	s.endBlock()
+	observedHeight := s.ctx.BlockHeight()
+
+	// the attestations aren't pruned until the next nonce is observed, the late votes
+	// are recorded until the end of the window
+	for s.ctx.BlockHeight() < observedHeight+window {
+		s.nextBlock()
+		s.endBlock()
+	}
+	s.nextBlock()
+	require.ErrorIs(t, s.attest(4, 1, 100), types.ErrAttestationAlreadyObserved)
+
+	// the nonces observed within the window stay in the claim slashing queue
+	s.attestOnTime(2, 101)
+	s.endBlock()
+	s.nextBlock()
+	s.attestOnTime(3, 102)
+	require.Equal(t, map[string][]string{testhyperion.ValAddrs[4].String(): {"missing_claim"}}, s.endBlock())
+	require.Empty(t, k.GetPendingClaimSlashing(s.ctx, hyperionId, 1))
+	require.NotEmpty(t, k.GetPendingClaimSlashing(s.ctx, hyperionId, 2))
+}
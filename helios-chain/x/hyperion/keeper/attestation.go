@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/Helios-Chain-Labs/metrics"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -69,6 +71,9 @@ func (k *Keeper) Attest(ctx sdk.Context, claim types.EthereumClaim, anyClaim *co
 
 	// Tries to get an attestation with the same eventNonce and claim as the claim that was submitted.
 	att := k.GetAttestation(ctx, claim.GetHyperionId(), claim.GetEventNonce(), claim.ClaimHash())
+	if (att != nil && att.Observed) || k.NonceAlreadyObserved(ctx, claim.GetHyperionId(), claim.GetEventNonce()) {
+		return k.attestLate(ctx, valAddr, claim)
+	}
 	isNewAttestation := false
 
 	// If it does not exist, create a new one.
@@ -82,14 +87,6 @@ func (k *Keeper) Attest(ctx sdk.Context, claim types.EthereumClaim, anyClaim *co
 		isNewAttestation = true
 	}
 
-	if att.Observed {
-		return nil, errors.Wrap(types.ErrAttestationAlreadyObserved, "Attestation already Observed")
-	}
-
-	if k.NonceAlreadyObserved(ctx, claim.GetHyperionId(), claim.GetEventNonce()) {
-		return nil, errors.Wrap(types.ErrAttestationAlreadyObserved, "Attestation already Observed")
-	}
-
 	if ctx.BlockHeight() > testnet.TESTNET_BLOCK_NUMBER_UPDATE_0 && att.ContainsVote(valAddr.String()) {
 		return nil, errors.Wrap(types.ErrAttestationAlreadyVoted, "Attestation already voted")
 	}
@@ -122,6 +119,80 @@ func (k *Keeper) Attest(ctx sdk.Context, claim types.EthereumClaim, anyClaim *co
 	return att, nil
 }
 
+// attestLate records the vote of a validator for the observed claim of an event nonce until the
+// signed claims window has passed, so that the validators attesting after the nonce was observed
+// aren't slashed as missing by the claim slashing. The claims conflicting with the observed one
+// are rejected.
+func (k *Keeper) attestLate(ctx sdk.Context, valAddr sdk.ValAddress, claim types.EthereumClaim) (*types.Attestation, error) {
+	hyperionId, nonce := claim.GetHyperionId(), claim.GetEventNonce()
+
+	// the attestations of a nonce are moved to the claim slashing queue once the next nonce is observed
+	attestations, pending := k.getAttestationsByNonce(ctx, hyperionId, nonce), false
+	if len(attestations) == 0 {
+		attestations, pending = k.GetPendingClaimSlashing(ctx, hyperionId, nonce), true
+	}
+
+	observed := types.GetObservedAttestation(attestations)
+	if observed == nil {
+		return nil, errors.Wrap(types.ErrAttestationAlreadyObserved, "Attestation already Observed")
+	}
+	observedClaim, err := k.UnpackAttestationClaim(observed)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+	if !bytes.Equal(observedClaim.ClaimHash(), claim.ClaimHash()) {
+		return nil, errors.Wrap(types.ErrAttestationAlreadyObserved, "a conflicting attestation was observed")
+	}
+
+	params, found := k.GetCounterpartyChainParams(ctx)[hyperionId]
+	if !found || uint64(ctx.BlockHeight()) > observed.Height+params.SignedClaimsWindow {
+		return nil, errors.Wrap(types.ErrAttestationAlreadyObserved, "Attestation already Observed and signed claims window passed")
+	}
+
+	for _, att := range attestations {
+		if att.ContainsVote(valAddr.String()) {
+			return nil, errors.Wrap(types.ErrAttestationAlreadyVoted, "Attestation already voted")
+		}
+	}
+
+	observed.Votes = append(observed.Votes, valAddr.String()+":"+fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())))
+	observed.RpcsUsed = append(observed.RpcsUsed, claim.GetRpcUsed())
+	if pending {
+		k.setPendingClaimSlashing(ctx, hyperionId, nonce, claim.ClaimHash(), observed)
+	} else {
+		k.SetAttestation(ctx, hyperionId, nonce, claim.ClaimHash(), observed)
+	}
+
+	if lastEvent := k.GetLastEventByValidatorAndHyperionId(ctx, hyperionId, valAddr); lastEvent.EthereumEventNonce < nonce {
+		k.setLastEventByValidatorAndHyperionId(ctx, hyperionId, valAddr, nonce, claim.GetBlockHeight())
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventAttestationVote{
+		EventNonce:    nonce,
+		AttestationId: types.GetAttestationKeyWithHash(nonce, claim.ClaimHash()),
+		Voter:         valAddr.String(),
+	})
+
+	return observed, nil
+}
+
+// getAttestationsByNonce returns the attestations of an event nonce which aren't pruned yet
+func (k *Keeper) getAttestationsByNonce(ctx sdk.Context, hyperionId uint64, nonce uint64) []*types.Attestation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.OracleAttestationKey, sdk.Uint64ToBigEndian(hyperionId)...))
+	iter := storetypes.KVStorePrefixIterator(store, sdk.Uint64ToBigEndian(nonce))
+	defer iter.Close()
+
+	attestations := make([]*types.Attestation, 0)
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &att)
+		attestations = append(attestations, &att)
+	}
+	return attestations
+}
+
 func emitNewClaimEvent(ctx sdk.Context, claim types.EthereumClaim, attestationId []byte) {
 	switch claim := claim.(type) {
 	case *types.MsgDepositClaim:
@@ -45,7 +45,12 @@ func (a AttestationHandler) Handle(ctx sdk.Context, claim types.EthereumClaim, a
 	case *types.MsgDepositClaim:
 		invalidAddress := false
 
-		ethereumSender, errEthereumSender := types.NewEthAddress(claim.EthereumSender)
+		chainType, err := a.keeper.GetChainType(ctx, claim.HyperionId)
+		if err != nil {
+			metrics.ReportFuncError(a.svcTags)
+			return err
+		}
+		ethereumSender, errEthereumSender := chainType.DecodeAddress(claim.EthereumSender)
 		// likewise nil sender would have to be caused by a bogus event
 		if errEthereumSender != nil {
 			metrics.ReportFuncError(a.svcTags)
@@ -60,7 +65,7 @@ func (a AttestationHandler) Handle(ctx sdk.Context, claim types.EthereumClaim, a
 
 		// Block blacklisted asset transfers
 		// (these funds are unrecoverable for the blacklisted sender, they will instead be sent to community pool)
-		if a.keeper.IsOnBlacklist(ctx, *ethereumSender) {
+		if a.keeper.IsOnBlacklist(ctx, ethereumSender) {
 			metrics.ReportFuncError(a.svcTags)
 			invalidAddress = true
 		}
@@ -142,6 +147,17 @@ func (a AttestationHandler) Handle(ctx sdk.Context, claim types.EthereumClaim, a
 				return errors.Wrap(err, "failed to send to Community pool")
 			}
 		}
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventDepositReceived{
+			HyperionId:          claim.HyperionId,
+			EventNonce:          claim.EventNonce,
+			EthereumSender:      claim.EthereumSender,
+			CosmosReceiver:      claim.CosmosReceiver,
+			TokenContract:       claim.TokenContract,
+			Amount:              coins[0],
+			SentToCommunityPool: invalidAddress,
+		})
 		// withdraw in this context means a withdraw from the Ethereum side of the bridge
 	case *types.MsgWithdrawClaim:
 		tokenContract := common.HexToAddress(claim.TokenContract)
@@ -33,6 +33,13 @@ func (k *Keeper) BuildOutgoingTXBatch(ctx sdk.Context, tokenContract common.Addr
 		return nil, errors.Wrap(types.ErrInvalid, "max elements value")
 	}
 
+	// the checkpoint of the batch must be known to tell the honest signatures from the bad ones
+	chainType, err := k.GetChainType(ctx, hyperionId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, hyperionId, tokenContract)
 
 	// lastBatch may be nil if there are no existing batches, we only need
@@ -70,8 +77,7 @@ func (k *Keeper) BuildOutgoingTXBatch(ctx sdk.Context, tokenContract common.Addr
 	k.StoreBatch(ctx, batch)
 
 	// Get the checkpoint and store it as a legit past batch
-	checkpoint := batch.GetCheckpoint(hyperionId)
-	k.SetPastEthSignatureCheckpoint(ctx, hyperionId, checkpoint)
+	k.SetPastEthSignatureCheckpoint(ctx, hyperionId, chainType.BatchCheckpoint(*batch, hyperionId))
 
 	return batch, nil
 }
@@ -85,6 +91,13 @@ func (k *Keeper) BuildOutgoingTXBatchWithIds(ctx sdk.Context, tokenContract comm
 		return nil, errors.Wrap(types.ErrInvalid, "max elements value")
 	}
 
+	// the checkpoint of the batch must be known to tell the honest signatures from the bad ones
+	chainType, err := k.GetChainType(ctx, hyperionId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	selectedTx, err := k.pickUnbatchedTXWithIds(ctx, tokenContract, maxElements, hyperionId, ids)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
@@ -102,8 +115,7 @@ func (k *Keeper) BuildOutgoingTXBatchWithIds(ctx sdk.Context, tokenContract comm
 	k.StoreBatch(ctx, batch)
 
 	// Get the checkpoint and store it as a legit past batch
-	checkpoint := batch.GetCheckpoint(hyperionId)
-	k.SetPastEthSignatureCheckpoint(ctx, hyperionId, checkpoint)
+	k.SetPastEthSignatureCheckpoint(ctx, hyperionId, chainType.BatchCheckpoint(*batch, hyperionId))
 
 	return batch, nil
 }
@@ -172,6 +184,20 @@ func (k *Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract common.A
 		panic(fmt.Sprintf("Failed to send fees to orchestrator %s", claim.Orchestrator))
 	}
 
+	batchTxIDs := make([]uint64, 0, len(b.Transactions))
+	for _, tx := range b.Transactions {
+		batchTxIDs = append(batchTxIDs, tx.Id)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventOutgoingBatchExecuted{
+		HyperionId:          hyperionId,
+		TokenContract:       b.TokenContract,
+		BatchNonce:          b.BatchNonce,
+		BatchTxIds:          batchTxIDs,
+		OrchestratorAddress: claim.Orchestrator,
+	})
+
 	for _, tx := range b.Transactions {
 		tokenAddressToDenom, _ := k.GetTokenFromAddress(ctx, tx.HyperionId, common.HexToAddress(tx.Token.Contract))
 
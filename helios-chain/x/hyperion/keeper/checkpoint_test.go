@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/hyperion/testhyperion"
+)
+
+// setBridgeChainType bypasses the params validation to store an unknown chain type
+func setBridgeChainType(input testhyperion.TestInput, chainType string) {
+	params := input.HyperionKeeper.GetParams(input.Context)
+	params.CounterpartyChainParams[0].BridgeChainType = chainType
+	input.HyperionKeeper.SetParams(input.Context, params)
+}
+
+func TestValsetRequestStoresCheckpoint(t *testing.T) {
+	input, ctx := testhyperion.SetupFiveValChain(t)
+	k := &input.HyperionKeeper
+	hyperionId := testhyperion.TestingHyperionEthereumParams.HyperionId
+	for i, addr := range testhyperion.ValAddrs {
+		k.SetEthAddressForValidator(ctx, hyperionId, addr, testhyperion.EthAddrs[i])
+	}
+
+	valset, err := k.SetValsetRequest(ctx, hyperionId, 0)
+	require.NoError(t, err)
+	require.NotNil(t, valset)
+	chainType, err := k.GetChainType(ctx, hyperionId)
+	require.NoError(t, err)
+	require.True(t, k.GetPastEthSignatureCheckpoint(ctx, hyperionId, chainType.ValsetCheckpoint(*valset, hyperionId)))
+
+	// a valset whose checkpoint can't be computed is never requested
+	setBridgeChainType(input, "unknown")
+	nonce := k.GetLatestValsetNonce(ctx, hyperionId)
+	_, err = k.SetValsetRequest(ctx.WithBlockHeight(ctx.BlockHeight()+1), hyperionId, 0)
+	require.Error(t, err)
+	require.Equal(t, nonce, k.GetLatestValsetNonce(ctx, hyperionId))
+}
+
+func TestBuildBatchRequiresChainType(t *testing.T) {
+	input := testhyperion.CreateTestEnv(t)
+	ctx := input.Context
+	k := &input.HyperionKeeper
+	hyperionId := testhyperion.TestingHyperionEthereumParams.HyperionId
+	token := common.HexToAddress(testhyperion.TokenContractAddrs[0])
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+
+	setBridgeChainType(input, "unknown")
+
+	_, err := k.BuildOutgoingTXBatch(ctx, token, hyperionId, 10, fee, fee)
+	require.ErrorContains(t, err, "unknown bridge chain type")
+	_, err = k.BuildOutgoingTXBatchWithIds(ctx, token, hyperionId, 10, fee, fee, []uint64{1})
+	require.ErrorContains(t, err, "unknown bridge chain type")
+	require.Nil(t, k.GetLastOutgoingBatchByTokenType(ctx, hyperionId, token))
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"helios-core/helios-chain/x/hyperion/types"
+)
+
+// QueueClaimSlashing keeps the attestations of an observed event nonce when they are pruned,
+// the late votes for the observed claim are recorded in the queue until the signed claims
+// window has passed and the claim slashing checks the votes.
+func (k *Keeper) QueueClaimSlashing(ctx sdk.Context, hyperionId uint64, nonce uint64, attestations []*types.Attestation) {
+	for _, att := range attestations {
+		claim, err := k.UnpackAttestationClaim(att)
+		if err != nil {
+			k.Logger(ctx).Error("failed to unpack attestation claim", "error", err, "hyperion_id", hyperionId, "nonce", nonce)
+			continue
+		}
+		k.setPendingClaimSlashing(ctx, hyperionId, nonce, claim.ClaimHash(), att)
+	}
+}
+
+func (k *Keeper) setPendingClaimSlashing(ctx sdk.Context, hyperionId uint64, nonce uint64, claimHash []byte, att *types.Attestation) {
+	ctx.KVStore(k.storeKey).Set(types.GetPendingClaimSlashingKey(hyperionId, nonce, claimHash), k.cdc.MustMarshal(att))
+}
+
+// GetPendingClaimSlashing returns the attestations of an event nonce waiting for the claim slashing
+func (k *Keeper) GetPendingClaimSlashing(ctx sdk.Context, hyperionId uint64, nonce uint64) []*types.Attestation {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetPendingClaimSlashingNoncePrefix(hyperionId, nonce))
+	defer iter.Close()
+
+	attestations := make([]*types.Attestation, 0)
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &att)
+		attestations = append(attestations, &att)
+	}
+	return attestations
+}
+
+// IteratePendingClaimSlashings iterates the attestations waiting for the claim slashing grouped by
+// event nonce in ASC order, cb returns true to stop before the attestations of the next nonces are loaded.
+func (k *Keeper) IteratePendingClaimSlashings(ctx sdk.Context, hyperionId uint64, cb func(nonce uint64, attestations []*types.Attestation) (stop bool)) {
+	prefixKey := types.GetPendingClaimSlashingPrefix(hyperionId)
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefixKey)
+	defer iter.Close()
+
+	var (
+		nonce        uint64
+		attestations []*types.Attestation
+	)
+	for ; iter.Valid(); iter.Next() {
+		// [nonce][claimHash]
+		keyNonce := types.UInt64FromBytes(iter.Key()[len(prefixKey) : len(prefixKey)+8])
+		if len(attestations) > 0 && keyNonce != nonce {
+			if cb(nonce, attestations) {
+				return
+			}
+			attestations = nil
+		}
+		nonce = keyNonce
+
+		var att types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &att)
+		attestations = append(attestations, &att)
+	}
+	if len(attestations) > 0 {
+		cb(nonce, attestations)
+	}
+}
+
+// DeletePendingClaimSlashing deletes the attestations of an event nonce once the claim slashing is done
+func (k *Keeper) DeletePendingClaimSlashing(ctx sdk.Context, hyperionId uint64, nonce uint64) {
+	k.deletePrefix(ctx, types.GetPendingClaimSlashingNoncePrefix(hyperionId, nonce))
+}
+
+// CleanPendingClaimSlashings deletes all the attestations waiting for the claim slashing
+func (k *Keeper) CleanPendingClaimSlashings(ctx sdk.Context, hyperionId uint64) {
+	k.deletePrefix(ctx, types.GetPendingClaimSlashingPrefix(hyperionId))
+}
+
+func (k *Keeper) deletePrefix(ctx sdk.Context, prefixKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, prefixKey)
+	defer iter.Close()
+
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"helios-core/helios-chain/x/hyperion/types"
+)
+
+// GetCounterpartyHeader returns the header of the counterparty chain of hyperionId accepted at height
+func (k *Keeper) GetCounterpartyHeader(ctx sdk.Context, hyperionId uint64, height uint64) (*types.CounterpartyHeader, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCounterpartyHeaderKey(hyperionId, height))
+	if bz == nil {
+		return nil, false
+	}
+	var header types.CounterpartyHeader
+	k.cdc.MustUnmarshal(bz, &header)
+	return &header, true
+}
+
+// GetLatestCounterpartyHeader returns the highest header of the counterparty chain of hyperionId accepted
+func (k *Keeper) GetLatestCounterpartyHeader(ctx sdk.Context, hyperionId uint64) (*types.CounterpartyHeader, bool) {
+	iter := storetypes.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.GetCounterpartyHeaderPrefix(hyperionId))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, false
+	}
+	var header types.CounterpartyHeader
+	k.cdc.MustUnmarshal(iter.Value(), &header)
+	return &header, true
+}
+
+func (k *Keeper) setCounterpartyHeader(ctx sdk.Context, hyperionId uint64, header types.CounterpartyHeader) {
+	ctx.KVStore(k.storeKey).Set(types.GetCounterpartyHeaderKey(hyperionId, header.Height), k.cdc.MustMarshal(&header))
+}
+
+// VoteCounterpartyHeader records the vote of the validator for the header, the header is accepted
+// in the header store once the validators who voted for it reach 66% of the valset power.
+func (k *Keeper) VoteCounterpartyHeader(ctx sdk.Context, hyperionId uint64, validator sdk.ValAddress, header *ethtypes.Header) error {
+	height := header.Number.Uint64()
+	hash := header.Hash()
+
+	if accepted, found := k.GetCounterpartyHeader(ctx, hyperionId, height); found {
+		if common.HexToHash(accepted.Hash) != hash {
+			return errors.Wrapf(types.ErrInvalid, "header %s conflicts with the header %s accepted at height %d", hash.Hex(), accepted.Hash, height)
+		}
+		return nil
+	}
+
+	if k.hasVotedCounterpartyHeader(ctx, hyperionId, height, validator) {
+		return errors.Wrapf(types.ErrDuplicate, "validator already voted for a header at height %d", height)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetCounterpartyHeaderVoteKey(hyperionId, height, hash, validator), []byte{0x1})
+
+	totalPower := k.GetCurrentValsetTotalPower(ctx, hyperionId)
+	requiredPower := k.GetRequiredPower(totalPower, 66)
+	if k.counterpartyHeaderVotesPower(ctx, hyperionId, height, hash).LT(requiredPower) {
+		return nil
+	}
+
+	counterpartyHeader := types.NewCounterpartyHeader(header)
+	k.setCounterpartyHeader(ctx, hyperionId, counterpartyHeader)
+	k.pruneCounterpartyHeaderVotes(ctx, hyperionId, height)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCounterpartyHeaderAccepted{
+		HyperionId: hyperionId,
+		Height:     counterpartyHeader.Height,
+		Hash:       counterpartyHeader.Hash,
+	})
+	return nil
+}
+
+// hasVotedCounterpartyHeader returns true if the validator voted for any header at height
+func (k *Keeper) hasVotedCounterpartyHeader(ctx sdk.Context, hyperionId uint64, height uint64, validator sdk.ValAddress) bool {
+	heightPrefix := types.GetCounterpartyHeaderHeightVotesPrefix(hyperionId, height)
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), heightPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// [hash][validator]
+		if sdk.ValAddress(iter.Key()[len(heightPrefix)+common.HashLength:]).Equals(validator) {
+			return true
+		}
+	}
+	return false
+}
+
+// counterpartyHeaderVotesPower sums the current power of the validators who voted for the header
+func (k *Keeper) counterpartyHeaderVotesPower(ctx sdk.Context, hyperionId uint64, height uint64, hash common.Hash) math.Int {
+	prefixKey := types.GetCounterpartyHeaderVotesPrefix(hyperionId, height, hash)
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefixKey)
+	defer iter.Close()
+
+	power := math.ZeroInt()
+	for ; iter.Valid(); iter.Next() {
+		validatorPower, err := k.StakingKeeper.GetLastValidatorPower(ctx, sdk.ValAddress(iter.Key()[len(prefixKey):]))
+		if err != nil {
+			continue
+		}
+		power = power.Add(math.NewInt(validatorPower))
+	}
+	return power
+}
+
+// pruneCounterpartyHeaderVotes deletes the votes for the headers up to height once a header is accepted
+func (k *Keeper) pruneCounterpartyHeaderVotes(ctx sdk.Context, hyperionId uint64, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixKey := types.GetCounterpartyHeaderVotePrefix(hyperionId)
+	iter := store.Iterator(prefixKey, types.GetCounterpartyHeaderHeightVotesPrefix(hyperionId, height+1))
+	defer iter.Close()
+
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// verifyClaimReceiptProof checks the receipt proof of the claim when the counterparty chain
+// requires them, the event log claimed must be in a block of the header store.
+func (k *Keeper) verifyClaimReceiptProof(ctx sdk.Context, claim types.ReceiptProvenClaim) error {
+	counterpartyChainParams, found := k.GetCounterpartyChainParams(ctx)[claim.GetHyperionId()]
+	if !found || !counterpartyChainParams.RequiresReceiptProof() {
+		return nil
+	}
+
+	proof := claim.GetReceiptProof()
+	if proof == nil {
+		return errors.Wrap(types.ErrInvalidReceiptProof, "receipt proof required")
+	}
+	header, found := k.GetCounterpartyHeader(ctx, claim.GetHyperionId(), claim.GetBlockHeight())
+	if !found {
+		return errors.Wrapf(types.ErrInvalidReceiptProof, "no counterparty header at height %d", claim.GetBlockHeight())
+	}
+
+	log, err := proof.VerifyLog(common.HexToHash(header.ReceiptsRoot))
+	if err != nil {
+		return err
+	}
+	return claim.VerifyLog(log, common.HexToAddress(counterpartyChainParams.BridgeCounterpartyAddress))
+}
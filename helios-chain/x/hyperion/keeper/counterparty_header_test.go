@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/hyperion/keeper"
+	"helios-core/helios-chain/x/hyperion/testhyperion"
+	"helios-core/helios-chain/x/hyperion/types"
+)
+
+// setupHeaderValset registers the eth addresses of the five validators for the test
+// counterparty chain so that they all count in its valset power
+func setupHeaderValset(t *testing.T) (testhyperion.TestInput, uint64) {
+	input, ctx := testhyperion.SetupFiveValChain(t)
+	hyperionId := testhyperion.TestingHyperionEthereumParams.HyperionId
+	for i, val := range testhyperion.ValAddrs {
+		input.HyperionKeeper.SetEthAddressForValidator(ctx, hyperionId, val, testhyperion.EthAddrs[i])
+	}
+	return input, hyperionId
+}
+
+func testCounterpartyHeader(height int64, receiptsRoot string) *ethtypes.Header {
+	return &ethtypes.Header{
+		Number:      big.NewInt(height),
+		ParentHash:  common.HexToHash("0x01"),
+		ReceiptHash: common.HexToHash(receiptsRoot),
+		Difficulty:  big.NewInt(0),
+		Time:        uint64(height) * 12,
+	}
+}
+
+func TestVoteCounterpartyHeaderQuorum(t *testing.T) {
+	input, hyperionId := setupHeaderValset(t)
+	ctx := input.Context
+	k := &input.HyperionKeeper
+	header := testCounterpartyHeader(100, "0xaa")
+
+	// 3 of the 5 validators of equal power are below 66% of the valset power
+	for _, val := range testhyperion.ValAddrs[:3] {
+		require.NoError(t, k.VoteCounterpartyHeader(ctx, hyperionId, val, header))
+	}
+	_, found := k.GetCounterpartyHeader(ctx, hyperionId, 100)
+	require.False(t, found)
+
+	// a validator votes once per height, whatever the header
+	err := k.VoteCounterpartyHeader(ctx, hyperionId, testhyperion.ValAddrs[0], header)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+	err = k.VoteCounterpartyHeader(ctx, hyperionId, testhyperion.ValAddrs[0], testCounterpartyHeader(100, "0xbb"))
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// the 4th vote reaches the quorum
+	require.NoError(t, k.VoteCounterpartyHeader(ctx, hyperionId, testhyperion.ValAddrs[3], header))
+	accepted, found := k.GetCounterpartyHeader(ctx, hyperionId, 100)
+	require.True(t, found)
+	require.Equal(t, types.NewCounterpartyHeader(header), *accepted)
+
+	latest, found := k.GetLatestCounterpartyHeader(ctx, hyperionId)
+	require.True(t, found)
+	require.Equal(t, uint64(100), latest.Height)
+
+	// late votes for the accepted header are no-ops, conflicting ones are rejected
+	require.NoError(t, k.VoteCounterpartyHeader(ctx, hyperionId, testhyperion.ValAddrs[4], header))
+	err = k.VoteCounterpartyHeader(ctx, hyperionId, testhyperion.ValAddrs[4], testCounterpartyHeader(100, "0xbb"))
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// other counterparty chains don't see the header
+	_, found = k.GetLatestCounterpartyHeader(ctx, hyperionId+1)
+	require.False(t, found)
+}
+
+func TestVoteCounterpartyHeaderSplitVotes(t *testing.T) {
+	input, hyperionId := setupHeaderValset(t)
+	ctx := input.Context
+	k := &input.HyperionKeeper
+	headerA := testCounterpartyHeader(200, "0xaa")
+	headerB := testCounterpartyHeader(200, "0xbb")
+
+	// votes for different headers at the same height don't add up
+	for _, val := range testhyperion.ValAddrs[:3] {
+		require.NoError(t, k.VoteCounterpartyHeader(ctx, hyperionId, val, headerA))
+	}
+	for _, val := range testhyperion.ValAddrs[3:] {
+		require.NoError(t, k.VoteCounterpartyHeader(ctx, hyperionId, val, headerB))
+	}
+	_, found := k.GetCounterpartyHeader(ctx, hyperionId, 200)
+	require.False(t, found)
+
+	// a higher header reaching the quorum becomes the latest one
+	headerC := testCounterpartyHeader(201, "0xcc")
+	for _, val := range testhyperion.ValAddrs[:4] {
+		require.NoError(t, k.VoteCounterpartyHeader(ctx, hyperionId, val, headerC))
+	}
+	latest, found := k.GetLatestCounterpartyHeader(ctx, hyperionId)
+	require.True(t, found)
+	require.Equal(t, headerC.Hash().Hex(), latest.Hash)
+}
+
+func TestMsgSubmitCounterpartyHeader(t *testing.T) {
+	input, hyperionId := setupHeaderValset(t)
+	ctx := input.Context
+	msgServer := keeper.NewMsgServerImpl(input.HyperionKeeper)
+
+	header := testCounterpartyHeader(300, "0xaa")
+	bz, err := rlp.EncodeToBytes(header)
+	require.NoError(t, err)
+
+	// only the orchestrators of the valset can vote
+	_, err = msgServer.SubmitCounterpartyHeader(ctx, &types.MsgSubmitCounterpartyHeader{
+		Orchestrator: testhyperion.AccAddrs[0].String(),
+		HyperionId:   hyperionId,
+		Header:       bz,
+	})
+	require.ErrorIs(t, err, types.ErrUnknown)
+
+	for i, val := range testhyperion.ValAddrs {
+		input.HyperionKeeper.SetOrchestratorValidator(ctx, hyperionId, val, testhyperion.AccAddrs[i])
+	}
+
+	_, err = msgServer.SubmitCounterpartyHeader(ctx, &types.MsgSubmitCounterpartyHeader{
+		Orchestrator: testhyperion.AccAddrs[0].String(),
+		HyperionId:   hyperionId,
+		Header:       []byte{0x1},
+	})
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	for i := range testhyperion.ValAddrs[:4] {
+		_, err = msgServer.SubmitCounterpartyHeader(ctx, &types.MsgSubmitCounterpartyHeader{
+			Orchestrator: testhyperion.AccAddrs[i].String(),
+			HyperionId:   hyperionId,
+			Header:       bz,
+		})
+		require.NoError(t, err)
+	}
+	accepted, found := input.HyperionKeeper.GetCounterpartyHeader(ctx, hyperionId, 300)
+	require.True(t, found)
+	require.Equal(t, header.Hash().Hex(), accepted.Hash)
+}
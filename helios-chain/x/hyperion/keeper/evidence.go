@@ -44,8 +44,18 @@ func (k *Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject type
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
+	chainType, err := k.GetChainType(ctx, hyperionId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
 	// Get checkpoint of the supposed bad signature (fake valset, batch, or logic call submitted to eth)
-	checkpoint := subject.GetCheckpoint(hyperionId)
+	checkpoint, err := types.Checkpoint(chainType, subject, hyperionId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
 
 	// Try to find the checkpoint in the archives. If it exists, we don't slash because
 	// this is not a bad signature
@@ -62,7 +72,7 @@ func (k *Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject type
 	}
 
 	// Get eth address of the offending validator using the checkpoint and the signature
-	ethAddress, err := types.EthAddressFromSignature(checkpoint, sigBytes)
+	ethAddress, err := chainType.SignerFromSignature(checkpoint, sigBytes)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
 		return errors.Wrap(types.ErrInvalid, fmt.Sprintf("signature to eth address failed with checkpoint %s and signature %s", checkpoint.Hex(), signature))
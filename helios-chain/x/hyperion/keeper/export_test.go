@@ -0,0 +1,4 @@
+package keeper
+
+// ConsumeOutboundQuota exposes consumeOutboundQuota to the keeper tests.
+var ConsumeOutboundQuota = (*Keeper).consumeOutboundQuota
@@ -857,3 +857,55 @@ func (k *Keeper) QueryGetAllSkippedNonces(c context.Context, req *types.QueryGet
 		SkippedNonces: skippedNonces,
 	}, nil
 }
+
+func (k *Keeper) QueryGetOutboundQuota(c context.Context, req *types.QueryGetOutboundQuotaRequest) (*types.QueryGetOutboundQuotaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetHyperionParamsFromChainId(ctx, req.ChainId)
+
+	if params == nil {
+		return nil, errors.Wrap(types.ErrInvalid, "chainId not found "+strconv.FormatUint(req.ChainId, 10))
+	}
+	if !common.IsHexAddress(req.TokenAddress) {
+		return nil, status.Error(codes.InvalidArgument, "invalid token address")
+	}
+
+	rateLimit, used, remaining, limited := k.GetOutboundQuota(ctx, params.HyperionId, common.HexToAddress(req.TokenAddress))
+	if !limited {
+		return &types.QueryGetOutboundQuotaResponse{
+			Limited:   false,
+			Used:      math.ZeroInt(),
+			Remaining: math.ZeroInt(),
+		}, nil
+	}
+
+	return &types.QueryGetOutboundQuotaResponse{
+		Limited:   true,
+		RateLimit: *rateLimit,
+		Used:      used,
+		Remaining: remaining,
+	}, nil
+}
+
+func (k *Keeper) QueryGetCounterpartyHeader(c context.Context, req *types.QueryGetCounterpartyHeaderRequest) (*types.QueryGetCounterpartyHeaderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetHyperionParamsFromChainId(ctx, req.ChainId)
+
+	if params == nil {
+		return nil, errors.Wrap(types.ErrInvalid, "chainId not found "+strconv.FormatUint(req.ChainId, 10))
+	}
+
+	var (
+		header *types.CounterpartyHeader
+		found  bool
+	)
+	if req.Height == 0 {
+		header, found = k.GetLatestCounterpartyHeader(ctx, params.HyperionId)
+	} else {
+		header, found = k.GetCounterpartyHeader(ctx, params.HyperionId, req.Height)
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, "counterparty header not found")
+	}
+
+	return &types.QueryGetCounterpartyHeaderResponse{Header: header}, nil
+}
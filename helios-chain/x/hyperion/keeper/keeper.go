@@ -139,21 +139,28 @@ func (k *Keeper) SetTimeoutCommit(timeoutCommit time.Duration) {
 
 // SetValsetRequest returns a new instance of the Hyperion BridgeValidatorSet
 // i.e. {"nonce": 1, "memebers": [{"eth_addr": "foo", "power": 11223}]}
-func (k *Keeper) SetValsetRequest(ctx sdk.Context, hyperionId uint64, offsetValsetNonce uint64) *types.Valset {
+func (k *Keeper) SetValsetRequest(ctx sdk.Context, hyperionId uint64, offsetValsetNonce uint64) (*types.Valset, error) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
+	// the params validation rejects unknown chain types, storing a valset without its checkpoint
+	// would get the honest signatures of the valset slashed
+	chainType, err := k.GetChainType(ctx, hyperionId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	valset := k.GetCurrentValset(ctx, hyperionId)
 
 	// If none of the bonded validators has registered eth key, then valset.Members = 0.
 	if len(valset.Members) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	k.StoreValset(ctx, valset)
 	// Store the checkpoint as a legit past valset
-	checkpoint := valset.GetCheckpoint(hyperionId)
-	k.SetPastEthSignatureCheckpoint(ctx, hyperionId, checkpoint)
+	k.SetPastEthSignatureCheckpoint(ctx, hyperionId, chainType.ValsetCheckpoint(*valset, hyperionId))
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventValsetUpdateRequest{
@@ -165,7 +172,7 @@ func (k *Keeper) SetValsetRequest(ctx sdk.Context, hyperionId uint64, offsetVals
 		RewardToken:   valset.RewardToken,
 	})
 
-	return valset
+	return valset, nil
 }
 
 // StoreValset is for storing a valiator set at a given height
@@ -1291,6 +1298,15 @@ func (k *Keeper) SetErc20Keeper(erc20Keeper erc20keeper.Keeper) {
 	k.erc20Keeper = erc20Keeper
 }
 
+// GetChainType returns the chain type of the counterparty chain of hyperionId
+func (k *Keeper) GetChainType(ctx sdk.Context, hyperionId uint64) (types.ChainType, error) {
+	counterpartyChainParams, found := k.GetCounterpartyChainParams(ctx)[hyperionId]
+	if !found {
+		return nil, errors.Wrapf(types.ErrInvalid, "hyperion id %d not found", hyperionId)
+	}
+	return counterpartyChainParams.GetChainType()
+}
+
 func (k *Keeper) GetHyperionParamsFromChainId(ctx sdk.Context, chainId uint64) *types.CounterpartyChainParams {
 	params := k.GetParams(ctx)
 
@@ -548,3 +548,86 @@ func (k msgServer) RevokeBlacklist(ctx context.Context, msg *types.MsgRevokeBlac
 
 	return &types.MsgRevokeBlacklistResponse{}, nil
 }
+
+// [Not Used In Hyperion] SetOutboundRateLimit
+// -------------
+// MsgSetOutboundRateLimit
+// Defines the message used by governance to set the outbound rate limit of a token (or the default
+// one of the chain with an empty token address), replacing the previous one if any.
+// -------------
+func (k msgServer) SetOutboundRateLimit(c context.Context, msg *types.MsgSetOutboundRateLimit) (*types.MsgSetOutboundRateLimitResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if k.Keeper.authority != msg.Signer {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.Keeper.authority, msg.Signer)
+	}
+
+	if err := msg.RateLimit.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalid, err.Error())
+	}
+
+	hyperionParams := k.Keeper.GetHyperionParamsFromChainId(ctx, msg.ChainId)
+	if hyperionParams == nil {
+		return nil, errors.Wrap(types.ErrInvalid, "HyperionParams not found")
+	}
+
+	rateLimit := msg.RateLimit
+	if !rateLimit.IsDefault() {
+		rateLimit.TokenAddress = common.HexToAddress(rateLimit.TokenAddress).Hex()
+	}
+
+	hyperionParams.OutboundRateLimits = removeOutboundRateLimit(hyperionParams.OutboundRateLimits, rateLimit.TokenAddress)
+	hyperionParams.OutboundRateLimits = append(hyperionParams.OutboundRateLimits, &rateLimit)
+	k.Keeper.SetCounterpartyChainParams(ctx, hyperionParams.HyperionId, hyperionParams)
+
+	return &types.MsgSetOutboundRateLimitResponse{}, nil
+}
+
+// [Not Used In Hyperion] RemoveOutboundRateLimit
+// -------------
+// MsgRemoveOutboundRateLimit
+// Defines the message used by governance to remove the outbound rate limit of a token (or the
+// default one of the chain with an empty token address).
+// -------------
+func (k msgServer) RemoveOutboundRateLimit(c context.Context, msg *types.MsgRemoveOutboundRateLimit) (*types.MsgRemoveOutboundRateLimitResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if k.Keeper.authority != msg.Signer {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.Keeper.authority, msg.Signer)
+	}
+
+	hyperionParams := k.Keeper.GetHyperionParamsFromChainId(ctx, msg.ChainId)
+	if hyperionParams == nil {
+		return nil, errors.Wrap(types.ErrInvalid, "HyperionParams not found")
+	}
+
+	tokenAddress := msg.TokenAddress
+	if tokenAddress != "" {
+		tokenAddress = common.HexToAddress(tokenAddress).Hex()
+	}
+
+	rateLimits := removeOutboundRateLimit(hyperionParams.OutboundRateLimits, tokenAddress)
+	if len(rateLimits) == len(hyperionParams.OutboundRateLimits) {
+		return nil, errors.Wrapf(types.ErrInvalid, "no outbound rate limit for token %q", tokenAddress)
+	}
+	hyperionParams.OutboundRateLimits = rateLimits
+	k.Keeper.SetCounterpartyChainParams(ctx, hyperionParams.HyperionId, hyperionParams)
+
+	return &types.MsgRemoveOutboundRateLimitResponse{}, nil
+}
+
+func removeOutboundRateLimit(rateLimits []*types.OutboundRateLimit, tokenAddress string) []*types.OutboundRateLimit {
+	filtered := make([]*types.OutboundRateLimit, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		if rateLimit.TokenAddress != tokenAddress {
+			filtered = append(filtered, rateLimit)
+		}
+	}
+	return filtered
+}
@@ -51,7 +51,12 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 		return nil, errors.Wrap(types.ErrInvalid, "couldn't find valset")
 	}
 
-	checkpoint := valset.GetCheckpoint(msg.HyperionId)
+	chainType, err := k.Keeper.GetChainType(ctx, msg.HyperionId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+	checkpoint := chainType.ValsetCheckpoint(*valset, msg.HyperionId)
 
 	sigBytes, err := hex.DecodeString(msg.Signature)
 	if err != nil {
@@ -71,7 +76,7 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 		return nil, errors.Wrap(types.ErrEmpty, "no eth address found")
 	}
 
-	if err = types.ValidateEthereumSignature(checkpoint, sigBytes, ethAddress); err != nil {
+	if err = chainType.ValidateSignature(checkpoint, sigBytes, ethAddress); err != nil {
 		description := fmt.Sprintf(
 			"signature verification failed expected sig by %s with hyperion-id %d with checkpoint %s found %s",
 			ethAddress.String(), msg.HyperionId, checkpoint.Hex(), msg.Signature,
@@ -171,22 +176,26 @@ func (k msgServer) SendToChain(c context.Context, msg *types.MsgSendToChain) (*t
 	// fmt.Println("msg.BridgeFee :", msg.BridgeFee)
 	//-------------------------------------
 
-	dest := common.HexToAddress(msg.Dest)
-	if k.Keeper.InvalidSendToChainAddress(ctx, dest) {
-		return nil, errors.Wrap(types.ErrInvalidEthDestination, "destination address is invalid or blacklisted")
-	}
-
 	hyperionParams := k.Keeper.GetHyperionParamsFromChainId(ctx, msg.DestChainId)
 
 	if hyperionParams == nil {
 		return nil, errors.Wrap(types.ErrInvalidEthDestination, "destination chainId doesn't exists")
 	}
+
+	chainType, err := hyperionParams.GetChainType()
+	if err != nil {
+		return nil, err
+	}
+	dest, err := chainType.DecodeAddress(msg.Dest)
+	if err != nil || k.Keeper.InvalidSendToChainAddress(ctx, dest) {
+		return nil, errors.Wrap(types.ErrInvalidEthDestination, "destination address is invalid or blacklisted")
+	}
 	if hyperionParams.Paused {
 		return nil, errors.Wrap(types.ErrInvalidEthDestination, "destination chain is paused")
 	}
 	hyperionId := hyperionParams.HyperionId
 
-	txID, err := k.Keeper.AddToOutgoingPool(ctx, sender, common.HexToAddress(msg.Dest), msg.Amount, msg.BridgeFee, hyperionId, txHash)
+	txID, err := k.Keeper.AddToOutgoingPool(ctx, sender, dest, msg.Amount, msg.BridgeFee, hyperionId, txHash)
 	if err != nil {
 		return nil, err
 	}
@@ -367,7 +376,12 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		return nil, errors.Wrap(types.ErrInvalid, "couldn't find batch")
 	}
 
-	checkpoint := batch.GetCheckpoint(msg.HyperionId)
+	chainType, err := k.Keeper.GetChainType(ctx, msg.HyperionId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+	checkpoint := chainType.BatchCheckpoint(*batch, msg.HyperionId)
 
 	sigBytes, err := hex.DecodeString(msg.Signature)
 	if err != nil {
@@ -388,7 +402,7 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 		return nil, errors.Wrap(types.ErrEmpty, "eth address not found")
 	}
 
-	err = types.ValidateEthereumSignature(checkpoint, sigBytes, ethAddress)
+	err = chainType.ValidateSignature(checkpoint, sigBytes, ethAddress)
 	if err != nil {
 		description := fmt.Sprintf(
 			"signature verification failed expected sig by %s with hyperion-id %d with checkpoint %s found %s",
@@ -447,6 +461,12 @@ func (k msgServer) DepositClaim(c context.Context, msg *types.MsgDepositClaim) (
 		return nil, errors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in active set")
 	}
 
+	// the vote is only counted if the event log is proven when the chain requires it
+	if err := k.Keeper.verifyClaimReceiptProof(ctx, msg); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	// Check if the claim data is a valid sdk.Msg. If not, ignore the data
 	if msg.Data != "" {
 		metadata, _, err := k.Keeper.parseClaimData(ctx, msg.Data)
@@ -513,6 +533,12 @@ func (k msgServer) WithdrawClaim(c context.Context, msg *types.MsgWithdrawClaim)
 		return nil, errors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in active set")
 	}
 
+	// the vote is only counted if the event log is proven when the chain requires it
+	if err := k.Keeper.verifyClaimReceiptProof(ctx, msg); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
 	a, err := codectypes.NewAnyWithValue(msg)
 	if err != nil {
 		metrics.ReportFuncError(k.svcTags)
@@ -529,6 +555,50 @@ func (k msgServer) WithdrawClaim(c context.Context, msg *types.MsgWithdrawClaim)
 	return &types.MsgWithdrawClaimResponse{}, nil
 }
 
+// [Used In Hyperion] SubmitCounterpartyHeader handles MsgSubmitCounterpartyHeader
+// -------------
+// MsgSubmitCounterpartyHeader
+// the vote of an orchestrator for a block header of the counterparty chain, the
+// claims of the chains in receipt_proof mode are proven against these headers
+// -------------
+func (k msgServer) SubmitCounterpartyHeader(c context.Context, msg *types.MsgSubmitCounterpartyHeader) (*types.MsgSubmitCounterpartyHeaderResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	orchestrator, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	validator, found := k.Keeper.GetOrchestratorValidator(ctx, msg.HyperionId, orchestrator)
+	if !found {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrUnknown, "validator")
+	}
+
+	// return an error if the validator isn't in the active set
+	val, err := k.Keeper.StakingKeeper.Validator(ctx, validator)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(err, "validator can't be retrieved")
+	}
+	if val == nil || !val.IsBonded() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(sdkerrors.ErrorInvalidSigner, "validator not in active set")
+	}
+
+	header, err := types.DecodeCounterpartyHeader(msg.Header)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	if err := k.Keeper.VoteCounterpartyHeader(ctx, msg.HyperionId, validator, header); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgSubmitCounterpartyHeaderResponse{}, nil
+}
+
 func (k msgServer) ExternalDataClaim(c context.Context, msg *types.MsgExternalDataClaim) (*types.MsgExternalDataClaimResponse, error) {
 	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
 	defer doneFn()
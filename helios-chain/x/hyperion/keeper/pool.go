@@ -54,6 +54,12 @@ func (k *Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, count
 	isCosmosOriginated := tokenAddressToDenom.IsCosmosOriginated
 	tokenContract := common.HexToAddress(tokenAddressToDenom.TokenAddress)
 
+	// bound the value leaving through the bridge over the rate limit window of the token
+	if err := k.consumeOutboundQuota(ctx, hyperionId, tokenContract, amount.Amount); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return 0, err
+	}
+
 	// for the amount sent:
 
 	if isCosmosOriginated { // write information to the metadata balance if they are cosmos originated (to know how much is locked)
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"helios-core/helios-chain/x/hyperion/types"
+)
+
+// GetOutboundRateLimit returns the rate limit applying to the token sent toward the chain of hyperionId
+func (k *Keeper) GetOutboundRateLimit(ctx sdk.Context, hyperionId uint64, tokenContract common.Address) (*types.OutboundRateLimit, bool) {
+	counterpartyChainParams, found := k.GetCounterpartyChainParams(ctx)[hyperionId]
+	if !found {
+		return nil, false
+	}
+	return counterpartyChainParams.GetOutboundRateLimit(tokenContract)
+}
+
+// GetOutboundUsage returns the amount of the token sent toward the chain of hyperionId over the rate limit window
+func (k *Keeper) GetOutboundUsage(ctx sdk.Context, hyperionId uint64, tokenContract common.Address, rateLimit types.OutboundRateLimit) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutboundRateLimitUsagePrefix(hyperionId, tokenContract))
+
+	used := math.ZeroInt()
+	iter := store.Iterator(types.UInt64Bytes(windowStart(ctx, rateLimit)), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			continue
+		}
+		used = used.Add(amount)
+	}
+	return used
+}
+
+// GetOutboundQuota returns the rate limit applying to the token with the amount already sent and
+// the amount which can still be sent over the window. limited is false if no rate limit applies.
+func (k *Keeper) GetOutboundQuota(ctx sdk.Context, hyperionId uint64, tokenContract common.Address) (rateLimit *types.OutboundRateLimit, used math.Int, remaining math.Int, limited bool) {
+	rateLimit, limited = k.GetOutboundRateLimit(ctx, hyperionId, tokenContract)
+	if !limited {
+		return nil, math.ZeroInt(), math.ZeroInt(), false
+	}
+
+	used = k.GetOutboundUsage(ctx, hyperionId, tokenContract, *rateLimit)
+	quota := rateLimit.Quota(k.GetHyperionContractBalance(ctx, hyperionId, tokenContract))
+	remaining = math.ZeroInt()
+	if quota.GT(used) {
+		remaining = quota.Sub(used)
+	}
+	return rateLimit, used, remaining, true
+}
+
+// consumeOutboundQuota records amount as sent toward the chain of hyperionId, failing if it exceeds
+// the remaining quota of the token rate limit.
+func (k *Keeper) consumeOutboundQuota(ctx sdk.Context, hyperionId uint64, tokenContract common.Address, amount math.Int) error {
+	rateLimit, _, remaining, limited := k.GetOutboundQuota(ctx, hyperionId, tokenContract)
+	if !limited {
+		return nil
+	}
+	if amount.GT(remaining) {
+		return errors.Wrapf(types.ErrOutboundRateLimitExceeded, "amount %s exceeds the remaining quota %s of %s over %ds", amount, remaining, tokenContract.Hex(), rateLimit.WindowSeconds)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutboundRateLimitUsagePrefix(hyperionId, tokenContract))
+	k.pruneOutboundUsage(store, windowStart(ctx, *rateLimit))
+
+	bucketSeconds := rateLimit.BucketSeconds()
+	bucketStart := uint64(ctx.BlockTime().Unix()) / bucketSeconds * bucketSeconds
+	key := types.UInt64Bytes(bucketStart)
+
+	bucketAmount := math.ZeroInt()
+	if bz := store.Get(key); bz != nil {
+		if err := bucketAmount.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+	bz, err := bucketAmount.Add(amount).Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// pruneOutboundUsage deletes the buckets started before the window
+func (k *Keeper) pruneOutboundUsage(store prefix.Store, windowStart uint64) {
+	iter := store.Iterator(nil, types.UInt64Bytes(windowStart))
+	defer iter.Close()
+
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// windowStart returns the start (unix seconds) of the first bucket counted in the rate limit window.
+// The bucket overlapping the start of the window is fully counted so the quota is never exceeded
+// over any window_seconds period.
+func windowStart(ctx sdk.Context, rateLimit types.OutboundRateLimit) uint64 {
+	now := uint64(ctx.BlockTime().Unix())
+	if now < rateLimit.WindowSeconds {
+		return 0
+	}
+	bucketSeconds := rateLimit.BucketSeconds()
+	return (now - rateLimit.WindowSeconds) / bucketSeconds * bucketSeconds
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"helios-core/helios-chain/x/hyperion/keeper"
+	"helios-core/helios-chain/x/hyperion/testhyperion"
+	"helios-core/helios-chain/x/hyperion/types"
+)
+
+// setOutboundRateLimits replaces the rate limits of the test counterparty chain
+func setOutboundRateLimits(input testhyperion.TestInput, rateLimits ...*types.OutboundRateLimit) {
+	params := input.HyperionKeeper.GetParams(input.Context)
+	params.CounterpartyChainParams[0].OutboundRateLimits = rateLimits
+	input.HyperionKeeper.SetParams(input.Context, params)
+}
+
+func TestOutboundRateLimitEnforcement(t *testing.T) {
+	input := testhyperion.CreateTestEnv(t)
+	ctx := input.Context
+	k := &input.HyperionKeeper
+	hyperionId := testhyperion.TestingHyperionEthereumParams.HyperionId
+
+	dai := common.HexToAddress(testhyperion.TokenContractAddrs[0])
+	yfi := common.HexToAddress(testhyperion.TokenContractAddrs[1])
+	setOutboundRateLimits(input,
+		&types.OutboundRateLimit{WindowSeconds: 3600, MaxAmount: math.NewInt(1_000), MaxPercentage: math.LegacyZeroDec()},
+		&types.OutboundRateLimit{TokenAddress: dai.Hex(), WindowSeconds: 3600, MaxAmount: math.NewInt(100), MaxPercentage: math.LegacyZeroDec()},
+	)
+
+	// tokens without a rate limit of their own use the default one
+	rateLimit, _, remaining, limited := k.GetOutboundQuota(ctx, hyperionId, yfi)
+	require.True(t, limited)
+	require.True(t, rateLimit.IsDefault())
+	require.Equal(t, math.NewInt(1_000), remaining)
+
+	require.NoError(t, keeper.ConsumeOutboundQuota(k, ctx, hyperionId, dai, math.NewInt(60)))
+	err := keeper.ConsumeOutboundQuota(k, ctx, hyperionId, dai, math.NewInt(50))
+	require.ErrorIs(t, err, types.ErrOutboundRateLimitExceeded)
+
+	// the rejected amount isn't recorded
+	require.NoError(t, keeper.ConsumeOutboundQuota(k, ctx, hyperionId, dai, math.NewInt(40)))
+	_, used, remaining, _ := k.GetOutboundQuota(ctx, hyperionId, dai)
+	require.Equal(t, math.NewInt(100), used)
+	require.True(t, remaining.IsZero())
+	require.ErrorIs(t, keeper.ConsumeOutboundQuota(k, ctx, hyperionId, dai, math.OneInt()), types.ErrOutboundRateLimitExceeded)
+
+	// the usage of a token doesn't count against the other ones
+	_, used, _, _ = k.GetOutboundQuota(ctx, hyperionId, yfi)
+	require.True(t, used.IsZero())
+	require.NoError(t, keeper.ConsumeOutboundQuota(k, ctx, hyperionId, yfi, math.NewInt(1_000)))
+
+	// chains without a rate limit are not limited
+	setOutboundRateLimits(input)
+	_, _, _, limited = k.GetOutboundQuota(ctx, hyperionId, dai)
+	require.False(t, limited)
+	require.NoError(t, keeper.ConsumeOutboundQuota(k, ctx, hyperionId, dai, math.NewInt(1_000_000)))
+}
+
+func TestOutboundRateLimitWindowRollover(t *testing.T) {
+	input := testhyperion.CreateTestEnv(t)
+	k := &input.HyperionKeeper
+	hyperionId := testhyperion.TestingHyperionEthereumParams.HyperionId
+	dai := common.HexToAddress(testhyperion.TokenContractAddrs[0])
+
+	// 24 buckets of 100 seconds
+	rateLimit := &types.OutboundRateLimit{TokenAddress: dai.Hex(), WindowSeconds: 2_400, MaxAmount: math.NewInt(100), MaxPercentage: math.LegacyZeroDec()}
+	setOutboundRateLimits(input, rateLimit)
+	bucketSeconds := time.Duration(rateLimit.BucketSeconds()) * time.Second
+	window := time.Duration(rateLimit.WindowSeconds) * time.Second
+
+	start := input.Context.BlockTime()
+	require.Zero(t, start.Unix()%int64(rateLimit.BucketSeconds()), "the test starts on a bucket boundary")
+	at := func(d time.Duration) func() (math.Int, math.Int) {
+		ctx := input.Context.WithBlockTime(start.Add(d))
+		return func() (math.Int, math.Int) {
+			_, used, remaining, _ := k.GetOutboundQuota(ctx, hyperionId, dai)
+			return used, remaining
+		}
+	}
+	consume := func(d time.Duration, amount int64) error {
+		return keeper.ConsumeOutboundQuota(k, input.Context.WithBlockTime(start.Add(d)), hyperionId, dai, math.NewInt(amount))
+	}
+
+	require.NoError(t, consume(0, 60))
+	require.NoError(t, consume(window/2, 40))
+	require.ErrorIs(t, consume(window/2, 1), types.ErrOutboundRateLimitExceeded)
+
+	// the bucket overlapping the start of the window is still fully counted
+	used, remaining := at(window)()
+	require.Equal(t, math.NewInt(100), used)
+	require.True(t, remaining.IsZero())
+	require.ErrorIs(t, consume(window, 1), types.ErrOutboundRateLimitExceeded)
+
+	// once the first bucket left the window, its amount can be sent again
+	used, remaining = at(window + bucketSeconds)()
+	require.Equal(t, math.NewInt(40), used)
+	require.Equal(t, math.NewInt(60), remaining)
+	require.NoError(t, consume(window+bucketSeconds, 60))
+	require.ErrorIs(t, consume(window+bucketSeconds, 1), types.ErrOutboundRateLimitExceeded)
+
+	// the whole quota is back after a full window without transfers
+	used, remaining = at(2*window + 2*bucketSeconds)()
+	require.True(t, used.IsZero())
+	require.Equal(t, math.NewInt(100), remaining)
+}
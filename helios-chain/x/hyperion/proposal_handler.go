@@ -245,6 +245,18 @@ func HandleHyperionProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.Hy
 		if err != nil {
 			return err
 		}
+	case *types.MsgSetOutboundRateLimit:
+		msg.Signer = k.GetAuthority()
+		_, err := keeper.NewMsgServerImpl(k).SetOutboundRateLimit(ctx, msg)
+		if err != nil {
+			return err
+		}
+	case *types.MsgRemoveOutboundRateLimit:
+		msg.Signer = k.GetAuthority()
+		_, err := keeper.NewMsgServerImpl(k).RemoveOutboundRateLimit(ctx, msg)
+		if err != nil {
+			return err
+		}
 	default:
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized hyperion proposal message type: %T", msg)
 	}
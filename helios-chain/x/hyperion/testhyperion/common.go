@@ -451,6 +451,11 @@ func CreateTestEnv(t *testing.T) TestInput {
 
 	erc20Keeper := erc20keeper.NewKeeper(erc20Key, marshaler, authtypes.NewModuleAddress(govtypes.ModuleName), accountKeeper, bankKeeper, nil, nil, authzKeeper, nil)
 
+	// the staking module weights the delegations by the whitelisted assets
+	_ = sdk.RegisterDenom(TestingStakeParams.BondDenom, math.LegacyOneDec())
+	err = erc20Keeper.AddAssetToConsensusWhitelist(ctx, erc20types.Asset{Denom: TestingStakeParams.BondDenom, BaseWeight: 1})
+	require.NoError(t, err)
+
 	stakingKeeper := stakingkeeper.NewKeeper(
 		marshaler,
 		runtime.NewKVStoreService(keyStaking),
@@ -862,6 +867,7 @@ func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey ccrypto.PubKey, am
 	if err != nil {
 		panic(err)
 	}
+	out.MinDelegation = math.ZeroInt()
 
 	return out
 }
@@ -52,4 +52,29 @@ const (
 			{ "internalType": "bytes32", "name": "", "type": "bytes32" }
 		]
 	}]`
+
+	// HyperionEventsABIJSON describes the events of the hyperion contract proven by the claims
+	// of the chains in receipt_proof claim verification mode
+	HyperionEventsABIJSON = `[{
+		"name": "SendToHeliosEvent",
+		"type": "event",
+		"anonymous": false,
+		"inputs": [
+			{ "indexed": true,  "internalType": "address", "name": "_tokenContract", "type": "address" },
+			{ "indexed": true,  "internalType": "address", "name": "_sender",        "type": "address" },
+			{ "indexed": true,  "internalType": "bytes32", "name": "_destination",   "type": "bytes32" },
+			{ "indexed": false, "internalType": "uint256", "name": "_amount",        "type": "uint256" },
+			{ "indexed": false, "internalType": "uint256", "name": "_eventNonce",    "type": "uint256" },
+			{ "indexed": false, "internalType": "string",  "name": "_data",          "type": "string"  }
+		]
+	}, {
+		"name": "TransactionBatchExecutedEvent",
+		"type": "event",
+		"anonymous": false,
+		"inputs": [
+			{ "indexed": true,  "internalType": "uint256", "name": "_batchNonce", "type": "uint256" },
+			{ "indexed": true,  "internalType": "address", "name": "_token",      "type": "address" },
+			{ "indexed": false, "internalType": "uint256", "name": "_eventNonce", "type": "uint256" }
+		]
+	}]`
 )
@@ -11,3 +11,40 @@ func (a *Attestation) ContainsVote(address string) bool {
 	}
 	return false
 }
+
+// ClaimFault is the fault of a validator in the attestations of an event nonce
+type ClaimFault int
+
+const (
+	// ClaimFaultNone is for the validators who voted for the observed attestation
+	ClaimFaultNone ClaimFault = iota
+	// ClaimFaultMissing is for the validators who didn't vote at the event nonce
+	ClaimFaultMissing
+	// ClaimFaultConflicting is for the validators who only voted for attestations which weren't observed
+	ClaimFaultConflicting
+)
+
+// GetClaimFault returns the fault of the validator given all the attestations of an event nonce
+func GetClaimFault(attestations []*Attestation, validator string) ClaimFault {
+	fault := ClaimFaultMissing
+	for _, att := range attestations {
+		if !att.ContainsVote(validator) {
+			continue
+		}
+		if att.Observed {
+			return ClaimFaultNone
+		}
+		fault = ClaimFaultConflicting
+	}
+	return fault
+}
+
+// GetObservedAttestation returns the observed attestation among the attestations of an event nonce
+func GetObservedAttestation(attestations []*Attestation) *Attestation {
+	for _, att := range attestations {
+		if att.Observed {
+			return att
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hyperiontypes "helios-core/helios-chain/x/hyperion/types"
+)
+
+func TestGetClaimFault(t *testing.T) {
+	observed := &hyperiontypes.Attestation{
+		Observed: true,
+		Votes:    []string{"heliosvaloper1a:AA", "heliosvaloper1b:BB"},
+	}
+	conflicting := &hyperiontypes.Attestation{
+		Votes: []string{"heliosvaloper1c:CC"},
+	}
+	attestations := []*hyperiontypes.Attestation{conflicting, observed}
+
+	testCases := []struct {
+		name      string
+		validator string
+		expFault  hyperiontypes.ClaimFault
+	}{
+		{"voted for the observed claim", "heliosvaloper1a", hyperiontypes.ClaimFaultNone},
+		{"voted for a conflicting claim", "heliosvaloper1c", hyperiontypes.ClaimFaultConflicting},
+		{"didn't vote", "heliosvaloper1d", hyperiontypes.ClaimFaultMissing},
+		{"vote prefix of another validator", "heliosvaloper1", hyperiontypes.ClaimFaultMissing},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expFault, hyperiontypes.GetClaimFault(attestations, tc.validator), tc.name)
+	}
+
+	// a validator who voted for both claims voted for the observed one
+	conflicting.Votes = append(conflicting.Votes, "heliosvaloper1a:DD")
+	require.Equal(t, hyperiontypes.ClaimFaultNone, hyperiontypes.GetClaimFault(attestations, "heliosvaloper1a"))
+
+	require.Equal(t, hyperiontypes.ClaimFaultMissing, hyperiontypes.GetClaimFault(nil, "heliosvaloper1a"))
+}
+
+func TestPendingClaimSlashingKey(t *testing.T) {
+	key := hyperiontypes.GetPendingClaimSlashingKey(1, 5, []byte{0xaa})
+	require.True(t, bytes.HasPrefix(key, hyperiontypes.GetPendingClaimSlashingNoncePrefix(1, 5)))
+	require.True(t, bytes.HasPrefix(key, hyperiontypes.GetPendingClaimSlashingPrefix(1)))
+	require.False(t, bytes.HasPrefix(key, hyperiontypes.GetPendingClaimSlashingPrefix(2)))
+
+	// nonces are iterated in ASC order
+	require.Equal(t, -1, bytes.Compare(
+		hyperiontypes.GetPendingClaimSlashingKey(1, 5, []byte{0xff}),
+		hyperiontypes.GetPendingClaimSlashingKey(1, 256, []byte{0x00}),
+	))
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ChainTypeEVM is the bridge_chain_type of EVM counterparty chains
+	ChainTypeEVM = "evm"
+	// ChainTypeCosmos is the bridge_chain_type of Cosmos-SDK counterparty chains
+	ChainTypeCosmos = "cosmos"
+)
+
+// ChainType abstracts what depends on the family of the counterparty chain: how its
+// addresses are encoded, how the valset and batch checkpoints signed by the orchestrators
+// are hashed and which signature scheme they sign them with.
+//
+// Counterparty addresses are kept internally as 20 bytes (common.Address) whatever the
+// chain type, the chain type only decodes them from their native encoding.
+type ChainType interface {
+	// Name returns the bridge_chain_type of the chain family
+	Name() string
+	// ValidateAddress checks an address in the native encoding of the chain
+	ValidateAddress(address string) error
+	// DecodeAddress decodes an address in the native encoding of the chain
+	DecodeAddress(address string) (common.Address, error)
+	// ValsetCheckpoint returns the checkpoint signed by the orchestrators for a valset
+	ValsetCheckpoint(valset Valset, hyperionID uint64) common.Hash
+	// BatchCheckpoint returns the checkpoint signed by the orchestrators for a batch
+	BatchCheckpoint(batch OutgoingTxBatch, hyperionID uint64) common.Hash
+	// SignerFromSignature returns the address of the signer of the checkpoint
+	SignerFromSignature(checkpoint common.Hash, signature []byte) (common.Address, error)
+	// ValidateSignature returns an error if the signature of the checkpoint is not from signer
+	ValidateSignature(checkpoint common.Hash, signature []byte, signer common.Address) error
+}
+
+var chainTypes = map[string]ChainType{}
+
+func init() {
+	RegisterChainType(EVMChainType{})
+	RegisterChainType(CosmosChainType{})
+}
+
+// RegisterChainType makes a chain family available as bridge_chain_type, it panics
+// if a chain type is already registered under the same name.
+func RegisterChainType(chainType ChainType) {
+	if _, found := chainTypes[chainType.Name()]; found {
+		panic(fmt.Sprintf("chain type %s already registered", chainType.Name()))
+	}
+	chainTypes[chainType.Name()] = chainType
+}
+
+// GetChainType returns the chain type registered under name, an empty name
+// being the evm chain type for the chains added before chain types existed.
+func GetChainType(name string) (ChainType, error) {
+	if name == "" {
+		name = ChainTypeEVM
+	}
+	chainType, found := chainTypes[name]
+	if !found {
+		return nil, errors.Wrapf(ErrInvalid, "unknown bridge chain type %q, must be one of %v", name, ChainTypeNames())
+	}
+	return chainType, nil
+}
+
+// ChainTypeNames returns the sorted names of the registered chain types
+func ChainTypeNames() []string {
+	names := make([]string, 0, len(chainTypes))
+	for name := range chainTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateChainAddress checks that the address is valid for at least one of the
+// registered chain types, it's used where the counterparty chain isn't known.
+func ValidateChainAddress(address string) error {
+	if address == "" {
+		return fmt.Errorf("empty")
+	}
+	for _, name := range ChainTypeNames() {
+		if chainTypes[name].ValidateAddress(address) == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a valid address for any of the chain types %v", address, ChainTypeNames())
+}
+
+// GetChainType returns the chain type of the counterparty chain
+func (p CounterpartyChainParams) GetChainType() (ChainType, error) {
+	return GetChainType(p.BridgeChainType)
+}
+
+// Checkpoint returns the checkpoint of a valset or a batch for the chain type
+func Checkpoint(chainType ChainType, subject EthereumSigned, hyperionID uint64) (common.Hash, error) {
+	switch subject := subject.(type) {
+	case *Valset:
+		return chainType.ValsetCheckpoint(*subject, hyperionID), nil
+	case *OutgoingTxBatch:
+		return chainType.BatchCheckpoint(*subject, hyperionID), nil
+	default:
+		return common.Hash{}, errors.Wrapf(ErrInvalid, "no checkpoint for %T", subject)
+	}
+}
+
+func validateBridgeChainType(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	_, err := GetChainType(v)
+	return err
+}
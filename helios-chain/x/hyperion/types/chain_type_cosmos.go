@@ -0,0 +1,131 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CosmosSignatureLength is the length of a cosmos chain type signature: the 33 bytes
+// compressed secp256k1 public key of the signer followed by the 64 bytes r || s signature.
+const CosmosSignatureLength = secp256k1.PubKeySize + 64
+
+var _ ChainType = CosmosChainType{}
+
+// CosmosChainType is the chain type of Cosmos-SDK chains: bech32 addresses (any prefix),
+// checkpoints hashed with sha256 over a length prefixed big endian encoding and secp256k1
+// signatures (over sha256 of the checkpoint) carrying the public key of the signer.
+type CosmosChainType struct{}
+
+func (CosmosChainType) Name() string {
+	return ChainTypeCosmos
+}
+
+func (c CosmosChainType) ValidateAddress(address string) error {
+	_, err := c.DecodeAddress(address)
+	return err
+}
+
+func (CosmosChainType) DecodeAddress(address string) (common.Address, error) {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(ErrInvalid, "%s is not a valid bech32 address: %s", address, err)
+	}
+	if len(bz) != common.AddressLength {
+		return common.Address{}, errors.Wrapf(ErrInvalid, "%s is not a %d bytes address", address, common.AddressLength)
+	}
+	return common.BytesToAddress(bz), nil
+}
+
+func (CosmosChainType) ValsetCheckpoint(valset Valset, hyperionID uint64) common.Hash {
+	var rewardToken []byte
+	if valset.RewardToken != "" {
+		rewardToken = common.HexToAddress(valset.RewardToken).Bytes()
+	}
+	rewardAmount := ""
+	if !valset.RewardAmount.IsNil() {
+		rewardAmount = valset.RewardAmount.String()
+	}
+
+	e := newCheckpointEncoder("checkpoint", hyperionID)
+	e.uint64(valset.Nonce)
+	e.uint64(uint64(len(valset.Members)))
+	for _, member := range valset.Members {
+		e.bytes(common.HexToAddress(member.EthereumAddress).Bytes())
+		e.uint64(member.Power)
+	}
+	e.bytes([]byte(rewardAmount))
+	e.bytes(rewardToken)
+	return e.sum()
+}
+
+func (CosmosChainType) BatchCheckpoint(batch OutgoingTxBatch, hyperionID uint64) common.Hash {
+	e := newCheckpointEncoder("transactionBatch", hyperionID)
+	e.uint64(batch.BatchNonce)
+	e.uint64(batch.BatchTimeout)
+	e.bytes(common.HexToAddress(batch.TokenContract).Bytes())
+	e.uint64(uint64(len(batch.Transactions)))
+	for _, tx := range batch.Transactions {
+		amount := ""
+		if tx.Token != nil && !tx.Token.Amount.IsNil() {
+			amount = tx.Token.Amount.String()
+		}
+		e.uint64(tx.Id)
+		e.bytes(common.HexToAddress(tx.DestAddress).Bytes())
+		e.bytes([]byte(amount))
+	}
+	return e.sum()
+}
+
+func (CosmosChainType) SignerFromSignature(checkpoint common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != CosmosSignatureLength {
+		return common.Address{}, errors.Wrapf(ErrInvalid, "wrong size for signature: got %d, want %d", len(signature), CosmosSignatureLength)
+	}
+	pubKey := &secp256k1.PubKey{Key: signature[:secp256k1.PubKeySize]}
+	if !pubKey.VerifySignature(checkpoint.Bytes(), signature[secp256k1.PubKeySize:]) {
+		return common.Address{}, errors.Wrap(ErrInvalid, "signature verification failed")
+	}
+	return common.BytesToAddress(pubKey.Address()), nil
+}
+
+func (c CosmosChainType) ValidateSignature(checkpoint common.Hash, signature []byte, signer common.Address) error {
+	addr, err := c.SignerFromSignature(checkpoint, signature)
+	if err != nil {
+		return err
+	}
+	if addr != signer {
+		return errors.Wrap(ErrInvalid, "signature not matching")
+	}
+	return nil
+}
+
+// checkpointEncoder builds the deterministic encoding hashed into cosmos checkpoints,
+// every field is either a big endian uint64 or length prefixed bytes.
+type checkpointEncoder struct {
+	buf []byte
+}
+
+func newCheckpointEncoder(method string, hyperionID uint64) *checkpointEncoder {
+	e := &checkpointEncoder{}
+	e.bytes([]byte(fmt.Sprintf("hyperion/%s", method)))
+	e.uint64(hyperionID)
+	return e
+}
+
+func (e *checkpointEncoder) uint64(v uint64) {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, v)
+}
+
+func (e *checkpointEncoder) bytes(bz []byte) {
+	e.uint64(uint64(len(bz)))
+	e.buf = append(e.buf, bz...)
+}
+
+func (e *checkpointEncoder) sum() common.Hash {
+	return sha256.Sum256(e.buf)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ ChainType = EVMChainType{}
+
+// EVMChainType is the chain type of EVM chains: hex addresses, checkpoints hashed
+// with keccak256 over their abi encoding and secp256k1 Ethereum signed messages.
+type EVMChainType struct{}
+
+func (EVMChainType) Name() string {
+	return ChainTypeEVM
+}
+
+func (EVMChainType) ValidateAddress(address string) error {
+	return ValidateEthAddress(address)
+}
+
+func (EVMChainType) DecodeAddress(address string) (common.Address, error) {
+	addr, err := NewEthAddress(address)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return *addr, nil
+}
+
+func (EVMChainType) ValsetCheckpoint(valset Valset, hyperionID uint64) common.Hash {
+	return valset.GetCheckpoint(hyperionID)
+}
+
+func (EVMChainType) BatchCheckpoint(batch OutgoingTxBatch, hyperionID uint64) common.Hash {
+	return batch.GetCheckpoint(hyperionID)
+}
+
+func (EVMChainType) SignerFromSignature(checkpoint common.Hash, signature []byte) (common.Address, error) {
+	return EthAddressFromSignature(checkpoint, signature)
+}
+
+func (EVMChainType) ValidateSignature(checkpoint common.Hash, signature []byte, signer common.Address) error {
+	return ValidateEthereumSignature(checkpoint, signature, signer)
+}
@@ -0,0 +1,182 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	hyperiontypes "helios-core/helios-chain/x/hyperion/types"
+)
+
+func testValset() hyperiontypes.Valset {
+	return hyperiontypes.Valset{
+		Nonce: 3,
+		Members: []*hyperiontypes.BridgeValidator{
+			{Power: 2000, EthereumAddress: common.HexToAddress("0x1000").Hex()},
+			{Power: 1000, EthereumAddress: common.HexToAddress("0x2000").Hex()},
+		},
+		RewardAmount: math.NewInt(10),
+		RewardToken:  common.HexToAddress("0x3000").Hex(),
+	}
+}
+
+func testBatch() hyperiontypes.OutgoingTxBatch {
+	return hyperiontypes.OutgoingTxBatch{
+		BatchNonce:    7,
+		BatchTimeout:  1000,
+		TokenContract: common.HexToAddress("0x3000").Hex(),
+		Transactions: []*hyperiontypes.OutgoingTransferTx{
+			{Id: 1, DestAddress: common.HexToAddress("0x4000").Hex(), Token: &hyperiontypes.Token{Contract: common.HexToAddress("0x3000").Hex(), Amount: math.NewInt(100)}},
+		},
+	}
+}
+
+func TestGetChainType(t *testing.T) {
+	chainType, err := hyperiontypes.GetChainType("")
+	require.NoError(t, err)
+	require.Equal(t, hyperiontypes.ChainTypeEVM, chainType.Name())
+
+	chainType, err = hyperiontypes.GetChainType(hyperiontypes.ChainTypeCosmos)
+	require.NoError(t, err)
+	require.Equal(t, hyperiontypes.ChainTypeCosmos, chainType.Name())
+
+	_, err = hyperiontypes.GetChainType("solana")
+	require.Error(t, err)
+
+	require.Panics(t, func() { hyperiontypes.RegisterChainType(hyperiontypes.EVMChainType{}) })
+}
+
+func TestChainTypeAddresses(t *testing.T) {
+	addr := common.HexToAddress("0x1000")
+	bech32Addr, err := bech32.ConvertAndEncode("osmo", addr.Bytes())
+	require.NoError(t, err)
+
+	evm := hyperiontypes.EVMChainType{}
+	decoded, err := evm.DecodeAddress(addr.Hex())
+	require.NoError(t, err)
+	require.Equal(t, addr, decoded)
+	require.Error(t, evm.ValidateAddress(bech32Addr))
+
+	cosmos := hyperiontypes.CosmosChainType{}
+	decoded, err = cosmos.DecodeAddress(bech32Addr)
+	require.NoError(t, err)
+	require.Equal(t, addr, decoded)
+	require.Error(t, cosmos.ValidateAddress(addr.Hex()))
+
+	// 32 bytes module style addresses can't be represented
+	longAddr, err := bech32.ConvertAndEncode("osmo", make([]byte, 32))
+	require.NoError(t, err)
+	require.Error(t, cosmos.ValidateAddress(longAddr))
+
+	require.NoError(t, hyperiontypes.ValidateChainAddress(addr.Hex()))
+	require.NoError(t, hyperiontypes.ValidateChainAddress(bech32Addr))
+	require.Error(t, hyperiontypes.ValidateChainAddress("foo"))
+	require.Error(t, hyperiontypes.ValidateChainAddress(""))
+}
+
+func TestChainTypeCheckpoints(t *testing.T) {
+	valset, batch := testValset(), testBatch()
+
+	evm := hyperiontypes.EVMChainType{}
+	require.Equal(t, valset.GetCheckpoint(21), evm.ValsetCheckpoint(valset, 21))
+	require.Equal(t, batch.GetCheckpoint(21), evm.BatchCheckpoint(batch, 21))
+
+	cosmos := hyperiontypes.CosmosChainType{}
+	valsetCheckpoint := cosmos.ValsetCheckpoint(valset, 21)
+	require.Equal(t, valsetCheckpoint, cosmos.ValsetCheckpoint(testValset(), 21))
+	require.NotEqual(t, valsetCheckpoint, evm.ValsetCheckpoint(valset, 21))
+	require.NotEqual(t, valsetCheckpoint, cosmos.ValsetCheckpoint(valset, 22))
+
+	valset.Members[0].Power++
+	require.NotEqual(t, valsetCheckpoint, cosmos.ValsetCheckpoint(valset, 21))
+
+	batchCheckpoint := cosmos.BatchCheckpoint(batch, 21)
+	require.Equal(t, batchCheckpoint, cosmos.BatchCheckpoint(testBatch(), 21))
+	require.NotEqual(t, batchCheckpoint, evm.BatchCheckpoint(batch, 21))
+	require.NotEqual(t, batchCheckpoint, cosmos.BatchCheckpoint(batch, 22))
+
+	batch.Transactions[0].DestAddress = common.HexToAddress("0x5000").Hex()
+	require.NotEqual(t, batchCheckpoint, cosmos.BatchCheckpoint(batch, 21))
+
+	checkpoint, err := hyperiontypes.Checkpoint(cosmos, &batch, 21)
+	require.NoError(t, err)
+	require.Equal(t, cosmos.BatchCheckpoint(batch, 21), checkpoint)
+}
+
+func TestEVMChainTypeSignature(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	evm := hyperiontypes.EVMChainType{}
+	checkpoint := evm.ValsetCheckpoint(testValset(), 21)
+	signature, err := hyperiontypes.NewEthereumSignature(checkpoint, privKey)
+	require.NoError(t, err)
+
+	recovered, err := evm.SignerFromSignature(checkpoint, signature)
+	require.NoError(t, err)
+	require.Equal(t, signer, recovered)
+	require.NoError(t, evm.ValidateSignature(checkpoint, signature, signer))
+}
+
+func TestCosmosChainTypeSignature(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	signer := common.BytesToAddress(privKey.PubKey().Address())
+
+	cosmos := hyperiontypes.CosmosChainType{}
+	checkpoint := cosmos.BatchCheckpoint(testBatch(), 21)
+	sig, err := privKey.Sign(checkpoint.Bytes())
+	require.NoError(t, err)
+	signature := append(privKey.PubKey().Bytes(), sig...)
+	require.Len(t, signature, hyperiontypes.CosmosSignatureLength)
+
+	recovered, err := cosmos.SignerFromSignature(checkpoint, signature)
+	require.NoError(t, err)
+	require.Equal(t, signer, recovered)
+	require.NoError(t, cosmos.ValidateSignature(checkpoint, signature, signer))
+
+	// signed by someone else
+	require.Error(t, cosmos.ValidateSignature(checkpoint, signature, common.HexToAddress("0x1000")))
+	// signature of another checkpoint
+	require.Error(t, cosmos.ValidateSignature(cosmos.BatchCheckpoint(testBatch(), 22), signature, signer))
+	// truncated
+	require.Error(t, cosmos.ValidateSignature(checkpoint, signature[:64], signer))
+}
+
+func TestCounterpartyChainParamsBridgeChainType(t *testing.T) {
+	params := *hyperiontypes.DefaultPolygonAmoyTestnet21ChainParams()
+	require.NoError(t, params.ValidateBasic())
+
+	params.BridgeChainType = hyperiontypes.ChainTypeCosmos
+	require.NoError(t, params.ValidateBasic())
+	chainType, err := params.GetChainType()
+	require.NoError(t, err)
+	require.Equal(t, hyperiontypes.ChainTypeCosmos, chainType.Name())
+
+	params.BridgeChainType = "solana"
+	require.Error(t, params.ValidateBasic())
+}
+
+func TestMsgDepositClaimCosmosSender(t *testing.T) {
+	sender, err := bech32.ConvertAndEncode("osmo", common.HexToAddress("0x1000").Bytes())
+	require.NoError(t, err)
+
+	msg := &hyperiontypes.MsgDepositClaim{
+		EventNonce:     1,
+		TokenContract:  common.HexToAddress("0x3000").Hex(),
+		Amount:         math.NewInt(100),
+		EthereumSender: sender,
+		CosmosReceiver: sdk.AccAddress(common.HexToAddress("0x2000").Bytes()).String(),
+		Orchestrator:   sdk.AccAddress(common.HexToAddress("0x2000").Bytes()).String(),
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.EthereumSender = "foo"
+	require.Error(t, msg.ValidateBasic())
+}
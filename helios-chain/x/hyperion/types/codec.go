@@ -77,6 +77,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSetWhitelistedAddresses{},
 		&MsgAddOneWhitelistedAddress{},
 		&MsgRemoveOneWhitelistedAddress{},
+
+		&MsgSetOutboundRateLimit{},
+		&MsgRemoveOutboundRateLimit{},
+
+		&MsgSubmitCounterpartyHeader{},
 	)
 
 	registry.RegisterInterface(
@@ -150,6 +155,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetWhitelistedAddresses{}, "hyperion/MsgSetWhitelistedAddresses", nil)
 	cdc.RegisterConcrete(&MsgAddOneWhitelistedAddress{}, "hyperion/MsgAddOneWhitelistedAddress", nil)
 	cdc.RegisterConcrete(&MsgRemoveOneWhitelistedAddress{}, "hyperion/MsgRemoveOneWhitelistedAddress", nil)
+	cdc.RegisterConcrete(&MsgSetOutboundRateLimit{}, "hyperion/MsgSetOutboundRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveOutboundRateLimit{}, "hyperion/MsgRemoveOutboundRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSubmitCounterpartyHeader{}, "hyperion/MsgSubmitCounterpartyHeader", nil)
 
 	cdc.RegisterConcrete(&Params{}, "hyperion/Params", nil)
 }
@@ -25,4 +25,6 @@ var (
 	ErrInvalidSigner                    = errors.Register(ModuleName, 18, "invalid signer")
 	ErrAttestationAlreadyVoted          = errors.Register(ModuleName, 19, "attestation already voted")
 	ErrAttestationAlreadyObserved       = errors.Register(ModuleName, 20, "attestation already observed")
+	ErrOutboundRateLimitExceeded        = errors.Register(ModuleName, 21, "outbound rate limit exceeded")
+	ErrInvalidReceiptProof              = errors.Register(ModuleName, 22, "invalid receipt proof")
 )
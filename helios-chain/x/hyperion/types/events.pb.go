@@ -1306,6 +1306,227 @@ func (m *EventValidatorSlash) GetMoniker() string {
 	return ""
 }
 
+type EventCounterpartyHeaderAccepted struct {
+	HyperionId uint64 `protobuf:"varint,1,opt,name=hyperion_id,json=hyperionId,proto3" json:"hyperion_id,omitempty"`
+	Height     uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Hash       string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *EventCounterpartyHeaderAccepted) Reset()         { *m = EventCounterpartyHeaderAccepted{} }
+func (m *EventCounterpartyHeaderAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventCounterpartyHeaderAccepted) ProtoMessage()    {}
+func (*EventCounterpartyHeaderAccepted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d0bd3a761f8331, []int{17}
+}
+func (m *EventCounterpartyHeaderAccepted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCounterpartyHeaderAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCounterpartyHeaderAccepted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCounterpartyHeaderAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCounterpartyHeaderAccepted.Merge(m, src)
+}
+func (m *EventCounterpartyHeaderAccepted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCounterpartyHeaderAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCounterpartyHeaderAccepted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCounterpartyHeaderAccepted proto.InternalMessageInfo
+
+func (m *EventCounterpartyHeaderAccepted) GetHyperionId() uint64 {
+	if m != nil {
+		return m.HyperionId
+	}
+	return 0
+}
+
+func (m *EventCounterpartyHeaderAccepted) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventCounterpartyHeaderAccepted) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type EventDepositReceived struct {
+	HyperionId          uint64                                  `protobuf:"varint,1,opt,name=hyperion_id,json=hyperionId,proto3" json:"hyperion_id,omitempty"`
+	EventNonce          uint64                                  `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthereumSender      string                                  `protobuf:"bytes,3,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver      string                                  `protobuf:"bytes,4,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	TokenContract       string                                  `protobuf:"bytes,5,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount              github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	SentToCommunityPool bool                                    `protobuf:"varint,7,opt,name=sent_to_community_pool,json=sentToCommunityPool,proto3" json:"sent_to_community_pool,omitempty"`
+}
+
+func (m *EventDepositReceived) Reset()         { *m = EventDepositReceived{} }
+func (m *EventDepositReceived) String() string { return proto.CompactTextString(m) }
+func (*EventDepositReceived) ProtoMessage()    {}
+func (*EventDepositReceived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d0bd3a761f8331, []int{18}
+}
+func (m *EventDepositReceived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDepositReceived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDepositReceived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDepositReceived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDepositReceived.Merge(m, src)
+}
+func (m *EventDepositReceived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDepositReceived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDepositReceived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDepositReceived proto.InternalMessageInfo
+
+func (m *EventDepositReceived) GetHyperionId() uint64 {
+	if m != nil {
+		return m.HyperionId
+	}
+	return 0
+}
+
+func (m *EventDepositReceived) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *EventDepositReceived) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *EventDepositReceived) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *EventDepositReceived) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *EventDepositReceived) GetSentToCommunityPool() bool {
+	if m != nil {
+		return m.SentToCommunityPool
+	}
+	return false
+}
+
+type EventOutgoingBatchExecuted struct {
+	HyperionId          uint64   `protobuf:"varint,1,opt,name=hyperion_id,json=hyperionId,proto3" json:"hyperion_id,omitempty"`
+	TokenContract       string   `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce          uint64   `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	BatchTxIds          []uint64 `protobuf:"varint,4,rep,packed,name=batch_tx_ids,json=batchTxIds,proto3" json:"batch_tx_ids,omitempty"`
+	OrchestratorAddress string   `protobuf:"bytes,5,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+}
+
+func (m *EventOutgoingBatchExecuted) Reset()         { *m = EventOutgoingBatchExecuted{} }
+func (m *EventOutgoingBatchExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventOutgoingBatchExecuted) ProtoMessage()    {}
+func (*EventOutgoingBatchExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d0bd3a761f8331, []int{19}
+}
+func (m *EventOutgoingBatchExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOutgoingBatchExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOutgoingBatchExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOutgoingBatchExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOutgoingBatchExecuted.Merge(m, src)
+}
+func (m *EventOutgoingBatchExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOutgoingBatchExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOutgoingBatchExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOutgoingBatchExecuted proto.InternalMessageInfo
+
+func (m *EventOutgoingBatchExecuted) GetHyperionId() uint64 {
+	if m != nil {
+		return m.HyperionId
+	}
+	return 0
+}
+
+func (m *EventOutgoingBatchExecuted) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *EventOutgoingBatchExecuted) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *EventOutgoingBatchExecuted) GetBatchTxIds() []uint64 {
+	if m != nil {
+		return m.BatchTxIds
+	}
+	return nil
+}
+
+func (m *EventOutgoingBatchExecuted) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventAttestationObserved)(nil), "helios.hyperion.v1.EventAttestationObserved")
 	proto.RegisterType((*EventBridgeWithdrawCanceled)(nil), "helios.hyperion.v1.EventBridgeWithdrawCanceled")
@@ -1324,93 +1545,105 @@ func init() {
 	proto.RegisterType((*EventCancelSendToChain)(nil), "helios.hyperion.v1.EventCancelSendToChain")
 	proto.RegisterType((*EventSubmitBadSignatureEvidence)(nil), "helios.hyperion.v1.EventSubmitBadSignatureEvidence")
 	proto.RegisterType((*EventValidatorSlash)(nil), "helios.hyperion.v1.EventValidatorSlash")
+	proto.RegisterType((*EventCounterpartyHeaderAccepted)(nil), "helios.hyperion.v1.EventCounterpartyHeaderAccepted")
+	proto.RegisterType((*EventDepositReceived)(nil), "helios.hyperion.v1.EventDepositReceived")
+	proto.RegisterType((*EventOutgoingBatchExecuted)(nil), "helios.hyperion.v1.EventOutgoingBatchExecuted")
 }
 
 func init() { proto.RegisterFile("helios/hyperion/v1/events.proto", fileDescriptor_26d0bd3a761f8331) }
 
 var fileDescriptor_26d0bd3a761f8331 = []byte{
-	// 1292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x8e, 0x13, 0x4f, 0x1c, 0x3b, 0xdd, 0x86, 0xe2, 0x06, 0xd5, 0x71, 0xb7, 0x2d,
-	0x49, 0x41, 0xb5, 0xdb, 0x22, 0x0e, 0x5c, 0x90, 0x6a, 0x37, 0xd0, 0x20, 0xd1, 0x4a, 0x9b, 0x50,
-	0x24, 0x2e, 0xd6, 0x78, 0xe7, 0xd5, 0x3b, 0xc4, 0xbb, 0x63, 0x76, 0xc6, 0x6e, 0xf3, 0x0d, 0x90,
-	0xb8, 0x70, 0xe2, 0xc2, 0x9d, 0x1b, 0x57, 0xae, 0x5c, 0x7b, 0xac, 0x38, 0x20, 0xc4, 0xa1, 0x42,
-	0xad, 0xc4, 0x81, 0x23, 0x9f, 0x00, 0xed, 0xbc, 0xd9, 0x8d, 0xeb, 0xb5, 0x55, 0xb7, 0xf4, 0x90,
-	0x93, 0x77, 0xde, 0xbc, 0x37, 0xf3, 0xe6, 0xf7, 0x7e, 0xef, 0x8f, 0xc9, 0xb6, 0x0f, 0x03, 0x2e,
-	0x64, 0xcb, 0x3f, 0x1e, 0x42, 0xc4, 0x45, 0xd8, 0x1a, 0xdf, 0x68, 0xc1, 0x18, 0x42, 0x25, 0x9b,
-	0xc3, 0x48, 0x28, 0x61, 0xdb, 0xa8, 0xd0, 0x4c, 0x14, 0x9a, 0xe3, 0x1b, 0x5b, 0x9b, 0x7d, 0xd1,
-	0x17, 0x7a, 0xbb, 0x15, 0x7f, 0xa1, 0xe6, 0xd6, 0xe5, 0x19, 0x47, 0x51, 0xa5, 0x40, 0x2a, 0xaa,
-	0x62, 0x43, 0xd4, 0xaa, 0xcf, 0xd0, 0x52, 0xc7, 0x43, 0x30, 0xf7, 0x39, 0xff, 0x5a, 0xa4, 0xb6,
-	0x17, 0x3b, 0x70, 0xeb, 0xc4, 0xf4, 0x5e, 0x4f, 0x42, 0x34, 0x06, 0x66, 0xdf, 0x21, 0x1b, 0x13,
-	0x27, 0x76, 0x63, 0xbb, 0x9a, 0xd5, 0xb0, 0x76, 0x2b, 0x37, 0x2f, 0x34, 0xb3, 0x7e, 0x36, 0x3b,
-	0x03, 0xca, 0x83, 0xc3, 0xe3, 0x21, 0xb8, 0xd5, 0x09, 0xb3, 0x58, 0x60, 0xef, 0x90, 0x6a, 0x2f,
-	0xe2, 0xac, 0x0f, 0x5d, 0x4f, 0x84, 0x2a, 0xa2, 0x9e, 0xaa, 0xe5, 0x1a, 0xd6, 0x6e, 0xc9, 0xad,
-	0xa0, 0xb8, 0x63, 0xa4, 0xf6, 0xbb, 0x27, 0x8a, 0x3e, 0xe5, 0x61, 0x97, 0xb3, 0x5a, 0xbe, 0x61,
-	0xed, 0x16, 0xdc, 0x75, 0xa3, 0x18, 0x4b, 0xf7, 0x99, 0x7d, 0x85, 0x54, 0x26, 0x5d, 0xe3, 0xac,
-	0x56, 0x68, 0x58, 0xbb, 0x65, 0x77, 0x7d, 0x42, 0xba, 0xcf, 0xec, 0x4d, 0xb2, 0x1c, 0x8a, 0xd0,
-	0x83, 0xda, 0xb2, 0x3e, 0x04, 0x17, 0x4e, 0x48, 0xde, 0xd1, 0x6f, 0x6e, 0xeb, 0x23, 0xbf, 0xe4,
-	0xca, 0x67, 0x11, 0x7d, 0xd8, 0xa1, 0xa1, 0x07, 0x03, 0x60, 0xb3, 0x9c, 0xb5, 0x16, 0x75, 0x36,
-	0x37, 0xc3, 0x59, 0xe7, 0x6f, 0x8b, 0xd8, 0xfa, 0xc2, 0x7b, 0x23, 0xd5, 0x17, 0x3c, 0xec, 0xb7,
-	0xa9, 0xf2, 0x7c, 0x7b, 0x9b, 0xac, 0x25, 0xf0, 0xc5, 0xa6, 0x96, 0x36, 0x25, 0x89, 0x08, 0xbd,
-	0x67, 0x10, 0x8a, 0xc0, 0x60, 0x85, 0x0b, 0xfb, 0x06, 0xd9, 0x14, 0x91, 0xe7, 0x83, 0x54, 0x11,
-	0x55, 0x22, 0xea, 0x52, 0xc6, 0x22, 0x90, 0x52, 0xe3, 0x54, 0x72, 0xcf, 0x4e, 0xee, 0xdd, 0xc2,
-	0xad, 0xf8, 0xa6, 0x5e, 0x7c, 0x65, 0x17, 0xc1, 0x28, 0xe0, 0x4d, 0x5a, 0x74, 0x37, 0x96, 0xd8,
-	0x97, 0xc8, 0x3a, 0x2a, 0x28, 0x1e, 0x80, 0x18, 0x29, 0x83, 0x57, 0x59, 0x0b, 0x0f, 0x51, 0x66,
-	0x37, 0x48, 0xd9, 0x28, 0x3d, 0xea, 0x72, 0x26, 0x6b, 0xc5, 0x46, 0x3e, 0x3d, 0xe6, 0xf0, 0xd1,
-	0x3e, 0x93, 0xce, 0xaf, 0x16, 0xd9, 0xca, 0x3e, 0x34, 0x05, 0xf6, 0xa5, 0x0f, 0x7e, 0xe3, 0x34,
-	0x39, 0x4f, 0x56, 0xd1, 0x65, 0x43, 0x90, 0x82, 0xbb, 0xa2, 0xd7, 0x73, 0xa9, 0xf1, 0x4b, 0xce,
-	0xe4, 0xc3, 0x7d, 0x3a, 0x90, 0xa0, 0xbe, 0x18, 0x32, 0xaa, 0xc0, 0x85, 0x6f, 0x46, 0x20, 0xd5,
-	0xcb, 0xfd, 0xbf, 0x48, 0xca, 0x63, 0x6d, 0x67, 0x80, 0x46, 0x36, 0xac, 0xa1, 0x2c, 0x45, 0xda,
-	0xa8, 0xf8, 0xc0, 0xfb, 0xbe, 0x32, 0x7e, 0x1b, 0xbb, 0x3b, 0x5a, 0x66, 0x7f, 0x46, 0x2a, 0x46,
-	0x29, 0x80, 0xa0, 0x07, 0x91, 0xac, 0x15, 0x1a, 0xf9, 0xdd, 0xb5, 0x9b, 0x97, 0x66, 0xa5, 0x1d,
-	0xb2, 0xf8, 0x3e, 0x1d, 0x70, 0x16, 0xc7, 0xdc, 0x35, 0xe7, 0x7f, 0x8e, 0x96, 0x76, 0x9b, 0xac,
-	0x47, 0xf0, 0x90, 0x46, 0xac, 0x4b, 0x03, 0x31, 0x0a, 0x31, 0xb4, 0xa5, 0xf6, 0x85, 0xc7, 0x4f,
-	0xb7, 0x97, 0xfe, 0x7c, 0xba, 0xfd, 0x96, 0x27, 0x64, 0x20, 0xa4, 0x64, 0x47, 0x4d, 0x2e, 0x5a,
-	0x01, 0x55, 0x7e, 0x73, 0x3f, 0x54, 0x6e, 0x19, 0x6d, 0x6e, 0x69, 0x93, 0xf8, 0x5d, 0xe6, 0x0c,
-	0x25, 0x8e, 0x20, 0xac, 0x15, 0x75, 0x50, 0xd6, 0x50, 0x76, 0x18, 0x8b, 0x9c, 0xdf, 0x2c, 0x72,
-	0x41, 0x03, 0x77, 0x00, 0xea, 0x5e, 0x96, 0x82, 0x20, 0xed, 0xf7, 0xc9, 0x99, 0x71, 0xe2, 0x64,
-	0x4a, 0x5a, 0x4c, 0xac, 0x8d, 0x74, 0x23, 0x61, 0xec, 0x3c, 0x92, 0xe7, 0xe6, 0x93, 0xfc, 0x3a,
-	0xd9, 0x14, 0x43, 0x40, 0x75, 0x50, 0xfe, 0x54, 0x5e, 0xd8, 0xc9, 0xde, 0x9e, 0xf2, 0x27, 0xd2,
-	0x62, 0x32, 0x9e, 0x85, 0xe9, 0x78, 0x3a, 0xdf, 0x25, 0x89, 0x8b, 0x6c, 0xe8, 0x88, 0xf0, 0x01,
-	0x8f, 0x82, 0x37, 0xc2, 0x83, 0x57, 0xcf, 0x62, 0xe7, 0xa7, 0x1c, 0xd9, 0x30, 0x10, 0x87, 0xec,
-	0x50, 0x68, 0x8e, 0xbf, 0xdc, 0x97, 0xcb, 0xa4, 0x22, 0x4c, 0x36, 0x62, 0xe2, 0x1a, 0x6f, 0xca,
-	0x89, 0x34, 0x4e, 0x5d, 0xfb, 0x1c, 0x29, 0x4a, 0x08, 0x19, 0x44, 0xc6, 0x01, 0xb3, 0xb2, 0xb7,
-	0xc8, 0x6a, 0x04, 0x1e, 0xf0, 0x31, 0x44, 0x1a, 0x9f, 0x92, 0x9b, 0xae, 0xed, 0x4f, 0x49, 0xf1,
-	0x05, 0x4a, 0xb5, 0x0c, 0xa5, 0x76, 0xfa, 0x5c, 0xf9, 0xa3, 0x5e, 0xd3, 0x13, 0x41, 0x0b, 0xd9,
-	0x65, 0x7e, 0xae, 0x49, 0x76, 0x64, 0xba, 0x4f, 0x47, 0xf0, 0xd0, 0x35, 0xe6, 0xf6, 0x5d, 0x42,
-	0x4c, 0x36, 0x3f, 0x00, 0x40, 0x72, 0xbd, 0xfa, 0x61, 0x25, 0x3c, 0xe2, 0x13, 0x00, 0xe7, 0x5b,
-	0x8b, 0x9c, 0xd1, 0x40, 0x99, 0x80, 0x2d, 0x58, 0x6e, 0xa7, 0xaa, 0x64, 0x2e, 0x53, 0x25, 0x5f,
-	0x23, 0x66, 0x8a, 0x6c, 0x4e, 0xb7, 0xd7, 0xfb, 0x42, 0x41, 0x7c, 0x97, 0xee, 0xfb, 0xe6, 0x2e,
-	0xe3, 0x8c, 0x16, 0xe1, 0x5d, 0xd9, 0x06, 0x97, 0x9b, 0xd3, 0xe0, 0xc6, 0x42, 0xa5, 0x61, 0xc3,
-	0x85, 0xf3, 0x43, 0xde, 0x00, 0x70, 0x1b, 0x86, 0x42, 0x72, 0xa5, 0x3b, 0xf3, 0x42, 0x00, 0x4c,
-	0x3a, 0x95, 0xcb, 0x38, 0x75, 0x91, 0x94, 0x51, 0xe1, 0x85, 0xda, 0x85, 0x46, 0xa6, 0x74, 0x2d,
-	0xd8, 0x98, 0x77, 0x48, 0x15, 0x94, 0x0f, 0x11, 0x8c, 0x82, 0xae, 0x21, 0xde, 0x32, 0x56, 0xfa,
-	0x44, 0x7c, 0x80, 0x04, 0xdc, 0x21, 0x55, 0x8c, 0x77, 0x37, 0xe5, 0x21, 0x56, 0x9f, 0x0a, 0x8a,
-	0xdd, 0x84, 0x8d, 0x57, 0x48, 0x45, 0x17, 0xa7, 0x93, 0xd6, 0xb1, 0xa2, 0xf5, 0xd6, 0xb5, 0x34,
-	0xed, 0x1c, 0x1f, 0xa6, 0xa4, 0x5d, 0x5d, 0xa4, 0x0e, 0x26, 0x14, 0x9d, 0x17, 0xfa, 0xd2, 0xfc,
-	0x7a, 0x64, 0x93, 0x02, 0xa3, 0x8a, 0xd6, 0x88, 0x56, 0xd1, 0xdf, 0xce, 0x8f, 0x39, 0x53, 0x50,
-	0xd2, 0xa1, 0xe3, 0xd4, 0x45, 0x66, 0x2a, 0x0b, 0x96, 0x33, 0x59, 0x90, 0x05, 0xba, 0x38, 0x0b,
-	0xe8, 0x79, 0x88, 0xad, 0xcc, 0x4f, 0x96, 0x7f, 0x72, 0xe4, 0x6d, 0x8d, 0xce, 0x9e, 0xdb, 0xb9,
-	0x79, 0xfd, 0x36, 0x0c, 0x07, 0xe2, 0x18, 0xd8, 0xe9, 0x83, 0xe8, 0x22, 0x29, 0x1b, 0x4e, 0xe2,
-	0x78, 0x86, 0xcc, 0x5d, 0x43, 0xd9, 0x6d, 0x3d, 0xa4, 0x2d, 0x08, 0x92, 0x4d, 0x0a, 0x21, 0x0d,
-	0xc0, 0x80, 0xa2, 0xbf, 0x75, 0x29, 0x3e, 0x0e, 0x7a, 0x62, 0x80, 0x0c, 0x75, 0xcd, 0x2a, 0x2e,
-	0xc5, 0x0c, 0x3c, 0x1e, 0xd0, 0x01, 0xd2, 0xae, 0xe0, 0xa6, 0xeb, 0xb9, 0x60, 0x93, 0xf9, 0x60,
-	0xff, 0x9c, 0x27, 0xe7, 0x32, 0x93, 0xce, 0xa9, 0xc4, 0xfa, 0x85, 0x56, 0xba, 0x9c, 0x6d, 0xa5,
-	0xd9, 0x69, 0xa9, 0xf8, 0xe6, 0xa6, 0xa5, 0x95, 0xff, 0x3f, 0x2d, 0xad, 0x66, 0xa6, 0xa5, 0xd7,
-	0x28, 0x27, 0xce, 0xc7, 0x26, 0x5c, 0x38, 0x4d, 0x4f, 0x8e, 0x00, 0xd9, 0x0e, 0x6f, 0x65, 0x3b,
-	0x7c, 0xdc, 0x14, 0xb7, 0x71, 0x7a, 0x18, 0xf5, 0x02, 0xae, 0xda, 0x94, 0x1d, 0xf0, 0x7e, 0x48,
-	0xd5, 0x28, 0x82, 0xbd, 0x31, 0x67, 0x10, 0x23, 0xf9, 0x1e, 0x39, 0xd3, 0xa3, 0x4c, 0x4f, 0x4f,
-	0x32, 0xd9, 0x34, 0x23, 0x5a, 0xb5, 0x47, 0xd9, 0x9e, 0xf2, 0x53, 0x1b, 0xfb, 0x23, 0x72, 0x3e,
-	0xa3, 0xdb, 0x95, 0xa3, 0xde, 0xd7, 0x90, 0x4e, 0xed, 0xe7, 0xa6, 0x6c, 0x0e, 0x70, 0xd7, 0xf9,
-	0xdd, 0x22, 0x67, 0x13, 0xea, 0x61, 0x18, 0x0e, 0x06, 0x54, 0x2e, 0xf6, 0x87, 0x68, 0x28, 0x1e,
-	0x42, 0xa4, 0xcf, 0xcf, 0xbb, 0xb8, 0x88, 0x13, 0x26, 0x02, 0x2a, 0x45, 0x98, 0xcc, 0x2e, 0xb8,
-	0x8a, 0x07, 0x4e, 0x4f, 0x84, 0x12, 0x42, 0x39, 0x92, 0x29, 0xc2, 0x38, 0xc4, 0x6c, 0xa4, 0x1b,
-	0x49, 0xb5, 0xbe, 0x4a, 0x36, 0xd2, 0xe9, 0x31, 0xd1, 0xc5, 0xbc, 0xae, 0x26, 0xf2, 0x44, 0xb5,
-	0x46, 0x56, 0x02, 0x11, 0xf2, 0xa3, 0xb4, 0x15, 0x25, 0xcb, 0x76, 0xe7, 0xf1, 0xb3, 0xba, 0xf5,
-	0xe4, 0x59, 0xdd, 0xfa, 0xeb, 0x59, 0xdd, 0xfa, 0xfe, 0x79, 0x7d, 0xe9, 0xc9, 0xf3, 0xfa, 0xd2,
-	0x1f, 0xcf, 0xeb, 0x4b, 0x5f, 0x5d, 0x45, 0x2a, 0x5e, 0xf3, 0x44, 0x04, 0xad, 0xe4, 0x3b, 0x8e,
-	0x5e, 0xeb, 0xd1, 0xc9, 0x7f, 0x73, 0x3d, 0xcd, 0xf4, 0x8a, 0xfa, 0x9f, 0xf9, 0x07, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0xf6, 0x83, 0xea, 0x55, 0x2c, 0x10, 0x00, 0x00,
+	// 1431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xfa, 0x57, 0x92, 0x89, 0xf3, 0xa3, 0xdb, 0x7c, 0xf3, 0x75, 0x83, 0xea, 0xb8, 0xdb,
+	0x42, 0x53, 0x50, 0xed, 0xfe, 0x10, 0x07, 0x2e, 0x48, 0x89, 0x1b, 0x68, 0x90, 0x68, 0xd1, 0x26,
+	0x14, 0x89, 0x8b, 0x35, 0xde, 0x79, 0xf5, 0x2e, 0xf1, 0xce, 0x98, 0x99, 0xb1, 0x1b, 0xff, 0x07,
+	0x48, 0x5c, 0x38, 0x71, 0xe1, 0xce, 0x8d, 0x2b, 0x57, 0xae, 0xbd, 0x51, 0x71, 0x40, 0xa8, 0x87,
+	0x0a, 0xb5, 0x12, 0x07, 0x8e, 0xfc, 0x05, 0x68, 0x7e, 0xec, 0xc6, 0xf5, 0xda, 0xaa, 0x69, 0x73,
+	0xc8, 0x29, 0x9e, 0x37, 0xef, 0xcd, 0xbc, 0xf9, 0xbc, 0xcf, 0xfb, 0xb1, 0x41, 0x5b, 0x21, 0x74,
+	0x23, 0x26, 0x1a, 0xe1, 0xb0, 0x07, 0x3c, 0x62, 0xb4, 0x31, 0xb8, 0xd9, 0x80, 0x01, 0x50, 0x29,
+	0xea, 0x3d, 0xce, 0x24, 0x73, 0x5d, 0xa3, 0x50, 0x4f, 0x14, 0xea, 0x83, 0x9b, 0x9b, 0xeb, 0x1d,
+	0xd6, 0x61, 0x7a, 0xbb, 0xa1, 0x7e, 0x19, 0xcd, 0xcd, 0x2b, 0x13, 0x8e, 0xc2, 0x52, 0x82, 0x90,
+	0x58, 0x2a, 0x43, 0xa3, 0x55, 0x9d, 0xa0, 0x25, 0x87, 0x3d, 0xb0, 0xf7, 0x79, 0xff, 0x38, 0xa8,
+	0xb2, 0xa7, 0x1c, 0xd8, 0x39, 0x31, 0xbd, 0xdf, 0x16, 0xc0, 0x07, 0x40, 0xdc, 0xbb, 0x68, 0x6d,
+	0xe4, 0xc4, 0x96, 0xb2, 0xab, 0x38, 0x35, 0x67, 0x7b, 0xe5, 0xd6, 0xc5, 0x7a, 0xd6, 0xcf, 0x7a,
+	0xb3, 0x8b, 0xa3, 0xf8, 0x70, 0xd8, 0x03, 0x7f, 0x75, 0xc4, 0x4c, 0x09, 0xdc, 0xab, 0x68, 0xb5,
+	0xcd, 0x23, 0xd2, 0x81, 0x56, 0xc0, 0xa8, 0xe4, 0x38, 0x90, 0x95, 0x5c, 0xcd, 0xd9, 0x5e, 0xf4,
+	0x57, 0x8c, 0xb8, 0x69, 0xa5, 0xee, 0x3b, 0x27, 0x8a, 0x21, 0x8e, 0x68, 0x2b, 0x22, 0x95, 0x7c,
+	0xcd, 0xd9, 0x2e, 0xf8, 0xcb, 0x56, 0x51, 0x49, 0xf7, 0x89, 0xfb, 0x36, 0x5a, 0x19, 0x75, 0x2d,
+	0x22, 0x95, 0x42, 0xcd, 0xd9, 0x2e, 0xfb, 0xcb, 0x23, 0xd2, 0x7d, 0xe2, 0xae, 0xa3, 0x22, 0x65,
+	0x34, 0x80, 0x4a, 0x51, 0x1f, 0x62, 0x16, 0x1e, 0x45, 0x6f, 0xe9, 0x37, 0xef, 0xea, 0x23, 0xbf,
+	0x88, 0x64, 0x48, 0x38, 0x7e, 0xd4, 0xc4, 0x34, 0x80, 0x2e, 0x90, 0x49, 0xce, 0x3a, 0xb3, 0x3a,
+	0x9b, 0x9b, 0xe0, 0xac, 0xf7, 0x97, 0x83, 0x5c, 0x7d, 0xe1, 0xfd, 0xbe, 0xec, 0xb0, 0x88, 0x76,
+	0x76, 0xb1, 0x0c, 0x42, 0x77, 0x0b, 0x2d, 0x25, 0xf0, 0x29, 0x53, 0x47, 0x9b, 0xa2, 0x44, 0x64,
+	0xbc, 0x27, 0x40, 0x59, 0x6c, 0xb1, 0x32, 0x0b, 0xf7, 0x26, 0x5a, 0x67, 0x3c, 0x08, 0x41, 0x48,
+	0x8e, 0x25, 0xe3, 0x2d, 0x4c, 0x08, 0x07, 0x21, 0x34, 0x4e, 0x8b, 0xfe, 0xf9, 0xd1, 0xbd, 0x1d,
+	0xb3, 0xa5, 0x6e, 0x6a, 0xab, 0x2b, 0x5b, 0x06, 0x8c, 0x82, 0xb9, 0x49, 0x8b, 0xee, 0x29, 0x89,
+	0x7b, 0x19, 0x2d, 0x1b, 0x05, 0x19, 0xc5, 0xc0, 0xfa, 0xd2, 0xe2, 0x55, 0xd6, 0xc2, 0x43, 0x23,
+	0x73, 0x6b, 0xa8, 0x6c, 0x95, 0x8e, 0x5b, 0x11, 0x11, 0x95, 0x52, 0x2d, 0x9f, 0x1e, 0x73, 0x78,
+	0xbc, 0x4f, 0x84, 0xf7, 0x8b, 0x83, 0x36, 0xb3, 0x0f, 0x4d, 0x81, 0x7d, 0xe5, 0x83, 0x4f, 0x9d,
+	0x26, 0x17, 0xd0, 0x82, 0x71, 0xd9, 0x12, 0xa4, 0xe0, 0xcf, 0xeb, 0xf5, 0x54, 0x6a, 0xfc, 0x9c,
+	0xb3, 0xf9, 0xf0, 0x00, 0x77, 0x05, 0xc8, 0xcf, 0x7b, 0x04, 0x4b, 0xf0, 0xe1, 0xeb, 0x3e, 0x08,
+	0xf9, 0x6a, 0xff, 0x2f, 0xa1, 0xf2, 0x40, 0xdb, 0x59, 0xa0, 0x0d, 0x1b, 0x96, 0x8c, 0x2c, 0x45,
+	0xda, 0xaa, 0x84, 0x10, 0x75, 0x42, 0x69, 0xfd, 0xb6, 0x76, 0x77, 0xb5, 0xcc, 0xfd, 0x04, 0xad,
+	0x58, 0xa5, 0x18, 0xe2, 0x36, 0x70, 0x51, 0x29, 0xd4, 0xf2, 0xdb, 0x4b, 0xb7, 0x2e, 0x4f, 0x4a,
+	0x3b, 0xc3, 0xe2, 0x07, 0xb8, 0x1b, 0x11, 0x15, 0x73, 0xdf, 0x9e, 0xff, 0xa9, 0xb1, 0x74, 0x77,
+	0xd1, 0x32, 0x87, 0x47, 0x98, 0x93, 0x16, 0x8e, 0x59, 0x9f, 0x9a, 0xd0, 0x2e, 0xee, 0x5e, 0x7c,
+	0xfc, 0x6c, 0x6b, 0xee, 0xe9, 0xb3, 0xad, 0xff, 0x05, 0x4c, 0xc4, 0x4c, 0x08, 0x72, 0x54, 0x8f,
+	0x58, 0x23, 0xc6, 0x32, 0xac, 0xef, 0x53, 0xe9, 0x97, 0x8d, 0xcd, 0x8e, 0x36, 0x51, 0xef, 0xb2,
+	0x67, 0x48, 0x76, 0x04, 0xb4, 0x52, 0xd2, 0x41, 0x59, 0x32, 0xb2, 0x43, 0x25, 0xf2, 0x7e, 0x73,
+	0xd0, 0x45, 0x0d, 0xdc, 0x01, 0xc8, 0xfb, 0x59, 0x0a, 0x82, 0x70, 0xdf, 0x43, 0xe7, 0x06, 0x89,
+	0x93, 0x29, 0x69, 0x4d, 0x62, 0xad, 0xa5, 0x1b, 0x09, 0x63, 0xa7, 0x91, 0x3c, 0x37, 0x9d, 0xe4,
+	0x37, 0xd0, 0x3a, 0xeb, 0x81, 0x51, 0x07, 0x19, 0x8e, 0xe5, 0x85, 0x9b, 0xec, 0xed, 0xc9, 0x70,
+	0x24, 0x2d, 0x46, 0xe3, 0x59, 0x18, 0x8f, 0xa7, 0xf7, 0x6d, 0x92, 0xb8, 0x86, 0x0d, 0x4d, 0x46,
+	0x1f, 0x46, 0x3c, 0x3e, 0x15, 0x1e, 0xfc, 0xf7, 0x2c, 0xf6, 0x7e, 0xcc, 0xa1, 0x35, 0x0b, 0x31,
+	0x25, 0x87, 0x4c, 0x73, 0xfc, 0xd5, 0xbe, 0x5c, 0x41, 0x2b, 0xcc, 0x66, 0xa3, 0x49, 0x5c, 0xeb,
+	0x4d, 0x39, 0x91, 0xaa, 0xd4, 0x75, 0x37, 0x50, 0x49, 0x00, 0x25, 0xc0, 0xad, 0x03, 0x76, 0xe5,
+	0x6e, 0xa2, 0x05, 0x0e, 0x01, 0x44, 0x03, 0xe0, 0x1a, 0x9f, 0x45, 0x3f, 0x5d, 0xbb, 0x1f, 0xa3,
+	0xd2, 0x4b, 0x94, 0x6a, 0x58, 0x4a, 0x5d, 0xed, 0x44, 0x32, 0xec, 0xb7, 0xeb, 0x01, 0x8b, 0x1b,
+	0x86, 0x5d, 0xf6, 0xcf, 0x75, 0x41, 0x8e, 0x6c, 0xf7, 0x69, 0xb2, 0x88, 0xfa, 0xd6, 0xdc, 0xbd,
+	0x87, 0x90, 0xcd, 0xe6, 0x87, 0x00, 0x95, 0xd2, 0xeb, 0x1d, 0xb6, 0x68, 0x8e, 0xf8, 0x08, 0xc0,
+	0xfb, 0xc6, 0x41, 0xe7, 0x34, 0x50, 0x36, 0x60, 0x33, 0x96, 0xdb, 0xb1, 0x2a, 0x99, 0xcb, 0x54,
+	0xc9, 0xd7, 0x88, 0x99, 0x44, 0xeb, 0xe3, 0xed, 0xf5, 0x01, 0x93, 0xa0, 0xee, 0xd2, 0x7d, 0xdf,
+	0xde, 0x65, 0x9d, 0xd1, 0x22, 0x73, 0x57, 0xb6, 0xc1, 0xe5, 0xa6, 0x34, 0xb8, 0x01, 0x93, 0x69,
+	0xd8, 0xcc, 0xc2, 0xfb, 0x3e, 0x6f, 0x01, 0xb8, 0x03, 0x3d, 0x26, 0x22, 0xa9, 0x3b, 0xf3, 0x4c,
+	0x00, 0x8c, 0x3a, 0x95, 0xcb, 0x38, 0x75, 0x09, 0x95, 0x8d, 0xc2, 0x4b, 0xb5, 0xcb, 0x18, 0xd9,
+	0xd2, 0x35, 0x63, 0x63, 0xbe, 0x8a, 0x56, 0x41, 0x86, 0xc0, 0xa1, 0x1f, 0xb7, 0x2c, 0xf1, 0x8a,
+	0xa6, 0xd2, 0x27, 0xe2, 0x03, 0x2d, 0x55, 0x8a, 0x26, 0xde, 0xad, 0x94, 0x87, 0xa6, 0xfa, 0xac,
+	0x18, 0xb1, 0x6f, 0xa5, 0xea, 0x62, 0x5d, 0x9c, 0x4e, 0x5a, 0xc7, 0xbc, 0xd6, 0x5b, 0xd6, 0xd2,
+	0xb4, 0x73, 0xbc, 0x9f, 0x92, 0x76, 0x61, 0x96, 0x3a, 0x98, 0x50, 0x74, 0x5a, 0xe8, 0x17, 0xa7,
+	0xd7, 0x23, 0x17, 0x15, 0x08, 0x96, 0xb8, 0x82, 0xb4, 0x8a, 0xfe, 0xed, 0xfd, 0x90, 0xb3, 0x05,
+	0x25, 0x1d, 0x3a, 0xce, 0x5c, 0x64, 0xc6, 0xb2, 0xa0, 0x98, 0xc9, 0x82, 0x2c, 0xd0, 0xa5, 0x49,
+	0x40, 0x4f, 0x43, 0x6c, 0x7e, 0x7a, 0xb2, 0xfc, 0x9d, 0x43, 0xff, 0xd7, 0xe8, 0xec, 0xf9, 0xcd,
+	0x5b, 0x37, 0xee, 0x40, 0xaf, 0xcb, 0x86, 0x40, 0xce, 0x1e, 0x44, 0x97, 0x50, 0xd9, 0x72, 0xd2,
+	0x8c, 0x67, 0x86, 0xb9, 0x4b, 0x46, 0x76, 0x47, 0x89, 0x66, 0x05, 0xc9, 0x45, 0x05, 0x8a, 0x63,
+	0xb0, 0xa0, 0xe8, 0xdf, 0xba, 0x14, 0x0f, 0xe3, 0x36, 0xeb, 0x1a, 0x86, 0xfa, 0x76, 0xa5, 0x4a,
+	0x31, 0x81, 0x20, 0x8a, 0x71, 0xd7, 0xd0, 0xae, 0xe0, 0xa7, 0xeb, 0xa9, 0x60, 0xa3, 0xe9, 0x60,
+	0xff, 0x94, 0x47, 0x1b, 0x99, 0x49, 0xe7, 0x4c, 0x62, 0xfd, 0x52, 0x2b, 0x2d, 0x66, 0x5b, 0x69,
+	0x76, 0x5a, 0x2a, 0x9d, 0xde, 0xb4, 0x34, 0xff, 0xe6, 0xd3, 0xd2, 0x42, 0x66, 0x5a, 0x7a, 0x8d,
+	0x72, 0xe2, 0x7d, 0x68, 0xc3, 0x65, 0xa6, 0xe9, 0xd1, 0x11, 0x20, 0xdb, 0xe1, 0x9d, 0x6c, 0x87,
+	0x57, 0x4d, 0x71, 0xcb, 0x4c, 0x0f, 0xfd, 0x76, 0x1c, 0xc9, 0x5d, 0x4c, 0x0e, 0xa2, 0x0e, 0xc5,
+	0xb2, 0xcf, 0x61, 0x6f, 0x10, 0x11, 0x50, 0x48, 0xbe, 0x8b, 0xce, 0xb5, 0x31, 0xd1, 0xd3, 0x93,
+	0x48, 0x36, 0xed, 0x88, 0xb6, 0xda, 0xc6, 0x64, 0x4f, 0x86, 0xa9, 0x8d, 0xfb, 0x01, 0xba, 0x90,
+	0xd1, 0x6d, 0x89, 0x7e, 0xfb, 0x2b, 0x48, 0xa7, 0xf6, 0x8d, 0x31, 0x9b, 0x03, 0xb3, 0xeb, 0xfd,
+	0xee, 0xa0, 0xf3, 0x09, 0xf5, 0x4c, 0x18, 0x0e, 0xba, 0x58, 0xcc, 0xf6, 0x41, 0xd4, 0x63, 0x8f,
+	0x80, 0xeb, 0xf3, 0xf3, 0xbe, 0x59, 0xa8, 0x84, 0xe1, 0x80, 0x05, 0xa3, 0xc9, 0xec, 0x62, 0x56,
+	0x6a, 0xe0, 0x0c, 0x18, 0x15, 0x40, 0x45, 0x5f, 0xa4, 0x08, 0x9b, 0x21, 0x66, 0x2d, 0xdd, 0x48,
+	0xaa, 0xf5, 0x35, 0xb4, 0x96, 0x4e, 0x8f, 0x89, 0xae, 0xc9, 0xeb, 0xd5, 0x44, 0x9e, 0xa8, 0x56,
+	0xd0, 0x7c, 0xcc, 0x68, 0x74, 0x94, 0xb6, 0xa2, 0x64, 0xe9, 0x51, 0x0b, 0x71, 0x53, 0xf1, 0x00,
+	0x78, 0x0f, 0x73, 0x39, 0xbc, 0x0b, 0x98, 0x00, 0xdf, 0x09, 0x02, 0xe8, 0xc9, 0x59, 0xbe, 0x81,
+	0x36, 0x50, 0xc9, 0x26, 0x8d, 0x49, 0x2b, 0xbb, 0x52, 0xa5, 0x22, 0xc4, 0x22, 0xb4, 0x6f, 0xd4,
+	0xbf, 0xbd, 0x5f, 0x73, 0x68, 0x7d, 0xb4, 0xcf, 0xdb, 0x66, 0x48, 0x4e, 0x21, 0x83, 0x27, 0x34,
+	0xe8, 0xfc, 0xac, 0x0d, 0xba, 0x30, 0x63, 0x83, 0x2e, 0x4e, 0x2a, 0x89, 0x27, 0x53, 0x65, 0xe9,
+	0xcd, 0xa6, 0xca, 0xdb, 0x68, 0x43, 0xa8, 0x17, 0x4a, 0xd6, 0x0a, 0x58, 0x1c, 0xf7, 0x69, 0x24,
+	0x87, 0xad, 0x1e, 0x63, 0x5d, 0x9d, 0xd3, 0x0b, 0xfe, 0x79, 0xb5, 0x7b, 0xc8, 0x9a, 0xc9, 0xde,
+	0x67, 0x8c, 0x75, 0xbd, 0xa7, 0x13, 0xbf, 0x60, 0xf7, 0x8e, 0x21, 0xe8, 0xcf, 0x14, 0xbd, 0xec,
+	0x23, 0x73, 0x93, 0x1e, 0x39, 0xd6, 0x64, 0xf3, 0x99, 0x26, 0x3b, 0xfe, 0xad, 0x5d, 0x18, 0xff,
+	0xd6, 0x9e, 0x5a, 0x42, 0x8a, 0x53, 0x4b, 0xc8, 0x6e, 0xf3, 0xf1, 0xf3, 0xaa, 0xf3, 0xe4, 0x79,
+	0xd5, 0xf9, 0xf3, 0x79, 0xd5, 0xf9, 0xee, 0x45, 0x75, 0xee, 0xc9, 0x8b, 0xea, 0xdc, 0x1f, 0x2f,
+	0xaa, 0x73, 0x5f, 0x5e, 0x33, 0x95, 0xf2, 0x7a, 0xc0, 0x38, 0x34, 0x92, 0xdf, 0xaa, 0xb8, 0x34,
+	0x8e, 0x4f, 0xfe, 0x75, 0xa4, 0x31, 0x6e, 0x97, 0xf4, 0x3f, 0x8e, 0x6e, 0xff, 0x3b, 0x00, 0xd8,
+	0xbc, 0x3a, 0x59, 0xcb, 0x12, 0x00, 0x00,
 }
 
 func (m *EventAttestationObserved) Marshal() (dAtA []byte, err error) {
@@ -2380,92 +2613,271 @@ func (m *EventValidatorSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventCounterpartyHeaderAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventAttestationObserved) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventCounterpartyHeaderAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCounterpartyHeaderAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AttestationType != 0 {
-		n += 1 + sovEvents(uint64(m.AttestationType))
-	}
-	l = len(m.BridgeContract)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovEvents(uint64(m.BridgeChainId))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.AttestationId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovEvents(uint64(m.Nonce))
+	if m.HyperionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HyperionId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBridgeWithdrawCanceled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BridgeContract)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovEvents(uint64(m.BridgeChainId))
+func (m *EventDepositReceived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventOutgoingBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventDepositReceived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDepositReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HyperionId != 0 {
-		n += 1 + sovEvents(uint64(m.HyperionId))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovEvents(uint64(m.BatchNonce))
-	}
-	if m.BatchTimeout != 0 {
-		n += 1 + sovEvents(uint64(m.BatchTimeout))
+	if m.SentToCommunityPool {
+		i--
+		if m.SentToCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.BatchTxIds) > 0 {
-		l = 0
-		for _, e := range m.BatchTxIds {
-			l += sovEvents(uint64(e))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovEvents(uint64(l)) + l
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
-}
-
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HyperionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HyperionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOutgoingBatchExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutgoingBatchExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutgoingBatchExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BatchTxIds) > 0 {
+		dAtA4 := make([]byte, len(m.BatchTxIds)*10)
+		var j3 int
+		for _, num := range m.BatchTxIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvents(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HyperionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HyperionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventAttestationObserved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AttestationType != 0 {
+		n += 1 + sovEvents(uint64(m.AttestationType))
+	}
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovEvents(uint64(m.BridgeChainId))
+	}
+	l = len(m.AttestationId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *EventBridgeWithdrawCanceled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovEvents(uint64(m.BridgeChainId))
+	}
+	return n
+}
+
+func (m *EventOutgoingBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HyperionId != 0 {
+		n += 1 + sovEvents(uint64(m.HyperionId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovEvents(uint64(m.BatchNonce))
+	}
+	if m.BatchTimeout != 0 {
+		n += 1 + sovEvents(uint64(m.BatchTimeout))
+	}
+	if len(m.BatchTxIds) > 0 {
+		l = 0
+		for _, e := range m.BatchTxIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *EventOutgoingBatchCanceled) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2853,6 +3265,87 @@ func (m *EventValidatorSlash) Size() (n int) {
 	return n
 }
 
+func (m *EventCounterpartyHeaderAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HyperionId != 0 {
+		n += 1 + sovEvents(uint64(m.HyperionId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDepositReceived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HyperionId != 0 {
+		n += 1 + sovEvents(uint64(m.HyperionId))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovEvents(uint64(m.EventNonce))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SentToCommunityPool {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventOutgoingBatchExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HyperionId != 0 {
+		n += 1 + sovEvents(uint64(m.HyperionId))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovEvents(uint64(m.BatchNonce))
+	}
+	if len(m.BatchTxIds) > 0 {
+		l = 0
+		for _, e := range m.BatchTxIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6075,6 +6568,592 @@ func (m *EventValidatorSlash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCounterpartyHeaderAccepted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCounterpartyHeaderAccepted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCounterpartyHeaderAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionId", wireType)
+			}
+			m.HyperionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HyperionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDepositReceived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDepositReceived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDepositReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionId", wireType)
+			}
+			m.HyperionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HyperionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentToCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SentToCommunityPool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutgoingBatchExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutgoingBatchExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutgoingBatchExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionId", wireType)
+			}
+			m.HyperionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HyperionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BatchTxIds = append(m.BatchTxIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BatchTxIds) == 0 {
+					m.BatchTxIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BatchTxIds = append(m.BatchTxIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTxIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -130,6 +130,21 @@ var (
 	WhitelistKey = []byte{0x24}
 
 	SkippedNonceKey = []byte{0x25}
+
+	// OutboundRateLimitUsageKey indexes the amounts sent to a counterparty chain by token and time bucket
+	OutboundRateLimitUsageKey = []byte{0x26}
+
+	// CounterpartyHeaderKey indexes the counterparty headers accepted by the orchestrators
+	// [0x27][hyperionId][height]
+	CounterpartyHeaderKey = []byte{0x27}
+
+	// CounterpartyHeaderVoteKey indexes the orchestrators votes for counterparty headers
+	// [0x28][hyperionId][height][hash][validator]
+	CounterpartyHeaderVoteKey = []byte{0x28}
+
+	// PendingClaimSlashingKey indexes the attestations of the observed event nonces waiting for the claim slashing
+	// [0x29][hyperionId][nonce][claimHash]
+	PendingClaimSlashingKey = []byte{0x29}
 )
 
 var (
@@ -467,3 +482,61 @@ func GetWhitelistKey(hyperionId uint64) []byte {
 	buf = append(buf, UInt64Bytes(hyperionId)...)
 	return buf
 }
+
+func GetOutboundRateLimitUsagePrefix(hyperionId uint64, tokenContract common.Address) []byte {
+	buf := make([]byte, 0, len(OutboundRateLimitUsageKey)+8+common.AddressLength)
+	buf = append(buf, OutboundRateLimitUsageKey...)
+	buf = append(buf, UInt64Bytes(hyperionId)...)
+	buf = append(buf, tokenContract.Bytes()...)
+	return buf
+}
+
+// GetOutboundRateLimitUsageKey returns the key of the amount sent in the time bucket starting at bucketStart (unix seconds)
+func GetOutboundRateLimitUsageKey(hyperionId uint64, tokenContract common.Address, bucketStart uint64) []byte {
+	return append(GetOutboundRateLimitUsagePrefix(hyperionId, tokenContract), UInt64Bytes(bucketStart)...)
+}
+
+func GetCounterpartyHeaderPrefix(hyperionId uint64) []byte {
+	buf := make([]byte, 0, len(CounterpartyHeaderKey)+8)
+	buf = append(buf, CounterpartyHeaderKey...)
+	buf = append(buf, UInt64Bytes(hyperionId)...)
+	return buf
+}
+
+func GetCounterpartyHeaderKey(hyperionId uint64, height uint64) []byte {
+	return append(GetCounterpartyHeaderPrefix(hyperionId), UInt64Bytes(height)...)
+}
+
+func GetCounterpartyHeaderVotePrefix(hyperionId uint64) []byte {
+	buf := make([]byte, 0, len(CounterpartyHeaderVoteKey)+8)
+	buf = append(buf, CounterpartyHeaderVoteKey...)
+	buf = append(buf, UInt64Bytes(hyperionId)...)
+	return buf
+}
+
+func GetCounterpartyHeaderHeightVotesPrefix(hyperionId uint64, height uint64) []byte {
+	return append(GetCounterpartyHeaderVotePrefix(hyperionId), UInt64Bytes(height)...)
+}
+
+func GetCounterpartyHeaderVotesPrefix(hyperionId uint64, height uint64, hash common.Hash) []byte {
+	return append(GetCounterpartyHeaderHeightVotesPrefix(hyperionId, height), hash.Bytes()...)
+}
+
+func GetCounterpartyHeaderVoteKey(hyperionId uint64, height uint64, hash common.Hash, validator sdk.ValAddress) []byte {
+	return append(GetCounterpartyHeaderVotesPrefix(hyperionId, height, hash), validator.Bytes()...)
+}
+
+func GetPendingClaimSlashingPrefix(hyperionId uint64) []byte {
+	buf := make([]byte, 0, len(PendingClaimSlashingKey)+8)
+	buf = append(buf, PendingClaimSlashingKey...)
+	buf = append(buf, UInt64Bytes(hyperionId)...)
+	return buf
+}
+
+func GetPendingClaimSlashingNoncePrefix(hyperionId uint64, nonce uint64) []byte {
+	return append(GetPendingClaimSlashingPrefix(hyperionId), UInt64Bytes(nonce)...)
+}
+
+func GetPendingClaimSlashingKey(hyperionId uint64, nonce uint64, claimHash []byte) []byte {
+	return append(GetPendingClaimSlashingNoncePrefix(hyperionId, nonce), claimHash...)
+}
@@ -27,6 +27,7 @@ var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgBlacklistAddresses{}
 	_ sdk.Msg = &MsgRevokeBlacklist{}
+	_ sdk.Msg = &MsgSubmitCounterpartyHeader{}
 )
 
 func (m *MsgUpdateParams) Route() string { return RouterKey }
@@ -173,8 +174,8 @@ func (msg MsgSendToChain) ValidateBasic() error {
 	if !msg.BridgeFee.IsValid() || msg.BridgeFee.IsZero() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
 	}
-	if err := ValidateEthAddress(msg.Dest); err != nil {
-		return errors.Wrap(err, "ethereum address")
+	if err := ValidateChainAddress(msg.Dest); err != nil {
+		return errors.Wrap(err, "destination address")
 	}
 	return nil
 }
@@ -368,7 +369,7 @@ func (msg *MsgDepositClaim) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.CosmosReceiver); err != nil {
 		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosReceiver)
 	}
-	if err := ValidateEthAddress(msg.EthereumSender); err != nil {
+	if err := ValidateChainAddress(msg.EthereumSender); err != nil {
 		return errors.Wrap(err, "eth sender")
 	}
 	if err := ValidateEthAddress(msg.TokenContract); err != nil {
@@ -738,3 +739,35 @@ func (m *MsgRevokeBlacklist) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Signer)
 	return []sdk.AccAddress{addr}
 }
+
+// Route should return the name of the module
+func (msg MsgSubmitCounterpartyHeader) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSubmitCounterpartyHeader) Type() string { return "submit_counterparty_header" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgSubmitCounterpartyHeader) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	if _, err := DecodeCounterpartyHeader(msg.Header); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubmitCounterpartyHeader) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitCounterpartyHeader) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
@@ -877,6 +877,8 @@ type MsgDepositClaim struct {
 	Data           string                `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
 	TxHash         string                `protobuf:"bytes,10,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 	RpcUsed        string                `protobuf:"bytes,11,opt,name=rpc_used,json=rpcUsed,proto3" json:"rpc_used,omitempty"`
+	// required when the claim_verification_mode of the chain is receipt_proof
+	ReceiptProof *ReceiptProof `protobuf:"bytes,12,opt,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
 }
 
 func (m *MsgDepositClaim) Reset()         { *m = MsgDepositClaim{} }
@@ -982,6 +984,13 @@ func (m *MsgDepositClaim) GetRpcUsed() string {
 	return ""
 }
 
+func (m *MsgDepositClaim) GetReceiptProof() *ReceiptProof {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
 type MsgDepositClaimResponse struct {
 }
 
@@ -1029,6 +1038,8 @@ type MsgWithdrawClaim struct {
 	Orchestrator  string `protobuf:"bytes,6,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	TxHash        string `protobuf:"bytes,7,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 	RpcUsed       string `protobuf:"bytes,8,opt,name=rpc_used,json=rpcUsed,proto3" json:"rpc_used,omitempty"`
+	// required when the claim_verification_mode of the chain is receipt_proof
+	ReceiptProof *ReceiptProof `protobuf:"bytes,9,opt,name=receipt_proof,json=receiptProof,proto3" json:"receipt_proof,omitempty"`
 }
 
 func (m *MsgWithdrawClaim) Reset()         { *m = MsgWithdrawClaim{} }
@@ -1120,6 +1131,13 @@ func (m *MsgWithdrawClaim) GetRpcUsed() string {
 	return ""
 }
 
+func (m *MsgWithdrawClaim) GetReceiptProof() *ReceiptProof {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
 type MsgWithdrawClaimResponse struct {
 }
 
@@ -5869,6 +5887,300 @@ func (m *MsgCleanAllSkippedTxsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCleanAllSkippedTxsResponse proto.InternalMessageInfo
 
+type MsgSetOutboundRateLimit struct {
+	Signer    string            `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId   uint64            `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	RateLimit OutboundRateLimit `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *MsgSetOutboundRateLimit) Reset()         { *m = MsgSetOutboundRateLimit{} }
+func (m *MsgSetOutboundRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOutboundRateLimit) ProtoMessage()    {}
+func (*MsgSetOutboundRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a72024d09ffd28, []int{110}
+}
+func (m *MsgSetOutboundRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOutboundRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOutboundRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOutboundRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOutboundRateLimit.Merge(m, src)
+}
+func (m *MsgSetOutboundRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOutboundRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOutboundRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOutboundRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetOutboundRateLimit) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSetOutboundRateLimit) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgSetOutboundRateLimit) GetRateLimit() OutboundRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return OutboundRateLimit{}
+}
+
+type MsgSetOutboundRateLimitResponse struct {
+}
+
+func (m *MsgSetOutboundRateLimitResponse) Reset()         { *m = MsgSetOutboundRateLimitResponse{} }
+func (m *MsgSetOutboundRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOutboundRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetOutboundRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a72024d09ffd28, []int{111}
+}
+func (m *MsgSetOutboundRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOutboundRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOutboundRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOutboundRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOutboundRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetOutboundRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOutboundRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOutboundRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOutboundRateLimitResponse proto.InternalMessageInfo
+
+type MsgRemoveOutboundRateLimit struct {
+	Signer       string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ChainId      uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenAddress string `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+}
+
+func (m *MsgRemoveOutboundRateLimit) Reset()         { *m = MsgRemoveOutboundRateLimit{} }
+func (m *MsgRemoveOutboundRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOutboundRateLimit) ProtoMessage()    {}
+func (*MsgRemoveOutboundRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a72024d09ffd28, []int{112}
+}
+func (m *MsgRemoveOutboundRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOutboundRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOutboundRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOutboundRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOutboundRateLimit.Merge(m, src)
+}
+func (m *MsgRemoveOutboundRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOutboundRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOutboundRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOutboundRateLimit proto.InternalMessageInfo
+
+func (m *MsgRemoveOutboundRateLimit) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRemoveOutboundRateLimit) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgRemoveOutboundRateLimit) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+type MsgRemoveOutboundRateLimitResponse struct {
+}
+
+func (m *MsgRemoveOutboundRateLimitResponse) Reset()         { *m = MsgRemoveOutboundRateLimitResponse{} }
+func (m *MsgRemoveOutboundRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveOutboundRateLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveOutboundRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a72024d09ffd28, []int{113}
+}
+func (m *MsgRemoveOutboundRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveOutboundRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveOutboundRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveOutboundRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveOutboundRateLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveOutboundRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveOutboundRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveOutboundRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveOutboundRateLimitResponse proto.InternalMessageInfo
+
+// MsgSubmitCounterpartyHeader is the vote of an orchestrator for a block header of
+// the counterparty chain, the header is stored once orchestrators with 66% of the
+// power have submitted it.
+//
+// header:
+// the RLP encoded block header
+type MsgSubmitCounterpartyHeader struct {
+	Orchestrator string `protobuf:"bytes,1,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	HyperionId   uint64 `protobuf:"varint,2,opt,name=hyperion_id,json=hyperionId,proto3" json:"hyperion_id,omitempty"`
+	Header       []byte `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *MsgSubmitCounterpartyHeader) Reset()         { *m = MsgSubmitCounterpartyHeader{} }
+func (m *MsgSubmitCounterpartyHeader) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCounterpartyHeader) ProtoMessage()    {}
+func (*MsgSubmitCounterpartyHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a72024d09ffd28, []int{114}
+}
+func (m *MsgSubmitCounterpartyHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCounterpartyHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCounterpartyHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitCounterpartyHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCounterpartyHeader.Merge(m, src)
+}
+func (m *MsgSubmitCounterpartyHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCounterpartyHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCounterpartyHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitCounterpartyHeader proto.InternalMessageInfo
+
+func (m *MsgSubmitCounterpartyHeader) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *MsgSubmitCounterpartyHeader) GetHyperionId() uint64 {
+	if m != nil {
+		return m.HyperionId
+	}
+	return 0
+}
+
+func (m *MsgSubmitCounterpartyHeader) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type MsgSubmitCounterpartyHeaderResponse struct {
+}
+
+func (m *MsgSubmitCounterpartyHeaderResponse) Reset()         { *m = MsgSubmitCounterpartyHeaderResponse{} }
+func (m *MsgSubmitCounterpartyHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCounterpartyHeaderResponse) ProtoMessage()    {}
+func (*MsgSubmitCounterpartyHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4a72024d09ffd28, []int{115}
+}
+func (m *MsgSubmitCounterpartyHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCounterpartyHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCounterpartyHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitCounterpartyHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCounterpartyHeaderResponse.Merge(m, src)
+}
+func (m *MsgSubmitCounterpartyHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCounterpartyHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCounterpartyHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitCounterpartyHeaderResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetOrchestratorAddresses)(nil), "helios.hyperion.v1.MsgSetOrchestratorAddresses")
 	proto.RegisterType((*MsgSetOrchestratorAddressesResponse)(nil), "helios.hyperion.v1.MsgSetOrchestratorAddressesResponse")
@@ -5980,323 +6292,341 @@ func init() {
 	proto.RegisterType((*MsgCleanSkippedTxsResponse)(nil), "helios.hyperion.v1.MsgCleanSkippedTxsResponse")
 	proto.RegisterType((*MsgCleanAllSkippedTxs)(nil), "helios.hyperion.v1.MsgCleanAllSkippedTxs")
 	proto.RegisterType((*MsgCleanAllSkippedTxsResponse)(nil), "helios.hyperion.v1.MsgCleanAllSkippedTxsResponse")
+	proto.RegisterType((*MsgSetOutboundRateLimit)(nil), "helios.hyperion.v1.MsgSetOutboundRateLimit")
+	proto.RegisterType((*MsgSetOutboundRateLimitResponse)(nil), "helios.hyperion.v1.MsgSetOutboundRateLimitResponse")
+	proto.RegisterType((*MsgRemoveOutboundRateLimit)(nil), "helios.hyperion.v1.MsgRemoveOutboundRateLimit")
+	proto.RegisterType((*MsgRemoveOutboundRateLimitResponse)(nil), "helios.hyperion.v1.MsgRemoveOutboundRateLimitResponse")
+	proto.RegisterType((*MsgSubmitCounterpartyHeader)(nil), "helios.hyperion.v1.MsgSubmitCounterpartyHeader")
+	proto.RegisterType((*MsgSubmitCounterpartyHeaderResponse)(nil), "helios.hyperion.v1.MsgSubmitCounterpartyHeaderResponse")
 }
 
 func init() { proto.RegisterFile("helios/hyperion/v1/msgs.proto", fileDescriptor_b4a72024d09ffd28) }
 
 var fileDescriptor_b4a72024d09ffd28 = []byte{
-	// 4965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0x6f, 0x6c, 0x1c, 0x49,
-	0x56, 0xdf, 0xb6, 0x1d, 0x3b, 0x2e, 0x27, 0xf1, 0xa6, 0xd7, 0x1b, 0x8f, 0x3b, 0x89, 0x9d, 0xb4,
-	0x13, 0xff, 0x8d, 0x67, 0x1c, 0xe7, 0xdf, 0x66, 0x76, 0x37, 0x27, 0xdb, 0x49, 0xb8, 0xc0, 0x7a,
-	0xb3, 0x1a, 0x67, 0x6f, 0x25, 0xbe, 0xb4, 0x7a, 0xa6, 0xcb, 0x33, 0x7d, 0xe9, 0xe9, 0x1e, 0xba,
-	0x7b, 0x6c, 0xe7, 0xf8, 0x70, 0xc7, 0x1e, 0x12, 0xa7, 0x03, 0x89, 0x93, 0x38, 0xc4, 0x21, 0x21,
-	0x1d, 0xab, 0x03, 0xc4, 0x07, 0x90, 0xf6, 0x24, 0x10, 0xe8, 0xa4, 0xfb, 0x72, 0x07, 0x68, 0x8f,
-	0x4f, 0x0b, 0x88, 0x3f, 0x3a, 0x89, 0x13, 0xec, 0x22, 0x56, 0x7c, 0x42, 0x88, 0xaf, 0x20, 0x50,
-	0xfd, 0xe9, 0x9a, 0xea, 0xee, 0xaa, 0x9a, 0xf6, 0xc4, 0xbb, 0xba, 0x2f, 0x91, 0xa7, 0xea, 0xbd,
-	0xaa, 0xdf, 0x7b, 0xf5, 0xaa, 0xea, 0xbd, 0x57, 0xaf, 0x03, 0x2e, 0xb6, 0xa0, 0xe7, 0x06, 0x51,
-	0xa5, 0xf5, 0xac, 0x03, 0x43, 0x37, 0xf0, 0x2b, 0xfb, 0xd7, 0x2b, 0xed, 0xa8, 0x19, 0x95, 0x3b,
-	0x61, 0x10, 0x07, 0xba, 0x4e, 0xba, 0xcb, 0x49, 0x77, 0x79, 0xff, 0xba, 0x31, 0xdb, 0x08, 0xa2,
-	0x76, 0x10, 0x55, 0xea, 0x76, 0x04, 0x2b, 0xfb, 0xd7, 0xeb, 0x30, 0xb6, 0xaf, 0x57, 0x1a, 0x81,
-	0xeb, 0x13, 0x1e, 0x63, 0xaa, 0x19, 0x34, 0x03, 0xfc, 0x67, 0x05, 0xfd, 0x45, 0x5b, 0x2f, 0x34,
-	0x83, 0xa0, 0xe9, 0xc1, 0x8a, 0xdd, 0x71, 0x2b, 0xb6, 0xef, 0x07, 0xb1, 0x1d, 0xbb, 0x81, 0x4f,
-	0xe7, 0x31, 0x66, 0x05, 0x30, 0xe2, 0x67, 0x1d, 0x98, 0xf4, 0xcf, 0x09, 0xfa, 0x3b, 0x76, 0x68,
-	0xb7, 0x13, 0x82, 0x19, 0x3a, 0x3c, 0xfe, 0x55, 0xef, 0xee, 0x55, 0x6c, 0xff, 0x19, 0xed, 0x9a,
-	0xa6, 0x78, 0xdb, 0x51, 0x93, 0x4a, 0x97, 0xf0, 0x90, 0x0e, 0x8b, 0x60, 0x25, 0x3f, 0x68, 0xd7,
-	0x59, 0xbb, 0xed, 0xfa, 0x41, 0x05, 0xff, 0x4b, 0x9a, 0xcc, 0xbf, 0xd1, 0xc0, 0xf9, 0x9d, 0xa8,
-	0xb9, 0x0b, 0xe3, 0xc7, 0x61, 0xa3, 0x05, 0xa3, 0x38, 0xb4, 0xe3, 0x20, 0xdc, 0x74, 0x9c, 0x10,
-	0x46, 0x11, 0x8c, 0xf4, 0x73, 0x60, 0x34, 0x82, 0xbe, 0x03, 0xc3, 0x92, 0x76, 0x49, 0x5b, 0x1a,
-	0xaf, 0xd1, 0x5f, 0xba, 0x09, 0x4e, 0x05, 0x1c, 0x43, 0x69, 0x08, 0xf7, 0xa6, 0xda, 0xf4, 0x39,
-	0x30, 0x01, 0xe3, 0x96, 0x65, 0x93, 0xc1, 0x4a, 0xc3, 0x98, 0x04, 0xc0, 0xb8, 0x45, 0x87, 0x47,
-	0x04, 0x89, 0xe8, 0x96, 0xeb, 0x94, 0x46, 0x2e, 0x69, 0x4b, 0x23, 0x35, 0x90, 0x34, 0x3d, 0x72,
-	0xaa, 0x37, 0xdf, 0xfd, 0xe4, 0xfd, 0x15, 0x3a, 0xe5, 0xd7, 0x3f, 0x79, 0x7f, 0xe5, 0x0a, 0xd3,
-	0x94, 0x02, 0xb3, 0x79, 0x15, 0xcc, 0x2b, 0xba, 0x6b, 0x30, 0xea, 0x04, 0x7e, 0x04, 0xcd, 0x7f,
-	0xd6, 0xc0, 0x8b, 0x3b, 0x51, 0xf3, 0x0b, 0xb6, 0x17, 0xc1, 0x78, 0x3b, 0xf0, 0xf7, 0xdc, 0xb0,
-	0x9d, 0x85, 0xa4, 0x65, 0x21, 0xe9, 0x53, 0xe0, 0x84, 0x1f, 0xf8, 0x0d, 0x88, 0x25, 0x1e, 0xa9,
-	0x91, 0x1f, 0x39, 0x75, 0x0c, 0xf7, 0x57, 0xc7, 0x48, 0x4e, 0x1d, 0x17, 0xc0, 0x78, 0xe4, 0x36,
-	0x7d, 0x3b, 0xee, 0x86, 0xb0, 0x74, 0x02, 0x77, 0xf7, 0x1a, 0xaa, 0x15, 0xa4, 0x8b, 0xd4, 0x88,
-	0x48, 0x23, 0x33, 0xbc, 0x46, 0x52, 0xa2, 0x98, 0x06, 0x28, 0x65, 0xdb, 0x98, 0xec, 0xef, 0x0e,
-	0x81, 0x33, 0x58, 0x47, 0xbe, 0xf3, 0x24, 0xd8, 0x6e, 0xd9, 0xae, 0xaf, 0x58, 0xe9, 0xd3, 0x0e,
-	0x8c, 0x62, 0xab, 0x81, 0xa8, 0x90, 0x4e, 0x88, 0xe0, 0x13, 0xa8, 0x11, 0x73, 0x3e, 0x72, 0x74,
-	0x1d, 0x8c, 0xa0, 0x9f, 0x54, 0x6c, 0xfc, 0xb7, 0x7e, 0x07, 0x8c, 0xda, 0xed, 0xa0, 0xeb, 0xc7,
-	0x58, 0xd2, 0x89, 0x8d, 0x99, 0x32, 0xb5, 0x45, 0xb4, 0xc3, 0xca, 0x74, 0x87, 0x95, 0xb7, 0x03,
-	0xd7, 0xdf, 0x1a, 0xf9, 0xe0, 0x27, 0x73, 0x2f, 0xd4, 0x28, 0xb9, 0x7e, 0x0f, 0x80, 0x7a, 0xe8,
-	0x3a, 0x4d, 0x68, 0xed, 0x41, 0xa2, 0x87, 0x02, 0xcc, 0xe3, 0x84, 0xe5, 0x21, 0x84, 0xd5, 0xc5,
-	0x8c, 0xd1, 0x4c, 0xa7, 0x8d, 0x86, 0x49, 0x6c, 0x96, 0xc0, 0xb9, 0x74, 0x0b, 0x53, 0xcf, 0xef,
-	0x68, 0x60, 0x72, 0x27, 0x6a, 0xd6, 0xe0, 0x2f, 0x74, 0x61, 0x14, 0x6f, 0xd9, 0x71, 0xa3, 0xd5,
-	0xdf, 0x32, 0x8a, 0x6c, 0x89, 0x29, 0x70, 0xc2, 0x81, 0x7e, 0xd0, 0xa6, 0x9a, 0x22, 0x3f, 0xaa,
-	0x65, 0xe1, 0xd2, 0x96, 0x78, 0xdc, 0x3c, 0x14, 0x73, 0x06, 0x4c, 0x67, 0x9a, 0x18, 0xf2, 0x8f,
-	0x87, 0xc0, 0xc5, 0x4c, 0xdf, 0x3b, 0x6e, 0xdc, 0xda, 0x71, 0x7d, 0xb7, 0xdd, 0x6d, 0x3f, 0x84,
-	0xf0, 0x53, 0x94, 0x43, 0xff, 0x39, 0x70, 0xb6, 0x4d, 0x26, 0xb2, 0xea, 0x68, 0x66, 0xbc, 0x80,
-	0x05, 0x57, 0x7f, 0x92, 0x72, 0x62, 0xc8, 0x08, 0xe7, 0x03, 0x70, 0x26, 0x19, 0x2c, 0x3e, 0x3c,
-	0x8a, 0x29, 0x9c, 0xa2, 0x6c, 0x4f, 0x0e, 0xd1, 0x30, 0x2f, 0x83, 0xd1, 0xf8, 0xd0, 0x72, 0x9d,
-	0xa8, 0x34, 0x7a, 0x69, 0x18, 0x6d, 0xd8, 0xf8, 0xf0, 0x91, 0x13, 0x55, 0x5f, 0x13, 0xaa, 0x7c,
-	0x41, 0xa6, 0xf2, 0xb4, 0x0e, 0xcd, 0x45, 0x70, 0x55, 0x49, 0xc0, 0x96, 0xe3, 0x2b, 0x43, 0xd8,
-	0x90, 0xe8, 0xf6, 0x2b, 0x68, 0x48, 0xe2, 0x23, 0xe6, 0x2a, 0x38, 0x13, 0x07, 0x4f, 0xa1, 0x6f,
-	0x35, 0x02, 0x3f, 0x0e, 0xed, 0x46, 0xb2, 0xdb, 0x4e, 0xe3, 0xd6, 0x6d, 0xda, 0xa8, 0x5f, 0x04,
-	0xe8, 0x48, 0xb1, 0xd0, 0xb9, 0x01, 0x43, 0x7a, 0xc8, 0x8c, 0xc3, 0xb8, 0xb5, 0x8b, 0x1b, 0x72,
-	0x8b, 0x7b, 0x42, 0xb0, 0xb8, 0xa9, 0x73, 0x68, 0x34, 0x7b, 0x0e, 0x15, 0x30, 0x56, 0x5e, 0x5c,
-	0x6a, 0xac, 0x7c, 0x13, 0xaf, 0x9d, 0x99, 0x5e, 0xdf, 0x4e, 0xd7, 0x8b, 0xdd, 0x8e, 0x07, 0x31,
-	0x0d, 0x8c, 0xfa, 0xeb, 0x29, 0x2d, 0xea, 0x50, 0x3f, 0x51, 0x45, 0x67, 0xf2, 0x03, 0x30, 0x56,
-	0x27, 0xd3, 0x95, 0x46, 0x2e, 0x0d, 0x2f, 0x4d, 0x6c, 0xac, 0x96, 0xf3, 0xbe, 0x41, 0x19, 0x23,
-	0x7a, 0x13, 0xad, 0x02, 0x5a, 0xe6, 0xdd, 0x44, 0x15, 0xb5, 0x84, 0xb7, 0xfa, 0x8a, 0x50, 0x27,
-	0xa6, 0x40, 0x27, 0x19, 0x21, 0xcd, 0x79, 0x70, 0x59, 0xda, 0xc9, 0xf4, 0xf4, 0x83, 0x61, 0x6c,
-	0x45, 0xf7, 0x61, 0x27, 0x88, 0xdc, 0x78, 0xdb, 0xb3, 0xdd, 0x02, 0x17, 0x15, 0xba, 0x6e, 0xf6,
-	0xa1, 0x1f, 0x5b, 0xbc, 0x2d, 0x01, 0xdc, 0x84, 0x45, 0xd1, 0x2f, 0x83, 0x53, 0x75, 0x2f, 0x68,
-	0x3c, 0xb5, 0x5a, 0xd0, 0x6d, 0xb6, 0x88, 0x39, 0x8d, 0xd4, 0x26, 0x70, 0xdb, 0xe7, 0x71, 0x93,
-	0xc0, 0xe6, 0x46, 0x44, 0x36, 0x77, 0x8b, 0x1d, 0xf5, 0xd8, 0x9c, 0xb6, 0x2e, 0xa2, 0x7d, 0xf8,
-	0xe3, 0x9f, 0xcc, 0xbd, 0x4c, 0x76, 0x6a, 0xe4, 0x3c, 0x2d, 0xbb, 0x41, 0xa5, 0x6d, 0xc7, 0xad,
-	0xf2, 0x23, 0x3f, 0x66, 0x07, 0xfd, 0x22, 0x98, 0x84, 0x71, 0x0b, 0x86, 0xb0, 0xdb, 0xb6, 0xe8,
-	0xd5, 0x43, 0xac, 0xed, 0x4c, 0xd2, 0xbc, 0x4b, 0xae, 0xa0, 0x45, 0x30, 0x49, 0x9d, 0x9a, 0x10,
-	0x36, 0xa0, 0xbb, 0x0f, 0xc3, 0xd2, 0x18, 0x21, 0x24, 0xcd, 0x35, 0xda, 0x9a, 0x5b, 0xf2, 0x93,
-	0x82, 0x25, 0x47, 0x77, 0x95, 0x1d, 0xdb, 0xa5, 0x71, 0x7a, 0x57, 0xd9, 0xb1, 0xad, 0x4f, 0x83,
-	0xb1, 0xf8, 0xd0, 0x6a, 0xd9, 0x51, 0xab, 0x04, 0xc8, 0xe5, 0x17, 0x1f, 0x7e, 0xde, 0x8e, 0x5a,
-	0xfa, 0x0c, 0x38, 0x19, 0x76, 0x1a, 0x56, 0x37, 0x82, 0x4e, 0x69, 0x02, 0xf7, 0x8c, 0x85, 0x9d,
-	0xc6, 0xdb, 0x11, 0x74, 0x8a, 0xec, 0x03, 0x7e, 0xc1, 0xe8, 0x3e, 0xe0, 0x9b, 0xd8, 0xfa, 0x7e,
-	0x30, 0x84, 0x3d, 0x11, 0x64, 0x5c, 0x4e, 0x68, 0x1f, 0x7c, 0x86, 0x0b, 0x3c, 0x07, 0x26, 0xc8,
-	0x49, 0x4d, 0xc6, 0xa0, 0x1e, 0x58, 0x9d, 0xd9, 0xbb, 0xc0, 0x02, 0x4e, 0x88, 0x2c, 0x20, 0xab,
-	0xf8, 0x51, 0x81, 0xe2, 0x39, 0x25, 0x8f, 0x49, 0x95, 0x7c, 0x32, 0xad, 0xe4, 0x02, 0x4e, 0x4f,
-	0x4a, 0x6b, 0xd4, 0xe9, 0x49, 0xb5, 0x31, 0x35, 0xff, 0xf2, 0x30, 0x98, 0xda, 0x89, 0x9a, 0x0f,
-	0x0e, 0x63, 0x18, 0xfa, 0xb6, 0x77, 0xdf, 0x8e, 0xed, 0x82, 0xaa, 0xce, 0x6a, 0x72, 0x28, 0xaf,
-	0xc9, 0x19, 0x70, 0x32, 0x3e, 0xa4, 0x6a, 0x24, 0x8a, 0x1e, 0x8b, 0x0f, 0x89, 0x0e, 0xab, 0x60,
-	0x06, 0xd2, 0x39, 0x99, 0x1a, 0x33, 0x6e, 0xe0, 0x74, 0x42, 0x90, 0x68, 0x34, 0xf1, 0x09, 0x8b,
-	0x9c, 0xd7, 0x4b, 0xe0, 0xc5, 0x86, 0xed, 0x79, 0x16, 0x32, 0x65, 0x2b, 0x84, 0x51, 0xd7, 0x8b,
-	0x93, 0x8d, 0x84, 0xda, 0x91, 0x9c, 0x35, 0xdc, 0xaa, 0xdf, 0x00, 0xe7, 0xb2, 0x94, 0x16, 0x0c,
-	0xc3, 0x20, 0xd9, 0x4f, 0x2f, 0xa5, 0xe9, 0x1f, 0xa0, 0x2e, 0xd5, 0xf2, 0xdc, 0x10, 0x2e, 0xcf,
-	0x45, 0x7e, 0x79, 0x72, 0xda, 0x36, 0x67, 0xc1, 0x05, 0x51, 0x3b, 0x5b, 0xa6, 0xaf, 0x0e, 0x83,
-	0x97, 0x11, 0x41, 0x6d, 0x7b, 0x63, 0xfd, 0x3e, 0xec, 0x78, 0xc1, 0x33, 0xe8, 0x7c, 0x86, 0x5b,
-	0xe2, 0x32, 0x38, 0x45, 0x0f, 0x1b, 0xe2, 0xe1, 0x90, 0x05, 0x9a, 0x20, 0x6d, 0xf7, 0xb1, 0x9f,
-	0x53, 0x70, 0x53, 0xe8, 0x60, 0xc4, 0xb7, 0xdb, 0xc9, 0x15, 0x8a, 0xff, 0xc6, 0x5e, 0xf6, 0xb3,
-	0x76, 0x3d, 0xf0, 0x92, 0x3d, 0x40, 0x7e, 0xe9, 0x06, 0x38, 0xe9, 0xc0, 0x86, 0xdb, 0xb6, 0xbd,
-	0x08, 0x2b, 0x79, 0xa4, 0xc6, 0x7e, 0xe7, 0x6c, 0x60, 0x5c, 0x60, 0x03, 0xfc, 0x22, 0x81, 0xf4,
-	0x22, 0xdd, 0x14, 0x2e, 0xd2, 0x6c, 0x6a, 0x91, 0x72, 0xba, 0x36, 0xe7, 0xb0, 0x1f, 0x99, 0xef,
-	0x60, 0xcb, 0xf4, 0x6d, 0x0d, 0xef, 0xa6, 0x6d, 0xdb, 0x6f, 0x40, 0x8f, 0x0f, 0x24, 0x90, 0x76,
-	0x42, 0xdb, 0x8f, 0xec, 0x46, 0x9c, 0x5a, 0xa8, 0xd3, 0x5c, 0xeb, 0x23, 0x87, 0x8b, 0x37, 0x86,
-	0x52, 0xf1, 0xc6, 0x0c, 0x38, 0xc9, 0x42, 0x0d, 0xba, 0x91, 0x1a, 0x24, 0xcc, 0xa8, 0xae, 0x65,
-	0x3c, 0xfb, 0x94, 0xa1, 0xe5, 0x80, 0x50, 0x43, 0xcb, 0xb5, 0x33, 0x09, 0xbe, 0xaf, 0x61, 0x19,
-	0x77, 0xbb, 0xf5, 0xb6, 0x1b, 0x6f, 0xd9, 0x0e, 0xbb, 0xd8, 0x1f, 0xec, 0xbb, 0x0e, 0x44, 0xe6,
-	0x52, 0x06, 0x63, 0x51, 0xb7, 0xfe, 0x45, 0xd8, 0x88, 0xb1, 0x0c, 0x13, 0x1b, 0x53, 0x65, 0x12,
-	0x91, 0x97, 0x93, 0x88, 0xbc, 0xbc, 0xe9, 0x3f, 0xab, 0x25, 0x44, 0x69, 0xcf, 0x69, 0x28, 0xe3,
-	0x39, 0x71, 0x12, 0x0f, 0xf3, 0x12, 0x57, 0x6f, 0x67, 0xc4, 0x4a, 0x79, 0xa1, 0x72, 0x74, 0xd4,
-	0x0b, 0x95, 0x13, 0x30, 0x41, 0xbf, 0x47, 0x76, 0x14, 0x09, 0x05, 0xdf, 0xee, 0x38, 0x76, 0x7c,
-	0xac, 0x3b, 0x6a, 0x1f, 0x8f, 0x9b, 0x3a, 0xfb, 0x26, 0x48, 0x9b, 0x78, 0xd3, 0x8d, 0xe4, 0x37,
-	0xdd, 0xeb, 0x60, 0xac, 0x0d, 0xdb, 0x75, 0x18, 0x46, 0xa5, 0x13, 0xd8, 0x0f, 0x9b, 0x17, 0xfa,
-	0x61, 0x38, 0xc6, 0xfb, 0x82, 0xed, 0xb9, 0x0e, 0x32, 0xe3, 0x5a, 0xc2, 0xa3, 0x6f, 0x81, 0xd3,
-	0x21, 0x3c, 0xb0, 0x43, 0xc7, 0xa2, 0x7e, 0xc8, 0x68, 0x11, 0x3f, 0xe4, 0x14, 0xe1, 0xd9, 0x24,
-	0xde, 0xc8, 0x65, 0x40, 0x7f, 0x5b, 0x78, 0x17, 0xd3, 0xfd, 0x39, 0x41, 0xda, 0x9e, 0xa0, 0xa6,
-	0x42, 0xee, 0x05, 0xbf, 0x11, 0xc7, 0x8f, 0xbc, 0x11, 0xf3, 0x4b, 0x44, 0x37, 0x62, 0xbe, 0x83,
-	0xad, 0xee, 0x7f, 0x11, 0x33, 0xde, 0x74, 0x9c, 0x6d, 0x24, 0x08, 0x0c, 0x3b, 0x76, 0x18, 0x3f,
-	0xc3, 0xa6, 0xfe, 0x16, 0x4e, 0x26, 0xe9, 0xb7, 0xc1, 0xb8, 0xdd, 0x8d, 0x5b, 0x41, 0xe8, 0xc6,
-	0xcf, 0x48, 0x74, 0xbf, 0x55, 0xfa, 0xdb, 0x3f, 0x59, 0x9b, 0xa2, 0x81, 0x14, 0xbd, 0x6b, 0x76,
-	0xe3, 0xd0, 0xf5, 0x9b, 0xb5, 0x1e, 0xa9, 0xde, 0x04, 0x33, 0x0d, 0x6e, 0x48, 0x9a, 0x02, 0x20,
-	0x19, 0x2a, 0x6c, 0x0a, 0x12, 0x7f, 0x59, 0x82, 0xa3, 0x36, 0xdd, 0x10, 0x77, 0x90, 0x6b, 0xbe,
-	0x37, 0x31, 0x52, 0xcb, 0x05, 0x5e, 0x2d, 0x48, 0x36, 0x44, 0xfe, 0x24, 0x20, 0x0c, 0xd4, 0xf4,
-	0xe5, 0x22, 0x33, 0xe5, 0xbc, 0x4f, 0x22, 0x79, 0xa2, 0xb8, 0xe7, 0x54, 0xc7, 0x2b, 0x60, 0x34,
-	0x25, 0xbb, 0x21, 0x92, 0x9d, 0xcc, 0x91, 0xa4, 0x34, 0x08, 0x7d, 0x75, 0x35, 0x2f, 0x5f, 0xca,
-	0x51, 0xe4, 0xe1, 0x51, 0x47, 0x91, 0x6f, 0x62, 0xd2, 0x7c, 0x43, 0xc3, 0x1b, 0x79, 0xcb, 0xb3,
-	0x1b, 0x4f, 0x3d, 0x37, 0x8a, 0xd3, 0x79, 0x3a, 0x12, 0x07, 0x25, 0xd9, 0x1b, 0x12, 0x04, 0x55,
-	0xc0, 0x4b, 0xf5, 0x84, 0x3a, 0xf1, 0x39, 0x20, 0x12, 0x60, 0x78, 0x69, 0xbc, 0xa6, 0xd7, 0x73,
-	0x03, 0x11, 0xb7, 0x96, 0x72, 0xe7, 0xcc, 0x33, 0x3f, 0x31, 0x35, 0xcf, 0x7c, 0x07, 0xc3, 0xfc,
-	0x75, 0x0d, 0xe8, 0x38, 0x58, 0xde, 0x0f, 0x9e, 0x42, 0x46, 0x77, 0x7c, 0x80, 0x57, 0x33, 0x80,
-	0xcf, 0xa7, 0x63, 0xf8, 0xd4, 0xac, 0xe6, 0x05, 0x60, 0xe4, 0x5b, 0x19, 0xd4, 0x4f, 0x86, 0xc0,
-	0xca, 0x4e, 0xd4, 0x7c, 0x18, 0x84, 0x0d, 0xb8, 0x0b, 0x63, 0xb2, 0xe7, 0x36, 0x7d, 0xe7, 0x0d,
-	0x3b, 0x8a, 0x1f, 0xd7, 0x23, 0x18, 0xee, 0x43, 0xe7, 0x41, 0xef, 0xe8, 0x93, 0x89, 0x90, 0x39,
-	0x54, 0x87, 0x72, 0x87, 0xea, 0x06, 0x18, 0x25, 0xe7, 0x23, 0x3e, 0x2d, 0x25, 0x86, 0x44, 0x66,
-	0xaf, 0x51, 0x4a, 0xfd, 0x2e, 0x98, 0xf1, 0xec, 0x28, 0xb6, 0x02, 0x8a, 0xc3, 0xe2, 0x8f, 0x65,
-	0x72, 0xa2, 0x9e, 0xf3, 0xc4, 0x38, 0xdf, 0x00, 0xf3, 0x19, 0xd6, 0x24, 0xea, 0x4a, 0x1d, 0xcb,
-	0x27, 0xf0, 0x20, 0x73, 0xa9, 0x41, 0x28, 0xe1, 0x56, 0xef, 0xa8, 0xae, 0x6e, 0x67, 0xf4, 0x7d,
-	0x83, 0xd7, 0x77, 0x41, 0xd5, 0x99, 0x37, 0xc1, 0x46, 0x71, 0x6a, 0xb6, 0x3e, 0xff, 0xa7, 0xe1,
-	0x6d, 0x4f, 0xb6, 0x46, 0x6e, 0xe7, 0x3f, 0xf2, 0xf7, 0x82, 0x88, 0x6e, 0x71, 0xd9, 0xd2, 0x2c,
-	0x80, 0x49, 0x9a, 0x5b, 0xcc, 0xa4, 0x33, 0x4f, 0x93, 0xe6, 0x24, 0xa1, 0xb9, 0x02, 0xce, 0xa6,
-	0xe8, 0xbc, 0xa0, 0x19, 0xd0, 0x5b, 0x7b, 0x92, 0xa3, 0x7c, 0x23, 0x68, 0x06, 0x39, 0x5a, 0xec,
-	0xf3, 0x8d, 0xe4, 0x68, 0xdf, 0xb4, 0xdb, 0xb0, 0x7a, 0x2f, 0xa3, 0xbc, 0x72, 0xfe, 0x14, 0x50,
-	0xc9, 0x65, 0x3e, 0x02, 0x6b, 0x85, 0x08, 0x13, 0x95, 0xe9, 0x25, 0x30, 0xd6, 0x25, 0x97, 0x06,
-	0xd6, 0xc4, 0xc9, 0x5a, 0xf2, 0xd3, 0xfc, 0x2e, 0xb9, 0x36, 0xde, 0xf6, 0x8f, 0x9c, 0xfb, 0xef,
-	0x6b, 0xdf, 0xfd, 0x12, 0xff, 0x6a, 0x8f, 0x47, 0x8e, 0x88, 0x1e, 0xfb, 0x72, 0x02, 0x66, 0x29,
-	0xff, 0x39, 0x84, 0x9f, 0x35, 0xa8, 0xa2, 0x90, 0x72, 0x76, 0xdb, 0x76, 0x18, 0x33, 0xd7, 0x5c,
-	0x66, 0x1f, 0xbc, 0xf3, 0x39, 0x94, 0x72, 0x3e, 0xf5, 0xdb, 0x60, 0x3a, 0x59, 0xe6, 0x6c, 0x0c,
-	0x47, 0x04, 0x7c, 0x99, 0x2e, 0x76, 0x26, 0x82, 0xfb, 0x1c, 0xb8, 0x90, 0xe5, 0x8b, 0x62, 0x3b,
-	0x8c, 0xd3, 0xde, 0xd0, 0x4c, 0x9a, 0x79, 0x17, 0x51, 0x50, 0xdf, 0x68, 0x1d, 0x4c, 0xf5, 0x38,
-	0x83, 0x6e, 0xd8, 0x80, 0x24, 0x88, 0x26, 0x31, 0x87, 0x9e, 0xf4, 0xed, 0xe2, 0x2e, 0x1c, 0x50,
-	0xbf, 0x06, 0x8c, 0x3d, 0x37, 0x44, 0x3b, 0x9e, 0x53, 0x12, 0x43, 0x4b, 0xc2, 0x91, 0x12, 0xa6,
-	0x10, 0x68, 0x31, 0x79, 0x74, 0x61, 0x36, 0x7a, 0x45, 0x60, 0xa3, 0x39, 0x8d, 0xd2, 0x47, 0x17,
-	0x59, 0x37, 0x5b, 0x98, 0xaf, 0x91, 0xdb, 0x80, 0xa3, 0xc3, 0x7b, 0x68, 0x80, 0xf5, 0xd0, 0xc1,
-	0x08, 0xb7, 0x2b, 0xf1, 0xdf, 0xea, 0xbb, 0x20, 0x33, 0x27, 0xbd, 0x0b, 0x32, 0xad, 0x0a, 0xa0,
-	0x6f, 0x26, 0xf1, 0xdb, 0xd1, 0x81, 0xe2, 0x23, 0x61, 0xb8, 0x17, 0x06, 0x16, 0x06, 0x8a, 0xe6,
-	0xcc, 0x03, 0x45, 0xad, 0x0c, 0xa8, 0x87, 0x5f, 0x72, 0xee, 0x43, 0x0f, 0xd2, 0xde, 0x01, 0x30,
-	0x26, 0x6f, 0x26, 0x0c, 0xcf, 0x74, 0x3a, 0x8d, 0xc5, 0xc6, 0xa6, 0x6f, 0x26, 0x5c, 0x4b, 0x06,
-	0xc7, 0xb6, 0x07, 0xed, 0x90, 0x9c, 0xe7, 0xc7, 0x8e, 0x83, 0x1b, 0x9b, 0xe2, 0xe0, 0x5a, 0x18,
-	0x8e, 0xf7, 0x68, 0x5c, 0xda, 0xb2, 0xfd, 0x26, 0x7c, 0xe4, 0xbb, 0xb1, 0x6b, 0x7b, 0xee, 0x97,
-	0x60, 0x38, 0xc8, 0xd2, 0x2d, 0x82, 0x49, 0x1f, 0x1e, 0x58, 0x6e, 0x6f, 0x14, 0xba, 0x8a, 0x67,
-	0x7c, 0x78, 0xc0, 0x8d, 0x9d, 0x44, 0xa6, 0x0c, 0x77, 0x3a, 0x32, 0xcd, 0x42, 0x49, 0x22, 0xd3,
-	0x6c, 0x3b, 0x93, 0xe1, 0x8b, 0xe0, 0xf4, 0x4e, 0xd4, 0x7c, 0xcb, 0xee, 0x46, 0x83, 0x2f, 0xe9,
-	0x42, 0x06, 0xd2, 0x39, 0x1e, 0x52, 0x6f, 0x68, 0x73, 0x1a, 0xbb, 0x94, 0xbd, 0x06, 0x06, 0xc2,
-	0x27, 0x9e, 0xb3, 0xdf, 0x79, 0x2e, 0x18, 0x4b, 0x19, 0x18, 0x69, 0xbf, 0x97, 0x1b, 0x3c, 0xf1,
-	0x7b, 0xb9, 0x26, 0x06, 0xe5, 0x17, 0xc1, 0x38, 0x71, 0xf7, 0x6b, 0x9d, 0xc6, 0x20, 0xeb, 0x38,
-	0x0d, 0xc6, 0x70, 0x50, 0x16, 0x7a, 0x49, 0xe8, 0x8d, 0x62, 0xb2, 0xd0, 0xab, 0x9a, 0x19, 0x74,
-	0x7a, 0x26, 0xea, 0xa8, 0x75, 0x1a, 0xe6, 0x4b, 0xe0, 0x2c, 0xfb, 0xc1, 0x10, 0xfd, 0x92, 0x06,
-	0x4e, 0x61, 0x4f, 0xb2, 0x1d, 0xec, 0xc3, 0xe3, 0x46, 0x75, 0x35, 0x83, 0xea, 0xe5, 0xb4, 0x4b,
-	0x4b, 0xa7, 0x34, 0xcf, 0x61, 0x43, 0x67, 0xbf, 0x19, 0xb6, 0x1f, 0x0e, 0xe1, 0xa3, 0x6b, 0x17,
-	0xc6, 0x38, 0x6c, 0xe5, 0x1f, 0x78, 0x8f, 0x88, 0x70, 0x1e, 0x90, 0x94, 0x56, 0xe6, 0xa6, 0x3b,
-	0x85, 0x1b, 0x93, 0x0b, 0x8e, 0xbd, 0x05, 0x8e, 0xf0, 0x6f, 0x81, 0xbd, 0x44, 0xd7, 0x09, 0x69,
-	0xa2, 0x6b, 0x34, 0x93, 0xe8, 0x5a, 0x07, 0x53, 0x6e, 0x64, 0xd1, 0xec, 0x5b, 0x10, 0xba, 0x4d,
-	0xd7, 0xc7, 0x9e, 0xcb, 0x18, 0xf6, 0x5c, 0x74, 0x37, 0xda, 0xc6, 0x5d, 0x8f, 0x59, 0x8f, 0x7e,
-	0x0d, 0xe8, 0x98, 0xc3, 0x6f, 0x40, 0x3f, 0xea, 0x46, 0x34, 0x74, 0x3f, 0x89, 0xe9, 0x5f, 0x44,
-	0xf4, 0xb4, 0x03, 0x2b, 0x42, 0x7d, 0xea, 0x66, 0xd4, 0x45, 0x4f, 0xdd, 0x4c, 0x2b, 0xd3, 0xf1,
-	0x37, 0x35, 0xfa, 0x06, 0x8b, 0x94, 0x8f, 0x29, 0x1e, 0x86, 0x41, 0x7b, 0x60, 0x45, 0x8b, 0xdf,
-	0x85, 0xd7, 0x33, 0x78, 0x2f, 0xe5, 0xed, 0x20, 0x3d, 0xb5, 0x79, 0x19, 0xcc, 0x49, 0xba, 0x7a,
-	0xae, 0x11, 0xb1, 0xdc, 0x1d, 0xd7, 0x27, 0x92, 0x7d, 0x6a, 0x76, 0x71, 0x2b, 0x55, 0x00, 0x50,
-	0xf8, 0x55, 0x68, 0x19, 0xbc, 0x98, 0xbc, 0xf2, 0xb0, 0xe1, 0x89, 0x09, 0x4d, 0x26, 0xed, 0x89,
-	0xa7, 0xa2, 0xdc, 0x27, 0x4c, 0x40, 0xba, 0x4f, 0xd8, 0x6f, 0xa6, 0x89, 0xbf, 0x20, 0x9a, 0xd8,
-	0xea, 0x86, 0xfe, 0x4f, 0xa3, 0x26, 0xd4, 0xe2, 0x31, 0xd4, 0x54, 0x3c, 0xf6, 0x9b, 0x89, 0xf7,
-	0x9b, 0x1a, 0x3e, 0xb8, 0x58, 0x7c, 0xa5, 0x0e, 0x5a, 0x15, 0x32, 0xf6, 0x4f, 0xf1, 0x55, 0x57,
-	0x32, 0x50, 0x8d, 0xcc, 0xce, 0xe2, 0x10, 0x98, 0xe7, 0xf1, 0xa3, 0x6f, 0xba, 0x91, 0x5f, 0x93,
-	0x0b, 0xa4, 0x77, 0xc7, 0xf5, 0xb7, 0x6d, 0xcf, 0xe3, 0xdf, 0x09, 0x7e, 0xc6, 0x8e, 0x06, 0xc1,
-	0x5f, 0x05, 0x46, 0xdb, 0xf5, 0x2d, 0xfc, 0xf2, 0xc1, 0x1e, 0x62, 0xf0, 0x13, 0x48, 0xd3, 0x8e,
-	0xa8, 0x34, 0xe7, 0xda, 0xc2, 0xe9, 0xaa, 0xb7, 0x32, 0x82, 0x5d, 0xcd, 0x08, 0x26, 0x46, 0x69,
-	0x2e, 0x80, 0x2b, 0xaa, 0x7e, 0x26, 0xee, 0x87, 0x5a, 0x72, 0x54, 0x27, 0x5e, 0xcc, 0x81, 0x1d,
-	0x3a, 0x3f, 0x6d, 0x86, 0xd8, 0xef, 0xdc, 0xe4, 0xb1, 0xf7, 0xce, 0x4d, 0xbe, 0x95, 0x09, 0xfc,
-	0x8f, 0x1a, 0x7e, 0xf0, 0xde, 0x85, 0xf1, 0xdb, 0x7e, 0x3d, 0xf0, 0x9d, 0x5d, 0xcf, 0x8e, 0x5a,
-	0xae, 0x4f, 0xf3, 0x9b, 0xd1, 0x3b, 0xae, 0xef, 0x04, 0x07, 0x83, 0xc8, 0xbf, 0x0d, 0x66, 0xbb,
-	0x78, 0x44, 0x2b, 0xa2, 0x43, 0x5a, 0xc4, 0x40, 0x23, 0xeb, 0x00, 0x0f, 0x4a, 0x17, 0xfa, 0x7c,
-	0x57, 0x3e, 0x6f, 0xb5, 0x9a, 0x11, 0x74, 0x25, 0x23, 0xa8, 0x02, 0xb3, 0xb9, 0x0a, 0x96, 0xfb,
-	0x12, 0x31, 0x35, 0xfc, 0x68, 0x08, 0x7b, 0x5d, 0xc4, 0xa7, 0xbf, 0x0f, 0xf7, 0xec, 0xae, 0xf7,
-	0x29, 0x9f, 0xc6, 0xc7, 0x77, 0x4b, 0x8b, 0xef, 0xdc, 0x31, 0xf1, 0x9d, 0x2b, 0xbd, 0xd3, 0x4f,
-	0xca, 0xee, 0x74, 0x75, 0x06, 0x32, 0xaf, 0x31, 0x9a, 0x81, 0xcc, 0x77, 0x30, 0x65, 0xff, 0x87,
-	0xc6, 0x29, 0xfb, 0x71, 0x37, 0x7e, 0x72, 0xf8, 0xc4, 0x6d, 0xc3, 0xa0, 0x3b, 0x50, 0x1a, 0xe0,
-	0x55, 0x60, 0xc4, 0x76, 0xd8, 0x84, 0xb1, 0x15, 0x74, 0xe3, 0x66, 0x80, 0xec, 0x2c, 0x3e, 0xb4,
-	0x62, 0x32, 0x20, 0xb5, 0xb1, 0x69, 0x42, 0xf1, 0x98, 0x12, 0xf4, 0xe6, 0x5b, 0x07, 0x53, 0x94,
-	0x99, 0xbc, 0xba, 0x27, 0x6c, 0x24, 0x07, 0xa0, 0x93, 0x3e, 0x5c, 0xfd, 0x41, 0x39, 0x8a, 0x28,
-	0x83, 0x97, 0x28, 0xa5, 0x0c, 0xbe, 0x83, 0x29, 0xe3, 0xd7, 0x34, 0x30, 0xcb, 0x5e, 0xc5, 0x36,
-	0x3d, 0xef, 0x2d, 0xe8, 0x3b, 0xae, 0xdf, 0xec, 0x61, 0x1d, 0xe4, 0x88, 0xad, 0xde, 0xc9, 0xc0,
-	0x5c, 0xcc, 0xbf, 0xcc, 0x09, 0xe7, 0x32, 0x97, 0xc0, 0x82, 0x9a, 0x82, 0xaf, 0xc9, 0x3b, 0xcf,
-	0x48, 0x8f, 0x05, 0x35, 0xda, 0x13, 0xf8, 0x45, 0x82, 0x2e, 0x1b, 0xf9, 0xa1, 0xce, 0x7f, 0xc8,
-	0xa6, 0xa7, 0xf9, 0x0f, 0x59, 0x37, 0x93, 0xe2, 0xcf, 0x34, 0x2e, 0xbb, 0x4f, 0xdf, 0x34, 0x9e,
-	0xc2, 0x81, 0x93, 0x20, 0x85, 0xb6, 0x7e, 0x92, 0x29, 0x19, 0xe1, 0x32, 0x25, 0x4a, 0xd7, 0x52,
-	0x84, 0x8e, 0xba, 0x96, 0xa2, 0x2e, 0xfe, 0xb1, 0x65, 0x86, 0xd1, 0x6c, 0xee, 0xc3, 0xd0, 0x6e,
-	0x42, 0x9c, 0x3a, 0x46, 0x46, 0x38, 0x88, 0x78, 0xd7, 0x80, 0x6e, 0x93, 0x61, 0x68, 0xaa, 0x1a,
-	0x6d, 0x18, 0xba, 0x5a, 0x2f, 0xda, 0x99, 0x09, 0xaa, 0x1b, 0x19, 0x99, 0xcc, 0xbc, 0x4c, 0x59,
-	0x50, 0xb4, 0xfe, 0x4a, 0xdc, 0xc9, 0xe4, 0xfa, 0x57, 0x3e, 0xef, 0x4c, 0xa9, 0xf8, 0xec, 0xeb,
-	0x73, 0xc9, 0xf8, 0x00, 0xcc, 0x25, 0x32, 0xa6, 0x1e, 0xdb, 0x72, 0x02, 0x5f, 0xb0, 0x15, 0x33,
-	0x17, 0xc9, 0x2c, 0xab, 0x90, 0x9b, 0x15, 0x2e, 0xb3, 0xac, 0x22, 0x64, 0x4a, 0xf9, 0xef, 0x21,
-	0x60, 0x2a, 0xca, 0xac, 0xdf, 0x71, 0x63, 0x5c, 0xc6, 0x39, 0x70, 0x12, 0xf9, 0x58, 0x4a, 0xaa,
-	0x8f, 0xa9, 0x88, 0x54, 0x58, 0xd8, 0x3a, 0x3a, 0x58, 0x61, 0x6b, 0xf5, 0xd5, 0x4c, 0xf2, 0x7b,
-	0xb5, 0x48, 0x51, 0x3b, 0x55, 0xa7, 0x79, 0x0d, 0xbf, 0x50, 0xf5, 0xa1, 0x62, 0x6b, 0xf4, 0x47,
-	0x43, 0x9c, 0x79, 0x0b, 0x39, 0x9e, 0x6b, 0x89, 0xf2, 0xda, 0x1d, 0x3e, 0x36, 0xed, 0x0e, 0x58,
-	0x36, 0x9c, 0xb8, 0x70, 0x4c, 0xbb, 0x2b, 0x82, 0x0b, 0x53, 0xa2, 0x08, 0xea, 0xc2, 0xa9, 0x89,
-	0x98, 0x6e, 0xbf, 0x45, 0x3c, 0x59, 0x92, 0x0a, 0x3d, 0x76, 0xdd, 0xaa, 0xe5, 0x50, 0x4f, 0x4a,
-	0xe5, 0x50, 0x13, 0x65, 0xeb, 0x78, 0x76, 0x61, 0xfc, 0x86, 0x4d, 0x8b, 0x99, 0x9f, 0xf3, 0x79,
-	0x33, 0x53, 0x54, 0x38, 0x9c, 0x2d, 0x2a, 0x54, 0x67, 0x4b, 0x73, 0x40, 0x68, 0xb6, 0x34, 0xd7,
-	0xce, 0x24, 0xf8, 0x43, 0x2d, 0x09, 0x39, 0xde, 0x69, 0xb9, 0x31, 0xf4, 0xdc, 0x28, 0x86, 0x4e,
-	0xff, 0xa7, 0xf1, 0xbe, 0x72, 0x5c, 0x00, 0xe3, 0xbd, 0x07, 0xe8, 0x61, 0xfc, 0x00, 0xdd, 0x6b,
-	0x20, 0xb5, 0x6f, 0x9c, 0x10, 0xf3, 0x19, 0x21, 0x44, 0x58, 0xcc, 0x2b, 0xc9, 0x99, 0x29, 0xea,
-	0x65, 0x02, 0xfd, 0x01, 0x71, 0x75, 0x36, 0x1d, 0xe7, 0xb1, 0x0f, 0xf3, 0x94, 0x83, 0x4b, 0x54,
-	0x02, 0x63, 0x69, 0x47, 0x21, 0xf9, 0xa9, 0x76, 0x7a, 0x64, 0x40, 0xa8, 0xd3, 0x23, 0xeb, 0xee,
-	0x1d, 0x43, 0xc4, 0xe7, 0x24, 0x69, 0xa9, 0xcf, 0x4c, 0x24, 0xa5, 0x4f, 0xaa, 0xc0, 0x62, 0x3e,
-	0xc4, 0x3e, 0xa9, 0x82, 0x82, 0xbd, 0xae, 0xa6, 0x2c, 0x44, 0xcb, 0x58, 0x88, 0xf9, 0x25, 0x1c,
-	0xdb, 0x6f, 0x7b, 0xd0, 0xf6, 0x77, 0x9f, 0xba, 0x9d, 0x0e, 0x74, 0x54, 0x7e, 0x6a, 0xdf, 0x13,
-	0x41, 0x19, 0x85, 0x67, 0x66, 0xa1, 0x51, 0x78, 0xa6, 0x95, 0x2d, 0x88, 0x85, 0x03, 0x22, 0xdc,
-	0xbb, 0xe9, 0x79, 0xfd, 0xc1, 0xa9, 0xc3, 0x90, 0xfc, 0x38, 0x34, 0x0c, 0xc9, 0x77, 0x24, 0x08,
-	0x36, 0xfe, 0xe7, 0x11, 0x18, 0xde, 0x89, 0x9a, 0xfa, 0xaf, 0x6b, 0xe0, 0x74, 0xfa, 0x0b, 0xac,
-	0x2b, 0xa2, 0x62, 0x88, 0xec, 0x87, 0x4c, 0xc6, 0xb5, 0x22, 0x54, 0x4c, 0xde, 0x95, 0x77, 0xff,
-	0xee, 0xdf, 0x7e, 0x63, 0xe8, 0x8a, 0x69, 0x56, 0x04, 0x5f, 0xdc, 0xd1, 0xdc, 0x56, 0x83, 0xce,
-	0xff, 0x35, 0x0d, 0x4c, 0xf0, 0xe5, 0x8c, 0xa6, 0x64, 0x26, 0x8e, 0xc6, 0x58, 0xe9, 0x4f, 0xc3,
-	0xb0, 0x2c, 0x63, 0x2c, 0xf3, 0xe6, 0x65, 0x11, 0x16, 0x74, 0xde, 0x5b, 0x71, 0x40, 0x2a, 0x09,
-	0xf4, 0x5f, 0xd5, 0xc0, 0xa9, 0xd4, 0x37, 0x48, 0xf3, 0x92, 0x79, 0x78, 0x22, 0x63, 0xb5, 0x00,
-	0x51, 0x31, 0x34, 0x21, 0xe1, 0x20, 0xb7, 0xb2, 0xfe, 0x57, 0x1a, 0x30, 0x14, 0xdf, 0x15, 0x5d,
-	0x2f, 0x30, 0x6d, 0x9a, 0xc5, 0xb8, 0x7b, 0x64, 0x16, 0x86, 0xbb, 0x8a, 0x71, 0xdf, 0x34, 0x37,
-	0xfa, 0xe2, 0xb6, 0x0e, 0xdc, 0xb8, 0x65, 0x25, 0x0e, 0xc6, 0x1e, 0x84, 0x58, 0xad, 0xa9, 0x2f,
-	0x72, 0x64, 0x6a, 0xe5, 0x89, 0xa4, 0x6a, 0x15, 0x7e, 0xd9, 0xa2, 0x54, 0x2b, 0xb5, 0x34, 0xaa,
-	0xd6, 0xef, 0x6a, 0xe0, 0x9c, 0xe4, 0x0b, 0x98, 0x35, 0xf5, 0x94, 0x19, 0x72, 0xe3, 0xd6, 0x91,
-	0xc8, 0x19, 0xd6, 0xeb, 0x18, 0xeb, 0xaa, 0xb9, 0xac, 0xc2, 0xda, 0x46, 0xcc, 0x16, 0xfd, 0xde,
-	0x05, 0x6b, 0x30, 0xf5, 0x35, 0x8a, 0x4c, 0x83, 0x3c, 0x91, 0x54, 0x83, 0xc2, 0x6f, 0x22, 0x94,
-	0x1a, 0x74, 0x08, 0x87, 0xd5, 0xc0, 0x93, 0xa3, 0x33, 0x24, 0xfd, 0xed, 0x84, 0xec, 0x0c, 0x49,
-	0x51, 0x49, 0xcf, 0x10, 0xf1, 0xd7, 0x03, 0xca, 0x33, 0xe4, 0x80, 0xb2, 0x50, 0x44, 0xbf, 0xab,
-	0x81, 0xb3, 0xf9, 0xcf, 0x0c, 0x96, 0x24, 0xf3, 0xe5, 0x28, 0x8d, 0xf5, 0xa2, 0x94, 0x0c, 0x5d,
-	0x05, 0xa3, 0x5b, 0x36, 0x17, 0x45, 0xe8, 0xd2, 0x49, 0x6f, 0x02, 0xf1, 0x3b, 0x1a, 0x38, 0xcb,
-	0x17, 0x95, 0x12, 0x88, 0xcb, 0xca, 0x63, 0x95, 0x2f, 0x3f, 0x35, 0xae, 0x17, 0x26, 0x65, 0x20,
-	0xd7, 0x31, 0xc8, 0x15, 0x73, 0x49, 0x71, 0x0c, 0xd3, 0xf2, 0x24, 0x8a, 0xf2, 0xf7, 0x34, 0xa0,
-	0x0b, 0x3e, 0x04, 0x90, 0xc1, 0xcc, 0x93, 0x4a, 0x61, 0x2a, 0x2a, 0xdb, 0x95, 0x30, 0x61, 0xd8,
-	0xd8, 0x58, 0xb7, 0x1c, 0xca, 0x48, 0x61, 0x7e, 0x4f, 0x03, 0x25, 0x69, 0x19, 0x55, 0x45, 0x7a,
-	0x39, 0x88, 0x19, 0x8c, 0x3b, 0x47, 0x64, 0x60, 0xc0, 0x6f, 0x62, 0xe0, 0x65, 0xf3, 0x9a, 0xf8,
-	0x6a, 0x11, 0xd7, 0x03, 0xe9, 0x3f, 0xd0, 0x80, 0xa1, 0xa8, 0x02, 0x93, 0x29, 0x50, 0xce, 0x22,
-	0x3d, 0xd7, 0x0b, 0x14, 0x6e, 0xdd, 0xc6, 0x22, 0xac, 0x9b, 0x65, 0x91, 0x08, 0x5d, 0x5f, 0x2a,
-	0xc4, 0x7b, 0x1a, 0x38, 0x9b, 0xff, 0x14, 0x41, 0xb6, 0xe3, 0x72, 0x94, 0xd2, 0x1d, 0x27, 0xff,
-	0x7a, 0x40, 0x69, 0x25, 0x0d, 0xcc, 0x66, 0xa5, 0xaf, 0xf3, 0xbf, 0xd4, 0x80, 0xa1, 0xf8, 0xd8,
-	0x40, 0xa6, 0x68, 0x39, 0x8b, 0x54, 0xd1, 0x05, 0xbe, 0x09, 0xb8, 0x8b, 0xe1, 0xdf, 0x30, 0xaf,
-	0x0b, 0x6d, 0x05, 0xf3, 0x5b, 0x75, 0xdb, 0xb1, 0xd8, 0xe7, 0x0b, 0x16, 0x4c, 0x80, 0x22, 0x39,
-	0x14, 0xd5, 0xe6, 0x32, 0x39, 0xe4, 0x2c, 0x52, 0x39, 0x0a, 0x14, 0x78, 0x2b, 0xe5, 0xb0, 0x1d,
-	0xc7, 0x92, 0x56, 0xb0, 0xeb, 0xff, 0xae, 0x01, 0xb3, 0x40, 0x2d, 0xa9, 0xd4, 0x9a, 0xfb, 0xb2,
-	0x1a, 0x9b, 0x03, 0xb3, 0x32, 0xf9, 0xb6, 0xb0, 0x7c, 0xaf, 0x99, 0x55, 0xe1, 0x86, 0xc0, 0xe3,
-	0x88, 0x44, 0x74, 0xd1, 0x50, 0x89, 0xa0, 0xe8, 0xba, 0x4e, 0x55, 0xc0, 0xcf, 0x2b, 0x71, 0x51,
-	0xf0, 0xab, 0x05, 0x88, 0x8a, 0x5d, 0xd7, 0x14, 0x26, 0x45, 0xf3, 0x1d, 0x0d, 0xe8, 0x82, 0x0a,
-	0x76, 0xd9, 0x99, 0x9e, 0x27, 0x95, 0x9e, 0xe9, 0x8a, 0x2a, 0x74, 0xe5, 0xfd, 0x28, 0x28, 0x38,
-	0xd7, 0x7f, 0x4b, 0x03, 0x93, 0xd9, 0x9a, 0xf5, 0x05, 0xa9, 0xbf, 0x9a, 0xa2, 0x33, 0xca, 0xc5,
-	0xe8, 0x18, 0xb8, 0x6b, 0x18, 0xdc, 0x82, 0x79, 0x45, 0xec, 0xcc, 0x22, 0x26, 0x8b, 0x61, 0xd4,
-	0xff, 0x57, 0x03, 0x8b, 0x45, 0x4b, 0xd4, 0xef, 0x49, 0x90, 0x14, 0xe4, 0x37, 0x1e, 0x3e, 0x1f,
-	0x3f, 0x93, 0xf0, 0x67, 0xb1, 0x84, 0xf7, 0xcd, 0x2d, 0x91, 0x84, 0x7b, 0x68, 0x30, 0x0b, 0x1d,
-	0xed, 0xd4, 0x07, 0xb0, 0x7d, 0xc7, 0x92, 0x16, 0xbb, 0xeb, 0xdf, 0xd7, 0x40, 0x49, 0x5a, 0xd8,
-	0x5b, 0x51, 0xef, 0xb8, 0x1c, 0x83, 0xf4, 0xb2, 0xed, 0x5b, 0xc9, 0x7a, 0x07, 0x8b, 0x74, 0xdd,
-	0xac, 0xa8, 0x36, 0x26, 0xde, 0x8b, 0x11, 0xe2, 0x67, 0xd5, 0xbf, 0xfa, 0xb7, 0x34, 0x30, 0x99,
-	0xad, 0x7f, 0x5d, 0xe8, 0x8f, 0x02, 0xd1, 0x49, 0x2d, 0x4b, 0x56, 0xc5, 0xba, 0x86, 0x41, 0x2e,
-	0x9a, 0x57, 0xfb, 0x82, 0xf4, 0x10, 0x8c, 0x0c, 0x34, 0x5c, 0xf1, 0x5a, 0x00, 0x1a, 0xa2, 0x2b,
-	0x02, 0x2d, 0x55, 0xb7, 0x5a, 0x1c, 0x1a, 0xfe, 0x70, 0xf2, 0x57, 0x34, 0x30, 0xc1, 0x17, 0xb9,
-	0x9a, 0xd2, 0x60, 0x82, 0xd1, 0x48, 0xc3, 0x72, 0x51, 0xf9, 0xea, 0x12, 0x86, 0x63, 0x9a, 0x97,
-	0xc4, 0xf1, 0x06, 0x62, 0xa0, 0xd7, 0x38, 0x42, 0xc2, 0x97, 0xb9, 0xca, 0x90, 0x70, 0x34, 0x52,
-	0x24, 0xa2, 0x02, 0x56, 0x25, 0x92, 0x06, 0x62, 0xa0, 0xfb, 0x44, 0xff, 0x36, 0x72, 0x7a, 0x72,
-	0x75, 0xae, 0x52, 0xa7, 0x27, 0x4b, 0x29, 0x77, 0x7a, 0xa4, 0x85, 0xa9, 0x65, 0x8c, 0x6d, 0xc9,
-	0x5c, 0x10, 0x62, 0xc3, 0x6c, 0x7c, 0xb5, 0xac, 0xfe, 0x55, 0x0d, 0x00, 0xae, 0x8c, 0xf5, 0xb2,
-	0x64, 0xc2, 0x1e, 0x89, 0xb1, 0xdc, 0x97, 0x84, 0x81, 0x59, 0xc4, 0x60, 0x2e, 0x9b, 0x73, 0x15,
-	0xe1, 0xff, 0xa3, 0xd4, 0x8d, 0x20, 0x97, 0x47, 0x49, 0xd5, 0xb1, 0x4a, 0xef, 0x3f, 0x8e, 0x48,
-	0x7e, 0xff, 0x89, 0x2a, 0x54, 0xd5, 0xf7, 0x9f, 0xcf, 0xa3, 0x89, 0xc0, 0x28, 0xad, 0x64, 0xbd,
-	0x28, 0x77, 0x7b, 0x6a, 0x9d, 0x86, 0x71, 0x55, 0xd9, 0xcd, 0xa6, 0x9e, 0xc7, 0x53, 0x5f, 0x34,
-	0xcf, 0xcb, 0x3c, 0xa0, 0xb0, 0xd3, 0xd0, 0xbf, 0x0c, 0xc6, 0x7b, 0xb5, 0xaa, 0x97, 0xa4, 0xf7,
-	0x13, 0xa5, 0x30, 0x96, 0xfa, 0x51, 0xb0, 0xd9, 0x17, 0xf0, 0xec, 0x97, 0xcc, 0x59, 0xf1, 0xdd,
-	0x85, 0xc8, 0x31, 0x80, 0xdf, 0xd6, 0xc0, 0x64, 0xb6, 0x22, 0x75, 0x41, 0x1e, 0xe8, 0xf0, 0x74,
-	0xd2, 0xa3, 0x45, 0x56, 0x9c, 0xa9, 0xb4, 0x52, 0x74, 0xc1, 0x90, 0x37, 0x73, 0xe6, 0x98, 0xff,
-	0xb1, 0x06, 0xa6, 0x84, 0x95, 0x9c, 0xab, 0x4a, 0x35, 0xa4, 0x89, 0x8d, 0x1b, 0x47, 0x20, 0x66,
-	0x50, 0x6f, 0x60, 0xa8, 0x6b, 0xe6, 0xaa, 0x42, 0x7d, 0x04, 0xed, 0x5e, 0x18, 0xb4, 0x29, 0xde,
-	0x2f, 0x83, 0xf1, 0x5e, 0xf9, 0xa6, 0x6c, 0x31, 0x19, 0x85, 0x74, 0x31, 0xf3, 0x15, 0x91, 0xca,
-	0xc5, 0x6c, 0xbb, 0x3e, 0xd5, 0x1c, 0x02, 0xd0, 0xab, 0x9a, 0x94, 0x01, 0x60, 0x14, 0x52, 0x00,
-	0xf9, 0x9a, 0x45, 0x25, 0x80, 0x7a, 0x37, 0xf4, 0x29, 0x80, 0x6f, 0x6a, 0xe0, 0x4c, 0xa6, 0xb0,
-	0xf1, 0xaa, 0xdc, 0x48, 0x38, 0x32, 0x63, 0xad, 0x10, 0x59, 0x31, 0xd7, 0x8c, 0x73, 0x59, 0x88,
-	0x6b, 0xf2, 0x43, 0x0d, 0xcc, 0xc8, 0x4b, 0x17, 0xd7, 0xe5, 0x53, 0x8b, 0x39, 0x8c, 0x57, 0x8e,
-	0xca, 0x51, 0x2c, 0x3f, 0x8a, 0x00, 0xcb, 0x2b, 0x22, 0xb1, 0x17, 0x90, 0xad, 0x48, 0x5c, 0xe8,
-	0xa7, 0x36, 0x42, 0xa7, 0xda, 0xaa, 0xc2, 0x7a, 0x40, 0xa5, 0x17, 0xc0, 0xe9, 0x97, 0x7c, 0x86,
-	0xad, 0xff, 0x83, 0x06, 0x66, 0xfb, 0xd4, 0x0e, 0xde, 0x92, 0x23, 0x50, 0xb0, 0x19, 0xaf, 0x0f,
-	0xc4, 0xc6, 0xe4, 0xb8, 0x87, 0xe5, 0x78, 0xc5, 0xbc, 0x2d, 0x93, 0x43, 0x5d, 0x9c, 0x88, 0x13,
-	0x5d, 0x82, 0x6a, 0xc0, 0x65, 0xa5, 0x53, 0xc5, 0x93, 0x4a, 0x83, 0x22, 0x45, 0x61, 0x9c, 0x32,
-	0x85, 0x41, 0x5d, 0x30, 0x87, 0x30, 0xd2, 0x7d, 0xf7, 0xfb, 0x0c, 0x66, 0xaa, 0x8e, 0x4e, 0x0d,
-	0x93, 0x27, 0xed, 0x03, 0x53, 0x58, 0xb2, 0xa6, 0x4c, 0x50, 0x53, 0x98, 0x41, 0x37, 0xe6, 0xea,
-	0xf0, 0xf4, 0xbf, 0xd6, 0xc0, 0x79, 0x55, 0x89, 0xdb, 0x86, 0x32, 0xdd, 0x23, 0xe4, 0x31, 0xaa,
-	0x47, 0xe7, 0x61, 0x22, 0xbc, 0x8a, 0x45, 0xb8, 0x65, 0xde, 0x50, 0x24, 0x8b, 0xd0, 0x66, 0xec,
-	0x90, 0x21, 0xf8, 0xda, 0xc2, 0x08, 0x07, 0x3c, 0xd2, 0xb2, 0xb7, 0x8a, 0x12, 0x95, 0x40, 0x8c,
-	0x3b, 0x47, 0x64, 0x28, 0x16, 0xf0, 0x50, 0x19, 0x84, 0xf8, 0xd1, 0xf5, 0x2a, 0x2c, 0x78, 0x5b,
-	0xed, 0x1f, 0x32, 0x30, 0x62, 0xe9, 0xf5, 0xaa, 0xac, 0x48, 0x53, 0x5e, 0xaf, 0xa9, 0x20, 0x83,
-	0x5c, 0xb2, 0x38, 0x0a, 0xfa, 0x53, 0x0d, 0x9c, 0x93, 0xd4, 0xb0, 0xad, 0x29, 0x41, 0x64, 0xc9,
-	0xa5, 0x2f, 0x32, 0x7d, 0xea, 0xcd, 0x6e, 0x61, 0xd4, 0x15, 0x73, 0x4d, 0x81, 0x3a, 0x5f, 0x17,
-	0xc7, 0xe5, 0xb3, 0x94, 0x35, 0x6a, 0x77, 0x8b, 0x80, 0x12, 0xb2, 0xf6, 0xc9, 0x67, 0x15, 0x2a,
-	0x1b, 0x2b, 0x92, 0xcf, 0xea, 0x53, 0x0f, 0xa7, 0xff, 0x58, 0x03, 0x73, 0xfd, 0xea, 0xce, 0x6e,
-	0x1f, 0x31, 0x89, 0x4e, 0xf9, 0x8c, 0x7b, 0x83, 0xf1, 0x31, 0xf9, 0x3e, 0x87, 0xe5, 0xbb, 0x6b,
-	0xde, 0x39, 0x4a, 0x0e, 0x1e, 0x46, 0xe4, 0x95, 0x72, 0x0f, 0x42, 0xfd, 0xef, 0x35, 0x30, 0xdb,
-	0xa7, 0x60, 0x4b, 0x6d, 0x56, 0x32, 0x36, 0xe9, 0x15, 0x57, 0xb0, 0xe0, 0xe9, 0x75, 0x2c, 0xd9,
-	0x1d, 0xf3, 0x96, 0xea, 0x18, 0x16, 0x0b, 0x97, 0xc8, 0xd5, 0xa7, 0x58, 0xea, 0x96, 0x32, 0x5e,
-	0x3f, 0xb2, 0x5c, 0x05, 0x0b, 0xa0, 0x94, 0x72, 0xd1, 0xc8, 0x5f, 0x21, 0xd7, 0x7b, 0x1a, 0x38,
-	0x9b, 0x2f, 0x9e, 0x5a, 0x92, 0x9b, 0x51, 0x9a, 0xd2, 0x58, 0x2f, 0x4a, 0x59, 0xec, 0xda, 0x46,
-	0x26, 0x86, 0x73, 0x67, 0x5c, 0x09, 0x96, 0xfe, 0xe7, 0x1a, 0x98, 0x96, 0x95, 0x47, 0x29, 0x3c,
-	0x36, 0x11, 0xbd, 0x71, 0xfb, 0x68, 0xf4, 0xc5, 0x0e, 0x35, 0x84, 0xfa, 0xa0, 0xc7, 0xcd, 0xe5,
-	0x61, 0xd1, 0xe5, 0x27, 0x2d, 0x84, 0xaa, 0xc8, 0x23, 0x64, 0x21, 0x83, 0xf4, 0xf2, 0xeb, 0x5b,
-	0xc2, 0xa4, 0xbc, 0xfc, 0x50, 0x90, 0x1d, 0xf8, 0x50, 0x24, 0x81, 0xfe, 0x23, 0x0d, 0x9c, 0x57,
-	0x15, 0x3e, 0x6d, 0x28, 0xa3, 0x46, 0xb1, 0x14, 0xd5, 0xa3, 0xf3, 0x14, 0x2d, 0x9c, 0xc0, 0x01,
-	0xa7, 0x4c, 0x16, 0x14, 0x18, 0x64, 0xcb, 0x99, 0x16, 0x14, 0x99, 0x2d, 0x8e, 0x4e, 0x1a, 0x18,
-	0xc8, 0x4a, 0x94, 0x94, 0x81, 0x41, 0x03, 0x31, 0x59, 0x11, 0xe1, 0xc2, 0x3e, 0x06, 0x72, 0x4c,
-	0x05, 0xf5, 0x4c, 0xcb, 0xaa, 0x59, 0x53, 0xa4, 0x52, 0xc7, 0x54, 0x5e, 0xc4, 0xd4, 0xa7, 0x72,
-	0x02, 0x63, 0x44, 0x4e, 0x1d, 0x87, 0xd3, 0x38, 0xf1, 0x95, 0x4f, 0xde, 0x5f, 0xd1, 0xb6, 0xb6,
-	0x3f, 0xf8, 0x68, 0x56, 0xfb, 0xf0, 0xa3, 0x59, 0xed, 0x5f, 0x3e, 0x9a, 0xd5, 0xbe, 0xf1, 0xf1,
-	0xec, 0x0b, 0x1f, 0x7e, 0x3c, 0xfb, 0xc2, 0x3f, 0x7d, 0x3c, 0xfb, 0xc2, 0xcf, 0x2f, 0x93, 0xa1,
-	0xd6, 0x1a, 0x41, 0x08, 0x2b, 0xc9, 0xdf, 0xc8, 0x43, 0xa9, 0x1c, 0xf6, 0x86, 0xc7, 0xff, 0x89,
-	0x78, 0x7d, 0x14, 0xff, 0x27, 0x64, 0x37, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x5c, 0x63,
-	0x1d, 0xee, 0x5c, 0x00, 0x00,
+	// 5161 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x5b, 0x6c, 0x1c, 0xc9,
+	0x75, 0xf6, 0x36, 0x49, 0x91, 0x62, 0x91, 0x92, 0x56, 0xbd, 0x94, 0x38, 0x6c, 0x49, 0xa4, 0xd4,
+	0x12, 0x29, 0x5e, 0xc4, 0x19, 0x5e, 0x96, 0xe2, 0x6a, 0xf6, 0x62, 0x90, 0x94, 0xf4, 0xaf, 0xfc,
+	0x2f, 0x57, 0x8b, 0xa1, 0xd6, 0x0b, 0xe4, 0xa5, 0xd1, 0x33, 0x5d, 0x9c, 0x69, 0x6b, 0xa6, 0x7b,
+	0xd2, 0xdd, 0x43, 0x51, 0xce, 0x83, 0x9d, 0x75, 0x80, 0x38, 0x4e, 0x00, 0x1b, 0x88, 0x83, 0x38,
+	0x40, 0x00, 0xc7, 0x70, 0xae, 0x40, 0x02, 0xac, 0x81, 0x5c, 0x8d, 0xf8, 0x25, 0x4e, 0x02, 0xdb,
+	0x4f, 0x9b, 0x04, 0xb9, 0xc0, 0x40, 0x8c, 0x64, 0x37, 0xc8, 0x22, 0x4f, 0x41, 0x90, 0xe7, 0x20,
+	0x41, 0x5d, 0xba, 0xa6, 0xba, 0xbb, 0xaa, 0xa7, 0x66, 0xc4, 0x5d, 0xf8, 0x65, 0xc1, 0xa9, 0xfa,
+	0x4e, 0xd5, 0x77, 0x4e, 0x9d, 0xaa, 0x3a, 0x55, 0x75, 0x5a, 0x0b, 0xae, 0x34, 0x60, 0xd3, 0xf5,
+	0xc3, 0x52, 0xe3, 0x69, 0x1b, 0x06, 0xae, 0xef, 0x95, 0x8e, 0xd6, 0x4b, 0xad, 0xb0, 0x1e, 0x16,
+	0xdb, 0x81, 0x1f, 0xf9, 0xba, 0x4e, 0xaa, 0x8b, 0x71, 0x75, 0xf1, 0x68, 0xdd, 0x98, 0xad, 0xf9,
+	0x61, 0xcb, 0x0f, 0x4b, 0x55, 0x3b, 0x84, 0xa5, 0xa3, 0xf5, 0x2a, 0x8c, 0xec, 0xf5, 0x52, 0xcd,
+	0x77, 0x3d, 0x22, 0x63, 0x4c, 0xd5, 0xfd, 0xba, 0x8f, 0xff, 0x2c, 0xa1, 0xbf, 0x68, 0xe9, 0xe5,
+	0xba, 0xef, 0xd7, 0x9b, 0xb0, 0x64, 0xb7, 0xdd, 0x92, 0xed, 0x79, 0x7e, 0x64, 0x47, 0xae, 0xef,
+	0xd1, 0x7e, 0x8c, 0x59, 0x01, 0x8d, 0xe8, 0x69, 0x1b, 0xc6, 0xf5, 0x73, 0x82, 0xfa, 0xb6, 0x1d,
+	0xd8, 0xad, 0x18, 0x30, 0x43, 0x9b, 0xc7, 0xbf, 0xaa, 0x9d, 0xc3, 0x92, 0xed, 0x3d, 0xa5, 0x55,
+	0xd3, 0x94, 0x6f, 0x2b, 0xac, 0x53, 0xed, 0x62, 0x19, 0x52, 0x61, 0x11, 0xae, 0xe4, 0x07, 0xad,
+	0x3a, 0x6f, 0xb7, 0x5c, 0xcf, 0x2f, 0xe1, 0xff, 0x92, 0x22, 0xf3, 0x6f, 0x34, 0x70, 0x69, 0x3f,
+	0xac, 0x1f, 0xc0, 0xe8, 0x61, 0x50, 0x6b, 0xc0, 0x30, 0x0a, 0xec, 0xc8, 0x0f, 0x76, 0x1c, 0x27,
+	0x80, 0x61, 0x08, 0x43, 0xfd, 0x22, 0x18, 0x0d, 0xa1, 0xe7, 0xc0, 0xa0, 0xa0, 0x5d, 0xd5, 0x16,
+	0xc7, 0x2b, 0xf4, 0x97, 0x6e, 0x82, 0x49, 0x9f, 0x13, 0x28, 0x0c, 0xe1, 0xda, 0x44, 0x99, 0x3e,
+	0x07, 0x26, 0x60, 0xd4, 0xb0, 0x6c, 0xd2, 0x58, 0x61, 0x18, 0x43, 0x00, 0x8c, 0x1a, 0xb4, 0x79,
+	0x04, 0x88, 0x55, 0xb7, 0x5c, 0xa7, 0x30, 0x72, 0x55, 0x5b, 0x1c, 0xa9, 0x80, 0xb8, 0xe8, 0x81,
+	0x53, 0x7e, 0xf1, 0xdd, 0x8f, 0xde, 0x5b, 0xa6, 0x5d, 0x7e, 0xf9, 0xa3, 0xf7, 0x96, 0x6f, 0x30,
+	0x4b, 0xe5, 0x70, 0x36, 0xe7, 0xc1, 0xf5, 0x9c, 0xea, 0x0a, 0x0c, 0xdb, 0xbe, 0x17, 0x42, 0xf3,
+	0x9f, 0x35, 0xf0, 0xfc, 0x7e, 0x58, 0xff, 0x8c, 0xdd, 0x0c, 0x61, 0xb4, 0xe7, 0x7b, 0x87, 0x6e,
+	0xd0, 0x4a, 0x53, 0xd2, 0xd2, 0x94, 0xf4, 0x29, 0x70, 0xca, 0xf3, 0xbd, 0x1a, 0xc4, 0x1a, 0x8f,
+	0x54, 0xc8, 0x8f, 0x8c, 0x39, 0x86, 0x7b, 0x9b, 0x63, 0x24, 0x63, 0x8e, 0xcb, 0x60, 0x3c, 0x74,
+	0xeb, 0x9e, 0x1d, 0x75, 0x02, 0x58, 0x38, 0x85, 0xab, 0xbb, 0x05, 0xe5, 0x12, 0xb2, 0x45, 0xa2,
+	0x45, 0x64, 0x91, 0x19, 0xde, 0x22, 0x09, 0x55, 0x4c, 0x03, 0x14, 0xd2, 0x65, 0x4c, 0xf7, 0x77,
+	0x87, 0xc0, 0x59, 0x6c, 0x23, 0xcf, 0x79, 0xe4, 0xef, 0x35, 0x6c, 0xd7, 0xcb, 0x19, 0xe9, 0x33,
+	0x0e, 0x0c, 0x23, 0xab, 0x86, 0x50, 0xc8, 0x26, 0x44, 0xf1, 0x09, 0x54, 0x88, 0x25, 0x1f, 0x38,
+	0xba, 0x0e, 0x46, 0xd0, 0x4f, 0xaa, 0x36, 0xfe, 0x5b, 0xdf, 0x06, 0xa3, 0x76, 0xcb, 0xef, 0x78,
+	0x11, 0xd6, 0x74, 0x62, 0x63, 0xa6, 0x48, 0x7d, 0x11, 0xcd, 0xb0, 0x22, 0x9d, 0x61, 0xc5, 0x3d,
+	0xdf, 0xf5, 0x76, 0x47, 0xbe, 0xff, 0xe3, 0xb9, 0xe7, 0x2a, 0x14, 0xae, 0xbf, 0x06, 0x40, 0x35,
+	0x70, 0x9d, 0x3a, 0xb4, 0x0e, 0x21, 0xb1, 0x83, 0x82, 0xf0, 0x38, 0x11, 0xb9, 0x0f, 0x61, 0xf9,
+	0x66, 0xca, 0x69, 0xa6, 0x93, 0x4e, 0xc3, 0x34, 0x36, 0x0b, 0xe0, 0x62, 0xb2, 0x84, 0x99, 0xe7,
+	0xd7, 0x35, 0x70, 0x6e, 0x3f, 0xac, 0x57, 0xe0, 0x4f, 0x77, 0x60, 0x18, 0xed, 0xda, 0x51, 0xad,
+	0xd1, 0xdb, 0x33, 0x54, 0xa6, 0xc4, 0x14, 0x38, 0xe5, 0x40, 0xcf, 0x6f, 0x51, 0x4b, 0x91, 0x1f,
+	0xe5, 0xa2, 0x70, 0x68, 0x0b, 0x3c, 0x6f, 0x9e, 0x8a, 0x39, 0x03, 0xa6, 0x53, 0x45, 0x8c, 0xf9,
+	0x87, 0x43, 0xe0, 0x4a, 0xaa, 0xee, 0x1d, 0x37, 0x6a, 0xec, 0xbb, 0x9e, 0xdb, 0xea, 0xb4, 0xee,
+	0x43, 0xf8, 0x31, 0xea, 0xa1, 0xff, 0x7f, 0x70, 0xbe, 0x45, 0x3a, 0xb2, 0xaa, 0xa8, 0x67, 0x3c,
+	0x80, 0x8a, 0xa3, 0x7f, 0x8e, 0x4a, 0x62, 0xca, 0x88, 0xe7, 0x3d, 0x70, 0x36, 0x6e, 0x2c, 0x3a,
+	0xee, 0xc7, 0x15, 0x26, 0xa9, 0xd8, 0xa3, 0x63, 0xd4, 0xcc, 0x05, 0x30, 0x1a, 0x1d, 0x5b, 0xae,
+	0x13, 0x16, 0x46, 0xaf, 0x0e, 0xa3, 0x09, 0x1b, 0x1d, 0x3f, 0x70, 0xc2, 0xf2, 0x2b, 0x42, 0x93,
+	0x2f, 0xc8, 0x4c, 0x9e, 0xb4, 0xa1, 0x79, 0x13, 0xcc, 0xe7, 0x02, 0xd8, 0x70, 0x7c, 0x61, 0x08,
+	0x3b, 0x12, 0x9d, 0x7e, 0x8a, 0x8e, 0x24, 0x5e, 0x62, 0xe6, 0xc1, 0xd9, 0xc8, 0x7f, 0x0c, 0x3d,
+	0xab, 0xe6, 0x7b, 0x51, 0x60, 0xd7, 0xe2, 0xd9, 0x76, 0x06, 0x97, 0xee, 0xd1, 0x42, 0xfd, 0x0a,
+	0x40, 0x4b, 0x8a, 0x85, 0xd6, 0x0d, 0x18, 0xd0, 0x45, 0x66, 0x1c, 0x46, 0x8d, 0x03, 0x5c, 0x90,
+	0x19, 0xdc, 0x53, 0x82, 0xc1, 0x4d, 0xac, 0x43, 0xa3, 0xe9, 0x75, 0x48, 0xc1, 0x59, 0x79, 0x75,
+	0xa9, 0xb3, 0xf2, 0x45, 0xbc, 0x75, 0x66, 0xba, 0x75, 0xfb, 0x9d, 0x66, 0xe4, 0xb6, 0x9b, 0x10,
+	0x63, 0x60, 0xd8, 0xdb, 0x4e, 0x49, 0x55, 0x87, 0x7a, 0xa9, 0x2a, 0x5a, 0x93, 0xef, 0x81, 0xb1,
+	0x2a, 0xe9, 0xae, 0x30, 0x72, 0x75, 0x78, 0x71, 0x62, 0x63, 0xa5, 0x98, 0x8d, 0x0d, 0x8a, 0x98,
+	0xd1, 0x9b, 0x68, 0x14, 0xde, 0x71, 0x49, 0xf3, 0xd8, 0x14, 0x95, 0x58, 0xb6, 0xfc, 0x92, 0xd0,
+	0x26, 0xa6, 0xc0, 0x26, 0x29, 0x25, 0xcd, 0xeb, 0xe0, 0x9a, 0xb4, 0x92, 0xd9, 0xe9, 0x17, 0x46,
+	0xb0, 0x17, 0xdd, 0x85, 0x6d, 0x3f, 0x74, 0xa3, 0xbd, 0xa6, 0xed, 0x2a, 0x6c, 0x54, 0x68, 0xbb,
+	0x39, 0x82, 0x5e, 0x64, 0xf1, 0xbe, 0x04, 0x70, 0x11, 0x56, 0x45, 0xbf, 0x06, 0x26, 0xab, 0x4d,
+	0xbf, 0xf6, 0xd8, 0x6a, 0x40, 0xb7, 0xde, 0x20, 0xee, 0x34, 0x52, 0x99, 0xc0, 0x65, 0xaf, 0xe3,
+	0x22, 0x81, 0xcf, 0x8d, 0x88, 0x7c, 0x6e, 0x8b, 0x2d, 0xf5, 0xd8, 0x9d, 0x76, 0xaf, 0xa0, 0x79,
+	0xf8, 0xa3, 0x1f, 0xcf, 0x5d, 0x20, 0x33, 0x35, 0x74, 0x1e, 0x17, 0x5d, 0xbf, 0xd4, 0xb2, 0xa3,
+	0x46, 0xf1, 0x81, 0x17, 0xb1, 0x85, 0xfe, 0x26, 0x38, 0x07, 0xa3, 0x06, 0x0c, 0x60, 0xa7, 0x65,
+	0xd1, 0xad, 0x87, 0x78, 0xdb, 0xd9, 0xb8, 0xf8, 0x00, 0x97, 0x22, 0x20, 0x0d, 0x6a, 0x02, 0x58,
+	0x83, 0xee, 0x11, 0x0c, 0x0a, 0x63, 0x04, 0x48, 0x8a, 0x2b, 0xb4, 0x34, 0x33, 0xe4, 0xa7, 0x05,
+	0x43, 0x8e, 0xf6, 0x2a, 0x3b, 0xb2, 0x0b, 0xe3, 0x74, 0xaf, 0xb2, 0x23, 0x5b, 0x9f, 0x06, 0x63,
+	0xd1, 0xb1, 0xd5, 0xb0, 0xc3, 0x46, 0x01, 0x90, 0xcd, 0x2f, 0x3a, 0x7e, 0xdd, 0x0e, 0x1b, 0xfa,
+	0x0c, 0x38, 0x1d, 0xb4, 0x6b, 0x56, 0x27, 0x84, 0x4e, 0x61, 0x02, 0xd7, 0x8c, 0x05, 0xed, 0xda,
+	0xdb, 0x21, 0x74, 0xf4, 0x7b, 0xe0, 0x0c, 0x66, 0xd3, 0x8e, 0x50, 0xa8, 0xe5, 0x1f, 0x16, 0x26,
+	0xf1, 0xf2, 0x74, 0x55, 0xe4, 0x40, 0x15, 0x02, 0x7c, 0x0b, 0xe1, 0x2a, 0x93, 0x01, 0xf7, 0x4b,
+	0x65, 0x3a, 0xf1, 0xe3, 0x4e, 0xa7, 0x13, 0x5f, 0xc4, 0xdc, 0xe4, 0x2b, 0xc3, 0x38, 0xa0, 0x41,
+	0x3e, 0xea, 0x04, 0xf6, 0x93, 0x4f, 0xd0, 0x4f, 0xe6, 0xc0, 0x04, 0x59, 0xf0, 0x49, 0x1b, 0x34,
+	0x90, 0xab, 0xb2, 0x69, 0x23, 0x70, 0xa4, 0x53, 0x22, 0x47, 0x4a, 0x8f, 0xdf, 0xa8, 0x60, 0xfc,
+	0xb8, 0xb1, 0x1a, 0x93, 0x8e, 0xd5, 0xe9, 0x1e, 0x63, 0x35, 0x3e, 0xd0, 0x58, 0x29, 0x84, 0x60,
+	0x09, 0xe3, 0xd3, 0x10, 0x2c, 0x51, 0xc6, 0x46, 0xeb, 0xe7, 0x86, 0xc1, 0xd4, 0x7e, 0x58, 0xbf,
+	0x77, 0x1c, 0xc1, 0xc0, 0xb3, 0x9b, 0x77, 0xed, 0xc8, 0x56, 0x1c, 0xb1, 0xf4, 0x80, 0x0c, 0x65,
+	0x07, 0x64, 0x06, 0x9c, 0x8e, 0x8e, 0xe9, 0x68, 0x90, 0xf1, 0x1a, 0x8b, 0x8e, 0xc9, 0x50, 0x94,
+	0xc1, 0x0c, 0xa4, 0x7d, 0xb2, 0xd1, 0x48, 0x05, 0xa5, 0xd3, 0x31, 0x20, 0x1e, 0x98, 0x38, 0x42,
+	0x55, 0xd9, 0x3d, 0x16, 0xc1, 0xf3, 0x35, 0xbb, 0xd9, 0xb4, 0xd0, 0xc4, 0xb2, 0x02, 0x18, 0x76,
+	0x9a, 0x51, 0x3c, 0xad, 0x51, 0x39, 0xd2, 0xb3, 0x82, 0x4b, 0xf5, 0x4d, 0x70, 0x31, 0x8d, 0xb4,
+	0x60, 0x10, 0xf8, 0xf1, 0xec, 0x7e, 0x21, 0x89, 0xbf, 0x87, 0xaa, 0x72, 0x46, 0xb9, 0xbc, 0x29,
+	0x1c, 0x9e, 0x2b, 0xfc, 0xf0, 0x64, 0xac, 0x6d, 0xce, 0x82, 0xcb, 0xa2, 0x72, 0x36, 0x4c, 0x5f,
+	0x1c, 0x06, 0x17, 0x10, 0xa0, 0xb2, 0xb7, 0xb1, 0x76, 0x17, 0xb6, 0x9b, 0xfe, 0x53, 0xe8, 0x7c,
+	0x82, 0x33, 0xeb, 0x1a, 0x98, 0xa4, 0x4b, 0x1f, 0x89, 0xb7, 0xc8, 0x00, 0x4d, 0x90, 0xb2, 0xbb,
+	0xa8, 0x48, 0x75, 0x6e, 0xe9, 0x60, 0xc4, 0xb3, 0x5b, 0xf1, 0x86, 0x8e, 0xff, 0xc6, 0x31, 0xff,
+	0xd3, 0x56, 0xd5, 0x6f, 0xc6, 0x53, 0x89, 0xfc, 0xd2, 0x0d, 0x70, 0xda, 0x81, 0x35, 0xb7, 0x65,
+	0x37, 0x43, 0x6c, 0xe4, 0x91, 0x0a, 0xfb, 0x9d, 0xf1, 0x81, 0x71, 0x81, 0x0f, 0xf0, 0x83, 0x04,
+	0x92, 0x83, 0xf4, 0xa2, 0x70, 0x90, 0x66, 0x13, 0x83, 0x94, 0xb1, 0xb5, 0x39, 0x07, 0xae, 0x08,
+	0x2b, 0xd8, 0x30, 0x7d, 0x43, 0xc3, 0xb3, 0x69, 0xcf, 0xf6, 0x6a, 0xb0, 0xc9, 0x1f, 0x6b, 0x90,
+	0x75, 0x02, 0xdb, 0x0b, 0xed, 0x5a, 0x94, 0x18, 0xa8, 0x33, 0x5c, 0xe9, 0x03, 0x87, 0x3b, 0xfd,
+	0x0c, 0x25, 0x4e, 0x3f, 0x33, 0xe0, 0x34, 0x3b, 0xf8, 0xd0, 0x89, 0x54, 0x23, 0x87, 0x9e, 0xf2,
+	0x6a, 0xea, 0x9c, 0x91, 0x70, 0xb4, 0x0c, 0x11, 0xea, 0x68, 0x99, 0x72, 0xa6, 0xc1, 0x77, 0x35,
+	0xac, 0xe3, 0x41, 0xa7, 0xda, 0x72, 0xa3, 0x5d, 0xdb, 0x61, 0x61, 0xc6, 0xbd, 0x23, 0xd7, 0x81,
+	0xc8, 0x5d, 0x8a, 0x60, 0x2c, 0xec, 0x54, 0x3f, 0x0b, 0x6b, 0x11, 0xd6, 0x61, 0x62, 0x63, 0xaa,
+	0x48, 0xee, 0x07, 0x8a, 0xf1, 0xfd, 0x40, 0x71, 0xc7, 0x7b, 0x5a, 0x89, 0x41, 0xc9, 0x38, 0x6e,
+	0x28, 0x15, 0xc7, 0x71, 0x1a, 0x0f, 0xf3, 0x1a, 0x97, 0x6f, 0xa7, 0xd4, 0x4a, 0xc4, 0xc4, 0x72,
+	0x76, 0x34, 0x26, 0x96, 0x03, 0x98, 0xa2, 0xdf, 0x21, 0x33, 0x8a, 0x1c, 0x4c, 0xdf, 0x6e, 0x3b,
+	0x76, 0x74, 0xa2, 0x33, 0xea, 0x08, 0xb7, 0x9b, 0x58, 0xfb, 0x26, 0x48, 0x99, 0x78, 0xd2, 0x8d,
+	0x64, 0x27, 0xdd, 0xab, 0x60, 0xac, 0x05, 0x5b, 0x55, 0x18, 0x84, 0x85, 0x53, 0x38, 0x2a, 0xbc,
+	0x2e, 0x8c, 0x0a, 0xf1, 0x89, 0xf3, 0x33, 0x76, 0xd3, 0x75, 0x90, 0x1b, 0x57, 0x62, 0x19, 0x7d,
+	0x17, 0xed, 0x36, 0x4f, 0xec, 0xc0, 0xb1, 0x68, 0x54, 0x34, 0xaa, 0x12, 0x15, 0x4d, 0x12, 0x99,
+	0x1d, 0x2c, 0x82, 0x58, 0xd2, 0x36, 0xf0, 0x2c, 0xa6, 0xf3, 0x73, 0x82, 0x94, 0x3d, 0x42, 0x45,
+	0x4a, 0xc1, 0x0e, 0x3f, 0x11, 0xc7, 0xfb, 0x9e, 0x88, 0xd9, 0x21, 0xa2, 0x13, 0x31, 0x5b, 0xc1,
+	0x46, 0xf7, 0xbf, 0x88, 0x1b, 0xef, 0x38, 0xce, 0x1e, 0x52, 0x04, 0x06, 0x6d, 0x3b, 0x88, 0x9e,
+	0x62, 0x57, 0x7f, 0x0b, 0x5f, 0x6d, 0xe9, 0xb7, 0xc1, 0xb8, 0xdd, 0x89, 0x1a, 0x7e, 0xe0, 0x46,
+	0x4f, 0xc9, 0x5d, 0xc3, 0x6e, 0xe1, 0x6f, 0xff, 0x70, 0x75, 0x8a, 0x1e, 0xeb, 0xe8, 0x5e, 0x73,
+	0x10, 0x05, 0xae, 0x57, 0xaf, 0x74, 0xa1, 0x7a, 0x1d, 0xcc, 0xd4, 0xb8, 0x26, 0xe9, 0x85, 0x04,
+	0xb9, 0x2f, 0xc3, 0xae, 0x20, 0x89, 0xde, 0x25, 0x3c, 0x2a, 0xd3, 0x35, 0x71, 0x05, 0xd9, 0xe6,
+	0xbb, 0x1d, 0x23, 0xb3, 0x5c, 0xe6, 0xcd, 0x82, 0x74, 0x43, 0xf0, 0x47, 0x3e, 0x11, 0xa0, 0xae,
+	0x2f, 0x57, 0x99, 0x19, 0xe7, 0x3d, 0x72, 0xaf, 0x40, 0x0c, 0xf7, 0x8c, 0xe6, 0x78, 0x09, 0x8c,
+	0x26, 0x74, 0x37, 0x44, 0xba, 0x93, 0x3e, 0xe2, 0x0b, 0x16, 0x82, 0x2f, 0xaf, 0x64, 0xf5, 0x4b,
+	0xc4, 0x9b, 0x3c, 0x3d, 0x1a, 0x6f, 0xf2, 0x45, 0x4c, 0x9b, 0xaf, 0x6a, 0x78, 0x22, 0xef, 0x36,
+	0xed, 0xda, 0xe3, 0xa6, 0x1b, 0x46, 0xc9, 0x5b, 0x43, 0x72, 0x2a, 0x8b, 0xef, 0x92, 0xf0, 0x2f,
+	0xbd, 0x04, 0x5e, 0xa8, 0xc6, 0xe8, 0x38, 0xe6, 0x80, 0x48, 0x81, 0xe1, 0xc5, 0xf1, 0x8a, 0x5e,
+	0xcd, 0x34, 0x44, 0xa2, 0x63, 0x2a, 0x9d, 0x71, 0xcf, 0x6c, 0xc7, 0xd4, 0x3d, 0xb3, 0x15, 0x8c,
+	0xf3, 0x97, 0x35, 0xa0, 0xe3, 0xa3, 0xfb, 0x91, 0xff, 0x18, 0x32, 0xdc, 0xc9, 0x11, 0x5e, 0x49,
+	0x11, 0xbe, 0x94, 0xbc, 0x51, 0x48, 0xf4, 0x6a, 0x5e, 0x06, 0x46, 0xb6, 0x94, 0x51, 0xfd, 0x68,
+	0x08, 0x2c, 0xef, 0x87, 0xf5, 0xfb, 0x7e, 0x50, 0x83, 0x07, 0x30, 0x22, 0x73, 0x6e, 0xc7, 0x73,
+	0xde, 0xb0, 0xc3, 0xe8, 0x61, 0x35, 0x84, 0xc1, 0x11, 0x74, 0xee, 0x75, 0x97, 0x3e, 0x99, 0x0a,
+	0xa9, 0x45, 0x75, 0x28, 0xb3, 0xa8, 0x6e, 0x80, 0x51, 0xb2, 0x3e, 0x16, 0x86, 0xe5, 0x8e, 0x44,
+	0x7a, 0xaf, 0x50, 0xa4, 0x7e, 0x07, 0xcc, 0x34, 0xed, 0x30, 0xb2, 0x7c, 0xca, 0xc3, 0xe2, 0x97,
+	0x65, 0xb2, 0xa2, 0x5e, 0x6c, 0x8a, 0x79, 0xbe, 0x01, 0xae, 0xa7, 0x44, 0xe3, 0x33, 0x60, 0x62,
+	0x59, 0x3e, 0x85, 0x1b, 0x99, 0x4b, 0x34, 0x42, 0x81, 0xbb, 0xdd, 0xa5, 0xba, 0xbc, 0x97, 0xb2,
+	0xf7, 0x26, 0x6f, 0x6f, 0x45, 0xd3, 0x99, 0x2f, 0x82, 0x0d, 0x75, 0x34, 0x1b, 0x9f, 0xff, 0xd5,
+	0xc0, 0x3c, 0x9b, 0x1a, 0x99, 0x99, 0xff, 0xc0, 0x3b, 0xf4, 0x43, 0x3a, 0xc5, 0x65, 0x43, 0xb3,
+	0x00, 0xce, 0xd1, 0x9b, 0xce, 0xd4, 0xe5, 0xea, 0x19, 0x52, 0x1c, 0x5f, 0xaf, 0x2e, 0x83, 0xf3,
+	0x09, 0x5c, 0xd3, 0xaf, 0xfb, 0x74, 0xd7, 0x3e, 0xc7, 0x21, 0xdf, 0xf0, 0xeb, 0x7e, 0x06, 0x8b,
+	0x63, 0xbe, 0x91, 0x0c, 0xf6, 0x4d, 0xbb, 0x05, 0xcb, 0xaf, 0xa5, 0x8c, 0x57, 0xcc, 0xae, 0x02,
+	0x79, 0x7a, 0x99, 0x0f, 0xc0, 0xaa, 0x12, 0x30, 0x36, 0x99, 0x5e, 0x00, 0x63, 0x1d, 0x8c, 0x26,
+	0x9b, 0xfb, 0xe9, 0x4a, 0xfc, 0xd3, 0xfc, 0x36, 0xd9, 0x36, 0xde, 0xf6, 0xfa, 0x7e, 0x89, 0xe8,
+	0xe9, 0xdf, 0xbd, 0x9e, 0x21, 0xf2, 0x23, 0x1e, 0x39, 0x23, 0xba, 0xec, 0xcb, 0x01, 0xcc, 0x53,
+	0xfe, 0x73, 0x08, 0x5c, 0xea, 0x1a, 0x0a, 0x19, 0xe7, 0xa0, 0x65, 0x07, 0x11, 0x0b, 0xcd, 0x65,
+	0xfe, 0xc1, 0x07, 0x9f, 0x43, 0x89, 0xe0, 0x53, 0xbf, 0x0d, 0xa6, 0xe3, 0x61, 0x4e, 0x9f, 0xe1,
+	0x88, 0x82, 0x17, 0xe8, 0x60, 0xa7, 0x4e, 0x70, 0x9f, 0x02, 0x97, 0xd3, 0x72, 0x61, 0x64, 0x07,
+	0x51, 0x32, 0x1a, 0x9a, 0x49, 0x0a, 0x1f, 0x20, 0x04, 0x8d, 0x8d, 0xd6, 0xc0, 0x54, 0x57, 0xd2,
+	0xef, 0x04, 0x35, 0x48, 0xce, 0xe2, 0xe4, 0xcc, 0xa1, 0xc7, 0x75, 0x07, 0xb8, 0x0a, 0x9f, 0xcb,
+	0x5f, 0x01, 0xc6, 0xa1, 0x1b, 0xa0, 0x19, 0xcf, 0x19, 0x89, 0xb1, 0x25, 0xc7, 0x91, 0x02, 0x46,
+	0x08, 0xac, 0x18, 0x3f, 0x01, 0x31, 0x1f, 0xbd, 0x21, 0xf0, 0xd1, 0x8c, 0x45, 0xe9, 0x13, 0x90,
+	0xac, 0x9a, 0x0d, 0xcc, 0x97, 0xc8, 0x6e, 0xc0, 0xe1, 0xf0, 0x1c, 0x1a, 0x60, 0x3c, 0x74, 0x30,
+	0xc2, 0xcd, 0x4a, 0xfc, 0x77, 0xfe, 0x5e, 0x90, 0xea, 0x93, 0xee, 0x05, 0xa9, 0xd2, 0x1c, 0xa2,
+	0x6f, 0xc6, 0xe7, 0xb7, 0xfe, 0x89, 0xe2, 0x25, 0x61, 0xb8, 0x7b, 0x0c, 0x54, 0x26, 0x8a, 0xfa,
+	0xcc, 0x12, 0x45, 0xa5, 0x8c, 0x68, 0x13, 0xbf, 0x2b, 0xdd, 0x85, 0x4d, 0x48, 0x6b, 0x07, 0xe0,
+	0x18, 0xbf, 0xe0, 0x30, 0x3e, 0xd3, 0xc9, 0xdb, 0x30, 0xd6, 0x36, 0x7d, 0xc1, 0xe1, 0x4a, 0x52,
+	0x3c, 0xf6, 0x9a, 0xd0, 0x0e, 0xc8, 0x7a, 0x7e, 0xe2, 0x3c, 0xb8, 0xb6, 0x29, 0x0f, 0xae, 0x84,
+	0xf1, 0xf8, 0x26, 0x3d, 0x97, 0x36, 0x6c, 0xaf, 0x0e, 0x1f, 0x78, 0x6e, 0xe4, 0xda, 0x4d, 0xf7,
+	0x73, 0x30, 0x18, 0x64, 0xe8, 0x6e, 0x82, 0x73, 0x1e, 0x7c, 0x62, 0xb9, 0xdd, 0x56, 0xe8, 0x28,
+	0x9e, 0xf5, 0xe0, 0x13, 0xae, 0xed, 0xf8, 0x64, 0xca, 0x78, 0x27, 0x4f, 0xa6, 0x69, 0x2a, 0xf1,
+	0xc9, 0x34, 0x5d, 0xce, 0x74, 0xf8, 0x2c, 0x38, 0xb3, 0x1f, 0xd6, 0xdf, 0xb2, 0x3b, 0xe1, 0xe0,
+	0x43, 0xba, 0x90, 0xa2, 0x74, 0x91, 0xa7, 0xd4, 0x6d, 0xda, 0x9c, 0x06, 0x17, 0x12, 0x05, 0x8c,
+	0x84, 0x47, 0x22, 0x67, 0xaf, 0xfd, 0x4c, 0x34, 0x16, 0x53, 0x34, 0x92, 0x71, 0x2f, 0xd7, 0x78,
+	0x1c, 0xf7, 0x7a, 0xed, 0x2c, 0x95, 0x9f, 0x01, 0xe3, 0x24, 0xdc, 0xaf, 0xb4, 0x6b, 0x83, 0x8c,
+	0xe3, 0x34, 0x18, 0xc3, 0x87, 0xb2, 0xa0, 0x19, 0x1f, 0xbd, 0xd1, 0x99, 0x2c, 0x68, 0x96, 0xcd,
+	0x14, 0x3b, 0x3d, 0x75, 0xea, 0xa8, 0xb4, 0x6b, 0xe6, 0x0b, 0xe0, 0x3c, 0xfb, 0xc1, 0x18, 0xfd,
+	0xac, 0x06, 0x26, 0x71, 0x24, 0xd9, 0xf2, 0x8f, 0xe0, 0x49, 0xb3, 0x9a, 0x4f, 0xb1, 0xba, 0x90,
+	0x0c, 0x69, 0x69, 0x97, 0xe6, 0x45, 0x30, 0xc5, 0xff, 0x66, 0xdc, 0xbe, 0x37, 0x84, 0x97, 0xae,
+	0x03, 0x18, 0xe1, 0x63, 0x2b, 0xff, 0xdc, 0xdc, 0x27, 0xc3, 0xeb, 0x80, 0x5c, 0x69, 0xa5, 0x76,
+	0xba, 0x49, 0x5c, 0x18, 0x6f, 0x70, 0xec, 0x65, 0x72, 0x84, 0x7f, 0x99, 0xec, 0x5e, 0x74, 0x9d,
+	0x92, 0x5e, 0x74, 0x8d, 0xa6, 0x2e, 0xba, 0xd6, 0xc0, 0x94, 0x1b, 0x5a, 0xf4, 0xf6, 0xcd, 0x0f,
+	0xdc, 0xba, 0xeb, 0xe1, 0xc8, 0x65, 0x0c, 0x47, 0x2e, 0xba, 0x1b, 0xee, 0xe1, 0xaa, 0x87, 0xac,
+	0x46, 0xbf, 0x05, 0x74, 0x2c, 0xe1, 0xd5, 0xa0, 0x17, 0x76, 0x42, 0x7a, 0x74, 0x3f, 0x8d, 0xf1,
+	0xcf, 0x23, 0x3c, 0xad, 0xc0, 0x86, 0xc8, 0x5f, 0x75, 0x53, 0xe6, 0xa2, 0xab, 0x6e, 0xaa, 0x94,
+	0xd9, 0xf8, 0x6b, 0x1a, 0x98, 0x66, 0xc6, 0xc7, 0x88, 0xfb, 0x81, 0xdf, 0x1a, 0xd8, 0xd0, 0xe2,
+	0x57, 0xea, 0xb5, 0x14, 0xdf, 0xab, 0x59, 0x3f, 0x48, 0x76, 0x6d, 0x5e, 0x03, 0x73, 0x92, 0xaa,
+	0x6e, 0x68, 0x44, 0x3c, 0x77, 0xdf, 0xf5, 0x88, 0x66, 0x1f, 0x9b, 0x5f, 0x6c, 0x25, 0xd2, 0x11,
+	0x94, 0xdf, 0xa8, 0x96, 0xc0, 0xf3, 0xf1, 0x9b, 0x13, 0x6b, 0x9e, 0xb8, 0xd0, 0xb9, 0xb8, 0x3c,
+	0x8e, 0x54, 0x72, 0xe7, 0x09, 0x53, 0x90, 0xce, 0x13, 0xf6, 0x9b, 0x59, 0xe2, 0x2f, 0x89, 0x25,
+	0x76, 0x3b, 0x81, 0xf7, 0x93, 0x68, 0x89, 0x7c, 0xf5, 0x18, 0x6b, 0xaa, 0x1e, 0xfb, 0xcd, 0xd4,
+	0xfb, 0x15, 0x0d, 0x2f, 0x5c, 0xec, 0x7c, 0x95, 0x7f, 0x68, 0xcd, 0xd1, 0xb1, 0xf7, 0x15, 0x5f,
+	0x79, 0x39, 0x45, 0xd5, 0x48, 0xcd, 0x2c, 0x8e, 0x81, 0x79, 0x09, 0xcc, 0x64, 0x0a, 0xf9, 0x31,
+	0xb9, 0x4c, 0x6a, 0xf7, 0x5d, 0x6f, 0xcf, 0x6e, 0x36, 0xf9, 0x77, 0x82, 0xff, 0x67, 0x87, 0x83,
+	0xf0, 0x2f, 0x03, 0xa3, 0xe5, 0x7a, 0x16, 0x7e, 0xf9, 0x60, 0x0f, 0x31, 0xf8, 0x09, 0xa4, 0x6e,
+	0x87, 0x54, 0x9b, 0x8b, 0x2d, 0x61, 0x77, 0xe5, 0xad, 0x94, 0x62, 0xf3, 0x29, 0xc5, 0xc4, 0x2c,
+	0xcd, 0x05, 0x70, 0x23, 0xaf, 0x9e, 0xa9, 0xfb, 0xbe, 0x06, 0x74, 0xde, 0x18, 0x15, 0x7c, 0xdb,
+	0xf8, 0x93, 0xe6, 0x88, 0xbd, 0xd6, 0x4d, 0x9e, 0x7b, 0x77, 0xdd, 0xe4, 0x4b, 0x99, 0xc2, 0xff,
+	0xa8, 0xe1, 0xe7, 0xf7, 0x03, 0x18, 0xbd, 0xed, 0x55, 0x7d, 0xcf, 0x39, 0x68, 0xda, 0x61, 0xc3,
+	0xf5, 0xe8, 0xfd, 0x66, 0xf8, 0x8e, 0xeb, 0x39, 0xfe, 0x93, 0x41, 0xf4, 0xdf, 0x03, 0xb3, 0x1d,
+	0xdc, 0xa2, 0x15, 0xd2, 0x26, 0x2d, 0xe2, 0xa0, 0xa1, 0xf5, 0x04, 0x37, 0x4a, 0x07, 0xfa, 0x52,
+	0x47, 0xde, 0x6f, 0xb9, 0x9c, 0x52, 0x74, 0x39, 0xa5, 0x68, 0x0e, 0x67, 0x73, 0x05, 0x2c, 0xf5,
+	0x04, 0x31, 0x33, 0xfc, 0x60, 0x08, 0x5c, 0x60, 0x31, 0xfd, 0x5d, 0x78, 0x68, 0x77, 0x9a, 0x1f,
+	0xf3, 0x6a, 0x7c, 0x72, 0xbb, 0xb4, 0x78, 0xcf, 0x1d, 0x13, 0xef, 0xb9, 0xd2, 0x3d, 0xfd, 0xb4,
+	0x6c, 0x4f, 0xcf, 0xbf, 0x81, 0xcc, 0x5a, 0x8c, 0xde, 0x40, 0x66, 0x2b, 0x98, 0xb1, 0xff, 0x43,
+	0xe3, 0x8c, 0xfd, 0xb0, 0x13, 0x3d, 0x3a, 0x7e, 0xe4, 0xb6, 0xa0, 0xdf, 0x19, 0xe8, 0x1a, 0xe0,
+	0x65, 0x60, 0x44, 0x76, 0x50, 0x87, 0x91, 0xe5, 0x77, 0xa2, 0xba, 0x8f, 0xfc, 0x2c, 0x3a, 0xb6,
+	0x22, 0xd2, 0x20, 0xf5, 0xb1, 0x69, 0x82, 0x78, 0x48, 0x01, 0xdd, 0xfe, 0xd6, 0xc0, 0x14, 0x15,
+	0x26, 0x8f, 0xf7, 0xb1, 0x18, 0xb9, 0x03, 0xd0, 0x49, 0x1d, 0xce, 0x45, 0xa1, 0x12, 0x2a, 0xc6,
+	0xe0, 0x35, 0x4a, 0x18, 0x83, 0xaf, 0x60, 0xc6, 0xf8, 0x25, 0x0d, 0xcc, 0xb2, 0x57, 0xb1, 0x9d,
+	0x66, 0xf3, 0x2d, 0xe8, 0x39, 0xae, 0x57, 0xef, 0x72, 0x1d, 0x64, 0x89, 0x2d, 0x6f, 0xa7, 0x68,
+	0xde, 0xcc, 0xbe, 0xcc, 0x09, 0xfb, 0x32, 0x17, 0xc1, 0x42, 0x3e, 0x82, 0xcf, 0x10, 0xbc, 0xc4,
+	0xa0, 0x27, 0xc2, 0x1a, 0xcd, 0x09, 0xfc, 0x22, 0x41, 0x87, 0x8d, 0xfc, 0xc8, 0xbf, 0xff, 0x90,
+	0x75, 0x4f, 0xef, 0x3f, 0x64, 0xd5, 0x4c, 0x8b, 0x3f, 0xd1, 0xb8, 0xdb, 0x7d, 0xfa, 0xa6, 0xf1,
+	0x18, 0x0e, 0x7c, 0x09, 0xa2, 0x34, 0xf5, 0xe3, 0x9b, 0x92, 0x11, 0xee, 0xa6, 0x24, 0x37, 0xb4,
+	0x14, 0xb1, 0xa3, 0xa1, 0xa5, 0xa8, 0x8a, 0x7f, 0x6c, 0x99, 0x61, 0x98, 0x9d, 0x23, 0x18, 0xd8,
+	0x75, 0x88, 0xaf, 0x8e, 0x91, 0x13, 0x0e, 0xa2, 0xde, 0x2d, 0xa0, 0xdb, 0xa4, 0x19, 0x7a, 0x55,
+	0x8d, 0x26, 0x0c, 0x1d, 0xad, 0xe7, 0xed, 0x54, 0x07, 0xe5, 0x8d, 0x94, 0x4e, 0x66, 0x56, 0xa7,
+	0x34, 0x29, 0x9a, 0x0d, 0x26, 0xae, 0x64, 0x7a, 0xfd, 0x2b, 0x7f, 0xef, 0x4c, 0x51, 0xfc, 0xed,
+	0xeb, 0x33, 0xe9, 0x78, 0x0f, 0xcc, 0xc5, 0x3a, 0x26, 0x1e, 0xdb, 0x32, 0x0a, 0x5f, 0xb6, 0x73,
+	0x7a, 0x56, 0xb9, 0x59, 0xce, 0x63, 0x6e, 0x96, 0xc0, 0xaa, 0x12, 0x90, 0x19, 0xe5, 0xbf, 0x87,
+	0x80, 0x99, 0x93, 0xf4, 0x8d, 0x52, 0x70, 0xee, 0x43, 0x62, 0x91, 0x81, 0x2e, 0x91, 0x4f, 0x24,
+	0xc1, 0xfb, 0x84, 0x52, 0x5a, 0x85, 0x69, 0xb6, 0xa3, 0x83, 0xa5, 0xd9, 0x96, 0x5f, 0x4e, 0x5d,
+	0x7e, 0xaf, 0xa8, 0xa4, 0xd8, 0x53, 0x73, 0x9a, 0xb7, 0xc0, 0x72, 0x6f, 0x14, 0x1b, 0xa3, 0xdf,
+	0x1f, 0xe2, 0xdc, 0x5b, 0x28, 0xf1, 0x4c, 0x43, 0x94, 0xb5, 0xee, 0xf0, 0x89, 0x59, 0x77, 0xc0,
+	0x24, 0xe6, 0x38, 0x84, 0x63, 0xd6, 0x5d, 0x16, 0x6c, 0x98, 0x12, 0x43, 0xd0, 0x10, 0x2e, 0x1f,
+	0xc4, 0x6c, 0xfb, 0x75, 0x12, 0xc9, 0x92, 0xab, 0xd0, 0x13, 0xb7, 0x6d, 0xbe, 0x1e, 0xf9, 0x9d,
+	0x52, 0x3d, 0xf2, 0x41, 0xe9, 0x3c, 0x9e, 0x03, 0x18, 0xa1, 0xd7, 0xb7, 0x6e, 0xe2, 0xed, 0xe0,
+	0xcf, 0x9b, 0xa9, 0xdc, 0xc4, 0xe1, 0x74, 0x6e, 0x62, 0xfe, 0x6d, 0x69, 0x86, 0x08, 0xbd, 0x2d,
+	0xcd, 0x94, 0x33, 0x0d, 0x7e, 0x57, 0x8b, 0x8f, 0x1c, 0xef, 0x34, 0xdc, 0x08, 0xa2, 0x27, 0x5d,
+	0xe8, 0xf4, 0x7e, 0x1a, 0xef, 0xa9, 0xc7, 0x65, 0x30, 0xde, 0x7d, 0x80, 0x1e, 0xc6, 0x0f, 0xd0,
+	0xdd, 0x02, 0x92, 0xfb, 0xc6, 0x29, 0x71, 0x3d, 0xa5, 0x84, 0x88, 0x8b, 0x79, 0x03, 0x98, 0xf2,
+	0x5a, 0xa6, 0xd0, 0x6f, 0x93, 0x50, 0x67, 0xc7, 0x71, 0x1e, 0x7a, 0x30, 0x8b, 0x1c, 0x5c, 0xa3,
+	0x02, 0x18, 0x4b, 0x06, 0x0a, 0xf1, 0xcf, 0xfc, 0xa0, 0x47, 0x46, 0x84, 0x06, 0x3d, 0xb2, 0xea,
+	0xee, 0x32, 0x44, 0x62, 0x4e, 0x72, 0x2d, 0xf5, 0x89, 0xa9, 0x94, 0x1b, 0x93, 0xe6, 0x70, 0x31,
+	0xef, 0x83, 0x85, 0x7c, 0x04, 0x7b, 0x5d, 0x4d, 0x78, 0x88, 0x96, 0xf2, 0x10, 0xf3, 0x73, 0x40,
+	0xa7, 0x6f, 0x14, 0xde, 0xc1, 0x63, 0xb7, 0xdd, 0x86, 0xce, 0xa3, 0xe3, 0xc1, 0x35, 0xcd, 0x3f,
+	0x85, 0xa7, 0x7a, 0xa1, 0xa7, 0xf0, 0x54, 0x29, 0x1b, 0x10, 0x0b, 0x5c, 0x88, 0x6b, 0x77, 0x9a,
+	0xcd, 0xde, 0xe4, 0xf2, 0x8f, 0x21, 0xd9, 0x76, 0xe8, 0x31, 0x24, 0x5b, 0xc1, 0x18, 0xfc, 0x90,
+	0xc4, 0xc1, 0x07, 0xf8, 0x94, 0x54, 0xf5, 0x3b, 0x9e, 0x53, 0xb1, 0x23, 0xf8, 0x86, 0xdb, 0x72,
+	0x07, 0x3a, 0x95, 0x7d, 0x1a, 0x80, 0xc0, 0x8e, 0xa0, 0xd5, 0x44, 0x0d, 0xd0, 0x5d, 0x68, 0x5e,
+	0x94, 0x55, 0x91, 0xe9, 0x2d, 0xfe, 0x9a, 0x29, 0x88, 0x0b, 0xf2, 0x43, 0x63, 0x11, 0x61, 0x1a,
+	0x1a, 0x8b, 0xaa, 0x98, 0xbe, 0xbf, 0xa7, 0x01, 0xa3, 0xeb, 0x54, 0x27, 0xa1, 0xb2, 0x4a, 0xe8,
+	0x9f, 0xbf, 0x48, 0x49, 0xc8, 0xd0, 0x45, 0x4a, 0x52, 0xcb, 0x34, 0xfa, 0x63, 0xfa, 0x1d, 0x23,
+	0x4e, 0x3f, 0xe4, 0x43, 0xc5, 0xd7, 0xa1, 0x2d, 0xfa, 0x5e, 0x51, 0x13, 0xc7, 0x6f, 0xf9, 0xb3,
+	0xfb, 0x22, 0x18, 0x6d, 0xe0, 0xe6, 0xb0, 0x76, 0x93, 0x15, 0xfa, 0x8b, 0xec, 0x8e, 0x99, 0x54,
+	0xba, 0x1b, 0xd9, 0xc4, 0xc9, 0x2c, 0xb1, 0xf8, 0x63, 0x45, 0x49, 0x75, 0xac, 0xdf, 0xc6, 0xef,
+	0xbc, 0x09, 0x86, 0xf7, 0xc3, 0xba, 0xfe, 0x15, 0x0d, 0x9c, 0x49, 0x7e, 0xb1, 0x78, 0x43, 0xe4,
+	0x58, 0xe9, 0x0f, 0xff, 0x8c, 0x5b, 0x2a, 0x28, 0x66, 0xcd, 0xe5, 0x77, 0xff, 0xee, 0xdf, 0x7e,
+	0x79, 0xe8, 0x86, 0x69, 0x96, 0x04, 0x5f, 0xa8, 0xd2, 0xdb, 0xd7, 0x1a, 0xed, 0xff, 0x4b, 0x1a,
+	0x98, 0xe0, 0x13, 0x6e, 0x4d, 0x49, 0x4f, 0x1c, 0xc6, 0x58, 0xee, 0x8d, 0x61, 0x5c, 0x96, 0x30,
+	0x97, 0xeb, 0xe6, 0x35, 0x11, 0x97, 0x10, 0x7a, 0x28, 0x43, 0x92, 0xe4, 0xba, 0xe8, 0xbf, 0xa8,
+	0x81, 0xc9, 0xc4, 0x37, 0x7b, 0xd7, 0x25, 0xfd, 0xf0, 0x20, 0x63, 0x45, 0x01, 0xa4, 0xc6, 0x26,
+	0x20, 0x12, 0x24, 0x6e, 0xd4, 0xff, 0x5a, 0x03, 0x46, 0xce, 0x77, 0x78, 0xeb, 0x0a, 0xdd, 0x26,
+	0x45, 0x8c, 0x3b, 0x7d, 0x8b, 0x30, 0xde, 0x65, 0xcc, 0xfb, 0x45, 0x73, 0xa3, 0x27, 0x6f, 0xeb,
+	0x89, 0x1b, 0x35, 0xac, 0x38, 0x04, 0x3e, 0x84, 0x10, 0x9b, 0x35, 0xf1, 0x05, 0x9b, 0xcc, 0xac,
+	0x3c, 0xc8, 0x58, 0x51, 0x00, 0xa9, 0x99, 0x95, 0x7a, 0x1a, 0x35, 0xeb, 0xb7, 0x35, 0x70, 0x51,
+	0xf2, 0xc5, 0xd8, 0x6a, 0x7e, 0x97, 0x29, 0xb8, 0xb1, 0xd5, 0x17, 0x9c, 0x71, 0x5d, 0xc7, 0x5c,
+	0x57, 0xcc, 0xa5, 0x3c, 0xae, 0x2d, 0x24, 0x6c, 0xd1, 0xef, 0xc3, 0xb0, 0x05, 0x13, 0x5f, 0x6f,
+	0xc9, 0x2c, 0xc8, 0x83, 0x8c, 0x15, 0x05, 0x90, 0x9a, 0x05, 0x1d, 0x22, 0x61, 0xd5, 0x70, 0xe7,
+	0x68, 0x0d, 0x49, 0x7e, 0x24, 0x24, 0x5b, 0x43, 0x12, 0x28, 0xe3, 0x96, 0x0a, 0x4a, 0x6d, 0x0d,
+	0x79, 0x42, 0x45, 0x28, 0xa3, 0xdf, 0xd0, 0xc0, 0xf9, 0xec, 0x87, 0x30, 0x8b, 0x92, 0xfe, 0x32,
+	0x48, 0x63, 0x4d, 0x15, 0xc9, 0xd8, 0x95, 0x30, 0xbb, 0x25, 0xf3, 0xa6, 0x88, 0x5d, 0xf2, 0x59,
+	0x86, 0x50, 0xfc, 0x96, 0x06, 0xce, 0xf3, 0x69, 0xcf, 0x84, 0xe2, 0x52, 0xee, 0xb2, 0xca, 0x27,
+	0x48, 0x1b, 0xeb, 0xca, 0x50, 0x46, 0x72, 0x0d, 0x93, 0x5c, 0x36, 0x17, 0x73, 0x96, 0x61, 0x9a,
+	0x40, 0x47, 0x59, 0xfe, 0xa6, 0x06, 0x74, 0xc1, 0xa7, 0x2a, 0x32, 0x9a, 0x59, 0xa8, 0xb1, 0xae,
+	0x0c, 0x55, 0xa3, 0x09, 0x83, 0xda, 0xc6, 0x9a, 0xe5, 0x50, 0x41, 0x4a, 0xf3, 0x3b, 0x1a, 0x28,
+	0x48, 0x13, 0xfd, 0x4a, 0xd2, 0xcd, 0x41, 0x2c, 0x60, 0x6c, 0xf7, 0x29, 0xc0, 0x88, 0xbf, 0x88,
+	0x89, 0x17, 0xcd, 0x5b, 0xe2, 0xad, 0x45, 0x9c, 0xb1, 0xa6, 0xff, 0x85, 0x06, 0x8c, 0x9c, 0x3c,
+	0x45, 0x99, 0x01, 0xe5, 0x22, 0xc6, 0x9d, 0xbe, 0x45, 0x98, 0x0a, 0xb7, 0xb1, 0x0a, 0x6b, 0x66,
+	0x51, 0xa4, 0x42, 0xc7, 0x93, 0x2a, 0xf1, 0x4d, 0x0d, 0x9c, 0xcf, 0x7e, 0x2c, 0x23, 0x9b, 0x71,
+	0x19, 0xa4, 0xb1, 0xa6, 0x8a, 0x54, 0xf3, 0x92, 0x1a, 0x16, 0xb3, 0x92, 0xdb, 0xf9, 0x5f, 0x69,
+	0xc0, 0xc8, 0xf9, 0x1c, 0x46, 0x66, 0x68, 0xb9, 0x88, 0x71, 0xa7, 0x6f, 0x11, 0x46, 0xff, 0x0e,
+	0xa6, 0xbf, 0x69, 0xae, 0x0b, 0x7d, 0x05, 0xcb, 0x5b, 0x55, 0xdb, 0xb1, 0xd8, 0x07, 0x36, 0x16,
+	0x8c, 0x89, 0x22, 0x3d, 0x72, 0xbe, 0x87, 0x90, 0xe9, 0x21, 0x17, 0x31, 0xee, 0xf4, 0x2d, 0xa2,
+	0xa6, 0x87, 0xed, 0x38, 0x96, 0xf4, 0x1b, 0x0b, 0xfd, 0xdf, 0x35, 0x60, 0x2a, 0x64, 0x3b, 0x4b,
+	0xbd, 0xb9, 0xa7, 0xa8, 0xb1, 0x33, 0xb0, 0x28, 0xd3, 0x6f, 0x17, 0xeb, 0xf7, 0x8a, 0x59, 0x16,
+	0x4e, 0x08, 0xdc, 0x8e, 0x48, 0x45, 0x17, 0x35, 0x15, 0x2b, 0x8a, 0xb6, 0xeb, 0xc4, 0x37, 0x1a,
+	0xd7, 0x73, 0x79, 0x51, 0xf2, 0x2b, 0x0a, 0x20, 0xb5, 0xed, 0x9a, 0xd2, 0xa4, 0x6c, 0xbe, 0xa5,
+	0x01, 0x5d, 0xf0, 0x8d, 0x85, 0x6c, 0x4d, 0xcf, 0x42, 0x8d, 0x75, 0x65, 0xa8, 0xda, 0xfe, 0x28,
+	0xf8, 0x24, 0x42, 0xff, 0x55, 0x0d, 0x9c, 0x4b, 0x7f, 0x55, 0xb1, 0x20, 0x8d, 0x57, 0x13, 0x38,
+	0xa3, 0xa8, 0x86, 0x63, 0xe4, 0x6e, 0x61, 0x72, 0x0b, 0xe6, 0x0d, 0x71, 0x30, 0x8b, 0x84, 0x2c,
+	0xc6, 0x51, 0xff, 0x1f, 0x0d, 0xdc, 0x54, 0xfd, 0x88, 0xe2, 0x35, 0x09, 0x13, 0x45, 0x79, 0xe3,
+	0xfe, 0xb3, 0xc9, 0x33, 0x0d, 0x3f, 0x8d, 0x35, 0xbc, 0x6b, 0xee, 0x8a, 0x34, 0x3c, 0x44, 0x8d,
+	0x59, 0x68, 0x69, 0xa7, 0x31, 0x80, 0xed, 0x39, 0x96, 0xf4, 0x73, 0x0c, 0xfd, 0xbb, 0x1a, 0x28,
+	0x48, 0x53, 0xcf, 0x4b, 0xf9, 0x33, 0x2e, 0x23, 0x60, 0x6c, 0xf7, 0x29, 0xc0, 0x54, 0xda, 0xc6,
+	0x2a, 0xad, 0x9b, 0xa5, 0xbc, 0x89, 0x89, 0xe7, 0x62, 0x88, 0xe4, 0x59, 0x7e, 0xba, 0xfe, 0x75,
+	0x0d, 0x9c, 0x4b, 0x67, 0x68, 0x2f, 0xf4, 0x66, 0x81, 0x70, 0x46, 0x51, 0x0d, 0xc7, 0x48, 0xae,
+	0x62, 0x92, 0x37, 0xcd, 0xf9, 0x9e, 0x24, 0xd1, 0xbb, 0x65, 0x9a, 0x1a, 0xce, 0xc9, 0x56, 0xa0,
+	0x86, 0x70, 0x46, 0x51, 0x0d, 0x37, 0x00, 0x35, 0xfc, 0x69, 0xef, 0xcf, 0x6b, 0x60, 0x82, 0x4f,
+	0xc3, 0x36, 0xa5, 0x87, 0x09, 0x86, 0x31, 0x96, 0x7b, 0x63, 0x18, 0x9d, 0x45, 0x4c, 0xc7, 0x34,
+	0xaf, 0x8a, 0xcf, 0x1b, 0x4d, 0x18, 0xd3, 0xc1, 0x4c, 0xf8, 0x44, 0x6c, 0x19, 0x13, 0x0e, 0x63,
+	0x2c, 0xf7, 0xc6, 0xa8, 0x31, 0xa9, 0x21, 0x01, 0x3a, 0x4f, 0xf4, 0x6f, 0xa0, 0xa0, 0x27, 0x93,
+	0x89, 0x2d, 0x0d, 0x7a, 0xd2, 0x48, 0x63, 0x4d, 0x15, 0xc9, 0xb8, 0x15, 0x31, 0xb7, 0x45, 0x73,
+	0x41, 0xc8, 0x0d, 0x8b, 0xf1, 0xf9, 0xdc, 0xfa, 0x17, 0x35, 0x00, 0xb8, 0x44, 0xeb, 0x6b, 0x92,
+	0x0e, 0xbb, 0x10, 0x63, 0xa9, 0x27, 0x84, 0x91, 0xb9, 0x89, 0xc9, 0x5c, 0x33, 0xe7, 0x4a, 0xc2,
+	0x7f, 0x77, 0xac, 0x13, 0x42, 0xee, 0x1e, 0x25, 0x91, 0x69, 0x2d, 0xdd, 0xff, 0x38, 0x90, 0xb1,
+	0xa2, 0x00, 0x52, 0xdc, 0xff, 0x3c, 0x9e, 0x4d, 0x08, 0x46, 0x69, 0xae, 0xf5, 0x15, 0x79, 0xd8,
+	0x53, 0x69, 0xd7, 0x8c, 0xf9, 0xdc, 0x6a, 0xd6, 0xf5, 0x75, 0xdc, 0xf5, 0x15, 0xf3, 0x92, 0x2c,
+	0x02, 0x0a, 0xda, 0x35, 0xfd, 0xf3, 0x60, 0xbc, 0x9b, 0x4d, 0x7d, 0x55, 0xba, 0x3f, 0x51, 0x84,
+	0xb1, 0xd8, 0x0b, 0xc1, 0x7a, 0x5f, 0xc0, 0xbd, 0x5f, 0x35, 0x67, 0xc5, 0x7b, 0x17, 0x82, 0x63,
+	0x02, 0xbf, 0xa6, 0x81, 0x73, 0xe9, 0x9c, 0xe9, 0x05, 0xf9, 0x41, 0x87, 0xc7, 0x19, 0x45, 0x35,
+	0x9c, 0x9a, 0x97, 0xa2, 0x0d, 0x86, 0x5c, 0xed, 0xb2, 0xc0, 0xfc, 0x0f, 0x34, 0x30, 0x25, 0xcc,
+	0x35, 0x5e, 0xc9, 0x35, 0x43, 0x12, 0x6c, 0x6c, 0xf6, 0x01, 0x66, 0x54, 0x37, 0x31, 0xd5, 0x55,
+	0x73, 0x25, 0xc7, 0x7c, 0x84, 0xed, 0x61, 0xe0, 0xb7, 0x28, 0xdf, 0xcf, 0x83, 0xf1, 0x6e, 0x82,
+	0xb1, 0x6c, 0x30, 0x19, 0xc2, 0x58, 0xec, 0x85, 0x50, 0x1b, 0xcc, 0x96, 0xeb, 0x51, 0xcb, 0x21,
+	0x02, 0xdd, 0xbc, 0x5e, 0x19, 0x01, 0x86, 0x30, 0x16, 0x7b, 0x21, 0xd4, 0x08, 0x54, 0x3b, 0x81,
+	0x47, 0x09, 0x7c, 0x4d, 0x03, 0x67, 0x53, 0xa9, 0xb7, 0xf3, 0x72, 0x27, 0xe1, 0x60, 0xc6, 0xaa,
+	0x12, 0x4c, 0x2d, 0x34, 0xe3, 0x42, 0x16, 0x12, 0x9a, 0x7c, 0x4f, 0x03, 0x33, 0xf2, 0xe4, 0xda,
+	0x35, 0x79, 0xd7, 0x62, 0x09, 0xe3, 0xa5, 0x7e, 0x25, 0xd4, 0xee, 0x47, 0x11, 0x61, 0x79, 0xce,
+	0x2e, 0x8e, 0x02, 0xd2, 0x39, 0xb3, 0x0b, 0xbd, 0xcc, 0x46, 0x70, 0x46, 0x51, 0x0d, 0xa7, 0x16,
+	0x05, 0x70, 0xf6, 0x25, 0xff, 0x50, 0x80, 0xfe, 0x0f, 0x1a, 0x98, 0xed, 0x91, 0xdd, 0xba, 0x25,
+	0x67, 0x90, 0x23, 0x66, 0xbc, 0x3a, 0x90, 0x18, 0xd3, 0xe3, 0x35, 0xac, 0xc7, 0x4b, 0xe6, 0x6d,
+	0x99, 0x1e, 0xf9, 0xe9, 0xb3, 0xf8, 0xa2, 0x4b, 0x90, 0xaf, 0xba, 0x94, 0x1b, 0x54, 0xf1, 0x50,
+	0x63, 0x5d, 0x19, 0xaa, 0x76, 0x85, 0x41, 0x43, 0x30, 0x87, 0x08, 0xd2, 0x79, 0xf7, 0x5b, 0x8c,
+	0x66, 0x22, 0xd3, 0x33, 0x9f, 0x26, 0x0f, 0x35, 0xd6, 0x95, 0xa1, 0x6a, 0x17, 0xd4, 0x94, 0xa6,
+	0xdf, 0x89, 0xb8, 0x4c, 0x51, 0xfd, 0x87, 0x1a, 0xb8, 0x94, 0x97, 0x84, 0xb9, 0x91, 0x7b, 0xdd,
+	0x23, 0x94, 0x31, 0xca, 0xfd, 0xcb, 0x30, 0x15, 0x5e, 0xc6, 0x2a, 0x6c, 0x99, 0x9b, 0x39, 0x97,
+	0x45, 0x68, 0x32, 0xb6, 0x49, 0x13, 0x7c, 0xf6, 0x6b, 0x88, 0x0f, 0x3c, 0xd2, 0xc4, 0xcc, 0x52,
+	0x2e, 0x2b, 0x81, 0x1a, 0xdb, 0x7d, 0x0a, 0xa8, 0x1d, 0x78, 0xa8, 0x0e, 0x42, 0xfe, 0x68, 0x7b,
+	0x15, 0xa6, 0x64, 0xae, 0xf4, 0x3e, 0x32, 0x30, 0xb0, 0xb1, 0xd9, 0x07, 0x58, 0x6d, 0x7b, 0x4d,
+	0x1c, 0x32, 0xc8, 0x26, 0x8b, 0x4f, 0x41, 0x7f, 0xa4, 0x81, 0x8b, 0x92, 0x2c, 0xcb, 0xd5, 0x5c,
+	0x12, 0x69, 0xb8, 0xb1, 0xd5, 0x17, 0x9c, 0xb1, 0xde, 0xc2, 0xac, 0x4b, 0xe6, 0x6a, 0x0e, 0xeb,
+	0x6c, 0xe6, 0x26, 0x77, 0x9f, 0x95, 0x9b, 0x45, 0x79, 0x47, 0x85, 0x94, 0x50, 0xd4, 0xd8, 0x19,
+	0x58, 0xb4, 0xaf, 0xfb, 0xac, 0x1e, 0x19, 0x9b, 0xfa, 0x8f, 0x34, 0x30, 0xd7, 0x2b, 0x33, 0xf2,
+	0x76, 0x9f, 0x97, 0xe8, 0x54, 0xce, 0x78, 0x6d, 0x30, 0x39, 0xa6, 0xdf, 0xa7, 0xb0, 0x7e, 0x77,
+	0xcc, 0xed, 0x7e, 0xee, 0xe0, 0x61, 0x48, 0x5e, 0x29, 0xd1, 0xeb, 0xe4, 0xdf, 0x6b, 0x60, 0xb6,
+	0x47, 0x4a, 0x61, 0xbe, 0x5b, 0xc9, 0xc4, 0x8c, 0x57, 0x07, 0x12, 0x63, 0x9a, 0xbd, 0x8a, 0x35,
+	0xdb, 0x36, 0xb7, 0xf2, 0x96, 0x61, 0xb1, 0x72, 0xb1, 0x5e, 0x3d, 0xd2, 0xf9, 0xb6, 0x72, 0xcf,
+	0xeb, 0x7d, 0xeb, 0xa5, 0x98, 0xa2, 0x97, 0xab, 0x17, 0x3d, 0xf9, 0xe7, 0xe8, 0x85, 0x5e, 0x1e,
+	0xb2, 0xe9, 0x7d, 0x8b, 0x72, 0x37, 0x4a, 0x22, 0x8d, 0x35, 0x55, 0xa4, 0xda, 0xb6, 0x8d, 0x5c,
+	0x0c, 0xdf, 0x9d, 0x71, 0x49, 0x82, 0xfa, 0x9f, 0x6a, 0x60, 0x5a, 0x96, 0xc0, 0x97, 0x13, 0xb1,
+	0x89, 0xf0, 0xc6, 0xed, 0xfe, 0xf0, 0x6a, 0x8b, 0x1a, 0x62, 0xfd, 0xa4, 0x2b, 0xcd, 0xdd, 0xc3,
+	0xa2, 0xcd, 0x4f, 0x9a, 0xaa, 0x57, 0x92, 0x9f, 0x90, 0x85, 0x02, 0xc6, 0x76, 0x9f, 0x02, 0x6a,
+	0x9b, 0x1f, 0x3a, 0x64, 0xfb, 0x1e, 0x14, 0x69, 0xa0, 0xff, 0x40, 0x03, 0x97, 0xf2, 0x52, 0xf3,
+	0x36, 0x72, 0x4f, 0x8d, 0x62, 0x2d, 0xca, 0xfd, 0xcb, 0xa8, 0x26, 0x4e, 0xe0, 0x03, 0xa7, 0x4c,
+	0x17, 0x74, 0x30, 0x48, 0x27, 0xdc, 0x2d, 0xe4, 0xdc, 0x6c, 0x71, 0x38, 0xa3, 0xa8, 0x86, 0x53,
+	0x3b, 0x18, 0xa0, 0x5b, 0x30, 0xcf, 0x0a, 0x89, 0x14, 0x8b, 0x31, 0x84, 0xe9, 0x6e, 0x2b, 0x39,
+	0xeb, 0x79, 0x1a, 0x6c, 0x6c, 0xf6, 0x01, 0x56, 0x8b, 0x31, 0x42, 0xf2, 0x29, 0x13, 0x16, 0xb5,
+	0xba, 0x49, 0x74, 0xfa, 0x9f, 0x69, 0x60, 0x5a, 0x96, 0xae, 0x56, 0xcc, 0x1f, 0xde, 0x0c, 0xeb,
+	0xdb, 0xfd, 0xe1, 0xd5, 0xde, 0x5a, 0x63, 0x57, 0x10, 0x70, 0xff, 0x73, 0xf4, 0xda, 0x2d, 0x4b,
+	0x4c, 0x2b, 0xe5, 0x3e, 0x49, 0x66, 0x05, 0x8c, 0xed, 0x3e, 0x05, 0xd4, 0xe8, 0xd3, 0x17, 0xcc,
+	0x44, 0x04, 0x41, 0xb2, 0xdb, 0xf0, 0x19, 0x46, 0x90, 0x9c, 0xb9, 0x94, 0xe7, 0xa0, 0x09, 0xa8,
+	0xb1, 0xae, 0x0c, 0x55, 0x4c, 0xb2, 0xc1, 0xee, 0x8c, 0xe2, 0x7f, 0xce, 0xa5, 0x8d, 0x53, 0x5f,
+	0xf8, 0xe8, 0xbd, 0x65, 0x6d, 0x77, 0xef, 0xfb, 0x1f, 0xcc, 0x6a, 0xef, 0x7f, 0x30, 0xab, 0xfd,
+	0xcb, 0x07, 0xb3, 0xda, 0x57, 0x3f, 0x9c, 0x7d, 0xee, 0xfd, 0x0f, 0x67, 0x9f, 0xfb, 0xa7, 0x0f,
+	0x67, 0x9f, 0xfb, 0xa9, 0x25, 0xd2, 0xd4, 0x6a, 0xcd, 0x0f, 0x60, 0x29, 0xfe, 0x1b, 0x05, 0xb3,
+	0xa5, 0xe3, 0x6e, 0xf3, 0xf8, 0xff, 0xcf, 0x50, 0x1d, 0xc5, 0xff, 0xa2, 0xe2, 0xe6, 0xff, 0x0d,
+	0x00, 0x17, 0x63, 0xe4, 0xd0, 0x49, 0x62, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6368,6 +6698,9 @@ type MsgClient interface {
 	AddOneWhitelistedAddress(ctx context.Context, in *MsgAddOneWhitelistedAddress, opts ...grpc.CallOption) (*MsgAddOneWhitelistedAddressResponse, error)
 	RemoveOneWhitelistedAddress(ctx context.Context, in *MsgRemoveOneWhitelistedAddress, opts ...grpc.CallOption) (*MsgRemoveOneWhitelistedAddressResponse, error)
 	CleanSkippedTxs(ctx context.Context, in *MsgCleanSkippedTxs, opts ...grpc.CallOption) (*MsgCleanSkippedTxsResponse, error)
+	SetOutboundRateLimit(ctx context.Context, in *MsgSetOutboundRateLimit, opts ...grpc.CallOption) (*MsgSetOutboundRateLimitResponse, error)
+	RemoveOutboundRateLimit(ctx context.Context, in *MsgRemoveOutboundRateLimit, opts ...grpc.CallOption) (*MsgRemoveOutboundRateLimitResponse, error)
+	SubmitCounterpartyHeader(ctx context.Context, in *MsgSubmitCounterpartyHeader, opts ...grpc.CallOption) (*MsgSubmitCounterpartyHeaderResponse, error)
 	CleanAllSkippedTxs(ctx context.Context, in *MsgCleanAllSkippedTxs, opts ...grpc.CallOption) (*MsgCleanAllSkippedTxsResponse, error)
 }
 
@@ -6865,6 +7198,33 @@ func (c *msgClient) CleanSkippedTxs(ctx context.Context, in *MsgCleanSkippedTxs,
 	return out, nil
 }
 
+func (c *msgClient) SetOutboundRateLimit(ctx context.Context, in *MsgSetOutboundRateLimit, opts ...grpc.CallOption) (*MsgSetOutboundRateLimitResponse, error) {
+	out := new(MsgSetOutboundRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/helios.hyperion.v1.Msg/SetOutboundRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveOutboundRateLimit(ctx context.Context, in *MsgRemoveOutboundRateLimit, opts ...grpc.CallOption) (*MsgRemoveOutboundRateLimitResponse, error) {
+	out := new(MsgRemoveOutboundRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/helios.hyperion.v1.Msg/RemoveOutboundRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitCounterpartyHeader(ctx context.Context, in *MsgSubmitCounterpartyHeader, opts ...grpc.CallOption) (*MsgSubmitCounterpartyHeaderResponse, error) {
+	out := new(MsgSubmitCounterpartyHeaderResponse)
+	err := c.cc.Invoke(ctx, "/helios.hyperion.v1.Msg/SubmitCounterpartyHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CleanAllSkippedTxs(ctx context.Context, in *MsgCleanAllSkippedTxs, opts ...grpc.CallOption) (*MsgCleanAllSkippedTxsResponse, error) {
 	out := new(MsgCleanAllSkippedTxsResponse)
 	err := c.cc.Invoke(ctx, "/helios.hyperion.v1.Msg/CleanAllSkippedTxs", in, out, opts...)
@@ -6933,6 +7293,9 @@ type MsgServer interface {
 	AddOneWhitelistedAddress(context.Context, *MsgAddOneWhitelistedAddress) (*MsgAddOneWhitelistedAddressResponse, error)
 	RemoveOneWhitelistedAddress(context.Context, *MsgRemoveOneWhitelistedAddress) (*MsgRemoveOneWhitelistedAddressResponse, error)
 	CleanSkippedTxs(context.Context, *MsgCleanSkippedTxs) (*MsgCleanSkippedTxsResponse, error)
+	SetOutboundRateLimit(context.Context, *MsgSetOutboundRateLimit) (*MsgSetOutboundRateLimitResponse, error)
+	RemoveOutboundRateLimit(context.Context, *MsgRemoveOutboundRateLimit) (*MsgRemoveOutboundRateLimitResponse, error)
+	SubmitCounterpartyHeader(context.Context, *MsgSubmitCounterpartyHeader) (*MsgSubmitCounterpartyHeaderResponse, error)
 	CleanAllSkippedTxs(context.Context, *MsgCleanAllSkippedTxs) (*MsgCleanAllSkippedTxsResponse, error)
 }
 
@@ -7102,6 +7465,15 @@ func (*UnimplementedMsgServer) RemoveOneWhitelistedAddress(ctx context.Context,
 func (*UnimplementedMsgServer) CleanSkippedTxs(ctx context.Context, req *MsgCleanSkippedTxs) (*MsgCleanSkippedTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CleanSkippedTxs not implemented")
 }
+func (*UnimplementedMsgServer) SetOutboundRateLimit(ctx context.Context, req *MsgSetOutboundRateLimit) (*MsgSetOutboundRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOutboundRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveOutboundRateLimit(ctx context.Context, req *MsgRemoveOutboundRateLimit) (*MsgRemoveOutboundRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOutboundRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) SubmitCounterpartyHeader(ctx context.Context, req *MsgSubmitCounterpartyHeader) (*MsgSubmitCounterpartyHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCounterpartyHeader not implemented")
+}
 func (*UnimplementedMsgServer) CleanAllSkippedTxs(ctx context.Context, req *MsgCleanAllSkippedTxs) (*MsgCleanAllSkippedTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CleanAllSkippedTxs not implemented")
 }
@@ -8082,6 +8454,60 @@ func _Msg_CleanSkippedTxs_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetOutboundRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOutboundRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOutboundRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.hyperion.v1.Msg/SetOutboundRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOutboundRateLimit(ctx, req.(*MsgSetOutboundRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveOutboundRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveOutboundRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveOutboundRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.hyperion.v1.Msg/RemoveOutboundRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveOutboundRateLimit(ctx, req.(*MsgRemoveOutboundRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitCounterpartyHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitCounterpartyHeader)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitCounterpartyHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.hyperion.v1.Msg/SubmitCounterpartyHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitCounterpartyHeader(ctx, req.(*MsgSubmitCounterpartyHeader))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CleanAllSkippedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCleanAllSkippedTxs)
 	if err := dec(in); err != nil {
@@ -8100,6 +8526,7 @@ func _Msg_CleanAllSkippedTxs_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helios.hyperion.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -8321,12 +8748,24 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Msg_CleanSkippedTxs_Handler,
 		},
 		{
-			MethodName: "CleanAllSkippedTxs",
-			Handler:    _Msg_CleanAllSkippedTxs_Handler,
+			MethodName: "SetOutboundRateLimit",
+			Handler:    _Msg_SetOutboundRateLimit_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "helios/hyperion/v1/msgs.proto",
+		{
+			MethodName: "RemoveOutboundRateLimit",
+			Handler:    _Msg_RemoveOutboundRateLimit_Handler,
+		},
+		{
+			MethodName: "SubmitCounterpartyHeader",
+			Handler:    _Msg_SubmitCounterpartyHeader_Handler,
+		},
+		{
+			MethodName: "CleanAllSkippedTxs",
+			Handler:    _Msg_CleanAllSkippedTxs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "helios/hyperion/v1/msgs.proto",
 }
 
 func (m *MsgSetOrchestratorAddresses) Marshal() (dAtA []byte, err error) {
@@ -8914,6 +9353,18 @@ func (m *MsgDepositClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReceiptProof != nil {
+		{
+			size, err := m.ReceiptProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.RpcUsed) > 0 {
 		i -= len(m.RpcUsed)
 		copy(dAtA[i:], m.RpcUsed)
@@ -9034,6 +9485,18 @@ func (m *MsgWithdrawClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReceiptProof != nil {
+		{
+			size, err := m.ReceiptProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.RpcUsed) > 0 {
 		i -= len(m.RpcUsed)
 		copy(dAtA[i:], m.RpcUsed)
@@ -12367,6 +12830,204 @@ func (m *MsgCleanAllSkippedTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetOutboundRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOutboundRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOutboundRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChainId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOutboundRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOutboundRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOutboundRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOutboundRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOutboundRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOutboundRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveOutboundRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveOutboundRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveOutboundRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCounterpartyHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCounterpartyHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCounterpartyHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HyperionId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.HyperionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCounterpartyHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCounterpartyHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCounterpartyHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -12669,6 +13330,10 @@ func (m *MsgDepositClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	if m.ReceiptProof != nil {
+		l = m.ReceiptProof.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -12715,6 +13380,10 @@ func (m *MsgWithdrawClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	if m.ReceiptProof != nil {
+		l = m.ReceiptProof.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -14163,34 +14832,119 @@ func (m *MsgCleanAllSkippedTxsResponse) Size() (n int) {
 	return n
 }
 
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetOutboundRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovMsgs(uint64(m.ChainId))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
 }
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetOutboundRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
+
+func (m *MsgRemoveOutboundRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovMsgs(uint64(m.ChainId))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveOutboundRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitCounterpartyHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.HyperionId != 0 {
+		n += 1 + sovMsgs(uint64(m.HyperionId))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitCounterpartyHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: MsgSetOrchestratorAddresses: wiretype end group for non-group")
 		}
@@ -16202,6 +16956,42 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.RpcUsed = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceiptProof == nil {
+				m.ReceiptProof = &ReceiptProof{}
+			}
+			if err := m.ReceiptProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -16506,6 +17296,42 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.RpcUsed = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceiptProof == nil {
+				m.ReceiptProof = &ReceiptProof{}
+			}
+			if err := m.ReceiptProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -25797,6 +26623,558 @@ func (m *MsgCleanAllSkippedTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetOutboundRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOutboundRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOutboundRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOutboundRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOutboundRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOutboundRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOutboundRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOutboundRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOutboundRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveOutboundRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveOutboundRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveOutboundRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitCounterpartyHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitCounterpartyHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitCounterpartyHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperionId", wireType)
+			}
+			m.HyperionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HyperionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = append(m.Header[:0], dAtA[iNdEx:postIndex]...)
+			if m.Header == nil {
+				m.Header = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitCounterpartyHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitCounterpartyHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitCounterpartyHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
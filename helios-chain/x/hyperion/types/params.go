@@ -36,7 +36,7 @@ func DefaultLocalPolygonAmoyTestnet21ChainParams() *CounterpartyChainParams {
 		BridgeChainId:                 80002,
 		BridgeChainName:               "Polygon Amoy Testnet",
 		BridgeChainLogo:               "51ff5cb29b89cebe3bb8c9c3191fd5109122a5419c2c0bbebddd7a080b20a3b1",
-		BridgeChainType:               "evm",
+		BridgeChainType:               ChainTypeEVM,
 		SignedValsetsWindow:           25000,
 		SignedBatchesWindow:           25000,
 		SignedClaimsWindow:            25000,
@@ -122,7 +122,7 @@ func DefaultPolygonAmoyTestnet21ChainParams() *CounterpartyChainParams {
 		BridgeChainId:                 80002,
 		BridgeChainName:               "Polygon Amoy Testnet",
 		BridgeChainLogo:               "51ff5cb29b89cebe3bb8c9c3191fd5109122a5419c2c0bbebddd7a080b20a3b1",
-		BridgeChainType:               "evm",
+		BridgeChainType:               ChainTypeEVM,
 		SignedValsetsWindow:           25000,
 		SignedBatchesWindow:           25000,
 		SignedClaimsWindow:            25000,
@@ -193,7 +193,7 @@ func DefaultEthereumSepoliaTestnet22ChainParams() *CounterpartyChainParams {
 		BridgeChainId:                 11155111,
 		BridgeChainName:               "Sepolia Testnet",
 		BridgeChainLogo:               "45fa0204dcbb461f9899168a8b56162ecc832919b0c8b81b85f7de2abba408aa",
-		BridgeChainType:               "evm",
+		BridgeChainType:               ChainTypeEVM,
 		SignedValsetsWindow:           25000,
 		SignedBatchesWindow:           25000,
 		SignedClaimsWindow:            25000,
@@ -265,7 +265,7 @@ func DefaultFujiTestnet23ChainParams() *CounterpartyChainParams {
 		BridgeChainId:                 43113,
 		BridgeChainName:               "Avalanche Fuji C-Chain",
 		BridgeChainLogo:               "d7903a5f39adc94975d84821e64b3eb37a9ea1607699ade587f0d3abfdc2c889",
-		BridgeChainType:               "evm",
+		BridgeChainType:               ChainTypeEVM,
 		SignedValsetsWindow:           25000,
 		SignedBatchesWindow:           25000,
 		SignedClaimsWindow:            25000,
@@ -372,6 +372,15 @@ func validateCounterpartyChainParams(i interface{}) error {
 	if err := validateBridgeChainID(v.BridgeChainId); err != nil {
 		return errors.Wrap(err, "bridge chain id")
 	}
+	if err := validateBridgeChainType(v.BridgeChainType); err != nil {
+		return errors.Wrap(err, "bridge chain type")
+	}
+	if err := validateClaimVerificationMode(v.ClaimVerificationMode); err != nil {
+		return errors.Wrap(err, "claim verification mode")
+	}
+	if v.RequiresReceiptProof() && v.BridgeChainType != "" && v.BridgeChainType != ChainTypeEVM {
+		return fmt.Errorf("claim verification mode %s is only supported by %s chains", ClaimVerificationModeReceiptProof, ChainTypeEVM)
+	}
 	if err := validateTargetBatchTimeout(v.TargetBatchTimeout); err != nil {
 		return errors.Wrap(err, "Batch timeout")
 	}
@@ -408,6 +417,9 @@ func validateCounterpartyChainParams(i interface{}) error {
 	if err := validateUnbondSlashingValsetsWindow(v.UnbondSlashingValsetsWindow); err != nil {
 		return errors.Wrap(err, "unbond Slashing valset window")
 	}
+	if err := validateOutboundRateLimits(v.OutboundRateLimits); err != nil {
+		return errors.Wrap(err, "outbound rate limits")
+	}
 
 	return nil
 }
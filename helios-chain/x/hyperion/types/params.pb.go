@@ -99,6 +99,11 @@ type CounterpartyChainParams struct {
 	OffsetValsetNonce             uint64                                 `protobuf:"varint,28,opt,name=offset_valset_nonce,json=offsetValsetNonce,proto3" json:"offset_valset_nonce,omitempty"`
 	MinCallExternalDataGas        uint64                                 `protobuf:"varint,29,opt,name=min_call_external_data_gas,json=minCallExternalDataGas,proto3" json:"min_call_external_data_gas,omitempty"`
 	Paused                        bool                                   `protobuf:"varint,30,opt,name=paused,proto3" json:"paused,omitempty"`
+	OutboundRateLimits            []*OutboundRateLimit                   `protobuf:"bytes,31,rep,name=outbound_rate_limits,json=outboundRateLimits,proto3" json:"outbound_rate_limits,omitempty"`
+	// how deposit and withdraw claims are verified, "attestation" (default) only
+	// counts the orchestrators votes while "receipt_proof" also requires every claim
+	// to prove its event log against a counterparty header of the header store
+	ClaimVerificationMode string `protobuf:"bytes,32,opt,name=claim_verification_mode,json=claimVerificationMode,proto3" json:"claim_verification_mode,omitempty"`
 }
 
 func (m *CounterpartyChainParams) Reset()         { *m = CounterpartyChainParams{} }
@@ -295,6 +300,87 @@ func (m *CounterpartyChainParams) GetPaused() bool {
 	return false
 }
 
+func (m *CounterpartyChainParams) GetOutboundRateLimits() []*OutboundRateLimit {
+	if m != nil {
+		return m.OutboundRateLimits
+	}
+	return nil
+}
+
+func (m *CounterpartyChainParams) GetClaimVerificationMode() string {
+	if m != nil {
+		return m.ClaimVerificationMode
+	}
+	return ""
+}
+
+// OutboundRateLimit caps the amount of a token which can leave through the bridge
+// toward the counterparty chain over a rolling window of window_seconds.
+//
+// token_address:
+// the token contract on the counterparty chain, an empty address makes it the
+// default limit of every token of the chain without a dedicated limit
+//
+// max_amount:
+// the maximum amount sent over the window, zero disables this cap
+//
+// max_percentage:
+// the maximum amount sent over the window as a fraction of the token balance locked
+// in the hyperion contract, zero disables this cap
+type OutboundRateLimit struct {
+	TokenAddress  string                      `protobuf:"bytes,1,opt,name=token_address,json=tokenAddress,proto3" json:"tokenAddress"`
+	WindowSeconds uint64                      `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"windowSeconds"`
+	MaxAmount     cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"maxAmount"`
+	MaxPercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_percentage,json=maxPercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maxPercentage"`
+}
+
+func (m *OutboundRateLimit) Reset()         { *m = OutboundRateLimit{} }
+func (m *OutboundRateLimit) String() string { return proto.CompactTextString(m) }
+func (*OutboundRateLimit) ProtoMessage()    {}
+func (*OutboundRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5f87689d64baa8c, []int{2}
+}
+func (m *OutboundRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboundRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundRateLimit.Merge(m, src)
+}
+func (m *OutboundRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundRateLimit proto.InternalMessageInfo
+
+func (m *OutboundRateLimit) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+func (m *OutboundRateLimit) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
 type ComplemetaryInfo struct {
 	AverageCounterpartyBlockTime uint64 `protobuf:"varint,1,opt,name=average_counterparty_block_time,json=averageCounterpartyBlockTime,proto3" json:"averageCounterpartyBlockTime"`
 	LatestObservedBlockHeight    uint64 `protobuf:"varint,2,opt,name=latest_observed_block_height,json=latestObservedBlockHeight,proto3" json:"latestObservedBlockHeight"`
@@ -305,7 +391,7 @@ func (m *ComplemetaryInfo) Reset()         { *m = ComplemetaryInfo{} }
 func (m *ComplemetaryInfo) String() string { return proto.CompactTextString(m) }
 func (*ComplemetaryInfo) ProtoMessage()    {}
 func (*ComplemetaryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5f87689d64baa8c, []int{2}
+	return fileDescriptor_f5f87689d64baa8c, []int{3}
 }
 func (m *ComplemetaryInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +454,7 @@ func (m *CounterpartyChainParamsWithComplemetaryInfo) String() string {
 }
 func (*CounterpartyChainParamsWithComplemetaryInfo) ProtoMessage() {}
 func (*CounterpartyChainParamsWithComplemetaryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5f87689d64baa8c, []int{3}
+	return fileDescriptor_f5f87689d64baa8c, []int{4}
 }
 func (m *CounterpartyChainParamsWithComplemetaryInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,6 +500,7 @@ func (m *CounterpartyChainParamsWithComplemetaryInfo) GetComplemetaryInfo() *Com
 func init() {
 	proto.RegisterType((*Params)(nil), "helios.hyperion.v1.Params")
 	proto.RegisterType((*CounterpartyChainParams)(nil), "helios.hyperion.v1.CounterpartyChainParams")
+	proto.RegisterType((*OutboundRateLimit)(nil), "helios.hyperion.v1.OutboundRateLimit")
 	proto.RegisterType((*ComplemetaryInfo)(nil), "helios.hyperion.v1.ComplemetaryInfo")
 	proto.RegisterType((*CounterpartyChainParamsWithComplemetaryInfo)(nil), "helios.hyperion.v1.CounterpartyChainParamsWithComplemetaryInfo")
 }
@@ -421,81 +508,126 @@ func init() {
 func init() { proto.RegisterFile("helios/hyperion/v1/params.proto", fileDescriptor_f5f87689d64baa8c) }
 
 var fileDescriptor_f5f87689d64baa8c = []byte{
-	// 1143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
-	0x1b, 0x67, 0x81, 0x97, 0x37, 0x19, 0x43, 0x80, 0xc1, 0x89, 0x07, 0x03, 0xb6, 0x45, 0xab, 0xca,
-	0x09, 0xed, 0x3a, 0xd0, 0x43, 0x25, 0x2a, 0xb5, 0xaa, 0x0d, 0x4d, 0x90, 0xa2, 0xa4, 0x5a, 0x68,
-	0xa9, 0x7a, 0xc8, 0x68, 0xbc, 0x3b, 0xde, 0x1d, 0xb1, 0x3b, 0x63, 0xed, 0x8c, 0x0d, 0xee, 0xa9,
-	0x87, 0x9e, 0xda, 0x4b, 0xbf, 0x40, 0xbf, 0x43, 0x2f, 0xfd, 0x0e, 0x39, 0xe6, 0x58, 0x55, 0x95,
-	0x55, 0xc1, 0xa1, 0x12, 0x9f, 0xa2, 0xda, 0x99, 0x5d, 0x63, 0x63, 0x93, 0x90, 0x5e, 0x90, 0x99,
-	0xdf, 0x9f, 0xe7, 0x99, 0x67, 0x9e, 0x7d, 0x66, 0x40, 0x39, 0xa0, 0x21, 0x13, 0xb2, 0x16, 0xf4,
-	0xda, 0x34, 0x66, 0x82, 0xd7, 0xba, 0xdb, 0xb5, 0x36, 0x89, 0x49, 0x24, 0xed, 0x76, 0x2c, 0x94,
-	0x80, 0xd0, 0x10, 0xec, 0x8c, 0x60, 0x77, 0xb7, 0x8b, 0x79, 0x5f, 0xf8, 0x42, 0xc3, 0xb5, 0xe4,
-	0x97, 0x61, 0x16, 0x4b, 0xae, 0x90, 0x91, 0x90, 0xb5, 0x26, 0x91, 0xb4, 0xd6, 0xdd, 0x6e, 0x52,
-	0x45, 0xb6, 0x6b, 0xae, 0x60, 0x3c, 0xc5, 0x97, 0x49, 0xc4, 0xb8, 0xa8, 0xe9, 0xbf, 0x99, 0x64,
-	0x42, 0x74, 0xd5, 0x6b, 0xd3, 0x34, 0xf8, 0xe6, 0xcf, 0x16, 0x98, 0xfb, 0x4a, 0x67, 0x03, 0x7d,
-	0xb0, 0xea, 0x8a, 0x0e, 0x57, 0x34, 0x6e, 0x93, 0x58, 0xf5, 0xb0, 0x1b, 0x10, 0xc6, 0xb1, 0x49,
-	0x15, 0x59, 0x95, 0x99, 0x6a, 0x6e, 0x67, 0xcb, 0x1e, 0xcf, 0xd5, 0x6e, 0x0c, 0x89, 0x1a, 0x89,
-	0xc6, 0xf8, 0x39, 0x05, 0x77, 0x32, 0xb0, 0x8b, 0x7e, 0xf8, 0xab, 0x32, 0xf5, 0xd3, 0x3f, 0xbf,
-	0x3d, 0x5a, 0x1c, 0x64, 0x65, 0x90, 0xcd, 0xf3, 0x05, 0x50, 0xb8, 0xc1, 0x0e, 0x96, 0x41, 0x2e,
-	0xa3, 0x63, 0xe6, 0x21, 0xab, 0x62, 0x55, 0x67, 0x1d, 0x90, 0x2d, 0x1d, 0x78, 0xf0, 0x31, 0xc8,
-	0xbb, 0x82, 0xab, 0x98, 0xb8, 0x0a, 0x4b, 0xd1, 0x89, 0x5d, 0x8a, 0x03, 0x22, 0x03, 0x34, 0x5d,
-	0xb1, 0xaa, 0x77, 0x1d, 0x98, 0x61, 0x87, 0x1a, 0x7a, 0x4a, 0x64, 0x00, 0x3f, 0x03, 0x6b, 0xcd,
-	0x98, 0x79, 0x3e, 0xc5, 0x23, 0x1b, 0x27, 0x9e, 0x17, 0x53, 0x29, 0xd1, 0x8c, 0x16, 0xae, 0x1a,
-	0xca, 0x70, 0x5a, 0x5f, 0x18, 0x02, 0xfc, 0x00, 0x2c, 0x66, 0x7a, 0x5d, 0x2b, 0xe6, 0xa1, 0x59,
-	0x9d, 0xd6, 0x42, 0xaa, 0x49, 0x56, 0x0f, 0x3c, 0xf8, 0x08, 0x2c, 0x8f, 0xf0, 0x38, 0x89, 0x28,
-	0xfa, 0x9f, 0x76, 0x5f, 0x1c, 0x62, 0x3e, 0x27, 0x11, 0x1d, 0xe3, 0x86, 0xc2, 0x17, 0x68, 0x6e,
-	0x8c, 0xfb, 0x4c, 0xf8, 0x62, 0x8c, 0x9b, 0x1c, 0x2c, 0xfa, 0xff, 0x18, 0xf7, 0xa8, 0xd7, 0xa6,
-	0x70, 0x07, 0xdc, 0x97, 0xcc, 0xe7, 0xd4, 0xc3, 0x5d, 0x12, 0x4a, 0xaa, 0x24, 0x3e, 0x65, 0xdc,
-	0x13, 0xa7, 0xe8, 0x8e, 0xce, 0x78, 0xc5, 0x80, 0xdf, 0x18, 0xec, 0x58, 0x43, 0x43, 0x9a, 0x26,
-	0x51, 0x6e, 0x40, 0x07, 0x9a, 0xbb, 0xc3, 0x9a, 0xba, 0xc1, 0x52, 0xcd, 0x63, 0x90, 0x4f, 0x35,
-	0x6e, 0x48, 0x58, 0x34, 0x90, 0x00, 0x2d, 0x81, 0x06, 0x6b, 0x68, 0xe8, 0x4a, 0xa1, 0x48, 0xec,
-	0x53, 0x65, 0xa2, 0x60, 0xc5, 0x22, 0x2a, 0x3a, 0x0a, 0xe5, 0x8c, 0xc2, 0x60, 0x3a, 0xc8, 0x91,
-	0x41, 0xe0, 0xa7, 0xa0, 0x98, 0x2a, 0x44, 0x47, 0xf9, 0x82, 0x71, 0x1f, 0xab, 0xb3, 0x81, 0x6e,
-	0x5e, 0xeb, 0x0a, 0x86, 0xf1, 0x22, 0x25, 0x1c, 0x9d, 0x65, 0xe2, 0x0f, 0x01, 0x24, 0x5d, 0x1a,
-	0x13, 0x9f, 0xe2, 0x66, 0x28, 0xdc, 0x13, 0xad, 0x43, 0x0b, 0x5a, 0xb4, 0x94, 0x22, 0xf5, 0x04,
-	0x48, 0x04, 0x70, 0x1f, 0x94, 0x33, 0xf6, 0x48, 0x8f, 0x0c, 0x49, 0xef, 0x69, 0xe9, 0x7a, 0x4a,
-	0x1b, 0xee, 0x93, 0x2b, 0x9b, 0x63, 0x70, 0x5f, 0x86, 0x44, 0x06, 0xb8, 0x95, 0xb4, 0x60, 0xd2,
-	0xc2, 0xe6, 0x14, 0xd0, 0x62, 0xc5, 0xaa, 0xce, 0xd7, 0xdf, 0x7b, 0xd5, 0x2f, 0x4f, 0xfd, 0xd9,
-	0x2f, 0xaf, 0x99, 0x0f, 0x5c, 0x7a, 0x27, 0x36, 0x13, 0xb5, 0x88, 0xa8, 0xc0, 0x7e, 0x46, 0x7d,
-	0xe2, 0xf6, 0xf6, 0xa8, 0xeb, 0xac, 0x68, 0x87, 0x2f, 0x53, 0x03, 0x73, 0x52, 0xf0, 0x6b, 0x90,
-	0xbf, 0x66, 0xac, 0x8b, 0x88, 0x96, 0x6e, 0xef, 0x0b, 0x47, 0x7c, 0x75, 0xa1, 0x27, 0xd8, 0xea,
-	0xd3, 0x44, 0xcb, 0xff, 0xd5, 0x56, 0x9f, 0x38, 0x0c, 0x41, 0xe5, 0xba, 0xad, 0xe0, 0xad, 0x90,
-	0xb9, 0x2a, 0x39, 0x43, 0x13, 0x02, 0xde, 0x3e, 0xc4, 0xc6, 0x68, 0x88, 0x2b, 0x2b, 0x13, 0xad,
-	0x01, 0x4a, 0x1d, 0xde, 0x14, 0xdc, 0xc3, 0x9a, 0x97, 0x84, 0xb8, 0xd6, 0xfb, 0x2b, 0xfa, 0xe8,
-	0xd6, 0x0c, 0xeb, 0x30, 0x25, 0x8d, 0x7e, 0x03, 0x27, 0x63, 0x29, 0x37, 0x89, 0x87, 0xa9, 0x0a,
-	0x70, 0xd2, 0xca, 0x44, 0x75, 0x62, 0x8a, 0xf2, 0xb7, 0x4f, 0x79, 0xfd, 0x5a, 0xb1, 0xbd, 0x7d,
-	0x15, 0x1c, 0x66, 0x46, 0xf0, 0x73, 0xb0, 0x3e, 0x18, 0x48, 0xd9, 0x24, 0x53, 0x24, 0x56, 0x38,
-	0xa0, 0xcc, 0x0f, 0x14, 0x2a, 0xe8, 0x7c, 0x07, 0x13, 0x29, 0x1d, 0x68, 0x09, 0xe3, 0xa9, 0x26,
-	0xc0, 0x3d, 0xb0, 0x60, 0xb6, 0x88, 0x63, 0x7a, 0x4a, 0x62, 0x0f, 0xa1, 0x8a, 0x55, 0xcd, 0xed,
-	0xac, 0xda, 0x26, 0x27, 0x3b, 0xb9, 0x39, 0xec, 0xf4, 0xe6, 0xb0, 0x1b, 0x82, 0xf1, 0xfa, 0x6c,
-	0x92, 0xb5, 0x33, 0x6f, 0x54, 0x8e, 0x16, 0xc1, 0x97, 0xe0, 0x9e, 0x47, 0x5b, 0xa4, 0x13, 0x2a,
-	0xac, 0xc4, 0x09, 0xe5, 0x12, 0xad, 0xea, 0xf1, 0xff, 0xc9, 0xa4, 0xf1, 0x7f, 0x94, 0x30, 0xd2,
-	0x89, 0x78, 0x24, 0xf6, 0x28, 0x17, 0xd1, 0x31, 0x53, 0xc1, 0x13, 0xca, 0xa9, 0x64, 0xf2, 0x80,
-	0xb7, 0x84, 0x74, 0x16, 0x52, 0x3b, 0xcd, 0x95, 0xb0, 0x02, 0x72, 0x8c, 0x33, 0xc5, 0x48, 0xc8,
-	0xbe, 0xa7, 0x31, 0x2a, 0xea, 0x89, 0x35, 0xbc, 0x04, 0xb7, 0xc0, 0x6c, 0xdc, 0x76, 0x25, 0x5a,
-	0xd3, 0x71, 0x0b, 0x93, 0xe2, 0x3a, 0x6d, 0xd7, 0xd1, 0x24, 0x68, 0x83, 0x15, 0xd1, 0x6a, 0x25,
-	0x9b, 0x4e, 0xf7, 0xce, 0x05, 0x77, 0x29, 0x5a, 0xd7, 0xc5, 0x5a, 0x36, 0x90, 0x39, 0xd4, 0xe7,
-	0x09, 0x00, 0x77, 0x41, 0x31, 0x62, 0x1c, 0xbb, 0x24, 0x0c, 0x31, 0x3d, 0x53, 0x34, 0xe6, 0x24,
-	0xc4, 0x1e, 0x51, 0x04, 0xfb, 0x44, 0xa2, 0x0d, 0x2d, 0x7b, 0x10, 0x31, 0xde, 0x20, 0x61, 0xb8,
-	0x9f, 0xe2, 0x7b, 0x44, 0x91, 0x27, 0x44, 0xc2, 0x07, 0x60, 0xae, 0x4d, 0x3a, 0x92, 0x7a, 0xa8,
-	0x54, 0xb1, 0xaa, 0x77, 0x9c, 0xf4, 0xbf, 0xdd, 0x87, 0xd9, 0x9d, 0x56, 0x19, 0xdc, 0x69, 0x37,
-	0x5c, 0x64, 0x9b, 0xbf, 0x4f, 0x83, 0xa5, 0x86, 0x88, 0xda, 0x21, 0x8d, 0xa8, 0x22, 0x71, 0x2f,
-	0x29, 0x11, 0xf4, 0xdf, 0x3e, 0x67, 0xf4, 0x8d, 0x57, 0xaf, 0x5c, 0xf6, 0xcb, 0x6f, 0x9c, 0x35,
-	0x6f, 0x99, 0x44, 0x2f, 0xc1, 0x7a, 0x48, 0x14, 0x95, 0x0a, 0x8b, 0xa6, 0xa4, 0x71, 0x37, 0x19,
-	0xee, 0x3a, 0x46, 0xda, 0x62, 0xd3, 0x3a, 0xca, 0xc6, 0x65, 0xbf, 0xbc, 0x6a, 0x78, 0x2f, 0x52,
-	0x9a, 0xb6, 0x30, 0x6d, 0xe6, 0xdc, 0x0c, 0xc1, 0x6f, 0x41, 0x71, 0xb2, 0xbf, 0xde, 0xc3, 0x8c,
-	0x76, 0x5f, 0xbb, 0xec, 0x97, 0x0b, 0x13, 0x2c, 0x74, 0xfa, 0x37, 0x01, 0x9b, 0xbf, 0x4e, 0x83,
-	0xad, 0x1b, 0x6a, 0x9a, 0x34, 0xdc, 0x58, 0x49, 0x7f, 0xb4, 0xde, 0xfc, 0xa0, 0xb1, 0xde, 0xf1,
-	0x41, 0x63, 0xd2, 0x76, 0xdf, 0xf1, 0xb5, 0x03, 0x7d, 0xb0, 0xec, 0x0e, 0xa5, 0x86, 0x19, 0x6f,
-	0x09, 0x5d, 0xe5, 0xdc, 0xce, 0xfb, 0x93, 0xa3, 0x8f, 0xee, 0xa3, 0x9e, 0xbf, 0xec, 0x97, 0x97,
-	0xdc, 0x6b, 0xab, 0xce, 0xd8, 0x4a, 0xbd, 0xf1, 0xea, 0xbc, 0x64, 0xbd, 0x3e, 0x2f, 0x59, 0x7f,
-	0x9f, 0x97, 0xac, 0x5f, 0x2e, 0x4a, 0x53, 0xaf, 0x2f, 0x4a, 0x53, 0x7f, 0x5c, 0x94, 0xa6, 0xbe,
-	0x7b, 0x68, 0xc2, 0x7c, 0xe4, 0x8a, 0x98, 0xd6, 0xb2, 0xdf, 0x49, 0x8a, 0xb5, 0xb3, 0xab, 0x87,
-	0xa1, 0x7e, 0x15, 0x36, 0xe7, 0xf4, 0xb3, 0xf0, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x73,
-	0x22, 0x08, 0x0d, 0xb6, 0x0a, 0x00, 0x00,
+	// 1336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdb, 0x6e, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xa6, 0xf9, 0xe7, 0xdf, 0x4c, 0x8e, 0x9e, 0x38, 0xf5, 0xe4, 0x50, 0xaf, 0x15, 0x0e,
+	0x4a, 0x5b, 0xb0, 0x9b, 0x70, 0x54, 0x90, 0x40, 0xb5, 0x53, 0xda, 0x48, 0xa5, 0xad, 0x36, 0xa1,
+	0x41, 0x20, 0x75, 0x34, 0xde, 0x1d, 0xef, 0x8e, 0xb2, 0x3b, 0x63, 0xed, 0x8c, 0x5d, 0x87, 0x2b,
+	0x2e, 0xb8, 0x82, 0x1b, 0x5e, 0x00, 0x89, 0x47, 0x40, 0x48, 0xbc, 0x43, 0x2f, 0x7b, 0x89, 0x10,
+	0xb2, 0x50, 0x73, 0x01, 0xf2, 0x2d, 0x2f, 0x80, 0x76, 0x66, 0xed, 0xf8, 0x94, 0x36, 0xe5, 0xc6,
+	0xf2, 0xce, 0xef, 0xf0, 0x7d, 0x73, 0xfa, 0xbe, 0x01, 0x76, 0x40, 0x43, 0x26, 0x64, 0x29, 0x38,
+	0xa9, 0xd3, 0x98, 0x09, 0x5e, 0x6a, 0x6e, 0x97, 0xea, 0x24, 0x26, 0x91, 0x2c, 0xd6, 0x63, 0xa1,
+	0x04, 0x84, 0x86, 0x50, 0xec, 0x12, 0x8a, 0xcd, 0xed, 0xb5, 0xac, 0x2f, 0x7c, 0xa1, 0xe1, 0x52,
+	0xf2, 0xcf, 0x30, 0xd7, 0xf2, 0xae, 0x90, 0x91, 0x90, 0xa5, 0x2a, 0x91, 0xb4, 0xd4, 0xdc, 0xae,
+	0x52, 0x45, 0xb6, 0x4b, 0xae, 0x60, 0x3c, 0xc5, 0x33, 0x24, 0x62, 0x5c, 0x94, 0xf4, 0x6f, 0x57,
+	0x32, 0x26, 0xba, 0x3a, 0xa9, 0xd3, 0x34, 0xf8, 0xe6, 0xf7, 0x16, 0x98, 0x7e, 0xa8, 0xb3, 0x81,
+	0x3e, 0x58, 0x75, 0x45, 0x83, 0x2b, 0x1a, 0xd7, 0x49, 0xac, 0x4e, 0xb0, 0x1b, 0x10, 0xc6, 0xb1,
+	0x49, 0x15, 0x59, 0x85, 0x4b, 0x5b, 0xb3, 0x3b, 0x37, 0x8a, 0xa3, 0xb9, 0x16, 0x2b, 0x7d, 0xa2,
+	0x4a, 0xa2, 0x31, 0x7e, 0x4e, 0xce, 0x1d, 0x0f, 0xec, 0xa2, 0x6f, 0xfe, 0x28, 0x4c, 0x7c, 0xf7,
+	0xd7, 0xcf, 0xd7, 0x17, 0x7b, 0x59, 0x19, 0x64, 0xf3, 0x9f, 0x05, 0x90, 0x3b, 0xc7, 0x0e, 0xda,
+	0x60, 0xb6, 0x4b, 0xc7, 0xcc, 0x43, 0x56, 0xc1, 0xda, 0x9a, 0x72, 0x40, 0x77, 0x68, 0xdf, 0x83,
+	0x37, 0x41, 0xd6, 0x15, 0x5c, 0xc5, 0xc4, 0x55, 0x58, 0x8a, 0x46, 0xec, 0x52, 0x1c, 0x10, 0x19,
+	0xa0, 0xc9, 0x82, 0xb5, 0x35, 0xe3, 0xc0, 0x2e, 0x76, 0xa0, 0xa1, 0xbb, 0x44, 0x06, 0xf0, 0x63,
+	0xb0, 0x5e, 0x8d, 0x99, 0xe7, 0x53, 0x3c, 0x30, 0x71, 0xe2, 0x79, 0x31, 0x95, 0x12, 0x5d, 0xd2,
+	0xc2, 0x55, 0x43, 0xe9, 0x4f, 0xeb, 0x96, 0x21, 0xc0, 0x37, 0xc1, 0x62, 0x57, 0xaf, 0xd7, 0x8a,
+	0x79, 0x68, 0x4a, 0xa7, 0x35, 0x9f, 0x6a, 0x92, 0xd1, 0x7d, 0x0f, 0x5e, 0x07, 0x99, 0x01, 0x1e,
+	0x27, 0x11, 0x45, 0xff, 0xd3, 0xee, 0x8b, 0x7d, 0xcc, 0xfb, 0x24, 0xa2, 0x23, 0xdc, 0x50, 0xf8,
+	0x02, 0x4d, 0x8f, 0x70, 0xef, 0x09, 0x5f, 0x8c, 0x70, 0x93, 0x8d, 0x45, 0xff, 0x1f, 0xe1, 0x1e,
+	0x9e, 0xd4, 0x29, 0xdc, 0x01, 0x2b, 0x92, 0xf9, 0x9c, 0x7a, 0xb8, 0x49, 0x42, 0x49, 0x95, 0xc4,
+	0x4f, 0x18, 0xf7, 0xc4, 0x13, 0x74, 0x59, 0x67, 0xbc, 0x6c, 0xc0, 0x47, 0x06, 0x3b, 0xd2, 0x50,
+	0x9f, 0xa6, 0x4a, 0x94, 0x1b, 0xd0, 0x9e, 0x66, 0xa6, 0x5f, 0x53, 0x36, 0x58, 0xaa, 0xb9, 0x09,
+	0xb2, 0xa9, 0xc6, 0x0d, 0x09, 0x8b, 0x7a, 0x12, 0xa0, 0x25, 0xd0, 0x60, 0x15, 0x0d, 0x9d, 0x29,
+	0x14, 0x89, 0x7d, 0xaa, 0x4c, 0x14, 0xac, 0x58, 0x44, 0x45, 0x43, 0xa1, 0x59, 0xa3, 0x30, 0x98,
+	0x0e, 0x72, 0x68, 0x10, 0xf8, 0x11, 0x58, 0x4b, 0x15, 0xa2, 0xa1, 0x7c, 0xc1, 0xb8, 0x8f, 0x55,
+	0xab, 0xa7, 0x9b, 0xd3, 0xba, 0x9c, 0x61, 0x3c, 0x48, 0x09, 0x87, 0xad, 0xae, 0xf8, 0x2d, 0x00,
+	0x49, 0x93, 0xc6, 0xc4, 0xa7, 0xb8, 0x1a, 0x0a, 0xf7, 0x58, 0xeb, 0xd0, 0xbc, 0x16, 0x2d, 0xa5,
+	0x48, 0x39, 0x01, 0x12, 0x01, 0xbc, 0x0d, 0xec, 0x2e, 0x7b, 0xe0, 0x8c, 0xf4, 0x49, 0x17, 0xb4,
+	0x74, 0x23, 0xa5, 0xf5, 0x9f, 0x93, 0x33, 0x9b, 0x23, 0xb0, 0x22, 0x43, 0x22, 0x03, 0x5c, 0x4b,
+	0x8e, 0x60, 0x72, 0x84, 0xcd, 0x2e, 0xa0, 0xc5, 0x82, 0xb5, 0x35, 0x57, 0x7e, 0xed, 0x69, 0xdb,
+	0x9e, 0xf8, 0xbd, 0x6d, 0xaf, 0x9b, 0x0b, 0x2e, 0xbd, 0xe3, 0x22, 0x13, 0xa5, 0x88, 0xa8, 0xa0,
+	0x78, 0x8f, 0xfa, 0xc4, 0x3d, 0xd9, 0xa3, 0xae, 0xb3, 0xac, 0x1d, 0x3e, 0x4d, 0x0d, 0xcc, 0x4e,
+	0xc1, 0xcf, 0x41, 0x76, 0xc8, 0x58, 0x2f, 0x22, 0x5a, 0xba, 0xb8, 0x2f, 0x1c, 0xf0, 0xd5, 0x0b,
+	0x3d, 0xc6, 0x56, 0xef, 0x26, 0xca, 0xfc, 0x57, 0x5b, 0xbd, 0xe3, 0x30, 0x04, 0x85, 0x61, 0x5b,
+	0xc1, 0x6b, 0x21, 0x73, 0x55, 0xb2, 0x87, 0x26, 0x04, 0xbc, 0x78, 0x88, 0xab, 0x83, 0x21, 0xce,
+	0xac, 0x4c, 0xb4, 0x0a, 0xc8, 0x37, 0x78, 0x55, 0x70, 0x0f, 0x6b, 0x5e, 0x12, 0x62, 0xe8, 0xec,
+	0x2f, 0xeb, 0xad, 0x5b, 0x37, 0xac, 0x83, 0x94, 0x34, 0x78, 0x07, 0x8e, 0x47, 0x52, 0xae, 0x12,
+	0x0f, 0x53, 0x15, 0xe0, 0xe4, 0x28, 0x13, 0xd5, 0x88, 0x29, 0xca, 0x5e, 0x3c, 0xe5, 0x8d, 0xa1,
+	0xc5, 0xf6, 0x6e, 0xab, 0xe0, 0xa0, 0x6b, 0x04, 0x3f, 0x01, 0x1b, 0xbd, 0x82, 0xd4, 0xad, 0x64,
+	0x8a, 0xc4, 0x0a, 0x07, 0x94, 0xf9, 0x81, 0x42, 0x39, 0x9d, 0x6f, 0xaf, 0x22, 0xa5, 0x05, 0x2d,
+	0x61, 0xdc, 0xd5, 0x04, 0xb8, 0x07, 0xe6, 0xcd, 0x14, 0x71, 0x4c, 0x9f, 0x90, 0xd8, 0x43, 0xa8,
+	0x60, 0x6d, 0xcd, 0xee, 0xac, 0x16, 0x4d, 0x4e, 0xc5, 0xa4, 0x73, 0x14, 0xd3, 0xce, 0x51, 0xac,
+	0x08, 0xc6, 0xcb, 0x53, 0x49, 0xd6, 0xce, 0x9c, 0x51, 0x39, 0x5a, 0x04, 0x1f, 0x83, 0x05, 0x8f,
+	0xd6, 0x48, 0x23, 0x54, 0x58, 0x89, 0x63, 0xca, 0x25, 0x5a, 0xd5, 0xe5, 0xff, 0x83, 0x71, 0xe5,
+	0xff, 0x30, 0x61, 0xa4, 0x15, 0xf1, 0x50, 0xec, 0x51, 0x2e, 0xa2, 0x23, 0xa6, 0x82, 0x3b, 0x94,
+	0x53, 0xc9, 0xe4, 0x3e, 0xaf, 0x09, 0xe9, 0xcc, 0xa7, 0x76, 0x9a, 0x2b, 0x61, 0x01, 0xcc, 0x32,
+	0xce, 0x14, 0x23, 0x21, 0xfb, 0x9a, 0xc6, 0x68, 0x4d, 0x57, 0xac, 0xfe, 0x21, 0x78, 0x03, 0x4c,
+	0xc5, 0x75, 0x57, 0xa2, 0x75, 0x1d, 0x37, 0x37, 0x2e, 0xae, 0x53, 0x77, 0x1d, 0x4d, 0x82, 0x45,
+	0xb0, 0x2c, 0x6a, 0xb5, 0x64, 0xd2, 0xe9, 0xdc, 0xb9, 0xe0, 0x2e, 0x45, 0x1b, 0x7a, 0xb1, 0x32,
+	0x06, 0x32, 0x9b, 0x7a, 0x3f, 0x01, 0xe0, 0x2e, 0x58, 0x8b, 0x18, 0xc7, 0x2e, 0x09, 0x43, 0x4c,
+	0x5b, 0x8a, 0xc6, 0x9c, 0x84, 0xd8, 0x23, 0x8a, 0x60, 0x9f, 0x48, 0x74, 0x55, 0xcb, 0xae, 0x44,
+	0x8c, 0x57, 0x48, 0x18, 0xde, 0x4e, 0xf1, 0x3d, 0xa2, 0xc8, 0x1d, 0x22, 0xe1, 0x15, 0x30, 0x5d,
+	0x27, 0x0d, 0x49, 0x3d, 0x94, 0x2f, 0x58, 0x5b, 0x97, 0x9d, 0xf4, 0x0b, 0x1e, 0x81, 0xac, 0x68,
+	0xa8, 0xaa, 0x68, 0x70, 0x0f, 0xc7, 0x44, 0x51, 0x1c, 0xb2, 0x88, 0x29, 0x89, 0x6c, 0x3d, 0x81,
+	0x37, 0xc6, 0x4d, 0xe0, 0x41, 0xca, 0x77, 0x88, 0xa2, 0xf7, 0x12, 0xb6, 0x03, 0xc5, 0xf0, 0x90,
+	0x84, 0xef, 0x83, 0x9c, 0xbe, 0x17, 0xb8, 0x49, 0x63, 0x56, 0x63, 0x2e, 0xd1, 0x67, 0x30, 0x12,
+	0x1e, 0x45, 0x05, 0xbd, 0x6e, 0x2b, 0x1a, 0x7e, 0xd4, 0x87, 0x7e, 0x26, 0x3c, 0xba, 0x7b, 0xad,
+	0xdb, 0x64, 0x0b, 0xbd, 0x26, 0x7b, 0x4e, 0x67, 0xdd, 0xfc, 0x65, 0x12, 0x64, 0x46, 0x92, 0x81,
+	0xef, 0x81, 0x79, 0xbd, 0xf9, 0xbd, 0x76, 0x98, 0x74, 0xdc, 0x99, 0xf2, 0x52, 0xa7, 0x6d, 0xcf,
+	0xa9, 0xbe, 0x3d, 0x77, 0x06, 0xbe, 0xe0, 0x87, 0x60, 0xc1, 0x5c, 0x2e, 0x2c, 0xa9, 0x2b, 0xb8,
+	0x27, 0x75, 0xff, 0x9d, 0x2a, 0x67, 0x3a, 0x6d, 0x7b, 0xde, 0x20, 0x07, 0x06, 0x70, 0x06, 0x3f,
+	0xe1, 0x3e, 0x00, 0x11, 0x69, 0x61, 0x12, 0x25, 0x69, 0x9a, 0xe6, 0x5b, 0xbe, 0x9e, 0xde, 0xa9,
+	0x95, 0xd1, 0x3b, 0xb5, 0xcf, 0x55, 0xa7, 0x6d, 0xcf, 0x44, 0xa4, 0x75, 0x4b, 0x2b, 0x9c, 0xb3,
+	0xbf, 0xf0, 0x2b, 0xb0, 0x90, 0x58, 0xd5, 0x69, 0xec, 0x52, 0xae, 0x88, 0x4f, 0x75, 0x5f, 0x9e,
+	0x29, 0xbf, 0x7b, 0x81, 0x2b, 0x9a, 0xe4, 0x19, 0x91, 0xd6, 0xc3, 0x9e, 0xd6, 0x19, 0xfc, 0xdc,
+	0x9d, 0xfa, 0xfb, 0x27, 0xdb, 0xda, 0xfc, 0x75, 0x12, 0x2c, 0x55, 0x44, 0x54, 0x0f, 0x69, 0x44,
+	0x15, 0x89, 0x4f, 0x92, 0x83, 0x0e, 0xfd, 0x97, 0x77, 0x0b, 0xfd, 0x6e, 0x29, 0x17, 0x3a, 0x6d,
+	0xfb, 0x85, 0x1d, 0xe3, 0x25, 0xfd, 0xe4, 0x31, 0xd8, 0x08, 0x89, 0xa2, 0x52, 0x61, 0x51, 0x95,
+	0x34, 0x6e, 0x26, 0x2d, 0x5a, 0xc7, 0x48, 0x0b, 0x85, 0x59, 0xf3, 0xab, 0x9d, 0xb6, 0xbd, 0x6a,
+	0x78, 0x0f, 0x52, 0x9a, 0xb6, 0x30, 0xc5, 0xc2, 0x39, 0x1f, 0x82, 0x5f, 0x80, 0xb5, 0xf1, 0xfe,
+	0x7a, 0x0e, 0x97, 0xb4, 0xfb, 0x7a, 0xa7, 0x6d, 0xe7, 0xc6, 0x58, 0xe8, 0xf4, 0xcf, 0x03, 0x36,
+	0x7f, 0x9c, 0x04, 0x37, 0xce, 0x39, 0x88, 0x49, 0xd9, 0x18, 0x59, 0xd2, 0x6f, 0xad, 0x17, 0x3f,
+	0x4b, 0xad, 0x57, 0x7c, 0x96, 0x9a, 0xb4, 0xdd, 0x57, 0x7c, 0xb3, 0x42, 0x1f, 0x64, 0xdc, 0xbe,
+	0xd4, 0x30, 0xe3, 0x35, 0xa1, 0x57, 0x79, 0x76, 0xe7, 0xf5, 0xf1, 0xd1, 0x07, 0xe7, 0x51, 0xce,
+	0x76, 0xda, 0xf6, 0x92, 0x3b, 0x34, 0xea, 0x8c, 0x8c, 0x94, 0x2b, 0x4f, 0x9f, 0xe7, 0xad, 0x67,
+	0xcf, 0xf3, 0xd6, 0x9f, 0xcf, 0xf3, 0xd6, 0x0f, 0xa7, 0xf9, 0x89, 0x67, 0xa7, 0xf9, 0x89, 0xdf,
+	0x4e, 0xf3, 0x13, 0x5f, 0x5e, 0x33, 0x61, 0xde, 0x76, 0x45, 0x4c, 0x4b, 0xdd, 0xff, 0x49, 0x8a,
+	0xa5, 0xd6, 0xd9, 0xf3, 0x5e, 0xbf, 0xed, 0xab, 0xd3, 0xfa, 0x71, 0xff, 0xce, 0xbf, 0x03, 0x00,
+	0xaa, 0xd4, 0x55, 0x40, 0x7c, 0x0c, 0x00, 0x00,
+}
+
+func (this *OutboundRateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutboundRateLimit)
+	if !ok {
+		that2, ok := that.(OutboundRateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TokenAddress != that1.TokenAddress {
+		return false
+	}
+	if this.WindowSeconds != that1.WindowSeconds {
+		return false
+	}
+	if !this.MaxAmount.Equal(that1.MaxAmount) {
+		return false
+	}
+	if !this.MaxPercentage.Equal(that1.MaxPercentage) {
+		return false
+	}
+	return true
 }
-
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -553,6 +685,31 @@ func (m *CounterpartyChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimVerificationMode) > 0 {
+		i -= len(m.ClaimVerificationMode)
+		copy(dAtA[i:], m.ClaimVerificationMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ClaimVerificationMode)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.OutboundRateLimits) > 0 {
+		for iNdEx := len(m.OutboundRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
 	if m.Paused {
 		i--
 		if m.Paused {
@@ -787,6 +944,61 @@ func (m *CounterpartyChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *OutboundRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPercentage.Size()
+		i -= size
+		if _, err := m.MaxPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ComplemetaryInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -994,6 +1206,36 @@ func (m *CounterpartyChainParams) Size() (n int) {
 	if m.Paused {
 		n += 3
 	}
+	if len(m.OutboundRateLimits) > 0 {
+		for _, e := range m.OutboundRateLimits {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.ClaimVerificationMode)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *OutboundRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovParams(uint64(m.WindowSeconds))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxPercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1876,6 +2118,241 @@ func (m *CounterpartyChainParams) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Paused = bool(v != 0)
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundRateLimits = append(m.OutboundRateLimits, &OutboundRateLimit{})
+			if err := m.OutboundRateLimits[len(m.OutboundRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimVerificationMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimVerificationMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboundRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
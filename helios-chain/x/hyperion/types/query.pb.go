@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -4587,6 +4588,210 @@ func (m *QueryGetHeliosEffectiveAverageBlockTimeResponse) GetAverageBlockTime()
 	return 0
 }
 
+type QueryGetOutboundQuotaRequest struct {
+	ChainId      uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TokenAddress string `protobuf:"bytes,2,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+}
+
+func (m *QueryGetOutboundQuotaRequest) Reset()         { *m = QueryGetOutboundQuotaRequest{} }
+func (m *QueryGetOutboundQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutboundQuotaRequest) ProtoMessage()    {}
+func (*QueryGetOutboundQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0fc6da5c0da7973, []int{94}
+}
+func (m *QueryGetOutboundQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOutboundQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOutboundQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOutboundQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutboundQuotaRequest.Merge(m, src)
+}
+func (m *QueryGetOutboundQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOutboundQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutboundQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOutboundQuotaRequest proto.InternalMessageInfo
+
+func (m *QueryGetOutboundQuotaRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryGetOutboundQuotaRequest) GetTokenAddress() string {
+	if m != nil {
+		return m.TokenAddress
+	}
+	return ""
+}
+
+type QueryGetOutboundQuotaResponse struct {
+	// false when no rate limit applies to the token, used and remaining are then empty
+	Limited   bool                  `protobuf:"varint,1,opt,name=limited,proto3" json:"limited,omitempty"`
+	RateLimit OutboundRateLimit     `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rateLimit"`
+	Used      cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+}
+
+func (m *QueryGetOutboundQuotaResponse) Reset()         { *m = QueryGetOutboundQuotaResponse{} }
+func (m *QueryGetOutboundQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOutboundQuotaResponse) ProtoMessage()    {}
+func (*QueryGetOutboundQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0fc6da5c0da7973, []int{95}
+}
+func (m *QueryGetOutboundQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetOutboundQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetOutboundQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetOutboundQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOutboundQuotaResponse.Merge(m, src)
+}
+func (m *QueryGetOutboundQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetOutboundQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOutboundQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetOutboundQuotaResponse proto.InternalMessageInfo
+
+func (m *QueryGetOutboundQuotaResponse) GetLimited() bool {
+	if m != nil {
+		return m.Limited
+	}
+	return false
+}
+
+func (m *QueryGetOutboundQuotaResponse) GetRateLimit() OutboundRateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return OutboundRateLimit{}
+}
+
+type QueryGetCounterpartyHeaderRequest struct {
+	ChainId uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// zero for the latest header
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryGetCounterpartyHeaderRequest) Reset()         { *m = QueryGetCounterpartyHeaderRequest{} }
+func (m *QueryGetCounterpartyHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCounterpartyHeaderRequest) ProtoMessage()    {}
+func (*QueryGetCounterpartyHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0fc6da5c0da7973, []int{96}
+}
+func (m *QueryGetCounterpartyHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCounterpartyHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCounterpartyHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCounterpartyHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCounterpartyHeaderRequest.Merge(m, src)
+}
+func (m *QueryGetCounterpartyHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCounterpartyHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCounterpartyHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCounterpartyHeaderRequest proto.InternalMessageInfo
+
+func (m *QueryGetCounterpartyHeaderRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryGetCounterpartyHeaderRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryGetCounterpartyHeaderResponse struct {
+	Header *CounterpartyHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (m *QueryGetCounterpartyHeaderResponse) Reset()         { *m = QueryGetCounterpartyHeaderResponse{} }
+func (m *QueryGetCounterpartyHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCounterpartyHeaderResponse) ProtoMessage()    {}
+func (*QueryGetCounterpartyHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0fc6da5c0da7973, []int{97}
+}
+func (m *QueryGetCounterpartyHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCounterpartyHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCounterpartyHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCounterpartyHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCounterpartyHeaderResponse.Merge(m, src)
+}
+func (m *QueryGetCounterpartyHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCounterpartyHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCounterpartyHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCounterpartyHeaderResponse proto.InternalMessageInfo
+
+func (m *QueryGetCounterpartyHeaderResponse) GetHeader() *CounterpartyHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "helios.hyperion.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "helios.hyperion.v1.QueryParamsResponse")
@@ -4682,264 +4887,280 @@ func init() {
 	proto.RegisterType((*QueryGetCounterpartyChainParamsWithComplemetaryInfoResponse)(nil), "helios.hyperion.v1.QueryGetCounterpartyChainParamsWithComplemetaryInfoResponse")
 	proto.RegisterType((*QueryGetHeliosEffectiveAverageBlockTimeRequest)(nil), "helios.hyperion.v1.QueryGetHeliosEffectiveAverageBlockTimeRequest")
 	proto.RegisterType((*QueryGetHeliosEffectiveAverageBlockTimeResponse)(nil), "helios.hyperion.v1.QueryGetHeliosEffectiveAverageBlockTimeResponse")
+	proto.RegisterType((*QueryGetOutboundQuotaRequest)(nil), "helios.hyperion.v1.QueryGetOutboundQuotaRequest")
+	proto.RegisterType((*QueryGetOutboundQuotaResponse)(nil), "helios.hyperion.v1.QueryGetOutboundQuotaResponse")
+	proto.RegisterType((*QueryGetCounterpartyHeaderRequest)(nil), "helios.hyperion.v1.QueryGetCounterpartyHeaderRequest")
+	proto.RegisterType((*QueryGetCounterpartyHeaderResponse)(nil), "helios.hyperion.v1.QueryGetCounterpartyHeaderResponse")
 }
 
 func init() { proto.RegisterFile("helios/hyperion/v1/query.proto", fileDescriptor_c0fc6da5c0da7973) }
 
 var fileDescriptor_c0fc6da5c0da7973 = []byte{
-	// 4020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0x4d, 0x6c, 0x1c, 0xc7,
-	0x95, 0x56, 0x53, 0xd4, 0x0f, 0x8b, 0xfa, 0x2d, 0x51, 0x12, 0xd9, 0xa2, 0x48, 0xa9, 0xa9, 0x7f,
-	0x4a, 0x1c, 0x8a, 0xfa, 0xb5, 0x25, 0x59, 0x26, 0x29, 0x8a, 0x24, 0x2c, 0x99, 0xf2, 0x88, 0x58,
-	0x19, 0xde, 0x35, 0x7a, 0x9b, 0x33, 0x35, 0x33, 0x6d, 0xf5, 0x74, 0x8f, 0xbb, 0x8b, 0x34, 0x69,
-	0xad, 0x16, 0xeb, 0xdd, 0xc3, 0x1e, 0xf6, 0xb2, 0x86, 0x81, 0xc5, 0x02, 0x49, 0x90, 0x18, 0x41,
-	0x92, 0x43, 0xe0, 0x04, 0x09, 0x82, 0x00, 0x39, 0x24, 0x39, 0x24, 0x01, 0x8c, 0x20, 0x3f, 0x46,
-	0x12, 0x24, 0xce, 0x25, 0x08, 0x6c, 0x23, 0xa7, 0x9c, 0x72, 0xcd, 0x25, 0xe8, 0xaa, 0x57, 0xd3,
-	0x3f, 0x53, 0x5d, 0xd3, 0x33, 0x14, 0x02, 0xe4, 0x46, 0x56, 0xd5, 0x7b, 0xf5, 0xbd, 0xfa, 0x79,
-	0xf5, 0xde, 0xeb, 0x8f, 0x44, 0x23, 0x35, 0xe2, 0xd8, 0x5e, 0x50, 0xa8, 0x6d, 0x34, 0x88, 0x6f,
-	0x7b, 0x6e, 0x61, 0xed, 0x62, 0xe1, 0xcd, 0x55, 0xe2, 0x6f, 0x4c, 0x34, 0x7c, 0x8f, 0x7a, 0x18,
-	0xf3, 0xfe, 0x09, 0xd1, 0x3f, 0xb1, 0x76, 0x51, 0x3f, 0x57, 0xf2, 0x82, 0xba, 0x17, 0x14, 0x56,
-	0xac, 0x80, 0xf0, 0xc1, 0x85, 0xb5, 0x8b, 0x2b, 0x84, 0x5a, 0x17, 0x0b, 0x0d, 0xab, 0x6a, 0xbb,
-	0x16, 0x0d, 0x07, 0x32, 0x79, 0x7d, 0x24, 0x3e, 0x56, 0x8c, 0x2a, 0x79, 0xb6, 0xe8, 0x3f, 0x21,
-	0x99, 0xdf, 0xa2, 0x94, 0x04, 0x34, 0xae, 0xe5, 0x98, 0x64, 0x54, 0x95, 0xb8, 0x24, 0xb0, 0x03,
-	0x18, 0x31, 0x2a, 0x19, 0xd1, 0xb0, 0x7c, 0xab, 0x2e, 0x06, 0xc8, 0x0c, 0xa5, 0x1b, 0x0d, 0x22,
-	0xfa, 0x8f, 0x4a, 0xfa, 0xeb, 0x41, 0x55, 0xd5, 0xdd, 0xf0, 0x3c, 0x47, 0xa1, 0x7d, 0xc5, 0xa2,
-	0xa5, 0x1a, 0xf4, 0x9f, 0x92, 0xf4, 0x93, 0x75, 0x4a, 0x7c, 0xd7, 0x72, 0xcc, 0xb2, 0x45, 0x2d,
-	0x18, 0x37, 0x5c, 0xf5, 0xbc, 0xaa, 0x43, 0x0a, 0x56, 0xc3, 0x2e, 0x58, 0xae, 0xeb, 0xf1, 0x55,
-	0x10, 0x20, 0x06, 0xaa, 0x5e, 0xd5, 0x63, 0x3f, 0x16, 0xc2, 0x9f, 0x78, 0xab, 0x31, 0x80, 0xf0,
-	0x2b, 0xe1, 0x26, 0x3c, 0x60, 0xe6, 0x16, 0xc9, 0x9b, 0xab, 0x24, 0xa0, 0xc6, 0x12, 0x3a, 0x90,
-	0x68, 0x0d, 0x1a, 0x9e, 0x1b, 0x10, 0x7c, 0x1d, 0x6d, 0xe7, 0xcb, 0x32, 0xa8, 0x1d, 0xd3, 0xce,
-	0xf4, 0x4f, 0xe9, 0x13, 0xad, 0x1b, 0x3c, 0xc1, 0x65, 0x66, 0x7a, 0x3f, 0xfc, 0xc3, 0xe8, 0x96,
-	0x22, 0x8c, 0x37, 0x3c, 0x74, 0x98, 0x29, 0x9c, 0x8e, 0x76, 0x07, 0xe6, 0xc2, 0x03, 0x68, 0x9b,
-	0xeb, 0xb9, 0x25, 0xc2, 0x74, 0xf6, 0x16, 0xf9, 0x2f, 0xf8, 0x28, 0x42, 0x25, 0xc7, 0xb2, 0xeb,
-	0x66, 0xcd, 0x0a, 0x6a, 0x83, 0x3d, 0xc7, 0xb4, 0x33, 0xbb, 0x8a, 0x7d, 0xac, 0x65, 0xc1, 0x0a,
-	0x6a, 0x78, 0x14, 0xf5, 0x8b, 0x39, 0x4d, 0xbb, 0x3c, 0xb8, 0x95, 0x89, 0x22, 0xd1, 0xb4, 0x58,
-	0x36, 0x5e, 0x47, 0x83, 0xad, 0x13, 0x82, 0x19, 0xd3, 0xa8, 0x3f, 0x76, 0x4a, 0xc0, 0x96, 0x51,
-	0x99, 0x2d, 0x71, 0xe9, 0xb8, 0x8c, 0x71, 0x13, 0x0d, 0x31, 0xf5, 0xb3, 0xab, 0xbe, 0x4f, 0x5c,
-	0xfa, 0x4f, 0x96, 0x13, 0x10, 0x2a, 0x2c, 0x4a, 0x81, 0xd3, 0x5a, 0xc0, 0x3d, 0x40, 0xba, 0x4c,
-	0x1a, 0xe0, 0x4d, 0xa1, 0xed, 0x6b, 0xac, 0x45, 0xb5, 0xca, 0x20, 0x03, 0x23, 0x8d, 0x22, 0xe0,
-	0x49, 0x00, 0xc9, 0x8b, 0x27, 0xda, 0x82, 0x9e, 0xd8, 0x16, 0x34, 0x51, 0xa6, 0x74, 0x6e, 0x02,
-	0xa5, 0x93, 0x40, 0x39, 0xeb, 0xb9, 0x15, 0xdb, 0xaf, 0x6f, 0x0e, 0x25, 0x1e, 0x44, 0x3b, 0xac,
-	0x72, 0xd9, 0x27, 0x41, 0xc0, 0x4e, 0x41, 0x5f, 0x51, 0xfc, 0x6a, 0xfc, 0x4b, 0x02, 0x7f, 0x73,
-	0x36, 0xc0, 0xff, 0x02, 0xda, 0x51, 0xe2, 0x4d, 0x60, 0xc0, 0x09, 0x99, 0x01, 0xf7, 0x83, 0x6a,
-	0x52, 0x5c, 0x08, 0x19, 0xaf, 0xa1, 0xe3, 0xad, 0xda, 0x83, 0x99, 0x8d, 0x97, 0x43, 0x54, 0x9b,
-	0x5c, 0xf9, 0x0a, 0x32, 0x54, 0xba, 0xc1, 0x82, 0x17, 0xd1, 0x4e, 0x00, 0x13, 0xde, 0xc7, 0xad,
-	0xb9, 0x4d, 0x68, 0x4a, 0x19, 0xd3, 0x68, 0x84, 0xcd, 0x73, 0xcf, 0x0a, 0x92, 0x47, 0x38, 0xc8,
-	0x7d, 0x94, 0x1f, 0xa1, 0xd1, 0x4c, 0x15, 0x80, 0xf3, 0x32, 0xda, 0xc1, 0xf7, 0x5f, 0xc0, 0x54,
-	0x1d, 0x15, 0x31, 0xd4, 0xa8, 0xa2, 0x73, 0x4d, 0xc5, 0x0f, 0x88, 0x5b, 0xb6, 0xdd, 0x6a, 0x42,
-	0xff, 0xcc, 0xc6, 0x74, 0xb9, 0xec, 0xe7, 0x5e, 0xe8, 0xd8, 0x31, 0xe9, 0x49, 0x1e, 0x93, 0x12,
-	0x1a, 0xcf, 0x35, 0xd1, 0xa6, 0xac, 0xb9, 0x86, 0x06, 0xd8, 0x24, 0x33, 0xa1, 0x5b, 0xbf, 0x4b,
-	0x72, 0x1f, 0x10, 0x63, 0x19, 0x1d, 0x4c, 0x09, 0x02, 0x8e, 0x1b, 0xa8, 0x6f, 0x05, 0xda, 0x04,
-	0x92, 0xa3, 0x32, 0x24, 0x42, 0x30, 0x28, 0x46, 0xe3, 0x8d, 0xdf, 0x6b, 0x70, 0xc2, 0x44, 0xef,
-	0x23, 0x9b, 0xd6, 0xee, 0xdb, 0xae, 0x5d, 0x5f, 0xad, 0x77, 0x80, 0x0e, 0xbf, 0x84, 0xf6, 0xd7,
-	0xb9, 0x94, 0xc9, 0x94, 0x9b, 0x15, 0xc2, 0x8f, 0x72, 0xff, 0xd4, 0xd0, 0x04, 0x7f, 0xbc, 0x27,
-	0xc2, 0xc7, 0x7b, 0x02, 0x1e, 0xef, 0x89, 0x59, 0xcf, 0x76, 0xe1, 0x69, 0xd8, 0x0b, 0x92, 0x02,
-	0x02, 0x9e, 0x43, 0x7b, 0x84, 0x32, 0xba, 0xce, 0x34, 0x6d, 0xcd, 0xa7, 0x69, 0x17, 0x88, 0x2d,
-	0xaf, 0xdf, 0x25, 0xc4, 0xb0, 0xd1, 0x98, 0xd2, 0x34, 0x58, 0xbf, 0x99, 0xd6, 0xf5, 0x3b, 0xa1,
-	0x5c, 0xbf, 0x50, 0xcf, 0x62, 0x39, 0xb1, 0x8c, 0x15, 0x74, 0x36, 0x7d, 0x74, 0xd8, 0xf0, 0x67,
-	0x7d, 0x44, 0x25, 0x77, 0x41, 0x36, 0x0f, 0x58, 0xf6, 0x1c, 0xda, 0xc6, 0x20, 0x82, 0x5f, 0x1b,
-	0x93, 0x59, 0xb5, 0xb4, 0x4a, 0xab, 0x9e, 0xed, 0x56, 0x97, 0xd7, 0xb9, 0x22, 0x2e, 0x91, 0x39,
-	0x51, 0xf0, 0xac, 0x2d, 0x7a, 0xa3, 0xf5, 0xd2, 0x49, 0x27, 0x6a, 0x1e, 0xf6, 0xed, 0x0c, 0xa0,
-	0xd8, 0xa9, 0x5c, 0x36, 0x81, 0x88, 0xf1, 0x22, 0x3a, 0xca, 0xe6, 0x4a, 0xf5, 0x93, 0xfc, 0x4e,
-	0xce, 0x04, 0x3f, 0x29, 0xd1, 0x00, 0x00, 0x6f, 0xa1, 0x1d, 0x2b, 0xbc, 0xa9, 0x13, 0x84, 0x42,
-	0xc6, 0xf8, 0x2f, 0x0d, 0xdc, 0x68, 0x72, 0x5b, 0x9f, 0xc1, 0x5b, 0x82, 0xcf, 0xa2, 0x7d, 0x25,
-	0xcf, 0xa5, 0xbe, 0x55, 0xa2, 0x66, 0xf2, 0xa1, 0xdc, 0x2b, 0xda, 0xa7, 0x61, 0x53, 0x5e, 0x47,
-	0xc7, 0xb2, 0x41, 0x6c, 0xfe, 0x70, 0x3d, 0x85, 0xd7, 0x9f, 0x35, 0x8a, 0x47, 0xed, 0xef, 0x69,
-	0x9d, 0x2e, 0x9b, 0x1e, 0xec, 0xba, 0xdd, 0xf2, 0x98, 0x8e, 0x65, 0x3c, 0xa6, 0x20, 0xca, 0x4d,
-	0x8b, 0xde, 0xd2, 0x57, 0xd1, 0x91, 0xe6, 0x89, 0x9e, 0x5b, 0x23, 0xee, 0xb3, 0xbb, 0x2b, 0x0e,
-	0x1a, 0x96, 0x6b, 0x06, 0xe8, 0xf7, 0xd0, 0x3e, 0xc7, 0x0a, 0xa8, 0xc9, 0xe3, 0x65, 0x12, 0x8e,
-	0x80, 0xdd, 0x31, 0x64, 0x26, 0x84, 0x6a, 0x66, 0xc3, 0xa1, 0x4c, 0x57, 0x71, 0x8f, 0x93, 0xf8,
-	0xdd, 0xa8, 0xc2, 0x49, 0x5c, 0xf6, 0x1e, 0x13, 0x17, 0xd6, 0x6e, 0xd9, 0xbb, 0x43, 0x5c, 0x2f,
-	0x7f, 0xa4, 0x36, 0x86, 0x76, 0xd3, 0x50, 0xdc, 0x4c, 0x5a, 0xb4, 0x8b, 0xc6, 0x74, 0x1a, 0x04,
-	0x4e, 0x9b, 0x74, 0x22, 0x30, 0x6d, 0x00, 0x6d, 0x2b, 0x87, 0x0d, 0x6c, 0x8e, 0xbe, 0x22, 0xff,
-	0x05, 0x8f, 0xa3, 0xfd, 0xfc, 0x45, 0x30, 0x3d, 0xdf, 0x66, 0x29, 0x23, 0x29, 0xb3, 0x29, 0x76,
-	0x16, 0xf7, 0xf1, 0x8e, 0xa5, 0x66, 0xbb, 0xf1, 0x2a, 0xd8, 0xc3, 0x14, 0x2f, 0x7b, 0xf1, 0xd9,
-	0x3a, 0x39, 0x7b, 0x1c, 0x46, 0x4f, 0x0c, 0x86, 0x41, 0xc1, 0x00, 0xa9, 0x66, 0x30, 0xa0, 0x65,
-	0x25, 0xb4, 0xd6, 0x95, 0xe8, 0xcc, 0x9e, 0x00, 0x9e, 0xb7, 0x3b, 0xc4, 0x21, 0x55, 0x8b, 0x92,
-	0x97, 0xc8, 0x46, 0x30, 0x13, 0x46, 0x8a, 0x76, 0xd9, 0xa2, 0x9e, 0x2f, 0x74, 0xb6, 0xb5, 0x69,
-	0x1c, 0xed, 0x5f, 0x13, 0x42, 0xa9, 0x7d, 0xda, 0xb7, 0x96, 0xd2, 0x66, 0xbc, 0xa3, 0x81, 0xbf,
-	0x56, 0xcf, 0xda, 0x34, 0x7b, 0x14, 0xf5, 0x13, 0x5a, 0x4b, 0x19, 0x8d, 0x08, 0xad, 0x09, 0x78,
-	0x17, 0xd1, 0x80, 0xe7, 0x87, 0xae, 0x8f, 0xfa, 0x12, 0x00, 0x07, 0xe2, 0x7d, 0x02, 0x83, 0x05,
-	0x6e, 0x3c, 0x09, 0x61, 0x2e, 0xd2, 0xd9, 0xd6, 0xe4, 0x14, 0xaa, 0x9e, 0x34, 0x2a, 0xe3, 0xbf,
-	0x35, 0x74, 0x52, 0x39, 0x47, 0xd3, 0x40, 0xe9, 0xea, 0x69, 0xf2, 0xd5, 0xeb, 0xc6, 0xd8, 0x7f,
-	0x43, 0xa7, 0x24, 0x40, 0x96, 0x5a, 0x47, 0xb6, 0xb7, 0xba, 0x8b, 0xd9, 0xff, 0x1d, 0x4d, 0xe4,
-	0x9b, 0xbd, 0xbb, 0xf5, 0x68, 0xbb, 0x0f, 0x16, 0x54, 0x0b, 0x20, 0x32, 0x78, 0x48, 0xdc, 0xf2,
-	0xb2, 0x37, 0x5b, 0xb3, 0x6c, 0xb7, 0xbd, 0xb9, 0x27, 0xd1, 0x9e, 0x80, 0xb8, 0x65, 0x92, 0x36,
-	0x74, 0x37, 0x6f, 0x15, 0x53, 0xfc, 0x46, 0xbc, 0xb8, 0xad, 0x73, 0x34, 0x8d, 0x7a, 0x15, 0x0d,
-	0x50, 0xdf, 0x72, 0x83, 0x0a, 0xf1, 0x03, 0xd3, 0x76, 0xcd, 0xe4, 0x0b, 0x7f, 0x4a, 0xf9, 0xf4,
-	0x81, 0xdc, 0xf2, 0x7a, 0x11, 0x37, 0x75, 0x2c, 0xba, 0x10, 0x36, 0xe0, 0x47, 0xe8, 0xc0, 0xaa,
-	0xcb, 0xd5, 0x95, 0xcd, 0x66, 0xff, 0x60, 0x4f, 0x67, 0x8a, 0x9b, 0x2a, 0x44, 0x63, 0x60, 0xdc,
-	0x81, 0xac, 0x74, 0xda, 0x71, 0x64, 0x86, 0xe5, 0x8c, 0x77, 0x7e, 0x27, 0xd2, 0x83, 0x0c, 0x35,
-	0xff, 0xb8, 0xeb, 0x33, 0x04, 0x27, 0xeb, 0xbe, 0x57, 0x5e, 0x75, 0xc8, 0x43, 0x6a, 0x51, 0x11,
-	0x5f, 0x19, 0x45, 0xa8, 0x18, 0x25, 0xba, 0xc0, 0xd2, 0xab, 0x68, 0x5b, 0x10, 0x36, 0xc0, 0xbb,
-	0x7a, 0x4c, 0x86, 0x60, 0x9e, 0x97, 0x14, 0xb9, 0x20, 0x1f, 0x1e, 0xa6, 0x7d, 0xf7, 0xed, 0x20,
-	0xb0, 0xdd, 0x2a, 0x8b, 0xa2, 0xf2, 0x47, 0x9c, 0x77, 0xd1, 0xc1, 0x94, 0x20, 0x20, 0xb9, 0x80,
-	0xb0, 0xd7, 0x20, 0x89, 0x9b, 0x0c, 0x2b, 0xde, 0x57, 0xdc, 0x2f, 0x7a, 0xa6, 0x45, 0x87, 0x31,
-	0x8d, 0x4e, 0x30, 0xa3, 0xe6, 0x09, 0x5d, 0x68, 0x6a, 0xbf, 0xeb, 0x7b, 0x75, 0xb6, 0x93, 0x8b,
-	0x65, 0x01, 0x68, 0x08, 0xed, 0x2c, 0x85, 0x2d, 0x11, 0x9a, 0x1d, 0x25, 0x3e, 0xc2, 0x58, 0x00,
-	0x9f, 0x98, 0xad, 0x22, 0x72, 0xfa, 0x6a, 0xa3, 0x5e, 0x01, 0xb7, 0x32, 0x4f, 0x68, 0x18, 0x84,
-	0x2c, 0xad, 0x04, 0xc4, 0x5f, 0x23, 0xe5, 0x39, 0x5a, 0x23, 0x3e, 0x59, 0xad, 0xcf, 0x38, 0x5e,
-	0xe9, 0xf1, 0x02, 0xb1, 0xab, 0xb5, 0xfc, 0x95, 0xb4, 0xff, 0xd7, 0x50, 0x21, 0xb7, 0x4e, 0xc0,
-	0x49, 0xd0, 0x00, 0x8b, 0x97, 0x3c, 0x18, 0x6b, 0xd6, 0x58, 0x3f, 0xec, 0xed, 0xa5, 0xac, 0x98,
-	0x49, 0xa5, 0x1a, 0x3b, 0xb1, 0x01, 0xbc, 0x2d, 0xbe, 0x6e, 0x09, 0xf1, 0x30, 0xca, 0xea, 0x28,
-	0xb0, 0x37, 0x4a, 0xf0, 0x18, 0x28, 0x34, 0x35, 0xa3, 0xf3, 0xa1, 0xa4, 0x69, 0x2c, 0x1a, 0x34,
-	0xe3, 0xf5, 0xd5, 0x43, 0x8e, 0x54, 0x85, 0xf1, 0x4d, 0x0d, 0x9d, 0x11, 0xb3, 0xb0, 0xfb, 0x62,
-	0x95, 0x58, 0xf5, 0x78, 0x66, 0xe3, 0x81, 0x55, 0x25, 0xd3, 0x6e, 0xf9, 0xa1, 0xfd, 0x76, 0x13,
-	0x72, 0x2c, 0x58, 0xd5, 0x12, 0xc1, 0x2a, 0xbe, 0x8b, 0x50, 0x54, 0xc6, 0x87, 0x52, 0xc0, 0xa9,
-	0x44, 0x02, 0xcf, 0x3f, 0x10, 0x88, 0x34, 0x3e, 0x54, 0x2e, 0x4a, 0x8b, 0x31, 0x49, 0x7c, 0x1c,
-	0xed, 0xaa, 0x78, 0x7e, 0xdd, 0xa2, 0x26, 0xf1, 0x4b, 0x53, 0x93, 0x2c, 0xa8, 0xdf, 0x59, 0xec,
-	0xe7, 0x6d, 0x73, 0x61, 0x93, 0xb1, 0x02, 0xd9, 0xb7, 0x1a, 0x30, 0xac, 0xcc, 0x15, 0xb4, 0x95,
-	0xae, 0x2b, 0x43, 0x7b, 0x1e, 0x8c, 0x46, 0xee, 0x23, 0x1c, 0x6f, 0xdc, 0x8b, 0x8e, 0xd7, 0xac,
-	0xb7, 0xea, 0x52, 0xe2, 0x37, 0x2c, 0x9f, 0x6e, 0xb0, 0xa3, 0x0f, 0x85, 0xee, 0x8d, 0xfc, 0x57,
-	0xe9, 0x73, 0x1a, 0x9a, 0xcc, 0xaf, 0x0e, 0x90, 0x57, 0xd1, 0x50, 0x29, 0x36, 0xd6, 0xe4, 0xca,
-	0x13, 0x75, 0xf8, 0x71, 0x99, 0x3d, 0x19, 0x13, 0x14, 0x0f, 0x97, 0xe4, 0x1d, 0xc6, 0x4d, 0x78,
-	0x11, 0xe7, 0x09, 0x2d, 0x36, 0x4a, 0xf7, 0xec, 0x30, 0xf9, 0xcb, 0x6f, 0xdb, 0x12, 0x44, 0xc3,
-	0x52, 0xe9, 0x66, 0x94, 0xd0, 0xeb, 0x37, 0x4a, 0x62, 0x17, 0x0e, 0xcb, 0x50, 0x17, 0x1b, 0xa5,
-	0x22, 0x1b, 0x64, 0xcc, 0x47, 0xae, 0x2b, 0x19, 0x87, 0xb4, 0x46, 0xef, 0xca, 0x58, 0xd3, 0xb8,
-	0x13, 0x5d, 0xc4, 0x0c, 0x45, 0x00, 0xef, 0x08, 0xea, 0x13, 0xd6, 0x71, 0x8c, 0xbd, 0xc5, 0x9d,
-	0x60, 0x5e, 0x60, 0xdc, 0x85, 0xb8, 0x5b, 0x3c, 0x34, 0x73, 0xf0, 0x01, 0xe6, 0x8e, 0x45, 0xad,
-	0xe5, 0xf5, 0xfc, 0x9e, 0xbd, 0x0c, 0x66, 0x65, 0xea, 0x01, 0x30, 0x37, 0xe3, 0x07, 0xf6, 0x9c,
-	0xea, 0xc9, 0x4b, 0x6a, 0xe0, 0xe7, 0xd6, 0x82, 0x9c, 0x31, 0xbc, 0x1b, 0x61, 0xaa, 0x11, 0x2c,
-	0x55, 0x12, 0x21, 0x00, 0x46, 0xbd, 0x0d, 0xab, 0x2a, 0x7c, 0x02, 0xfb, 0x39, 0x6c, 0x0b, 0xec,
-	0xb7, 0x45, 0x82, 0xcd, 0x7e, 0x4e, 0x6c, 0xf8, 0xd6, 0xe4, 0x86, 0x7f, 0x4d, 0x83, 0x80, 0xbc,
-	0x75, 0x8e, 0x66, 0x51, 0x64, 0x3b, 0xcb, 0x73, 0x84, 0x15, 0x27, 0x65, 0x56, 0xdc, 0x5d, 0x75,
-	0x9c, 0xfb, 0x84, 0x5a, 0xe5, 0x10, 0x7d, 0x38, 0xba, 0x08, 0x42, 0x78, 0x5e, 0xe2, 0x4a, 0x4e,
-	0xb7, 0x75, 0x25, 0x7c, 0xee, 0xb8, 0x2f, 0x31, 0xe6, 0x20, 0x9a, 0x69, 0x29, 0xdf, 0xb0, 0x2b,
-	0x92, 0x7b, 0xe7, 0xac, 0xd4, 0x09, 0x48, 0xab, 0x01, 0xab, 0x87, 0xd0, 0x4e, 0xba, 0x6e, 0xb2,
-	0x4b, 0x26, 0xee, 0x08, 0x5d, 0x67, 0x43, 0xc2, 0x29, 0x78, 0x99, 0x94, 0xf7, 0xf2, 0x85, 0x46,
-	0x2b, 0xbc, 0x20, 0xb1, 0xea, 0x52, 0xe3, 0x16, 0xd4, 0x28, 0x16, 0xec, 0x80, 0x7a, 0xbe, 0x5d,
-	0xb2, 0x1c, 0x56, 0xb9, 0xcd, 0x8b, 0xf0, 0x2f, 0x1a, 0x14, 0x21, 0xd2, 0xf2, 0xcd, 0x9a, 0xe7,
-	0xde, 0x5a, 0xb3, 0xc7, 0xac, 0x44, 0x95, 0xcf, 0xec, 0x12, 0x6b, 0x71, 0x4f, 0x2d, 0xa1, 0x0b,
-	0x8f, 0xa3, 0xad, 0x8e, 0xf7, 0x56, 0xdb, 0x22, 0x6f, 0x31, 0x1c, 0x85, 0x2f, 0xa0, 0xde, 0x9a,
-	0x5d, 0xad, 0xb5, 0x2d, 0xe4, 0x16, 0xd9, 0x30, 0x7c, 0x09, 0xed, 0xb0, 0xd6, 0x88, 0x1f, 0x1e,
-	0xcc, 0xde, 0x76, 0x12, 0x62, 0xa4, 0xb1, 0x10, 0x39, 0x9e, 0x78, 0x86, 0x12, 0x84, 0xd7, 0x41,
-	0xac, 0xdc, 0x09, 0xb4, 0x3b, 0x9e, 0xe7, 0x88, 0x80, 0x29, 0xd9, 0x68, 0xac, 0x43, 0xf0, 0x2c,
-	0xd7, 0x04, 0x6b, 0xf8, 0x10, 0xe1, 0x84, 0x14, 0xfb, 0x00, 0xab, 0x2a, 0x20, 0xc7, 0x55, 0x31,
-	0x4d, 0xfb, 0xbd, 0xb4, 0x72, 0x63, 0x39, 0x72, 0xbd, 0x2d, 0xc3, 0xc1, 0x84, 0xac, 0x34, 0x4e,
-	0xcb, 0x4e, 0xe3, 0x56, 0xe5, 0x2b, 0x93, 0x30, 0xe7, 0x15, 0x94, 0x80, 0x23, 0xac, 0xd1, 0x72,
-	0x5b, 0xb3, 0xcf, 0x4b, 0xb5, 0x18, 0x4f, 0xd1, 0x69, 0xd9, 0xb4, 0x22, 0x78, 0xdc, 0x9c, 0x51,
-	0xe9, 0x4b, 0xd0, 0xd3, 0x72, 0x09, 0xfe, 0x2f, 0x16, 0xc8, 0x64, 0xcf, 0x0f, 0xe6, 0xbf, 0x81,
-	0xf4, 0x04, 0x80, 0xa6, 0xea, 0xd8, 0x3a, 0x9c, 0x6f, 0xb7, 0x0e, 0x09, 0xcd, 0x83, 0x5e, 0x46,
-	0x8f, 0xf1, 0x6e, 0x0f, 0x00, 0x6b, 0x71, 0x20, 0x8f, 0x6c, 0x5a, 0x5b, 0x6a, 0xb0, 0xe8, 0x25,
-	0x77, 0x4d, 0xaa, 0xe9, 0x4b, 0xe2, 0x55, 0x51, 0xee, 0x4b, 0x58, 0x40, 0x17, 0x26, 0xc2, 0xbc,
-	0xf4, 0x24, 0x0a, 0xa1, 0x50, 0x18, 0xe5, 0x05, 0xa9, 0x59, 0x68, 0xc4, 0x63, 0x68, 0x37, 0xd7,
-	0x43, 0xed, 0x3a, 0xf1, 0x56, 0x29, 0xbb, 0x79, 0xbd, 0xc5, 0x5d, 0xac, 0x71, 0x99, 0xb7, 0xc5,
-	0xe3, 0xbd, 0x6d, 0xc9, 0x78, 0xef, 0x16, 0x1a, 0x2e, 0xd5, 0x48, 0xe9, 0xb1, 0x69, 0x57, 0x4c,
-	0xdb, 0xac, 0x59, 0x6b, 0xc4, 0x0c, 0xec, 0xaa, 0x4b, 0xca, 0x3c, 0x1b, 0x1c, 0xdc, 0xce, 0xe2,
-	0xb6, 0xc3, 0x6c, 0xcc, 0x62, 0x65, 0x71, 0xc1, 0x5a, 0x23, 0x0f, 0x59, 0x3f, 0x33, 0xdd, 0x78,
-	0x03, 0x62, 0x38, 0xf5, 0x92, 0x3c, 0x9b, 0x22, 0xbb, 0x78, 0xc1, 0xe7, 0x09, 0x7d, 0x54, 0xb3,
-	0x29, 0x71, 0xec, 0x80, 0x92, 0x72, 0x33, 0x57, 0xca, 0xed, 0x65, 0xef, 0x44, 0x81, 0x89, 0x5c,
-	0x0f, 0xc0, 0x1d, 0x46, 0x7d, 0xe9, 0x0c, 0x2d, 0x6a, 0x30, 0x16, 0x21, 0xa8, 0x9f, 0x0b, 0xa8,
-	0x5d, 0xb7, 0x28, 0xb9, 0x67, 0x51, 0x12, 0x50, 0x96, 0x54, 0xa4, 0xde, 0xea, 0xb6, 0x80, 0xee,
-	0xc1, 0x85, 0x53, 0xa9, 0x02, 0x4c, 0xc7, 0xd1, 0x2e, 0x87, 0xf5, 0x9a, 0x2b, 0x61, 0x37, 0x28,
-	0xeb, 0x77, 0x22, 0x09, 0xe3, 0x9f, 0xe1, 0xb5, 0x5c, 0x0c, 0xd8, 0x39, 0x9a, 0x76, 0x7c, 0x62,
-	0x95, 0x37, 0x44, 0xc6, 0xb0, 0xc9, 0x2f, 0xdb, 0xff, 0x0a, 0x7b, 0x90, 0xa5, 0x3c, 0xca, 0x63,
-	0xec, 0x80, 0x9f, 0x6c, 0xd3, 0xe2, 0x63, 0x9a, 0x39, 0x0d, 0x9b, 0x6b, 0x67, 0xf1, 0x90, 0x2d,
-	0x55, 0x61, 0xdc, 0x8e, 0x22, 0x9f, 0x87, 0x8f, 0xed, 0x46, 0x83, 0x94, 0x3b, 0x4c, 0xbd, 0x1b,
-	0x51, 0x58, 0x93, 0x52, 0x00, 0xe0, 0x96, 0xd0, 0x9e, 0x80, 0x77, 0x70, 0x84, 0xe2, 0x34, 0x9e,
-	0x91, 0x9d, 0xc6, 0xb8, 0x8a, 0x30, 0xd4, 0x59, 0x74, 0x2b, 0x5e, 0x71, 0x77, 0x10, 0x57, 0x6c,
-	0xcc, 0x44, 0xde, 0x7f, 0xda, 0x71, 0xba, 0x43, 0xfd, 0x8e, 0x16, 0x39, 0xfb, 0x56, 0x25, 0x80,
-	0xfc, 0xf5, 0x0c, 0xe4, 0x57, 0xf3, 0x22, 0x0f, 0x6f, 0x65, 0x54, 0x04, 0x48, 0xdb, 0x71, 0x13,
-	0x3d, 0xdf, 0x26, 0xbb, 0x09, 0xe5, 0x67, 0xbd, 0x7a, 0xc3, 0x21, 0x75, 0x42, 0x2d, 0x7f, 0x83,
-	0xad, 0x06, 0xd4, 0x5f, 0xfe, 0xa4, 0xa1, 0x1b, 0x5d, 0x89, 0x83, 0x71, 0x5f, 0xd5, 0xd0, 0x64,
-	0x66, 0xa2, 0x64, 0xbe, 0x65, 0xd3, 0x30, 0xec, 0x8a, 0x64, 0x4d, 0xdb, 0xad, 0x78, 0x60, 0xff,
-	0xed, 0x0e, 0xf2, 0x27, 0x29, 0x86, 0xf1, 0x52, 0xfe, 0xc1, 0xc6, 0x64, 0x54, 0x06, 0x59, 0x60,
-	0xd3, 0xce, 0x55, 0x2a, 0xa4, 0x44, 0xed, 0x35, 0x32, 0xcd, 0x43, 0x1a, 0x76, 0x0f, 0x43, 0xd7,
-	0x2b, 0x96, 0xc6, 0x8c, 0xb2, 0xd0, 0xb6, 0x12, 0xb0, 0x1a, 0xe7, 0x11, 0x86, 0x00, 0x89, 0xdf,
-	0x74, 0xe6, 0xde, 0xe1, 0xdc, 0xec, 0xb3, 0x52, 0x52, 0x53, 0xbf, 0x9a, 0x45, 0xdb, 0xd8, 0x0c,
-	0x78, 0x0d, 0x6d, 0xe7, 0xd0, 0xf1, 0xa9, 0xcc, 0x24, 0x39, 0xc1, 0x15, 0xd3, 0x4f, 0xb7, 0x1d,
-	0xc7, 0x21, 0x19, 0x47, 0xfe, 0xf3, 0xd7, 0x9f, 0xbd, 0xd7, 0x73, 0x10, 0x1f, 0x90, 0xf0, 0xec,
-	0xf0, 0x17, 0x35, 0xd4, 0x1f, 0x63, 0x5b, 0xe1, 0xf1, 0x4c, 0xad, 0xad, 0x14, 0x32, 0xfd, 0x7c,
-	0xbe, 0xc1, 0x80, 0xe3, 0x0a, 0xc3, 0x51, 0xc0, 0x17, 0xb2, 0x78, 0x83, 0x85, 0x27, 0xec, 0x76,
-	0x3c, 0x2d, 0x3c, 0x89, 0x28, 0x68, 0x4f, 0xf1, 0x7b, 0x1a, 0xda, 0x9d, 0x20, 0x6c, 0xe1, 0x0b,
-	0x99, 0xd3, 0xca, 0x68, 0x61, 0xfa, 0x44, 0xde, 0xe1, 0x80, 0x73, 0x8c, 0xe1, 0x3c, 0x8a, 0x8f,
-	0x24, 0x70, 0x72, 0x46, 0x49, 0xa1, 0xc4, 0x45, 0xf0, 0xff, 0x68, 0x68, 0x77, 0x92, 0x7d, 0x96,
-	0x8d, 0x4a, 0x46, 0x0e, 0x53, 0xa0, 0x92, 0xf2, 0xbe, 0x32, 0x76, 0x91, 0xa3, 0x62, 0x6b, 0x94,
-	0x20, 0x1b, 0xb5, 0x45, 0x93, 0x24, 0x81, 0xb5, 0x45, 0x93, 0x62, 0x71, 0xb5, 0x59, 0x23, 0xc0,
-	0xf0, 0x81, 0x86, 0x0e, 0x4a, 0xa9, 0x54, 0xf8, 0x4a, 0xbe, 0xe9, 0x52, 0xb4, 0x2e, 0xfd, 0x6a,
-	0xa7, 0x62, 0x80, 0xf6, 0x24, 0x43, 0x3b, 0x8a, 0x8f, 0x26, 0xd0, 0x8a, 0x4f, 0xc8, 0xe2, 0xd8,
-	0xe1, 0x2f, 0x6b, 0x08, 0xb7, 0xf2, 0xa9, 0xf0, 0x54, 0xe6, 0xac, 0x99, 0xfc, 0x2d, 0xfd, 0x52,
-	0x47, 0x32, 0x00, 0xf3, 0x04, 0x83, 0x39, 0x82, 0x87, 0x65, 0x8b, 0xea, 0x0b, 0x38, 0x3f, 0xd2,
-	0xd0, 0x88, 0x9a, 0x33, 0x85, 0x5f, 0x50, 0xce, 0xde, 0x96, 0xd5, 0xa5, 0xdf, 0xee, 0x5a, 0x1e,
-	0x2c, 0x39, 0xc6, 0x2c, 0xd1, 0xf1, 0xa0, 0xcc, 0x12, 0xc7, 0x0a, 0x28, 0x7e, 0x5f, 0x43, 0x7b,
-	0x53, 0x1f, 0xd6, 0x71, 0x41, 0x39, 0x6d, 0xeb, 0xc7, 0x7d, 0x7d, 0x32, 0xbf, 0x00, 0x00, 0x1b,
-	0x67, 0xc0, 0x4e, 0xe2, 0xb1, 0x04, 0x30, 0xcf, 0xb7, 0x4a, 0x0e, 0x29, 0xb0, 0xa2, 0x6d, 0xe1,
-	0x09, 0xc4, 0x8a, 0x4f, 0xf1, 0x57, 0x34, 0x74, 0x70, 0x9e, 0x50, 0xc9, 0xd7, 0xb0, 0x6c, 0x2f,
-	0xd9, 0x3a, 0x58, 0x71, 0x16, 0xb2, 0xbf, 0xf1, 0x18, 0xe7, 0x18, 0xd0, 0x13, 0xd8, 0x48, 0x3a,
-	0x6d, 0x2e, 0x60, 0x06, 0xc4, 0x2d, 0x9b, 0xd4, 0xe3, 0x4f, 0x2d, 0xfe, 0x9e, 0x86, 0x06, 0x79,
-	0xf8, 0x21, 0x81, 0x9a, 0x7d, 0xd5, 0x54, 0xdf, 0xaa, 0x14, 0x57, 0x4d, 0xf9, 0x6d, 0xca, 0x98,
-	0x60, 0xb8, 0xcf, 0xe0, 0x53, 0x49, 0x27, 0xef, 0x38, 0xa6, 0x1c, 0xfb, 0x7f, 0x68, 0xa8, 0xaf,
-	0x49, 0xf5, 0xc2, 0x67, 0x32, 0x67, 0x4d, 0x11, 0xf8, 0xf4, 0xb3, 0x39, 0x46, 0x02, 0xa4, 0x11,
-	0x06, 0x69, 0x10, 0x1f, 0x6a, 0x25, 0x7a, 0x57, 0xc2, 0x49, 0xbf, 0xab, 0xa1, 0xc3, 0x09, 0xb6,
-	0x59, 0xc4, 0x5a, 0xc3, 0x57, 0xdb, 0x4e, 0x23, 0x65, 0xf0, 0xe9, 0xd7, 0x3a, 0x96, 0x53, 0xee,
-	0x7b, 0x13, 0x6c, 0x41, 0x30, 0xf5, 0x2a, 0x84, 0x84, 0x77, 0x68, 0x7f, 0x4b, 0x02, 0x87, 0x2f,
-	0x66, 0x4e, 0x9d, 0x45, 0xc4, 0xd2, 0xa7, 0x3a, 0x11, 0x51, 0xfa, 0x54, 0x06, 0xb4, 0xe0, 0x81,
-	0x14, 0x5d, 0xc7, 0x3f, 0xd4, 0xd0, 0x51, 0x25, 0x7d, 0x0e, 0xdf, 0xca, 0xe3, 0x6c, 0x32, 0xe9,
-	0x7d, 0xfa, 0x0b, 0xdd, 0x8a, 0x83, 0x1d, 0xa3, 0xcc, 0x8e, 0x21, 0x7c, 0x58, 0x62, 0x07, 0xf3,
-	0x54, 0x29, 0x7f, 0x2b, 0xa1, 0xcb, 0xe1, 0xfc, 0x18, 0x82, 0xee, 0xfd, 0xad, 0x82, 0xa7, 0x97,
-	0xe1, 0x6f, 0x23, 0x23, 0x02, 0xfc, 0x79, 0x0d, 0x1d, 0x90, 0xf0, 0xcb, 0xf0, 0x25, 0xf5, 0x41,
-	0x95, 0x52, 0xe2, 0xf4, 0xcb, 0x9d, 0x09, 0x01, 0x48, 0x9d, 0x81, 0x1c, 0xc0, 0xb8, 0x15, 0x24,
-	0x0b, 0x60, 0x12, 0x04, 0x31, 0x45, 0x00, 0x23, 0xe3, 0xb1, 0x29, 0x02, 0x18, 0x29, 0xef, 0x2c,
-	0x23, 0x80, 0xe1, 0x2b, 0x26, 0x02, 0x03, 0xfc, 0x1d, 0x0d, 0x1d, 0x90, 0xd0, 0xa4, 0x14, 0x8b,
-	0x96, 0xcd, 0xde, 0x52, 0x2c, 0x9a, 0x82, 0x89, 0x65, 0x4c, 0x31, 0x9c, 0xe7, 0xf1, 0xb9, 0xe4,
-	0xdf, 0xc0, 0xb0, 0x4a, 0x7d, 0x21, 0x41, 0x71, 0x0a, 0x7d, 0x2a, 0xe7, 0x69, 0x85, 0xb0, 0x25,
-	0xe4, 0x28, 0x05, 0xec, 0x6c, 0x92, 0x96, 0x02, 0xb6, 0x82, 0x7f, 0xa5, 0x86, 0xcd, 0x50, 0x86,
-	0x70, 0x13, 0xf8, 0xf1, 0xcf, 0x34, 0x34, 0x94, 0xfc, 0x56, 0x14, 0x63, 0x3a, 0xe1, 0x6b, 0x0a,
-	0x1c, 0x2a, 0x6e, 0x94, 0xe2, 0x72, 0xe5, 0x23, 0x55, 0x65, 0xe4, 0x2d, 0xec, 0x1b, 0x88, 0x59,
-	0x06, 0x15, 0xe6, 0x63, 0xb2, 0x11, 0x98, 0x2b, 0x1b, 0x66, 0x93, 0x74, 0xc3, 0x76, 0x21, 0x6d,
-	0xce, 0x1c, 0xad, 0x29, 0xfc, 0x73, 0x16, 0xfb, 0x49, 0x7f, 0xae, 0x63, 0x91, 0x26, 0xf8, 0x02,
-	0x03, 0x7f, 0x16, 0x9f, 0xce, 0x03, 0x9e, 0xd0, 0x1a, 0xfe, 0x58, 0x43, 0xc3, 0x69, 0xd8, 0xf1,
-	0xa2, 0x2b, 0x7e, 0x3e, 0x27, 0x18, 0x09, 0x6d, 0x49, 0x9f, 0xe9, 0x5e, 0xb6, 0x69, 0xd1, 0x75,
-	0x66, 0xd1, 0x14, 0x9e, 0xcc, 0x63, 0x51, 0xbc, 0x28, 0x1c, 0x3a, 0x99, 0x03, 0xa2, 0x8a, 0x12,
-	0x63, 0x9b, 0x28, 0xa2, 0xb9, 0x56, 0xba, 0x8a, 0x22, 0xe7, 0x95, 0x10, 0x58, 0x8c, 0xe3, 0x0c,
-	0xec, 0x11, 0x3c, 0x94, 0xfc, 0x13, 0x35, 0x36, 0xd2, 0x64, 0x5c, 0x15, 0xfc, 0xae, 0xd6, 0xe4,
-	0x9c, 0x08, 0x70, 0xbc, 0xae, 0x23, 0x8f, 0x86, 0x64, 0xbc, 0x16, 0x79, 0x34, 0x24, 0x25, 0xb2,
-	0x64, 0x38, 0xbe, 0x3a, 0x1f, 0x0b, 0xe5, 0x29, 0xfc, 0x13, 0x1e, 0x51, 0x4a, 0x79, 0x27, 0xf8,
-	0x7a, 0xe6, 0x0a, 0xb4, 0x61, 0xbb, 0x28, 0xce, 0x71, 0x3b, 0x92, 0x8b, 0x71, 0x99, 0xc1, 0x9e,
-	0xc0, 0xe7, 0x25, 0xbb, 0x1e, 0xab, 0xd0, 0x99, 0x15, 0xdf, 0xab, 0x9b, 0xe2, 0xd3, 0x29, 0xfe,
-	0xab, 0x16, 0x7d, 0x13, 0x69, 0xc3, 0x25, 0xc1, 0x33, 0x2a, 0x70, 0xf9, 0x78, 0x33, 0xfa, 0xec,
-	0xa6, 0x74, 0x80, 0xa9, 0xf3, 0xcc, 0xd4, 0x69, 0x7c, 0x5b, 0x65, 0x6a, 0x8a, 0x6e, 0x02, 0xea,
-	0xa0, 0xe6, 0xc4, 0x89, 0x35, 0xf8, 0xb7, 0x1a, 0xf0, 0xe7, 0x33, 0x09, 0x2c, 0xf8, 0xb9, 0xdc,
-	0x80, 0xd3, 0xf4, 0x19, 0xfd, 0xf9, 0x6e, 0x44, 0xc1, 0xc4, 0x5b, 0xcc, 0xc4, 0x6b, 0xf8, 0x4a,
-	0x07, 0x26, 0x46, 0x8c, 0x1a, 0xfc, 0x73, 0x2d, 0xfa, 0x62, 0x98, 0x49, 0x41, 0xc1, 0x37, 0x55,
-	0x00, 0xdb, 0x51, 0x6d, 0xf4, 0x5b, 0x5d, 0x4a, 0x2b, 0x13, 0x4d, 0x1a, 0x93, 0x8b, 0x25, 0x9a,
-	0x9f, 0xc5, 0x3e, 0x9d, 0xb5, 0xe3, 0xa7, 0x60, 0xe5, 0x19, 0xcb, 0x49, 0x96, 0xd1, 0xef, 0x6c,
-	0x4e, 0x09, 0x18, 0x79, 0x81, 0x19, 0x79, 0x1a, 0x9f, 0x4c, 0xd6, 0x55, 0xc2, 0x51, 0x41, 0xe1,
-	0x89, 0xb8, 0x85, 0x4f, 0x45, 0xad, 0xf1, 0xdb, 0x1a, 0x50, 0xfd, 0x24, 0x5c, 0x15, 0x45, 0x70,
-	0x92, 0xcd, 0x8b, 0x51, 0x04, 0x27, 0x0a, 0x3a, 0x4c, 0xc6, 0xde, 0xb4, 0xc2, 0xf6, 0x1b, 0xa5,
-	0x80, 0x25, 0x30, 0x4a, 0x1a, 0x8b, 0xda, 0x1f, 0xaa, 0x28, 0x34, 0x6a, 0x7f, 0xa8, 0xe4, 0xcc,
-	0x18, 0x67, 0x99, 0x0d, 0x63, 0xf8, 0xb8, 0xcc, 0x86, 0xc4, 0x33, 0x88, 0x7f, 0xac, 0xa1, 0xc3,
-	0x19, 0xac, 0x17, 0x45, 0x54, 0xa5, 0xe6, 0xdb, 0xe8, 0xd7, 0x3b, 0x17, 0x04, 0xe4, 0x57, 0x19,
-	0xf2, 0x49, 0x3c, 0x21, 0x43, 0x2e, 0x32, 0x47, 0x33, 0xf1, 0x77, 0xd6, 0x26, 0x5d, 0x0f, 0xf0,
-	0x97, 0x34, 0xf8, 0x93, 0xbc, 0x34, 0xef, 0x05, 0x4f, 0x2a, 0xaf, 0xaa, 0x84, 0x86, 0xa3, 0x5f,
-	0xec, 0x40, 0x02, 0x60, 0x1b, 0x0c, 0xf6, 0x30, 0xd6, 0x65, 0xb0, 0x81, 0x39, 0xf3, 0x75, 0x0d,
-	0x1d, 0x92, 0xb3, 0x54, 0x14, 0x85, 0x04, 0x25, 0x3b, 0x46, 0xbf, 0xd6, 0xb1, 0x5c, 0x8e, 0x94,
-	0x90, 0x7d, 0x35, 0x61, 0x29, 0xa1, 0x84, 0xb5, 0x82, 0xb3, 0x53, 0x29, 0x29, 0x3d, 0x46, 0x2f,
-	0xe4, 0x1e, 0xaf, 0xac, 0x73, 0xa6, 0x18, 0x32, 0xf8, 0x5b, 0x1a, 0xfc, 0xdd, 0x92, 0x8c, 0x16,
-	0x82, 0x95, 0x37, 0x3f, 0x8b, 0x8f, 0xa2, 0x5f, 0xe9, 0x50, 0x0a, 0x00, 0x9f, 0x66, 0x80, 0x8f,
-	0xe3, 0xd1, 0x54, 0xd5, 0x30, 0x4d, 0x47, 0xc1, 0x1f, 0xc4, 0x3c, 0x5c, 0x9a, 0xb1, 0xa1, 0xf6,
-	0x70, 0x19, 0xf4, 0x13, 0xfd, 0x72, 0x67, 0x42, 0x00, 0xf8, 0x14, 0x03, 0x7c, 0x0c, 0x8f, 0x64,
-	0x02, 0xe6, 0x78, 0x7f, 0xa1, 0xc9, 0xa9, 0x2a, 0x71, 0xfe, 0x04, 0xbe, 0x91, 0x17, 0x82, 0x84,
-	0x69, 0xa2, 0xdf, 0xec, 0x4e, 0x58, 0x99, 0xbd, 0x64, 0x33, 0x47, 0xf0, 0x4f, 0x35, 0x34, 0xac,
-	0xe2, 0x34, 0x28, 0x82, 0x82, 0x1c, 0xec, 0x10, 0x45, 0x50, 0x90, 0x87, 0x48, 0x91, 0xf1, 0x5e,
-	0xa6, 0x6b, 0x66, 0x05, 0x0f, 0xb0, 0x7e, 0x5f, 0x8b, 0xbe, 0xa9, 0xcb, 0x18, 0x0f, 0x0a, 0xef,
-	0xad, 0xe6, 0x5a, 0xe8, 0xd7, 0x3b, 0x17, 0x54, 0xd6, 0x27, 0xdf, 0x8a, 0x44, 0x22, 0x76, 0x3c,
-	0xfe, 0xa5, 0xf8, 0x5b, 0x8f, 0x6c, 0x82, 0x84, 0x22, 0x9b, 0x6c, 0x4b, 0xd0, 0xd0, 0x6f, 0x74,
-	0x25, 0x0b, 0x86, 0x5c, 0x62, 0x86, 0x5c, 0xc0, 0xe3, 0xc9, 0x7f, 0xef, 0x01, 0x82, 0x66, 0x9c,
-	0xad, 0x61, 0x7a, 0x15, 0xa8, 0x56, 0xff, 0x40, 0x10, 0xfd, 0xe4, 0x3c, 0x0a, 0x85, 0x97, 0x57,
-	0xb2, 0x3a, 0x14, 0x5e, 0x5e, 0x4d, 0xd8, 0xc8, 0x28, 0xb7, 0x67, 0x72, 0x38, 0xf0, 0xfb, 0xb1,
-	0x47, 0x34, 0xc1, 0x55, 0x50, 0x3f, 0xa2, 0x32, 0x6e, 0x84, 0xfa, 0x11, 0x95, 0x12, 0x21, 0x32,
-	0x92, 0xcf, 0x24, 0x37, 0x02, 0x7f, 0x23, 0xe6, 0x44, 0xd3, 0x94, 0x0a, 0xb5, 0x13, 0xcd, 0x60,
-	0x71, 0xa8, 0x9d, 0x68, 0x16, 0x6b, 0x23, 0xc3, 0xeb, 0x5b, 0x8e, 0x63, 0xa6, 0x00, 0x7f, 0xa1,
-	0x07, 0x5d, 0xea, 0x82, 0x41, 0x81, 0x5f, 0xee, 0x22, 0x08, 0x57, 0x30, 0x39, 0xf4, 0xa5, 0x67,
-	0xa6, 0x0f, 0x56, 0x60, 0x8e, 0xad, 0xc0, 0x6d, 0x7c, 0x2b, 0xf5, 0xdd, 0xb4, 0x33, 0xb2, 0x07,
-	0xfe, 0x73, 0x2c, 0x0b, 0x6f, 0xc3, 0xa3, 0x50, 0x67, 0xe1, 0xf9, 0x68, 0x1b, 0xea, 0x2c, 0x3c,
-	0x27, 0x91, 0x23, 0xa3, 0xcc, 0xc4, 0x15, 0x9b, 0x44, 0x88, 0x9b, 0xad, 0x64, 0x8f, 0x99, 0xd9,
-	0x0f, 0x3f, 0x19, 0xd1, 0x3e, 0xfa, 0x64, 0x44, 0xfb, 0xe3, 0x27, 0x23, 0xda, 0xff, 0x7e, 0x3a,
-	0xb2, 0xe5, 0xa3, 0x4f, 0x47, 0xb6, 0x7c, 0xfc, 0xe9, 0xc8, 0x96, 0xd7, 0xce, 0x72, 0xf1, 0x0b,
-	0x25, 0xcf, 0x27, 0x05, 0xf1, 0x73, 0xb8, 0x80, 0x85, 0xf5, 0x68, 0x16, 0xf6, 0xff, 0x8d, 0x56,
-	0xb6, 0xb3, 0x7f, 0x13, 0x74, 0xe9, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x30, 0x2f, 0xc5,
-	0xeb, 0x49, 0x00, 0x00,
+	// 4212 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0x5b, 0x6c, 0x1c, 0x59,
+	0x5a, 0x9e, 0x72, 0x1c, 0x27, 0xfe, 0x73, 0x99, 0xe4, 0xc4, 0x49, 0xec, 0x8a, 0x6f, 0x29, 0x5f,
+	0x72, 0x71, 0xd2, 0x1d, 0x3b, 0xf7, 0x99, 0x64, 0xb2, 0xb6, 0xe3, 0xd8, 0xd6, 0x26, 0xeb, 0x99,
+	0x8e, 0xb5, 0x19, 0x2d, 0x0c, 0x45, 0xb9, 0xfb, 0x74, 0x77, 0x4d, 0xba, 0xab, 0x7a, 0xaa, 0x4e,
+	0x7b, 0xec, 0x0d, 0x41, 0xec, 0xf2, 0xc0, 0x03, 0x2f, 0xac, 0x56, 0x42, 0x48, 0x80, 0x60, 0x85,
+	0x80, 0x07, 0xb4, 0x8b, 0x40, 0x80, 0xc4, 0x03, 0xf0, 0x00, 0x48, 0x2b, 0xc4, 0x65, 0x25, 0x10,
+	0x2c, 0x3c, 0x8c, 0xd0, 0xcc, 0x88, 0x07, 0xc4, 0x13, 0xaf, 0xbc, 0xa0, 0x3a, 0x97, 0xba, 0xf5,
+	0xa9, 0xd3, 0xd5, 0xed, 0x08, 0x89, 0x37, 0xf7, 0x39, 0xff, 0xff, 0x9f, 0xef, 0x3f, 0x97, 0xff,
+	0xfc, 0xe7, 0xaf, 0x2f, 0x81, 0xc9, 0x3a, 0x6e, 0xd8, 0xae, 0x5f, 0xac, 0xef, 0xb7, 0xb0, 0x67,
+	0xbb, 0x4e, 0x71, 0x77, 0xb1, 0xf8, 0x49, 0x1b, 0x7b, 0xfb, 0x85, 0x96, 0xe7, 0x12, 0x17, 0x21,
+	0xd6, 0x5f, 0x10, 0xfd, 0x85, 0xdd, 0x45, 0xfd, 0x6a, 0xd9, 0xf5, 0x9b, 0xae, 0x5f, 0xdc, 0xb1,
+	0x7c, 0xcc, 0x84, 0x8b, 0xbb, 0x8b, 0x3b, 0x98, 0x58, 0x8b, 0xc5, 0x96, 0x55, 0xb3, 0x1d, 0x8b,
+	0x04, 0x82, 0x54, 0x5f, 0x9f, 0x8c, 0xcb, 0x0a, 0xa9, 0xb2, 0x6b, 0x8b, 0xfe, 0x59, 0xc9, 0xf8,
+	0x16, 0x21, 0xd8, 0x27, 0x71, 0x2b, 0xd3, 0x12, 0xa9, 0x1a, 0x76, 0xb0, 0x6f, 0xfb, 0x5c, 0x62,
+	0x4a, 0x22, 0xd1, 0xb2, 0x3c, 0xab, 0x29, 0x04, 0x64, 0x8e, 0x92, 0xfd, 0x16, 0x16, 0xfd, 0x13,
+	0x92, 0xfe, 0xa6, 0x5f, 0x53, 0x75, 0xb7, 0x5c, 0xb7, 0xa1, 0xb0, 0xbe, 0x63, 0x91, 0x72, 0x9d,
+	0xf7, 0xcf, 0x4b, 0xfa, 0xf1, 0x1e, 0xc1, 0x9e, 0x63, 0x35, 0xcc, 0x8a, 0x45, 0x2c, 0x2e, 0x37,
+	0x5e, 0x73, 0xdd, 0x5a, 0x03, 0x17, 0xad, 0x96, 0x5d, 0xb4, 0x1c, 0xc7, 0x65, 0xb3, 0x20, 0x40,
+	0x8c, 0xd4, 0xdc, 0x9a, 0x4b, 0xff, 0x2c, 0x06, 0x7f, 0xb1, 0x56, 0x63, 0x04, 0xd0, 0x07, 0xc1,
+	0x22, 0xbc, 0x4f, 0xdd, 0x2d, 0xe1, 0x4f, 0xda, 0xd8, 0x27, 0xc6, 0x16, 0x9c, 0x49, 0xb4, 0xfa,
+	0x2d, 0xd7, 0xf1, 0x31, 0xba, 0x07, 0x43, 0x6c, 0x5a, 0x46, 0xb5, 0x69, 0xed, 0xf2, 0xb1, 0x25,
+	0xbd, 0xd0, 0xb9, 0xc0, 0x05, 0xa6, 0xb3, 0x32, 0xf8, 0xc3, 0xcf, 0xa6, 0xde, 0x2a, 0x71, 0x79,
+	0xc3, 0x85, 0xf3, 0xd4, 0xe0, 0x72, 0xb4, 0x3a, 0x7c, 0x2c, 0x34, 0x02, 0x87, 0x1d, 0xd7, 0x29,
+	0x63, 0x6a, 0x73, 0xb0, 0xc4, 0x7e, 0xa0, 0x09, 0x80, 0x72, 0xc3, 0xb2, 0x9b, 0x66, 0xdd, 0xf2,
+	0xeb, 0xa3, 0x03, 0xd3, 0xda, 0xe5, 0xe3, 0xa5, 0x61, 0xda, 0xb2, 0x61, 0xf9, 0x75, 0x34, 0x05,
+	0xc7, 0xc4, 0x98, 0xa6, 0x5d, 0x19, 0x3d, 0x44, 0x55, 0x41, 0x34, 0x6d, 0x56, 0x8c, 0x8f, 0x60,
+	0xb4, 0x73, 0x40, 0xee, 0xc6, 0x32, 0x1c, 0x8b, 0xed, 0x12, 0xee, 0xcb, 0x94, 0xcc, 0x97, 0xb8,
+	0x76, 0x5c, 0xc7, 0x78, 0x00, 0x63, 0xd4, 0xfc, 0x6a, 0xdb, 0xf3, 0xb0, 0x43, 0xbe, 0x6e, 0x35,
+	0x7c, 0x4c, 0x84, 0x47, 0x29, 0x70, 0x5a, 0x07, 0xb8, 0xf7, 0x41, 0x97, 0x69, 0x73, 0x78, 0x4b,
+	0x30, 0xb4, 0x4b, 0x5b, 0x54, 0xb3, 0xcc, 0x75, 0xb8, 0xa4, 0x51, 0xe2, 0x78, 0x12, 0x40, 0xf2,
+	0xe2, 0x89, 0x96, 0x60, 0x20, 0xb6, 0x04, 0x21, 0xca, 0x94, 0xcd, 0x03, 0xa0, 0x6c, 0x24, 0x50,
+	0xae, 0xba, 0x4e, 0xd5, 0xf6, 0x9a, 0x07, 0x43, 0x89, 0x46, 0xe1, 0x88, 0x55, 0xa9, 0x78, 0xd8,
+	0xf7, 0xe9, 0x2e, 0x18, 0x2e, 0x89, 0x9f, 0xc6, 0x4f, 0x82, 0x2e, 0x1b, 0x8d, 0xe3, 0x7f, 0x0f,
+	0x8e, 0x94, 0x59, 0x13, 0x77, 0x60, 0x56, 0xe6, 0xc0, 0x33, 0xbf, 0x96, 0x54, 0x17, 0x4a, 0xc6,
+	0x37, 0xe0, 0x62, 0xa7, 0x75, 0x7f, 0x65, 0xff, 0x6b, 0x01, 0xaa, 0x03, 0xce, 0x7c, 0x15, 0x0c,
+	0x95, 0x6d, 0xee, 0xc1, 0x57, 0xe0, 0x28, 0x07, 0x13, 0x9c, 0xc7, 0x43, 0xb9, 0x5d, 0x08, 0xb5,
+	0x8c, 0x65, 0x98, 0xa4, 0xe3, 0x3c, 0xb5, 0xfc, 0xe4, 0x16, 0xf6, 0x73, 0x6f, 0xe5, 0x17, 0x30,
+	0x95, 0x69, 0x82, 0xe3, 0xbc, 0x05, 0x47, 0xd8, 0xfa, 0x0b, 0x98, 0xaa, 0xad, 0x22, 0x44, 0x8d,
+	0x1a, 0x5c, 0x0d, 0x0d, 0xbf, 0x8f, 0x9d, 0x8a, 0xed, 0xd4, 0x12, 0xf6, 0x57, 0xf6, 0x97, 0x2b,
+	0x15, 0x2f, 0xf7, 0x44, 0xc7, 0xb6, 0xc9, 0x40, 0x72, 0x9b, 0x94, 0x61, 0x21, 0xd7, 0x40, 0x07,
+	0xf2, 0xe6, 0x2e, 0x8c, 0xd0, 0x41, 0x56, 0x82, 0xb0, 0xfe, 0x04, 0xe7, 0xde, 0x20, 0xc6, 0x36,
+	0x9c, 0x4d, 0x29, 0x72, 0x1c, 0xef, 0xc2, 0xf0, 0x0e, 0x6f, 0x13, 0x48, 0x26, 0x64, 0x48, 0x84,
+	0xa2, 0x5f, 0x8a, 0xe4, 0x8d, 0x7f, 0xd5, 0xf8, 0x0e, 0x13, 0xbd, 0x2f, 0x6c, 0x52, 0x7f, 0x66,
+	0x3b, 0x76, 0xb3, 0xdd, 0xec, 0x01, 0x1d, 0xfa, 0x2a, 0x9c, 0x6e, 0x32, 0x2d, 0x93, 0x1a, 0x37,
+	0xab, 0x98, 0x6d, 0xe5, 0x63, 0x4b, 0x63, 0x05, 0x76, 0x79, 0x17, 0x82, 0xcb, 0xbb, 0xc0, 0x2f,
+	0xef, 0xc2, 0xaa, 0x6b, 0x3b, 0xfc, 0x6a, 0x78, 0x9b, 0x6b, 0x0a, 0x08, 0x68, 0x0d, 0x4e, 0x0a,
+	0x63, 0x64, 0x8f, 0x5a, 0x3a, 0x94, 0xcf, 0xd2, 0x71, 0xae, 0xb6, 0xbd, 0xf7, 0x04, 0x63, 0xc3,
+	0x86, 0x19, 0xa5, 0x6b, 0x7c, 0xfe, 0x56, 0x3a, 0xe7, 0x6f, 0x56, 0x39, 0x7f, 0x81, 0x9d, 0xcd,
+	0x4a, 0x62, 0x1a, 0xab, 0x70, 0x25, 0xbd, 0x75, 0xa8, 0xf8, 0x9b, 0xde, 0xa2, 0x92, 0xb3, 0x20,
+	0x1b, 0x87, 0x7b, 0x76, 0x1f, 0x0e, 0x53, 0x88, 0x3c, 0xae, 0xcd, 0xc8, 0xbc, 0xda, 0x6a, 0x93,
+	0x9a, 0x6b, 0x3b, 0xb5, 0xed, 0x3d, 0x66, 0x88, 0x69, 0x64, 0x0e, 0xe4, 0xbf, 0x69, 0x8f, 0x3e,
+	0x86, 0x85, 0x5c, 0x03, 0x85, 0x9b, 0x7d, 0x88, 0x02, 0x14, 0x2b, 0x95, 0xcb, 0x27, 0xae, 0x62,
+	0x7c, 0x05, 0x26, 0xe8, 0x58, 0xa9, 0x7e, 0x9c, 0x3f, 0xc8, 0x99, 0x30, 0x99, 0x65, 0x81, 0x03,
+	0x7c, 0x08, 0x47, 0x76, 0x58, 0x53, 0x2f, 0x08, 0x85, 0x8e, 0xf1, 0xf3, 0x1a, 0x0f, 0xa3, 0xc9,
+	0x65, 0x7d, 0x03, 0x77, 0x09, 0xba, 0x02, 0xa7, 0xca, 0xae, 0x43, 0x3c, 0xab, 0x4c, 0xcc, 0xe4,
+	0x45, 0xf9, 0xb6, 0x68, 0x5f, 0xe6, 0x8b, 0xf2, 0x11, 0x4c, 0x67, 0x83, 0x38, 0xf8, 0xe6, 0x7a,
+	0xcd, 0x6f, 0x7f, 0xda, 0x28, 0x2e, 0xb5, 0xff, 0x4b, 0xef, 0x74, 0xd9, 0xf0, 0xdc, 0xaf, 0x47,
+	0x1d, 0x97, 0xe9, 0x4c, 0xc6, 0x65, 0xca, 0x55, 0x99, 0x6b, 0xd1, 0x5d, 0xfa, 0x21, 0x5c, 0x08,
+	0x77, 0xf4, 0xda, 0x2e, 0x76, 0xde, 0xdc, 0x59, 0x69, 0xc0, 0xb8, 0xdc, 0x32, 0x87, 0xfe, 0x14,
+	0x4e, 0x35, 0x2c, 0x9f, 0x98, 0x2c, 0x5f, 0xc6, 0x81, 0x04, 0x5f, 0x1d, 0x43, 0xe6, 0x42, 0x60,
+	0x66, 0x35, 0x10, 0xa5, 0xb6, 0x4a, 0x27, 0x1b, 0x89, 0xdf, 0x46, 0x8d, 0xef, 0xc4, 0x6d, 0xf7,
+	0x25, 0x76, 0xf8, 0xdc, 0x6d, 0xbb, 0x8f, 0xb1, 0xe3, 0xe6, 0xcf, 0xd4, 0x66, 0xe0, 0x04, 0x09,
+	0xd4, 0xcd, 0xa4, 0x47, 0xc7, 0x49, 0xcc, 0xa6, 0x81, 0x61, 0x3a, 0x7b, 0x20, 0xee, 0xda, 0x08,
+	0x1c, 0xae, 0x04, 0x0d, 0x74, 0x8c, 0xe1, 0x12, 0xfb, 0x81, 0x16, 0xe0, 0x34, 0xbb, 0x11, 0x4c,
+	0xd7, 0xb3, 0xe9, 0x93, 0x11, 0x57, 0xe8, 0x10, 0x47, 0x4b, 0xa7, 0x58, 0xc7, 0x56, 0xd8, 0x6e,
+	0x7c, 0xc8, 0xfd, 0xa1, 0x86, 0xb7, 0xdd, 0xf8, 0x68, 0xbd, 0xec, 0x3d, 0x06, 0x63, 0x20, 0x06,
+	0xc3, 0x20, 0x30, 0x9d, 0x6d, 0x99, 0x3b, 0xd0, 0x31, 0x13, 0x5a, 0xe7, 0x4c, 0xf4, 0xe6, 0x8f,
+	0xcf, 0xaf, 0xb7, 0xc7, 0xb8, 0x81, 0x6b, 0x16, 0xc1, 0x5f, 0xc5, 0xfb, 0xfe, 0x4a, 0x90, 0x29,
+	0xda, 0x15, 0x8b, 0xb8, 0x9e, 0xb0, 0xd9, 0xd5, 0xa7, 0x05, 0x38, 0xbd, 0x2b, 0x94, 0x52, 0xeb,
+	0x74, 0x6a, 0x37, 0x65, 0xcd, 0xf8, 0x96, 0x06, 0x0b, 0x39, 0x46, 0x0d, 0xdd, 0x9e, 0x82, 0x63,
+	0x98, 0xd4, 0x53, 0x4e, 0x03, 0x26, 0x75, 0x01, 0x6f, 0x11, 0x46, 0x5c, 0x2f, 0x08, 0x7d, 0xc4,
+	0x93, 0x00, 0x38, 0x13, 0xef, 0x13, 0x18, 0x2c, 0x98, 0x90, 0x40, 0x58, 0x23, 0xf5, 0xdc, 0x2e,
+	0xa7, 0x50, 0x0d, 0xa4, 0x51, 0x19, 0xbf, 0xa0, 0xc1, 0x9c, 0x72, 0x8c, 0xd0, 0x41, 0xe9, 0xec,
+	0x69, 0xf2, 0xd9, 0xeb, 0xc7, 0xd9, 0x9f, 0x81, 0x79, 0x09, 0x90, 0xad, 0x4e, 0xc9, 0xee, 0x5e,
+	0xf7, 0x31, 0xfa, 0xcf, 0x42, 0x21, 0xdf, 0xe8, 0xfd, 0xcd, 0x47, 0xd7, 0x75, 0xb0, 0x78, 0xb5,
+	0x80, 0x67, 0x06, 0xcf, 0xb1, 0x53, 0xd9, 0x76, 0x57, 0xeb, 0x96, 0xed, 0x74, 0x77, 0x77, 0x0e,
+	0x4e, 0xfa, 0xd8, 0xa9, 0xe0, 0xb4, 0xa3, 0x27, 0x58, 0xab, 0x18, 0xe2, 0x9f, 0xc4, 0x8d, 0xdb,
+	0x39, 0x46, 0xe8, 0xd4, 0x87, 0x30, 0x42, 0x3c, 0xcb, 0xf1, 0xab, 0xd8, 0xf3, 0x4d, 0xdb, 0x31,
+	0x93, 0x37, 0xfc, 0xbc, 0xf2, 0xea, 0xe3, 0x7a, 0xdb, 0x7b, 0x25, 0x14, 0xda, 0xd8, 0x74, 0x78,
+	0xda, 0x80, 0x5e, 0xc0, 0x99, 0xb6, 0xc3, 0xcc, 0x55, 0xcc, 0xb0, 0x7f, 0x74, 0xa0, 0x37, 0xc3,
+	0xa1, 0x09, 0xd1, 0xe8, 0x1b, 0x8f, 0xf9, 0xab, 0x74, 0xb9, 0xd1, 0x90, 0x39, 0x96, 0x33, 0xdf,
+	0xf9, 0x17, 0xf1, 0x3c, 0xc8, 0x30, 0xf3, 0xff, 0x77, 0x7e, 0xc6, 0xf8, 0xce, 0x7a, 0xe6, 0x56,
+	0xda, 0x0d, 0xfc, 0x9c, 0x58, 0x44, 0xe4, 0x57, 0x46, 0x09, 0x46, 0x3b, 0xbb, 0xb8, 0xa7, 0x77,
+	0xe0, 0xb0, 0x1f, 0x34, 0xf0, 0x7b, 0x75, 0x5a, 0x86, 0x60, 0x9d, 0x95, 0x14, 0x99, 0x22, 0x13,
+	0x0f, 0x9e, 0x7d, 0xcf, 0x6c, 0xdf, 0xb7, 0x9d, 0x1a, 0xcd, 0xa2, 0xf2, 0x67, 0x9c, 0x4f, 0xe0,
+	0x6c, 0x4a, 0x91, 0x23, 0xb9, 0x0e, 0xc8, 0x6d, 0xe1, 0xc4, 0x49, 0xe6, 0x33, 0x3e, 0x5c, 0x3a,
+	0x2d, 0x7a, 0x96, 0x45, 0x87, 0xb1, 0x0c, 0xb3, 0xd4, 0xa9, 0x75, 0x4c, 0x36, 0x42, 0xeb, 0x4f,
+	0x3c, 0xb7, 0x49, 0x57, 0x72, 0xb3, 0x22, 0x00, 0x8d, 0xc1, 0xd1, 0x72, 0xd0, 0x12, 0xa1, 0x39,
+	0x52, 0x66, 0x12, 0xc6, 0x06, 0xcc, 0x75, 0x31, 0x11, 0x05, 0x7d, 0xb5, 0x53, 0x1f, 0xf0, 0xb0,
+	0xb2, 0x8e, 0x49, 0x90, 0x84, 0x6c, 0xed, 0xf8, 0xd8, 0xdb, 0xc5, 0x95, 0x35, 0x52, 0xc7, 0x1e,
+	0x6e, 0x37, 0x57, 0x1a, 0x6e, 0xf9, 0xe5, 0x06, 0xb6, 0x6b, 0xf5, 0xfc, 0x95, 0xb4, 0x5f, 0xd1,
+	0xa0, 0x98, 0xdb, 0x26, 0xc7, 0x89, 0x61, 0x84, 0xe6, 0x4b, 0x2e, 0x97, 0x35, 0xeb, 0xb4, 0x9f,
+	0xaf, 0xed, 0xcd, 0xac, 0x9c, 0x49, 0x65, 0x1a, 0x35, 0x62, 0x02, 0xac, 0x2d, 0x3e, 0x6f, 0x09,
+	0xf5, 0x20, 0xcb, 0xea, 0x29, 0xb1, 0x37, 0xca, 0x30, 0xdf, 0xcd, 0x52, 0x98, 0x9d, 0x8f, 0x25,
+	0x5d, 0xa3, 0xd9, 0xa0, 0x19, 0xaf, 0xaf, 0x9e, 0x6b, 0x48, 0x4d, 0x18, 0xbf, 0xaf, 0xc1, 0x65,
+	0x31, 0x0a, 0x3d, 0x2f, 0x56, 0x99, 0x56, 0x8f, 0x57, 0xf6, 0xdf, 0xb7, 0x6a, 0x78, 0xd9, 0xa9,
+	0x3c, 0xb7, 0xbf, 0x19, 0x42, 0x8e, 0x25, 0xab, 0x5a, 0x22, 0x59, 0x45, 0x4f, 0x00, 0xa2, 0x32,
+	0x3e, 0x2f, 0x05, 0xcc, 0x27, 0x1e, 0xf0, 0xec, 0x03, 0x81, 0x78, 0xc6, 0x07, 0xc6, 0xb9, 0xd5,
+	0x52, 0x4c, 0x13, 0x5d, 0x84, 0xe3, 0x55, 0xd7, 0x6b, 0x5a, 0xc4, 0xc4, 0x5e, 0x79, 0xe9, 0x06,
+	0x4d, 0xea, 0x8f, 0x96, 0x8e, 0xb1, 0xb6, 0xb5, 0xa0, 0xc9, 0xd8, 0x81, 0x2b, 0x39, 0x00, 0xf3,
+	0x99, 0xb9, 0x0d, 0x87, 0xc8, 0x9e, 0x32, 0xb5, 0x67, 0xc9, 0x68, 0x14, 0x3e, 0x02, 0x79, 0xe3,
+	0x69, 0xb4, 0xbd, 0x56, 0xdd, 0xb6, 0x43, 0xb0, 0xd7, 0xb2, 0x3c, 0xb2, 0x4f, 0xb7, 0x3e, 0x2f,
+	0x74, 0xef, 0xe7, 0x3f, 0x4a, 0xbf, 0xaa, 0xc1, 0x8d, 0xfc, 0xe6, 0x38, 0xf2, 0x1a, 0x8c, 0x95,
+	0x63, 0xb2, 0x26, 0x33, 0x9e, 0xa8, 0xc3, 0x2f, 0xc8, 0xfc, 0xc9, 0x18, 0xa0, 0x74, 0xbe, 0x2c,
+	0xef, 0x30, 0x1e, 0xf0, 0x1b, 0x71, 0x1d, 0x93, 0x52, 0xab, 0xfc, 0xd4, 0x0e, 0x1e, 0x7f, 0xf9,
+	0x7d, 0xdb, 0x82, 0xe9, 0x6c, 0xed, 0x30, 0x4b, 0x18, 0xf4, 0x5a, 0x65, 0xb1, 0x0a, 0xe7, 0x65,
+	0xa8, 0x4b, 0xad, 0x72, 0x89, 0x0a, 0x19, 0xeb, 0x51, 0xe8, 0x4a, 0xe6, 0x21, 0x9d, 0xd9, 0xbb,
+	0x32, 0xd7, 0x34, 0x1e, 0xc3, 0x5c, 0x17, 0x43, 0x1c, 0xde, 0x05, 0x18, 0x16, 0xde, 0x31, 0x8c,
+	0x83, 0xa5, 0xa3, 0xdc, 0x3d, 0xdf, 0x78, 0xc2, 0xf3, 0x6e, 0x71, 0xd1, 0xac, 0xf1, 0x0f, 0x30,
+	0x8f, 0x2d, 0x62, 0x6d, 0xef, 0xe5, 0x8f, 0xec, 0x15, 0x98, 0x55, 0xdb, 0xe1, 0x60, 0x1e, 0xc4,
+	0x37, 0xec, 0x55, 0xd5, 0x95, 0x97, 0xb4, 0xc0, 0xf6, 0xad, 0x05, 0xe3, 0xe1, 0xd9, 0x08, 0x9e,
+	0x1a, 0xfe, 0x56, 0x35, 0x91, 0x02, 0x20, 0x18, 0x6c, 0x59, 0x35, 0x11, 0x13, 0xe8, 0xdf, 0x41,
+	0x9b, 0x6f, 0x7f, 0x53, 0x3c, 0xb0, 0xe9, 0xdf, 0x89, 0x05, 0x3f, 0x94, 0x5c, 0xf0, 0xdf, 0xd5,
+	0x60, 0x22, 0x63, 0x8c, 0xb0, 0x28, 0x32, 0x44, 0xdf, 0x39, 0xc2, 0x8b, 0x39, 0x99, 0x17, 0x4f,
+	0xda, 0x8d, 0xc6, 0x33, 0x4c, 0xac, 0xe0, 0x3b, 0x16, 0x35, 0x53, 0xe2, 0x4a, 0x68, 0x5d, 0x12,
+	0x4a, 0x2e, 0x75, 0x0d, 0x25, 0x6c, 0xec, 0x78, 0x2c, 0x31, 0xd6, 0x78, 0x36, 0xd3, 0x51, 0xbe,
+	0xa1, 0x47, 0x24, 0xf7, 0xca, 0x59, 0x30, 0xa3, 0x34, 0xc3, 0xbd, 0x1e, 0x83, 0xa3, 0x64, 0xcf,
+	0xa4, 0x87, 0x4c, 0x9c, 0x11, 0xb2, 0x47, 0x45, 0x82, 0x21, 0x58, 0x99, 0x94, 0xf5, 0xb2, 0x89,
+	0x86, 0x1d, 0x56, 0x90, 0x68, 0x3b, 0xc4, 0x78, 0xc8, 0x6b, 0x14, 0x1b, 0xb6, 0x4f, 0x5c, 0xcf,
+	0x2e, 0x5b, 0x0d, 0x5a, 0xb9, 0xcd, 0x8b, 0xf0, 0xbf, 0x35, 0xb8, 0x20, 0xd5, 0x0f, 0x6b, 0x9e,
+	0x6f, 0xd7, 0xc3, 0x9e, 0xa0, 0xc2, 0x2a, 0x56, 0x26, 0xbb, 0xc4, 0x5a, 0x3a, 0x59, 0x4f, 0xd8,
+	0x42, 0x0b, 0x70, 0xa8, 0xe1, 0x7e, 0xda, 0xb5, 0xc8, 0x5b, 0x0a, 0xa4, 0xd0, 0x75, 0x18, 0xac,
+	0xdb, 0xb5, 0x7a, 0xd7, 0x42, 0x6e, 0x89, 0x8a, 0xa1, 0x9b, 0x70, 0xc4, 0xda, 0xc5, 0x5e, 0xb0,
+	0x31, 0x07, 0xbb, 0x69, 0x08, 0x49, 0x63, 0x23, 0x0a, 0x3c, 0xf1, 0x17, 0x8a, 0x1f, 0x1c, 0x07,
+	0x31, 0x73, 0xb3, 0x70, 0x22, 0xfe, 0xce, 0x11, 0x09, 0x53, 0xb2, 0xd1, 0xd8, 0x83, 0x8b, 0x0a,
+	0x4b, 0x7c, 0x0e, 0x9f, 0x03, 0x4a, 0x68, 0xd1, 0x0f, 0xb0, 0xaa, 0x02, 0x72, 0xdc, 0x14, 0xb5,
+	0x74, 0xda, 0x4d, 0x1b, 0x37, 0xb6, 0xa3, 0xd0, 0xdb, 0x21, 0xce, 0x5d, 0xc8, 0x7a, 0xc6, 0x69,
+	0xd9, 0xcf, 0xb8, 0x36, 0x4c, 0x67, 0x5b, 0xe5, 0xee, 0x7c, 0x00, 0x09, 0x38, 0xc2, 0x1b, 0x2d,
+	0xb7, 0x37, 0xa7, 0xdc, 0x54, 0x8b, 0xf1, 0x1a, 0x2e, 0xc9, 0x86, 0x15, 0xc9, 0xe3, 0xc1, 0x9c,
+	0x4a, 0x1f, 0x82, 0x81, 0x8e, 0x43, 0xf0, 0xcb, 0xb1, 0x44, 0x26, 0x7b, 0x7c, 0xee, 0xfe, 0xc7,
+	0xa0, 0x27, 0x00, 0x84, 0xa6, 0x63, 0xf3, 0x70, 0xad, 0xdb, 0x3c, 0x24, 0x2c, 0x8f, 0xba, 0x19,
+	0x3d, 0xc6, 0x77, 0x06, 0xe0, 0xb2, 0x3c, 0x80, 0x04, 0x9f, 0x16, 0xb6, 0x5a, 0x34, 0x7b, 0xc9,
+	0x5d, 0x93, 0x0a, 0x63, 0x49, 0xbc, 0x2a, 0xca, 0x62, 0x09, 0x4d, 0xe8, 0x82, 0x87, 0x30, 0x2b,
+	0x3d, 0x89, 0x42, 0x28, 0x2f, 0x8c, 0xb2, 0x82, 0xd4, 0x2a, 0x6f, 0x0c, 0x2a, 0x54, 0xcc, 0x0e,
+	0xb1, 0x9b, 0xd8, 0x6d, 0x13, 0x7a, 0xf2, 0x06, 0x4b, 0xc7, 0x69, 0xe3, 0x36, 0x6b, 0x8b, 0xe7,
+	0x7b, 0x87, 0x93, 0xf9, 0xde, 0x43, 0x18, 0x2f, 0xd7, 0x71, 0xf9, 0xa5, 0x69, 0x57, 0x4d, 0xdb,
+	0xac, 0x5b, 0xbb, 0xd8, 0xf4, 0xed, 0x9a, 0x83, 0x2b, 0xec, 0x35, 0x38, 0x3a, 0x44, 0xf3, 0xb6,
+	0xf3, 0x54, 0x66, 0xb3, 0xba, 0xb9, 0x61, 0xed, 0xe2, 0xe7, 0xb4, 0x9f, 0xba, 0x6e, 0x7c, 0xcc,
+	0x73, 0x38, 0xf5, 0x94, 0xbc, 0x99, 0x22, 0xbb, 0xb8, 0xc1, 0xd7, 0x31, 0x79, 0x51, 0xb7, 0x09,
+	0x6e, 0xd8, 0x3e, 0xc1, 0x95, 0xf0, 0xad, 0x94, 0x3b, 0xca, 0x3e, 0x86, 0x59, 0xb5, 0x1d, 0x0e,
+	0x77, 0x1c, 0x86, 0xd3, 0x2f, 0xb4, 0xa8, 0xc1, 0xd8, 0xe4, 0x49, 0xfd, 0x9a, 0x4f, 0xec, 0xa6,
+	0x45, 0xf0, 0x53, 0x8b, 0x60, 0x9f, 0xd0, 0x47, 0x45, 0xea, 0xae, 0xee, 0x0a, 0xe8, 0x29, 0x5c,
+	0xea, 0x6a, 0x8a, 0x63, 0xba, 0x08, 0xc7, 0x1b, 0xb4, 0xd7, 0xdc, 0x09, 0xba, 0xb9, 0xb1, 0x63,
+	0x8d, 0x48, 0xc3, 0xf8, 0x09, 0x7e, 0x5b, 0x6e, 0xfa, 0x74, 0x1f, 0x2d, 0x37, 0x3c, 0x6c, 0x55,
+	0xf6, 0xc5, 0x8b, 0xe1, 0x80, 0x5f, 0xb6, 0x7f, 0x1a, 0x66, 0x94, 0xc6, 0xa3, 0x77, 0x8c, 0xed,
+	0xb3, 0x9d, 0x6d, 0x5a, 0x4c, 0x26, 0x7c, 0xd3, 0xd0, 0xb1, 0x8e, 0x96, 0xce, 0xd9, 0x52, 0x13,
+	0xc6, 0xa3, 0x28, 0xf3, 0x79, 0xfe, 0xd2, 0x6e, 0xb5, 0x70, 0xa5, 0xc7, 0xa7, 0x77, 0x0b, 0x26,
+	0x32, 0x0c, 0x70, 0x70, 0x5b, 0x70, 0xd2, 0x67, 0x1d, 0x0c, 0xa1, 0xd8, 0x8d, 0x97, 0x65, 0xbb,
+	0x31, 0x6e, 0x22, 0x48, 0x75, 0x36, 0x9d, 0xaa, 0x5b, 0x3a, 0xe1, 0xc7, 0x0d, 0x1b, 0x2b, 0x51,
+	0xf4, 0x5f, 0x6e, 0x34, 0xfa, 0x43, 0xfd, 0x2d, 0x0d, 0xa6, 0xb3, 0x8d, 0x70, 0xe4, 0x1f, 0x65,
+	0x20, 0xbf, 0x93, 0x17, 0x79, 0x70, 0x2a, 0xa3, 0x22, 0x40, 0xda, 0x8f, 0x07, 0xf0, 0x4e, 0x97,
+	0xd7, 0x4d, 0xa0, 0xbf, 0xea, 0x36, 0x5b, 0x0d, 0xdc, 0xc4, 0xc4, 0xf2, 0xf6, 0xe9, 0x6c, 0xf0,
+	0xfa, 0xcb, 0x7f, 0x68, 0xf0, 0x6e, 0x5f, 0xea, 0xdc, 0xb9, 0xdf, 0xd1, 0xe0, 0x46, 0xe6, 0x43,
+	0xc9, 0xfc, 0xd4, 0x26, 0x41, 0xda, 0x15, 0xe9, 0x9a, 0xb6, 0x53, 0x75, 0xb9, 0xff, 0x8f, 0x7a,
+	0x78, 0x3f, 0x49, 0x31, 0x2c, 0x94, 0xf3, 0x0b, 0x1b, 0x37, 0xa2, 0x32, 0xc8, 0x06, 0x1d, 0x76,
+	0xad, 0x5a, 0xc5, 0x65, 0x62, 0xef, 0xe2, 0x65, 0x96, 0xd2, 0xd0, 0x73, 0x18, 0x84, 0x5e, 0x31,
+	0x35, 0x26, 0x14, 0x73, 0x6b, 0xf0, 0xd9, 0xb8, 0x06, 0x88, 0x27, 0x48, 0xec, 0xa4, 0xd3, 0xf0,
+	0xce, 0xf7, 0xcd, 0x29, 0x2b, 0xa5, 0x65, 0xfc, 0x54, 0x74, 0x68, 0xb6, 0xda, 0x64, 0xc7, 0x6d,
+	0x3b, 0x95, 0x0f, 0xda, 0x6e, 0x74, 0x4f, 0x67, 0xbf, 0xfb, 0xf2, 0x7d, 0xeb, 0xf9, 0xf6, 0x00,
+	0x4c, 0x64, 0x0c, 0xc0, 0xf1, 0x8e, 0xc2, 0x91, 0x86, 0xdd, 0xb4, 0x49, 0x78, 0xbe, 0xc5, 0x4f,
+	0xf4, 0x02, 0xc0, 0xb3, 0x08, 0x36, 0xe9, 0x6f, 0x9e, 0x77, 0xce, 0x65, 0x04, 0x7e, 0x6a, 0xb8,
+	0x14, 0x84, 0xc0, 0x40, 0x78, 0xe5, 0x74, 0x40, 0x0f, 0xf8, 0xcf, 0xcf, 0xa6, 0x86, 0x3d, 0xd1,
+	0x54, 0x8a, 0xfe, 0x44, 0x8b, 0x30, 0xd8, 0xf6, 0x31, 0x7b, 0xd7, 0x0c, 0xaf, 0x4c, 0x04, 0xb2,
+	0xff, 0xf6, 0xd9, 0xd4, 0x59, 0x96, 0x71, 0xfa, 0x95, 0x97, 0x05, 0xdb, 0x2d, 0x36, 0x2d, 0x52,
+	0x2f, 0x6c, 0x3a, 0xa4, 0x44, 0x45, 0x03, 0xd2, 0x85, 0x87, 0x9b, 0x96, 0xed, 0xd8, 0x4e, 0x6d,
+	0x74, 0x30, 0x8f, 0x5e, 0x24, 0x6f, 0x7c, 0x3d, 0x4a, 0x2f, 0xe3, 0x7b, 0x6b, 0x03, 0x5b, 0x15,
+	0xec, 0xe5, 0x98, 0xe9, 0x73, 0x30, 0xc4, 0x2b, 0x55, 0x2c, 0xa4, 0xf2, 0x5f, 0x46, 0x05, 0x0c,
+	0x95, 0xdd, 0x90, 0xef, 0x34, 0x54, 0xa7, 0x2d, 0x3c, 0xab, 0x99, 0xef, 0xb6, 0xe7, 0xb9, 0x3e,
+	0xd7, 0x5a, 0xfa, 0xc1, 0x13, 0x38, 0x4c, 0x87, 0x41, 0xbb, 0x30, 0xc4, 0x76, 0x37, 0x9a, 0xcf,
+	0xac, 0xa3, 0x24, 0xe8, 0x84, 0xfa, 0xa5, 0xae, 0x72, 0x0c, 0xa4, 0x71, 0xe1, 0xdb, 0xff, 0xf8,
+	0xe5, 0x77, 0x07, 0xce, 0xa2, 0x33, 0x12, 0x2a, 0x26, 0xfa, 0x0d, 0x0d, 0x8e, 0xc5, 0x08, 0x79,
+	0x68, 0x21, 0xd3, 0x6a, 0x27, 0xcb, 0x50, 0xbf, 0x96, 0x4f, 0x98, 0xe3, 0xb8, 0x4d, 0x71, 0x14,
+	0xd1, 0xf5, 0x2c, 0x6a, 0x69, 0xf1, 0x15, 0x0d, 0xa0, 0xaf, 0x8b, 0xaf, 0x22, 0x96, 0xe2, 0x6b,
+	0xf4, 0x5d, 0x0d, 0x4e, 0x24, 0x38, 0x7d, 0xe8, 0x7a, 0xe6, 0xb0, 0x32, 0xe6, 0xa0, 0x5e, 0xc8,
+	0x2b, 0xce, 0x71, 0xce, 0x50, 0x9c, 0x13, 0xe8, 0x42, 0x02, 0x27, 0x23, 0x1d, 0x15, 0xcb, 0x4c,
+	0x05, 0xfd, 0xa2, 0x06, 0x27, 0x12, 0xc3, 0x28, 0x50, 0xc9, 0xf8, 0x83, 0x7a, 0x21, 0xaf, 0xb8,
+	0x72, 0x15, 0x19, 0x2a, 0x3a, 0x47, 0x09, 0x3e, 0x5a, 0x57, 0x34, 0x49, 0x9e, 0xa0, 0x5e, 0xc8,
+	0x2b, 0x9e, 0x6b, 0x8e, 0x38, 0x86, 0xef, 0x6b, 0x70, 0x56, 0xca, 0xb6, 0x43, 0xb7, 0xf3, 0x0d,
+	0x97, 0x62, 0xfe, 0xe9, 0x77, 0x7a, 0x55, 0xe3, 0x68, 0xe7, 0x28, 0xda, 0x29, 0x34, 0x91, 0x40,
+	0xcb, 0x61, 0xfa, 0x62, 0xdb, 0xa1, 0xdf, 0xd2, 0x00, 0x75, 0x52, 0xee, 0xd0, 0x52, 0xe6, 0xa8,
+	0x99, 0x14, 0x3f, 0xfd, 0x66, 0x4f, 0x3a, 0x1c, 0xe6, 0x2c, 0x85, 0x39, 0x89, 0xc6, 0x65, 0x93,
+	0xea, 0x09, 0x38, 0x7f, 0xa9, 0xc1, 0xa4, 0x9a, 0x56, 0x87, 0xde, 0x53, 0x8e, 0xde, 0x95, 0xf8,
+	0xa7, 0x3f, 0xea, 0x5b, 0x9f, 0x7b, 0x32, 0x4d, 0x3d, 0xd1, 0xd1, 0xa8, 0xcc, 0x93, 0xa0, 0x56,
+	0x8e, 0xbe, 0xa7, 0xc1, 0xdb, 0x29, 0xee, 0x05, 0x2a, 0x2a, 0x87, 0xed, 0xe4, 0x7f, 0xe8, 0x37,
+	0xf2, 0x2b, 0x70, 0x60, 0x0b, 0x14, 0xd8, 0x1c, 0x9a, 0x49, 0x00, 0x73, 0x3d, 0xab, 0xdc, 0xc0,
+	0x45, 0x5a, 0xd7, 0x2f, 0xbe, 0xe2, 0x77, 0xee, 0x6b, 0xf4, 0xdb, 0x1a, 0x9c, 0x5d, 0xc7, 0x44,
+	0xf2, 0xc1, 0x34, 0x3b, 0x4a, 0x76, 0x0a, 0xeb, 0x37, 0x7b, 0x10, 0x0e, 0x81, 0x5e, 0xa5, 0x40,
+	0x67, 0x91, 0x91, 0x0c, 0xda, 0x4c, 0xc1, 0xf4, 0xb1, 0x53, 0x31, 0x89, 0xcb, 0xb2, 0x31, 0xf4,
+	0xa7, 0x1a, 0x8c, 0xb2, 0x0c, 0x55, 0x02, 0x35, 0xfb, 0xa8, 0xa9, 0x3e, 0x67, 0xea, 0x77, 0x7a,
+	0x55, 0xe3, 0xb8, 0x0b, 0x14, 0xf7, 0x65, 0x34, 0x9f, 0x0c, 0xf2, 0x8d, 0x86, 0x29, 0xc7, 0xfe,
+	0x73, 0x1a, 0x0c, 0x87, 0x6c, 0x40, 0x74, 0x39, 0x73, 0xd4, 0x14, 0xc7, 0x53, 0xbf, 0x92, 0x43,
+	0x92, 0x43, 0x9a, 0xa4, 0x90, 0x46, 0xd1, 0xb9, 0xce, 0x7f, 0x0b, 0x50, 0x0d, 0x06, 0xfd, 0x13,
+	0x0d, 0xce, 0x27, 0x08, 0x89, 0x11, 0xb1, 0x11, 0xdd, 0xe9, 0x3a, 0x8c, 0x94, 0xe4, 0xa9, 0xdf,
+	0xed, 0x59, 0x4f, 0xb9, 0xee, 0x21, 0xd8, 0xa2, 0x20, 0x73, 0x56, 0x31, 0x0e, 0xce, 0xd0, 0xe9,
+	0x8e, 0x37, 0x3e, 0x5a, 0xcc, 0x1c, 0x3a, 0x8b, 0xab, 0xa7, 0x2f, 0xf5, 0xa2, 0xa2, 0x8c, 0xa9,
+	0x14, 0x68, 0xd1, 0xe5, 0x5a, 0x64, 0x0f, 0xfd, 0x85, 0x06, 0x13, 0x4a, 0x86, 0x25, 0x7a, 0x98,
+	0x27, 0xd8, 0x64, 0x32, 0x40, 0xf5, 0xf7, 0xfa, 0x55, 0xe7, 0x7e, 0x4c, 0x51, 0x3f, 0xc6, 0xd0,
+	0x79, 0x89, 0x1f, 0x34, 0x52, 0xa5, 0xe2, 0xad, 0x84, 0x51, 0x89, 0xf2, 0x63, 0xf0, 0xfb, 0x8f,
+	0xb7, 0x0a, 0x2a, 0x67, 0x46, 0xbc, 0x8d, 0x9c, 0xf0, 0xd1, 0xaf, 0x69, 0x70, 0x46, 0x42, 0x41,
+	0x44, 0x37, 0xd5, 0x1b, 0x55, 0xca, 0x9a, 0xd4, 0x6f, 0xf5, 0xa6, 0xc4, 0x41, 0xea, 0x14, 0xe4,
+	0x08, 0x42, 0x9d, 0x20, 0x69, 0x02, 0x93, 0xe0, 0x10, 0x2a, 0x12, 0x18, 0x19, 0xd5, 0x51, 0x2f,
+	0xe4, 0x15, 0x57, 0x26, 0x30, 0x6c, 0xc6, 0x44, 0x62, 0x80, 0xfe, 0x48, 0x83, 0x33, 0x12, 0x26,
+	0x9d, 0x62, 0xd2, 0xb2, 0x09, 0x7e, 0xfa, 0xad, 0xde, 0x94, 0x38, 0xce, 0x25, 0x8a, 0xf3, 0x1a,
+	0xba, 0x9a, 0xc0, 0xc9, 0x3e, 0xe6, 0x14, 0x13, 0x6f, 0xc4, 0x20, 0xa6, 0x32, 0x2a, 0x5f, 0x00,
+	0x5b, 0xc2, 0x9f, 0x53, 0xc0, 0xce, 0xe6, 0xf1, 0xe9, 0xb7, 0x7a, 0x53, 0xca, 0x03, 0x9b, 0xa2,
+	0x0c, 0xe0, 0x26, 0xf0, 0xa3, 0xbf, 0xd5, 0x60, 0x2c, 0xf9, 0x39, 0x31, 0x46, 0x86, 0x43, 0x77,
+	0x15, 0x38, 0x54, 0xf4, 0x39, 0xfd, 0x51, 0x9f, 0x8a, 0x5d, 0xde, 0x2d, 0xf4, 0x33, 0x99, 0x59,
+	0xe1, 0x26, 0xcc, 0x97, 0x78, 0xdf, 0x37, 0x77, 0xf6, 0xcd, 0x90, 0x97, 0x45, 0x57, 0x21, 0xed,
+	0xce, 0x1a, 0xa9, 0x2b, 0xe2, 0x73, 0x16, 0x41, 0x4e, 0xbf, 0xdf, 0xb3, 0x4a, 0x08, 0xbe, 0x48,
+	0xc1, 0x5f, 0x41, 0x97, 0xf2, 0x80, 0xc7, 0xa4, 0x8e, 0x7e, 0xac, 0xc1, 0x78, 0x1a, 0x76, 0xbc,
+	0x2e, 0x8f, 0xde, 0xc9, 0x09, 0x46, 0xc2, 0x6c, 0xd3, 0x57, 0xfa, 0xd7, 0x0d, 0x3d, 0xba, 0x47,
+	0x3d, 0x5a, 0x42, 0x37, 0xf2, 0x78, 0x14, 0xff, 0x6e, 0x10, 0x04, 0x99, 0x33, 0xa2, 0xd0, 0x16,
+	0x23, 0x24, 0x29, 0xb2, 0xb9, 0x4e, 0x46, 0x93, 0x7e, 0x2d, 0x9f, 0x30, 0x07, 0x7b, 0x91, 0x82,
+	0xbd, 0x80, 0xc6, 0x92, 0xff, 0x8a, 0x91, 0x4a, 0x9a, 0x94, 0xce, 0x84, 0xbe, 0xa3, 0x85, 0xb4,
+	0x24, 0x01, 0x8e, 0x95, 0xfe, 0xe4, 0xd9, 0x90, 0x8c, 0xfa, 0xa4, 0x5f, 0xc9, 0x21, 0xa9, 0x0c,
+	0x7c, 0x4d, 0x26, 0xcb, 0x2b, 0x98, 0xe8, 0xaf, 0x59, 0x46, 0x29, 0xa5, 0x26, 0xa1, 0x7b, 0x99,
+	0x33, 0xd0, 0x85, 0x10, 0xa5, 0xdf, 0xef, 0x43, 0x93, 0xc3, 0xbe, 0x45, 0x61, 0x17, 0xd0, 0x35,
+	0xc9, 0xaa, 0xc7, 0x8a, 0xb8, 0x66, 0xd5, 0x73, 0x9b, 0xa6, 0x28, 0xf6, 0xa0, 0xff, 0xd1, 0xa2,
+	0xcf, 0x66, 0x5d, 0xe8, 0x46, 0x68, 0x45, 0x05, 0x2e, 0x1f, 0xb5, 0x4a, 0x5f, 0x3d, 0x90, 0x0d,
+	0xee, 0xea, 0x3a, 0x75, 0x75, 0x19, 0x3d, 0x52, 0xb9, 0x9a, 0x62, 0x24, 0x71, 0x73, 0xbc, 0x2c,
+	0xc9, 0x6a, 0x58, 0xe8, 0x9f, 0x35, 0x98, 0x94, 0x0e, 0x1e, 0x12, 0x94, 0xd0, 0xfd, 0xdc, 0x80,
+	0xd3, 0x0c, 0x2b, 0xfd, 0x9d, 0x7e, 0x54, 0xb9, 0x8b, 0x0f, 0xa9, 0x8b, 0x77, 0xd1, 0xed, 0x1e,
+	0x5c, 0x8c, 0x48, 0x57, 0xe8, 0xef, 0xb4, 0xa8, 0xea, 0x97, 0xc9, 0x52, 0x42, 0x0f, 0x54, 0x00,
+	0xbb, 0xb1, 0xb1, 0xf4, 0x87, 0x7d, 0x6a, 0x2b, 0x1f, 0x9a, 0x24, 0xa6, 0x17, 0x7b, 0x68, 0x7e,
+	0x19, 0xfb, 0xba, 0xda, 0x8d, 0xc2, 0x84, 0x94, 0x7b, 0x2c, 0x27, 0x9f, 0x4a, 0x7f, 0x7c, 0x30,
+	0x23, 0xdc, 0xc9, 0xeb, 0xd4, 0xc9, 0x4b, 0x68, 0x2e, 0x59, 0x57, 0x09, 0xa4, 0xfc, 0xe2, 0x2b,
+	0x71, 0x0a, 0x5f, 0x8b, 0x5a, 0xe3, 0x1f, 0x6a, 0x30, 0x9a, 0x45, 0x67, 0x52, 0x24, 0x27, 0xd9,
+	0xd4, 0x29, 0xfd, 0x56, 0x6f, 0x4a, 0xca, 0xb5, 0xe9, 0x84, 0xed, 0xb5, 0xca, 0x3e, 0x7d, 0xc0,
+	0x28, 0x99, 0x4e, 0xea, 0x78, 0xa8, 0x62, 0x59, 0xe9, 0xf7, 0xfb, 0xd0, 0xe4, 0x3e, 0x5c, 0xa1,
+	0x3e, 0xcc, 0xa0, 0x8b, 0x32, 0x1f, 0x12, 0xd7, 0x20, 0xfa, 0x2b, 0x0d, 0xce, 0x67, 0x10, 0xa3,
+	0x14, 0x59, 0x95, 0x9a, 0x92, 0xa5, 0xdf, 0xeb, 0x5d, 0x91, 0x23, 0xbf, 0x43, 0x91, 0xdf, 0x40,
+	0x05, 0x19, 0x72, 0xf1, 0x72, 0x34, 0x13, 0xff, 0x14, 0xdf, 0x24, 0x7b, 0x3e, 0xfa, 0x4d, 0x0d,
+	0xce, 0x86, 0xe7, 0x2f, 0x4e, 0x8d, 0x42, 0x37, 0x94, 0x47, 0x55, 0xc2, 0xd4, 0xd2, 0x17, 0x7b,
+	0xd0, 0xe0, 0xb0, 0x0d, 0x0a, 0x7b, 0x1c, 0xe9, 0x32, 0xd8, 0x9c, 0x5c, 0xf5, 0x7b, 0x1a, 0x9c,
+	0x93, 0x13, 0x99, 0x14, 0x85, 0x04, 0x25, 0x81, 0x4a, 0xbf, 0xdb, 0xb3, 0x5e, 0x8e, 0x27, 0x21,
+	0xfd, 0xb0, 0x46, 0x9f, 0x84, 0x12, 0x62, 0x13, 0xca, 0x7e, 0x4a, 0x49, 0x19, 0x54, 0x7a, 0x31,
+	0xb7, 0xbc, 0xb2, 0xce, 0x99, 0x22, 0x51, 0xa1, 0x3f, 0xd0, 0x60, 0x2c, 0xfc, 0xbc, 0x95, 0x26,
+	0xf7, 0x20, 0xe5, 0xc9, 0xcf, 0xa2, 0x2c, 0xe9, 0xb7, 0x7b, 0xd4, 0xe2, 0x80, 0x2f, 0x51, 0xc0,
+	0x17, 0xd1, 0x54, 0xaa, 0x6a, 0x98, 0x66, 0x2c, 0xa1, 0xef, 0xc7, 0x22, 0x5c, 0x9a, 0xd4, 0xa3,
+	0x8e, 0x70, 0x19, 0x0c, 0x25, 0xfd, 0x56, 0x6f, 0x4a, 0x1c, 0xf0, 0x3c, 0x05, 0x3c, 0x8d, 0x26,
+	0x33, 0x01, 0x33, 0xbc, 0x7f, 0xaf, 0xc9, 0xd9, 0x4c, 0x71, 0x8a, 0x0d, 0x7a, 0x37, 0x2f, 0x04,
+	0x09, 0x19, 0x49, 0x7f, 0xd0, 0x9f, 0xb2, 0xf2, 0xf5, 0x92, 0x4d, 0x2e, 0x42, 0x7f, 0xa3, 0xc1,
+	0xb8, 0x8a, 0xf6, 0xa2, 0x48, 0x0a, 0x72, 0x10, 0x88, 0xf4, 0x87, 0x7d, 0x6a, 0x2b, 0xef, 0xcb,
+	0x74, 0xcd, 0xac, 0xe8, 0x72, 0xac, 0x7f, 0xa6, 0xc1, 0xb8, 0x8a, 0x14, 0xa3, 0x88, 0xde, 0x6a,
+	0x3a, 0x8e, 0x7e, 0xaf, 0x77, 0x45, 0x65, 0x7d, 0xf2, 0xd3, 0x48, 0x25, 0xfa, 0x07, 0x14, 0xe8,
+	0x1f, 0xc4, 0x3f, 0x07, 0xca, 0xe6, 0xd0, 0x28, 0x5e, 0x93, 0x5d, 0x39, 0x3c, 0xfa, 0xbb, 0x7d,
+	0xe9, 0x72, 0x47, 0x6e, 0x52, 0x47, 0xae, 0xa3, 0x85, 0x84, 0x23, 0x98, 0x2b, 0x9a, 0x71, 0x42,
+	0x8f, 0xe9, 0x56, 0x79, 0xb5, 0xfa, 0xcf, 0x05, 0x17, 0x54, 0x4e, 0xb5, 0x51, 0x44, 0x79, 0x25,
+	0xf1, 0x47, 0xbf, 0xdb, 0xb3, 0x9e, 0xb2, 0xdc, 0x9e, 0x49, 0xf3, 0x41, 0xdf, 0x8b, 0x5d, 0xa2,
+	0x09, 0x3a, 0x8b, 0xfa, 0x12, 0x95, 0xd1, 0x67, 0xf4, 0xc5, 0x1e, 0x34, 0x94, 0x8f, 0xcf, 0x24,
+	0x7d, 0x06, 0xfd, 0x20, 0x16, 0x44, 0xd3, 0xac, 0x1b, 0x75, 0x10, 0xcd, 0x20, 0xfa, 0xe8, 0xb7,
+	0x7a, 0x53, 0x52, 0x46, 0xfd, 0xe0, 0x53, 0x46, 0x0a, 0xf0, 0xaf, 0x0f, 0xc0, 0xcd, 0x3e, 0x48,
+	0x36, 0xe8, 0x6b, 0x7d, 0x24, 0xe1, 0x0a, 0xb2, 0x8f, 0xbe, 0xf5, 0xc6, 0xec, 0xf1, 0x19, 0x58,
+	0xa3, 0x33, 0xf0, 0x08, 0x3d, 0x4c, 0x7d, 0x37, 0xed, 0x8d, 0x0f, 0x84, 0xfe, 0x2b, 0xf6, 0x0a,
+	0xef, 0x42, 0xb5, 0x51, 0xbf, 0xc2, 0xf3, 0x31, 0x7b, 0xf4, 0xd5, 0x03, 0xd9, 0x50, 0x96, 0x99,
+	0x98, 0x61, 0x13, 0x0b, 0x75, 0xb3, 0x93, 0x0f, 0x94, 0x38, 0x63, 0x09, 0x5e, 0x8e, 0xfa, 0x8c,
+	0xc9, 0x38, 0x42, 0xfa, 0x62, 0x0f, 0x1a, 0xca, 0x33, 0xe6, 0x72, 0x59, 0xf3, 0x13, 0x8a, 0xe4,
+	0x8f, 0x35, 0xd0, 0x65, 0x3b, 0x83, 0xf1, 0x53, 0xd0, 0xed, 0xbc, 0x3b, 0x29, 0xc1, 0xb3, 0xd1,
+	0xef, 0xf4, 0xaa, 0xc6, 0x21, 0x5f, 0xa6, 0x90, 0x0d, 0x34, 0x9d, 0xbd, 0xcf, 0x18, 0x61, 0x66,
+	0x65, 0xf5, 0x87, 0x9f, 0x4f, 0x6a, 0x3f, 0xfa, 0x7c, 0x52, 0xfb, 0xf7, 0xcf, 0x27, 0xb5, 0x5f,
+	0xfa, 0x62, 0xf2, 0xad, 0x1f, 0x7d, 0x31, 0xf9, 0xd6, 0x8f, 0xbf, 0x98, 0x7c, 0xeb, 0x1b, 0x57,
+	0xd8, 0xd0, 0xd7, 0xcb, 0xae, 0x87, 0x8b, 0xe2, 0xef, 0x60, 0x73, 0x16, 0xf7, 0x22, 0xab, 0xf4,
+	0xbf, 0x17, 0xdb, 0x19, 0xa2, 0xff, 0x4b, 0xd7, 0xcd, 0xff, 0x1d, 0x00, 0x5d, 0xc9, 0x76, 0x9d,
+	0x6a, 0x4d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5007,6 +5228,8 @@ type QueryClient interface {
 	QueryGetAllSkippedNonces(ctx context.Context, in *QueryGetAllSkippedNoncesRequest, opts ...grpc.CallOption) (*QueryGetAllSkippedNoncesResponse, error)
 	QueryGetCounterpartyChainParamsWithComplemetaryInfo(ctx context.Context, in *QueryGetCounterpartyChainParamsWithComplemetaryInfoRequest, opts ...grpc.CallOption) (*QueryGetCounterpartyChainParamsWithComplemetaryInfoResponse, error)
 	QueryGetHeliosEffectiveAverageBlockTime(ctx context.Context, in *QueryGetHeliosEffectiveAverageBlockTimeRequest, opts ...grpc.CallOption) (*QueryGetHeliosEffectiveAverageBlockTimeResponse, error)
+	QueryGetOutboundQuota(ctx context.Context, in *QueryGetOutboundQuotaRequest, opts ...grpc.CallOption) (*QueryGetOutboundQuotaResponse, error)
+	QueryGetCounterpartyHeader(ctx context.Context, in *QueryGetCounterpartyHeaderRequest, opts ...grpc.CallOption) (*QueryGetCounterpartyHeaderResponse, error)
 }
 
 type queryClient struct {
@@ -5440,6 +5663,24 @@ func (c *queryClient) QueryGetHeliosEffectiveAverageBlockTime(ctx context.Contex
 	return out, nil
 }
 
+func (c *queryClient) QueryGetOutboundQuota(ctx context.Context, in *QueryGetOutboundQuotaRequest, opts ...grpc.CallOption) (*QueryGetOutboundQuotaResponse, error) {
+	out := new(QueryGetOutboundQuotaResponse)
+	err := c.cc.Invoke(ctx, "/helios.hyperion.v1.Query/QueryGetOutboundQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryGetCounterpartyHeader(ctx context.Context, in *QueryGetCounterpartyHeaderRequest, opts ...grpc.CallOption) (*QueryGetCounterpartyHeaderResponse, error) {
+	out := new(QueryGetCounterpartyHeaderResponse)
+	err := c.cc.Invoke(ctx, "/helios.hyperion.v1.Query/QueryGetCounterpartyHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Deployments queries deployments
@@ -5495,6 +5736,8 @@ type QueryServer interface {
 	QueryGetAllSkippedNonces(context.Context, *QueryGetAllSkippedNoncesRequest) (*QueryGetAllSkippedNoncesResponse, error)
 	QueryGetCounterpartyChainParamsWithComplemetaryInfo(context.Context, *QueryGetCounterpartyChainParamsWithComplemetaryInfoRequest) (*QueryGetCounterpartyChainParamsWithComplemetaryInfoResponse, error)
 	QueryGetHeliosEffectiveAverageBlockTime(context.Context, *QueryGetHeliosEffectiveAverageBlockTimeRequest) (*QueryGetHeliosEffectiveAverageBlockTimeResponse, error)
+	QueryGetOutboundQuota(context.Context, *QueryGetOutboundQuotaRequest) (*QueryGetOutboundQuotaResponse, error)
+	QueryGetCounterpartyHeader(context.Context, *QueryGetCounterpartyHeaderRequest) (*QueryGetCounterpartyHeaderResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -5642,6 +5885,12 @@ func (*UnimplementedQueryServer) QueryGetCounterpartyChainParamsWithComplemetary
 func (*UnimplementedQueryServer) QueryGetHeliosEffectiveAverageBlockTime(ctx context.Context, req *QueryGetHeliosEffectiveAverageBlockTimeRequest) (*QueryGetHeliosEffectiveAverageBlockTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryGetHeliosEffectiveAverageBlockTime not implemented")
 }
+func (*UnimplementedQueryServer) QueryGetOutboundQuota(ctx context.Context, req *QueryGetOutboundQuotaRequest) (*QueryGetOutboundQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGetOutboundQuota not implemented")
+}
+func (*UnimplementedQueryServer) QueryGetCounterpartyHeader(ctx context.Context, req *QueryGetCounterpartyHeaderRequest) (*QueryGetCounterpartyHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGetCounterpartyHeader not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -6493,6 +6742,43 @@ func _Query_QueryGetHeliosEffectiveAverageBlockTime_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryGetOutboundQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOutboundQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGetOutboundQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.hyperion.v1.Query/QueryGetOutboundQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGetOutboundQuota(ctx, req.(*QueryGetOutboundQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryGetCounterpartyHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCounterpartyHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGetCounterpartyHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.hyperion.v1.Query/QueryGetCounterpartyHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGetCounterpartyHeader(ctx, req.(*QueryGetCounterpartyHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helios.hyperion.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -6685,6 +6971,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryGetHeliosEffectiveAverageBlockTime",
 			Handler:    _Query_QueryGetHeliosEffectiveAverageBlockTime_Handler,
 		},
+		{
+			MethodName: "QueryGetOutboundQuota",
+			Handler:    _Query_QueryGetOutboundQuota_Handler,
+		},
+		{
+			MethodName: "QueryGetCounterpartyHeader",
+			Handler:    _Query_QueryGetCounterpartyHeader_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "helios/hyperion/v1/query.proto",
@@ -9965,82 +10259,248 @@ func (m *QueryGetHeliosEffectiveAverageBlockTimeResponse) MarshalToSizedBuffer(d
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetOutboundQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetOutboundQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAttestationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetOutboundQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	l = len(m.ClaimHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.HyperionId != 0 {
-		n += 1 + sovQuery(uint64(m.HyperionId))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAttestationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		l = m.Attestation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetOutboundQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentValsetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HyperionId != 0 {
-		n += 1 + sovQuery(uint64(m.HyperionId))
-	}
-	return n
+func (m *QueryGetOutboundQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentValsetResponse) Size() (n int) {
+func (m *QueryGetOutboundQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Limited {
+		i--
+		if m.Limited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCounterpartyHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCounterpartyHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCounterpartyHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCounterpartyHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCounterpartyHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCounterpartyHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HyperionId != 0 {
+		n += 1 + sovQuery(uint64(m.HyperionId))
+	}
+	return n
+}
+
+func (m *QueryAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentValsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HyperionId != 0 {
+		n += 1 + sovQuery(uint64(m.HyperionId))
+	}
+	return n
+}
+
+func (m *QueryCurrentValsetResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11348,6 +11808,68 @@ func (m *QueryGetHeliosEffectiveAverageBlockTimeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetOutboundQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOutboundQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limited {
+		n += 2
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Used.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetCounterpartyHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryGetCounterpartyHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -19747,6 +20269,452 @@ func (m *QueryGetHeliosEffectiveAverageBlockTimeResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
+func (m *QueryGetOutboundQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutboundQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutboundQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutboundQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutboundQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutboundQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Limited = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCounterpartyHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCounterpartyHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCounterpartyHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCounterpartyHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCounterpartyHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCounterpartyHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &CounterpartyHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
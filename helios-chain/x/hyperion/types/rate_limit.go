@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// OutboundRateLimitBuckets is the number of time buckets a rate limit window is split into,
+// the window slides by steps of window_seconds / OutboundRateLimitBuckets.
+const OutboundRateLimitBuckets = 24
+
+// IsDefault returns true if the rate limit applies to every token without a dedicated limit
+func (r OutboundRateLimit) IsDefault() bool {
+	return r.TokenAddress == ""
+}
+
+// Validate checks the rate limit values
+func (r OutboundRateLimit) Validate() error {
+	if !r.IsDefault() {
+		if err := ValidateEthAddress(r.TokenAddress); err != nil {
+			return errors.Wrap(err, "token address")
+		}
+	}
+	if r.WindowSeconds == 0 {
+		return fmt.Errorf("window seconds cannot be 0")
+	}
+	if r.MaxAmount.IsNil() || r.MaxAmount.IsNegative() {
+		return fmt.Errorf("invalid max amount: %s", r.MaxAmount)
+	}
+	if r.MaxPercentage.IsNil() || r.MaxPercentage.IsNegative() || r.MaxPercentage.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("invalid max percentage, must be between 0 and 1: %s", r.MaxPercentage)
+	}
+	if r.MaxAmount.IsZero() && r.MaxPercentage.IsZero() {
+		return fmt.Errorf("at least one of max amount or max percentage must be set")
+	}
+	return nil
+}
+
+// BucketSeconds returns the duration of one time bucket of the window
+func (r OutboundRateLimit) BucketSeconds() uint64 {
+	return max(r.WindowSeconds/OutboundRateLimitBuckets, 1)
+}
+
+// Quota returns the maximum amount which can be sent over the window given the token
+// balance locked in the hyperion contract. The percentage cap is ignored while this
+// balance is unknown (zero).
+func (r OutboundRateLimit) Quota(contractBalance math.Int) math.Int {
+	quota := r.MaxAmount
+	if r.MaxPercentage.IsPositive() && contractBalance.IsPositive() {
+		percentageQuota := r.MaxPercentage.MulInt(contractBalance).TruncateInt()
+		if quota.IsZero() || percentageQuota.LT(quota) {
+			quota = percentageQuota
+		}
+	}
+	return quota
+}
+
+// GetOutboundRateLimit returns the rate limit applying to the token, its dedicated one
+// if any or else the default one of the chain.
+func (p CounterpartyChainParams) GetOutboundRateLimit(tokenContract common.Address) (*OutboundRateLimit, bool) {
+	var defaultRateLimit *OutboundRateLimit
+	for _, rateLimit := range p.OutboundRateLimits {
+		if rateLimit.IsDefault() {
+			defaultRateLimit = rateLimit
+			continue
+		}
+		if common.HexToAddress(rateLimit.TokenAddress) == tokenContract {
+			return rateLimit, true
+		}
+	}
+	return defaultRateLimit, defaultRateLimit != nil
+}
+
+func validateOutboundRateLimits(i interface{}) error {
+	v, ok := i.([]*OutboundRateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, rateLimit := range v {
+		if rateLimit == nil {
+			return fmt.Errorf("empty rate limit")
+		}
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		token := rateLimit.TokenAddress
+		if !rateLimit.IsDefault() {
+			token = common.HexToAddress(token).Hex()
+		}
+		if seen[token] {
+			return fmt.Errorf("duplicate rate limit for token %q", token)
+		}
+		seen[token] = true
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	hyperiontypes "helios-core/helios-chain/x/hyperion/types"
+)
+
+func TestOutboundRateLimitValidate(t *testing.T) {
+	token := common.HexToAddress("0x1000").Hex()
+
+	testCases := []struct {
+		name      string
+		rateLimit hyperiontypes.OutboundRateLimit
+		expPass   bool
+	}{
+		{"amount cap", hyperiontypes.OutboundRateLimit{TokenAddress: token, WindowSeconds: 3600, MaxAmount: math.NewInt(100), MaxPercentage: math.LegacyZeroDec()}, true},
+		{"default percentage cap", hyperiontypes.OutboundRateLimit{WindowSeconds: 3600, MaxAmount: math.ZeroInt(), MaxPercentage: math.LegacyNewDecWithPrec(1, 1)}, true},
+		{"invalid token", hyperiontypes.OutboundRateLimit{TokenAddress: "token", WindowSeconds: 3600, MaxAmount: math.NewInt(100), MaxPercentage: math.LegacyZeroDec()}, false},
+		{"no window", hyperiontypes.OutboundRateLimit{TokenAddress: token, MaxAmount: math.NewInt(100), MaxPercentage: math.LegacyZeroDec()}, false},
+		{"no cap", hyperiontypes.OutboundRateLimit{TokenAddress: token, WindowSeconds: 3600, MaxAmount: math.ZeroInt(), MaxPercentage: math.LegacyZeroDec()}, false},
+		{"percentage above one", hyperiontypes.OutboundRateLimit{TokenAddress: token, WindowSeconds: 3600, MaxAmount: math.ZeroInt(), MaxPercentage: math.LegacyNewDec(2)}, false},
+		{"unset fields", hyperiontypes.OutboundRateLimit{TokenAddress: token, WindowSeconds: 3600}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.rateLimit.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestOutboundRateLimitQuota(t *testing.T) {
+	rateLimit := hyperiontypes.OutboundRateLimit{
+		WindowSeconds: 3600,
+		MaxAmount:     math.NewInt(1_000),
+		MaxPercentage: math.LegacyNewDecWithPrec(1, 1), // 10%
+	}
+
+	// the lowest cap applies, the percentage one only once the contract balance is known
+	require.Equal(t, math.NewInt(1_000), rateLimit.Quota(math.ZeroInt()))
+	require.Equal(t, math.NewInt(500), rateLimit.Quota(math.NewInt(5_000)))
+	require.Equal(t, math.NewInt(1_000), rateLimit.Quota(math.NewInt(50_000)))
+
+	rateLimit.MaxAmount = math.ZeroInt()
+	require.Equal(t, math.NewInt(5_000), rateLimit.Quota(math.NewInt(50_000)))
+	require.Equal(t, uint64(150), rateLimit.BucketSeconds())
+}
+
+func TestCounterpartyChainParamsGetOutboundRateLimit(t *testing.T) {
+	token := common.HexToAddress("0x1000")
+	dedicated := &hyperiontypes.OutboundRateLimit{TokenAddress: token.Hex(), WindowSeconds: 60, MaxAmount: math.NewInt(1), MaxPercentage: math.LegacyZeroDec()}
+	fallback := &hyperiontypes.OutboundRateLimit{WindowSeconds: 60, MaxAmount: math.NewInt(2), MaxPercentage: math.LegacyZeroDec()}
+
+	params := hyperiontypes.CounterpartyChainParams{}
+	_, found := params.GetOutboundRateLimit(token)
+	require.False(t, found)
+
+	params.OutboundRateLimits = []*hyperiontypes.OutboundRateLimit{fallback, dedicated}
+	rateLimit, found := params.GetOutboundRateLimit(token)
+	require.True(t, found)
+	require.Equal(t, dedicated, rateLimit)
+
+	rateLimit, found = params.GetOutboundRateLimit(common.HexToAddress("0x2000"))
+	require.True(t, found)
+	require.Equal(t, fallback, rateLimit)
+}
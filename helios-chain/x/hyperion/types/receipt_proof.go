@@ -0,0 +1,198 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+const (
+	// ClaimVerificationModeAttestation only counts the votes of the orchestrators
+	ClaimVerificationModeAttestation = "attestation"
+	// ClaimVerificationModeReceiptProof also requires every claim to prove its event log
+	// against a counterparty header of the header store before its vote is counted
+	ClaimVerificationModeReceiptProof = "receipt_proof"
+)
+
+var hyperionEventsABI = mustParseABI(HyperionEventsABIJSON)
+
+func mustParseABI(abiJSON string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic("Bad ABI constant!")
+	}
+	return parsed
+}
+
+// ReceiptProvenClaim is a claim which can be proven by an event log of the hyperion contract
+type ReceiptProvenClaim interface {
+	EthereumClaim
+	GetReceiptProof() *ReceiptProof
+	// VerifyLog returns an error if the log isn't the event claimed
+	VerifyLog(log *ethtypes.Log, bridgeContract common.Address) error
+}
+
+var (
+	_ ReceiptProvenClaim = &MsgDepositClaim{}
+	_ ReceiptProvenClaim = &MsgWithdrawClaim{}
+)
+
+// RequiresReceiptProof returns true if the claims of the chain must carry a receipt proof
+func (p CounterpartyChainParams) RequiresReceiptProof() bool {
+	return p.ClaimVerificationMode == ClaimVerificationModeReceiptProof
+}
+
+func validateClaimVerificationMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch v {
+	case "", ClaimVerificationModeAttestation, ClaimVerificationModeReceiptProof:
+		return nil
+	default:
+		return fmt.Errorf("unknown claim verification mode %q, must be %s or %s", v, ClaimVerificationModeAttestation, ClaimVerificationModeReceiptProof)
+	}
+}
+
+// DecodeCounterpartyHeader decodes an RLP encoded block header of the counterparty chain
+func DecodeCounterpartyHeader(bz []byte) (*ethtypes.Header, error) {
+	if len(bz) == 0 {
+		return nil, errors.Wrap(ErrEmpty, "header")
+	}
+	var header ethtypes.Header
+	if err := rlp.DecodeBytes(bz, &header); err != nil {
+		return nil, errors.Wrap(ErrInvalid, fmt.Sprintf("header decoding: %s", err))
+	}
+	if header.Number == nil || !header.Number.IsUint64() {
+		return nil, errors.Wrap(ErrInvalid, "header number")
+	}
+	return &header, nil
+}
+
+// NewCounterpartyHeader returns the part of the header kept in the header store
+func NewCounterpartyHeader(header *ethtypes.Header) CounterpartyHeader {
+	return CounterpartyHeader{
+		Height:       header.Number.Uint64(),
+		Hash:         header.Hash().Hex(),
+		ParentHash:   header.ParentHash.Hex(),
+		ReceiptsRoot: header.ReceiptHash.Hex(),
+		Time:         header.Time,
+	}
+}
+
+// VerifyLog verifies the proof against the receipts root of a block and returns the proven log
+func (p ReceiptProof) VerifyLog(receiptsRoot common.Hash) (*ethtypes.Log, error) {
+	proofDB := memorydb.New()
+	for _, node := range p.Proof {
+		if err := proofDB.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := rlp.EncodeToBytes(p.TxIndex)
+	if err != nil {
+		return nil, err
+	}
+	value, err := trie.VerifyProof(receiptsRoot, key, proofDB)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidReceiptProof, err.Error())
+	}
+	if value == nil {
+		return nil, errors.Wrapf(ErrInvalidReceiptProof, "no receipt for transaction %d", p.TxIndex)
+	}
+
+	var receipt ethtypes.Receipt
+	if err := receipt.UnmarshalBinary(value); err != nil {
+		return nil, errors.Wrap(ErrInvalidReceiptProof, fmt.Sprintf("receipt decoding: %s", err))
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil, errors.Wrapf(ErrInvalidReceiptProof, "transaction %d failed", p.TxIndex)
+	}
+	if p.LogIndex >= uint64(len(receipt.Logs)) {
+		return nil, errors.Wrapf(ErrInvalidReceiptProof, "no log %d in the receipt of transaction %d", p.LogIndex, p.TxIndex)
+	}
+	return receipt.Logs[p.LogIndex], nil
+}
+
+// unpackHyperionEvent checks the log is the event of the hyperion contract and returns its non indexed values
+func unpackHyperionEvent(log *ethtypes.Log, bridgeContract common.Address, eventName string) ([]interface{}, error) {
+	event := hyperionEventsABI.Events[eventName]
+	if log.Address != bridgeContract {
+		return nil, errors.Wrapf(ErrInvalidReceiptProof, "log emitted by %s and not by the hyperion contract %s", log.Address.Hex(), bridgeContract.Hex())
+	}
+
+	indexed := 0
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed++
+		}
+	}
+	if len(log.Topics) != indexed+1 || log.Topics[0] != event.ID {
+		return nil, errors.Wrapf(ErrInvalidReceiptProof, "log is not a %s", eventName)
+	}
+
+	values, err := event.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidReceiptProof, fmt.Sprintf("%s decoding: %s", eventName, err))
+	}
+	return values, nil
+}
+
+// VerifyLog checks the log is the SendToHeliosEvent of the deposit
+func (msg *MsgDepositClaim) VerifyLog(log *ethtypes.Log, bridgeContract common.Address) error {
+	values, err := unpackHyperionEvent(log, bridgeContract, "SendToHeliosEvent")
+	if err != nil {
+		return err
+	}
+	receiver, err := sdk.AccAddressFromBech32(msg.CosmosReceiver)
+	if err != nil {
+		return errors.Wrap(ErrInvalidReceiptProof, "cosmos receiver")
+	}
+
+	amount, eventNonce, data := values[0].(*big.Int), values[1].(*big.Int), values[2].(string)
+	switch {
+	case log.Topics[1] != common.BytesToHash(common.HexToAddress(msg.TokenContract).Bytes()):
+		return errors.Wrap(ErrInvalidReceiptProof, "token contract not matching")
+	case log.Topics[2] != common.BytesToHash(common.HexToAddress(msg.EthereumSender).Bytes()):
+		return errors.Wrap(ErrInvalidReceiptProof, "sender not matching")
+	case log.Topics[3] != common.BytesToHash(receiver.Bytes()):
+		return errors.Wrap(ErrInvalidReceiptProof, "receiver not matching")
+	case msg.Amount.IsNil() || amount.Cmp(msg.Amount.BigInt()) != 0:
+		return errors.Wrap(ErrInvalidReceiptProof, "amount not matching")
+	case !eventNonce.IsUint64() || eventNonce.Uint64() != msg.EventNonce:
+		return errors.Wrap(ErrInvalidReceiptProof, "event nonce not matching")
+	case data != msg.Data:
+		return errors.Wrap(ErrInvalidReceiptProof, "data not matching")
+	}
+	return nil
+}
+
+// VerifyLog checks the log is the TransactionBatchExecutedEvent of the withdrawal
+func (msg *MsgWithdrawClaim) VerifyLog(log *ethtypes.Log, bridgeContract common.Address) error {
+	values, err := unpackHyperionEvent(log, bridgeContract, "TransactionBatchExecutedEvent")
+	if err != nil {
+		return err
+	}
+
+	eventNonce := values[0].(*big.Int)
+	switch {
+	case log.Topics[1] != common.BigToHash(new(big.Int).SetUint64(msg.BatchNonce)):
+		return errors.Wrap(ErrInvalidReceiptProof, "batch nonce not matching")
+	case log.Topics[2] != common.BytesToHash(common.HexToAddress(msg.TokenContract).Bytes()):
+		return errors.Wrap(ErrInvalidReceiptProof, "token contract not matching")
+	case !eventNonce.IsUint64() || eventNonce.Uint64() != msg.EventNonce:
+		return errors.Wrap(ErrInvalidReceiptProof, "event nonce not matching")
+	}
+	return nil
+}
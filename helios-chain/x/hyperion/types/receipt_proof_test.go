@@ -0,0 +1,213 @@
+package types_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	hyperiontypes "helios-core/helios-chain/x/hyperion/types"
+)
+
+var (
+	testBridgeContract = common.HexToAddress("0xb000")
+	testTokenContract  = common.HexToAddress("0x3000")
+	testSender         = common.HexToAddress("0x1000")
+	testReceiver       = sdk.AccAddress(common.HexToAddress("0x2000").Bytes())
+)
+
+func sendToHeliosLog(t *testing.T, amount int64, eventNonce uint64, data string) *ethtypes.Log {
+	t.Helper()
+	stringTy, _ := abi.NewType("string", "", nil)
+	uintTy, _ := abi.NewType("uint256", "", nil)
+	bz, err := abi.Arguments{{Type: uintTy}, {Type: uintTy}, {Type: stringTy}}.Pack(big.NewInt(amount), new(big.Int).SetUint64(eventNonce), data)
+	require.NoError(t, err)
+
+	return &ethtypes.Log{
+		Address: testBridgeContract,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("SendToHeliosEvent(address,address,bytes32,uint256,uint256,string)")),
+			common.BytesToHash(testTokenContract.Bytes()),
+			common.BytesToHash(testSender.Bytes()),
+			common.BytesToHash(testReceiver.Bytes()),
+		},
+		Data: bz,
+	}
+}
+
+// receiptsTrie builds the receipts trie of a block, as the counterparty chain does, and
+// returns its root with the proof of the receipt of transaction index
+func receiptsTrie(t *testing.T, receipts ethtypes.Receipts, index uint64) (common.Hash, [][]byte) {
+	t.Helper()
+	tr := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+	for i := range receipts {
+		key, err := rlp.EncodeToBytes(uint64(i))
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		receipts.EncodeIndex(i, &buf)
+		tr.Update(key, buf.Bytes())
+	}
+	require.Equal(t, ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)), tr.Hash())
+
+	key, err := rlp.EncodeToBytes(index)
+	require.NoError(t, err)
+	proofDB := memorydb.New()
+	require.NoError(t, tr.Prove(key, 0, proofDB))
+
+	proof := make([][]byte, 0)
+	it := proofDB.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		proof = append(proof, common.CopyBytes(it.Value()))
+	}
+	return tr.Hash(), proof
+}
+
+func TestReceiptProofVerifyLog(t *testing.T) {
+	depositLog := sendToHeliosLog(t, 100, 5, "")
+	receipts := ethtypes.Receipts{
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000, Logs: []*ethtypes.Log{}},
+		{Type: ethtypes.DynamicFeeTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 80000, Logs: []*ethtypes.Log{{Address: testTokenContract}, depositLog}},
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusFailed, CumulativeGasUsed: 90000, Logs: []*ethtypes.Log{}},
+	}
+	for _, receipt := range receipts {
+		receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+	}
+
+	root, proof := receiptsTrie(t, receipts, 1)
+	receiptProof := hyperiontypes.ReceiptProof{TxIndex: 1, LogIndex: 1, Proof: proof}
+
+	log, err := receiptProof.VerifyLog(root)
+	require.NoError(t, err)
+	require.Equal(t, depositLog.Topics, log.Topics)
+	require.Equal(t, depositLog.Data, log.Data)
+
+	// proof of another block
+	_, err = receiptProof.VerifyLog(common.HexToHash("0x01"))
+	require.ErrorIs(t, err, hyperiontypes.ErrInvalidReceiptProof)
+
+	// proof of another transaction
+	receiptProof.TxIndex = 0
+	_, err = receiptProof.VerifyLog(root)
+	require.Error(t, err)
+
+	// no such log
+	receiptProof.TxIndex, receiptProof.LogIndex = 1, 2
+	_, err = receiptProof.VerifyLog(root)
+	require.ErrorIs(t, err, hyperiontypes.ErrInvalidReceiptProof)
+
+	// failed transaction
+	root, proof = receiptsTrie(t, receipts, 2)
+	_, err = hyperiontypes.ReceiptProof{TxIndex: 2, Proof: proof}.VerifyLog(root)
+	require.ErrorIs(t, err, hyperiontypes.ErrInvalidReceiptProof)
+}
+
+func TestMsgDepositClaimVerifyLog(t *testing.T) {
+	claim := func() *hyperiontypes.MsgDepositClaim {
+		return &hyperiontypes.MsgDepositClaim{
+			EventNonce:     5,
+			TokenContract:  testTokenContract.Hex(),
+			Amount:         math.NewInt(100),
+			EthereumSender: testSender.Hex(),
+			CosmosReceiver: testReceiver.String(),
+		}
+	}
+	log := sendToHeliosLog(t, 100, 5, "")
+
+	require.NoError(t, claim().VerifyLog(log, testBridgeContract))
+	require.Error(t, claim().VerifyLog(log, common.HexToAddress("0xb001")))
+
+	fabricated := claim()
+	fabricated.Amount = math.NewInt(1_000_000)
+	require.ErrorIs(t, fabricated.VerifyLog(log, testBridgeContract), hyperiontypes.ErrInvalidReceiptProof)
+
+	fabricated = claim()
+	fabricated.EventNonce = 6
+	require.Error(t, fabricated.VerifyLog(log, testBridgeContract))
+
+	fabricated = claim()
+	fabricated.CosmosReceiver = sdk.AccAddress(common.HexToAddress("0x2001").Bytes()).String()
+	require.Error(t, fabricated.VerifyLog(log, testBridgeContract))
+
+	fabricated = claim()
+	fabricated.Data = "{}"
+	require.Error(t, fabricated.VerifyLog(log, testBridgeContract))
+
+	// a withdrawal log can't prove a deposit
+	withdraw := &hyperiontypes.MsgWithdrawClaim{EventNonce: 5, BatchNonce: 2, TokenContract: testTokenContract.Hex()}
+	require.Error(t, withdraw.VerifyLog(log, testBridgeContract))
+}
+
+func TestMsgWithdrawClaimVerifyLog(t *testing.T) {
+	uintTy, _ := abi.NewType("uint256", "", nil)
+	data, err := abi.Arguments{{Type: uintTy}}.Pack(big.NewInt(5))
+	require.NoError(t, err)
+	log := &ethtypes.Log{
+		Address: testBridgeContract,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("TransactionBatchExecutedEvent(uint256,address,uint256)")),
+			common.BigToHash(big.NewInt(2)),
+			common.BytesToHash(testTokenContract.Bytes()),
+		},
+		Data: data,
+	}
+
+	claim := &hyperiontypes.MsgWithdrawClaim{EventNonce: 5, BatchNonce: 2, TokenContract: testTokenContract.Hex()}
+	require.NoError(t, claim.VerifyLog(log, testBridgeContract))
+
+	claim.BatchNonce = 3
+	require.ErrorIs(t, claim.VerifyLog(log, testBridgeContract), hyperiontypes.ErrInvalidReceiptProof)
+}
+
+func TestDecodeCounterpartyHeader(t *testing.T) {
+	header := &ethtypes.Header{
+		ParentHash:  common.HexToHash("0x01"),
+		Number:      big.NewInt(1234),
+		ReceiptHash: common.HexToHash("0x02"),
+		Time:        1700000000,
+		Difficulty:  big.NewInt(0),
+	}
+	bz, err := rlp.EncodeToBytes(header)
+	require.NoError(t, err)
+
+	decoded, err := hyperiontypes.DecodeCounterpartyHeader(bz)
+	require.NoError(t, err)
+	require.Equal(t, header.Hash(), decoded.Hash())
+
+	counterpartyHeader := hyperiontypes.NewCounterpartyHeader(decoded)
+	require.Equal(t, uint64(1234), counterpartyHeader.Height)
+	require.Equal(t, header.Hash().Hex(), counterpartyHeader.Hash)
+	require.Equal(t, header.ReceiptHash.Hex(), counterpartyHeader.ReceiptsRoot)
+
+	_, err = hyperiontypes.DecodeCounterpartyHeader(nil)
+	require.Error(t, err)
+	_, err = hyperiontypes.DecodeCounterpartyHeader([]byte{0x01, 0x02})
+	require.Error(t, err)
+}
+
+func TestCounterpartyChainParamsClaimVerificationMode(t *testing.T) {
+	params := *hyperiontypes.DefaultPolygonAmoyTestnet21ChainParams()
+	require.False(t, params.RequiresReceiptProof())
+
+	params.ClaimVerificationMode = hyperiontypes.ClaimVerificationModeReceiptProof
+	require.NoError(t, params.ValidateBasic())
+	require.True(t, params.RequiresReceiptProof())
+
+	params.BridgeChainType = hyperiontypes.ChainTypeCosmos
+	require.Error(t, params.ValidateBasic())
+
+	params.BridgeChainType = hyperiontypes.ChainTypeEVM
+	params.ClaimVerificationMode = "zk"
+	require.Error(t, params.ValidateBasic())
+}
@@ -640,6 +640,150 @@ func (m *Proof) GetHashs() string {
 	return ""
 }
 
+// CounterpartyHeader is a block header of the counterparty chain accepted by the
+// orchestrators, claims are proven against its receipts root
+type CounterpartyHeader struct {
+	Height       uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash         string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash   string `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parentHash"`
+	ReceiptsRoot string `protobuf:"bytes,4,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receiptsRoot"`
+	Time         uint64 `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *CounterpartyHeader) Reset()         { *m = CounterpartyHeader{} }
+func (m *CounterpartyHeader) String() string { return proto.CompactTextString(m) }
+func (*CounterpartyHeader) ProtoMessage()    {}
+func (*CounterpartyHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a15a3d117055a1c, []int{10}
+}
+func (m *CounterpartyHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CounterpartyHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CounterpartyHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CounterpartyHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CounterpartyHeader.Merge(m, src)
+}
+func (m *CounterpartyHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *CounterpartyHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_CounterpartyHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CounterpartyHeader proto.InternalMessageInfo
+
+func (m *CounterpartyHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CounterpartyHeader) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *CounterpartyHeader) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *CounterpartyHeader) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return ""
+}
+
+func (m *CounterpartyHeader) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// ReceiptProof proves that the event log of a claim is in the receipt of the
+// transaction tx_index of the counterparty block block_height of the claim
+//
+// proof:
+// the nodes of the receipts trie on the path of the transaction index key,
+// from the root
+type ReceiptProof struct {
+	TxIndex  uint64   `protobuf:"varint,1,opt,name=tx_index,json=txIndex,proto3" json:"txIndex"`
+	LogIndex uint64   `protobuf:"varint,2,opt,name=log_index,json=logIndex,proto3" json:"logIndex"`
+	Proof    [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *ReceiptProof) Reset()         { *m = ReceiptProof{} }
+func (m *ReceiptProof) String() string { return proto.CompactTextString(m) }
+func (*ReceiptProof) ProtoMessage()    {}
+func (*ReceiptProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a15a3d117055a1c, []int{11}
+}
+func (m *ReceiptProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptProof.Merge(m, src)
+}
+func (m *ReceiptProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptProof proto.InternalMessageInfo
+
+func (m *ReceiptProof) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *ReceiptProof) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *ReceiptProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 type TransferTx struct {
 	HyperionId    uint64 `protobuf:"varint,1,opt,name=hyperion_id,json=hyperionId,proto3" json:"hyperionId"`
 	Id            uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -662,7 +806,7 @@ func (m *TransferTx) Reset()         { *m = TransferTx{} }
 func (m *TransferTx) String() string { return proto.CompactTextString(m) }
 func (*TransferTx) ProtoMessage()    {}
 func (*TransferTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{10}
+	return fileDescriptor_9a15a3d117055a1c, []int{12}
 }
 func (m *TransferTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +963,7 @@ func (m *QueryTransferTx) Reset()         { *m = QueryTransferTx{} }
 func (m *QueryTransferTx) String() string { return proto.CompactTextString(m) }
 func (*QueryTransferTx) ProtoMessage()    {}
 func (*QueryTransferTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{11}
+	return fileDescriptor_9a15a3d117055a1c, []int{13}
 }
 func (m *QueryTransferTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1114,7 @@ func (m *Rpc) Reset()         { *m = Rpc{} }
 func (m *Rpc) String() string { return proto.CompactTextString(m) }
 func (*Rpc) ProtoMessage()    {}
 func (*Rpc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{12}
+	return fileDescriptor_9a15a3d117055a1c, []int{14}
 }
 func (m *Rpc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1172,7 @@ func (m *LastFinalizedTxIndex) Reset()         { *m = LastFinalizedTxIndex{} }
 func (m *LastFinalizedTxIndex) String() string { return proto.CompactTextString(m) }
 func (*LastFinalizedTxIndex) ProtoMessage()    {}
 func (*LastFinalizedTxIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{13}
+	return fileDescriptor_9a15a3d117055a1c, []int{15}
 }
 func (m *LastFinalizedTxIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1074,7 +1218,7 @@ func (m *FullMetadataToken) Reset()         { *m = FullMetadataToken{} }
 func (m *FullMetadataToken) String() string { return proto.CompactTextString(m) }
 func (*FullMetadataToken) ProtoMessage()    {}
 func (*FullMetadataToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{14}
+	return fileDescriptor_9a15a3d117055a1c, []int{16}
 }
 func (m *FullMetadataToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1271,7 @@ func (m *BatchNonceWithSignature) Reset()         { *m = BatchNonceWithSignature
 func (m *BatchNonceWithSignature) String() string { return proto.CompactTextString(m) }
 func (*BatchNonceWithSignature) ProtoMessage()    {}
 func (*BatchNonceWithSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{15}
+	return fileDescriptor_9a15a3d117055a1c, []int{17}
 }
 func (m *BatchNonceWithSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1330,7 @@ func (m *SlashData) Reset()         { *m = SlashData{} }
 func (m *SlashData) String() string { return proto.CompactTextString(m) }
 func (*SlashData) ProtoMessage()    {}
 func (*SlashData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{16}
+	return fileDescriptor_9a15a3d117055a1c, []int{18}
 }
 func (m *SlashData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1242,7 +1386,7 @@ func (m *OrchestratorHyperionData) Reset()         { *m = OrchestratorHyperionDa
 func (m *OrchestratorHyperionData) String() string { return proto.CompactTextString(m) }
 func (*OrchestratorHyperionData) ProtoMessage()    {}
 func (*OrchestratorHyperionData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{17}
+	return fileDescriptor_9a15a3d117055a1c, []int{19}
 }
 func (m *OrchestratorHyperionData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,7 +1480,7 @@ func (m *OrchestratorData) Reset()         { *m = OrchestratorData{} }
 func (m *OrchestratorData) String() string { return proto.CompactTextString(m) }
 func (*OrchestratorData) ProtoMessage()    {}
 func (*OrchestratorData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{18}
+	return fileDescriptor_9a15a3d117055a1c, []int{20}
 }
 func (m *OrchestratorData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1387,7 +1531,7 @@ func (m *WhitelistedAddresses) Reset()         { *m = WhitelistedAddresses{} }
 func (m *WhitelistedAddresses) String() string { return proto.CompactTextString(m) }
 func (*WhitelistedAddresses) ProtoMessage()    {}
 func (*WhitelistedAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{19}
+	return fileDescriptor_9a15a3d117055a1c, []int{21}
 }
 func (m *WhitelistedAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1432,7 +1576,7 @@ func (m *SkippedNonceInfo) Reset()         { *m = SkippedNonceInfo{} }
 func (m *SkippedNonceInfo) String() string { return proto.CompactTextString(m) }
 func (*SkippedNonceInfo) ProtoMessage()    {}
 func (*SkippedNonceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{20}
+	return fileDescriptor_9a15a3d117055a1c, []int{22}
 }
 func (m *SkippedNonceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1485,7 +1629,7 @@ func (m *SkippedNonceFullInfo) Reset()         { *m = SkippedNonceFullInfo{} }
 func (m *SkippedNonceFullInfo) String() string { return proto.CompactTextString(m) }
 func (*SkippedNonceFullInfo) ProtoMessage()    {}
 func (*SkippedNonceFullInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{21}
+	return fileDescriptor_9a15a3d117055a1c, []int{23}
 }
 func (m *SkippedNonceFullInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1546,7 +1690,7 @@ func (m *SkippedNonceFullInfoWithHyperionId) Reset()         { *m = SkippedNonce
 func (m *SkippedNonceFullInfoWithHyperionId) String() string { return proto.CompactTextString(m) }
 func (*SkippedNonceFullInfoWithHyperionId) ProtoMessage()    {}
 func (*SkippedNonceFullInfoWithHyperionId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a15a3d117055a1c, []int{22}
+	return fileDescriptor_9a15a3d117055a1c, []int{24}
 }
 func (m *SkippedNonceFullInfoWithHyperionId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,6 +1758,8 @@ func init() {
 	proto.RegisterType((*TokenMetadata)(nil), "helios.hyperion.v1.TokenMetadata")
 	proto.RegisterType((*ClaimData)(nil), "helios.hyperion.v1.ClaimData")
 	proto.RegisterType((*Proof)(nil), "helios.hyperion.v1.Proof")
+	proto.RegisterType((*CounterpartyHeader)(nil), "helios.hyperion.v1.CounterpartyHeader")
+	proto.RegisterType((*ReceiptProof)(nil), "helios.hyperion.v1.ReceiptProof")
 	proto.RegisterType((*TransferTx)(nil), "helios.hyperion.v1.TransferTx")
 	proto.RegisterType((*QueryTransferTx)(nil), "helios.hyperion.v1.QueryTransferTx")
 	proto.RegisterType((*Rpc)(nil), "helios.hyperion.v1.Rpc")
@@ -1632,119 +1778,127 @@ func init() {
 func init() { proto.RegisterFile("helios/hyperion/v1/types.proto", fileDescriptor_9a15a3d117055a1c) }
 
 var fileDescriptor_9a15a3d117055a1c = []byte{
-	// 1786 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6f, 0x1b, 0xc9,
-	0xd1, 0x36, 0x45, 0xea, 0x83, 0xc5, 0x4f, 0xb5, 0xb5, 0x7e, 0x69, 0x61, 0x4d, 0x7a, 0x47, 0x7e,
-	0xb3, 0xde, 0xc0, 0x21, 0xd7, 0x4a, 0xf6, 0xb0, 0x40, 0x16, 0x88, 0xc9, 0xb5, 0x57, 0x42, 0xe2,
-	0x38, 0x19, 0x31, 0x6b, 0x20, 0x08, 0x32, 0x68, 0xcd, 0x94, 0xc4, 0x89, 0x66, 0xa6, 0x89, 0xe9,
-	0xa6, 0x96, 0xca, 0x3d, 0x40, 0x8e, 0xc9, 0x31, 0xd7, 0x5c, 0xf3, 0x43, 0xb2, 0xc8, 0x69, 0x8f,
-	0x8b, 0x1c, 0x88, 0xc0, 0x06, 0x72, 0xd0, 0x7f, 0x08, 0x12, 0x74, 0x75, 0x0f, 0x39, 0xb4, 0xa9,
-	0xb5, 0x7c, 0x09, 0x10, 0x60, 0x4f, 0x9c, 0xaa, 0xae, 0xaa, 0xae, 0xae, 0x7a, 0xba, 0xaa, 0x9a,
-	0xd0, 0x1e, 0x61, 0x14, 0x0a, 0xd9, 0x1b, 0x5d, 0x8c, 0x31, 0x0d, 0x45, 0xd2, 0x3b, 0x7f, 0xd8,
-	0x53, 0x17, 0x63, 0x94, 0xdd, 0x71, 0x2a, 0x94, 0x60, 0xcc, 0xac, 0x77, 0xb3, 0xf5, 0xee, 0xf9,
-	0xc3, 0xdd, 0x9d, 0x53, 0x71, 0x2a, 0x68, 0xb9, 0xa7, 0xbf, 0x8c, 0xe4, 0x6e, 0xdb, 0x17, 0x32,
-	0x16, 0xb2, 0x77, 0xcc, 0x93, 0xb3, 0xde, 0xf9, 0xc3, 0x63, 0x54, 0xfc, 0x21, 0x11, 0x76, 0xfd,
-	0xde, 0x8a, 0x9d, 0xb8, 0x52, 0x28, 0x15, 0x57, 0xda, 0x30, 0x49, 0x39, 0x2e, 0x34, 0xfa, 0x69,
-	0x18, 0x9c, 0xe2, 0xe7, 0x3c, 0x0a, 0x03, 0xae, 0x44, 0xca, 0x76, 0x60, 0x7d, 0x2c, 0xbe, 0xc0,
-	0xb4, 0x55, 0xb8, 0x5b, 0xb8, 0x5f, 0x72, 0x0d, 0xc1, 0x3e, 0x80, 0x26, 0xaa, 0x11, 0xa6, 0x38,
-	0x89, 0x3d, 0x1e, 0x04, 0x29, 0x4a, 0xd9, 0x5a, 0xbb, 0x5b, 0xb8, 0x5f, 0x76, 0x1b, 0x19, 0xff,
-	0x91, 0x61, 0x3b, 0xff, 0x2a, 0xc0, 0xc6, 0xe7, 0x3c, 0x92, 0xa8, 0x58, 0x07, 0x2a, 0xd9, 0xfe,
-	0x5e, 0x18, 0x58, 0x8b, 0x90, 0xb1, 0x0e, 0x03, 0xbd, 0x59, 0x22, 0x12, 0x1f, 0xc9, 0x56, 0xc9,
-	0x35, 0x04, 0xfb, 0x04, 0x36, 0x63, 0x8c, 0x8f, 0x31, 0x95, 0xad, 0xe2, 0xdd, 0xe2, 0xfd, 0xca,
-	0xfe, 0x5e, 0xf7, 0xf5, 0xb8, 0x74, 0x5f, 0x71, 0xdc, 0xcd, 0x74, 0xd8, 0x2d, 0xd8, 0x18, 0x61,
-	0x78, 0x3a, 0x52, 0xad, 0x12, 0x59, 0xb5, 0x14, 0xeb, 0x43, 0x2d, 0xc5, 0x2f, 0x78, 0x1a, 0x78,
-	0x3c, 0x16, 0x93, 0x44, 0xb5, 0xd6, 0xf5, 0x01, 0xfa, 0x77, 0xbe, 0x9c, 0x75, 0x6e, 0xfc, 0x7d,
-	0xd6, 0x79, 0xc7, 0x44, 0x54, 0x06, 0x67, 0xdd, 0x50, 0xf4, 0x62, 0xae, 0x46, 0xdd, 0xc3, 0x44,
-	0xb9, 0x55, 0xa3, 0xf3, 0x88, 0x54, 0xd8, 0x7b, 0x60, 0x69, 0x4f, 0x89, 0x33, 0x4c, 0x5a, 0x1b,
-	0x14, 0x83, 0x8a, 0xe1, 0x0d, 0x35, 0xcb, 0xf9, 0x5d, 0x01, 0x3a, 0x3f, 0xe1, 0x52, 0x3d, 0x3b,
-	0x96, 0x98, 0x9e, 0x63, 0xf0, 0xd8, 0xc6, 0xa7, 0x1f, 0x09, 0xff, 0xec, 0xc0, 0xb8, 0xd2, 0x85,
-	0x9b, 0x66, 0x37, 0xef, 0x58, 0x73, 0x3d, 0xeb, 0xaf, 0x09, 0xd0, 0xb6, 0x59, 0xca, 0xcb, 0xef,
-	0xc3, 0x3b, 0xf3, 0xf0, 0x2f, 0x69, 0x98, 0xb8, 0xdd, 0xc4, 0xd7, 0xf7, 0x70, 0xfe, 0x58, 0x80,
-	0xba, 0xf6, 0x63, 0x10, 0xf1, 0x30, 0x7e, 0x7c, 0x8e, 0x89, 0x62, 0xb7, 0x61, 0xcb, 0x1f, 0xf1,
-	0x70, 0x9e, 0x8c, 0xb2, 0xbb, 0x49, 0xf4, 0x61, 0xc0, 0x3e, 0x84, 0x9d, 0xf9, 0x0e, 0xa8, 0x85,
-	0xbd, 0x7c, 0x62, 0x58, 0xb6, 0x46, 0x76, 0x7e, 0x4a, 0x59, 0xca, 0xfb, 0x64, 0x34, 0xac, 0x4f,
-	0xc5, 0x65, 0x9f, 0x48, 0xc5, 0xfa, 0xf4, 0xef, 0x02, 0xdc, 0xa4, 0x28, 0x59, 0xb0, 0x0c, 0xc5,
-	0xa7, 0x98, 0x88, 0xf8, 0x9b, 0x1c, 0xdb, 0x83, 0x1a, 0x85, 0xfa, 0x15, 0xd8, 0x55, 0x55, 0xce,
-	0x8c, 0xc6, 0x51, 0xa0, 0x0d, 0xd1, 0xde, 0x65, 0xd7, 0x10, 0x1a, 0x08, 0xf2, 0x22, 0x3e, 0x16,
-	0x11, 0x01, 0xa1, 0xec, 0x5a, 0x8a, 0xed, 0xc2, 0x56, 0x80, 0x7e, 0x18, 0xf3, 0x48, 0x12, 0x06,
-	0x4a, 0xee, 0x9c, 0xd6, 0x71, 0x08, 0xa5, 0x67, 0x93, 0x23, 0xd2, 0xf0, 0x34, 0x4c, 0xb8, 0xc2,
-	0x80, 0x12, 0xbd, 0xe5, 0xb2, 0x50, 0x0e, 0x68, 0xe9, 0xd9, 0x7c, 0x85, 0x3d, 0x00, 0x46, 0x1a,
-	0x89, 0x8f, 0x89, 0x9c, 0x48, 0x0b, 0x8c, 0x4d, 0x92, 0x6f, 0x6a, 0x79, 0xbb, 0x60, 0xd0, 0xe1,
-	0x43, 0xf3, 0x40, 0x44, 0x01, 0xa6, 0xcf, 0x43, 0x35, 0xb2, 0xa0, 0x6a, 0xc1, 0x66, 0x76, 0x38,
-	0x7b, 0x78, 0x4b, 0xb2, 0x8f, 0x60, 0xc3, 0x62, 0x75, 0xed, 0x3a, 0x58, 0xb5, 0xc2, 0xce, 0x3f,
-	0x0b, 0xb0, 0xb7, 0x22, 0xcc, 0x7a, 0xcb, 0xcf, 0x30, 0x41, 0x19, 0xca, 0xc3, 0xe4, 0x44, 0x48,
-	0xf6, 0x2b, 0xb8, 0xb5, 0x14, 0x5b, 0x4f, 0x09, 0xcf, 0xc4, 0x51, 0xfb, 0x51, 0xd9, 0x7f, 0x7f,
-	0xd5, 0xbd, 0x5b, 0x61, 0xd8, 0xbd, 0xa9, 0x56, 0x24, 0xf5, 0x29, 0x34, 0x02, 0x3c, 0xe1, 0x93,
-	0x48, 0x79, 0x23, 0x3a, 0xb2, 0xce, 0x9d, 0xbe, 0xce, 0xf7, 0x56, 0x99, 0x7d, 0x35, 0x2a, 0x6e,
-	0xdd, 0x2a, 0x9b, 0x05, 0xc9, 0x18, 0x94, 0x22, 0x71, 0x2a, 0x6c, 0x8a, 0xe9, 0xdb, 0x79, 0x0e,
-	0x35, 0x72, 0xe7, 0x29, 0x2a, 0x1e, 0x70, 0xc5, 0x73, 0x29, 0x2f, 0x2c, 0xa5, 0x9c, 0x41, 0x29,
-	0xe1, 0x31, 0x5a, 0xf0, 0xd0, 0xf7, 0x12, 0x0c, 0x8a, 0xcb, 0x30, 0x70, 0x7e, 0x0d, 0x65, 0xba,
-	0x37, 0x9f, 0x6a, 0xa3, 0x9f, 0xc0, 0x56, 0x6c, 0x37, 0xb0, 0x81, 0x79, 0xef, 0xca, 0xc0, 0x64,
-	0x9e, 0xb8, 0x73, 0x15, 0xbd, 0x37, 0xa9, 0xda, 0xbd, 0xf5, 0xb7, 0x33, 0x80, 0xf5, 0x9f, 0xa5,
-	0x42, 0x9c, 0xb0, 0x7b, 0x50, 0x13, 0xa9, 0x3f, 0x42, 0xa9, 0x52, 0x5d, 0xc5, 0x32, 0x04, 0x2c,
-	0x33, 0x35, 0xbe, 0x47, 0x5c, 0x8e, 0x32, 0xf0, 0x1b, 0xc2, 0xf9, 0xcb, 0x3a, 0xc0, 0x30, 0xe5,
-	0x89, 0x3c, 0xc1, 0x74, 0x38, 0x65, 0xbd, 0x15, 0xd5, 0xb6, 0x5f, 0xbf, 0x9c, 0x75, 0x72, 0x15,
-	0x77, 0xa9, 0xfa, 0xd6, 0x61, 0x2d, 0x0c, 0xec, 0x0d, 0x5f, 0x0b, 0x83, 0x5c, 0xe1, 0x2c, 0x2e,
-	0x15, 0x4e, 0x1d, 0x54, 0x4c, 0x02, 0x4c, 0xe7, 0xf7, 0x88, 0x28, 0xb6, 0x0f, 0xd5, 0x00, 0xa5,
-	0x9a, 0xdf, 0x4c, 0x53, 0x4f, 0x1b, 0x97, 0xb3, 0x4e, 0x45, 0xf3, 0x2d, 0x1c, 0xdc, 0x3c, 0xc1,
-	0x8e, 0xa0, 0x9e, 0xa2, 0x8f, 0xe1, 0x39, 0xe6, 0x4b, 0x68, 0x65, 0xff, 0xf6, 0x95, 0x11, 0xed,
-	0x6f, 0x5f, 0xce, 0x3a, 0xb5, 0x4c, 0x89, 0x58, 0xee, 0x32, 0xc9, 0x3e, 0x03, 0x90, 0xba, 0x00,
-	0x2d, 0xae, 0xde, 0x37, 0x1a, 0xac, 0x5d, 0xce, 0x3a, 0x65, 0xad, 0x60, 0x8c, 0x2d, 0x3e, 0xd9,
-	0x53, 0x5d, 0xde, 0xad, 0x77, 0x27, 0x88, 0xad, 0xad, 0x37, 0x99, 0xa2, 0xc3, 0x66, 0x2a, 0x4f,
-	0x10, 0xdd, 0x3c, 0xc1, 0x1e, 0xc1, 0x16, 0xf9, 0xa5, 0x4d, 0x95, 0xdf, 0x64, 0xaa, 0x72, 0x39,
-	0xeb, 0x6c, 0x6a, 0x71, 0x6d, 0x26, 0xfb, 0xa0, 0xd8, 0x2b, 0xae, 0x26, 0xb2, 0x05, 0x36, 0xf6,
-	0x44, 0xb1, 0x77, 0xa1, 0x1c, 0x84, 0x29, 0xfa, 0xba, 0x99, 0xb7, 0x2a, 0xb4, 0xb4, 0x60, 0xb0,
-	0xef, 0xe4, 0xea, 0x69, 0x95, 0x70, 0x40, 0xd6, 0x6d, 0x4d, 0x5d, 0x14, 0xd7, 0x1e, 0xac, 0x8f,
-	0x35, 0x0c, 0x5b, 0xb5, 0xab, 0xbd, 0x23, 0x9c, 0xba, 0x46, 0x8e, 0xed, 0xc1, 0xa6, 0x9a, 0x7a,
-	0x1a, 0x7e, 0xad, 0x3a, 0x65, 0x1b, 0x2e, 0x67, 0x9d, 0x0d, 0x35, 0x3d, 0xe0, 0x72, 0xe4, 0xda,
-	0x5f, 0x8d, 0xd6, 0x30, 0x09, 0x70, 0xda, 0x6a, 0x98, 0xae, 0x4e, 0x84, 0xf3, 0xb7, 0x75, 0x68,
-	0xfc, 0x7c, 0x82, 0xe9, 0xc5, 0xb7, 0x90, 0xfd, 0x16, 0xb2, 0xff, 0x13, 0x90, 0xd5, 0x8d, 0x59,
-	0x85, 0x31, 0x8a, 0x89, 0x6a, 0x35, 0x89, 0x9f, 0x91, 0x0e, 0x87, 0xa2, 0x3b, 0xf6, 0x59, 0x13,
-	0x8a, 0x93, 0x34, 0xeb, 0x35, 0xfa, 0x93, 0xb5, 0x01, 0x52, 0x1c, 0x4f, 0xcc, 0x94, 0x6d, 0x81,
-	0x9a, 0xe3, 0xb0, 0xfb, 0xd0, 0x8c, 0xb8, 0xcc, 0x66, 0x25, 0x6f, 0x22, 0x31, 0xb0, 0xd0, 0xad,
-	0x6b, 0xbe, 0x99, 0x93, 0x7e, 0x21, 0x31, 0x70, 0x0e, 0x60, 0x47, 0x8f, 0x6f, 0x4f, 0xc2, 0x84,
-	0x47, 0xe1, 0x6f, 0x31, 0x18, 0x4e, 0x0f, 0xc9, 0xa9, 0x0f, 0xa1, 0xa8, 0xa6, 0xba, 0x4f, 0xe8,
-	0x56, 0xda, 0x5e, 0x99, 0x9c, 0xf9, 0x05, 0x73, 0xb5, 0xa8, 0xf3, 0xd7, 0x02, 0x6c, 0x3f, 0x99,
-	0x44, 0x51, 0xd6, 0x9b, 0x0c, 0x70, 0x3e, 0x7e, 0xad, 0xab, 0xdd, 0xe9, 0x9a, 0xb1, 0xa2, 0x4b,
-	0xef, 0x08, 0xfb, 0xa8, 0xe8, 0xae, 0xe8, 0x68, 0x1f, 0x41, 0xcd, 0x76, 0x74, 0xcf, 0x9f, 0x4f,
-	0x27, 0xa5, 0x7e, 0xf3, 0x72, 0xd6, 0xa9, 0xda, 0x85, 0x01, 0xf5, 0xf0, 0x25, 0x8a, 0xfd, 0x08,
-	0xaa, 0x4a, 0x28, 0x1e, 0x79, 0x72, 0x32, 0x1e, 0x47, 0x17, 0xa6, 0x93, 0xbf, 0x69, 0xa6, 0xa9,
-	0x90, 0xca, 0x11, 0x69, 0x38, 0x0a, 0xfe, 0xaf, 0xcf, 0x95, 0x3f, 0xa2, 0x09, 0x54, 0xcf, 0x0a,
-	0x47, 0xe1, 0x69, 0xc2, 0xd5, 0x24, 0x45, 0xf6, 0xff, 0x50, 0x37, 0xb3, 0x8c, 0x2f, 0x12, 0x95,
-	0x72, 0x5f, 0x65, 0x9d, 0x94, 0xb8, 0x03, 0xcb, 0xbc, 0xe2, 0xc5, 0xf1, 0x2e, 0x94, 0x65, 0x66,
-	0xc9, 0x0e, 0x18, 0x0b, 0x86, 0x73, 0x0e, 0xe5, 0xa3, 0x88, 0xcb, 0x11, 0x0d, 0x03, 0xef, 0x43,
-	0x43, 0x6a, 0xc2, 0xd3, 0x50, 0x90, 0x8a, 0xc7, 0x63, 0x3b, 0xb6, 0xd7, 0x89, 0x3d, 0xcc, 0xb8,
-	0xfa, 0xb4, 0x46, 0xf0, 0x6d, 0x26, 0xb8, 0x0a, 0xa9, 0x98, 0x09, 0xc8, 0xf9, 0xfd, 0x06, 0xb4,
-	0x9e, 0xe5, 0xe6, 0x80, 0x03, 0x9b, 0x63, 0xf2, 0xe3, 0x8d, 0x6f, 0xab, 0x01, 0xd4, 0xe3, 0x30,
-	0x09, 0xe3, 0x49, 0xec, 0xa9, 0x29, 0xdd, 0xe7, 0x6b, 0x79, 0x50, 0xb5, 0x4a, 0xc3, 0xa9, 0xbe,
-	0xcb, 0x87, 0xb0, 0x9d, 0x19, 0x39, 0xd6, 0x81, 0x27, 0x3b, 0xd7, 0xca, 0x5b, 0xc3, 0xea, 0x51,
-	0xbe, 0xb4, 0xa9, 0xef, 0xc2, 0xb6, 0xcd, 0x3e, 0x45, 0xc5, 0x00, 0xc7, 0xbc, 0xd0, 0x1a, 0x26,
-	0xc7, 0x9a, 0x6f, 0x90, 0xf2, 0x63, 0x60, 0x79, 0xd9, 0xb7, 0x79, 0xaf, 0x35, 0x17, 0xb6, 0xec,
-	0x78, 0xfd, 0x43, 0x00, 0x63, 0x86, 0xa0, 0xbe, 0x41, 0xf7, 0xe6, 0xce, 0xaa, 0x7b, 0x33, 0x4f,
-	0xb2, 0x5b, 0x96, 0xf3, 0x7c, 0x6b, 0xb7, 0xa7, 0x9e, 0x98, 0x28, 0x4f, 0xd9, 0x6b, 0x85, 0x01,
-	0xd5, 0x6b, 0xed, 0xf6, 0xf4, 0xd9, 0x44, 0x0d, 0xe7, 0x6c, 0x7d, 0xb9, 0xd5, 0xd4, 0x0b, 0x93,
-	0xbc, 0xe8, 0x96, 0x01, 0x87, 0x9a, 0x1e, 0x26, 0x39, 0xc9, 0x3d, 0xa8, 0x99, 0x78, 0xfa, 0x29,
-	0xd2, 0xfb, 0xa2, 0x4c, 0x62, 0x55, 0x62, 0x0e, 0x0c, 0x4f, 0x43, 0xcd, 0x0a, 0x89, 0xe4, 0x24,
-	0x4c, 0x63, 0x0c, 0xa8, 0xa2, 0x96, 0xdc, 0xba, 0x11, 0xcb, 0xb8, 0xfa, 0x65, 0x7b, 0x82, 0xe8,
-	0xf9, 0x22, 0x8a, 0xd0, 0xd7, 0xd6, 0x2a, 0xd7, 0xca, 0xf4, 0x09, 0xe2, 0x20, 0x53, 0x61, 0x1f,
-	0xc3, 0x6d, 0x9c, 0x2a, 0x4c, 0x13, 0x1e, 0x51, 0xa0, 0x34, 0x68, 0x70, 0x8a, 0xfe, 0x44, 0xdb,
-	0xa3, 0x82, 0xec, 0xde, 0xca, 0x04, 0x74, 0x60, 0x86, 0xd3, 0xc7, 0x76, 0x95, 0x71, 0x68, 0xbf,
-	0xa6, 0xba, 0xec, 0x4f, 0xed, 0x3a, 0xfe, 0xec, 0x2e, 0x9b, 0x7f, 0x92, 0xf3, 0xce, 0xf9, 0x73,
-	0x01, 0x9a, 0xf9, 0xab, 0x40, 0xa9, 0x71, 0xa0, 0x9a, 0x1f, 0x93, 0xed, 0x85, 0x5f, 0xe2, 0xb1,
-	0xdf, 0xc0, 0x6e, 0x9e, 0xf6, 0xe6, 0x77, 0xc6, 0x8e, 0xe4, 0x1a, 0x0c, 0x0f, 0x56, 0x81, 0xe1,
-	0xaa, 0x8b, 0xe7, 0xb6, 0xc4, 0x15, 0x2b, 0xce, 0x0f, 0x60, 0xe7, 0xf9, 0x28, 0x54, 0x18, 0x85,
-	0x52, 0x61, 0x60, 0xc7, 0x07, 0xa4, 0xc6, 0xc7, 0x33, 0x82, 0xea, 0x76, 0xd9, 0x5d, 0x30, 0x1c,
-	0x05, 0xcd, 0xa3, 0xb3, 0x70, 0x3c, 0xc6, 0x80, 0xaa, 0x9a, 0x7e, 0x99, 0xe9, 0x31, 0x45, 0x2a,
-	0x9e, 0xaa, 0xa5, 0x3f, 0x06, 0x4c, 0xe7, 0x26, 0xbe, 0x69, 0x13, 0x6e, 0x9e, 0x60, 0x0f, 0x00,
-	0x30, 0x09, 0x96, 0xfe, 0x18, 0x30, 0x63, 0x03, 0x26, 0x81, 0x95, 0x5f, 0x7c, 0x3a, 0x7f, 0x2a,
-	0xc0, 0x4e, 0x7e, 0x5b, 0xdd, 0x1f, 0xfe, 0x3b, 0x5b, 0xb3, 0x4e, 0x56, 0x82, 0xa9, 0xef, 0xf5,
-	0xcb, 0x97, 0xb3, 0x8e, 0x61, 0xd8, 0x6a, 0xec, 0x7c, 0x5d, 0x00, 0x67, 0x95, 0x6f, 0xba, 0xe0,
-	0x1f, 0x2c, 0x0a, 0xdc, 0x5b, 0x0f, 0x8f, 0xaf, 0x1e, 0x6d, 0xed, 0xad, 0x8f, 0x56, 0xbc, 0xee,
-	0xd1, 0x4a, 0xab, 0x8f, 0xd6, 0x1f, 0x7c, 0xf9, 0xa2, 0x5d, 0xf8, 0xea, 0x45, 0xbb, 0xf0, 0x8f,
-	0x17, 0xed, 0xc2, 0x1f, 0x5e, 0xb6, 0x6f, 0x7c, 0xf5, 0xb2, 0x7d, 0xe3, 0xeb, 0x97, 0xed, 0x1b,
-	0xbf, 0xfc, 0xc0, 0x60, 0xf0, 0x7b, 0xbe, 0x48, 0xb1, 0x97, 0x7d, 0xeb, 0x99, 0xa7, 0x37, 0x5d,
-	0xfc, 0x89, 0x47, 0xff, 0x15, 0x1e, 0x6f, 0xd0, 0x9f, 0x77, 0xdf, 0xff, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xe3, 0x50, 0x8a, 0xd4, 0x4e, 0x14, 0x00, 0x00,
+	// 1905 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
+	0x11, 0x36, 0x4d, 0xea, 0x87, 0xc5, 0x1f, 0x51, 0x6d, 0xad, 0x43, 0x0b, 0x6b, 0xd2, 0x3b, 0x72,
+	0xb2, 0x76, 0xe0, 0x90, 0x6b, 0x25, 0x3e, 0x2c, 0x90, 0x05, 0x62, 0x6a, 0xed, 0x95, 0x90, 0x38,
+	0x4e, 0x46, 0xca, 0x1a, 0x08, 0x82, 0x0c, 0x5a, 0x33, 0x25, 0x71, 0xa2, 0x99, 0x69, 0x62, 0xba,
+	0x29, 0x53, 0xb9, 0x07, 0xc8, 0x31, 0x39, 0xe6, 0x9a, 0x6b, 0x5e, 0x21, 0xf7, 0x2c, 0x72, 0xda,
+	0xe3, 0x22, 0x07, 0x22, 0xb0, 0x81, 0x1c, 0xf4, 0x0e, 0x41, 0x82, 0xae, 0xee, 0x21, 0x67, 0x2c,
+	0x6a, 0x2d, 0x5f, 0x02, 0x04, 0xd8, 0x13, 0xbb, 0xaa, 0xab, 0xaa, 0x6b, 0xaa, 0xbe, 0xae, 0xaa,
+	0x26, 0x74, 0x86, 0x18, 0x85, 0x42, 0xf6, 0x87, 0x67, 0x23, 0x4c, 0x43, 0x91, 0xf4, 0x4f, 0x1f,
+	0xf6, 0xd5, 0xd9, 0x08, 0x65, 0x6f, 0x94, 0x0a, 0x25, 0x18, 0x33, 0xfb, 0xbd, 0x6c, 0xbf, 0x77,
+	0xfa, 0x70, 0x73, 0xe3, 0x58, 0x1c, 0x0b, 0xda, 0xee, 0xeb, 0x95, 0x91, 0xdc, 0xec, 0xf8, 0x42,
+	0xc6, 0x42, 0xf6, 0x0f, 0x79, 0x72, 0xd2, 0x3f, 0x7d, 0x78, 0x88, 0x8a, 0x3f, 0x24, 0xc2, 0xee,
+	0xdf, 0x5d, 0x70, 0x12, 0x57, 0x0a, 0xa5, 0xe2, 0x4a, 0x1b, 0x26, 0x29, 0xc7, 0x85, 0xb5, 0x41,
+	0x1a, 0x06, 0xc7, 0xf8, 0x39, 0x8f, 0xc2, 0x80, 0x2b, 0x91, 0xb2, 0x0d, 0x58, 0x1a, 0x89, 0x97,
+	0x98, 0xb6, 0x4b, 0x77, 0x4a, 0xf7, 0x2a, 0xae, 0x21, 0xd8, 0x7d, 0x68, 0xa1, 0x1a, 0x62, 0x8a,
+	0xe3, 0xd8, 0xe3, 0x41, 0x90, 0xa2, 0x94, 0xed, 0xeb, 0x77, 0x4a, 0xf7, 0xaa, 0xee, 0x5a, 0xc6,
+	0x7f, 0x6c, 0xd8, 0xce, 0xbf, 0x4b, 0xb0, 0xfc, 0x39, 0x8f, 0x24, 0x2a, 0xd6, 0x85, 0x5a, 0x76,
+	0xbe, 0x17, 0x06, 0xd6, 0x22, 0x64, 0xac, 0xbd, 0x40, 0x1f, 0x96, 0x88, 0xc4, 0x47, 0xb2, 0x55,
+	0x71, 0x0d, 0xc1, 0x3e, 0x81, 0x95, 0x18, 0xe3, 0x43, 0x4c, 0x65, 0xbb, 0x7c, 0xa7, 0x7c, 0xaf,
+	0xb6, 0xbd, 0xd5, 0xbb, 0x18, 0x97, 0xde, 0x1b, 0x8e, 0xbb, 0x99, 0x0e, 0xbb, 0x09, 0xcb, 0x43,
+	0x0c, 0x8f, 0x87, 0xaa, 0x5d, 0x21, 0xab, 0x96, 0x62, 0x03, 0x68, 0xa4, 0xf8, 0x92, 0xa7, 0x81,
+	0xc7, 0x63, 0x31, 0x4e, 0x54, 0x7b, 0x49, 0x7f, 0xc0, 0xe0, 0xf6, 0x17, 0xd3, 0xee, 0xb5, 0x7f,
+	0x4c, 0xbb, 0xef, 0x99, 0x88, 0xca, 0xe0, 0xa4, 0x17, 0x8a, 0x7e, 0xcc, 0xd5, 0xb0, 0xb7, 0x97,
+	0x28, 0xb7, 0x6e, 0x74, 0x1e, 0x93, 0x0a, 0xfb, 0x00, 0x2c, 0xed, 0x29, 0x71, 0x82, 0x49, 0x7b,
+	0x99, 0x62, 0x50, 0x33, 0xbc, 0x03, 0xcd, 0x72, 0x7e, 0x57, 0x82, 0xee, 0x4f, 0xb8, 0x54, 0xcf,
+	0x0f, 0x25, 0xa6, 0xa7, 0x18, 0x3c, 0xb1, 0xf1, 0x19, 0x44, 0xc2, 0x3f, 0xd9, 0x35, 0xae, 0xf4,
+	0xe0, 0x86, 0x39, 0xcd, 0x3b, 0xd4, 0x5c, 0xcf, 0xfa, 0x6b, 0x02, 0xb4, 0x6e, 0xb6, 0xf2, 0xf2,
+	0xdb, 0xf0, 0xde, 0x2c, 0xfc, 0x05, 0x0d, 0x13, 0xb7, 0x1b, 0x78, 0xf1, 0x0c, 0xe7, 0x8f, 0x25,
+	0x68, 0x6a, 0x3f, 0x76, 0x22, 0x1e, 0xc6, 0x4f, 0x4e, 0x31, 0x51, 0xec, 0x16, 0xac, 0xfa, 0x43,
+	0x1e, 0xce, 0x92, 0x51, 0x75, 0x57, 0x88, 0xde, 0x0b, 0xd8, 0x47, 0xb0, 0x31, 0x3b, 0x01, 0xb5,
+	0xb0, 0x97, 0x4f, 0x0c, 0xcb, 0xf6, 0xc8, 0xce, 0x4f, 0x29, 0x4b, 0x79, 0x9f, 0x8c, 0x86, 0xf5,
+	0xa9, 0x5c, 0xf4, 0x89, 0x54, 0xac, 0x4f, 0xff, 0x29, 0xc1, 0x0d, 0x8a, 0x92, 0x05, 0xcb, 0x81,
+	0xf8, 0x14, 0x13, 0x11, 0x7f, 0x9d, 0x63, 0x5b, 0xd0, 0xa0, 0x50, 0xbf, 0x01, 0xbb, 0xba, 0xca,
+	0x99, 0xd1, 0x38, 0x0a, 0xb4, 0x21, 0x3a, 0xbb, 0xea, 0x1a, 0x42, 0x03, 0x41, 0x9e, 0xc5, 0x87,
+	0x22, 0x22, 0x20, 0x54, 0x5d, 0x4b, 0xb1, 0x4d, 0x58, 0x0d, 0xd0, 0x0f, 0x63, 0x1e, 0x49, 0xc2,
+	0x40, 0xc5, 0x9d, 0xd1, 0x3a, 0x0e, 0xa1, 0xf4, 0x6c, 0x72, 0x44, 0x1a, 0x1e, 0x87, 0x09, 0x57,
+	0x18, 0x50, 0xa2, 0x57, 0x5d, 0x16, 0xca, 0x1d, 0xda, 0x7a, 0x3e, 0xdb, 0x61, 0x0f, 0x80, 0x91,
+	0x46, 0xe2, 0x63, 0x22, 0xc7, 0xd2, 0x02, 0x63, 0x85, 0xe4, 0x5b, 0x5a, 0xde, 0x6e, 0x18, 0x74,
+	0xf8, 0xd0, 0xda, 0x15, 0x51, 0x80, 0xe9, 0x8b, 0x50, 0x0d, 0x2d, 0xa8, 0xda, 0xb0, 0x92, 0x7d,
+	0x9c, 0xfd, 0x78, 0x4b, 0xb2, 0x47, 0xb0, 0x6c, 0xb1, 0x7a, 0xfd, 0x2a, 0x58, 0xb5, 0xc2, 0xce,
+	0xbf, 0x4a, 0xb0, 0xb5, 0x20, 0xcc, 0xfa, 0xc8, 0xcf, 0x30, 0x41, 0x19, 0xca, 0xbd, 0xe4, 0x48,
+	0x48, 0xf6, 0x2b, 0xb8, 0x59, 0x88, 0xad, 0xa7, 0x84, 0x67, 0xe2, 0xa8, 0xfd, 0xa8, 0x6d, 0x7f,
+	0xb8, 0xe8, 0xde, 0x2d, 0x30, 0xec, 0xde, 0x50, 0x17, 0x99, 0xec, 0x19, 0xac, 0x05, 0x78, 0xc4,
+	0xc7, 0x91, 0xf2, 0x86, 0xf4, 0xc9, 0x3a, 0x77, 0xfa, 0x3a, 0xdf, 0x5d, 0x64, 0xf6, 0xcd, 0xa8,
+	0xb8, 0x4d, 0xab, 0x6c, 0x36, 0x24, 0x63, 0x50, 0x89, 0xc4, 0xb1, 0xb0, 0x29, 0xa6, 0xb5, 0xf3,
+	0x02, 0x1a, 0xe4, 0xce, 0x33, 0x54, 0x3c, 0xe0, 0x8a, 0xe7, 0x52, 0x5e, 0x2a, 0xa4, 0x9c, 0x41,
+	0x25, 0xe1, 0x31, 0x5a, 0xf0, 0xd0, 0xba, 0x00, 0x83, 0x72, 0x11, 0x06, 0xce, 0xaf, 0xa1, 0x4a,
+	0xf7, 0xe6, 0x53, 0x6d, 0xf4, 0x13, 0x58, 0x8d, 0xed, 0x01, 0x36, 0x30, 0x1f, 0x5c, 0x1a, 0x98,
+	0xcc, 0x13, 0x77, 0xa6, 0xa2, 0xcf, 0x26, 0x55, 0x7b, 0xb6, 0x5e, 0x3b, 0x3b, 0xb0, 0xf4, 0xb3,
+	0x54, 0x88, 0x23, 0x76, 0x17, 0x1a, 0x22, 0xf5, 0x87, 0x28, 0x55, 0xaa, 0xab, 0x58, 0x86, 0x80,
+	0x22, 0x53, 0xe3, 0x7b, 0xc8, 0xe5, 0x30, 0x03, 0xbf, 0x21, 0x9c, 0xbf, 0x96, 0x80, 0xed, 0xe8,
+	0x58, 0x61, 0x3a, 0xe2, 0xa9, 0x3a, 0xdb, 0x45, 0x1e, 0x60, 0x9a, 0xab, 0x7f, 0xa5, 0x42, 0xfd,
+	0x63, 0x50, 0xd1, 0x7a, 0x99, 0x1f, 0x7a, 0xcd, 0xfa, 0x50, 0x1b, 0xf1, 0x94, 0x2e, 0xaf, 0xde,
+	0xa2, 0xd8, 0x0e, 0x9a, 0xe7, 0xd3, 0x2e, 0x18, 0xf6, 0x2e, 0x97, 0x43, 0x37, 0xb7, 0x66, 0x8f,
+	0x74, 0x11, 0xf5, 0x31, 0x1c, 0x29, 0xe9, 0xa5, 0x42, 0x98, 0x1a, 0x5b, 0x1d, 0xb4, 0xce, 0xa7,
+	0xdd, 0x7a, 0xb6, 0xe1, 0x0a, 0xa1, 0xdc, 0x02, 0xa5, 0xcf, 0x56, 0x61, 0x8c, 0xf6, 0xba, 0xd1,
+	0xda, 0x79, 0x09, 0x75, 0xd7, 0xc8, 0x98, 0x50, 0x7c, 0x07, 0x56, 0xd5, 0xc4, 0x0b, 0x93, 0x00,
+	0x27, 0xc6, 0xf3, 0x41, 0xed, 0x7c, 0xda, 0x5d, 0x51, 0x93, 0x3d, 0xcd, 0x72, 0xb3, 0x05, 0xbb,
+	0x0f, 0xd5, 0x48, 0x1c, 0x5b, 0x41, 0xaa, 0x4f, 0x83, 0xfa, 0xf9, 0xb4, 0xbb, 0x1a, 0x89, 0x63,
+	0x23, 0x39, 0x5b, 0x51, 0x33, 0xd3, 0xb6, 0xa9, 0x8f, 0xd4, 0x5d, 0x43, 0x38, 0x7f, 0x59, 0x02,
+	0x38, 0x48, 0x79, 0x22, 0x8f, 0x30, 0x3d, 0x98, 0xe8, 0x18, 0x5c, 0xe8, 0x52, 0x26, 0x06, 0xf3,
+	0x4e, 0x55, 0xe8, 0x5a, 0x4d, 0xb8, 0x1e, 0x06, 0xb6, 0x32, 0x5e, 0x0f, 0x83, 0x5c, 0xc0, 0xcb,
+	0x85, 0x80, 0x6b, 0x30, 0x62, 0x12, 0x60, 0x3a, 0xab, 0x3f, 0x44, 0xb1, 0x6d, 0xa8, 0x07, 0x28,
+	0xd5, 0xac, 0xa2, 0x99, 0x3e, 0xb4, 0x76, 0x3e, 0xed, 0xd6, 0x34, 0xdf, 0x5e, 0x23, 0x37, 0x4f,
+	0xb0, 0x7d, 0x68, 0x52, 0x40, 0x4f, 0x31, 0xdf, 0x7a, 0x6a, 0xdb, 0xb7, 0x2e, 0x45, 0xe2, 0x60,
+	0xfd, 0x7c, 0xda, 0x6d, 0x64, 0x4a, 0xc4, 0x72, 0x8b, 0x24, 0xfb, 0x0c, 0x40, 0xea, 0xdc, 0xcf,
+	0x4b, 0xd6, 0xd7, 0x1a, 0x6c, 0x9c, 0x4f, 0xbb, 0x55, 0xad, 0x60, 0x8c, 0xcd, 0x97, 0xec, 0x19,
+	0xd4, 0x67, 0xde, 0x1d, 0x21, 0xb6, 0x57, 0xdf, 0x66, 0x8a, 0x3e, 0x36, 0x53, 0x79, 0x8a, 0xe8,
+	0xe6, 0x09, 0xf6, 0x18, 0x56, 0xc9, 0x2f, 0x6d, 0xaa, 0xfa, 0x36, 0x53, 0x04, 0x12, 0x2d, 0xae,
+	0xcd, 0x64, 0x0b, 0x8a, 0xbd, 0xe2, 0x6a, 0x2c, 0xdb, 0x60, 0x63, 0x4f, 0x14, 0x7b, 0x1f, 0xaa,
+	0x41, 0x98, 0xa2, 0xaf, 0x42, 0x91, 0xb4, 0x6b, 0xb4, 0x35, 0x67, 0x68, 0x08, 0xce, 0xfa, 0x50,
+	0x7d, 0x0e, 0x41, 0xdb, 0x8b, 0xe6, 0x4d, 0xa9, 0x9f, 0xe1, 0xaa, 0x71, 0xb9, 0x77, 0x04, 0x6a,
+	0x0b, 0x39, 0xb6, 0x05, 0x2b, 0x6a, 0x62, 0xee, 0x58, 0x93, 0xb2, 0x0d, 0xe7, 0xd3, 0xee, 0xb2,
+	0x9a, 0xd0, 0xfd, 0xb2, 0xbf, 0x1a, 0xad, 0x06, 0xd4, 0x6b, 0x66, 0x1a, 0x22, 0xc2, 0xf9, 0xfb,
+	0x12, 0xac, 0xfd, 0x7c, 0x8c, 0xe9, 0xd9, 0x37, 0x90, 0xfd, 0x06, 0xb2, 0xff, 0x17, 0x90, 0xd5,
+	0x03, 0x8d, 0xae, 0xf0, 0x62, 0xac, 0xda, 0x2d, 0xe2, 0x67, 0xa4, 0xc3, 0xa1, 0xec, 0x8e, 0x7c,
+	0xd6, 0x82, 0xf2, 0x38, 0xcd, 0x7a, 0xb4, 0x5e, 0xb2, 0x0e, 0x40, 0x8a, 0xa3, 0xb1, 0x79, 0x9d,
+	0x58, 0xa0, 0xe6, 0x38, 0xec, 0x1e, 0xb4, 0x22, 0x2e, 0xb3, 0x19, 0xd3, 0x1b, 0x4b, 0x0c, 0x2c,
+	0x74, 0x9b, 0x9a, 0x6f, 0xe6, 0xcb, 0x5f, 0x48, 0x0c, 0x9c, 0x5d, 0xd8, 0xd0, 0x63, 0xef, 0xd3,
+	0x30, 0xe1, 0x51, 0xf8, 0x5b, 0x0c, 0x0e, 0x6c, 0xdb, 0xf8, 0x08, 0xca, 0x6a, 0xa2, 0xfb, 0xab,
+	0x1e, 0x41, 0x3a, 0x0b, 0x93, 0x33, 0xbb, 0x60, 0xae, 0x16, 0x75, 0xfe, 0x56, 0x82, 0xf5, 0xa7,
+	0xe3, 0x28, 0xca, 0x7a, 0xba, 0x01, 0xce, 0xc7, 0x17, 0xa6, 0x81, 0xdb, 0x3d, 0x33, 0x8e, 0xf5,
+	0xe8, 0xfd, 0x65, 0x1f, 0x63, 0xbd, 0x05, 0x93, 0xc0, 0x23, 0x68, 0xd8, 0x49, 0xc8, 0xf3, 0x67,
+	0x53, 0x5d, 0xc5, 0x34, 0x4f, 0xbb, 0x41, 0xfd, 0xdc, 0x2d, 0x50, 0xec, 0x47, 0x50, 0x57, 0x42,
+	0xf1, 0xc8, 0x93, 0xe3, 0xd1, 0x28, 0x3a, 0x6b, 0x97, 0xaf, 0x32, 0x0b, 0xd6, 0x48, 0x65, 0x9f,
+	0x34, 0x1c, 0x05, 0xdf, 0x1a, 0x70, 0xe5, 0x0f, 0x69, 0x72, 0xd7, 0x33, 0xd6, 0x7e, 0x78, 0x9c,
+	0x70, 0x35, 0x4e, 0x91, 0x7d, 0x1b, 0x9a, 0x66, 0x06, 0xf4, 0x45, 0xa2, 0x52, 0xee, 0xab, 0x6c,
+	0x02, 0x21, 0xee, 0x8e, 0x65, 0x5e, 0xf2, 0x52, 0x7b, 0x1f, 0xaa, 0x32, 0xb3, 0x64, 0x07, 0xb3,
+	0x39, 0xc3, 0x39, 0x85, 0xea, 0x7e, 0xc4, 0xe5, 0x90, 0x86, 0xa8, 0x0f, 0x61, 0x4d, 0x6a, 0xc2,
+	0xd3, 0x50, 0x90, 0x8a, 0xc7, 0x23, 0x3b, 0x9e, 0x34, 0x89, 0x7d, 0x90, 0x71, 0xf5, 0xd7, 0x1a,
+	0xc1, 0x77, 0x99, 0x7c, 0x6b, 0xa4, 0x62, 0x26, 0x47, 0xe7, 0xf7, 0xcb, 0xd0, 0x7e, 0x9e, 0x9b,
+	0x9f, 0x76, 0x6d, 0x8e, 0xc9, 0x8f, 0xb7, 0xbe, 0x49, 0x77, 0xa0, 0x19, 0x87, 0x49, 0x18, 0x8f,
+	0x63, 0x4f, 0x4d, 0xe8, 0x3e, 0x5f, 0xc9, 0x83, 0xba, 0x55, 0x3a, 0x98, 0xe8, 0xbb, 0xbc, 0x07,
+	0xeb, 0x99, 0x91, 0x43, 0x1d, 0x78, 0xb2, 0x73, 0xa5, 0xbc, 0xad, 0x59, 0x3d, 0xca, 0x97, 0x36,
+	0xf5, 0x5d, 0x58, 0xb7, 0xd9, 0xa7, 0xa8, 0x18, 0xe0, 0x98, 0x97, 0xed, 0x9a, 0xc9, 0xb1, 0xe6,
+	0x1b, 0xa4, 0xfc, 0x18, 0x58, 0x5e, 0xf6, 0x5d, 0xde, 0xb9, 0xad, 0xb9, 0x2d, 0xfb, 0x2c, 0xf9,
+	0x21, 0x80, 0x31, 0x43, 0x50, 0x5f, 0xa6, 0x7b, 0x73, 0x7b, 0xd1, 0xbd, 0x99, 0x25, 0xd9, 0xad,
+	0xca, 0x6c, 0x49, 0x6e, 0x4f, 0x3c, 0x31, 0x56, 0x9e, 0xb2, 0xd7, 0x0a, 0x83, 0xf6, 0x8a, 0x75,
+	0x7b, 0xf2, 0x7c, 0xac, 0x0e, 0x66, 0x6c, 0x7d, 0xb9, 0x69, 0xf2, 0xcb, 0x8b, 0xae, 0x1a, 0x70,
+	0xe8, 0xa1, 0x2f, 0x27, 0xb9, 0x05, 0x0d, 0x13, 0x4f, 0x3f, 0x45, 0x7a, 0x97, 0x55, 0x49, 0xac,
+	0x4e, 0xcc, 0x1d, 0xc3, 0xd3, 0x50, 0xb3, 0x42, 0x22, 0x39, 0x0a, 0xd3, 0x18, 0x03, 0xaa, 0xa8,
+	0x15, 0xb7, 0x69, 0xc4, 0x32, 0xae, 0xfe, 0x47, 0xe0, 0x08, 0xd1, 0xf3, 0x45, 0x14, 0xa1, 0xaf,
+	0xad, 0xd5, 0xae, 0x94, 0xe9, 0x23, 0xc4, 0x9d, 0x4c, 0x85, 0x7d, 0x0c, 0xb7, 0x70, 0xa2, 0x30,
+	0x4d, 0x78, 0x44, 0x81, 0xd2, 0xa0, 0xc1, 0x09, 0xfa, 0x63, 0x85, 0xb6, 0x20, 0xbb, 0x37, 0x33,
+	0x01, 0x1d, 0x98, 0x83, 0xc9, 0x13, 0xbb, 0xcb, 0x38, 0x74, 0x2e, 0xa8, 0x16, 0xfd, 0x69, 0x5c,
+	0xc5, 0x9f, 0xcd, 0xa2, 0xf9, 0xa7, 0x39, 0xef, 0x9c, 0x3f, 0x97, 0xa0, 0x95, 0xbf, 0x0a, 0x94,
+	0x1a, 0x07, 0xea, 0xf9, 0xe7, 0x85, 0xbd, 0xf0, 0x05, 0x1e, 0xfb, 0x0d, 0x6c, 0xe6, 0x69, 0x6f,
+	0x76, 0x67, 0xec, 0x53, 0x46, 0x83, 0xe1, 0xc1, 0x22, 0x30, 0x5c, 0x76, 0xf1, 0xdc, 0xb6, 0xb8,
+	0x64, 0xc7, 0xf9, 0x01, 0x6c, 0xbc, 0x18, 0x86, 0x0a, 0xa3, 0x50, 0x2a, 0x0c, 0xec, 0xf8, 0x80,
+	0xd4, 0xf8, 0x78, 0x46, 0x50, 0xdd, 0xae, 0xba, 0x73, 0x86, 0xa3, 0xa0, 0xb5, 0x7f, 0x12, 0x8e,
+	0x46, 0x18, 0x50, 0x55, 0xd3, 0x2f, 0x5a, 0x3d, 0xa6, 0x48, 0xc5, 0x53, 0x55, 0xf8, 0x43, 0xc5,
+	0x74, 0x6e, 0xe2, 0x9b, 0x36, 0xe1, 0xe6, 0x09, 0xf6, 0x00, 0x00, 0x93, 0xa0, 0xf0, 0x87, 0x8a,
+	0x19, 0x1b, 0x30, 0x09, 0xac, 0xfc, 0x7c, 0xe9, 0xfc, 0xa9, 0x04, 0x1b, 0xf9, 0x63, 0x75, 0x7f,
+	0xf8, 0xdf, 0x1c, 0xcd, 0xba, 0x59, 0x09, 0xa6, 0xbe, 0x37, 0xa8, 0x9e, 0x4f, 0xbb, 0x86, 0x61,
+	0xab, 0xb1, 0xf3, 0x55, 0x09, 0x9c, 0x45, 0xbe, 0xe9, 0x82, 0xbf, 0x3b, 0x2f, 0x70, 0xef, 0x3c,
+	0x3c, 0xbe, 0xf9, 0x69, 0xd7, 0xdf, 0xf9, 0xd3, 0xca, 0x57, 0xfd, 0xb4, 0xca, 0xe2, 0x4f, 0x1b,
+	0xec, 0x7c, 0xf1, 0xaa, 0x53, 0xfa, 0xf2, 0x55, 0xa7, 0xf4, 0xcf, 0x57, 0x9d, 0xd2, 0x1f, 0x5e,
+	0x77, 0xae, 0x7d, 0xf9, 0xba, 0x73, 0xed, 0xab, 0xd7, 0x9d, 0x6b, 0xbf, 0xbc, 0x6f, 0x30, 0xf8,
+	0x3d, 0x5f, 0xa4, 0xd8, 0xcf, 0xd6, 0x7a, 0xe6, 0xe9, 0x4f, 0xe6, 0x7f, 0x7e, 0xd2, 0x7f, 0xac,
+	0x87, 0xcb, 0xf4, 0xa7, 0xe7, 0xf7, 0xff, 0x3b, 0x00, 0xe6, 0xba, 0xc6, 0xde, 0x86, 0x15, 0x00,
+	0x00,
 }
 
 func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
@@ -2217,6 +2371,102 @@ func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CounterpartyHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CounterpartyHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CounterpartyHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TransferTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3246,6 +3496,54 @@ func (m *Proof) Size() (n int) {
 	return n
 }
 
+func (m *CounterpartyHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTypes(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *ReceiptProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTypes(uint64(m.LogIndex))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TransferTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4989,6 +5287,310 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CounterpartyHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CounterpartyHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CounterpartyHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiptProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TransferTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
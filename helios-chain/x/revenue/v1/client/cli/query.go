@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryDeployerRevenues(),
 		GetCmdQueryWithdrawerRevenues(),
+		GetCmdQueryClaimableRevenue(),
 	)
 
 	return feesQueryCmd
@@ -212,3 +213,35 @@ func GetCmdQueryWithdrawerRevenues() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryClaimableRevenue implements a command that returns the transaction
+// fees accrued by a given withdraw address
+func GetCmdQueryClaimableRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claimable WITHDRAWER_ADDRESS",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the transaction fees accrued by a given withdrawer address",
+		Long:    "Query the transaction fees accrued by a given withdrawer address which can be claimed",
+		Example: fmt.Sprintf("%s query revenue claimable <withdrawer-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Query store
+			res, err := queryClient.ClaimableRevenue(context.Background(), &types.QueryClaimableRevenueRequest{
+				WithdrawerAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
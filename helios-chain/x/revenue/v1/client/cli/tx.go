@@ -6,6 +6,8 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -19,6 +21,9 @@ import (
 	types "helios-core/helios-chain/x/revenue/v1/types"
 )
 
+// FlagWithdrawers defines the weighted withdrawers of a contract
+const FlagWithdrawers = "withdrawers"
+
 // NewTxCmd returns a root CLI command handler for certain modules/revenue
 // transaction commands.
 func NewTxCmd() *cobra.Command {
@@ -34,6 +39,7 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterRevenue(),
 		NewCancelRevenue(),
 		NewUpdateRevenue(),
+		NewClaimRevenue(),
 	)
 	return txCmd
 }
@@ -78,17 +84,24 @@ func NewRegisterRevenue() *cobra.Command {
 				withdrawer = ""
 			}
 
+			withdrawers, err := parseWithdrawers(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRegisterRevenue{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
 				Nonces:            nonces,
+				Withdrawers:       withdrawers,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagWithdrawers, "", "weighted withdrawers splitting the fees, e.g. helios1...:3,helios1...:1")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -153,16 +166,72 @@ func NewUpdateRevenue() *cobra.Command {
 				return fmt.Errorf("invalid withdrawer bech32 address %w", err)
 			}
 
+			withdrawers, err := parseWithdrawers(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUpdateRevenue{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Withdrawers:       withdrawers,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagWithdrawers, "", "weighted withdrawers splitting the fees, e.g. helios1...:3,helios1...:1")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewClaimRevenue returns a CLI command handler for claiming the fees accrued
+// by a withdrawer
+func NewClaimRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Short: "Claim the transaction fees accrued by the withdrawer",
+		Long:  "Claim the transaction fees accrued by the withdrawer. The fees of the registered contracts accrue to their withdrawers until they are claimed.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimRevenue(cliCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseWithdrawers parses the weighted withdrawers flag formatted as ADDRESS:WEIGHT,...
+func parseWithdrawers(cmd *cobra.Command) ([]types.Withdrawer, error) {
+	value, err := cmd.Flags().GetString(FlagWithdrawers)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	var withdrawers []types.Withdrawer
+	for _, entry := range strings.Split(value, ",") {
+		address, weight, found := strings.Cut(strings.TrimSpace(entry), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid withdrawer %s, expected ADDRESS:WEIGHT", entry)
+		}
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return nil, fmt.Errorf("invalid withdrawer bech32 address %w", err)
+		}
+		w, err := strconv.ParseUint(weight, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid withdrawer weight %w", err)
+		}
+		withdrawers = append(withdrawers, types.Withdrawer{Address: address, Weight: w})
+	}
+	return withdrawers, types.ValidateWithdrawers(withdrawers)
+}
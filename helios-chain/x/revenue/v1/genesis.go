@@ -25,22 +25,23 @@ func InitGenesis(
 	for _, revenue := range data.Revenues {
 		contract := revenue.GetContractAddr()
 		deployer := revenue.GetDeployerAddr()
-		withdrawer := revenue.GetWithdrawerAddr()
 
 		// Set initial contracts receiving transaction fees
 		k.SetRevenue(ctx, revenue)
 		k.SetDeployerMap(ctx, deployer, contract)
+		k.SetWithdrawerMaps(ctx, revenue)
+	}
 
-		if len(withdrawer) != 0 {
-			k.SetWithdrawerMap(ctx, withdrawer, contract)
-		}
+	for _, claimable := range data.ClaimableRevenues {
+		k.SetClaimableRevenue(ctx, sdk.MustAccAddressFromBech32(claimable.WithdrawerAddress), claimable.Amount)
 	}
 }
 
 // ExportGenesis export module state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		Revenues: k.GetRevenues(ctx),
+		Params:            k.GetParams(ctx),
+		Revenues:          k.GetRevenues(ctx),
+		ClaimableRevenues: k.GetClaimableRevenues(ctx),
 	}
 }
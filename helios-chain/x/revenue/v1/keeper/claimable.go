@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:LGPL-3.0-only
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "helios-core/helios-chain/x/revenue/v1/types"
+)
+
+// GetClaimableRevenues returns the transaction fees accrued by all the withdrawers.
+func (k Keeper) GetClaimableRevenues(ctx sdk.Context) []types.ClaimableRevenue {
+	claimables := []types.ClaimableRevenue{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixClaimable)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claimable types.ClaimableRevenue
+		k.cdc.MustUnmarshal(iterator.Value(), &claimable)
+
+		claimables = append(claimables, claimable)
+	}
+
+	return claimables
+}
+
+// GetClaimableRevenue returns the transaction fees accrued by a withdrawer
+func (k Keeper) GetClaimableRevenue(ctx sdk.Context, withdrawer sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimable)
+	bz := store.Get(withdrawer.Bytes())
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var claimable types.ClaimableRevenue
+	k.cdc.MustUnmarshal(bz, &claimable)
+	return claimable.Amount
+}
+
+// SetClaimableRevenue stores the transaction fees accrued by a withdrawer, the entry is
+// deleted when the amount is empty
+func (k Keeper) SetClaimableRevenue(ctx sdk.Context, withdrawer sdk.AccAddress, amount sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimable)
+	if amount.IsZero() {
+		store.Delete(withdrawer.Bytes())
+		return
+	}
+
+	claimable := types.ClaimableRevenue{
+		WithdrawerAddress: withdrawer.String(),
+		Amount:            amount,
+	}
+	store.Set(withdrawer.Bytes(), k.cdc.MustMarshal(&claimable))
+}
+
+// AddClaimableRevenue adds the given amount to the transaction fees accrued by a withdrawer
+func (k Keeper) AddClaimableRevenue(ctx sdk.Context, withdrawer sdk.AccAddress, amount sdk.Coins) {
+	k.SetClaimableRevenue(ctx, withdrawer, k.GetClaimableRevenue(ctx, withdrawer).Add(amount...))
+}
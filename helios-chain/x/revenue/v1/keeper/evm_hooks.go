@@ -4,8 +4,7 @@
 package keeper
 
 import (
-	"fmt"
-
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -18,7 +17,10 @@ import (
 	types "helios-core/helios-chain/x/revenue/v1/types"
 )
 
-var _ evmtypes.EvmHooks = Hooks{}
+var (
+	_ evmtypes.EvmHooks        = Hooks{}
+	_ evmtypes.CallFramesHooks = Hooks{}
+)
 
 // Hooks wrapper struct for fees keeper
 type Hooks struct {
@@ -36,23 +38,43 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 	return h.k.PostTxProcessing(ctx, msg, receipt)
 }
 
+// RequireCallFrames is a wrapper for calling the EVM RequireCallFrames hook on
+// the module keeper
+func (h Hooks) RequireCallFrames(ctx sdk.Context, msg core.Message) bool {
+	return h.k.RequireCallFrames(ctx, msg)
+}
+
 func (h Hooks) PostContractCreation(ctx sdk.Context, contractAddress common.Address, deployerAddress sdk.AccAddress) error {
 	return h.k.PostContractCreation(ctx, contractAddress, deployerAddress)
 }
 
+// RequireCallFrames implements CallFramesHooks.RequireCallFrames. The call frames
+// are only recorded when the transaction pays fees to the developers and a contract
+// is registered, the fees are attributed without them otherwise.
+func (k Keeper) RequireCallFrames(ctx sdk.Context, msg core.Message) bool {
+	if msg.GasPrice().Sign() <= 0 {
+		return false
+	}
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue || params.DeveloperShares.IsZero() {
+		return false
+	}
+	return k.HasRevenues(ctx)
+}
+
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
-// interaction with a registered contract, the contract deployer (or, if set,
-// the withdraw address) receives a share from the transaction fees paid by the
-// transaction sender.
+// transaction, the developer share of the transaction fees paid by the sender is
+// attributed to the registered contracts whose code was executed, proportionally
+// to the gas used by their call frames. The share of each contract is split between
+// its withdrawers and accrues to their claimable revenue.
 func (k Keeper) PostTxProcessing(
 	ctx sdk.Context,
 	msg core.Message,
 	receipt *ethtypes.Receipt,
 ) error {
-	contract := msg.To()
 	// when baseFee and minGasPrice in freemarker module are both 0
 	// the user may send a transaction with gasPrice of 0 to the precompiled contract
-	if contract == nil || msg.GasPrice().Sign() <= 0 {
+	if msg.GasPrice().Sign() <= 0 {
 		return nil
 	}
 
@@ -63,61 +85,99 @@ func (k Keeper) PostTxProcessing(
 		return nil
 	}
 
-	evmParams := k.evmKeeper.GetParams(ctx)
-
-	var withdrawer sdk.AccAddress
-	containsPrecompile := slices.Contains(evmParams.ActiveStaticPrecompiles, contract.String())
-
-	if containsPrecompile {
-		return nil
+	frames := k.evmKeeper.GetCallFramesGasTransient(ctx, uint64(receipt.TransactionIndex))
+	if len(frames) == 0 {
+		// the call frames are not recorded, the fees go to the called contract
+		if msg.To() == nil {
+			return nil
+		}
+		frames = []evmtypes.CallFrameGas{{Address: *msg.To(), GasUsed: receipt.GasUsed}}
 	}
 
-	// if the contract is not a precompile, check if the contract is registered in the revenue module.
-	// else, return and avoid performing unnecessary logic
-	revenue, found := k.GetRevenue(ctx, *contract)
-	if !found {
-		return nil
+	totalGas := math.ZeroInt()
+	for _, frame := range frames {
+		totalGas = totalGas.Add(math.NewIntFromUint64(frame.GasUsed))
 	}
-
-	withdrawer = revenue.GetWithdrawerAddr()
-	if len(withdrawer) == 0 {
-		withdrawer = revenue.GetDeployerAddr()
+	if totalGas.IsZero() {
+		return nil
 	}
 
 	// calculate fees to be paid
 	txFee := math.NewIntFromUint64(receipt.GasUsed).Mul(math.NewIntFromBigInt(msg.GasPrice()))
+	evmParams := k.evmKeeper.GetParams(ctx)
+	feeDenom := evmParams.EvmDenom
+	if payment, ok := evmtypes.FeePaymentFromContext(ctx); ok {
+		// the fees have been paid with a fee token, the developers are paid with it too
+		txFee = payment.ConvertAmount(txFee.BigInt())
+		feeDenom = payment.Denom
+	}
 	developerFee := (params.DeveloperShares).MulInt(txFee).TruncateInt()
-	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
-	fees := sdk.Coins{{Denom: evmDenom, Amount: developerFee}}
-
-	// distribute the fees to the contract deployer / withdraw address
-	err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx,
-		k.feeCollectorName,
-		withdrawer,
-		fees,
-	)
-	if err != nil {
-		fmt.Println("Error distributing developer fees:", err)
-		// return errorsmod.Wrapf(
-		// 	err,
-		// 	"fee collector account failed to distribute developer fees (%s %s) to withdraw address %s. contract %s",
-		// 	fees, evmDenom, withdrawer, contract,
-		// )
+
+	type allocation struct {
+		contract   common.Address
+		withdrawer sdk.AccAddress
+		amount     math.Int
+	}
+	allocations := make([]allocation, 0, len(frames))
+	totalFees := math.ZeroInt()
+
+	for _, frame := range frames {
+		if frame.GasUsed == 0 || slices.Contains(evmParams.ActiveStaticPrecompiles, frame.Address.String()) {
+			continue
+		}
+
+		// only the registered contracts receive a share of the fees
+		revenue, found := k.GetRevenue(ctx, frame.Address)
+		if !found {
+			continue
+		}
+
+		contractFee := developerFee.Mul(math.NewIntFromUint64(frame.GasUsed)).Quo(totalGas)
+		if !contractFee.IsPositive() {
+			continue
+		}
+
+		withdrawers := revenue.EffectiveWithdrawers()
+		for i, amount := range types.SplitRevenue(contractFee, withdrawers) {
+			if !amount.IsPositive() {
+				continue
+			}
+			allocations = append(allocations, allocation{
+				contract:   frame.Address,
+				withdrawer: sdk.MustAccAddressFromBech32(withdrawers[i].Address),
+				amount:     amount,
+			})
+		}
+		totalFees = totalFees.Add(contractFee)
+	}
+
+	if !totalFees.IsPositive() {
 		return nil
 	}
 
-	ctx.EventManager().EmitEvents(
-		sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeDistributeDevRevenue,
-				sdk.NewAttribute(sdk.AttributeKeySender, msg.From().String()),
-				sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
-				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, developerFee.String()),
-			),
-		},
-	)
+	// the fees are held by the module account until they are claimed
+	fees := sdk.Coins{{Denom: feeDenom, Amount: totalFees}}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+		return errorsmod.Wrapf(
+			err,
+			"fee collector account failed to transfer developer fees (%s) to the revenue module",
+			fees,
+		)
+	}
+
+	events := make(sdk.Events, 0, len(allocations))
+	for _, alloc := range allocations {
+		k.AddClaimableRevenue(ctx, alloc.withdrawer, sdk.Coins{{Denom: feeDenom, Amount: alloc.amount}})
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDistributeDevRevenue,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From().String()),
+			sdk.NewAttribute(types.AttributeKeyContract, alloc.contract.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, alloc.withdrawer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, alloc.amount.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
 
 	return nil
 }
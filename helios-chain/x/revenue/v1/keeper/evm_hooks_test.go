@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	testkeyring "helios-core/helios-chain/testutil/integration/evmos/keyring"
+	"helios-core/helios-chain/testutil/integration/evmos/network"
+	utiltx "helios-core/helios-chain/testutil/tx"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+	types "helios-core/helios-chain/x/revenue/v1/types"
+)
+
+func TestPostTxProcessingWithFeeToken(t *testing.T) {
+	// one EVM coin is worth 10^6 units of the fee token
+	payment := evmtypes.FeePayment{Denom: "ausdt", ConversionRate: sdkmath.LegacyNewDec(1_000_000)}
+
+	// the fees paid with the fee token are held by the fee collector
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(payment.Denom, sdkmath.NewInt(1e6))),
+		},
+	}
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	keyring := testkeyring.New(2)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	ctx := evmtypes.ContextWithFeePayment(nw.GetContext(), payment)
+	revenueKeeper := nw.App.RevenueKeeper
+
+	params := revenueKeeper.GetParams(ctx)
+	params.EnableRevenue = true
+	params.DeveloperShares = sdkmath.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, revenueKeeper.SetParams(ctx, params))
+
+	contract := utiltx.GenerateAddress()
+	withdrawer := keyring.GetAccAddr(1)
+	revenueKeeper.SetRevenue(ctx, types.NewRevenue(contract, keyring.GetAccAddr(0), withdrawer))
+
+	msg := ethtypes.NewMessage(
+		keyring.GetAddr(0), &contract, 0, big.NewInt(0), 100_000,
+		big.NewInt(1e9), big.NewInt(1e9), big.NewInt(1e9), nil, nil, false,
+	)
+	receipt := &ethtypes.Receipt{GasUsed: 100_000}
+
+	require.NoError(t, revenueKeeper.PostTxProcessing(ctx, msg, receipt))
+
+	// the fee of 10^14 in the EVM denom is worth 100 units of the fee token, half of it
+	// goes to the developers
+	expFees := sdk.NewCoins(sdk.NewCoin(payment.Denom, sdkmath.NewInt(50)))
+	require.Equal(t, expFees, revenueKeeper.GetClaimableRevenue(ctx, withdrawer))
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	require.Equal(t, expFees, nw.App.BankKeeper.GetAllBalances(ctx, moduleAddr))
+}
@@ -194,3 +194,27 @@ func (k Keeper) WithdrawerRevenues( //nolint: dupl
 		Pagination:        pageRes,
 	}, nil
 }
+
+// ClaimableRevenue returns the transaction fees accrued by a given withdraw address
+func (k Keeper) ClaimableRevenue(
+	c context.Context,
+	req *types.QueryClaimableRevenueRequest,
+) (*types.QueryClaimableRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32 ('evmos...')", req.WithdrawerAddress,
+		)
+	}
+
+	return &types.QueryClaimableRevenueResponse{
+		Amount: k.GetClaimableRevenue(ctx, withdrawer),
+	}, nil
+}
@@ -154,13 +154,13 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 		})
 
 		It("should not distribute tx fees for previously registered contracts", func() {
-			preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+			preBalance := getClaimableRevenue(deployerAddress, denom)
 			gasPrice := big.NewInt(2000000000)
 			data := make([]byte, 0)
 			contractInteract(userKey, &registeredContract, gasPrice, nil, nil, data, nil)
 			s.Commit()
 
-			balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+			balance := getClaimableRevenue(deployerAddress, denom)
 			Expect(balance).To(Equal(preBalance))
 		})
 
@@ -233,14 +233,14 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 				})
 
 				It("should result in sending the tx fees to the deployer address", func() {
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getClaimableRevenue(deployerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					data := make([]byte, 0)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 					s.Commit()
 
 					developerCoins, _ := calculateFees(denom, params, res, gasPrice)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getClaimableRevenue(deployerAddress, denom)
 					Expect(developerCoins.IsPositive()).To(BeTrue())
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 				})
@@ -332,14 +332,14 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 				})
 
 				It("should send the fees to the withdraw address", func() {
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
+					preBalance := getClaimableRevenue(withdrawerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					data := make([]byte, 0)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 					s.Commit()
 
 					developerCoins, _ := calculateFees(denom, params, res, gasPrice)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
+					balance := getClaimableRevenue(withdrawerAddress, denom)
 					Expect(developerCoins.IsPositive()).To(BeTrue())
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 				})
@@ -377,14 +377,14 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 				It("should transfer legacy tx fees to validators and contract developer evenly", func() {
 					preFeeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getClaimableRevenue(deployerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					data := make([]byte, 0)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 
 					developerCoins, validatorCoins := calculateFees(denom, params, res, gasPrice)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getClaimableRevenue(deployerAddress, denom)
 
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					Expect(feeColectorBalance).To(Equal(
@@ -395,7 +395,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 				It("should transfer dynamic tx fees to validators and contract developer evenly", func() {
 					preFeeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getClaimableRevenue(deployerAddress, denom)
 					gasTipCap := big.NewInt(10000)
 					gasFeeCap := new(big.Int).Add(s.app.FeeMarketKeeper.GetBaseFee(s.ctx).BigInt(), gasTipCap)
 					data := make([]byte, 0)
@@ -411,7 +411,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 					developerCoins, validatorCoins := calculateFees(denom, params, res, gasFeeCap)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getClaimableRevenue(deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					Expect(feeColectorBalance).To(Equal(preFeeColectorBalance.Add(validatorCoins)))
 					s.Commit()
@@ -427,7 +427,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 				It("should transfer all tx fees to validators", func() {
 					preFeeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getClaimableRevenue(deployerAddress, denom)
 					gasTipCap := big.NewInt(10000)
 					gasFeeCap := new(big.Int).Add(s.app.FeeMarketKeeper.GetBaseFee(s.ctx).BigInt(), gasTipCap)
 					data := make([]byte, 0)
@@ -443,7 +443,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 					_, validatorCoins := calculateFees(denom, params, res, gasFeeCap)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getClaimableRevenue(deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance))
 					Expect(feeColectorBalance).To(Equal(preFeeColectorBalance.Add(validatorCoins)))
 					s.Commit()
@@ -459,7 +459,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 				It("should transfer all tx fees to developers", func() {
 					preFeeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getClaimableRevenue(deployerAddress, denom)
 					gasTipCap := big.NewInt(10000)
 					gasFeeCap := new(big.Int).Add(s.app.FeeMarketKeeper.GetBaseFee(s.ctx).BigInt(), gasTipCap)
 					data := make([]byte, 0)
@@ -475,7 +475,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 					developerCoins, _ := calculateFees(denom, params, res, gasFeeCap)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getClaimableRevenue(deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					Expect(feeColectorBalance).To(Equal(preFeeColectorBalance))
 					s.Commit()
@@ -538,16 +538,16 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 				})
 
 				It("should send tx fees to the new withdraw address", func() {
-					preBalanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
-					preBalanceW := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
+					preBalanceD := getClaimableRevenue(deployerAddress, denom)
+					preBalanceW := getClaimableRevenue(withdrawerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					data := make([]byte, 0)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 					s.Commit()
 
 					developerCoins, _ := calculateFees(denom, params, res, gasPrice)
-					balanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
-					balanceW := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
+					balanceD := getClaimableRevenue(deployerAddress, denom)
+					balanceW := getClaimableRevenue(withdrawerAddress, denom)
 					Expect(balanceW).To(Equal(preBalanceW.Add(developerCoins)))
 					Expect(balanceD).To(Equal(preBalanceD))
 				})
@@ -669,13 +669,13 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 				})
 
 				It("should no longer distribute fees to the contract deployer", func() {
-					preBalanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalanceD := getClaimableRevenue(deployerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					data := make([]byte, 0)
 					contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 					s.Commit()
 
-					balanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balanceD := getClaimableRevenue(deployerAddress, denom)
 					Expect(balanceD).To(Equal(preBalanceD))
 				})
 			})
@@ -736,7 +736,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 				})
 
 				It("should transfer legacy tx fees evenly to validator and deployer", func() {
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getClaimableRevenue(deployerAddress, denom)
 
 					// User interaction with registered contract
 					gasPrice := big.NewInt(2000000000)
@@ -744,13 +744,13 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 
 					developerCoins, _ := calculateFees(denom, params, res, gasPrice)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getClaimableRevenue(deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					s.Commit()
 				})
 
 				It("should transfer dynamic tx fees evenly to validator and deployer", func() {
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getClaimableRevenue(deployerAddress, denom)
 
 					// User interaction with registered contract
 					gasTipCap := big.NewInt(10000)
@@ -767,7 +767,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					)
 
 					developerCoins, _ := calculateFees(denom, params, res, gasFeeCap)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getClaimableRevenue(deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					s.Commit()
 				})
@@ -5,6 +5,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -98,8 +99,10 @@ func (k Keeper) RegisterRevenue(
 
 	// prevent storing the same address for deployer and withdrawer
 	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	revenue.Withdrawers = msg.Withdrawers
 	k.SetRevenue(ctx, revenue)
 	k.SetDeployerMap(ctx, deployer, contract)
+	k.SetWithdrawerMaps(ctx, revenue)
 
 	// The effective withdrawer is the withdraw address that is stored after the
 	// revenue registration is completed. It defaults to the deployer address if
@@ -108,7 +111,6 @@ func (k Keeper) RegisterRevenue(
 	effectiveWithdrawer := msg.DeployerAddress
 
 	if len(withdrawer) != 0 {
-		k.SetWithdrawerMap(ctx, withdrawer, contract)
 		effectiveWithdrawer = msg.WithdrawerAddress
 	}
 
@@ -168,30 +170,22 @@ func (k Keeper) UpdateRevenue(
 		msg.WithdrawerAddress = ""
 	}
 
-	// revenue with the given withdraw address is already registered
-	if msg.WithdrawerAddress == revenue.WithdrawerAddress {
+	// revenue with the given withdrawers is already registered
+	if msg.WithdrawerAddress == revenue.WithdrawerAddress && slices.Equal(msg.Withdrawers, revenue.Withdrawers) {
 		return nil, errorsmod.Wrapf(
 			types.ErrRevenueAlreadyRegistered,
 			"revenue with withdraw address %s", msg.WithdrawerAddress,
 		)
 	}
 
-	// only delete withdrawer map if is not default
-	if revenue.WithdrawerAddress != "" {
-		k.DeleteWithdrawerMap(ctx, sdk.MustAccAddressFromBech32(revenue.WithdrawerAddress), contract)
-	}
+	// replace the withdrawer maps of the previous withdrawers
+	k.DeleteWithdrawerMaps(ctx, revenue)
 
-	// only add withdrawer map if new entry is not default
-	if msg.WithdrawerAddress != "" {
-		k.SetWithdrawerMap(
-			ctx,
-			sdk.MustAccAddressFromBech32(msg.WithdrawerAddress),
-			contract,
-		)
-	}
 	// update revenue
 	revenue.WithdrawerAddress = msg.WithdrawerAddress
+	revenue.Withdrawers = msg.Withdrawers
 	k.SetRevenue(ctx, revenue)
+	k.SetWithdrawerMaps(ctx, revenue)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -243,14 +237,8 @@ func (k Keeper) CancelRevenue(
 		contract,
 	)
 
-	// delete entry from withdrawer map if not default
-	if fee.WithdrawerAddress != "" {
-		k.DeleteWithdrawerMap(
-			ctx,
-			fee.GetWithdrawerAddr(),
-			contract,
-		)
-	}
+	// delete the entries from withdrawer map
+	k.DeleteWithdrawerMaps(ctx, fee)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -265,6 +253,40 @@ func (k Keeper) CancelRevenue(
 	return &types.MsgCancelRevenueResponse{}, nil
 }
 
+// ClaimRevenue sends the transaction fees accrued by a withdrawer to its account
+func (k Keeper) ClaimRevenue(
+	goCtx context.Context,
+	msg *types.MsgClaimRevenue,
+) (*types.MsgClaimRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawer := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+	amount := k.GetClaimableRevenue(ctx, withdrawer)
+	if amount.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoClaimableRevenue,
+			"withdrawer %s", msg.WithdrawerAddress,
+		)
+	}
+
+	k.SetClaimableRevenue(ctx, withdrawer, sdk.Coins{})
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, amount); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to send claimed revenue to %s", msg.WithdrawerAddress)
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaimRevenue,
+				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		},
+	)
+
+	return &types.MsgClaimRevenueResponse{Amount: amount}, nil
+}
+
 // UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
 // proposal passes, it updates the module parameters. The update can only be
 // performed if the requested authority is the Cosmos SDK governance module
@@ -50,6 +50,15 @@ func (k Keeper) IterateRevenues(
 	}
 }
 
+// HasRevenues returns true if at least one contract is registered.
+func (k Keeper) HasRevenues(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRevenue)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
 // GetRevenue returns the Revenue for a registered contract
 func (k Keeper) GetRevenue(
 	ctx sdk.Context,
@@ -125,6 +134,22 @@ func (k Keeper) DeleteWithdrawerMap(
 	store.Delete(key)
 }
 
+// SetWithdrawerMaps stores the contract-by-withdrawer mappings of all the withdrawers
+// of a revenue other than the deployer
+func (k Keeper) SetWithdrawerMaps(ctx sdk.Context, revenue types.Revenue) {
+	for _, withdrawer := range revenue.GetWithdrawerMapAddrs() {
+		k.SetWithdrawerMap(ctx, withdrawer, revenue.GetContractAddr())
+	}
+}
+
+// DeleteWithdrawerMaps deletes the contract-by-withdrawer mappings of all the withdrawers
+// of a revenue
+func (k Keeper) DeleteWithdrawerMaps(ctx sdk.Context, revenue types.Revenue) {
+	for _, withdrawer := range revenue.GetWithdrawerMapAddrs() {
+		k.DeleteWithdrawerMap(ctx, withdrawer, revenue.GetContractAddr())
+	}
+}
+
 // IsRevenueRegistered checks if a contract was registered for receiving
 // transaction fees
 func (k Keeper) IsRevenueRegistered(
@@ -139,6 +139,11 @@ func getNonce(addressBytes []byte) uint64 {
 	)
 }
 
+func getClaimableRevenue(addr sdk.AccAddress, denom string) sdk.Coin {
+	keeper := getTestKeeper()
+	return sdk.NewCoin(denom, keeper.app.RevenueKeeper.GetClaimableRevenue(keeper.ctx, addr).AmountOf(denom))
+}
+
 func registerFee(
 	priv *ethsecp256k1.PrivKey,
 	contractAddress *common.Address,
@@ -29,6 +29,7 @@ const (
 	cancelRevenueName   = "evmos/MsgCancelRevenue"
 	registerRevenueName = "evmos/MsgRegisterRevenue"
 	updateRevenueName   = "evmos/MsgUpdateRevenue"
+	claimRevenueName    = "evmos/MsgClaimRevenue"
 	updateParamsName    = "evmos/MsgUpdateParams"
 )
 
@@ -45,6 +46,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterRevenue{},
 		&MsgCancelRevenue{},
 		&MsgUpdateRevenue{},
+		&MsgClaimRevenue{},
 		&MsgUpdateParams{},
 	)
 
@@ -59,4 +61,5 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelRevenue{}, cancelRevenueName, nil)
 	cdc.RegisterConcrete(&MsgRegisterRevenue{}, registerRevenueName, nil)
 	cdc.RegisterConcrete(&MsgUpdateRevenue{}, updateRevenueName, nil)
+	cdc.RegisterConcrete(&MsgClaimRevenue{}, claimRevenueName, nil)
 }
@@ -23,11 +23,12 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(4, len(impls))
+	suite.Require().Equal(5, len(impls))
 	suite.Require().ElementsMatch([]string{
-		"/evmos.revenue.v1.MsgRegisterRevenue",
-		"/evmos.revenue.v1.MsgCancelRevenue",
-		"/evmos.revenue.v1.MsgUpdateRevenue",
-		"/evmos.revenue.v1.MsgUpdateParams",
+		"/helios.revenue.v1.MsgRegisterRevenue",
+		"/helios.revenue.v1.MsgCancelRevenue",
+		"/helios.revenue.v1.MsgUpdateRevenue",
+		"/helios.revenue.v1.MsgClaimRevenue",
+		"/helios.revenue.v1.MsgUpdateParams",
 	}, impls)
 }
@@ -8,6 +8,7 @@ const (
 	EventTypeCancelRevenue        = "cancel_revenue"
 	EventTypeUpdateRevenue        = "update_revenue"
 	EventTypeDistributeDevRevenue = "distribute_dev_revenue"
+	EventTypeClaimRevenue         = "claim_revenue"
 
 	AttributeKeyContract          = "contract"
 	AttributeKeyWithdrawerAddress = "withdrawer_address"
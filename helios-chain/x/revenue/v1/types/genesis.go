@@ -38,5 +38,18 @@ func (gs GenesisState) Validate() error {
 		seenContract[fs.ContractAddress] = true
 	}
 
+	seenWithdrawer := make(map[string]bool)
+	for _, claimable := range gs.ClaimableRevenues {
+		if seenWithdrawer[claimable.WithdrawerAddress] {
+			return fmt.Errorf("claimable revenue duplicated on genesis '%s'", claimable.WithdrawerAddress)
+		}
+
+		if err := claimable.Validate(); err != nil {
+			return err
+		}
+
+		seenWithdrawer[claimable.WithdrawerAddress] = true
+	}
+
 	return gs.Params.Validate()
 }
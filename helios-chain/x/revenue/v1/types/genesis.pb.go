@@ -30,6 +30,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// revenues is a slice of active registered contracts for fee distribution
 	Revenues []Revenue `protobuf:"bytes,2,rep,name=revenues,proto3" json:"revenues"`
+	// claimable_revenues are the transaction fees accrued by the withdrawers
+	ClaimableRevenues []ClaimableRevenue `protobuf:"bytes,3,rep,name=claimable_revenues,json=claimableRevenues,proto3" json:"claimable_revenues"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +81,13 @@ func (m *GenesisState) GetRevenues() []Revenue {
 	return nil
 }
 
+func (m *GenesisState) GetClaimableRevenues() []ClaimableRevenue {
+	if m != nil {
+		return m.ClaimableRevenues
+	}
+	return nil
+}
+
 // Params defines the revenue module params
 type Params struct {
 	// enable_revenue defines a parameter to enable the revenue module
@@ -146,30 +155,31 @@ func init() {
 func init() { proto.RegisterFile("helios/revenue/v1/genesis.proto", fileDescriptor_200c3a2b77cb5d45) }
 
 var fileDescriptor_200c3a2b77cb5d45 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x14, 0xc6, 0x33, 0xb7, 0xa5, 0xf4, 0x4e, 0xef, 0xf5, 0x4f, 0x70, 0x11, 0x5b, 0x48, 0x4b, 0x45,
-	0x08, 0x88, 0x19, 0x5a, 0x17, 0x6e, 0x74, 0xd3, 0x56, 0xdc, 0x88, 0x48, 0xba, 0x73, 0x13, 0xa6,
-	0xc9, 0x21, 0x09, 0xb6, 0x99, 0x30, 0x33, 0x06, 0xfb, 0x00, 0xee, 0x7d, 0x22, 0xd7, 0x5d, 0x76,
-	0x29, 0x2e, 0x8a, 0xb4, 0x2f, 0x22, 0x9d, 0x49, 0x8b, 0x60, 0x77, 0x1f, 0x73, 0x7e, 0xbf, 0xef,
-	0x84, 0x1c, 0xdc, 0x8c, 0x61, 0x9c, 0x30, 0x41, 0x38, 0xe4, 0x90, 0x3e, 0x03, 0xc9, 0x3b, 0x24,
-	0x82, 0x14, 0x44, 0x22, 0xdc, 0x8c, 0x33, 0xc9, 0xcc, 0x43, 0x0d, 0xb8, 0x05, 0xe0, 0xe6, 0x9d,
-	0xfa, 0x0e, 0x67, 0x33, 0x55, 0x4e, 0xfd, 0x28, 0x62, 0x11, 0x53, 0x91, 0xac, 0x93, 0x7e, 0x6d,
-	0xbf, 0x22, 0xfc, 0xef, 0x56, 0x77, 0x0f, 0x25, 0x95, 0x60, 0x5e, 0xe2, 0x4a, 0x46, 0x39, 0x9d,
-	0x08, 0x0b, 0xb5, 0x90, 0x53, 0xeb, 0x1e, 0xbb, 0xbf, 0x76, 0xb9, 0x0f, 0x0a, 0xe8, 0x95, 0x67,
-	0x8b, 0xa6, 0xe1, 0x15, 0xb8, 0x79, 0x85, 0xab, 0x05, 0x22, 0xac, 0x3f, 0xad, 0x92, 0x53, 0xeb,
-	0xd6, 0x77, 0xa8, 0x9e, 0x8e, 0x85, 0xbb, 0x35, 0xda, 0xef, 0x08, 0x57, 0x74, 0xad, 0x79, 0x8a,
-	0xf7, 0x20, 0xa5, 0xa3, 0x31, 0xf8, 0xc5, 0x54, 0x7d, 0x49, 0xd5, 0xfb, 0xaf, 0x5f, 0x8b, 0x06,
-	0xf3, 0x1e, 0x1f, 0x84, 0x90, 0xc3, 0x98, 0x65, 0xc0, 0x7d, 0x11, 0x53, 0xae, 0xf6, 0x22, 0xe7,
-	0x6f, 0xef, 0x64, 0xdd, 0xfd, 0xb9, 0x68, 0x36, 0x02, 0x26, 0x26, 0x4c, 0x88, 0xf0, 0xc9, 0x4d,
-	0x18, 0x99, 0x50, 0x19, 0xbb, 0x77, 0x10, 0xd1, 0x60, 0x3a, 0x80, 0xc0, 0xdb, 0xdf, 0xca, 0x43,
-	0xe5, 0x9a, 0xd7, 0xb8, 0x41, 0xc3, 0x90, 0xfb, 0x21, 0xf0, 0x24, 0xa7, 0x32, 0x61, 0xa9, 0x1f,
-	0x30, 0x21, 0xfd, 0x80, 0x03, 0x95, 0x60, 0x95, 0x5a, 0xc8, 0x29, 0x7b, 0xd6, 0x1a, 0x19, 0x6c,
-	0x89, 0x3e, 0x13, 0xb2, 0xaf, 0xe6, 0xbd, 0x9b, 0xd9, 0xd2, 0x46, 0xf3, 0xa5, 0x8d, 0xbe, 0x96,
-	0x36, 0x7a, 0x5b, 0xd9, 0xc6, 0x7c, 0x65, 0x1b, 0x1f, 0x2b, 0xdb, 0x78, 0x3c, 0xd3, 0x7f, 0xe1,
-	0x3c, 0x60, 0x1c, 0xc8, 0x26, 0xc7, 0x34, 0x49, 0xc9, 0xcb, 0xcf, 0x6b, 0xc9, 0x69, 0x06, 0x62,
-	0x54, 0x51, 0x67, 0xb9, 0xf8, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xca, 0x09, 0x0a, 0xed, 0x03, 0x02,
-	0x00, 0x00,
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x8b, 0xda, 0x40,
+	0x18, 0xc6, 0x33, 0x55, 0xc4, 0x8e, 0xfd, 0xe7, 0xd0, 0x43, 0xaa, 0x10, 0x45, 0x29, 0x04, 0x4a,
+	0x13, 0xb4, 0x87, 0x5e, 0xda, 0x8b, 0x5a, 0x7a, 0x29, 0xa5, 0xc4, 0x4b, 0xe9, 0x25, 0x8c, 0x93,
+	0x97, 0x24, 0x34, 0xc9, 0x84, 0x99, 0x69, 0xa8, 0xdf, 0xa2, 0x9f, 0xa8, 0x67, 0x8f, 0x1e, 0x97,
+	0x3d, 0xb8, 0x8b, 0x7e, 0x91, 0xc5, 0x49, 0x0c, 0xee, 0xae, 0xb7, 0x87, 0x99, 0xdf, 0xef, 0x79,
+	0xf3, 0x26, 0xc1, 0x83, 0x08, 0x92, 0x98, 0x4b, 0x57, 0x40, 0x01, 0xd9, 0x1f, 0x70, 0x8b, 0x89,
+	0x1b, 0x42, 0x06, 0x32, 0x96, 0x4e, 0x2e, 0xb8, 0xe2, 0xa4, 0x5b, 0x02, 0x4e, 0x05, 0x38, 0xc5,
+	0xa4, 0x77, 0xc1, 0x39, 0xdd, 0x6a, 0xa7, 0xf7, 0x3a, 0xe4, 0x21, 0xd7, 0xd1, 0x3d, 0xa6, 0xf2,
+	0x74, 0x74, 0x83, 0xf0, 0xb3, 0xaf, 0x65, 0xf7, 0x52, 0x51, 0x05, 0xe4, 0x23, 0x6e, 0xe5, 0x54,
+	0xd0, 0x54, 0x9a, 0x68, 0x88, 0xec, 0xce, 0xf4, 0x8d, 0xf3, 0x68, 0x96, 0xf3, 0x43, 0x03, 0xb3,
+	0xe6, 0x66, 0x37, 0x30, 0xbc, 0x0a, 0x27, 0x9f, 0x70, 0xbb, 0x42, 0xa4, 0xf9, 0x64, 0xd8, 0xb0,
+	0x3b, 0xd3, 0xde, 0x05, 0xd5, 0x2b, 0x63, 0xe5, 0xd6, 0x06, 0xf9, 0x89, 0x09, 0x4b, 0x68, 0x9c,
+	0xd2, 0x55, 0x02, 0x7e, 0xdd, 0xd3, 0xd0, 0x3d, 0xe3, 0x0b, 0x3d, 0xf3, 0x13, 0x7c, 0xbf, 0xb0,
+	0xcb, 0x1e, 0x9c, 0xcb, 0xd1, 0x7f, 0x84, 0x5b, 0xe5, 0x03, 0x93, 0xb7, 0xf8, 0x05, 0x64, 0xe7,
+	0x13, 0xf4, 0x8e, 0x6d, 0xef, 0x39, 0x64, 0x67, 0x0a, 0xf9, 0x8e, 0x5f, 0x05, 0x50, 0x40, 0xc2,
+	0x73, 0x10, 0xbe, 0x8c, 0xa8, 0xd0, 0x1b, 0x21, 0xfb, 0xe9, 0x6c, 0x7c, 0x1c, 0x72, 0xbd, 0x1b,
+	0xf4, 0x19, 0x97, 0x29, 0x97, 0x32, 0xf8, 0xed, 0xc4, 0xdc, 0x4d, 0xa9, 0x8a, 0x9c, 0x6f, 0x10,
+	0x52, 0xb6, 0x5e, 0x00, 0xf3, 0x5e, 0xd6, 0xf2, 0x52, 0xbb, 0xe4, 0x33, 0xee, 0xd3, 0x20, 0x10,
+	0x7e, 0x00, 0x22, 0x2e, 0xa8, 0x8a, 0x79, 0xe6, 0x33, 0x2e, 0x95, 0xcf, 0x04, 0x50, 0x05, 0x66,
+	0x63, 0x88, 0xec, 0xa6, 0x67, 0x1e, 0x91, 0x45, 0x4d, 0xcc, 0xb9, 0x54, 0x73, 0x7d, 0x3f, 0xfb,
+	0xb2, 0xd9, 0x5b, 0x68, 0xbb, 0xb7, 0xd0, 0xed, 0xde, 0x42, 0xff, 0x0e, 0x96, 0xb1, 0x3d, 0x58,
+	0xc6, 0xd5, 0xc1, 0x32, 0x7e, 0xbd, 0x2b, 0xdf, 0xcb, 0x7b, 0xc6, 0x05, 0xb8, 0xa7, 0x1c, 0xd1,
+	0x38, 0x73, 0xff, 0x9e, 0xff, 0x07, 0x6a, 0x9d, 0x83, 0x5c, 0xb5, 0xf4, 0x07, 0xff, 0x70, 0x37,
+	0x00, 0xe2, 0x84, 0x42, 0x12, 0x5d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -192,6 +202,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimableRevenues) > 0 {
+		for iNdEx := len(m.ClaimableRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Revenues) > 0 {
 		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -292,6 +316,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClaimableRevenues) > 0 {
+		for _, e := range m.ClaimableRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -414,6 +444,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableRevenues = append(m.ClaimableRevenues, ClaimableRevenue{})
+			if err := m.ClaimableRevenues[len(m.ClaimableRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
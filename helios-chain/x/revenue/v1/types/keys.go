@@ -22,6 +22,7 @@ const (
 	prefixRevenue = iota + 1
 	prefixDeployer
 	prefixWithdrawer
+	prefixClaimable
 )
 
 // KVStore key prefixes
@@ -29,6 +30,7 @@ var (
 	KeyPrefixRevenue    = []byte{prefixRevenue}
 	KeyPrefixDeployer   = []byte{prefixDeployer}
 	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
+	KeyPrefixClaimable  = []byte{prefixClaimable}
 )
 
 // GetKeyPrefixDeployer returns the KVStore key prefix for storing
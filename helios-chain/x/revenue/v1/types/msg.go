@@ -16,6 +16,7 @@ var (
 	_ sdk.Msg = &MsgRegisterRevenue{}
 	_ sdk.Msg = &MsgCancelRevenue{}
 	_ sdk.Msg = &MsgUpdateRevenue{}
+	_ sdk.Msg = &MsgClaimRevenue{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -23,6 +24,7 @@ const (
 	TypeMsgRegisterRevenue = "register_revenue"
 	TypeMsgCancelRevenue   = "cancel_revenue"
 	TypeMsgUpdateRevenue   = "update_revenue"
+	TypeMsgClaimRevenue    = "claim_revenue"
 )
 
 // NewMsgRegisterRevenue creates new instance of MsgRegisterRevenue
@@ -75,6 +77,10 @@ func (msg MsgRegisterRevenue) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - array length must be less than 20")
 	}
 
+	if err := ValidateWithdrawers(msg.Withdrawers); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -163,6 +169,10 @@ func (msg MsgUpdateRevenue) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
 	}
 
+	if err := ValidateWithdrawers(msg.Withdrawers); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -177,6 +187,39 @@ func (msg MsgUpdateRevenue) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// NewMsgClaimRevenue creates new instance of MsgClaimRevenue
+func NewMsgClaimRevenue(withdrawer sdk.AccAddress) *MsgClaimRevenue {
+	return &MsgClaimRevenue{
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgClaimRevenue) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgClaimRevenue) Type() string { return TypeMsgClaimRevenue }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClaimRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClaimRevenue) GetSigners() []sdk.AccAddress {
+	from := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+	return []sdk.AccAddress{from}
+}
+
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(m.Authority)
@@ -292,3 +292,60 @@ func (suite *MsgsTestSuite) TestMsgUpdateRevenueNew() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgClaimRevenueGetters() {
+	msgInvalid := types.MsgClaimRevenue{}
+	msg := types.NewMsgClaimRevenue(suite.deployer)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgClaimRevenue, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Error(msgInvalid.ValidateBasic())
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateRevenueWithdrawers() {
+	testCases := []struct {
+		msg         string
+		withdrawers []types.Withdrawer
+		expectPass  bool
+	}{
+		{
+			"weighted withdrawers - pass",
+			[]types.Withdrawer{{Address: suite.deployerStr, Weight: 3}, {Address: suite.withdrawerStr, Weight: 1}},
+			true,
+		},
+		{
+			"zero weight",
+			[]types.Withdrawer{{Address: suite.withdrawerStr, Weight: 0}},
+			false,
+		},
+		{
+			"duplicated withdrawer",
+			[]types.Withdrawer{{Address: suite.withdrawerStr, Weight: 1}, {Address: suite.withdrawerStr, Weight: 2}},
+			false,
+		},
+		{
+			"invalid withdrawer address",
+			[]types.Withdrawer{{Address: "withdraw", Weight: 1}},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := types.MsgUpdateRevenue{
+			ContractAddress:   suite.contract.String(),
+			DeployerAddress:   suite.deployerStr,
+			WithdrawerAddress: suite.withdrawerStr,
+			Withdrawers:       tc.withdrawers,
+		}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -531,6 +533,98 @@ func (m *QueryWithdrawerRevenuesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryClaimableRevenueRequest is the request type for the Query/ClaimableRevenue RPC method.
+type QueryClaimableRevenueRequest struct {
+	// withdrawer_address in bech32 format
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *QueryClaimableRevenueRequest) Reset()         { *m = QueryClaimableRevenueRequest{} }
+func (m *QueryClaimableRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRevenueRequest) ProtoMessage()    {}
+func (*QueryClaimableRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8438772b21077cb8, []int{10}
+}
+func (m *QueryClaimableRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRevenueRequest.Merge(m, src)
+}
+func (m *QueryClaimableRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableRevenueRequest) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// QueryClaimableRevenueResponse is the response type for the Query/ClaimableRevenue RPC method.
+type QueryClaimableRevenueResponse struct {
+	// amount is the transaction fees accrued by the withdrawer
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryClaimableRevenueResponse) Reset()         { *m = QueryClaimableRevenueResponse{} }
+func (m *QueryClaimableRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRevenueResponse) ProtoMessage()    {}
+func (*QueryClaimableRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8438772b21077cb8, []int{11}
+}
+func (m *QueryClaimableRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRevenueResponse.Merge(m, src)
+}
+func (m *QueryClaimableRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableRevenueResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryRevenuesRequest)(nil), "helios.revenue.v1.QueryRevenuesRequest")
 	proto.RegisterType((*QueryRevenuesResponse)(nil), "helios.revenue.v1.QueryRevenuesResponse")
@@ -542,55 +636,65 @@ func init() {
 	proto.RegisterType((*QueryDeployerRevenuesResponse)(nil), "helios.revenue.v1.QueryDeployerRevenuesResponse")
 	proto.RegisterType((*QueryWithdrawerRevenuesRequest)(nil), "helios.revenue.v1.QueryWithdrawerRevenuesRequest")
 	proto.RegisterType((*QueryWithdrawerRevenuesResponse)(nil), "helios.revenue.v1.QueryWithdrawerRevenuesResponse")
+	proto.RegisterType((*QueryClaimableRevenueRequest)(nil), "helios.revenue.v1.QueryClaimableRevenueRequest")
+	proto.RegisterType((*QueryClaimableRevenueResponse)(nil), "helios.revenue.v1.QueryClaimableRevenueResponse")
 }
 
 func init() { proto.RegisterFile("helios/revenue/v1/query.proto", fileDescriptor_8438772b21077cb8) }
 
 var fileDescriptor_8438772b21077cb8 = []byte{
-	// 688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xc7, 0x77, 0x50, 0x17, 0x78, 0x1c, 0x84, 0x71, 0x4d, 0xa0, 0x40, 0xc1, 0x1a, 0x81, 0x68,
-	0xe8, 0xb0, 0x6b, 0xa2, 0xc6, 0x78, 0x11, 0x54, 0x6e, 0x06, 0xeb, 0xc1, 0x84, 0x83, 0x64, 0xd8,
-	0x9d, 0x94, 0x26, 0xd0, 0x29, 0x9d, 0xb2, 0x88, 0x86, 0x0b, 0x89, 0x77, 0xa3, 0x07, 0xe3, 0xc5,
-	0x2f, 0x60, 0x3c, 0x98, 0xe8, 0x77, 0xe0, 0x48, 0xf4, 0xe2, 0xc9, 0x18, 0xf0, 0x83, 0x18, 0x66,
-	0x5e, 0x81, 0xed, 0x6e, 0x59, 0x30, 0x24, 0xde, 0xba, 0xf3, 0xde, 0xff, 0xbd, 0xdf, 0x7b, 0xf3,
-	0xe6, 0x65, 0x61, 0x78, 0x49, 0x2c, 0x07, 0x52, 0xb1, 0x58, 0xd4, 0x45, 0xb8, 0x26, 0x58, 0xbd,
-	0xcc, 0x56, 0xd7, 0x44, 0xbc, 0xe1, 0x46, 0xb1, 0x4c, 0x24, 0xed, 0x33, 0x66, 0x17, 0xcd, 0x6e,
-	0xbd, 0x6c, 0x5d, 0xaf, 0x4a, 0xb5, 0x22, 0x15, 0x5b, 0xe4, 0x4a, 0x18, 0x5f, 0x56, 0x2f, 0x2f,
-	0x8a, 0x84, 0x97, 0x59, 0xc4, 0xfd, 0x20, 0xe4, 0x49, 0x20, 0x43, 0x23, 0xb7, 0x46, 0x9a, 0xa3,
-	0xfb, 0x22, 0x14, 0x2a, 0x50, 0xf9, 0x0e, 0x69, 0x2a, 0xe3, 0x50, 0xf2, 0xa5, 0x2f, 0xf5, 0x27,
-	0xdb, 0xff, 0xc2, 0xd3, 0x21, 0x5f, 0x4a, 0x7f, 0x59, 0x30, 0x1e, 0x05, 0x8c, 0x87, 0xa1, 0x4c,
-	0x74, 0xd2, 0x34, 0xe8, 0x80, 0x21, 0x5c, 0x30, 0x32, 0xf3, 0xc3, 0x98, 0x9c, 0xe7, 0x50, 0x7a,
-	0xb2, 0x8f, 0xec, 0x99, 0x24, 0xca, 0x13, 0xab, 0x6b, 0x42, 0x25, 0xf4, 0x11, 0xc0, 0x21, 0x7c,
-	0x3f, 0x19, 0x25, 0x13, 0x3d, 0x95, 0x31, 0x17, 0xa5, 0xfb, 0x95, 0xba, 0xa6, 0x2b, 0x58, 0xa9,
-	0x3b, 0xc7, 0x7d, 0x81, 0x5a, 0xef, 0x88, 0xd2, 0xf9, 0x48, 0xe0, 0x72, 0x26, 0x81, 0x8a, 0x64,
-	0xa8, 0x04, 0xbd, 0x07, 0x5d, 0x58, 0x99, 0xea, 0x27, 0xa3, 0xe7, 0x26, 0x7a, 0x2a, 0x96, 0xdb,
-	0xd4, 0x5c, 0x17, 0x65, 0xd3, 0xe7, 0xb7, 0x7f, 0x8d, 0x14, 0xbc, 0x03, 0x05, 0x9d, 0x6d, 0xe0,
-	0xeb, 0xd0, 0x7c, 0xe3, 0x6d, 0xf9, 0x4c, 0xea, 0x06, 0xc0, 0x79, 0xb8, 0x74, 0x94, 0x2f, 0xad,
-	0x7f, 0x06, 0x7a, 0xab, 0x32, 0x4c, 0x62, 0x5e, 0x4d, 0x16, 0x78, 0xad, 0x16, 0x0b, 0xa5, 0x74,
-	0x17, 0xba, 0xa7, 0xfb, 0xbf, 0x7f, 0x9d, 0x2c, 0x61, 0xa2, 0xfb, 0xc6, 0xf2, 0x34, 0x89, 0x83,
-	0xd0, 0xf7, 0x2e, 0xa6, 0x0a, 0x3c, 0x76, 0xbc, 0xc6, 0xe6, 0x1e, 0x94, 0x7e, 0x17, 0x3a, 0xb1,
-	0x10, 0xec, 0x6c, 0xfb, 0xca, 0x53, 0x81, 0x53, 0x02, 0xaa, 0x63, 0xce, 0xf1, 0x98, 0xaf, 0xa4,
-	0xd7, 0xe5, 0x3c, 0xc6, 0x2a, 0xd2, 0x53, 0x4c, 0x74, 0x1b, 0x8a, 0x91, 0x3e, 0xc1, 0x3c, 0x03,
-	0x2d, 0xf2, 0x18, 0x09, 0xa6, 0x41, 0x77, 0xe7, 0x13, 0x81, 0x21, 0x1d, 0xf0, 0x81, 0x88, 0x96,
-	0xe5, 0x86, 0x88, 0xb3, 0xf3, 0x31, 0x03, 0xbd, 0x35, 0x34, 0x9d, 0xbc, 0x3f, 0xa9, 0x02, 0x8f,
-	0x33, 0x43, 0xd6, 0xf1, 0xcf, 0x43, 0xf6, 0x9e, 0xc0, 0x70, 0x0e, 0x2d, 0x36, 0x62, 0x12, 0x68,
-	0xf6, 0x3a, 0x71, 0xec, 0xba, 0xbd, 0xbe, 0xcc, 0xb5, 0x9d, 0xe5, 0x74, 0x7d, 0x21, 0x60, 0x6b,
-	0xb2, 0x67, 0x41, 0xb2, 0x54, 0x8b, 0xf9, 0x7a, 0x73, 0x27, 0x67, 0x81, 0xae, 0x1f, 0x18, 0x4f,
-	0xdc, 0xcb, 0xbe, 0x43, 0xcd, 0x59, 0x77, 0xf3, 0x03, 0x81, 0x91, 0x5c, 0xe6, 0xff, 0xdb, 0xcf,
-	0xca, 0xeb, 0x22, 0x5c, 0xd0, 0x6c, 0x74, 0x8b, 0x40, 0x57, 0x8a, 0x45, 0xc7, 0x5b, 0xcc, 0x75,
-	0xab, 0xb5, 0x66, 0x4d, 0xb4, 0x77, 0x34, 0x59, 0x9d, 0xab, 0x5b, 0x3f, 0xfe, 0xbc, 0xeb, 0x18,
-	0xa6, 0x83, 0x2c, 0x77, 0x23, 0x2b, 0xfa, 0x96, 0x40, 0x27, 0x2a, 0xe9, 0x58, 0x9b, 0xd0, 0x29,
-	0xc2, 0x78, 0x5b, 0x3f, 0x24, 0xb8, 0xa5, 0x09, 0xa6, 0xa8, 0x7b, 0x0c, 0x01, 0x7b, 0x95, 0xbd,
-	0x86, 0x4d, 0xfa, 0x12, 0x8a, 0xe6, 0x4d, 0xd3, 0x6b, 0x79, 0xa9, 0x1a, 0x96, 0x87, 0x35, 0xd6,
-	0xce, 0x0d, 0x81, 0xae, 0x68, 0xa0, 0x41, 0x3a, 0xd0, 0x02, 0xc8, 0xec, 0x0d, 0xfa, 0x99, 0x40,
-	0x6f, 0xf6, 0x11, 0x52, 0x96, 0x17, 0x3f, 0x67, 0xb9, 0x58, 0x53, 0x27, 0x17, 0x9c, 0xaa, 0x57,
-	0xd9, 0x8d, 0xb5, 0x49, 0xbf, 0x11, 0xa0, 0xcd, 0x63, 0x4e, 0xcb, 0x79, 0x00, 0xb9, 0xcf, 0xd8,
-	0xaa, 0x9c, 0x46, 0x82, 0xd4, 0x77, 0x34, 0x75, 0x85, 0x4e, 0x1d, 0x4b, 0xdd, 0xbc, 0x1d, 0x36,
-	0xa7, 0x1f, 0x6e, 0xef, 0xda, 0x64, 0x67, 0xd7, 0x26, 0xbf, 0x77, 0x6d, 0xf2, 0x66, 0xcf, 0x2e,
-	0xec, 0xec, 0xd9, 0x85, 0x9f, 0x7b, 0x76, 0x61, 0xfe, 0x86, 0x09, 0x35, 0x59, 0x95, 0xb1, 0x60,
-	0xe9, 0xf7, 0x12, 0x0f, 0x42, 0xf6, 0xe2, 0x68, 0xf8, 0x64, 0x23, 0x12, 0x6a, 0xb1, 0xa8, 0xff,
-	0x04, 0xdc, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xe4, 0x43, 0xa7, 0xf5, 0x08, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xc7, 0x3d, 0xb4, 0x35, 0x30, 0x1c, 0x6a, 0x06, 0x57, 0xc2, 0x0b, 0xac, 0xe9, 0x56, 0x05,
+	0xab, 0x95, 0x77, 0x6d, 0x23, 0xf5, 0x97, 0x7a, 0xa9, 0xdd, 0xc2, 0xad, 0xa2, 0xdb, 0x43, 0x25,
+	0x0e, 0x45, 0xe3, 0xf5, 0x68, 0xbd, 0xaa, 0xbd, 0x63, 0x76, 0xd6, 0xa6, 0xb4, 0xe2, 0x82, 0xfa,
+	0x07, 0x54, 0xed, 0xa1, 0xea, 0x25, 0x7f, 0x40, 0xa2, 0x48, 0x89, 0x94, 0x48, 0xf9, 0x13, 0x38,
+	0xa2, 0xe4, 0x92, 0x53, 0x12, 0x41, 0xfe, 0x90, 0xc8, 0x33, 0x6f, 0xc1, 0x5e, 0x7b, 0xb1, 0x89,
+	0x90, 0x72, 0x62, 0x99, 0x99, 0xef, 0x7b, 0x9f, 0xf7, 0x9d, 0x37, 0x0f, 0xf0, 0x5a, 0x93, 0xb5,
+	0x3c, 0x2e, 0xac, 0x80, 0xf5, 0x98, 0xdf, 0x65, 0x56, 0xaf, 0x6c, 0x1d, 0x74, 0x59, 0x70, 0x64,
+	0x76, 0x02, 0x1e, 0x72, 0xb2, 0xa8, 0xb6, 0x4d, 0xd8, 0x36, 0x7b, 0x65, 0xed, 0x33, 0x87, 0x8b,
+	0x36, 0x17, 0x56, 0x9d, 0x0a, 0xa6, 0xce, 0x5a, 0xbd, 0x72, 0x9d, 0x85, 0xb4, 0x6c, 0x75, 0xa8,
+	0xeb, 0xf9, 0x34, 0xf4, 0xb8, 0xaf, 0xe4, 0x5a, 0x7e, 0x34, 0xba, 0xcb, 0x7c, 0x26, 0x3c, 0x91,
+	0x7c, 0x20, 0x4a, 0xa5, 0x0e, 0x64, 0x5d, 0xee, 0x72, 0xf9, 0x69, 0xf5, 0xbf, 0x60, 0x75, 0xd5,
+	0xe5, 0xdc, 0x6d, 0x31, 0x8b, 0x76, 0x3c, 0x8b, 0xfa, 0x3e, 0x0f, 0x65, 0xd2, 0x28, 0x68, 0x4e,
+	0x11, 0xee, 0x2b, 0x99, 0xfa, 0x05, 0xb6, 0xf4, 0x41, 0xf8, 0x08, 0xdb, 0xe1, 0x1e, 0x00, 0x1b,
+	0xbf, 0xe2, 0xec, 0x4f, 0xfd, 0x92, 0x6c, 0x05, 0x21, 0x6c, 0x76, 0xd0, 0x65, 0x22, 0x24, 0xdb,
+	0x18, 0x5f, 0x15, 0xb7, 0x8c, 0xd6, 0x51, 0x61, 0xa1, 0xb2, 0x61, 0x42, 0xe8, 0x7e, 0x30, 0x53,
+	0xb9, 0x06, 0x21, 0xcd, 0x5d, 0xea, 0x32, 0xd0, 0xda, 0x03, 0x4a, 0xe3, 0x0e, 0xc2, 0x1f, 0xc5,
+	0x12, 0x88, 0x0e, 0xf7, 0x05, 0x23, 0xdf, 0xe2, 0x39, 0xa8, 0x5c, 0x2c, 0xa3, 0xf5, 0xf7, 0x0a,
+	0x0b, 0x15, 0xcd, 0x1c, 0x31, 0xdf, 0x04, 0x59, 0xf5, 0xfd, 0xd3, 0x17, 0xf9, 0x94, 0x7d, 0xa9,
+	0x20, 0x3b, 0x43, 0x7c, 0x33, 0x92, 0x6f, 0x73, 0x22, 0x9f, 0x4a, 0x3d, 0x04, 0xb8, 0x87, 0x97,
+	0x06, 0xf9, 0xa2, 0xfa, 0x6b, 0x38, 0xe3, 0x70, 0x3f, 0x0c, 0xa8, 0x13, 0xee, 0xd3, 0x46, 0x23,
+	0x60, 0x42, 0x48, 0x17, 0xe6, 0xab, 0xcb, 0x4f, 0x1f, 0x15, 0xb3, 0x90, 0xe8, 0x3b, 0xb5, 0xf3,
+	0x73, 0x18, 0x78, 0xbe, 0x6b, 0x7f, 0x18, 0x29, 0x60, 0xd9, 0xb0, 0x87, 0xcd, 0xbd, 0x2c, 0xfd,
+	0x1b, 0x3c, 0x0b, 0x85, 0x80, 0xb3, 0x93, 0x2b, 0x8f, 0x04, 0x46, 0x16, 0x13, 0x19, 0x73, 0x97,
+	0x06, 0xb4, 0x1d, 0x5d, 0x97, 0xf1, 0x23, 0x5e, 0x1a, 0x5a, 0x85, 0x44, 0x5f, 0xe2, 0x74, 0x47,
+	0xae, 0x40, 0x9e, 0xdc, 0x98, 0x3c, 0x4a, 0x02, 0x69, 0xe0, 0xb8, 0x71, 0x0f, 0xe1, 0x55, 0x19,
+	0xf0, 0x7b, 0xd6, 0x69, 0xf1, 0x23, 0x16, 0xc4, 0xfb, 0xa3, 0x86, 0x33, 0x0d, 0xd8, 0x9a, 0xde,
+	0x9f, 0x48, 0x01, 0xcb, 0x64, 0x7b, 0xcc, 0x25, 0xbe, 0x4d, 0x93, 0xfd, 0x87, 0xf0, 0x5a, 0x02,
+	0x2d, 0x18, 0x51, 0xc4, 0x24, 0x7e, 0x9d, 0xd0, 0x76, 0xf3, 0xf6, 0x62, 0xec, 0xda, 0x6e, 0xb3,
+	0xbb, 0x1e, 0x22, 0xac, 0x4b, 0xb2, 0x5f, 0xbc, 0xb0, 0xd9, 0x08, 0xe8, 0xe1, 0xa8, 0x93, 0x3b,
+	0x98, 0x1c, 0x5e, 0x6e, 0x4e, 0xed, 0xe5, 0xe2, 0x95, 0xe6, 0xb6, 0xdd, 0xfc, 0x1f, 0xe1, 0x7c,
+	0x22, 0xf3, 0x3b, 0xf6, 0xd3, 0x85, 0xb6, 0xac, 0xb5, 0xa8, 0xd7, 0xa6, 0xf5, 0x16, 0x8b, 0x3d,
+	0xdb, 0xdb, 0x32, 0xd3, 0xf8, 0x2b, 0x6a, 0xa9, 0xd1, 0x4c, 0x60, 0x81, 0x83, 0xd3, 0xb4, 0xcd,
+	0xbb, 0x7e, 0x08, 0xd3, 0x2b, 0x37, 0x54, 0x4f, 0x54, 0x49, 0x8d, 0x7b, 0x7e, 0xb5, 0xd4, 0x7f,
+	0x5b, 0x77, 0x5f, 0xe6, 0x0b, 0xae, 0x17, 0x36, 0xbb, 0x75, 0xd3, 0xe1, 0x6d, 0x98, 0xd2, 0xf0,
+	0xa3, 0x28, 0x1a, 0xbf, 0x59, 0xe1, 0x51, 0x87, 0x09, 0x29, 0x10, 0x36, 0x84, 0xae, 0x3c, 0x99,
+	0xc5, 0x1f, 0x48, 0x0c, 0x72, 0x82, 0xf0, 0x5c, 0x74, 0x0d, 0x64, 0x73, 0xcc, 0x3b, 0x1e, 0x37,
+	0xc6, 0xb5, 0xc2, 0xe4, 0x83, 0xaa, 0x1c, 0xe3, 0x93, 0x93, 0x67, 0xaf, 0xff, 0x9d, 0x59, 0x23,
+	0x2b, 0x56, 0xe2, 0x5f, 0x28, 0x41, 0xfe, 0x41, 0x78, 0x16, 0x94, 0x64, 0x63, 0x42, 0xe8, 0x08,
+	0x61, 0x73, 0xe2, 0x39, 0x20, 0xf8, 0x42, 0x12, 0x94, 0x88, 0x79, 0x0d, 0x81, 0xf5, 0x67, 0xbc,
+	0xed, 0x8e, 0xc9, 0x1f, 0x38, 0xad, 0x66, 0x18, 0xf9, 0x34, 0x29, 0xd5, 0xd0, 0xb0, 0xd4, 0x36,
+	0x26, 0x1d, 0x03, 0xa0, 0x8f, 0x25, 0xd0, 0x0a, 0xc9, 0x8d, 0x01, 0x52, 0x73, 0x92, 0xdc, 0x47,
+	0x38, 0x13, 0x1f, 0x3a, 0xc4, 0x4a, 0x8a, 0x9f, 0x30, 0x4c, 0xb5, 0xd2, 0xf4, 0x82, 0x1b, 0x79,
+	0x15, 0x9f, 0xd0, 0xc7, 0xe4, 0x31, 0xc2, 0x64, 0xf4, 0x59, 0x93, 0x72, 0x12, 0x40, 0xe2, 0xd8,
+	0xd2, 0x2a, 0x37, 0x91, 0x00, 0xf5, 0x57, 0x92, 0xba, 0x42, 0x4a, 0xd7, 0x52, 0x8f, 0x3e, 0xe0,
+	0x63, 0xf2, 0x00, 0xe1, 0x4c, 0xfc, 0x25, 0x26, 0xfb, 0x9c, 0x30, 0x1d, 0xb4, 0xd2, 0xf4, 0x02,
+	0x20, 0xfe, 0x5a, 0x12, 0x6f, 0x91, 0xf2, 0x18, 0x62, 0x27, 0x12, 0x8d, 0x45, 0xae, 0xfe, 0x70,
+	0x7a, 0xae, 0xa3, 0xb3, 0x73, 0x1d, 0xbd, 0x3a, 0xd7, 0xd1, 0xdf, 0x17, 0x7a, 0xea, 0xec, 0x42,
+	0x4f, 0x3d, 0xbf, 0xd0, 0x53, 0x7b, 0x9f, 0xab, 0x58, 0x45, 0x87, 0x07, 0xcc, 0x8a, 0xbe, 0x9b,
+	0xd4, 0xf3, 0xad, 0xdf, 0x07, 0xe3, 0xcb, 0x79, 0x50, 0x4f, 0xcb, 0xff, 0xd3, 0xb6, 0xde, 0x0c,
+	0x00, 0x8b, 0xe6, 0xcb, 0x6c, 0xb8, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -617,6 +721,8 @@ type QueryClient interface {
 	// WithdrawerRevenues retrieves all revenues with a given withdrawer
 	// address
 	WithdrawerRevenues(ctx context.Context, in *QueryWithdrawerRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerRevenuesResponse, error)
+	// ClaimableRevenue retrieves the transaction fees accrued by a given withdrawer
+	ClaimableRevenue(ctx context.Context, in *QueryClaimableRevenueRequest, opts ...grpc.CallOption) (*QueryClaimableRevenueResponse, error)
 }
 
 type queryClient struct {
@@ -672,6 +778,15 @@ func (c *queryClient) WithdrawerRevenues(ctx context.Context, in *QueryWithdrawe
 	return out, nil
 }
 
+func (c *queryClient) ClaimableRevenue(ctx context.Context, in *QueryClaimableRevenueRequest, opts ...grpc.CallOption) (*QueryClaimableRevenueResponse, error) {
+	out := new(QueryClaimableRevenueResponse)
+	err := c.cc.Invoke(ctx, "/helios.revenue.v1.Query/ClaimableRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Revenues retrieves all registered revenues
@@ -686,6 +801,8 @@ type QueryServer interface {
 	// WithdrawerRevenues retrieves all revenues with a given withdrawer
 	// address
 	WithdrawerRevenues(context.Context, *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error)
+	// ClaimableRevenue retrieves the transaction fees accrued by a given withdrawer
+	ClaimableRevenue(context.Context, *QueryClaimableRevenueRequest) (*QueryClaimableRevenueResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -707,6 +824,9 @@ func (*UnimplementedQueryServer) DeployerRevenues(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) WithdrawerRevenues(ctx context.Context, req *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawerRevenues not implemented")
 }
+func (*UnimplementedQueryServer) ClaimableRevenue(ctx context.Context, req *QueryClaimableRevenueRequest) (*QueryClaimableRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableRevenue not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -802,6 +922,25 @@ func _Query_WithdrawerRevenues_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClaimableRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.revenue.v1.Query/ClaimableRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableRevenue(ctx, req.(*QueryClaimableRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helios.revenue.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -826,6 +965,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawerRevenues",
 			Handler:    _Query_WithdrawerRevenues_Handler,
 		},
+		{
+			MethodName: "ClaimableRevenue",
+			Handler:    _Query_ClaimableRevenue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "helios/revenue/v1/query.proto",
@@ -1206,6 +1349,73 @@ func (m *QueryWithdrawerRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryClaimableRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1365,6 +1575,34 @@ func (m *QueryWithdrawerRevenuesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClaimableRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2347,6 +2585,172 @@ func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClaimableRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
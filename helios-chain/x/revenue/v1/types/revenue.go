@@ -4,12 +4,18 @@
 package types
 
 import (
+	"fmt"
+
 	heliostypes "helios-core/helios-chain/types"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// MaxWithdrawers is the maximum number of weighted withdrawers of a revenue
+const MaxWithdrawers = 10
+
 // NewRevenue returns an instance of Revenue. If the provided withdrawer
 // address is empty, it sets the value to an empty string.
 func NewRevenue(contract common.Address, deployer, withdrawer sdk.AccAddress) Revenue {
@@ -62,5 +68,96 @@ func (fs Revenue) Validate() error {
 		}
 	}
 
+	return ValidateWithdrawers(fs.Withdrawers)
+}
+
+// EffectiveWithdrawers returns the withdrawers splitting the fees of the contract, it defaults to
+// the withdrawer address (or the deployer address) when no weighted withdrawers are set.
+func (fs Revenue) EffectiveWithdrawers() []Withdrawer {
+	if len(fs.Withdrawers) > 0 {
+		return fs.Withdrawers
+	}
+
+	withdrawer := fs.WithdrawerAddress
+	if withdrawer == "" {
+		withdrawer = fs.DeployerAddress
+	}
+	return []Withdrawer{{Address: withdrawer, Weight: 1}}
+}
+
+// GetWithdrawerMapAddrs returns the withdrawers of the contract other than the deployer,
+// which are indexed in the withdrawer map.
+func (fs Revenue) GetWithdrawerMapAddrs() []sdk.AccAddress {
+	seen := map[string]bool{fs.DeployerAddress: true, "": true}
+	addrs := make([]sdk.AccAddress, 0)
+	for _, address := range append([]string{fs.WithdrawerAddress}, withdrawerAddresses(fs.Withdrawers)...) {
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		addrs = append(addrs, sdk.MustAccAddressFromBech32(address))
+	}
+	return addrs
+}
+
+// SplitRevenue splits the amount between the withdrawers proportionally to their weights,
+// the remainder of the division goes to the first withdrawer.
+func SplitRevenue(amount math.Int, withdrawers []Withdrawer) []math.Int {
+	totalWeight := math.ZeroInt()
+	for _, withdrawer := range withdrawers {
+		totalWeight = totalWeight.Add(math.NewIntFromUint64(withdrawer.Weight))
+	}
+
+	amounts := make([]math.Int, len(withdrawers))
+	if totalWeight.IsZero() {
+		return amounts
+	}
+
+	remainder := amount
+	for i, withdrawer := range withdrawers {
+		amounts[i] = amount.Mul(math.NewIntFromUint64(withdrawer.Weight)).Quo(totalWeight)
+		remainder = remainder.Sub(amounts[i])
+	}
+	amounts[0] = amounts[0].Add(remainder)
+	return amounts
+}
+
+// ValidateWithdrawers validates a weighted list of withdrawers, the list can be empty.
+func ValidateWithdrawers(withdrawers []Withdrawer) error {
+	if len(withdrawers) > MaxWithdrawers {
+		return fmt.Errorf("too many withdrawers, got %d max %d", len(withdrawers), MaxWithdrawers)
+	}
+
+	seen := make(map[string]bool)
+	for _, withdrawer := range withdrawers {
+		if _, err := sdk.AccAddressFromBech32(withdrawer.Address); err != nil {
+			return fmt.Errorf("invalid withdrawer address %s: %w", withdrawer.Address, err)
+		}
+		if seen[withdrawer.Address] {
+			return fmt.Errorf("duplicated withdrawer %s", withdrawer.Address)
+		}
+		if withdrawer.Weight == 0 {
+			return fmt.Errorf("withdrawer %s has a zero weight", withdrawer.Address)
+		}
+		seen[withdrawer.Address] = true
+	}
+
 	return nil
 }
+
+func withdrawerAddresses(withdrawers []Withdrawer) []string {
+	addresses := make([]string, len(withdrawers))
+	for i, withdrawer := range withdrawers {
+		addresses[i] = withdrawer.Address
+	}
+	return addresses
+}
+
+// Validate performs a stateless validation of the claimable revenue
+func (cr ClaimableRevenue) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cr.WithdrawerAddress); err != nil {
+		return err
+	}
+
+	return cr.Amount.Validate()
+}
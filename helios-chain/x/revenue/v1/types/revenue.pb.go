@@ -6,6 +6,9 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -34,6 +37,9 @@ type Revenue struct {
 	// withdrawer_address is the bech32 address of account receiving the transaction fees it defaults to
 	// deployer_address
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers is a weighted list of accounts splitting the transaction fees, when set it takes
+	// precedence over withdrawer_address
+	Withdrawers []Withdrawer `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *Revenue) Reset()         { *m = Revenue{} }
@@ -90,29 +96,160 @@ func (m *Revenue) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *Revenue) GetWithdrawers() []Withdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
+// Withdrawer defines an account receiving a share of the transaction fees of a contract
+// proportional to its weight
+type Withdrawer struct {
+	// address is the bech32 address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the share of the account relatively to the other withdrawers
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *Withdrawer) Reset()         { *m = Withdrawer{} }
+func (m *Withdrawer) String() string { return proto.CompactTextString(m) }
+func (*Withdrawer) ProtoMessage()    {}
+func (*Withdrawer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36749e7d3a190e30, []int{1}
+}
+func (m *Withdrawer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Withdrawer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Withdrawer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Withdrawer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Withdrawer.Merge(m, src)
+}
+func (m *Withdrawer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Withdrawer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Withdrawer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Withdrawer proto.InternalMessageInfo
+
+func (m *Withdrawer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Withdrawer) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// ClaimableRevenue defines the transaction fees accrued by a withdrawer which can be claimed
+type ClaimableRevenue struct {
+	// withdrawer_address is the bech32 address of the withdrawer
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// amount is the accrued transaction fees
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ClaimableRevenue) Reset()         { *m = ClaimableRevenue{} }
+func (m *ClaimableRevenue) String() string { return proto.CompactTextString(m) }
+func (*ClaimableRevenue) ProtoMessage()    {}
+func (*ClaimableRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36749e7d3a190e30, []int{2}
+}
+func (m *ClaimableRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimableRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableRevenue.Merge(m, src)
+}
+func (m *ClaimableRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableRevenue proto.InternalMessageInfo
+
+func (m *ClaimableRevenue) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func (m *ClaimableRevenue) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Revenue)(nil), "helios.revenue.v1.Revenue")
+	proto.RegisterType((*Withdrawer)(nil), "helios.revenue.v1.Withdrawer")
+	proto.RegisterType((*ClaimableRevenue)(nil), "helios.revenue.v1.ClaimableRevenue")
 }
 
 func init() { proto.RegisterFile("helios/revenue/v1/revenue.proto", fileDescriptor_36749e7d3a190e30) }
 
 var fileDescriptor_36749e7d3a190e30 = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x48, 0xcd, 0xc9,
-	0xcc, 0x2f, 0xd6, 0x2f, 0x4a, 0x2d, 0x4b, 0xcd, 0x2b, 0x4d, 0xd5, 0x2f, 0x33, 0x84, 0x31, 0xf5,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x21, 0x0a, 0xf4, 0x60, 0xa2, 0x65, 0x86, 0x52, 0x92,
-	0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x60, 0x05, 0xfa, 0x10, 0x0e, 0x44, 0xb5, 0xd2, 0x1d,
-	0x46, 0x2e, 0xf6, 0x20, 0x88, 0x4a, 0x21, 0x67, 0x2e, 0x81, 0xe4, 0xfc, 0xbc, 0x92, 0xa2, 0xc4,
-	0xe4, 0x92, 0xf8, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e,
-	0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0xfa, 0x1c, 0x21, 0x32, 0xc1, 0x25, 0x45, 0x99, 0x79,
-	0xe9, 0x41, 0xfc, 0x30, 0x1d, 0x50, 0x61, 0x90, 0x21, 0x29, 0xa9, 0x05, 0x39, 0xf9, 0x95, 0xa9,
-	0x45, 0x70, 0x43, 0x98, 0x08, 0x19, 0x02, 0xd3, 0x01, 0x33, 0xc4, 0x9d, 0x4b, 0xa8, 0x3c, 0xb3,
-	0x24, 0x23, 0xa5, 0x28, 0xb1, 0x1c, 0xc9, 0x18, 0x66, 0x02, 0xc6, 0x08, 0x22, 0xf4, 0x40, 0x25,
-	0x9c, 0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x1b, 0x12, 0x4c,
-	0xba, 0xc9, 0xf9, 0x45, 0xa9, 0xfa, 0x30, 0x76, 0x46, 0x62, 0x66, 0x9e, 0x7e, 0x05, 0x72, 0xd8,
-	0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x67, 0xf7, 0x18, 0x59, 0x7d, 0x01, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0xae, 0xda, 0x30,
+	0x14, 0x86, 0x13, 0x40, 0xa0, 0x9a, 0xa1, 0x10, 0xa1, 0x0a, 0x90, 0x1a, 0x10, 0x13, 0x52, 0x85,
+	0x5d, 0xe8, 0x13, 0x34, 0x08, 0x75, 0x4f, 0x87, 0x56, 0x5d, 0x90, 0x93, 0x58, 0x89, 0xd5, 0x24,
+	0x46, 0xb6, 0x81, 0xf2, 0x16, 0x7d, 0x87, 0x6e, 0x9d, 0xfb, 0x00, 0x1d, 0x19, 0x51, 0xa7, 0x4e,
+	0xed, 0x15, 0xbc, 0xc8, 0x55, 0x62, 0x1b, 0x90, 0xee, 0x95, 0xb8, 0x77, 0x8a, 0xcf, 0xf1, 0xff,
+	0x7f, 0xd6, 0x39, 0xf9, 0xc1, 0x20, 0x21, 0x29, 0x65, 0x02, 0x71, 0xb2, 0x21, 0xf9, 0x9a, 0xa0,
+	0xcd, 0xd4, 0x1c, 0xe1, 0x8a, 0x33, 0xc9, 0x9c, 0xb6, 0x12, 0x40, 0xd3, 0xdd, 0x4c, 0xfb, 0xbd,
+	0x90, 0x89, 0x8c, 0x89, 0x65, 0x29, 0x40, 0xaa, 0x50, 0xea, 0xbe, 0xab, 0x2a, 0x14, 0x60, 0x51,
+	0xb0, 0x02, 0x22, 0xf1, 0x14, 0x85, 0x8c, 0xe6, 0xfa, 0xbe, 0x13, 0xb3, 0x98, 0x29, 0x5f, 0x71,
+	0x52, 0xdd, 0xd1, 0x8f, 0x0a, 0x68, 0xf8, 0x8a, 0xef, 0xcc, 0x41, 0x2b, 0x64, 0xb9, 0xe4, 0x38,
+	0x94, 0x4b, 0x1c, 0x45, 0x9c, 0x08, 0xd1, 0xb5, 0x87, 0xf6, 0xf8, 0x85, 0xd7, 0xfd, 0xf3, 0x6b,
+	0xd2, 0xd1, 0xaf, 0xbd, 0x57, 0x37, 0x1f, 0x25, 0xa7, 0x79, 0xec, 0xbf, 0x34, 0x0e, 0xdd, 0x2e,
+	0x20, 0x11, 0x59, 0xa5, 0x6c, 0x47, 0xf8, 0x19, 0x52, 0xb9, 0x05, 0x31, 0x0e, 0x03, 0xf9, 0x00,
+	0x9c, 0x2d, 0x95, 0x49, 0xc4, 0xf1, 0xf6, 0x0a, 0x53, 0xbd, 0x81, 0x69, 0x5f, 0x3c, 0x06, 0xb4,
+	0x00, 0xcd, 0x4b, 0x53, 0x74, 0x6b, 0xc3, 0xea, 0xb8, 0x39, 0x7b, 0x0d, 0x1f, 0x2c, 0x16, 0x7e,
+	0x3a, 0xab, 0xbc, 0xda, 0xfe, 0xdf, 0xc0, 0xf2, 0xaf, 0x7d, 0xa3, 0xcf, 0x00, 0x5c, 0x04, 0xce,
+	0x0c, 0x34, 0x9e, 0xba, 0x1e, 0x23, 0x74, 0x5e, 0x81, 0xfa, 0x96, 0xd0, 0x38, 0x91, 0xe5, 0x32,
+	0x6a, 0xbe, 0xae, 0x46, 0xbf, 0x6d, 0xd0, 0x9a, 0xa7, 0x98, 0x66, 0x38, 0x48, 0x89, 0xf9, 0x11,
+	0x8f, 0x8f, 0x6f, 0x3f, 0x7f, 0xfc, 0x10, 0xd4, 0x71, 0xc6, 0xd6, 0x79, 0xf1, 0x6a, 0x31, 0x79,
+	0x0f, 0x6a, 0x67, 0x11, 0x12, 0xa8, 0x43, 0x02, 0xe7, 0x8c, 0xe6, 0xde, 0xdb, 0x62, 0xea, 0x9f,
+	0xff, 0x07, 0xe3, 0x98, 0xca, 0x64, 0x1d, 0xc0, 0x90, 0x65, 0x3a, 0x5f, 0xfa, 0x33, 0x11, 0xd1,
+	0x57, 0x24, 0x77, 0x2b, 0x22, 0x4a, 0x83, 0xf0, 0x35, 0xda, 0x5b, 0xec, 0x8f, 0xae, 0x7d, 0x38,
+	0xba, 0xf6, 0xdd, 0xd1, 0xb5, 0xbf, 0x9f, 0x5c, 0xeb, 0x70, 0x72, 0xad, 0xbf, 0x27, 0xd7, 0xfa,
+	0xf2, 0x46, 0xed, 0x79, 0x12, 0x32, 0x4e, 0x90, 0x39, 0x27, 0x98, 0xe6, 0xe8, 0xdb, 0x75, 0xea,
+	0x4b, 0x68, 0x50, 0x2f, 0x03, 0xf9, 0xee, 0x7e, 0x00, 0x98, 0x3f, 0xc3, 0x0b, 0x17, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Revenue) Marshal() (dAtA []byte, err error) {
@@ -135,6 +272,20 @@ func (m *Revenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -159,6 +310,85 @@ func (m *Revenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Withdrawer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Withdrawer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Withdrawer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintRevenue(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRevenue(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRevenue(v)
 	base := offset
@@ -188,6 +418,47 @@ func (m *Revenue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRevenue(uint64(l))
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Withdrawer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovRevenue(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *ClaimableRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -322,6 +593,257 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, Withdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Withdrawer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Withdrawer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Withdrawer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRevenue(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -90,6 +91,7 @@ func (suite *RevenueTestSuite) TestFee() {
 				utiltx.GenerateAddress().String(),
 				suite.address1.String(),
 				suite.address2.String(),
+				nil,
 			},
 			true,
 		},
@@ -99,6 +101,7 @@ func (suite *RevenueTestSuite) TestFee() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
 				suite.address1.String(),
 				suite.address2.String(),
+				nil,
 			},
 			false,
 		},
@@ -108,6 +111,7 @@ func (suite *RevenueTestSuite) TestFee() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb19",
 				suite.address1.String(),
 				suite.address2.String(),
+				nil,
 			},
 			false,
 		},
@@ -117,6 +121,7 @@ func (suite *RevenueTestSuite) TestFee() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb194FFF",
 				suite.address1.String(),
 				suite.address2.String(),
+				nil,
 			},
 			false,
 		},
@@ -126,6 +131,7 @@ func (suite *RevenueTestSuite) TestFee() {
 				utiltx.GenerateAddress().String(),
 				"evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z",
 				suite.address2.String(),
+				nil,
 			},
 			false,
 		},
@@ -135,6 +141,7 @@ func (suite *RevenueTestSuite) TestFee() {
 				utiltx.GenerateAddress().String(),
 				suite.address1.String(),
 				"evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z",
+				nil,
 			},
 			false,
 		},
@@ -157,6 +164,7 @@ func (suite *RevenueTestSuite) TestRevenueGetters() {
 		contract.String(),
 		suite.address1.String(),
 		suite.address2.String(),
+		nil,
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
@@ -166,8 +174,44 @@ func (suite *RevenueTestSuite) TestRevenueGetters() {
 		contract.String(),
 		suite.address1.String(),
 		"",
+		nil,
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
 	suite.Equal(len(fs.GetWithdrawerAddr()), 0)
 }
+
+func (suite *RevenueTestSuite) TestEffectiveWithdrawers() {
+	contract := utiltx.GenerateAddress()
+	fs := types.NewRevenue(contract, suite.address1, nil)
+	suite.Equal([]types.Withdrawer{{Address: suite.address1.String(), Weight: 1}}, fs.EffectiveWithdrawers())
+	suite.Empty(fs.GetWithdrawerMapAddrs())
+
+	fs = types.NewRevenue(contract, suite.address1, suite.address2)
+	suite.Equal([]types.Withdrawer{{Address: suite.address2.String(), Weight: 1}}, fs.EffectiveWithdrawers())
+	suite.Equal([]sdk.AccAddress{suite.address2}, fs.GetWithdrawerMapAddrs())
+
+	fs.Withdrawers = []types.Withdrawer{
+		{Address: suite.address1.String(), Weight: 1},
+		{Address: suite.address2.String(), Weight: 2},
+	}
+	suite.Equal(fs.Withdrawers, fs.EffectiveWithdrawers())
+	suite.Equal([]sdk.AccAddress{suite.address2}, fs.GetWithdrawerMapAddrs())
+	suite.Require().NoError(fs.Validate())
+}
+
+func (suite *RevenueTestSuite) TestSplitRevenue() {
+	withdrawers := []types.Withdrawer{
+		{Address: suite.address1.String(), Weight: 1},
+		{Address: suite.address2.String(), Weight: 2},
+	}
+
+	amounts := types.SplitRevenue(math.NewInt(100), withdrawers)
+	suite.Equal([]math.Int{math.NewInt(34), math.NewInt(66)}, amounts)
+
+	amounts = types.SplitRevenue(math.NewInt(99), withdrawers)
+	suite.Equal([]math.Int{math.NewInt(33), math.NewInt(66)}, amounts)
+
+	amounts = types.SplitRevenue(math.NewInt(7), withdrawers[:1])
+	suite.Equal([]math.Int{math.NewInt(7)}, amounts)
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -44,6 +46,8 @@ type MsgRegisterRevenue struct {
 	// that determines the contract's address - it can be an EOA nonce or a
 	// factory contract nonce
 	Nonces []uint64 `protobuf:"varint,4,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	// withdrawers is an optional weighted list of accounts splitting the transaction fees
+	Withdrawers []Withdrawer `protobuf:"bytes,5,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgRegisterRevenue) Reset()         { *m = MsgRegisterRevenue{} }
@@ -107,6 +111,13 @@ func (m *MsgRegisterRevenue) GetNonces() []uint64 {
 	return nil
 }
 
+func (m *MsgRegisterRevenue) GetWithdrawers() []Withdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgRegisterRevenueResponse defines the MsgRegisterRevenue response type
 type MsgRegisterRevenueResponse struct {
 }
@@ -154,6 +165,8 @@ type MsgUpdateRevenue struct {
 	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
 	// withdrawer_address is the bech32 address of account receiving the transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers is an optional weighted list of accounts splitting the transaction fees
+	Withdrawers []Withdrawer `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgUpdateRevenue) Reset()         { *m = MsgUpdateRevenue{} }
@@ -210,6 +223,13 @@ func (m *MsgUpdateRevenue) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateRevenue) GetWithdrawers() []Withdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgUpdateRevenueResponse defines the MsgUpdateRevenue response type
 type MsgUpdateRevenueResponse struct {
 }
@@ -340,6 +360,98 @@ func (m *MsgCancelRevenueResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelRevenueResponse proto.InternalMessageInfo
 
+// MsgClaimRevenue defines a message that claims the transaction fees accrued by a withdrawer
+type MsgClaimRevenue struct {
+	// withdrawer_address is the bech32 address of the withdrawer claiming the fees
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *MsgClaimRevenue) Reset()         { *m = MsgClaimRevenue{} }
+func (m *MsgClaimRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRevenue) ProtoMessage()    {}
+func (*MsgClaimRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d27b39363311c194, []int{6}
+}
+func (m *MsgClaimRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRevenue.Merge(m, src)
+}
+func (m *MsgClaimRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRevenue proto.InternalMessageInfo
+
+func (m *MsgClaimRevenue) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// MsgClaimRevenueResponse defines the MsgClaimRevenue response type
+type MsgClaimRevenueResponse struct {
+	// amount is the claimed transaction fees
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimRevenueResponse) Reset()         { *m = MsgClaimRevenueResponse{} }
+func (m *MsgClaimRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRevenueResponse) ProtoMessage()    {}
+func (*MsgClaimRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d27b39363311c194, []int{7}
+}
+func (m *MsgClaimRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRevenueResponse.Merge(m, src)
+}
+func (m *MsgClaimRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRevenueResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRevenueResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // MsgUpdateParams defines a Msg for updating the x/revenue module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -353,7 +465,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d27b39363311c194, []int{6}
+	return fileDescriptor_d27b39363311c194, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +517,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d27b39363311c194, []int{7}
+	return fileDescriptor_d27b39363311c194, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,6 +553,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateRevenueResponse)(nil), "helios.revenue.v1.MsgUpdateRevenueResponse")
 	proto.RegisterType((*MsgCancelRevenue)(nil), "helios.revenue.v1.MsgCancelRevenue")
 	proto.RegisterType((*MsgCancelRevenueResponse)(nil), "helios.revenue.v1.MsgCancelRevenueResponse")
+	proto.RegisterType((*MsgClaimRevenue)(nil), "helios.revenue.v1.MsgClaimRevenue")
+	proto.RegisterType((*MsgClaimRevenueResponse)(nil), "helios.revenue.v1.MsgClaimRevenueResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "helios.revenue.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "helios.revenue.v1.MsgUpdateParamsResponse")
 }
@@ -448,44 +562,54 @@ func init() {
 func init() { proto.RegisterFile("helios/revenue/v1/tx.proto", fileDescriptor_d27b39363311c194) }
 
 var fileDescriptor_d27b39363311c194 = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x95, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0xb3, 0x49, 0x08, 0x74, 0xfe, 0x7f, 0x4d, 0xbb, 0x14, 0x9b, 0x2c, 0x65, 0x53, 0x56,
-	0x2d, 0xa1, 0x35, 0x3b, 0xb4, 0x82, 0x42, 0x6f, 0xa6, 0x88, 0xa7, 0x80, 0xac, 0x78, 0xf1, 0x60,
-	0x18, 0x37, 0xc3, 0x64, 0x21, 0x99, 0x59, 0x66, 0x26, 0x69, 0x73, 0x93, 0x7e, 0x01, 0x45, 0x0f,
-	0x82, 0x27, 0xc1, 0x2f, 0xe0, 0x41, 0xf0, 0x2b, 0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x24, 0x11, 0xf4,
-	0x63, 0x48, 0x76, 0x66, 0x37, 0x6e, 0x92, 0x76, 0x7b, 0x14, 0x6f, 0x3b, 0xf3, 0x3e, 0xef, 0x33,
-	0xbf, 0xf7, 0x61, 0x76, 0x17, 0x58, 0x5d, 0xdc, 0x0b, 0x98, 0x80, 0x1c, 0x0f, 0x31, 0x1d, 0x60,
-	0x38, 0xdc, 0x83, 0xf2, 0xd8, 0x0d, 0x39, 0x93, 0xcc, 0x5c, 0x53, 0x35, 0x57, 0xd7, 0xdc, 0xe1,
-	0x9e, 0xb5, 0xe1, 0x33, 0xd1, 0x67, 0x02, 0xf6, 0x05, 0x99, 0x4a, 0xfb, 0x82, 0x28, 0xad, 0x55,
-	0x55, 0x85, 0x76, 0xb4, 0x82, 0x6a, 0xa1, 0x4b, 0xb5, 0xc5, 0x23, 0x08, 0xa6, 0x58, 0x04, 0xb1,
-	0x60, 0x9d, 0x30, 0xc2, 0x54, 0xe3, 0xf4, 0x49, 0xef, 0x6e, 0x12, 0xc6, 0x48, 0x0f, 0x43, 0x14,
-	0x06, 0x10, 0x51, 0xca, 0x24, 0x92, 0x01, 0xa3, 0xba, 0xc7, 0x79, 0x9b, 0x07, 0x66, 0x4b, 0x10,
-	0x0f, 0x93, 0x40, 0x48, 0xcc, 0x3d, 0xe5, 0x6d, 0x1e, 0x82, 0x55, 0x9f, 0x51, 0xc9, 0x91, 0x2f,
-	0xdb, 0xa8, 0xd3, 0xe1, 0x58, 0x88, 0x8a, 0xb1, 0x65, 0xd4, 0x57, 0x9a, 0x95, 0xcf, 0x1f, 0x1b,
-	0xeb, 0x9a, 0xeb, 0x9e, 0xaa, 0x3c, 0x92, 0x3c, 0xa0, 0xc4, 0x2b, 0xc7, 0x1d, 0x7a, 0x7b, 0x6a,
-	0xd2, 0xc1, 0x61, 0x8f, 0x8d, 0x30, 0x4f, 0x4c, 0xf2, 0x59, 0x26, 0x71, 0x47, 0x6c, 0xf2, 0x00,
-	0x98, 0x47, 0x81, 0xec, 0x76, 0x38, 0x3a, 0xfa, 0xc3, 0xa6, 0x90, 0x61, 0xb3, 0x36, 0xeb, 0x89,
-	0x8d, 0xae, 0x81, 0x12, 0x65, 0xd4, 0xc7, 0xa2, 0x52, 0xdc, 0x2a, 0xd4, 0x8b, 0x9e, 0x5e, 0x1d,
-	0x54, 0x7f, 0xbd, 0xab, 0xe5, 0x4e, 0x7e, 0x7e, 0xd8, 0x59, 0x80, 0x75, 0x36, 0x81, 0xb5, 0x98,
-	0x8d, 0x87, 0x45, 0xc8, 0xa8, 0xc0, 0xce, 0xf3, 0x3c, 0x58, 0x6d, 0x09, 0xf2, 0x38, 0xec, 0x20,
-	0x89, 0xff, 0xdd, 0xe0, 0x2e, 0x0a, 0xc8, 0x02, 0x95, 0xf9, 0x04, 0x92, 0x78, 0x3e, 0x19, 0x51,
-	0x3c, 0x87, 0x88, 0xfa, 0xb8, 0xf7, 0xd7, 0xc5, 0x93, 0x3d, 0x55, 0x0a, 0x3c, 0x99, 0xea, 0x95,
-	0x01, 0xca, 0xc9, 0xc8, 0x0f, 0x11, 0x47, 0x7d, 0x61, 0xde, 0x01, 0x2b, 0x68, 0x20, 0xbb, 0x8c,
-	0x07, 0x72, 0x94, 0x39, 0xcd, 0x4c, 0x6a, 0xde, 0x05, 0xa5, 0x30, 0x72, 0x88, 0xe8, 0xff, 0xdb,
-	0xaf, 0xba, 0x0b, 0x1f, 0x0a, 0x57, 0x1d, 0xd1, 0x2c, 0x9e, 0x7e, 0xab, 0xe5, 0x3c, 0x2d, 0x3f,
-	0xb8, 0x3a, 0xe5, 0x9e, 0x19, 0x39, 0x55, 0xb0, 0x31, 0xc7, 0x14, 0xf3, 0xee, 0xbf, 0x2f, 0x82,
-	0x42, 0x4b, 0x10, 0xf3, 0x8d, 0x01, 0xca, 0xf3, 0x2f, 0xf9, 0xcd, 0x25, 0xe7, 0x2d, 0xde, 0x77,
-	0xab, 0x71, 0x29, 0x59, 0x92, 0x90, 0x7b, 0xf2, 0xe5, 0xc7, 0xeb, 0x7c, 0xdd, 0xd9, 0x86, 0xcb,
-	0x3e, 0x89, 0x90, 0xeb, 0xb6, 0xb6, 0xde, 0x36, 0x5f, 0x18, 0xe0, 0x4a, 0xfa, 0x1d, 0xba, 0xbe,
-	0xfc, 0xc0, 0x94, 0xc8, 0xda, 0xbd, 0x84, 0x28, 0x61, 0xba, 0x15, 0x31, 0x6d, 0x3b, 0x37, 0x96,
-	0x33, 0x0d, 0xa2, 0xa6, 0x14, 0x51, 0xfa, 0xda, 0x9e, 0x43, 0x94, 0x12, 0x9d, 0x47, 0xb4, 0xfc,
-	0x1e, 0x65, 0x10, 0xf9, 0x51, 0x53, 0x42, 0xf4, 0x14, 0xfc, 0x9f, 0xba, 0x71, 0xce, 0x45, 0xc3,
-	0x2b, 0x8d, 0xb5, 0x93, 0xad, 0x89, 0x69, 0x9a, 0xf7, 0x4f, 0xc7, 0xb6, 0x71, 0x36, 0xb6, 0x8d,
-	0xef, 0x63, 0xdb, 0x78, 0x39, 0xb1, 0x73, 0x67, 0x13, 0x3b, 0xf7, 0x75, 0x62, 0xe7, 0x9e, 0xec,
-	0x2a, 0x93, 0x86, 0xcf, 0x38, 0x86, 0xf1, 0x73, 0x17, 0x05, 0x14, 0x1e, 0xa7, 0x90, 0x47, 0x21,
-	0x16, 0xcf, 0x4a, 0xd1, 0x3f, 0xe5, 0xf6, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x74, 0x5b, 0x24,
-	0x4f, 0x0d, 0x07, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0x93, 0x34, 0x52, 0xa7, 0x7d, 0x2f, 0xad, 0x55, 0xbd, 0x26, 0x7e, 0x7d, 0x4e, 0xe5,
+	0xbe, 0x56, 0x51, 0x43, 0x6c, 0x5a, 0x24, 0x90, 0xba, 0x23, 0x55, 0xc5, 0xaa, 0x12, 0x32, 0x42,
+	0x48, 0x2c, 0xa8, 0x26, 0xce, 0x68, 0x32, 0x22, 0x9e, 0xb1, 0x3c, 0x93, 0x7e, 0x6c, 0x58, 0x74,
+	0xcb, 0x02, 0x04, 0x0b, 0xb6, 0xac, 0x59, 0x81, 0x84, 0xc4, 0x4f, 0xa0, 0xcb, 0x0a, 0x36, 0xac,
+	0xf8, 0x68, 0x91, 0xe0, 0x67, 0x20, 0xdb, 0x63, 0xa7, 0x4e, 0xd2, 0x26, 0x48, 0x2c, 0x10, 0xab,
+	0xda, 0xbe, 0xe7, 0x9e, 0x73, 0xee, 0xbd, 0x33, 0xb7, 0x01, 0x5a, 0x1b, 0x75, 0x08, 0xe3, 0x96,
+	0x8f, 0x76, 0x11, 0xed, 0x22, 0x6b, 0x77, 0xcd, 0x12, 0xfb, 0xa6, 0xe7, 0x33, 0xc1, 0xd4, 0xd9,
+	0x28, 0x66, 0xca, 0x98, 0xb9, 0xbb, 0xa6, 0xcd, 0x3b, 0x8c, 0xbb, 0x8c, 0x5b, 0x2e, 0xc7, 0x01,
+	0xd4, 0xe5, 0x38, 0xc2, 0x6a, 0xe5, 0x28, 0xb0, 0x13, 0xbe, 0x59, 0xd1, 0x8b, 0x0c, 0x55, 0x06,
+	0x25, 0x30, 0xa2, 0x88, 0x93, 0x0b, 0x00, 0xb1, 0x64, 0x04, 0xd0, 0xa5, 0x6a, 0x13, 0xf2, 0x20,
+	0xda, 0x44, 0x02, 0xae, 0x59, 0x0e, 0x23, 0x54, 0xc6, 0xe7, 0x30, 0xc3, 0x2c, 0x52, 0x0e, 0x9e,
+	0xe4, 0xd7, 0x05, 0xcc, 0x18, 0xee, 0x20, 0x0b, 0x7a, 0xc4, 0x82, 0x94, 0x32, 0x01, 0x05, 0x61,
+	0x54, 0x8a, 0x1a, 0x5f, 0xb2, 0x40, 0xdd, 0xe6, 0xd8, 0x46, 0x98, 0x70, 0x81, 0x7c, 0x3b, 0x12,
+	0x54, 0x37, 0xc1, 0x8c, 0xc3, 0xa8, 0xf0, 0xa1, 0x23, 0x76, 0x60, 0xab, 0xe5, 0x23, 0xce, 0x4b,
+	0xca, 0xa2, 0x52, 0x9d, 0x6c, 0x94, 0xde, 0xbd, 0xae, 0xcf, 0xc9, 0xc2, 0xae, 0x47, 0x91, 0x5b,
+	0xc2, 0x27, 0x14, 0xdb, 0xc5, 0x38, 0x43, 0x7e, 0x0e, 0x48, 0x5a, 0xc8, 0xeb, 0xb0, 0x03, 0xe4,
+	0x27, 0x24, 0xd9, 0x51, 0x24, 0x71, 0x46, 0x4c, 0x72, 0x03, 0xa8, 0x7b, 0x44, 0xb4, 0x5b, 0x3e,
+	0xdc, 0x3b, 0x43, 0x93, 0x1b, 0x41, 0x33, 0xdb, 0xcb, 0x89, 0x89, 0xfe, 0x01, 0x05, 0xca, 0xa8,
+	0x83, 0x78, 0x29, 0xbf, 0x98, 0xab, 0xe6, 0x6d, 0xf9, 0xa6, 0x6e, 0x81, 0xa9, 0x1e, 0x98, 0x97,
+	0x26, 0x16, 0x73, 0xd5, 0xa9, 0xf5, 0xff, 0xcc, 0x81, 0xa1, 0x9b, 0x77, 0x12, 0x54, 0x23, 0x7f,
+	0xf4, 0xb1, 0x92, 0xb1, 0xcf, 0xe6, 0x6d, 0x94, 0xbf, 0x3f, 0xaf, 0x64, 0x0e, 0xbf, 0xbd, 0x5c,
+	0x1d, 0xa8, 0xd9, 0x58, 0x00, 0xda, 0x60, 0x8b, 0x6d, 0xc4, 0x3d, 0x46, 0x39, 0x32, 0xde, 0x66,
+	0xc1, 0xcc, 0x36, 0xc7, 0xb7, 0xbd, 0x16, 0x14, 0xe8, 0x0f, 0xee, 0x7f, 0x5f, 0x9f, 0xf3, 0xbf,
+	0xbe, 0xcf, 0x1a, 0x28, 0xf5, 0x37, 0x32, 0xe9, 0xf2, 0x1b, 0x25, 0xec, 0xf2, 0x26, 0xa4, 0x0e,
+	0xea, 0xfc, 0x76, 0x5d, 0x1e, 0x5d, 0x55, 0xca, 0x78, 0x52, 0xd5, 0x1e, 0x28, 0x06, 0xb1, 0x0e,
+	0x24, 0x6e, 0x5c, 0xd3, 0xf0, 0x79, 0x29, 0x3f, 0x3d, 0xaf, 0x8d, 0x7f, 0x63, 0x4b, 0x43, 0xf8,
+	0x8c, 0x07, 0x60, 0xbe, 0x4f, 0x38, 0xf6, 0xa4, 0x3a, 0xa0, 0x00, 0x5d, 0xd6, 0xa5, 0xa2, 0xa4,
+	0x84, 0x23, 0x2e, 0x9b, 0x52, 0x31, 0x58, 0x5b, 0xa6, 0x5c, 0x5b, 0xe6, 0x26, 0x23, 0xb4, 0x71,
+	0x39, 0x18, 0xef, 0x8b, 0x4f, 0x95, 0x2a, 0x26, 0xa2, 0xdd, 0x6d, 0x9a, 0x0e, 0x73, 0xe5, 0xce,
+	0x94, 0x7f, 0xea, 0xbc, 0x75, 0xdf, 0x12, 0x07, 0x1e, 0xe2, 0x61, 0x02, 0xb7, 0x25, 0xb5, 0xf1,
+	0x44, 0x01, 0xc5, 0x64, 0xd6, 0x37, 0xa1, 0x0f, 0x5d, 0xae, 0x5e, 0x05, 0x93, 0xb0, 0x2b, 0xda,
+	0xcc, 0x27, 0xe2, 0x60, 0x64, 0xc1, 0x3d, 0xa8, 0x7a, 0x0d, 0x14, 0xbc, 0x90, 0x21, 0x1c, 0x5b,
+	0x60, 0x78, 0xf0, 0x4c, 0x46, 0x12, 0xf2, 0x3c, 0x4a, 0xf8, 0xc6, 0xdf, 0x41, 0x77, 0x7a, 0x44,
+	0x46, 0x19, 0xcc, 0xf7, 0x79, 0x8a, 0x9b, 0xb2, 0xfe, 0x6a, 0x02, 0xe4, 0xb6, 0x39, 0x56, 0x9f,
+	0x29, 0xa0, 0xd8, 0xbf, 0x6b, 0x97, 0x87, 0xe8, 0x0d, 0xee, 0x0b, 0xad, 0x3e, 0x16, 0x2c, 0x39,
+	0x1a, 0xe6, 0xe1, 0xfb, 0xaf, 0x4f, 0xb3, 0x55, 0x63, 0xc5, 0x1a, 0xf6, 0xaf, 0xcd, 0xf2, 0x65,
+	0xda, 0x8e, 0xfc, 0xac, 0x3e, 0x52, 0xc0, 0x5f, 0xe9, 0x1d, 0xb4, 0x34, 0x5c, 0x30, 0x05, 0xd2,
+	0x6a, 0x63, 0x80, 0x12, 0x4f, 0x97, 0x42, 0x4f, 0x2b, 0xc6, 0xff, 0xc3, 0x3d, 0x75, 0xc3, 0xa4,
+	0x94, 0xa3, 0xf4, 0x7d, 0x3d, 0xc7, 0x51, 0x0a, 0xa4, 0xd5, 0xc6, 0x00, 0x8d, 0xeb, 0xc8, 0x09,
+	0x93, 0x12, 0x47, 0x0f, 0x15, 0x30, 0x9d, 0xba, 0x6c, 0xc6, 0x39, 0x5a, 0x67, 0x30, 0xda, 0xea,
+	0x68, 0x4c, 0x62, 0xa7, 0x16, 0xda, 0x59, 0x36, 0x96, 0xce, 0xb1, 0x13, 0xe4, 0x24, 0x6e, 0xee,
+	0x81, 0xe9, 0xd4, 0xf9, 0x37, 0x2e, 0x1a, 0x45, 0x84, 0xd1, 0x56, 0x47, 0x63, 0x62, 0x33, 0x8d,
+	0xad, 0xa3, 0x13, 0x5d, 0x39, 0x3e, 0xd1, 0x95, 0xcf, 0x27, 0xba, 0xf2, 0xf8, 0x54, 0xcf, 0x1c,
+	0x9f, 0xea, 0x99, 0x0f, 0xa7, 0x7a, 0xe6, 0x6e, 0x2d, 0x22, 0xa9, 0x3b, 0xcc, 0x47, 0x56, 0xfc,
+	0xdc, 0x86, 0x84, 0x5a, 0xfb, 0x29, 0xc7, 0xc1, 0xc5, 0x6d, 0x16, 0xc2, 0x1f, 0x1a, 0x57, 0x7e,
+	0x0c, 0x00, 0xec, 0xd9, 0x75, 0xcd, 0x63, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -507,6 +631,8 @@ type MsgClient interface {
 	// CancelRevenue cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelRevenue(ctx context.Context, in *MsgCancelRevenue, opts ...grpc.CallOption) (*MsgCancelRevenueResponse, error)
+	// ClaimRevenue sends the transaction fees accrued by a withdrawer to its account
+	ClaimRevenue(ctx context.Context, in *MsgClaimRevenue, opts ...grpc.CallOption) (*MsgClaimRevenueResponse, error)
 	// UpdateParams defined a governance operation for updating the x/revenue module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -547,6 +673,15 @@ func (c *msgClient) CancelRevenue(ctx context.Context, in *MsgCancelRevenue, opt
 	return out, nil
 }
 
+func (c *msgClient) ClaimRevenue(ctx context.Context, in *MsgClaimRevenue, opts ...grpc.CallOption) (*MsgClaimRevenueResponse, error) {
+	out := new(MsgClaimRevenueResponse)
+	err := c.cc.Invoke(ctx, "/helios.revenue.v1.Msg/ClaimRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/helios.revenue.v1.Msg/UpdateParams", in, out, opts...)
@@ -565,6 +700,8 @@ type MsgServer interface {
 	// CancelRevenue cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelRevenue(context.Context, *MsgCancelRevenue) (*MsgCancelRevenueResponse, error)
+	// ClaimRevenue sends the transaction fees accrued by a withdrawer to its account
+	ClaimRevenue(context.Context, *MsgClaimRevenue) (*MsgClaimRevenueResponse, error)
 	// UpdateParams defined a governance operation for updating the x/revenue module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -583,6 +720,9 @@ func (*UnimplementedMsgServer) UpdateRevenue(ctx context.Context, req *MsgUpdate
 func (*UnimplementedMsgServer) CancelRevenue(ctx context.Context, req *MsgCancelRevenue) (*MsgCancelRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRevenue not implemented")
 }
+func (*UnimplementedMsgServer) ClaimRevenue(ctx context.Context, req *MsgClaimRevenue) (*MsgClaimRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRevenue not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -645,6 +785,24 @@ func _Msg_CancelRevenue_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.revenue.v1.Msg/ClaimRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRevenue(ctx, req.(*MsgClaimRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -663,6 +821,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helios.revenue.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -679,6 +838,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRevenue",
 			Handler:    _Msg_CancelRevenue_Handler,
 		},
+		{
+			MethodName: "ClaimRevenue",
+			Handler:    _Msg_ClaimRevenue_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -708,6 +871,20 @@ func (m *MsgRegisterRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Nonces) > 0 {
 		dAtA2 := make([]byte, len(m.Nonces)*10)
 		var j1 int
@@ -793,6 +970,20 @@ func (m *MsgUpdateRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -900,6 +1091,73 @@ func (m *MsgCancelRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -999,6 +1257,12 @@ func (m *MsgRegisterRevenue) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1029,6 +1293,12 @@ func (m *MsgUpdateRevenue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1067,6 +1337,34 @@ func (m *MsgCancelRevenueResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1298,6 +1596,40 @@ func (m *MsgRegisterRevenue) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, Withdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1494,6 +1826,40 @@ func (m *MsgUpdateRevenue) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, Withdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1729,6 +2095,172 @@ func (m *MsgCancelRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
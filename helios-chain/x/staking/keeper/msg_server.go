@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -30,14 +32,68 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 }
 
 // Delegate defines a method for performing a delegation of coins from a delegator to a validator.
-// The method performs some checks if the sender of the tx is a clawback vesting account and then
-// relay the message to the Cosmos SDK staking method.
+// The method performs some checks if the sender of the tx is a clawback vesting account, then
+// relay the message to the Cosmos SDK staking method and emits the delegate event.
 func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
 	if err := k.validateDelegationAmountNotUnvested(goCtx, msg.DelegatorAddress, msg.Amount.Amount); err != nil {
 		return nil, err
 	}
 
-	return k.MsgServer.Delegate(goCtx, msg)
+	res, err := k.MsgServer.Delegate(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+	return res, nil
+}
+
+// Undelegate defines a method for performing an undelegation from a delegate and a validator.
+// The method relays the message to the Cosmos SDK staking method and emits the unbond event.
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	res, err := k.MsgServer.Undelegate(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, res.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, res.CompletionTime.Format(time.RFC3339)),
+		),
+	)
+	return res, nil
+}
+
+// CancelUnbondingDelegation defines a method for canceling an unbonding delegation and
+// delegating back to the validator. The method relays the message to the Cosmos SDK
+// staking method and emits the cancel unbonding delegation event.
+func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.MsgCancelUnbondingDelegation) (*types.MsgCancelUnbondingDelegationResponse, error) {
+	res, err := k.MsgServer.CancelUnbondingDelegation(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+	)
+	return res, nil
 }
 
 // CreateValidator defines a method to create a validator. The method performs some checks if the
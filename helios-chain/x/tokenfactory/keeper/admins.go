@@ -47,3 +47,14 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom, admin string) error {
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+func (k Keeper) setForceTransferEnabled(ctx sdk.Context, denom string, enabled bool) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.ForceTransferEnabled = enabled
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "helios-core/helios-chain/x/evm/types"
+	"helios-core/helios-chain/x/tokenfactory/types"
+)
+
+// forceTransferCtxKey marks the context of a force transfer, which is not subject to
+// the before send hook of the denom
+type forceTransferCtxKey struct{}
+
+// GetBeforeSendHookAddress returns the hex address of the EVM contract consulted on the
+// sends of the denom, empty if the denom has no hook
+func (k Keeper) GetBeforeSendHookAddress(ctx sdk.Context, denom string) string {
+	return string(k.GetDenomPrefixStore(ctx, denom).Get(types.BeforeSendHookAddressKey))
+}
+
+// setBeforeSendHookAddress stores the before send hook of the denom, an empty address
+// removes it
+func (k Keeper) setBeforeSendHookAddress(ctx sdk.Context, denom, contractAddress string) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if contractAddress == "" {
+		store.Delete(types.BeforeSendHookAddressKey)
+		return
+	}
+	store.Set(types.BeforeSendHookAddressKey, []byte(common.HexToAddress(contractAddress).Hex()))
+}
+
+// BeforeSendRestriction is a bank send restriction consulting the before send hook of
+// the tokenfactory denoms. It covers the bank sends as well as the ERC20 transfers of
+// the denoms and the hyperion bridge deposits, which are all bank sends.
+func (k Keeper) BeforeSendRestriction(
+	goCtx context.Context,
+	from, to sdk.AccAddress,
+	amt sdk.Coin,
+) (sdk.AccAddress, error) {
+	if !strings.HasPrefix(amt.Denom, types.ModuleDenomPrefix+"/") {
+		return to, nil
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if forced, _ := ctx.Value(forceTransferCtxKey{}).(bool); forced {
+		return to, nil
+	}
+
+	hook := k.GetBeforeSendHookAddress(ctx, amt.Denom)
+	if hook == "" {
+		return to, nil
+	}
+
+	return to, k.callBeforeSendHook(ctx, common.HexToAddress(hook), from, to, amt)
+}
+
+// callBeforeSendHook calls the before send hook without committing its state changes.
+// The gas used by the hook is charged to the sender of the transaction.
+func (k Keeper) callBeforeSendHook(ctx sdk.Context, contract common.Address, from, to sdk.AccAddress, amt sdk.Coin) error {
+	data, err := types.BeforeSendHookABI.Pack(
+		types.BeforeSendHookMethod,
+		amt.Denom,
+		common.BytesToAddress(from),
+		common.BytesToAddress(to),
+		amt.Amount.BigInt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	msg := ethtypes.NewMessage(
+		common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName)),
+		&contract,
+		0,             // nonce
+		big.NewInt(0), // amount
+		types.BeforeSendHookGasLimit,
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		data,
+		ethtypes.AccessList{},
+		true, // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), false)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrSendBlockedByHook, "%s: %s", amt.Denom, err)
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "tokenfactory before send hook")
+
+	if res.Failed() {
+		return errorsmod.Wrapf(types.ErrSendBlockedByHook, "%s: %s", amt.Denom, res.VmError)
+	}
+	return nil
+}
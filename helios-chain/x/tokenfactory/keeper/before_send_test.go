@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"helios-core/helios-chain/contracts"
+	evmtypes "helios-core/helios-chain/x/evm/types"
+	hyperiontypes "helios-core/helios-chain/x/hyperion/types"
+	"helios-core/helios-chain/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestBeforeSendHookBankSend() {
+	allowed, denied := suite.keyring.GetAccAddr(1), suite.keyring.GetAccAddr(2)
+	amount := sdk.NewCoins(sdk.NewCoin(suite.denom, sdkmath.NewInt(100)))
+	bankKeeper := suite.network.App.BankKeeper
+
+	// without hook, the denom can be sent to anyone
+	suite.Require().NoError(bankKeeper.SendCoins(suite.ctx, suite.admin, denied, amount))
+
+	suite.setDenyHook(denied)
+	suite.Require().NoError(bankKeeper.SendCoins(suite.ctx, suite.admin, allowed, amount))
+	err := bankKeeper.SendCoins(suite.ctx, suite.admin, denied, amount)
+	suite.Require().ErrorIs(err, types.ErrSendBlockedByHook)
+	suite.Require().Equal(sdkmath.NewInt(100), suite.balance(allowed))
+	suite.Require().Equal(sdkmath.NewInt(100), suite.balance(denied))
+
+	// the hook only applies to its denom
+	baseDenom, err := sdk.GetBaseDenom()
+	suite.Require().NoError(err)
+	err = bankKeeper.SendCoins(suite.ctx, suite.admin, denied, sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(1))))
+	suite.Require().NoError(err)
+
+	// the hook is consulted on every send of the denom, not only the ones of the admin
+	err = bankKeeper.SendCoins(suite.ctx, allowed, denied, amount)
+	suite.Require().ErrorIs(err, types.ErrSendBlockedByHook)
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHookERC20Precompile() {
+	holder := suite.keyring.GetAddr(0)
+	allowed, denied := suite.keyring.GetAccAddr(1), suite.keyring.GetAccAddr(2)
+	erc20 := types.GetDenomERC20Address(suite.denom)
+
+	// transfer sends the amount of the denom with its ERC20 precompile
+	transfer := func(to sdk.AccAddress) *evmtypes.MsgEthereumTxResponse {
+		data, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", common.BytesToAddress(to), big.NewInt(100))
+		suite.Require().NoError(err)
+		nonce, err := suite.network.App.AccountKeeper.GetSequence(suite.ctx, holder.Bytes())
+		suite.Require().NoError(err)
+
+		msg := ethtypes.NewMessage(
+			holder,
+			&erc20,
+			nonce,
+			big.NewInt(0), // amount
+			1_000_000,     // gasLimit
+			big.NewInt(0), // gasPrice
+			big.NewInt(0), // gasFeeCap
+			big.NewInt(0), // gasTipCap
+			data,
+			ethtypes.AccessList{},
+			false, // isFake
+		)
+		res, err := suite.network.App.EvmKeeper.ApplyMessage(suite.ctx, msg, evmtypes.NewNoOpTracer(), true)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	suite.setDenyHook(denied)
+
+	res := transfer(allowed)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(sdkmath.NewInt(100), suite.balance(allowed))
+
+	res = transfer(denied)
+	suite.Require().True(res.Failed())
+	suite.Require().True(suite.balance(denied).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(900), suite.balance(suite.admin))
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHookHyperionDeposit() {
+	allowed, denied := suite.keyring.GetAccAddr(1), suite.keyring.GetAccAddr(2)
+	hyperionKeeper := &suite.network.App.HyperionKeeper
+
+	// the denom is bridged to the counterparty chain, which holds the deposited amounts
+	counterparty := hyperiontypes.DefaultLocalPolygonAmoyTestnet21ChainParams()
+	params := hyperionKeeper.GetParams(suite.ctx)
+	params.CounterpartyChainParams = append(params.CounterpartyChainParams, counterparty)
+	hyperionKeeper.SetParams(suite.ctx, params)
+	tokenContract := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	hyperionKeeper.SetTokenToChainMetadata(suite.ctx, counterparty.BridgeChainId, &hyperiontypes.TokenAddressToDenom{
+		Denom:              suite.denom,
+		TokenAddress:       tokenContract.Hex(),
+		IsCosmosOriginated: true,
+	})
+	hyperionKeeper.SetHyperionContractBalance(suite.ctx, counterparty.HyperionId, tokenContract, sdkmath.NewInt(1000))
+
+	suite.setDenyHook(denied)
+
+	deposit := func(nonce uint64, receiver sdk.AccAddress) {
+		err := hyperionKeeper.AttestationHandler.Handle(suite.ctx, &hyperiontypes.MsgDepositClaim{
+			HyperionId:     counterparty.HyperionId,
+			EventNonce:     nonce,
+			TokenContract:  tokenContract.Hex(),
+			Amount:         sdkmath.NewInt(100),
+			EthereumSender: "0x0000000000000000000000000000000000000001",
+			CosmosReceiver: receiver.String(),
+		}, nil)
+		suite.Require().NoError(err)
+	}
+
+	deposit(1, allowed)
+	suite.Require().Equal(sdkmath.NewInt(100), suite.balance(allowed))
+
+	// the deposits blocked by the hook go to the community pool
+	distrAddr := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	poolBalance := suite.balance(distrAddr)
+	deposit(2, denied)
+	suite.Require().True(suite.balance(denied).IsZero())
+	suite.Require().Equal(poolBalance.AddRaw(100), suite.balance(distrAddr))
+}
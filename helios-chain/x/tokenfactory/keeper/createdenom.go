@@ -58,7 +58,7 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr, denom,
 	}
 
 	k.addDenomFromCreator(ctx, creatorAddr, denom)
-	return nil
+	return k.registerERC20Representation(ctx, denom)
 }
 
 func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr, subdenom string) (newTokenDenom string, err error) {
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	erc20types "helios-core/helios-chain/x/erc20/types"
+	"helios-core/helios-chain/x/tokenfactory/types"
+)
+
+// registerERC20Representation registers the denom as a native coin token pair in
+// the erc20 module and enables its ERC20 dynamic precompile, which serves the
+// name, symbol and decimals from the bank metadata of the denom.
+//
+// It is a no-op if the denom already has a token pair, e.g. when it was imported
+// from the erc20 genesis.
+func (k Keeper) registerERC20Representation(ctx sdk.Context, denom string) error {
+	if k.erc20Keeper.IsDenomRegistered(ctx, denom) {
+		return nil
+	}
+
+	address := types.GetDenomERC20Address(denom)
+	if k.erc20Keeper.IsERC20Registered(ctx, address) {
+		return errorsmod.Wrapf(types.ErrERC20AddressCollision, "denom %s, address %s", denom, address)
+	}
+
+	pair := erc20types.NewTokenPair(address, denom, erc20types.OWNER_MODULE)
+	k.erc20Keeper.SetToken(ctx, pair)
+	if err := k.erc20Keeper.EnableDynamicPrecompiles(ctx, address); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			erc20types.EventTypeRegisterERC20Extension,
+			sdk.NewAttribute(erc20types.AttributeKeyCosmosCoin, denom),
+			sdk.NewAttribute(erc20types.AttributeKeyERC20Token, address.String()),
+		),
+	)
+
+	return nil
+}
@@ -30,6 +30,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		k.setBeforeSendHookAddress(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHookAddress())
 	}
 }
 
@@ -47,11 +48,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 
 		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:             denom,
-			AuthorityMetadata: authorityMetadata,
-			Name:              metadata.GetName(),
-			Symbol:            metadata.GetSymbol(),
-			Decimals:          metadata.GetDecimals(),
+			Denom:                 denom,
+			AuthorityMetadata:     authorityMetadata,
+			Name:                  metadata.GetName(),
+			Symbol:                metadata.GetSymbol(),
+			Decimals:              metadata.GetDecimals(),
+			BeforeSendHookAddress: k.GetBeforeSendHookAddress(ctx, denom),
 		})
 	}
 
@@ -20,6 +20,8 @@ type Keeper struct {
 	accountKeeper       types.AccountKeeper
 	bankKeeper          types.BankKeeper
 	communityPoolKeeper types.CommunityPoolKeeper
+	erc20Keeper         types.Erc20Keeper
+	evmKeeper           types.EVMKeeper
 
 	authority string
 }
@@ -30,6 +32,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	communityPoolKeeper types.CommunityPoolKeeper,
+	erc20Keeper types.Erc20Keeper,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -37,10 +40,16 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		erc20Keeper:         erc20Keeper,
 		authority:           authority,
 	}
 }
 
+// SetEVMKeeper sets the evm keeper calling the before send hooks
+func (k *Keeper) SetEVMKeeper(evmKeeper types.EVMKeeper) {
+	k.evmKeeper = evmKeeper
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -26,3 +26,17 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 		m.subspace,
 	)
 }
+
+// Migrate2to3 registers the ERC20 representation of the denoms created before
+// the tokenfactory denoms were automatically registered in the erc20 module.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	iterator := m.keeper.GetAllDenomsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := m.keeper.registerERC20Representation(ctx, string(iterator.Value())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
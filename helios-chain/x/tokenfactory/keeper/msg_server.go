@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"helios-core/helios-chain/x/tokenfactory/types"
 )
@@ -161,6 +162,14 @@ func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDeno
 
 	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
+	// the ERC20 precompile reads the updated metadata, only the factory denoms created
+	// before the automatic registration may still be missing their token pair
+	if _, _, err := types.DeconstructDenom(msg.Metadata.Base); err == nil {
+		if err := k.registerERC20Representation(ctx, msg.Metadata.Base); err != nil {
+			return nil, err
+		}
+	}
+
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventSetTFDenomMetadata{
 		Denom:    msg.Metadata.Base,
@@ -179,32 +188,90 @@ func getSdkAddressStringOrEmpty(address string) string {
 	return sdk.MustAccAddressFromBech32(address).String()
 }
 
-// nolint:all //omitted for now
-// func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
-
-// 	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	if msg.Sender != authorityMetadata.GetAdmin() {
-// 		return nil, types.ErrUnauthorized
-// 	}
-
-// 	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	ctx.EventManager().EmitEvents(sdk.Events{
-// 		sdk.NewEvent(
-// 			types.TypeMsgForceTransfer,
-// 			sdk.NewAttribute(types.AttributeTransferFromAddress, msg.TransferFromAddress),
-// 			sdk.NewAttribute(types.AttributeTransferToAddress, msg.TransferToAddress),
-// 			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
-// 		),
-// 	})
-
-// 	return &types.MsgForceTransferResponse{}, nil
-// }
+func (k msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if msg.ContractAddress != "" {
+		contract := common.HexToAddress(msg.ContractAddress)
+		if acc := k.evmKeeper.GetAccount(ctx, contract); acc == nil || !acc.IsContract() {
+			return nil, errors.Wrapf(types.ErrInvalidBeforeSendHook, "%s is not a contract", contract)
+		}
+	}
+
+	k.setBeforeSendHookAddress(ctx, msg.Denom, msg.ContractAddress)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSetTFBeforeSendHook{
+		Denom:           msg.Denom,
+		ContractAddress: k.GetBeforeSendHookAddress(ctx, msg.Denom),
+	})
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+func (k msgServer) SetForceTransferEnabled(goCtx context.Context, msg *types.MsgSetForceTransferEnabled) (*types.MsgSetForceTransferEnabledResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = k.setForceTransferEnabled(ctx, msg.Denom, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSetTFForceTransferEnabled{
+		Denom:   msg.Denom,
+		Enabled: msg.Enabled,
+	})
+
+	return &types.MsgSetForceTransferEnabledResponse{}, nil
+}
+
+func (k msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if !authorityMetadata.GetForceTransferEnabled() {
+		return nil, errors.Wrapf(types.ErrForceTransferDisabled, "denom: %s", msg.Amount.GetDenom())
+	}
+
+	err = k.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventForceTransferTFDenom{
+		AdminAddress:        getSdkAddressStringOrEmpty(msg.Sender),
+		TransferFromAddress: getSdkAddressStringOrEmpty(msg.TransferFromAddress),
+		TransferToAddress:   getSdkAddressStringOrEmpty(msg.TransferToAddress),
+		Amount:              msg.Amount,
+	})
+
+	return &types.MsgForceTransferResponse{}, nil
+}
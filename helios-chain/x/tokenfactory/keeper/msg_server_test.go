@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"helios-core/helios-chain/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestSetBeforeSendHook() {
+	var contract common.Address
+
+	testCases := []struct {
+		name    string
+		msg     func() *types.MsgSetBeforeSendHook
+		expHook bool
+		expErr  error
+	}{
+		{
+			"fail - sender is not the admin",
+			func() *types.MsgSetBeforeSendHook {
+				nonAdmin := suite.keyring.GetAccAddr(1)
+				return &types.MsgSetBeforeSendHook{Sender: nonAdmin.String(), Denom: suite.denom, ContractAddress: contract.Hex()}
+			},
+			false,
+			types.ErrUnauthorized,
+		},
+		{
+			"fail - hook is not a contract",
+			func() *types.MsgSetBeforeSendHook {
+				return &types.MsgSetBeforeSendHook{Sender: suite.admin.String(), Denom: suite.denom, ContractAddress: suite.keyring.GetAddr(1).Hex()}
+			},
+			false,
+			types.ErrInvalidBeforeSendHook,
+		},
+		{
+			"pass - hook set",
+			func() *types.MsgSetBeforeSendHook {
+				return &types.MsgSetBeforeSendHook{Sender: suite.admin.String(), Denom: suite.denom, ContractAddress: contract.Hex()}
+			},
+			true,
+			nil,
+		},
+		{
+			"pass - hook removed",
+			func() *types.MsgSetBeforeSendHook {
+				_, err := suite.msgServer.SetBeforeSendHook(suite.ctx, &types.MsgSetBeforeSendHook{
+					Sender: suite.admin.String(), Denom: suite.denom, ContractAddress: contract.Hex(),
+				})
+				suite.Require().NoError(err)
+				return &types.MsgSetBeforeSendHook{Sender: suite.admin.String(), Denom: suite.denom}
+			},
+			false,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract = suite.deployDenyContract(common.Address{})
+
+			_, err := suite.msgServer.SetBeforeSendHook(suite.ctx, tc.msg())
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+			expHook := ""
+			if tc.expHook {
+				expHook = contract.Hex()
+			}
+			suite.Require().Equal(expHook, suite.network.App.TokenFactoryKeeper.GetBeforeSendHookAddress(suite.ctx, suite.denom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetForceTransferEnabled() {
+	nonAdmin := suite.keyring.GetAccAddr(1)
+
+	_, err := suite.msgServer.SetForceTransferEnabled(suite.ctx, &types.MsgSetForceTransferEnabled{
+		Sender: nonAdmin.String(), Denom: suite.denom, Enabled: true,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	for _, enabled := range []bool{true, false} {
+		_, err = suite.msgServer.SetForceTransferEnabled(suite.ctx, &types.MsgSetForceTransferEnabled{
+			Sender: suite.admin.String(), Denom: suite.denom, Enabled: enabled,
+		})
+		suite.Require().NoError(err)
+
+		metadata, err := suite.network.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.ctx, suite.denom)
+		suite.Require().NoError(err)
+		suite.Require().Equal(enabled, metadata.ForceTransferEnabled)
+	}
+}
+
+func (suite *KeeperTestSuite) TestForceTransfer() {
+	var holder, denied sdk.AccAddress
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		admin    bool
+		expErr   error
+	}{
+		{
+			"fail - force transfer disabled",
+			func() {},
+			true,
+			types.ErrForceTransferDisabled,
+		},
+		{
+			"fail - sender is not the admin",
+			func() {
+				suite.enableForceTransfer(true)
+			},
+			false,
+			types.ErrUnauthorized,
+		},
+		{
+			"fail - force transfer disabled again",
+			func() {
+				suite.enableForceTransfer(true)
+				suite.enableForceTransfer(false)
+			},
+			true,
+			types.ErrForceTransferDisabled,
+		},
+		{
+			"pass - force transfer enabled",
+			func() {
+				suite.enableForceTransfer(true)
+			},
+			true,
+			nil,
+		},
+		{
+			"pass - force transfer bypasses the before send hook",
+			func() {
+				suite.enableForceTransfer(true)
+				suite.setDenyHook(denied)
+			},
+			true,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			holder, denied = suite.keyring.GetAccAddr(1), suite.keyring.GetAccAddr(2)
+			amount := sdk.NewCoin(suite.denom, sdkmath.NewInt(100))
+			err := suite.network.App.BankKeeper.SendCoins(suite.ctx, suite.admin, holder, sdk.NewCoins(amount))
+			suite.Require().NoError(err)
+			tc.malleate()
+
+			sender := suite.admin
+			if !tc.admin {
+				sender = holder
+			}
+			_, err = suite.msgServer.ForceTransfer(suite.ctx, &types.MsgForceTransfer{
+				Sender:              sender.String(),
+				Amount:              amount,
+				TransferFromAddress: holder.String(),
+				TransferToAddress:   denied.String(),
+			})
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(amount.Amount, suite.balance(holder))
+				suite.Require().True(suite.balance(denied).IsZero())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(suite.balance(holder).IsZero())
+			suite.Require().Equal(amount.Amount, suite.balance(denied))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) enableForceTransfer(enabled bool) {
+	_, err := suite.msgServer.SetForceTransferEnabled(suite.ctx, &types.MsgSetForceTransferEnabled{
+		Sender: suite.admin.String(), Denom: suite.denom, Enabled: enabled,
+	})
+	suite.Require().NoError(err)
+}
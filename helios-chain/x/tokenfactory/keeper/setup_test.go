@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"helios-core/helios-chain/testutil/integration/evmos/keyring"
+	"helios-core/helios-chain/testutil/integration/evmos/network"
+	"helios-core/helios-chain/x/tokenfactory/keeper"
+	"helios-core/helios-chain/x/tokenfactory/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	network   *network.UnitTestNetwork
+	keyring   keyring.Keyring
+	ctx       sdk.Context
+	msgServer types.MsgServer
+
+	// admin of the denom, holding its minted supply
+	admin sdk.AccAddress
+	denom string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	keys := keyring.New(3)
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keys.GetAllAccAddrs()...),
+	)
+
+	suite.network = nw
+	suite.keyring = keys
+	suite.ctx = nw.GetContext()
+	suite.msgServer = keeper.NewMsgServerImpl(nw.App.TokenFactoryKeeper)
+	suite.admin = keys.GetAccAddr(0)
+
+	// the denoms are created without fee
+	nw.App.TokenFactoryKeeper.SetParams(suite.ctx, types.Params{DenomCreationFee: sdk.NewCoins()})
+
+	res, err := suite.msgServer.CreateDenom(suite.ctx, &types.MsgCreateDenom{
+		Sender:   suite.admin.String(),
+		Subdenom: "hooked",
+		Name:     "Hooked",
+		Symbol:   "HOOK",
+	})
+	suite.Require().NoError(err)
+	suite.denom = res.NewTokenDenom
+
+	_, err = suite.msgServer.Mint(suite.ctx, &types.MsgMint{
+		Sender: suite.admin.String(),
+		Amount: sdk.NewCoin(suite.denom, sdkmath.NewInt(1000)),
+	})
+	suite.Require().NoError(err)
+}
+
+// deployDenyContract deploys a before send hook reverting the sends to the denied address
+func (suite *KeeperTestSuite) deployDenyContract(denied common.Address) common.Address {
+	// beforeSend(string,address,address,uint256): revert if the `to` argument is denied
+	runtime := append(append([]byte{
+		0x60, 0x44, // PUSH1 0x44, offset of `to` in the calldata
+		0x35, // CALLDATALOAD
+		0x73, // PUSH20 denied
+	}, denied.Bytes()...), []byte{
+		0x14,       // EQ
+		0x60, 0x1d, // PUSH1 revert
+		0x57,       // JUMPI
+		0x00,       // STOP
+		0x5b,       // JUMPDEST revert
+		0x60, 0x00, // PUSH1 0
+		0x80, // DUP1
+		0xfd, // REVERT
+	}...)
+	// copy the runtime code to memory and return it
+	initCode := append([]byte{
+		0x60, byte(len(runtime)), // PUSH1 len
+		0x60, 0x0c, // PUSH1 offset of the runtime code
+		0x60, 0x00, // PUSH1 0
+		0x39,                     // CODECOPY
+		0x60, byte(len(runtime)), // PUSH1 len
+		0x60, 0x00, // PUSH1 0
+		0xf3, // RETURN
+	}, runtime...)
+
+	deployer := suite.keyring.GetAddr(0)
+	nonce, err := suite.network.App.AccountKeeper.GetSequence(suite.ctx, deployer.Bytes())
+	suite.Require().NoError(err)
+	_, err = suite.network.App.EvmKeeper.CallEVMWithData(suite.ctx, deployer, nil, initCode, true)
+	suite.Require().NoError(err)
+
+	contract := crypto.CreateAddress(deployer, nonce)
+	acc := suite.network.App.EvmKeeper.GetAccount(suite.ctx, contract)
+	suite.Require().NotNil(acc)
+	suite.Require().True(acc.IsContract())
+	return contract
+}
+
+// setDenyHook sets a before send hook on the denom denying the sends to the address
+func (suite *KeeperTestSuite) setDenyHook(denied sdk.AccAddress) {
+	contract := suite.deployDenyContract(common.BytesToAddress(denied))
+	_, err := suite.msgServer.SetBeforeSendHook(suite.ctx, &types.MsgSetBeforeSendHook{
+		Sender:          suite.admin.String(),
+		Denom:           suite.denom,
+		ContractAddress: contract.Hex(),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdkmath.Int {
+	return suite.network.App.BankKeeper.GetBalance(suite.ctx, addr, suite.denom).Amount
+}
@@ -39,7 +39,7 @@ var (
 	_ appmodule.AppModule = AppModule{}
 )
 
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 // ----------------------------------------------------------------------------
 // AppModuleBasic
@@ -144,6 +144,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate tokenfactory from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate tokenfactory from version 2 to 3: %v", err))
+	}
 }
 
 // InitGenesis performs the x/tokenfactory module's genesis initialization. It
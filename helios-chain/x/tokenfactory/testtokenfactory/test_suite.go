@@ -80,7 +80,7 @@ func (s *KeeperTestHelper) CreateTestContext() sdk.Context {
 
 	ms := rootmulti.NewStore(db, logger, metrics.NewNoOpMetrics())
 
-	return sdk.NewContext(ms, tmtypes.Header{}, false, logger)
+	return sdk.NewContext(ms, nil, tmtypes.Header{}, false, logger)
 }
 
 // CreateTestContextWithMultiStore creates a test context and returns it together with multi store.
@@ -90,7 +90,7 @@ func (s *KeeperTestHelper) CreateTestContextWithMultiStore() (sdk.Context, store
 
 	ms := rootmulti.NewStore(db, logger, metrics.NewNoOpMetrics())
 
-	return sdk.NewContext(ms, tmtypes.Header{}, false, logger), ms
+	return sdk.NewContext(ms, nil, tmtypes.Header{}, false, logger), ms
 }
 
 func (s *KeeperTestHelper) Commit() {
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// BeforeSendHookMethod is the method of the EVM contract consulted on every bank
+	// send of a denom with a before send hook. The send is blocked if the call
+	// reverts or runs out of gas.
+	BeforeSendHookMethod = "beforeSend"
+
+	// BeforeSendHookGasLimit is the gas available to a before send hook call
+	BeforeSendHookGasLimit uint64 = 500_000
+
+	// BeforeSendHookABIJSON describes the interface of the before send hook contracts
+	BeforeSendHookABIJSON = `[{
+		"name": "beforeSend",
+		"stateMutability": "view",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "string", "name": "denom", "type": "string" },
+			{ "internalType": "address", "name": "from", "type": "address" },
+			{ "internalType": "address", "name": "to", "type": "address" },
+			{ "internalType": "uint256", "name": "amount", "type": "uint256" }
+		],
+		"outputs": []
+	}]`
+)
+
+// BeforeSendHookABI is the parsed BeforeSendHookABIJSON
+var BeforeSendHookABI abi.ABI
+
+func init() {
+	var err error
+	BeforeSendHookABI, err = abi.JSON(strings.NewReader(BeforeSendHookABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
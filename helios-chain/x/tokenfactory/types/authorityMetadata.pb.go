@@ -30,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid helios address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Set by the admin to allow the admin to move the denom out of any account
+	// with MsgForceTransfer
+	ForceTransferEnabled bool `protobuf:"varint,2,opt,name=force_transfer_enabled,json=forceTransferEnabled,proto3" json:"force_transfer_enabled,omitempty" yaml:"force_transfer_enabled"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,6 +75,13 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetForceTransferEnabled() bool {
+	if m != nil {
+		return m.ForceTransferEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "helios.tokenfactory.v1beta1.DenomAuthorityMetadata")
 }
@@ -81,22 +91,25 @@ func init() {
 }
 
 var fileDescriptor_357cc6b7cc5aa240 = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
+	// 282 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xce, 0x48, 0xcd, 0xc9,
 	0xcc, 0x2f, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4,
 	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c,
 	0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0x92, 0x86, 0x68, 0xd2, 0x43, 0xd6, 0xa4, 0x07, 0xd5, 0x24, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
 	0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x48, 0xc9, 0x25, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0x27,
-	0x25, 0x16, 0xa7, 0xc2, 0xcd, 0x4f, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9,
-	0xa4, 0xe6, 0xe5, 0xe7, 0x3a, 0xa2, 0x5b, 0x29, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99,
-	0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e,
-	0x8e, 0x95, 0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93,
-	0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0x42, 0x1c, 0xad, 0x9b,
-	0x9c, 0x5f, 0x94, 0xaa, 0x0f, 0x63, 0x67, 0x24, 0x66, 0xe6, 0xe9, 0x57, 0xa0, 0xfa, 0xbe, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x2e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3,
-	0x6b, 0x34, 0xea, 0x21, 0x01, 0x00, 0x00,
+	0x25, 0x16, 0xa7, 0xc2, 0xcd, 0x4f, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xcd, 0x67, 0xe4, 0x12,
+	0x73, 0x49, 0xcd, 0xcb, 0xcf, 0x75, 0x44, 0xb7, 0x53, 0x48, 0x8d, 0x8b, 0x35, 0x31, 0x25, 0x37,
+	0x33, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x9e, 0xca, 0xc4,
+	0xdc, 0x1c, 0x2b, 0x25, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0x5a, 0x28, 0x9c, 0x4b, 0x2c, 0x2d, 0xbf,
+	0x28, 0x39, 0x35, 0xbe, 0xa4, 0x28, 0x31, 0xaf, 0x38, 0x2d, 0xb5, 0x28, 0x3e, 0x35, 0x2f, 0x31,
+	0x29, 0x27, 0x35, 0x45, 0x82, 0x49, 0x81, 0x51, 0x83, 0xc3, 0x49, 0xf1, 0xd3, 0x3d, 0x79, 0x59,
+	0x88, 0x46, 0xec, 0xea, 0x94, 0x82, 0x44, 0xc0, 0x12, 0x21, 0x50, 0x71, 0x57, 0x88, 0xb0, 0x15,
+	0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0xee, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
+	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
+	0x10, 0xa5, 0x0b, 0x09, 0x0e, 0xdd, 0xe4, 0xfc, 0xa2, 0x54, 0x7d, 0x18, 0x3b, 0x23, 0x31, 0x33,
+	0x4f, 0xbf, 0x02, 0x35, 0x5c, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x3e, 0x36, 0x06,
+	0x0c, 0x00, 0x5d, 0xa4, 0x85, 0xff, 0x7b, 0x01, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +134,9 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if this.ForceTransferEnabled != that1.ForceTransferEnabled {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +159,16 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ForceTransferEnabled {
+		i--
+		if m.ForceTransferEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -174,6 +200,9 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if m.ForceTransferEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -244,6 +273,26 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceTransferEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -13,11 +13,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateDenom{}, "helios/tokenfactory/create-denom", nil)
 	cdc.RegisterConcrete(&MsgMint{}, "helios/tokenfactory/mint", nil)
 	cdc.RegisterConcrete(&MsgBurn{}, "helios/tokenfactory/burn", nil)
-	// nolint:all
-	// cdc.RegisterConcrete(&MsgForceTransfer{}, "helios/tokenfactory/force-transfer", nil)
+	cdc.RegisterConcrete(&MsgForceTransfer{}, "helios/tokenfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "helios/tokenfactory/change-admin", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "helios/tokenfactory/update-params", nil)
 	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "helios/tokenfactory/set-denom-metadata", nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "helios/tokenfactory/set-before-send-hook", nil)
+	cdc.RegisterConcrete(&MsgSetForceTransferEnabled{}, "helios/tokenfactory/set-force-transfer-enabled", nil)
 	cdc.RegisterConcrete(&Params{}, "helios/tokenfactory/Params", nil)
 
 }
@@ -27,10 +28,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateDenom{},
 		&MsgMint{},
 		&MsgBurn{},
-		// &MsgForceTransfer{},
+		&MsgForceTransfer{},
 		&MsgChangeAdmin{},
 		&MsgUpdateParams{},
 		&MsgSetDenomMetadata{},
+		&MsgSetBeforeSendHook{},
+		&MsgSetForceTransferEnabled{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -8,6 +8,8 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const (
@@ -102,6 +104,12 @@ func DeconstructDenom(denom string) (creator, subdenom string, err error) {
 	return creatorAddr.String(), subdenom, nil
 }
 
+// GetDenomERC20Address returns the address of the ERC20 precompile representing
+// the given tokenfactory denom, derived from the hash of the full denom.
+func GetDenomERC20Address(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(denom)))
+}
+
 // NewTokenFactoryDenomMintCoinsRestriction creates and returns a MintingRestrictionFn that only allows minting of
 // valid tokenfactory denoms
 func NewTokenFactoryDenomMintCoinsRestriction() banktypes.MintingRestrictionFn {
@@ -22,4 +22,8 @@ var (
 	ErrCreatorTooLong           = errors.Register(ModuleName, 11, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errors.Register(ModuleName, 12, "denom does not exist")
 	ErrAmountNotPositive        = errors.Register(ModuleName, 13, "amount has to be positive")
+	ErrERC20AddressCollision    = errors.Register(ModuleName, 14, "erc20 address of the denom is already registered")
+	ErrInvalidBeforeSendHook    = errors.Register(ModuleName, 15, "invalid before send hook")
+	ErrSendBlockedByHook        = errors.Register(ModuleName, 16, "send blocked by the before send hook")
+	ErrForceTransferDisabled    = errors.Register(ModuleName, 17, "force transfer is not enabled for the denom")
 )
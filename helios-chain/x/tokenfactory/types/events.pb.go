@@ -285,12 +285,187 @@ func (m *EventSetTFDenomMetadata) GetMetadata() types1.Metadata {
 	return types1.Metadata{}
 }
 
+type EventSetTFBeforeSendHook struct {
+	Denom           string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *EventSetTFBeforeSendHook) Reset()         { *m = EventSetTFBeforeSendHook{} }
+func (m *EventSetTFBeforeSendHook) String() string { return proto.CompactTextString(m) }
+func (*EventSetTFBeforeSendHook) ProtoMessage()    {}
+func (*EventSetTFBeforeSendHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09581b23f840402, []int{5}
+}
+func (m *EventSetTFBeforeSendHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetTFBeforeSendHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetTFBeforeSendHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetTFBeforeSendHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetTFBeforeSendHook.Merge(m, src)
+}
+func (m *EventSetTFBeforeSendHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetTFBeforeSendHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetTFBeforeSendHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetTFBeforeSendHook proto.InternalMessageInfo
+
+func (m *EventSetTFBeforeSendHook) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventSetTFBeforeSendHook) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+type EventSetTFForceTransferEnabled struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *EventSetTFForceTransferEnabled) Reset()         { *m = EventSetTFForceTransferEnabled{} }
+func (m *EventSetTFForceTransferEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventSetTFForceTransferEnabled) ProtoMessage()    {}
+func (*EventSetTFForceTransferEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09581b23f840402, []int{6}
+}
+func (m *EventSetTFForceTransferEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetTFForceTransferEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetTFForceTransferEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetTFForceTransferEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetTFForceTransferEnabled.Merge(m, src)
+}
+func (m *EventSetTFForceTransferEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetTFForceTransferEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetTFForceTransferEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetTFForceTransferEnabled proto.InternalMessageInfo
+
+func (m *EventSetTFForceTransferEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventSetTFForceTransferEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type EventForceTransferTFDenom struct {
+	AdminAddress        string     `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	TransferFromAddress string     `protobuf:"bytes,2,opt,name=transfer_from_address,json=transferFromAddress,proto3" json:"transfer_from_address,omitempty"`
+	TransferToAddress   string     `protobuf:"bytes,3,opt,name=transfer_to_address,json=transferToAddress,proto3" json:"transfer_to_address,omitempty"`
+	Amount              types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventForceTransferTFDenom) Reset()         { *m = EventForceTransferTFDenom{} }
+func (m *EventForceTransferTFDenom) String() string { return proto.CompactTextString(m) }
+func (*EventForceTransferTFDenom) ProtoMessage()    {}
+func (*EventForceTransferTFDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09581b23f840402, []int{7}
+}
+func (m *EventForceTransferTFDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForceTransferTFDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForceTransferTFDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForceTransferTFDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForceTransferTFDenom.Merge(m, src)
+}
+func (m *EventForceTransferTFDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForceTransferTFDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForceTransferTFDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForceTransferTFDenom proto.InternalMessageInfo
+
+func (m *EventForceTransferTFDenom) GetAdminAddress() string {
+	if m != nil {
+		return m.AdminAddress
+	}
+	return ""
+}
+
+func (m *EventForceTransferTFDenom) GetTransferFromAddress() string {
+	if m != nil {
+		return m.TransferFromAddress
+	}
+	return ""
+}
+
+func (m *EventForceTransferTFDenom) GetTransferToAddress() string {
+	if m != nil {
+		return m.TransferToAddress
+	}
+	return ""
+}
+
+func (m *EventForceTransferTFDenom) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventCreateTFDenom)(nil), "helios.tokenfactory.v1beta1.EventCreateTFDenom")
 	proto.RegisterType((*EventMintTFDenom)(nil), "helios.tokenfactory.v1beta1.EventMintTFDenom")
 	proto.RegisterType((*EventBurnDenom)(nil), "helios.tokenfactory.v1beta1.EventBurnDenom")
 	proto.RegisterType((*EventChangeTFAdmin)(nil), "helios.tokenfactory.v1beta1.EventChangeTFAdmin")
 	proto.RegisterType((*EventSetTFDenomMetadata)(nil), "helios.tokenfactory.v1beta1.EventSetTFDenomMetadata")
+	proto.RegisterType((*EventSetTFBeforeSendHook)(nil), "helios.tokenfactory.v1beta1.EventSetTFBeforeSendHook")
+	proto.RegisterType((*EventSetTFForceTransferEnabled)(nil), "helios.tokenfactory.v1beta1.EventSetTFForceTransferEnabled")
+	proto.RegisterType((*EventForceTransferTFDenom)(nil), "helios.tokenfactory.v1beta1.EventForceTransferTFDenom")
 }
 
 func init() {
@@ -298,34 +473,41 @@ func init() {
 }
 
 var fileDescriptor_c09581b23f840402 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xb5, 0x11, 0x14, 0x58, 0x44, 0x69, 0xad, 0x4a, 0x84, 0x22, 0x0c, 0xb2, 0x84, 0x54, 0x81,
-	0x6a, 0xab, 0xf4, 0xc0, 0x11, 0x35, 0x0d, 0xe1, 0x94, 0x4b, 0x88, 0x38, 0x70, 0x89, 0xd6, 0xf6,
-	0x10, 0xaf, 0x82, 0x67, 0xad, 0xdd, 0x75, 0x3e, 0xfe, 0x05, 0x3f, 0x2b, 0xc7, 0x1c, 0x39, 0x21,
-	0x94, 0xfc, 0x11, 0xe4, 0xfd, 0x70, 0x02, 0x08, 0x2e, 0xbd, 0xed, 0xcc, 0xbc, 0x79, 0x33, 0xef,
-	0xed, 0x90, 0xb3, 0x02, 0xbe, 0x32, 0x2e, 0x13, 0xc5, 0xa7, 0x80, 0x5f, 0x68, 0xa6, 0xb8, 0x58,
-	0x26, 0xb3, 0x8b, 0x14, 0x14, 0xbd, 0x48, 0x60, 0x06, 0xa8, 0x64, 0x5c, 0x09, 0xae, 0x78, 0xf0,
-	0xd4, 0x20, 0xe3, 0x7d, 0x64, 0x6c, 0x91, 0xa7, 0x27, 0x13, 0x3e, 0xe1, 0x1a, 0x97, 0x34, 0x2f,
-	0xd3, 0x72, 0x1a, 0x66, 0x5c, 0x96, 0x5c, 0x26, 0x29, 0x95, 0xd0, 0x92, 0x66, 0x9c, 0xe1, 0x5f,
-	0x75, 0x9c, 0xb6, 0xf5, 0x26, 0xb0, 0xf5, 0xcb, 0xff, 0x2d, 0x47, 0x6b, 0x55, 0x70, 0xc1, 0xd4,
-	0x72, 0x00, 0x8a, 0xe6, 0x54, 0x51, 0xd3, 0x14, 0xf5, 0x48, 0xf0, 0xbe, 0xd9, 0xfb, 0x5a, 0x00,
-	0x55, 0x30, 0xea, 0xf7, 0x00, 0x79, 0x19, 0x74, 0xc8, 0x5d, 0x9a, 0x65, 0xbc, 0x46, 0xd5, 0xf1,
-	0x5f, 0xf8, 0x67, 0xf7, 0x87, 0x2e, 0x0c, 0x4e, 0xc8, 0x9d, 0xbc, 0x81, 0x74, 0x6e, 0xe9, 0xbc,
-	0x09, 0xa2, 0x05, 0x39, 0xd2, 0x2c, 0x03, 0x86, 0xca, 0x71, 0xbc, 0x26, 0xc7, 0x02, 0x32, 0x56,
-	0x31, 0x40, 0x35, 0xa6, 0x79, 0x2e, 0x40, 0x4a, 0xcb, 0x76, 0xd4, 0x16, 0xae, 0x4c, 0x3e, 0x78,
-	0x4b, 0x0e, 0x68, 0xa9, 0xe7, 0x35, 0xbc, 0x0f, 0xde, 0x3c, 0x89, 0x8d, 0xd8, 0xb8, 0x31, 0xc3,
-	0xf9, 0x16, 0x5f, 0x73, 0x86, 0xdd, 0xdb, 0xab, 0x1f, 0xcf, 0xbd, 0xa1, 0x85, 0x47, 0x15, 0x39,
-	0xd4, 0x93, 0xbb, 0xb5, 0x40, 0x33, 0xf7, 0x25, 0x39, 0x4c, 0x6b, 0x81, 0x20, 0xfe, 0x18, 0xfa,
-	0xd0, 0x64, 0x6f, 0x3c, 0xf1, 0x93, 0x73, 0xac, 0xa0, 0x38, 0x81, 0x51, 0xff, 0x2a, 0x2f, 0x19,
-	0xee, 0x7c, 0xf1, 0xf7, 0x7c, 0x09, 0x5e, 0x91, 0x63, 0x84, 0xf9, 0x98, 0x36, 0x90, 0x76, 0x1d,
-	0xe3, 0xdc, 0x23, 0x84, 0xb9, 0x6e, 0xb5, 0x0b, 0x45, 0x15, 0x79, 0xac, 0x79, 0x3f, 0x82, 0xb3,
-	0xd0, 0x7d, 0xd5, 0x3f, 0xc8, 0xdf, 0x91, 0x7b, 0xa5, 0x45, 0x58, 0x0d, 0xcf, 0x76, 0x1a, 0x70,
-	0xda, 0x6a, 0x70, 0x34, 0x56, 0x47, 0xdb, 0xd4, 0xfd, 0xb0, 0xda, 0x84, 0xfe, 0x7a, 0x13, 0xfa,
-	0x3f, 0x37, 0xa1, 0xff, 0x6d, 0x1b, 0x7a, 0xeb, 0x6d, 0xe8, 0x7d, 0xdf, 0x86, 0xde, 0xe7, 0x73,
-	0x73, 0x4a, 0xe7, 0x19, 0x17, 0x90, 0xb8, 0x77, 0x41, 0x19, 0x26, 0x8b, 0xdf, 0xcf, 0x4b, 0x2d,
-	0x2b, 0x90, 0xe9, 0x81, 0xbe, 0xa5, 0xcb, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x65, 0x14,
-	0xac, 0x1f, 0x03, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xa1, 0xb4, 0x65, 0xa1, 0xa5, 0x31, 0x45, 0xa4, 0x45, 0x18, 0x64, 0x84, 0x54, 0x40,
+	0xb5, 0xd5, 0xf6, 0xc0, 0x11, 0x35, 0x6d, 0x03, 0x97, 0x4a, 0x28, 0x8d, 0x38, 0xc0, 0x21, 0xda,
+	0xac, 0x27, 0x89, 0x95, 0x7a, 0x26, 0x5a, 0x6f, 0xda, 0xe6, 0x5f, 0xf0, 0xb3, 0x7a, 0xec, 0x91,
+	0x0b, 0x08, 0x25, 0x7f, 0x04, 0x79, 0xd7, 0xeb, 0x7c, 0x54, 0x41, 0x42, 0xdc, 0xbc, 0x33, 0x6f,
+	0xde, 0x9b, 0xf7, 0xbc, 0xcb, 0x76, 0xba, 0x70, 0x1e, 0x53, 0x1a, 0x2a, 0xea, 0x01, 0xb6, 0xb9,
+	0x50, 0x24, 0x87, 0xe1, 0xc5, 0x5e, 0x0b, 0x14, 0xdf, 0x0b, 0xe1, 0x02, 0x50, 0xa5, 0x41, 0x5f,
+	0x92, 0x22, 0xf7, 0x99, 0x41, 0x06, 0xd3, 0xc8, 0x20, 0x47, 0x6e, 0x6f, 0x76, 0xa8, 0x43, 0x1a,
+	0x17, 0x66, 0x5f, 0x66, 0x64, 0xdb, 0x13, 0x94, 0x26, 0x94, 0x86, 0x2d, 0x9e, 0x42, 0x41, 0x2a,
+	0x28, 0xc6, 0x5b, 0x7d, 0xec, 0x15, 0xfd, 0xec, 0x90, 0xf7, 0x0f, 0xfe, 0xb6, 0x1c, 0x1f, 0xa8,
+	0x2e, 0xc9, 0x58, 0x0d, 0x4f, 0x41, 0xf1, 0x88, 0x2b, 0x6e, 0x86, 0xfc, 0x63, 0xe6, 0x9e, 0x64,
+	0x7b, 0x1f, 0x49, 0xe0, 0x0a, 0x1a, 0xb5, 0x63, 0x40, 0x4a, 0xdc, 0x0a, 0x5b, 0xe1, 0x42, 0xd0,
+	0x00, 0x55, 0xc5, 0x79, 0xe9, 0xec, 0xdc, 0xaf, 0xdb, 0xa3, 0xbb, 0xc9, 0xee, 0x45, 0x19, 0xa4,
+	0x72, 0x47, 0xd7, 0xcd, 0xc1, 0xbf, 0x62, 0x1b, 0x9a, 0xe5, 0x34, 0x46, 0x65, 0x39, 0xde, 0xb1,
+	0xb2, 0x04, 0x11, 0xf7, 0x63, 0x40, 0xd5, 0xe4, 0x51, 0x24, 0x21, 0x4d, 0x73, 0xb6, 0x8d, 0xa2,
+	0x71, 0x68, 0xea, 0xee, 0x7b, 0xb6, 0xcc, 0x13, 0xad, 0x97, 0xf1, 0x3e, 0xd8, 0xdf, 0x0a, 0x8c,
+	0xd9, 0x20, 0x0b, 0xc3, 0xe6, 0x16, 0x1c, 0x51, 0x8c, 0xd5, 0xa5, 0xeb, 0x5f, 0x2f, 0x4a, 0xf5,
+	0x1c, 0xee, 0xf7, 0xd9, 0xba, 0x56, 0xae, 0x0e, 0x24, 0x1a, 0xdd, 0xd7, 0x6c, 0xbd, 0x35, 0x90,
+	0x08, 0x72, 0x4e, 0x74, 0xcd, 0x54, 0xff, 0x5b, 0xf1, 0x8b, 0x4d, 0xac, 0xcb, 0xb1, 0x03, 0x8d,
+	0xda, 0x61, 0x94, 0xc4, 0x38, 0xc9, 0xc5, 0x99, 0xca, 0xc5, 0x7d, 0xcb, 0xca, 0x08, 0x97, 0x4d,
+	0x9e, 0x41, 0x8a, 0x75, 0x4c, 0x72, 0x8f, 0x10, 0x2e, 0xf5, 0x68, 0xbe, 0x90, 0xdf, 0x67, 0x4f,
+	0x35, 0xef, 0x19, 0xd8, 0x08, 0xed, 0xaf, 0x5a, 0x40, 0xfe, 0x81, 0xad, 0x26, 0x39, 0x22, 0xf7,
+	0xf0, 0x7c, 0xe2, 0x01, 0x7b, 0x85, 0x07, 0x4b, 0x93, 0xfb, 0x28, 0x86, 0xfc, 0x6f, 0xac, 0x32,
+	0x51, 0xac, 0x42, 0x9b, 0x24, 0x9c, 0x01, 0x46, 0x9f, 0x88, 0x7a, 0x0b, 0x24, 0xdf, 0xb0, 0x0d,
+	0x41, 0xa8, 0x24, 0x17, 0x6a, 0xde, 0x8e, 0xad, 0x5b, 0x3b, 0x9f, 0x99, 0x37, 0x21, 0xaf, 0x91,
+	0x14, 0xd0, 0x90, 0x1c, 0xd3, 0x36, 0xc8, 0x13, 0xe4, 0xad, 0x73, 0x88, 0x16, 0x48, 0x54, 0xd8,
+	0x0a, 0x18, 0x80, 0x66, 0x5e, 0xad, 0xdb, 0xa3, 0xff, 0xd3, 0x61, 0x5b, 0x9a, 0x72, 0x86, 0xcd,
+	0x5e, 0xb7, 0x57, 0x6c, 0x6d, 0x36, 0x66, 0xc3, 0xfa, 0x90, 0x4f, 0x65, 0xec, 0xee, 0xb3, 0x27,
+	0x2a, 0x9f, 0x6b, 0xb6, 0x25, 0x25, 0x73, 0x26, 0x1e, 0xdb, 0x66, 0x4d, 0x52, 0x62, 0x67, 0x02,
+	0x56, 0x94, 0x9b, 0x8a, 0x8a, 0x89, 0xbb, 0x7a, 0xa2, 0x6c, 0x5b, 0x0d, 0xba, 0x7d, 0xb1, 0x96,
+	0xfe, 0xe9, 0x62, 0x55, 0x3f, 0x5e, 0x8f, 0x3c, 0xe7, 0x66, 0xe4, 0x39, 0xbf, 0x47, 0x9e, 0xf3,
+	0x7d, 0xec, 0x95, 0x6e, 0xc6, 0x5e, 0xe9, 0xc7, 0xd8, 0x2b, 0x7d, 0xdd, 0x35, 0x2f, 0x7b, 0x57,
+	0x90, 0x84, 0xd0, 0x7e, 0x77, 0x79, 0x8c, 0xe1, 0xd5, 0xec, 0x6b, 0x57, 0xc3, 0x3e, 0xa4, 0xad,
+	0x65, 0xfd, 0xb4, 0x0f, 0xfe, 0x0c, 0x00, 0x94, 0xb2, 0xf1, 0x91, 0xae, 0x04, 0x00, 0x00,
 }
 
 func (m *EventCreateTFDenom) Marshal() (dAtA []byte, err error) {
@@ -522,6 +704,137 @@ func (m *EventSetTFDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSetTFBeforeSendHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetTFBeforeSendHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetTFBeforeSendHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetTFForceTransferEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetTFForceTransferEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetTFForceTransferEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForceTransferTFDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForceTransferTFDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForceTransferTFDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TransferToAddress) > 0 {
+		i -= len(m.TransferToAddress)
+		copy(dAtA[i:], m.TransferToAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TransferToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TransferFromAddress) > 0 {
+		i -= len(m.TransferFromAddress)
+		copy(dAtA[i:], m.TransferFromAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TransferFromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -612,6 +925,62 @@ func (m *EventSetTFDenomMetadata) Size() (n int) {
 	return n
 }
 
+func (m *EventSetTFBeforeSendHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetTFForceTransferEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventForceTransferTFDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TransferFromAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TransferToAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1191,6 +1560,401 @@ func (m *EventSetTFDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSetTFBeforeSendHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetTFBeforeSendHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetTFBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetTFForceTransferEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetTFForceTransferEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetTFForceTransferEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventForceTransferTFDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventForceTransferTFDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventForceTransferTFDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
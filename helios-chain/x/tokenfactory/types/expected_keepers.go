@@ -5,6 +5,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	erc20types "helios-core/helios-chain/x/erc20/types"
+	"helios-core/helios-chain/x/evm/core/vm"
+	"helios-core/helios-chain/x/evm/statedb"
+	evmtypes "helios-core/helios-chain/x/evm/types"
 )
 
 type BankKeeper interface {
@@ -33,3 +40,18 @@ type AccountKeeper interface {
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// Erc20Keeper defines the contract needed to register the ERC20 representation of
+// the factory denoms.
+type Erc20Keeper interface {
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	IsERC20Registered(ctx sdk.Context, erc20 common.Address) bool
+	SetToken(ctx sdk.Context, pair erc20types.TokenPair)
+	EnableDynamicPrecompiles(ctx sdk.Context, addresses ...common.Address) error
+}
+
+// EVMKeeper defines the contract needed to call the before send hooks of the denoms.
+type EVMKeeper interface {
+	GetAccount(ctx sdk.Context, address common.Address) *statedb.Account
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
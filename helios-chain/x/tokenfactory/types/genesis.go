@@ -3,6 +3,7 @@ package types
 import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // this line is used by starport scaffolding # genesis/types/import
@@ -45,6 +46,10 @@ func (gs GenesisState) Validate() error {
 				return errors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.BeforeSendHookAddress != "" && !common.IsHexAddress(denom.BeforeSendHookAddress) {
+			return errors.Wrapf(ErrInvalidBeforeSendHook, "invalid contract address %s of %s", denom.BeforeSendHookAddress, denom.GetDenom())
+		}
 	}
 
 	return nil
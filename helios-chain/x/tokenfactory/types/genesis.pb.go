@@ -86,6 +86,8 @@ type GenesisDenom struct {
 	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	Symbol            string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
 	Decimals          uint32                 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty" yaml:"decimals"`
+	// EVM contract consulted on every bank send of the denom, empty if unset
+	BeforeSendHookAddress string `protobuf:"bytes,6,opt,name=before_send_hook_address,json=beforeSendHookAddress,proto3" json:"before_send_hook_address,omitempty" yaml:"before_send_hook_address"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -156,6 +158,13 @@ func (m *GenesisDenom) GetDecimals() uint32 {
 	return 0
 }
 
+func (m *GenesisDenom) GetBeforeSendHookAddress() string {
+	if m != nil {
+		return m.BeforeSendHookAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "helios.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "helios.tokenfactory.v1beta1.GenesisDenom")
@@ -166,34 +175,37 @@ func init() {
 }
 
 var fileDescriptor_9e869f7a795f9263 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0xae, 0xd2, 0x40,
-	0x14, 0x86, 0x3b, 0xdc, 0x5e, 0xa2, 0xc3, 0xc5, 0x2b, 0xa3, 0x26, 0x15, 0x63, 0x8b, 0x43, 0x62,
-	0xca, 0x82, 0x36, 0xc0, 0x8e, 0x1d, 0x0d, 0x09, 0x2b, 0x13, 0x53, 0x77, 0x6e, 0xc8, 0x00, 0x23,
-	0x34, 0xb6, 0x1d, 0xd2, 0x8e, 0xc6, 0x3e, 0x80, 0x7b, 0x1f, 0xc1, 0x67, 0x31, 0x31, 0x61, 0xc9,
-	0xd2, 0x55, 0x63, 0x60, 0xe3, 0xba, 0x4f, 0x60, 0x3a, 0x33, 0x10, 0x91, 0xa4, 0xbb, 0xe9, 0x39,
-	0xdf, 0xff, 0xcf, 0x7f, 0x4e, 0x07, 0xf6, 0x36, 0x34, 0x0c, 0x58, 0xea, 0x72, 0xf6, 0x91, 0xc6,
-	0x1f, 0xc8, 0x92, 0xb3, 0x24, 0x73, 0x3f, 0x0f, 0x16, 0x94, 0x93, 0x81, 0xbb, 0xa6, 0x31, 0x4d,
-	0x83, 0xd4, 0xd9, 0x26, 0x8c, 0x33, 0xf4, 0x42, 0xa2, 0xce, 0xbf, 0xa8, 0xa3, 0xd0, 0xf6, 0xd3,
-	0x35, 0x5b, 0x33, 0xc1, 0xb9, 0xe5, 0x49, 0x4a, 0xda, 0xa3, 0x2a, 0x77, 0xf2, 0x89, 0x6f, 0x58,
-	0x12, 0xf0, 0xec, 0x0d, 0xe5, 0x64, 0x45, 0x38, 0x51, 0x22, 0xbb, 0x4a, 0xb4, 0x25, 0x09, 0x89,
-	0x54, 0x22, 0xfc, 0x03, 0xc0, 0xbb, 0x99, 0xcc, 0xf8, 0x8e, 0x13, 0x4e, 0xd1, 0x04, 0xd6, 0x25,
-	0x60, 0x80, 0x0e, 0xb0, 0x1b, 0xc3, 0xae, 0x53, 0x91, 0xd9, 0x79, 0x2b, 0x50, 0x4f, 0xdf, 0xe5,
-	0x96, 0xe6, 0x2b, 0x21, 0x62, 0xf0, 0x91, 0xe2, 0xe6, 0x2b, 0x1a, 0xb3, 0x28, 0x35, 0x6a, 0x9d,
-	0x1b, 0xbb, 0x31, 0xec, 0x55, 0x5a, 0xa9, 0x14, 0xd3, 0x52, 0xe1, 0xbd, 0x2c, 0x0d, 0x8b, 0xdc,
-	0x7a, 0x96, 0x91, 0x28, 0x1c, 0xe3, 0x4b, 0x3b, 0xec, 0x37, 0x55, 0x61, 0x2a, 0xbf, 0x7f, 0xd6,
-	0xce, 0x43, 0x88, 0x0a, 0x7a, 0x0d, 0x6f, 0x05, 0x2a, 0x66, 0x78, 0xe8, 0x3d, 0x2e, 0x72, 0xeb,
-	0x4e, 0x3a, 0x89, 0x32, 0xf6, 0x65, 0x1b, 0x7d, 0x05, 0x10, 0x9d, 0x77, 0x38, 0x8f, 0xd4, 0x12,
-	0x8d, 0x9a, 0x98, 0x7c, 0x54, 0x19, 0x57, 0x5c, 0x34, 0xf9, 0x7f, 0xff, 0xde, 0x2b, 0x15, 0xfc,
-	0xb9, 0xbc, 0xee, 0xda, 0x1c, 0xfb, 0xad, 0xab, 0xbf, 0x86, 0xba, 0x50, 0x8f, 0x49, 0x44, 0x8d,
-	0x1b, 0x11, 0xf7, 0xbe, 0xc8, 0xad, 0x86, 0xd4, 0x97, 0x55, 0xec, 0x8b, 0x26, 0xea, 0xc1, 0x7a,
-	0x9a, 0x45, 0x0b, 0x16, 0x1a, 0xba, 0xc0, 0x5a, 0x45, 0x6e, 0x35, 0x25, 0x26, 0xeb, 0xd8, 0x57,
-	0x00, 0x72, 0xe1, 0x83, 0x15, 0x5d, 0x06, 0x11, 0x09, 0x53, 0xe3, 0xb6, 0x03, 0xec, 0xa6, 0xf7,
-	0xa4, 0xc8, 0xad, 0xfb, 0xd3, 0x0a, 0x64, 0x07, 0xfb, 0x67, 0x68, 0xac, 0xff, 0xf9, 0x6e, 0x01,
-	0x6f, 0xb6, 0x3b, 0x98, 0x60, 0x7f, 0x30, 0xc1, 0xef, 0x83, 0x09, 0xbe, 0x1d, 0x4d, 0x6d, 0x7f,
-	0x34, 0xb5, 0x5f, 0x47, 0x53, 0x7b, 0xdf, 0x97, 0xab, 0xe8, 0x2f, 0x59, 0x42, 0xdd, 0xd3, 0x79,
-	0x43, 0x82, 0xd8, 0xfd, 0x72, 0xf9, 0xc8, 0x78, 0xb6, 0xa5, 0xe9, 0xa2, 0x2e, 0x1e, 0xd7, 0xe8,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xe3, 0x42, 0x06, 0x1b, 0x03, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0xb5, 0xab, 0xc0, 0x5d, 0x19, 0x33, 0x4c, 0x0a, 0x43, 0xc4, 0xc5, 0x95, 0x50,
+	0x7b, 0x58, 0xa3, 0xad, 0xb7, 0xdd, 0x1a, 0x4d, 0x1a, 0x17, 0x24, 0x94, 0xdd, 0x10, 0x52, 0xe4,
+	0x36, 0x5e, 0x1b, 0xb5, 0xc9, 0xab, 0x62, 0x83, 0xc8, 0x07, 0xe0, 0xce, 0x47, 0x40, 0xe2, 0x9b,
+	0x70, 0xda, 0x71, 0x47, 0x4e, 0x11, 0x6a, 0x2f, 0x9c, 0xf3, 0x09, 0x50, 0x6d, 0xaf, 0x62, 0x4c,
+	0xcb, 0xcd, 0x79, 0xef, 0xf7, 0xff, 0xfb, 0xff, 0x5e, 0x8c, 0xfb, 0x33, 0xb1, 0x88, 0x41, 0x7a,
+	0x0a, 0xe6, 0x22, 0xbd, 0xe2, 0x13, 0x05, 0x59, 0xee, 0x7d, 0x3e, 0x19, 0x0b, 0xc5, 0x4f, 0xbc,
+	0xa9, 0x48, 0x85, 0x8c, 0xe5, 0x60, 0x99, 0x81, 0x02, 0xf2, 0xd2, 0xa0, 0x83, 0x7f, 0xd1, 0x81,
+	0x45, 0x8f, 0x9e, 0x4f, 0x61, 0x0a, 0x9a, 0xf3, 0x36, 0x27, 0x23, 0x39, 0x1a, 0x56, 0xb9, 0xf3,
+	0x4f, 0x6a, 0x06, 0x59, 0xac, 0xf2, 0x77, 0x42, 0xf1, 0x88, 0x2b, 0x6e, 0x45, 0xbd, 0x2a, 0xd1,
+	0x92, 0x67, 0x3c, 0xb1, 0x89, 0xd8, 0x4f, 0x84, 0xf7, 0x2e, 0x4c, 0xc6, 0x4b, 0xc5, 0x95, 0x20,
+	0x23, 0xdc, 0x34, 0x80, 0x83, 0x3a, 0xa8, 0xd7, 0x3a, 0xed, 0x0e, 0x2a, 0x32, 0x0f, 0xde, 0x6b,
+	0xd4, 0x6f, 0x5c, 0x17, 0xb4, 0x16, 0x58, 0x21, 0x01, 0xfc, 0xc4, 0x72, 0x61, 0x24, 0x52, 0x48,
+	0xa4, 0xb3, 0xd3, 0xa9, 0xf7, 0x5a, 0xa7, 0xfd, 0x4a, 0x2b, 0x9b, 0xe2, 0x7c, 0xa3, 0xf0, 0x5f,
+	0x6d, 0x0c, 0xcb, 0x82, 0x1e, 0xe6, 0x3c, 0x59, 0x9c, 0xb1, 0xbb, 0x76, 0x2c, 0x68, 0xdb, 0xc2,
+	0xb9, 0xf9, 0xfe, 0x51, 0xdf, 0x0e, 0xa1, 0x2b, 0xe4, 0x0d, 0xde, 0xd5, 0xa8, 0x9e, 0xe1, 0xb1,
+	0xff, 0xb4, 0x2c, 0xe8, 0x9e, 0x71, 0xd2, 0x65, 0x16, 0x98, 0x36, 0xf9, 0x8a, 0x30, 0xd9, 0xee,
+	0x30, 0x4c, 0xec, 0x12, 0x9d, 0x1d, 0x3d, 0xf9, 0xb0, 0x32, 0xae, 0xbe, 0x68, 0xf4, 0xff, 0xfe,
+	0xfd, 0xd7, 0x36, 0xf8, 0x0b, 0x73, 0xdd, 0x7d, 0x73, 0x16, 0x1c, 0xdc, 0xfb, 0x6b, 0xa4, 0x8b,
+	0x1b, 0x29, 0x4f, 0x84, 0x53, 0xd7, 0x71, 0xf7, 0xcb, 0x82, 0xb6, 0x8c, 0x7e, 0x53, 0x65, 0x81,
+	0x6e, 0x92, 0x3e, 0x6e, 0xca, 0x3c, 0x19, 0xc3, 0xc2, 0x69, 0x68, 0xec, 0xa0, 0x2c, 0x68, 0xdb,
+	0x60, 0xa6, 0xce, 0x02, 0x0b, 0x10, 0x0f, 0x3f, 0x8a, 0xc4, 0x24, 0x4e, 0xf8, 0x42, 0x3a, 0xbb,
+	0x1d, 0xd4, 0x6b, 0xfb, 0xcf, 0xca, 0x82, 0xee, 0xdf, 0xae, 0xc0, 0x74, 0x58, 0xb0, 0x85, 0xc8,
+	0x47, 0xec, 0x8c, 0xc5, 0x15, 0x64, 0x22, 0x94, 0x22, 0x8d, 0xc2, 0x19, 0xc0, 0x3c, 0xe4, 0x51,
+	0x94, 0x09, 0x29, 0x9d, 0xa6, 0xbe, 0xad, 0x5b, 0x16, 0x94, 0x1a, 0x83, 0x87, 0x48, 0x16, 0x1c,
+	0x9a, 0xd6, 0xa5, 0x48, 0xa3, 0xb7, 0x00, 0xf3, 0x91, 0xa9, 0x9f, 0x35, 0xfe, 0x7c, 0xa7, 0xc8,
+	0xbf, 0xb8, 0x5e, 0xb9, 0xe8, 0x66, 0xe5, 0xa2, 0xdf, 0x2b, 0x17, 0x7d, 0x5b, 0xbb, 0xb5, 0x9b,
+	0xb5, 0x5b, 0xfb, 0xb5, 0x76, 0x6b, 0x1f, 0x8e, 0xcd, 0xa2, 0x8f, 0x27, 0x90, 0x09, 0xef, 0xf6,
+	0x3c, 0xe3, 0x71, 0xea, 0x7d, 0xb9, 0xfb, 0x84, 0x55, 0xbe, 0x14, 0x72, 0xdc, 0xd4, 0x4f, 0x77,
+	0xf8, 0x77, 0x00, 0xc9, 0x37, 0xdd, 0xe6, 0x79, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -230,6 +242,9 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if this.Decimals != that1.Decimals {
 		return false
 	}
+	if this.BeforeSendHookAddress != that1.BeforeSendHookAddress {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -299,6 +314,13 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BeforeSendHookAddress) > 0 {
+		i -= len(m.BeforeSendHookAddress)
+		copy(dAtA[i:], m.BeforeSendHookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHookAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Decimals != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
 		i--
@@ -389,6 +411,10 @@ func (m *GenesisDenom) Size() (n int) {
 	if m.Decimals != 0 {
 		n += 1 + sovGenesis(uint64(m.Decimals))
 	}
+	l = len(m.BeforeSendHookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -692,6 +718,38 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
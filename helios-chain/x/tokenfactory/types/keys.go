@@ -30,6 +30,7 @@ var (
 	CreatorPrefixKey          = []byte{0x03}
 	AdminPrefixKey            = []byte{0x04}
 	ParamsKey                 = []byte{0x05}
+	BeforeSendHookAddressKey  = []byte{0x06}
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -305,6 +305,106 @@ func (m *QueryDenomsFromCreatorResponse) GetDenoms() []string {
 	return nil
 }
 
+// QueryBeforeSendHookAddressRequest defines the request structure for the
+// BeforeSendHookAddress gRPC query.
+type QueryBeforeSendHookAddressRequest struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	SubDenom string `protobuf:"bytes,2,opt,name=sub_denom,json=subDenom,proto3" json:"sub_denom,omitempty" yaml:"sub_denom"`
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Reset()         { *m = QueryBeforeSendHookAddressRequest{} }
+func (m *QueryBeforeSendHookAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBeforeSendHookAddressRequest) ProtoMessage()    {}
+func (*QueryBeforeSendHookAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fe7854e5b401122, []int{6}
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeforeSendHookAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeforeSendHookAddressRequest.Merge(m, src)
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeforeSendHookAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeforeSendHookAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBeforeSendHookAddressRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryBeforeSendHookAddressRequest) GetSubDenom() string {
+	if m != nil {
+		return m.SubDenom
+	}
+	return ""
+}
+
+// QueryBeforeSendHookAddressResponse defines the response structure for the
+// BeforeSendHookAddress gRPC query.
+type QueryBeforeSendHookAddressResponse struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Reset()         { *m = QueryBeforeSendHookAddressResponse{} }
+func (m *QueryBeforeSendHookAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBeforeSendHookAddressResponse) ProtoMessage()    {}
+func (*QueryBeforeSendHookAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fe7854e5b401122, []int{7}
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeforeSendHookAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeforeSendHookAddressResponse.Merge(m, src)
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeforeSendHookAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeforeSendHookAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBeforeSendHookAddressResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
 // QueryModuleStateRequest is the request type for the
 // Query/TokenfactoryModuleState RPC method.
 type QueryModuleStateRequest struct {
@@ -314,7 +414,7 @@ func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest
 func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateRequest) ProtoMessage()    {}
 func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fe7854e5b401122, []int{6}
+	return fileDescriptor_1fe7854e5b401122, []int{8}
 }
 func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +453,7 @@ func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateRespon
 func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateResponse) ProtoMessage()    {}
 func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fe7854e5b401122, []int{7}
+	return fileDescriptor_1fe7854e5b401122, []int{9}
 }
 func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,6 +496,8 @@ func init() {
 	proto.RegisterType((*QueryDenomAuthorityMetadataResponse)(nil), "helios.tokenfactory.v1beta1.QueryDenomAuthorityMetadataResponse")
 	proto.RegisterType((*QueryDenomsFromCreatorRequest)(nil), "helios.tokenfactory.v1beta1.QueryDenomsFromCreatorRequest")
 	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "helios.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
+	proto.RegisterType((*QueryBeforeSendHookAddressRequest)(nil), "helios.tokenfactory.v1beta1.QueryBeforeSendHookAddressRequest")
+	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "helios.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
 	proto.RegisterType((*QueryModuleStateRequest)(nil), "helios.tokenfactory.v1beta1.QueryModuleStateRequest")
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "helios.tokenfactory.v1beta1.QueryModuleStateResponse")
 }
@@ -405,50 +507,56 @@ func init() {
 }
 
 var fileDescriptor_1fe7854e5b401122 = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4e, 0x13, 0x41,
-	0x1c, 0xee, 0xa0, 0x54, 0x19, 0xff, 0x04, 0x46, 0x22, 0x50, 0x75, 0xab, 0x43, 0x8c, 0x14, 0xa4,
-	0x63, 0x41, 0x2f, 0x68, 0x82, 0x54, 0x23, 0x07, 0xc5, 0x68, 0xe5, 0x60, 0xf4, 0xd0, 0x4c, 0xcb,
-	0xb0, 0x6c, 0xec, 0xee, 0x2c, 0x3b, 0xb3, 0xc6, 0x86, 0x70, 0xd1, 0x78, 0x37, 0xf1, 0xe2, 0x0b,
-	0x78, 0xf4, 0x01, 0x7c, 0x03, 0x8e, 0x24, 0x5e, 0x3c, 0x6d, 0x0c, 0xf8, 0x02, 0xf6, 0x09, 0xcc,
-	0xce, 0x4c, 0x4b, 0x61, 0x71, 0xa9, 0xdc, 0x36, 0x33, 0xdf, 0xf7, 0xfd, 0x7e, 0xdf, 0x6f, 0xbe,
-	0x5f, 0x16, 0xde, 0x58, 0x63, 0x0d, 0x87, 0x0b, 0x22, 0xf9, 0x1b, 0xe6, 0xad, 0xd2, 0xba, 0xe4,
-	0x41, 0x93, 0xbc, 0x2d, 0xd5, 0x98, 0xa4, 0x25, 0xb2, 0x1e, 0xb2, 0xa0, 0x59, 0xf4, 0x03, 0x2e,
-	0x39, 0xba, 0xa4, 0x81, 0xc5, 0x6e, 0x60, 0xd1, 0x00, 0x73, 0xc3, 0x36, 0xb7, 0xb9, 0xc2, 0x91,
-	0xf8, 0x4b, 0x53, 0x72, 0x97, 0x6d, 0xce, 0xed, 0x06, 0x23, 0xd4, 0x77, 0x08, 0xf5, 0x3c, 0x2e,
-	0xa9, 0x74, 0xb8, 0x27, 0xcc, 0xed, 0x64, 0x9d, 0x0b, 0x97, 0x0b, 0x52, 0xa3, 0x82, 0xe9, 0x4a,
-	0x9d, 0xba, 0x3e, 0xb5, 0x1d, 0x4f, 0x81, 0x0d, 0x76, 0x36, 0xad, 0x4b, 0x1a, 0xca, 0x35, 0x1e,
-	0x38, 0xb2, 0xb9, 0xc4, 0x24, 0x5d, 0xa1, 0x92, 0x1a, 0xd2, 0x44, 0x1a, 0xc9, 0xa7, 0x01, 0x75,
-	0xdb, 0xad, 0x14, 0xd2, 0x90, 0x36, 0xf3, 0x98, 0x70, 0x0c, 0x14, 0x0f, 0x43, 0xf4, 0x3c, 0xee,
-	0xf5, 0x99, 0xe2, 0x57, 0xd8, 0x7a, 0xc8, 0x84, 0xc4, 0x2f, 0xe1, 0x85, 0x7d, 0xa7, 0xc2, 0xe7,
-	0x9e, 0x60, 0x68, 0x01, 0x66, 0x75, 0x9d, 0x51, 0x70, 0x15, 0x4c, 0x9c, 0x99, 0x19, 0x2f, 0xa6,
-	0x0c, 0xb1, 0xa8, 0xc9, 0xe5, 0x93, 0x5b, 0x51, 0x3e, 0x53, 0x31, 0x44, 0xfc, 0x01, 0x40, 0xac,
-	0xa4, 0x1f, 0x32, 0x8f, 0xbb, 0x0b, 0x07, 0xad, 0x9a, 0x06, 0xd0, 0x24, 0x3c, 0x55, 0x0f, 0x18,
-	0x95, 0x3c, 0x50, 0xa5, 0x06, 0xca, 0x83, 0xad, 0x28, 0x7f, 0xb6, 0x49, 0xdd, 0xc6, 0x1c, 0x5e,
-	0x89, 0x99, 0xb8, 0xd2, 0x06, 0xa0, 0x12, 0x1c, 0x10, 0x61, 0xad, 0xaa, 0x8e, 0x47, 0xfb, 0x14,
-	0x7a, 0xb8, 0x15, 0xe5, 0x07, 0x35, 0xba, 0x73, 0x85, 0x2b, 0xa7, 0x45, 0x58, 0x53, 0x65, 0xf1,
-	0x37, 0x00, 0xc7, 0x53, 0xbb, 0x30, 0x86, 0x3f, 0x02, 0x88, 0x3a, 0xcf, 0x51, 0x75, 0xcd, 0xb5,
-	0x71, 0x3f, 0x9b, 0xea, 0xfe, 0x70, 0xe5, 0xf2, 0xb5, 0x78, 0x1a, 0xad, 0x28, 0x3f, 0xa6, 0xbb,
-	0x4b, 0x8a, 0xe3, 0xca, 0x50, 0x22, 0x00, 0x78, 0x09, 0x5e, 0xd9, 0x6b, 0x57, 0x3c, 0x0a, 0xb8,
-	0xfb, 0x40, 0x9b, 0x6f, 0xcf, 0xeb, 0xe6, 0xc1, 0x79, 0xa1, 0x56, 0x94, 0x3f, 0xaf, 0x6b, 0x98,
-	0x8b, 0xbd, 0x89, 0xe1, 0xc7, 0xd0, 0xfa, 0x97, 0x9c, 0x31, 0x5e, 0x80, 0x59, 0x35, 0xb4, 0xf8,
-	0xa5, 0x4f, 0x4c, 0x0c, 0x94, 0x87, 0x5a, 0x51, 0xfe, 0x5c, 0xd7, 0xf8, 0x05, 0xae, 0x18, 0x00,
-	0x1e, 0x83, 0x23, 0x4a, 0x6c, 0x89, 0xaf, 0x84, 0x0d, 0xf6, 0x42, 0x52, 0xc9, 0xda, 0x31, 0x7a,
-	0x0d, 0x47, 0x93, 0x57, 0xa6, 0xc2, 0x3c, 0xec, 0x17, 0xf1, 0x81, 0x19, 0x66, 0x21, 0x75, 0x98,
-	0x8b, 0x3a, 0xb3, 0x5a, 0x41, 0xf3, 0x66, 0xbe, 0x66, 0x61, 0xbf, 0x52, 0x47, 0x5f, 0x00, 0xcc,
-	0xea, 0xb0, 0x21, 0x92, 0x2a, 0x93, 0x4c, 0x7a, 0xee, 0x56, 0xef, 0x04, 0xdd, 0x38, 0x9e, 0x7a,
-	0xff, 0xe3, 0xf7, 0xe7, 0xbe, 0xeb, 0x68, 0x9c, 0x1c, 0xbd, 0x8f, 0xe8, 0x0f, 0x80, 0x17, 0x0f,
-	0x4f, 0x02, 0x9a, 0x3f, 0xba, 0x72, 0xea, 0x8e, 0xe4, 0xee, 0x1f, 0x5f, 0xc0, 0x58, 0x59, 0x56,
-	0x56, 0x9e, 0xa2, 0x27, 0xa9, 0x56, 0xf4, 0x3b, 0x93, 0x0d, 0x93, 0x9e, 0x4d, 0xb2, 0xd1, 0xd9,
-	0xa9, 0x4d, 0x92, 0x0c, 0x30, 0xda, 0x06, 0x70, 0x28, 0x91, 0x2c, 0x34, 0xd7, 0x63, 0xb7, 0x87,
-	0xa4, 0x3b, 0x77, 0xf7, 0x58, 0x5c, 0x63, 0xb2, 0xac, 0x4c, 0xde, 0x43, 0x73, 0x3d, 0x98, 0xac,
-	0xae, 0x06, 0xdc, 0xad, 0x1a, 0xa3, 0x7b, 0x8e, 0xd1, 0x77, 0x00, 0x47, 0x96, 0xbb, 0x68, 0x5d,
-	0x81, 0x46, 0xb7, 0x8f, 0x6e, 0x2e, 0xb9, 0x1a, 0xb9, 0x3b, 0xff, 0xc9, 0x32, 0x66, 0x4a, 0xca,
-	0xcc, 0x14, 0x2a, 0xa4, 0x9a, 0x71, 0x15, 0xb3, 0xaa, 0xf6, 0xa4, 0xbc, 0xb8, 0xb5, 0x63, 0x81,
-	0xed, 0x1d, 0x0b, 0xfc, 0xda, 0xb1, 0xc0, 0xa7, 0x5d, 0x2b, 0xb3, 0xbd, 0x6b, 0x65, 0x7e, 0xee,
-	0x5a, 0x99, 0x57, 0xd3, 0x5a, 0x63, 0xba, 0xce, 0x03, 0x46, 0xda, 0xdf, 0x6b, 0xd4, 0xf1, 0xc8,
-	0xbb, 0xfd, 0xba, 0xb2, 0xe9, 0x33, 0x51, 0xcb, 0xaa, 0x3f, 0xc6, 0xec, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xe0, 0xe7, 0xc5, 0x2e, 0x63, 0x07, 0x00, 0x00,
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x4f, 0x53, 0x4d,
+	0x18, 0xed, 0xf0, 0xbe, 0xf4, 0x85, 0x79, 0xfd, 0x80, 0x11, 0x05, 0x8a, 0xb6, 0x32, 0xc4, 0x48,
+	0x41, 0x3a, 0x16, 0x74, 0x83, 0x46, 0xa4, 0x1a, 0x30, 0x0a, 0x46, 0x2f, 0x2c, 0x8c, 0x2e, 0x6e,
+	0xa6, 0xed, 0xd0, 0x36, 0xf4, 0xde, 0x29, 0x77, 0xa6, 0xc6, 0x86, 0xb0, 0xf1, 0x63, 0x6f, 0xa2,
+	0x0b, 0xff, 0x84, 0x3f, 0xc0, 0x7f, 0xc0, 0x92, 0xc4, 0x8d, 0xab, 0xc6, 0x80, 0x4b, 0x37, 0xf6,
+	0x17, 0x98, 0xce, 0x4c, 0x4b, 0xe1, 0xd6, 0x5b, 0xec, 0xae, 0xb9, 0x73, 0xce, 0x79, 0xce, 0x79,
+	0xe6, 0x79, 0x26, 0x85, 0x57, 0xf3, 0xac, 0x58, 0xe0, 0x82, 0x48, 0xbe, 0xc9, 0xdc, 0x0d, 0x9a,
+	0x91, 0xdc, 0xab, 0x90, 0x97, 0xc9, 0x34, 0x93, 0x34, 0x49, 0xb6, 0xca, 0xcc, 0xab, 0x24, 0x4a,
+	0x1e, 0x97, 0x1c, 0x8d, 0x69, 0x60, 0xa2, 0x15, 0x98, 0x30, 0xc0, 0xc8, 0x50, 0x8e, 0xe7, 0xb8,
+	0xc2, 0x91, 0xfa, 0x2f, 0x4d, 0x89, 0x5c, 0xcc, 0x71, 0x9e, 0x2b, 0x32, 0x42, 0x4b, 0x05, 0x42,
+	0x5d, 0x97, 0x4b, 0x2a, 0x0b, 0xdc, 0x15, 0xe6, 0x74, 0x2a, 0xc3, 0x85, 0xc3, 0x05, 0x49, 0x53,
+	0xc1, 0x74, 0xa5, 0x66, 0xdd, 0x12, 0xcd, 0x15, 0x5c, 0x05, 0x36, 0xd8, 0xb9, 0x20, 0x97, 0xb4,
+	0x2c, 0xf3, 0xdc, 0x2b, 0xc8, 0xca, 0x2a, 0x93, 0x34, 0x4b, 0x25, 0x35, 0xa4, 0xc9, 0x20, 0x52,
+	0x89, 0x7a, 0xd4, 0x69, 0x58, 0x89, 0x07, 0x21, 0x73, 0xcc, 0x65, 0xa2, 0x60, 0xa0, 0x78, 0x08,
+	0xa2, 0xa7, 0x75, 0xaf, 0x4f, 0x14, 0xdf, 0x62, 0x5b, 0x65, 0x26, 0x24, 0x7e, 0x06, 0xcf, 0x1d,
+	0xf9, 0x2a, 0x4a, 0xdc, 0x15, 0x0c, 0x2d, 0xc2, 0xb0, 0xae, 0x33, 0x02, 0x2e, 0x83, 0xc9, 0xff,
+	0x67, 0x27, 0x12, 0x01, 0x4d, 0x4c, 0x68, 0x72, 0xea, 0xdf, 0xdd, 0x6a, 0x2c, 0x64, 0x19, 0x22,
+	0x7e, 0x03, 0x20, 0x56, 0xd2, 0xf7, 0x99, 0xcb, 0x9d, 0xc5, 0xe3, 0x51, 0x8d, 0x01, 0x34, 0x05,
+	0xff, 0xcb, 0x78, 0x8c, 0x4a, 0xee, 0xa9, 0x52, 0xfd, 0xa9, 0x81, 0x5a, 0x35, 0x76, 0xaa, 0x42,
+	0x9d, 0xe2, 0x3c, 0xce, 0xd6, 0x99, 0xd8, 0x6a, 0x00, 0x50, 0x12, 0xf6, 0x8b, 0x72, 0xda, 0x56,
+	0x9f, 0x47, 0x7a, 0x14, 0x7a, 0xa8, 0x56, 0x8d, 0x0d, 0x68, 0x74, 0xf3, 0x08, 0x5b, 0x7d, 0xa2,
+	0x9c, 0x56, 0x65, 0xf1, 0x67, 0x00, 0x27, 0x02, 0x5d, 0x98, 0xc0, 0xef, 0x00, 0x44, 0xcd, 0xeb,
+	0xb0, 0x1d, 0x73, 0x6c, 0xd2, 0xcf, 0x05, 0xa6, 0x6f, 0xaf, 0x9c, 0x1a, 0xaf, 0x77, 0xa3, 0x56,
+	0x8d, 0x8d, 0x6a, 0x77, 0x7e, 0x71, 0x6c, 0x0d, 0xfa, 0x06, 0x00, 0xaf, 0xc2, 0x4b, 0x87, 0x76,
+	0xc5, 0x92, 0xc7, 0x9d, 0x7b, 0x3a, 0x7c, 0xa3, 0x5f, 0xd7, 0x8e, 0xf7, 0x0b, 0xd5, 0xaa, 0xb1,
+	0x33, 0xba, 0x86, 0x39, 0x38, 0xec, 0x18, 0x7e, 0x04, 0xa3, 0x7f, 0x92, 0x33, 0xc1, 0xe3, 0x30,
+	0xac, 0x9a, 0x56, 0xbf, 0xe9, 0x7f, 0x26, 0xfb, 0x53, 0x83, 0xb5, 0x6a, 0xec, 0x74, 0x4b, 0xfb,
+	0x05, 0xb6, 0x0c, 0x00, 0xbf, 0x05, 0x70, 0x5c, 0xa9, 0xa5, 0xd8, 0x06, 0xf7, 0xd8, 0x1a, 0x73,
+	0xb3, 0x0f, 0x38, 0xdf, 0x5c, 0xcc, 0x66, 0x3d, 0x26, 0x44, 0x57, 0x06, 0xbb, 0xb9, 0xd2, 0x22,
+	0xc4, 0x41, 0x2e, 0x4c, 0xae, 0x25, 0x38, 0x90, 0xe1, 0xae, 0xf4, 0x68, 0x46, 0xda, 0x54, 0x9f,
+	0x19, 0x3f, 0x63, 0xb5, 0x6a, 0x6c, 0xd8, 0xf8, 0x39, 0x86, 0xc0, 0xd6, 0xd9, 0xc6, 0x27, 0xa3,
+	0x87, 0x47, 0xe1, 0xb0, 0xaa, 0xb6, 0xca, 0xb3, 0xe5, 0x22, 0x5b, 0x93, 0x54, 0xb2, 0xc6, 0xee,
+	0xbc, 0x80, 0x23, 0xfe, 0x23, 0x53, 0x7e, 0x01, 0xf6, 0x8a, 0xfa, 0x07, 0x33, 0x41, 0xf1, 0xc0,
+	0x09, 0x5a, 0xd6, 0x8b, 0xaa, 0x15, 0x34, 0x6f, 0xf6, 0x63, 0x1f, 0xec, 0x55, 0xea, 0xe8, 0x13,
+	0x80, 0x61, 0xbd, 0x61, 0x88, 0x04, 0xca, 0xf8, 0xd7, 0x3b, 0x72, 0xfd, 0xe4, 0x04, 0x6d, 0x1c,
+	0x4f, 0xbf, 0xfe, 0xfa, 0xe3, 0x43, 0xcf, 0x15, 0x34, 0x41, 0x3a, 0x3f, 0x42, 0xe8, 0x17, 0x80,
+	0x17, 0xda, 0x8f, 0x3f, 0x5a, 0xe8, 0x5c, 0x39, 0xf0, 0x61, 0x88, 0xdc, 0xed, 0x5e, 0xc0, 0x44,
+	0x59, 0x57, 0x51, 0x1e, 0xa3, 0x95, 0xc0, 0x28, 0x7a, 0xb8, 0xc9, 0xb6, 0x99, 0xc8, 0x1d, 0xb2,
+	0xdd, 0x9c, 0xba, 0x1d, 0xe2, 0xdf, 0x5a, 0xb4, 0x07, 0xe0, 0xa0, 0x6f, 0x9d, 0xd0, 0xfc, 0x09,
+	0xdd, 0xb6, 0x59, 0xe9, 0xc8, 0xad, 0xae, 0xb8, 0x26, 0x64, 0x4a, 0x85, 0xbc, 0x8d, 0xe6, 0x4f,
+	0x10, 0xd2, 0xde, 0xf0, 0xb8, 0x63, 0x9b, 0xa0, 0x87, 0x89, 0xd1, 0x4f, 0x00, 0xcf, 0xb7, 0xdd,
+	0x26, 0x74, 0xa7, 0xb3, 0xb5, 0xa0, 0xc7, 0x20, 0xb2, 0xd0, 0x35, 0xdf, 0xc4, 0xb3, 0x54, 0xbc,
+	0x15, 0xf4, 0xb0, 0xfb, 0x3b, 0x4c, 0xab, 0x02, 0xb6, 0x60, 0x6e, 0xd6, 0xce, 0x73, 0xbe, 0x89,
+	0xbe, 0x00, 0x38, 0xbc, 0xde, 0x22, 0xd3, 0xb2, 0xbf, 0xe8, 0x46, 0x67, 0xc3, 0xfe, 0x97, 0x20,
+	0x72, 0xf3, 0x2f, 0x59, 0x26, 0x5c, 0x52, 0x85, 0x9b, 0x46, 0xf1, 0xc0, 0x70, 0x8e, 0x62, 0xda,
+	0xea, 0x59, 0x48, 0x2d, 0xef, 0xee, 0x47, 0xc1, 0xde, 0x7e, 0x14, 0x7c, 0xdf, 0x8f, 0x82, 0xf7,
+	0x07, 0xd1, 0xd0, 0xde, 0x41, 0x34, 0xf4, 0xed, 0x20, 0x1a, 0x7a, 0x3e, 0xa3, 0x35, 0x66, 0x32,
+	0xdc, 0x63, 0xa4, 0xf1, 0x3b, 0x4f, 0x0b, 0x2e, 0x79, 0x75, 0x54, 0x57, 0x56, 0x4a, 0x4c, 0xa4,
+	0xc3, 0xea, 0x5f, 0xc1, 0xdc, 0xef, 0x01, 0x00, 0xfb, 0x87, 0x6f, 0x69, 0x47, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -472,6 +580,9 @@ type QueryClient interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// BeforeSendHookAddress defines a gRPC query method for fetching the address
+	// of the EVM contract consulted on the sends of a particular denom.
+	BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error)
 	// Retrieves the entire auction module's state
 	TokenfactoryModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 }
@@ -511,6 +622,15 @@ func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFrom
 	return out, nil
 }
 
+func (c *queryClient) BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error) {
+	out := new(QueryBeforeSendHookAddressResponse)
+	err := c.cc.Invoke(ctx, "/helios.tokenfactory.v1beta1.Query/BeforeSendHookAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TokenfactoryModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
 	out := new(QueryModuleStateResponse)
 	err := c.cc.Invoke(ctx, "/helios.tokenfactory.v1beta1.Query/TokenfactoryModuleState", in, out, opts...)
@@ -531,6 +651,9 @@ type QueryServer interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// BeforeSendHookAddress defines a gRPC query method for fetching the address
+	// of the EVM contract consulted on the sends of a particular denom.
+	BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error)
 	// Retrieves the entire auction module's state
 	TokenfactoryModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 }
@@ -548,6 +671,9 @@ func (*UnimplementedQueryServer) DenomAuthorityMetadata(ctx context.Context, req
 func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
 }
+func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendHookAddress not implemented")
+}
 func (*UnimplementedQueryServer) TokenfactoryModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenfactoryModuleState not implemented")
 }
@@ -610,6 +736,24 @@ func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BeforeSendHookAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeforeSendHookAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BeforeSendHookAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.tokenfactory.v1beta1.Query/BeforeSendHookAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BeforeSendHookAddress(ctx, req.(*QueryBeforeSendHookAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TokenfactoryModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModuleStateRequest)
 	if err := dec(in); err != nil {
@@ -628,6 +772,7 @@ func _Query_TokenfactoryModuleState_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helios.tokenfactory.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -644,6 +789,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsFromCreator",
 			Handler:    _Query_DenomsFromCreator_Handler,
 		},
+		{
+			MethodName: "BeforeSendHookAddress",
+			Handler:    _Query_BeforeSendHookAddress_Handler,
+		},
 		{
 			MethodName: "TokenfactoryModuleState",
 			Handler:    _Query_TokenfactoryModuleState_Handler,
@@ -841,6 +990,73 @@ func (m *QueryDenomsFromCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBeforeSendHookAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeforeSendHookAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeforeSendHookAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubDenom) > 0 {
+		i -= len(m.SubDenom)
+		copy(dAtA[i:], m.SubDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeforeSendHookAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeforeSendHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -986,6 +1202,36 @@ func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SubDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryModuleStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1508,6 +1754,202 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
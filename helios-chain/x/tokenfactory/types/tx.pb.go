@@ -629,6 +629,317 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetBeforeSendHook is the sdk.Msg type for allowing an admin account to
+// attach an EVM contract to the denom, consulted on every bank send of the
+// denom. An empty contract address removes the hook.
+type MsgSetBeforeSendHook struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// hex address of the EVM contract implementing the before send hook
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+}
+
+func (m *MsgSetBeforeSendHook) Reset()         { *m = MsgSetBeforeSendHook{} }
+func (m *MsgSetBeforeSendHook) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBeforeSendHook) ProtoMessage()    {}
+func (*MsgSetBeforeSendHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dcc10a6012e0d37, []int{12}
+}
+func (m *MsgSetBeforeSendHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBeforeSendHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBeforeSendHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBeforeSendHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBeforeSendHook.Merge(m, src)
+}
+func (m *MsgSetBeforeSendHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBeforeSendHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBeforeSendHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBeforeSendHook proto.InternalMessageInfo
+
+func (m *MsgSetBeforeSendHook) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetBeforeSendHook) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetBeforeSendHook) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgSetBeforeSendHookResponse defines the response structure for an executed
+// MsgSetBeforeSendHook message.
+type MsgSetBeforeSendHookResponse struct {
+}
+
+func (m *MsgSetBeforeSendHookResponse) Reset()         { *m = MsgSetBeforeSendHookResponse{} }
+func (m *MsgSetBeforeSendHookResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBeforeSendHookResponse) ProtoMessage()    {}
+func (*MsgSetBeforeSendHookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dcc10a6012e0d37, []int{13}
+}
+func (m *MsgSetBeforeSendHookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBeforeSendHookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBeforeSendHookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBeforeSendHookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBeforeSendHookResponse.Merge(m, src)
+}
+func (m *MsgSetBeforeSendHookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBeforeSendHookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBeforeSendHookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBeforeSendHookResponse proto.InternalMessageInfo
+
+// MsgSetForceTransferEnabled is the sdk.Msg type for allowing an admin account
+// to opt the denom in or out of MsgForceTransfer
+type MsgSetForceTransferEnabled struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *MsgSetForceTransferEnabled) Reset()         { *m = MsgSetForceTransferEnabled{} }
+func (m *MsgSetForceTransferEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForceTransferEnabled) ProtoMessage()    {}
+func (*MsgSetForceTransferEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dcc10a6012e0d37, []int{14}
+}
+func (m *MsgSetForceTransferEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForceTransferEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForceTransferEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetForceTransferEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForceTransferEnabled.Merge(m, src)
+}
+func (m *MsgSetForceTransferEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetForceTransferEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForceTransferEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetForceTransferEnabled proto.InternalMessageInfo
+
+func (m *MsgSetForceTransferEnabled) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetForceTransferEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetForceTransferEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetForceTransferEnabledResponse defines the response structure for an
+// executed MsgSetForceTransferEnabled message.
+type MsgSetForceTransferEnabledResponse struct {
+}
+
+func (m *MsgSetForceTransferEnabledResponse) Reset()         { *m = MsgSetForceTransferEnabledResponse{} }
+func (m *MsgSetForceTransferEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForceTransferEnabledResponse) ProtoMessage()    {}
+func (*MsgSetForceTransferEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dcc10a6012e0d37, []int{15}
+}
+func (m *MsgSetForceTransferEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForceTransferEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForceTransferEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetForceTransferEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForceTransferEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetForceTransferEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetForceTransferEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForceTransferEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetForceTransferEnabledResponse proto.InternalMessageInfo
+
+// MsgForceTransfer is the sdk.Msg type for allowing the admin account of a
+// denom opted in the force transfers to move the denom between any two
+// accounts. The before send hook of the denom is not consulted.
+type MsgForceTransfer struct {
+	Sender              string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Amount              types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+	TransferFromAddress string     `protobuf:"bytes,3,opt,name=transfer_from_address,json=transferFromAddress,proto3" json:"transfer_from_address,omitempty" yaml:"transfer_from_address"`
+	TransferToAddress   string     `protobuf:"bytes,4,opt,name=transfer_to_address,json=transferToAddress,proto3" json:"transfer_to_address,omitempty" yaml:"transfer_to_address"`
+}
+
+func (m *MsgForceTransfer) Reset()         { *m = MsgForceTransfer{} }
+func (m *MsgForceTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgForceTransfer) ProtoMessage()    {}
+func (*MsgForceTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dcc10a6012e0d37, []int{16}
+}
+func (m *MsgForceTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceTransfer.Merge(m, src)
+}
+func (m *MsgForceTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceTransfer proto.InternalMessageInfo
+
+func (m *MsgForceTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgForceTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgForceTransfer) GetTransferFromAddress() string {
+	if m != nil {
+		return m.TransferFromAddress
+	}
+	return ""
+}
+
+func (m *MsgForceTransfer) GetTransferToAddress() string {
+	if m != nil {
+		return m.TransferToAddress
+	}
+	return ""
+}
+
+// MsgForceTransferResponse defines the response structure for an executed
+// MsgForceTransfer message.
+type MsgForceTransferResponse struct {
+}
+
+func (m *MsgForceTransferResponse) Reset()         { *m = MsgForceTransferResponse{} }
+func (m *MsgForceTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForceTransferResponse) ProtoMessage()    {}
+func (*MsgForceTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dcc10a6012e0d37, []int{17}
+}
+func (m *MsgForceTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForceTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForceTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForceTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForceTransferResponse.Merge(m, src)
+}
+func (m *MsgForceTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForceTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForceTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForceTransferResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateDenom)(nil), "helios.tokenfactory.v1beta1.MsgCreateDenom")
 	proto.RegisterType((*MsgCreateDenomResponse)(nil), "helios.tokenfactory.v1beta1.MsgCreateDenomResponse")
@@ -642,6 +953,12 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "helios.tokenfactory.v1beta1.MsgSetDenomMetadataResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "helios.tokenfactory.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "helios.tokenfactory.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetBeforeSendHook)(nil), "helios.tokenfactory.v1beta1.MsgSetBeforeSendHook")
+	proto.RegisterType((*MsgSetBeforeSendHookResponse)(nil), "helios.tokenfactory.v1beta1.MsgSetBeforeSendHookResponse")
+	proto.RegisterType((*MsgSetForceTransferEnabled)(nil), "helios.tokenfactory.v1beta1.MsgSetForceTransferEnabled")
+	proto.RegisterType((*MsgSetForceTransferEnabledResponse)(nil), "helios.tokenfactory.v1beta1.MsgSetForceTransferEnabledResponse")
+	proto.RegisterType((*MsgForceTransfer)(nil), "helios.tokenfactory.v1beta1.MsgForceTransfer")
+	proto.RegisterType((*MsgForceTransferResponse)(nil), "helios.tokenfactory.v1beta1.MsgForceTransferResponse")
 }
 
 func init() {
@@ -649,61 +966,79 @@ func init() {
 }
 
 var fileDescriptor_4dcc10a6012e0d37 = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3d, 0x4f, 0x2b, 0x47,
-	0x14, 0xf5, 0xbe, 0x67, 0x3b, 0x30, 0x3c, 0x62, 0xd8, 0x47, 0x1e, 0xcb, 0x3e, 0x61, 0x93, 0x05,
-	0x21, 0x87, 0xc4, 0x5e, 0x0c, 0x08, 0x45, 0xee, 0x30, 0x91, 0x92, 0xc6, 0x52, 0xb4, 0x24, 0x0d,
-	0x8a, 0x84, 0xc6, 0xde, 0xc9, 0x7a, 0x85, 0x77, 0xc6, 0xda, 0x19, 0x03, 0x6e, 0xa2, 0x28, 0x65,
-	0xaa, 0xd4, 0x69, 0xd2, 0xa6, 0xa4, 0xc8, 0x2f, 0x48, 0x45, 0x91, 0x02, 0xa5, 0x4a, 0x65, 0x45,
-	0x50, 0xd0, 0xfb, 0x17, 0x44, 0xf3, 0xb1, 0xeb, 0xf5, 0x87, 0x30, 0xee, 0xd2, 0xd8, 0xbb, 0x73,
-	0xcf, 0xbd, 0x73, 0xce, 0x99, 0x3b, 0xd7, 0x06, 0x3b, 0x2d, 0xd4, 0xf6, 0x09, 0xb5, 0x19, 0xb9,
-	0x44, 0xf8, 0x7b, 0xd8, 0x64, 0x24, 0xec, 0xd9, 0x57, 0x95, 0x06, 0x62, 0xb0, 0x62, 0xb3, 0x9b,
-	0x72, 0x27, 0x24, 0x8c, 0xe8, 0xef, 0x25, 0xaa, 0x9c, 0x44, 0x95, 0x15, 0xca, 0x5c, 0xf3, 0x88,
-	0x47, 0x04, 0xce, 0xe6, 0x4f, 0x32, 0xc5, 0xcc, 0x37, 0x09, 0x0d, 0x08, 0xb5, 0x1b, 0x90, 0xa2,
-	0xb8, 0x60, 0x93, 0xf8, 0x78, 0x22, 0x8e, 0x2f, 0xe3, 0x38, 0x7f, 0x51, 0xf1, 0x75, 0x15, 0x0f,
-	0xa8, 0x67, 0x5f, 0x55, 0xf8, 0x97, 0x0a, 0x6c, 0xc8, 0xc0, 0x85, 0xdc, 0x51, 0xbe, 0xa8, 0x50,
-	0xf1, 0x39, 0x31, 0x1d, 0x18, 0xc2, 0x20, 0x42, 0xae, 0xc2, 0xc0, 0xc7, 0xc4, 0x16, 0x9f, 0x72,
-	0xc9, 0xfa, 0xed, 0x15, 0xf8, 0xb0, 0x4e, 0xbd, 0xd3, 0x10, 0x41, 0x86, 0xbe, 0x40, 0x98, 0x04,
-	0xfa, 0x27, 0x20, 0x4b, 0x11, 0x76, 0x51, 0x68, 0x68, 0x5b, 0x5a, 0x71, 0xb1, 0xb6, 0x3a, 0xe8,
-	0x17, 0x96, 0x7b, 0x30, 0x68, 0x57, 0x2d, 0xb9, 0x6e, 0x39, 0x0a, 0xa0, 0xdb, 0x60, 0x81, 0x76,
-	0x1b, 0x2e, 0x4f, 0x33, 0x5e, 0x09, 0xf0, 0xdb, 0x41, 0xbf, 0x90, 0x53, 0x60, 0x15, 0xb1, 0x9c,
-	0x18, 0xa4, 0x6f, 0x83, 0x34, 0x86, 0x01, 0x32, 0x5e, 0x0b, 0x70, 0x6e, 0xd0, 0x2f, 0x2c, 0x49,
-	0x30, 0x5f, 0xb5, 0x1c, 0x11, 0x14, 0x04, 0x7a, 0x41, 0x83, 0xb4, 0x8d, 0xf4, 0x04, 0x01, 0xb1,
-	0xce, 0x09, 0x88, 0x07, 0x4e, 0xc0, 0x45, 0x4d, 0x3f, 0x80, 0x6d, 0x6a, 0x64, 0xb6, 0xb4, 0xe2,
-	0x72, 0x92, 0x40, 0x14, 0xb1, 0x9c, 0x18, 0x54, 0xdd, 0xff, 0xe9, 0xe9, 0x76, 0x4f, 0xd1, 0xff,
-	0xf9, 0xe9, 0x76, 0x6f, 0x6b, 0x9a, 0x79, 0x4d, 0xe1, 0x46, 0x49, 0xb2, 0xff, 0x0e, 0xbc, 0x1b,
-	0x35, 0xc8, 0x41, 0xb4, 0x43, 0x30, 0x45, 0x7a, 0x0d, 0xe4, 0x30, 0xba, 0xbe, 0x10, 0xa9, 0x17,
-	0xd2, 0x04, 0xe9, 0x98, 0x39, 0xe8, 0x17, 0xde, 0x29, 0x5d, 0xa3, 0x00, 0xcb, 0x59, 0xc6, 0xe8,
-	0xfa, 0x1b, 0xbe, 0x20, 0x6a, 0x59, 0xbf, 0x6b, 0xe0, 0x83, 0x3a, 0xf5, 0xea, 0x3e, 0x66, 0xf3,
-	0x18, 0xff, 0x15, 0xc8, 0xc2, 0x80, 0x74, 0x31, 0x13, 0xb6, 0x2f, 0x1d, 0x6c, 0x94, 0x55, 0x4b,
-	0xf0, 0xc6, 0x8b, 0x7a, 0xb4, 0x7c, 0x4a, 0x7c, 0x5c, 0xfb, 0xe8, 0xae, 0x5f, 0x48, 0x0d, 0x2b,
-	0xc9, 0x34, 0xcb, 0x51, 0xf9, 0xd5, 0xe2, 0x98, 0x21, 0xc6, 0x34, 0x43, 0x02, 0x1f, 0x33, 0x6b,
-	0x15, 0xe4, 0x14, 0xd3, 0xc8, 0x81, 0x88, 0x7d, 0xad, 0x1b, 0xe2, 0xff, 0x31, 0xfb, 0x46, 0x37,
-	0xc4, 0x8a, 0x3d, 0x67, 0x1a, 0xb3, 0xbf, 0xd3, 0x64, 0xef, 0xb7, 0x20, 0xf6, 0xd0, 0x89, 0x1b,
-	0xf8, 0x73, 0x89, 0xd8, 0x05, 0x99, 0x64, 0xe3, 0xaf, 0x0c, 0xfa, 0x85, 0x37, 0x51, 0xdf, 0x89,
-	0x93, 0x96, 0x61, 0xbd, 0x02, 0x16, 0x79, 0x13, 0x40, 0x5e, 0x5f, 0xf5, 0xfd, 0xda, 0xa0, 0x5f,
-	0x58, 0x19, 0xf6, 0x87, 0x08, 0x59, 0xce, 0x02, 0x46, 0xd7, 0x82, 0xc5, 0x0b, 0x9b, 0x54, 0xd0,
-	0x2e, 0xc9, 0x64, 0x43, 0x36, 0xe9, 0x50, 0x49, 0x2c, 0xf2, 0x2f, 0x0d, 0xbc, 0xad, 0x53, 0xef,
-	0x0c, 0x31, 0xd1, 0x70, 0x75, 0xc4, 0xa0, 0x0b, 0x19, 0x9c, 0x47, 0xa9, 0x03, 0x16, 0x02, 0x95,
-	0xa6, 0x0e, 0x6c, 0x73, 0x78, 0x60, 0xf8, 0x32, 0x3e, 0xb0, 0xa8, 0x76, 0x6d, 0x5d, 0x1d, 0x9a,
-	0xba, 0x87, 0x51, 0xb2, 0xe5, 0xc4, 0x75, 0xaa, 0xc7, 0x63, 0x12, 0x77, 0xa7, 0x49, 0xa4, 0x88,
-	0xc9, 0x4b, 0x58, 0x8a, 0x4b, 0x6c, 0x82, 0xf7, 0x53, 0xd4, 0xc4, 0x6a, 0xff, 0xd4, 0xc4, 0x31,
-	0x7f, 0xdb, 0x71, 0x21, 0x43, 0x5f, 0x8b, 0xd9, 0xa7, 0x1f, 0x83, 0x45, 0xd8, 0x65, 0x2d, 0x12,
-	0xfa, 0xac, 0xa7, 0xc4, 0x1a, 0x7f, 0xff, 0x51, 0x5a, 0x53, 0x12, 0x4e, 0x5c, 0x37, 0x44, 0x94,
-	0x9e, 0xb1, 0xd0, 0xc7, 0x9e, 0x33, 0x84, 0xea, 0x27, 0x20, 0x2b, 0xa7, 0xa7, 0x12, 0xbd, 0x5d,
-	0x7e, 0xe6, 0xf7, 0xa0, 0x2c, 0x37, 0xab, 0xa5, 0xb9, 0x74, 0x47, 0x25, 0x56, 0x8f, 0xb8, 0xca,
-	0x61, 0x49, 0x2e, 0xf4, 0xe3, 0x69, 0x42, 0xbb, 0x82, 0x6f, 0x49, 0x66, 0x59, 0x1b, 0x60, 0x7d,
-	0x4c, 0x43, 0xa4, 0xef, 0xe0, 0xd7, 0x0c, 0x78, 0x5d, 0xa7, 0x9e, 0x4e, 0xc0, 0x52, 0x72, 0x64,
-	0x7f, 0xfa, 0x2c, 0xb5, 0xd1, 0xf1, 0x65, 0x1e, 0xce, 0x01, 0x8e, 0x67, 0xdd, 0x39, 0x48, 0x8b,
-	0x19, 0xb5, 0x33, 0x2b, 0x99, 0xa3, 0xcc, 0xcf, 0x5e, 0x82, 0x4a, 0xd6, 0x16, 0x13, 0x64, 0x66,
-	0x6d, 0x8e, 0x9a, 0x5d, 0x3b, 0x79, 0xc7, 0x85, 0x51, 0x89, 0xfb, 0x3d, 0xdb, 0xa8, 0x21, 0xf8,
-	0x05, 0x46, 0x4d, 0xde, 0x37, 0xfd, 0x07, 0xb0, 0x32, 0x71, 0xd7, 0xf6, 0x67, 0x15, 0x1a, 0xcf,
-	0x30, 0x3f, 0x9f, 0x37, 0x23, 0xde, 0x3f, 0x04, 0x6f, 0x46, 0xba, 0x7f, 0xa6, 0x5d, 0x49, 0xb4,
-	0x79, 0x34, 0x0f, 0x3a, 0xda, 0xd3, 0xcc, 0xfc, 0xf8, 0x74, 0xbb, 0xa7, 0xd5, 0xbe, 0xbc, 0x7b,
-	0xc8, 0x6b, 0xf7, 0x0f, 0x79, 0xed, 0xdf, 0x87, 0xbc, 0xf6, 0xcb, 0x63, 0x3e, 0x75, 0xff, 0x98,
-	0x4f, 0xfd, 0xf3, 0x98, 0x4f, 0x9d, 0x97, 0x64, 0xd5, 0x52, 0x93, 0x84, 0xc8, 0x8e, 0x9e, 0x5b,
-	0xd0, 0xc7, 0xf6, 0xcd, 0xe8, 0x45, 0x60, 0xbd, 0x0e, 0xa2, 0x8d, 0xac, 0xf8, 0x6f, 0x72, 0xf8,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x92, 0x06, 0x68, 0x6d, 0xa7, 0x09, 0x00, 0x00,
+	// 1148 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0x66, 0x09, 0x10, 0x18, 0x42, 0x80, 0x85, 0x04, 0xb3, 0x24, 0x5e, 0x3a, 0x41, 0x11, 0xa5,
+	0xac, 0x1d, 0x20, 0x4d, 0x53, 0x1f, 0x5a, 0xe1, 0xb4, 0x34, 0x17, 0x57, 0xd5, 0x42, 0x2f, 0x51,
+	0x25, 0x6b, 0x6c, 0x0f, 0x66, 0x05, 0x3b, 0x83, 0x76, 0xc7, 0x21, 0x5c, 0xaa, 0xb6, 0xc7, 0x9e,
+	0x2a, 0xf5, 0x0f, 0xe8, 0xb5, 0x52, 0x2f, 0x1c, 0xfa, 0x17, 0xf4, 0xc4, 0xa1, 0x87, 0xa8, 0xa7,
+	0x5e, 0xba, 0x6a, 0xe1, 0xc0, 0xa1, 0x37, 0xff, 0x05, 0xd5, 0xfc, 0xd8, 0xb1, 0x77, 0x31, 0xd8,
+	0xae, 0x14, 0xb5, 0x17, 0x58, 0xcf, 0xfb, 0xde, 0x9b, 0xef, 0xfb, 0xde, 0xf3, 0xcc, 0x1a, 0x2c,
+	0xed, 0xe1, 0x03, 0x8f, 0x86, 0x79, 0x46, 0xf7, 0x31, 0xd9, 0x45, 0x55, 0x46, 0x83, 0xe3, 0xfc,
+	0xcb, 0xb5, 0x0a, 0x66, 0x68, 0x2d, 0xcf, 0x5e, 0xe5, 0x0e, 0x03, 0xca, 0xa8, 0xb9, 0x20, 0x51,
+	0xb9, 0x76, 0x54, 0x4e, 0xa1, 0xac, 0xd9, 0x3a, 0xad, 0x53, 0x81, 0xcb, 0xf3, 0x27, 0x99, 0x62,
+	0x65, 0xab, 0x34, 0xf4, 0x69, 0x98, 0xaf, 0xa0, 0x10, 0xeb, 0x82, 0x55, 0xea, 0x91, 0x4b, 0x71,
+	0xb2, 0xaf, 0xe3, 0xfc, 0x83, 0x8a, 0xcf, 0xa9, 0xb8, 0x1f, 0xd6, 0xf3, 0x2f, 0xd7, 0xf8, 0x3f,
+	0x15, 0x98, 0x97, 0x81, 0xb2, 0xdc, 0x51, 0x7e, 0x50, 0xa1, 0xe5, 0xeb, 0xc4, 0x1c, 0xa2, 0x00,
+	0xf9, 0x31, 0x72, 0x1a, 0xf9, 0x1e, 0xa1, 0x79, 0xf1, 0x57, 0x2e, 0xc1, 0x1f, 0x06, 0xc1, 0xed,
+	0x52, 0x58, 0x7f, 0x16, 0x60, 0xc4, 0xf0, 0x47, 0x98, 0x50, 0xdf, 0x7c, 0x1b, 0x8c, 0x84, 0x98,
+	0xd4, 0x70, 0x90, 0x31, 0x16, 0x8d, 0xe5, 0xb1, 0xe2, 0x74, 0x33, 0xb2, 0x27, 0x8e, 0x91, 0x7f,
+	0x50, 0x80, 0x72, 0x1d, 0xba, 0x0a, 0x60, 0xe6, 0xc1, 0x68, 0xd8, 0xa8, 0xd4, 0x78, 0x5a, 0x66,
+	0x50, 0x80, 0x67, 0x9a, 0x91, 0x3d, 0xa9, 0xc0, 0x2a, 0x02, 0x5d, 0x0d, 0x32, 0x1f, 0x80, 0x21,
+	0x82, 0x7c, 0x9c, 0xb9, 0x21, 0xc0, 0x93, 0xcd, 0xc8, 0x1e, 0x97, 0x60, 0xbe, 0x0a, 0x5d, 0x11,
+	0x14, 0x04, 0x8e, 0xfd, 0x0a, 0x3d, 0xc8, 0x0c, 0x5d, 0x22, 0x20, 0xd6, 0x39, 0x01, 0xf1, 0xc0,
+	0x09, 0xd4, 0x70, 0xd5, 0xf3, 0xd1, 0x41, 0x98, 0x19, 0x5e, 0x34, 0x96, 0x27, 0xda, 0x09, 0xc4,
+	0x11, 0xe8, 0x6a, 0x50, 0xe1, 0xd1, 0x37, 0x17, 0x27, 0x2b, 0x8a, 0xfe, 0xb7, 0x17, 0x27, 0x2b,
+	0x8b, 0x9d, 0xcc, 0xab, 0x0a, 0x37, 0x1c, 0xc9, 0xfe, 0x0b, 0x70, 0x37, 0x69, 0x90, 0x8b, 0xc3,
+	0x43, 0x4a, 0x42, 0x6c, 0x16, 0xc1, 0x24, 0xc1, 0x47, 0x65, 0x91, 0x5a, 0x96, 0x26, 0x48, 0xc7,
+	0xac, 0x66, 0x64, 0xdf, 0x55, 0xba, 0x92, 0x00, 0xe8, 0x4e, 0x10, 0x7c, 0xb4, 0xc3, 0x17, 0x44,
+	0x2d, 0xf8, 0xa3, 0x01, 0x6e, 0x96, 0xc2, 0x7a, 0xc9, 0x23, 0xac, 0x1f, 0xe3, 0x9f, 0x83, 0x11,
+	0xe4, 0xd3, 0x06, 0x61, 0xc2, 0xf6, 0xf1, 0xf5, 0xf9, 0x9c, 0x1a, 0x09, 0x3e, 0x78, 0xf1, 0x8c,
+	0xe6, 0x9e, 0x51, 0x8f, 0x14, 0xef, 0x9c, 0x46, 0xf6, 0x40, 0xab, 0x92, 0x4c, 0x83, 0xae, 0xca,
+	0x2f, 0x2c, 0xa7, 0x0c, 0xc9, 0x74, 0x32, 0xc4, 0xf7, 0x08, 0x83, 0xd3, 0x60, 0x52, 0x31, 0x8d,
+	0x1d, 0x88, 0xd9, 0x17, 0x1b, 0x01, 0xf9, 0x1f, 0xb3, 0xaf, 0x34, 0x02, 0xa2, 0xd8, 0x73, 0xa6,
+	0x9a, 0xfd, 0xa9, 0x21, 0x67, 0x7f, 0x0f, 0x91, 0x3a, 0xde, 0xac, 0xf9, 0x5e, 0x5f, 0x22, 0x1e,
+	0x82, 0xe1, 0xf6, 0xc1, 0x9f, 0x6a, 0x46, 0xf6, 0xad, 0x78, 0xee, 0x44, 0xa7, 0x65, 0xd8, 0x5c,
+	0x03, 0x63, 0x7c, 0x08, 0x10, 0xaf, 0xaf, 0xe6, 0x7e, 0xb6, 0x19, 0xd9, 0x53, 0xad, 0xf9, 0x10,
+	0x21, 0xe8, 0x8e, 0x12, 0x7c, 0x24, 0x58, 0xf4, 0x38, 0xa4, 0x82, 0xb6, 0x23, 0x93, 0x33, 0x72,
+	0x48, 0x5b, 0x4a, 0xb4, 0xc8, 0x5f, 0x0d, 0x30, 0x53, 0x0a, 0xeb, 0xdb, 0x98, 0x89, 0x81, 0x2b,
+	0x61, 0x86, 0x6a, 0x88, 0xa1, 0x7e, 0x94, 0xba, 0x60, 0xd4, 0x57, 0x69, 0xaa, 0x61, 0xf7, 0x5b,
+	0x0d, 0x23, 0xfb, 0xba, 0x61, 0x71, 0xed, 0xe2, 0x9c, 0x6a, 0x9a, 0xfa, 0x1e, 0xc6, 0xc9, 0xd0,
+	0xd5, 0x75, 0x0a, 0x4f, 0x52, 0x12, 0x1f, 0x76, 0x92, 0x18, 0x62, 0x26, 0xbf, 0x84, 0x8e, 0x2e,
+	0x71, 0x1f, 0x2c, 0x74, 0x50, 0xa3, 0xd5, 0xfe, 0x62, 0x88, 0x36, 0x7f, 0x7e, 0x58, 0x43, 0x0c,
+	0x7f, 0x26, 0xce, 0x3e, 0xf3, 0x09, 0x18, 0x43, 0x0d, 0xb6, 0x47, 0x03, 0x8f, 0x1d, 0x2b, 0xb1,
+	0x99, 0xdf, 0x7e, 0x76, 0x66, 0x95, 0x84, 0xcd, 0x5a, 0x2d, 0xc0, 0x61, 0xb8, 0xcd, 0x02, 0x8f,
+	0xd4, 0xdd, 0x16, 0xd4, 0xdc, 0x04, 0x23, 0xf2, 0xf4, 0x54, 0xa2, 0x1f, 0xe4, 0xae, 0xb9, 0x0f,
+	0x72, 0x72, 0xb3, 0xe2, 0x10, 0x97, 0xee, 0xaa, 0xc4, 0xc2, 0x63, 0xae, 0xb2, 0x55, 0x92, 0x0b,
+	0x7d, 0xab, 0x93, 0xd0, 0x86, 0xe0, 0xeb, 0xc8, 0x2c, 0x38, 0x0f, 0xe6, 0x52, 0x1a, 0xb4, 0xbe,
+	0xbf, 0x0d, 0x30, 0x2b, 0xf5, 0x17, 0xf1, 0x2e, 0x0d, 0xf0, 0x36, 0x26, 0xb5, 0xe7, 0x94, 0xee,
+	0xbf, 0x89, 0xc1, 0xdd, 0x02, 0x53, 0x55, 0x4a, 0x58, 0x80, 0xaa, 0xac, 0x8c, 0xa4, 0x49, 0x6a,
+	0x7e, 0x17, 0x9a, 0x91, 0x3d, 0x27, 0x53, 0xd2, 0x08, 0xe8, 0x4e, 0xc6, 0x4b, 0xca, 0xd8, 0xc2,
+	0xd3, 0x54, 0xab, 0x97, 0xaf, 0x6a, 0x75, 0x45, 0x48, 0x72, 0x38, 0xd2, 0xd9, 0xa3, 0x74, 0x1f,
+	0x66, 0xc1, 0xbd, 0x4e, 0x62, 0xb5, 0x1b, 0x7f, 0x18, 0xc0, 0x92, 0x80, 0x2d, 0x1a, 0x54, 0xf1,
+	0x4e, 0x80, 0x48, 0xb8, 0x8b, 0x83, 0x8f, 0x09, 0xaa, 0x1c, 0xe0, 0xda, 0x9b, 0xf0, 0x64, 0x15,
+	0xdc, 0xc4, 0xb2, 0xba, 0xb0, 0x62, 0xb4, 0x68, 0x36, 0x23, 0xfb, 0xb6, 0x44, 0xaa, 0x00, 0x74,
+	0x63, 0x48, 0xe1, 0x83, 0x94, 0xf2, 0xdc, 0x55, 0xca, 0x77, 0x39, 0x7d, 0x87, 0x29, 0xfe, 0x4e,
+	0x5c, 0x69, 0x09, 0xc0, 0xab, 0xe5, 0x69, 0x17, 0xfe, 0x1a, 0x04, 0x53, 0xa5, 0xb0, 0x9e, 0xc0,
+	0xfc, 0x27, 0xa7, 0xb1, 0xb9, 0x03, 0xee, 0xc4, 0x1a, 0xca, 0xbb, 0x01, 0xf5, 0x53, 0x63, 0xb3,
+	0xd8, 0x8c, 0xec, 0x7b, 0x32, 0xb3, 0x23, 0x0c, 0xba, 0x33, 0xf1, 0xfa, 0x56, 0x40, 0x7d, 0x35,
+	0x3f, 0xe6, 0xa7, 0x40, 0x2f, 0x97, 0x19, 0xd5, 0x35, 0xe5, 0xbb, 0x41, 0xb6, 0x19, 0xd9, 0x56,
+	0xaa, 0x66, 0x0b, 0x04, 0xdd, 0xe9, 0x78, 0x75, 0x87, 0xc6, 0xf3, 0xb8, 0x9e, 0xea, 0x0a, 0xec,
+	0xd4, 0x95, 0x64, 0x47, 0xa0, 0x05, 0x32, 0x69, 0x8b, 0x63, 0xff, 0xd7, 0x7f, 0x1a, 0x05, 0x37,
+	0x4a, 0x61, 0xdd, 0xa4, 0x60, 0xbc, 0xfd, 0x35, 0xea, 0x9d, 0x6b, 0x8f, 0x8b, 0xe4, 0x2b, 0x85,
+	0xb5, 0xd1, 0x07, 0x58, 0xbf, 0x7f, 0xbc, 0x00, 0x43, 0xe2, 0xbd, 0x61, 0xa9, 0x5b, 0x32, 0x47,
+	0x59, 0xab, 0xbd, 0xa0, 0xda, 0x6b, 0x8b, 0x5b, 0xbd, 0x6b, 0x6d, 0x8e, 0xb2, 0x56, 0x7b, 0x41,
+	0xe9, 0xda, 0xdc, 0xa8, 0xb6, 0x3b, 0xb7, 0xbb, 0x51, 0x2d, 0xb0, 0xb5, 0xd1, 0x07, 0x58, 0x6f,
+	0xf8, 0x25, 0x98, 0xba, 0x74, 0xff, 0x3d, 0xea, 0x56, 0x28, 0x9d, 0x61, 0x3d, 0xed, 0x37, 0x43,
+	0xef, 0x1f, 0x80, 0x5b, 0x89, 0x1b, 0xa9, 0xab, 0x5d, 0xed, 0x68, 0xeb, 0x71, 0x3f, 0x68, 0xbd,
+	0xe7, 0xd7, 0x06, 0x98, 0xbe, 0x7c, 0x4d, 0xac, 0xf5, 0xa0, 0x21, 0x99, 0x62, 0xbd, 0xdf, 0x77,
+	0x8a, 0xe6, 0xf0, 0xbd, 0x01, 0xe6, 0xae, 0x3a, 0x9c, 0xdf, 0xeb, 0xa1, 0x6c, 0xa7, 0x44, 0xeb,
+	0xc3, 0x7f, 0x99, 0xa8, 0x59, 0x35, 0xc0, 0x44, 0xf2, 0xac, 0x74, 0xba, 0x55, 0x4c, 0xc0, 0xad,
+	0x77, 0xfb, 0x82, 0xc7, 0xdb, 0x5a, 0xc3, 0x5f, 0x5d, 0x9c, 0xac, 0x18, 0xc5, 0x4f, 0x4e, 0xcf,
+	0xb2, 0xc6, 0xeb, 0xb3, 0xac, 0xf1, 0xe7, 0x59, 0xd6, 0xf8, 0xee, 0x3c, 0x3b, 0xf0, 0xfa, 0x3c,
+	0x3b, 0xf0, 0xfb, 0x79, 0x76, 0xe0, 0x85, 0x23, 0xcb, 0x3a, 0x55, 0x1a, 0xe0, 0x7c, 0xfc, 0xbc,
+	0x87, 0x3c, 0x92, 0x7f, 0x95, 0x3c, 0x9b, 0xd8, 0xf1, 0x21, 0x0e, 0x2b, 0x23, 0xe2, 0x07, 0xdc,
+	0xc6, 0x3f, 0x03, 0x00, 0x6b, 0x8a, 0x1a, 0xcb, 0xcc, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -724,6 +1059,9 @@ type MsgClient interface {
 	ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error)
 	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error)
+	SetForceTransferEnabled(ctx context.Context, in *MsgSetForceTransferEnabled, opts ...grpc.CallOption) (*MsgSetForceTransferEnabledResponse, error)
+	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
 }
 
 type msgClient struct {
@@ -788,6 +1126,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error) {
+	out := new(MsgSetBeforeSendHookResponse)
+	err := c.cc.Invoke(ctx, "/helios.tokenfactory.v1beta1.Msg/SetBeforeSendHook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetForceTransferEnabled(ctx context.Context, in *MsgSetForceTransferEnabled, opts ...grpc.CallOption) (*MsgSetForceTransferEnabledResponse, error) {
+	out := new(MsgSetForceTransferEnabledResponse)
+	err := c.cc.Invoke(ctx, "/helios.tokenfactory.v1beta1.Msg/SetForceTransferEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error) {
+	out := new(MsgForceTransferResponse)
+	err := c.cc.Invoke(ctx, "/helios.tokenfactory.v1beta1.Msg/ForceTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
@@ -796,6 +1161,9 @@ type MsgServer interface {
 	ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error)
 	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error)
+	SetForceTransferEnabled(context.Context, *MsgSetForceTransferEnabled) (*MsgSetForceTransferEnabledResponse, error)
+	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -820,6 +1188,15 @@ func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSet
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetBeforeSendHook(ctx context.Context, req *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBeforeSendHook not implemented")
+}
+func (*UnimplementedMsgServer) SetForceTransferEnabled(ctx context.Context, req *MsgSetForceTransferEnabled) (*MsgSetForceTransferEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForceTransferEnabled not implemented")
+}
+func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgForceTransfer) (*MsgForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -933,6 +1310,61 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBeforeSendHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBeforeSendHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBeforeSendHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.tokenfactory.v1beta1.Msg/SetBeforeSendHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBeforeSendHook(ctx, req.(*MsgSetBeforeSendHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetForceTransferEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetForceTransferEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetForceTransferEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.tokenfactory.v1beta1.Msg/SetForceTransferEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetForceTransferEnabled(ctx, req.(*MsgSetForceTransferEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helios.tokenfactory.v1beta1.Msg/ForceTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helios.tokenfactory.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -961,6 +1393,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetBeforeSendHook",
+			Handler:    _Msg_SetBeforeSendHook_Handler,
+		},
+		{
+			MethodName: "SetForceTransferEnabled",
+			Handler:    _Msg_SetForceTransferEnabled_Handler,
+		},
+		{
+			MethodName: "ForceTransfer",
+			Handler:    _Msg_ForceTransfer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "helios/tokenfactory/v1beta1/tx.proto",
@@ -1371,14 +1815,228 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
+func (m *MsgSetBeforeSendHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBeforeSendHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBeforeSendHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBeforeSendHookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBeforeSendHookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBeforeSendHookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetForceTransferEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForceTransferEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForceTransferEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetForceTransferEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForceTransferEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForceTransferEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferToAddress) > 0 {
+		i -= len(m.TransferToAddress)
+		copy(dAtA[i:], m.TransferToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TransferFromAddress) > 0 {
+		i -= len(m.TransferFromAddress)
+		copy(dAtA[i:], m.TransferFromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferFromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForceTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
 	dAtA[offset] = uint8(v)
 	return base
 }
@@ -1549,13 +2207,713 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetBeforeSendHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetBeforeSendHookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
+
+func (m *MsgSetForceTransferEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetForceTransferEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TransferFromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewTokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,10 +2936,10 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1618,7 +2976,7 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,43 +3004,11 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1710,27 +3036,8 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1752,7 +3059,7 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1775,44 +3082,12 @@ func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewTokenDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1834,7 +3109,7 @@ func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1857,10 +3132,10 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1897,7 +3172,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1924,7 +3199,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1949,7 +3224,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1972,10 +3247,10 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1999,7 +3274,7 @@ func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2022,15 +3297,15 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2058,11 +3333,11 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2089,7 +3364,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2114,7 +3389,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2137,10 +3412,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2164,7 +3439,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBeforeSendHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,10 +3462,10 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBeforeSendHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBeforeSendHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2259,7 +3534,7 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2287,7 +3562,7 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2310,7 +3585,7 @@ func (m *MsgChangeAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBeforeSendHookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2333,10 +3608,10 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBeforeSendHookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2360,7 +3635,7 @@ func (m *MsgChangeAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgSetForceTransferEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2383,10 +3658,10 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetForceTransferEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetForceTransferEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2423,9 +3698,9 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2435,25 +3710,44 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2475,7 +3769,7 @@ func (m *MsgSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetForceTransferEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2498,10 +3792,10 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetForceTransferEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetForceTransferEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2525,7 +3819,7 @@ func (m *MsgSetDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2548,15 +3842,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2584,11 +3878,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2615,10 +3909,74 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2640,7 +3998,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2663,10 +4021,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: